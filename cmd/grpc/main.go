@@ -1,13 +1,26 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	"golang.org/x/sync/errgroup"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/cache"
 	"github.com/MAD-py/pandora-core/internal/adapters/grpc"
 	"github.com/MAD-py/pandora-core/internal/adapters/grpc/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/metrics"
 	"github.com/MAD-py/pandora-core/internal/adapters/persistence"
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence/migrations"
+	"github.com/MAD-py/pandora-core/internal/adapters/ratelimit"
+	"github.com/MAD-py/pandora-core/internal/adapters/requestlog"
+	"github.com/MAD-py/pandora-core/internal/adapters/security"
+	"github.com/MAD-py/pandora-core/internal/adapters/tracing"
 	"github.com/MAD-py/pandora-core/internal/config"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -20,20 +33,127 @@ func main() {
 	cfg := config.LoadGRPCConfig()
 	log.Printf("[INFO] gRPC config loaded")
 
+	tracingCfg := cfg.TracingConfig()
+	tracer, err := tracing.NewProvider(
+		context.Background(),
+		tracing.ExporterType(tracingCfg.Exporter()),
+		tracingCfg.File(),
+	)
+	if err != nil {
+		log.Fatalf("[ERROR] Failed to initialize tracing: %v", err)
+	}
+	defer tracer.Shutdown(context.Background())
+	log.Printf("[INFO] Tracing initialized (%s)", tracingCfg.Exporter())
+
 	validator := validator.NewValidator()
 	log.Println("[INFO] Validator initialized")
 
+	apiKeyProtector := security.NewAPIKeyProtector([]byte(cfg.APIKeySecret()))
+	log.Println("[INFO] API key protector initialized")
+
+	driver := persistence.DriverType(cfg.DBDriver())
+	if driver == persistence.MemoryDriver {
+		log.Println("[WARNING] Using the memory driver. Data is lost on exit and not shared with the other Pandora processes.")
+	}
+
+	if cfg.DBAutoMigrate() && driver == persistence.PostgresDriver {
+		err := migrations.Up(context.Background(), cfg.DBDNS(), migrations.SetPandora)
+		if err != nil {
+			log.Fatalf("[ERROR] Failed to migrate the database: %v", err)
+		}
+		log.Println("[INFO] Database migrated")
+	}
+
 	repositories := persistence.NewRepositories(
-		persistence.PostgresDriver, cfg.DBDNS(),
+		driver, cfg.DBDNS(), apiKeyProtector,
 	)
-	log.Println("[INFO] Repositories initialized")
+	log.Printf("[INFO] Repositories initialized (%s)", driver)
 
-	gRPCDeps := bootstrap.NewDependencies(validator, repositories)
+	rateLimiter := ratelimit.NewRateLimiter(cfg.RateLimitBackend(), repositories)
+	log.Printf("[INFO] Rate limiter initialized (%s)", cfg.RateLimitBackend())
+
+	// Reads of the memory driver never leave the process, and it does not
+	// notify changes, so only the postgres driver is cached.
+	gRPCRepositories := repositories
+	if cfg.CacheTTL() > 0 && driver == persistence.PostgresDriver {
+		validationCache := cache.New(cfg.CacheTTL(), cfg.CacheSize())
+		go cache.Listen(context.Background(), cfg.DBDNS(), validationCache)
+
+		gRPCRepositories = cache.NewRepositories(repositories, validationCache)
+		log.Printf("[INFO] Validation cache initialized (%s)", cfg.CacheTTL())
+	}
+
+	// Validations that queue their requests hand them to requestLog, which
+	// is drained before exiting.
+	var requestLog *requestlog.Writer
+	if cfg.RequestLogMode() == "async" {
+		requestLog = requestlog.NewWriter(
+			repositories.Request(),
+			cfg.RequestLogQueueSize(),
+			cfg.RequestLogFlushSize(),
+			cfg.RequestLogFlushInterval(),
+			cfg.RequestLogMaxWait(),
+		)
+
+		gRPCRepositories = requestlog.NewRepositories(gRPCRepositories, requestLog)
+		log.Printf(
+			"[INFO] Asynchronous request log initialized (%d requests or %s)",
+			cfg.RequestLogFlushSize(), cfg.RequestLogFlushInterval(),
+		)
+	}
+
+	gRPCDeps := bootstrap.NewDependencies(
+		validator, gRPCRepositories, rateLimiter, cfg.ReservationTTL(),
+	)
 
 	srv := grpc.NewServer(
 		fmt.Sprintf(":%s", cfg.Port()),
 		gRPCDeps,
 	)
 
-	srv.Run()
+	metricsSrv := metrics.NewServer(fmt.Sprintf(":%s", cfg.MetricsPort()))
+
+	ctx, stop := signal.NotifyContext(
+		context.Background(), os.Interrupt, syscall.SIGTERM,
+	)
+	defer stop()
+
+	var g errgroup.Group
+
+	g.Go(srv.Run)
+	g.Go(metricsSrv.Run)
+
+	failed := make(chan error, 1)
+	go func() { failed <- g.Wait() }()
+
+	select {
+	case err := <-failed:
+		if err != nil {
+			log.Fatalf("[FATAL] One of the services failed: %v", err)
+		}
+	case <-ctx.Done():
+		shutdown(srv, requestLog)
+	}
+}
+
+// shutdownTimeout bounds how long the gRPC calls in flight and the queued
+// requests get to finish on exit.
+const shutdownTimeout = 30 * time.Second
+
+// shutdown stops the gRPC server once its calls in flight finish, then
+// writes the requests requestLog still holds, if any. The other servers
+// are left to exit with the process.
+func shutdown(srv *grpc.Server, requestLog *requestlog.Writer) {
+	log.Println("[INFO] Shutting down...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	srv.Shutdown(ctx)
+	if requestLog != nil {
+		if err := requestLog.Close(ctx); err != nil {
+			log.Printf("[ERROR] Failed to write the queued requests: %v", err)
+		}
+	}
+	log.Println("[INFO] Pandora Core stopped")
 }
@@ -1,14 +1,21 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"golang.org/x/sync/errgroup"
+
 	"github.com/MAD-py/pandora-core/internal/adapters/http"
 	"github.com/MAD-py/pandora-core/internal/adapters/http/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/metrics"
 	"github.com/MAD-py/pandora-core/internal/adapters/persistence"
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence/migrations"
 	"github.com/MAD-py/pandora-core/internal/adapters/security"
+	"github.com/MAD-py/pandora-core/internal/adapters/tracing"
+	adminuser "github.com/MAD-py/pandora-core/internal/app/admin_user"
 	"github.com/MAD-py/pandora-core/internal/config"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -21,25 +28,68 @@ func main() {
 	cfg := config.LoadHTTPConfig()
 	log.Printf("[INFO] HTTP config loaded")
 
+	tracingCfg := cfg.TracingConfig()
+	tracer, err := tracing.NewProvider(
+		context.Background(),
+		tracing.ExporterType(tracingCfg.Exporter()),
+		tracingCfg.File(),
+	)
+	if err != nil {
+		log.Fatalf("[ERROR] Failed to initialize tracing: %v", err)
+	}
+	defer tracer.Shutdown(context.Background())
+	log.Printf("[INFO] Tracing initialized (%s)", tracingCfg.Exporter())
+
 	validator := validator.NewValidator()
 	log.Println("[INFO] Validator initialized")
 
+	apiKeyProtector := security.NewAPIKeyProtector([]byte(cfg.APIKeySecret()))
+	log.Println("[INFO] API key protector initialized")
+
+	driver := persistence.DriverType(cfg.DBDriver())
+	if driver == persistence.MemoryDriver {
+		log.Println("[WARNING] Using the memory driver. Data is lost on exit and not shared with the other Pandora processes.")
+	}
+
+	if cfg.DBAutoMigrate() && driver == persistence.PostgresDriver {
+		err := migrations.Up(context.Background(), cfg.DBDNS(), migrations.SetPandora)
+		if err != nil {
+			log.Fatalf("[ERROR] Failed to migrate the database: %v", err)
+		}
+		log.Println("[INFO] Database migrated")
+	}
+
 	repositories := persistence.NewRepositories(
-		persistence.PostgresDriver, cfg.DBDNS(),
+		driver, cfg.DBDNS(), apiKeyProtector,
 	)
-	log.Println("[INFO] Repositories initialized")
+	log.Printf("[INFO] Repositories initialized (%s)", driver)
 
 	jwtProvider := security.NewJWTProvider([]byte(cfg.JWTSecret()))
 	log.Println("[INFO] JWT provider initialized")
 
-	credentialsRepo := security.NewCredentialsRepository(cfg.CredentialsFile())
-	log.Println("[INFO] Credentials repository initialized")
+	seeded, seedErr := adminuser.NewSeedOwnerUseCase(
+		repositories.Credentials(),
+		security.NewLegacyCredentialsLoader(cfg.CredentialsFile()),
+	).Execute(context.Background())
+	if seedErr != nil {
+		log.Fatalf("[ERROR] Failed to seed the first admin user: %v", seedErr)
+	}
+	if seeded != nil && seeded.Imported {
+		log.Printf("[INFO] Admin user %s imported from credentials file as owner", seeded.Username)
+	} else if seeded != nil {
+		log.Println("[WARNING] No admin users were found, generating default credentials.")
+		log.Println("[INFO] Default admin credentials:")
+		log.Printf("        - Username: %s\n", seeded.Username)
+		log.Printf("        - Password: %s\n", seeded.Password)
+		log.Println("[SECURITY] It is highly recommended to change the default password immediately.")
+	}
 
 	httpDeps := bootstrap.NewDependencies(
 		validator,
 		repositories,
 		jwtProvider,
-		credentialsRepo,
+		cfg.APIKeyPrefix(),
+		cfg.APIKeyMaxGracePeriod(),
 	)
 
 	srv := http.NewServer(
@@ -48,5 +98,14 @@ func main() {
 		httpDeps,
 	)
 
-	srv.Run()
+	metricsSrv := metrics.NewServer(fmt.Sprintf(":%s", cfg.MetricsPort()))
+
+	var g errgroup.Group
+
+	g.Go(srv.Run)
+	g.Go(metricsSrv.Run)
+
+	if err := g.Wait(); err != nil {
+		log.Fatalf("[FATAL] One of the services failed: %v", err)
+	}
 }
@@ -1,21 +1,36 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/MAD-py/pandora-core/internal/adapters/archive"
+	"github.com/MAD-py/pandora-core/internal/adapters/cache"
 	"github.com/MAD-py/pandora-core/internal/adapters/grpc"
 	grpcBootstrap "github.com/MAD-py/pandora-core/internal/adapters/grpc/bootstrap"
 	"github.com/MAD-py/pandora-core/internal/adapters/http"
 	httpBootstrap "github.com/MAD-py/pandora-core/internal/adapters/http/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/metrics"
 	"github.com/MAD-py/pandora-core/internal/adapters/persistence"
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence/migrations"
+	"github.com/MAD-py/pandora-core/internal/adapters/ratelimit"
+	"github.com/MAD-py/pandora-core/internal/adapters/requestlog"
 	"github.com/MAD-py/pandora-core/internal/adapters/security"
 	"github.com/MAD-py/pandora-core/internal/adapters/taskengine"
 	taskengineBootstrap "github.com/MAD-py/pandora-core/internal/adapters/taskengine/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/tracing"
+	"github.com/MAD-py/pandora-core/internal/adapters/webhook"
+	adminuser "github.com/MAD-py/pandora-core/internal/app/admin_user"
 	"github.com/MAD-py/pandora-core/internal/config"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -23,27 +38,123 @@ import (
 func main() {
 	time.Local = time.UTC
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		migrate(config.LoadMigrationConfig(), os.Args[2:])
+		return
+	}
+
 	log.Println("[INFO] Starting Pandora Core (API RESTful + gRPC + TaskEngine)...")
 
 	cfg := config.LoadConfig()
 
 	log.Printf("[INFO] HTTP, gRPC and TaskEngine config loaded")
 
+	tracingCfg := cfg.TracingConfig()
+	tracer, err := tracing.NewProvider(
+		context.Background(),
+		tracing.ExporterType(tracingCfg.Exporter()),
+		tracingCfg.File(),
+	)
+	if err != nil {
+		log.Fatalf("[ERROR] Failed to initialize tracing: %v", err)
+	}
+	defer tracer.Shutdown(context.Background())
+	log.Printf("[INFO] Tracing initialized (%s)", tracingCfg.Exporter())
+
 	validator := validator.NewValidator()
 	log.Println("[INFO] Validator initialized")
 
+	apiKeyProtector := security.NewAPIKeyProtector([]byte(cfg.APIKeySecret()))
+	log.Println("[INFO] API key protector initialized")
+
+	driver := persistence.DriverType(cfg.DBDriver())
+	if driver == persistence.MemoryDriver {
+		log.Println("[WARNING] Using the memory driver. Data is lost on exit.")
+	}
+
+	if cfg.DBAutoMigrate() && driver == persistence.PostgresDriver {
+		err := migrations.Up(context.Background(), cfg.DBDNS(), migrations.SetPandora)
+		if err != nil {
+			log.Fatalf("[ERROR] Failed to migrate the database: %v", err)
+		}
+
+		err = migrations.Up(
+			context.Background(),
+			cfg.TaskEngineConfig().DBDNS(),
+			migrations.SetTaskEngine,
+		)
+		if err != nil {
+			log.Fatalf("[ERROR] Failed to migrate the TaskEngine database: %v", err)
+		}
+		log.Println("[INFO] Databases migrated")
+	}
+
 	repositories := persistence.NewRepositories(
-		persistence.PostgresDriver, cfg.DBDNS(),
+		driver, cfg.DBDNS(), apiKeyProtector,
 	)
-	log.Println("[INFO] Repositories initialized")
+	log.Printf("[INFO] Repositories initialized (%s)", driver)
 
 	jwtProvider := security.NewJWTProvider([]byte(cfg.HTTPConfig().JWTSecret()))
 	log.Println("[INFO] JWT provider initialized")
 
-	credentialsRepo := security.NewCredentialsRepository(cfg.HTTPConfig().CredentialsFile())
-	log.Println("[INFO] Credentials repository initialized")
+	seeded, seedErr := adminuser.NewSeedOwnerUseCase(
+		repositories.Credentials(),
+		security.NewLegacyCredentialsLoader(cfg.HTTPConfig().CredentialsFile()),
+	).Execute(context.Background())
+	if seedErr != nil {
+		log.Fatalf("[ERROR] Failed to seed the first admin user: %v", seedErr)
+	}
+	if seeded != nil && seeded.Imported {
+		log.Printf("[INFO] Admin user %s imported from credentials file as owner", seeded.Username)
+	} else if seeded != nil {
+		log.Println("[WARNING] No admin users were found, generating default credentials.")
+		log.Println("[INFO] Default admin credentials:")
+		log.Printf("        - Username: %s\n", seeded.Username)
+		log.Printf("        - Password: %s\n", seeded.Password)
+		log.Println("[SECURITY] It is highly recommended to change the default password immediately.")
+	}
+
+	rateLimiter := ratelimit.NewRateLimiter(
+		cfg.GRPCConfig().RateLimitBackend(), repositories,
+	)
+	log.Printf("[INFO] Rate limiter initialized (%s)", cfg.GRPCConfig().RateLimitBackend())
 
-	gRPCDeps := grpcBootstrap.NewDependencies(validator, repositories)
+	// Reads of the memory driver never leave the process, and it does not
+	// notify changes, so only the postgres driver is cached.
+	gRPCRepositories := repositories
+	if cfg.GRPCConfig().CacheTTL() > 0 && driver == persistence.PostgresDriver {
+		validationCache := cache.New(cfg.GRPCConfig().CacheTTL(), cfg.GRPCConfig().CacheSize())
+		go cache.Listen(context.Background(), cfg.DBDNS(), validationCache)
+
+		gRPCRepositories = cache.NewRepositories(repositories, validationCache)
+		log.Printf("[INFO] Validation cache initialized (%s)", cfg.GRPCConfig().CacheTTL())
+	}
+
+	// Validations that queue their requests hand them to requestLog, which
+	// is drained before exiting.
+	var requestLog *requestlog.Writer
+	if cfg.GRPCConfig().RequestLogMode() == "async" {
+		requestLog = requestlog.NewWriter(
+			repositories.Request(),
+			cfg.GRPCConfig().RequestLogQueueSize(),
+			cfg.GRPCConfig().RequestLogFlushSize(),
+			cfg.GRPCConfig().RequestLogFlushInterval(),
+			cfg.GRPCConfig().RequestLogMaxWait(),
+		)
+
+		gRPCRepositories = requestlog.NewRepositories(gRPCRepositories, requestLog)
+		log.Printf(
+			"[INFO] Asynchronous request log initialized (%d requests or %s)",
+			cfg.GRPCConfig().RequestLogFlushSize(), cfg.GRPCConfig().RequestLogFlushInterval(),
+		)
+	}
+
+	gRPCDeps := grpcBootstrap.NewDependencies(
+		validator,
+		gRPCRepositories,
+		rateLimiter,
+		cfg.GRPCConfig().ReservationTTL(),
+	)
 
 	grpcSrv := grpc.NewServer(
 		fmt.Sprintf(":%s", cfg.GRPCConfig().Port()),
@@ -54,7 +165,8 @@ func main() {
 		validator,
 		repositories,
 		jwtProvider,
-		credentialsRepo,
+		cfg.HTTPConfig().APIKeyPrefix(),
+		cfg.HTTPConfig().APIKeyMaxGracePeriod(),
 	)
 
 	httpSrv := http.NewServer(
@@ -63,23 +175,165 @@ func main() {
 		httpDeps,
 	)
 
-	taskEngineDeps := taskengineBootstrap.NewDependencies(repositories)
+	requestArchiver := archive.NewRequestArchiver(
+		cfg.TaskEngineConfig().RequestArchive(),
+		cfg.TaskEngineConfig().RequestArchiveDir(),
+	)
+	log.Printf("[INFO] Request archiver initialized (%s)", cfg.TaskEngineConfig().RequestArchive())
 
-	taskEngine, err := taskengine.NewEngine(
-		cfg.TaskEngineConfig().DBDNS(), taskEngineDeps,
+	taskEngineDeps := taskengineBootstrap.NewDependencies(
+		repositories,
+		requestArchiver,
+		cfg.TaskEngineConfig().RequestRetentionDays(),
+		webhook.NewHTTPSender(),
 	)
+
+	var taskEngine *taskengine.Engine
+	if driver == persistence.MemoryDriver {
+		taskEngine, err = taskengine.NewMemoryEngine(taskEngineDeps)
+	} else {
+		taskEngine, err = taskengine.NewEngine(
+			cfg.TaskEngineConfig().DBDNS(), taskEngineDeps,
+		)
+	}
 	if err != nil {
 		log.Fatalf("[ERROR] Failed to create TaskEngine: %v", err)
 	}
 
+	metricsSrv := metrics.NewServer(fmt.Sprintf(":%s", cfg.MetricsPort()))
+
+	ctx, stop := signal.NotifyContext(
+		context.Background(), os.Interrupt, syscall.SIGTERM,
+	)
+	defer stop()
+
 	var g errgroup.Group
 
 	g.Go(grpcSrv.Run)
 	g.Go(httpSrv.Run)
 	g.Go(taskEngine.Run)
+	g.Go(metricsSrv.Run)
 
-	if err := g.Wait(); err != nil {
-		log.Fatalf("[FATAL] One of the services failed: %v", err)
-		os.Exit(1)
+	failed := make(chan error, 1)
+	go func() { failed <- g.Wait() }()
+
+	select {
+	case err := <-failed:
+		if err != nil {
+			log.Fatalf("[FATAL] One of the services failed: %v", err)
+			os.Exit(1)
+		}
+	case <-ctx.Done():
+		shutdown(grpcSrv, requestLog)
 	}
 }
+
+// shutdownTimeout bounds how long the gRPC calls in flight and the queued
+// requests get to finish on exit.
+const shutdownTimeout = 30 * time.Second
+
+// shutdown stops the gRPC server once its calls in flight finish, then
+// writes the requests requestLog still holds, if any. The other servers
+// are left to exit with the process.
+func shutdown(srv *grpc.Server, requestLog *requestlog.Writer) {
+	log.Println("[INFO] Shutting down...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	srv.Shutdown(ctx)
+	if requestLog != nil {
+		if err := requestLog.Close(ctx); err != nil {
+			log.Printf("[ERROR] Failed to write the queued requests: %v", err)
+		}
+	}
+	log.Println("[INFO] Pandora Core stopped")
+}
+
+const migrateUsage = `Usage: pandora-core migrate [-taskengine] <command>
+
+Commands:
+  up            Apply every pending migration
+  down          Revert the last applied migration
+  status        List the migrations and when they were applied
+  to <version>  Apply or revert migrations until <version> is the last
+                applied one, 0 reverts them all
+
+Flags:
+`
+
+// migrate runs the migrate subcommand on the Pandora database, or on the
+// TaskEngine database with -taskengine.
+func migrate(cfg *config.MigrationConfig, args []string) {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	taskEngine := flags.Bool(
+		"taskengine", false, "migrate the TaskEngine database instead of the Pandora one",
+	)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), migrateUsage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	command, version := flags.Arg(0), 0
+	switch command {
+	case "up", "down", "status":
+	case "to":
+		var err error
+		version, err = strconv.Atoi(flags.Arg(1))
+		if err != nil || version < 0 {
+			flags.Usage()
+			os.Exit(2)
+		}
+	default:
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	set, dns := migrations.SetPandora, cfg.DBDNS()
+	if *taskEngine {
+		set, dns = migrations.SetTaskEngine, cfg.TaskEngineDBDNS()
+	}
+
+	ctx := context.Background()
+
+	migrator, err := migrations.NewMigrator(ctx, dns, set)
+	if err != nil {
+		log.Fatalf("[ERROR] %v", err)
+	}
+	defer migrator.Close(ctx)
+
+	switch command {
+	case "up":
+		err = migrator.Up(ctx)
+	case "down":
+		err = migrator.Down(ctx)
+	case "to":
+		err = migrator.To(ctx, version)
+	case "status":
+		err = printMigrationStatus(ctx, migrator)
+	}
+
+	if err != nil {
+		log.Fatalf("[ERROR] %v", err)
+	}
+}
+
+func printMigrationStatus(ctx context.Context, migrator *migrations.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+	for _, status := range statuses {
+		appliedAt := "pending"
+		if status.Applied() {
+			appliedAt = status.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+	}
+
+	return w.Flush()
+}
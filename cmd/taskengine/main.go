@@ -1,12 +1,22 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"time"
 
+	"golang.org/x/sync/errgroup"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/archive"
+	"github.com/MAD-py/pandora-core/internal/adapters/metrics"
 	"github.com/MAD-py/pandora-core/internal/adapters/persistence"
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence/migrations"
+	"github.com/MAD-py/pandora-core/internal/adapters/security"
 	"github.com/MAD-py/pandora-core/internal/adapters/taskengine"
 	"github.com/MAD-py/pandora-core/internal/adapters/taskengine/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/tracing"
+	"github.com/MAD-py/pandora-core/internal/adapters/webhook"
 	"github.com/MAD-py/pandora-core/internal/config"
 )
 
@@ -18,19 +28,76 @@ func main() {
 	cfg := config.LoadTaskEngineConfig()
 	log.Printf("[INFO] TaskEngine config loaded")
 
+	tracingCfg := cfg.TracingConfig()
+	tracer, err := tracing.NewProvider(
+		context.Background(),
+		tracing.ExporterType(tracingCfg.Exporter()),
+		tracingCfg.File(),
+	)
+	if err != nil {
+		log.Fatalf("[ERROR] Failed to initialize tracing: %v", err)
+	}
+	defer tracer.Shutdown(context.Background())
+	log.Printf("[INFO] Tracing initialized (%s)", tracingCfg.Exporter())
+
+	apiKeyProtector := security.NewAPIKeyProtector([]byte(cfg.APIKeySecret()))
+	log.Println("[INFO] API key protector initialized")
+
+	driver := persistence.DriverType(cfg.DBDriver())
+	if driver == persistence.MemoryDriver {
+		log.Println("[WARNING] Using the memory driver. Data is lost on exit and not shared with the other Pandora processes.")
+	}
+
+	if cfg.DBAutoMigrate() && driver == persistence.PostgresDriver {
+		err := migrations.Up(context.Background(), cfg.DBDNS(), migrations.SetPandora)
+		if err != nil {
+			log.Fatalf("[ERROR] Failed to migrate the database: %v", err)
+		}
+
+		err = migrations.Up(context.Background(), cfg.DBDNS(), migrations.SetTaskEngine)
+		if err != nil {
+			log.Fatalf("[ERROR] Failed to migrate the TaskEngine database: %v", err)
+		}
+		log.Println("[INFO] Databases migrated")
+	}
+
 	repositories := persistence.NewRepositories(
-		persistence.PostgresDriver, cfg.DBDNS(),
+		driver, cfg.DBDNS(), apiKeyProtector,
+	)
+	log.Printf("[INFO] Repositories initialized (%s)", driver)
+
+	requestArchiver := archive.NewRequestArchiver(
+		cfg.RequestArchive(), cfg.RequestArchiveDir(),
 	)
-	log.Println("[INFO] Repositories initialized")
+	log.Printf("[INFO] Request archiver initialized (%s)", cfg.RequestArchive())
 
-	taskEngineDeps := bootstrap.NewDependencies(repositories)
+	taskEngineDeps := bootstrap.NewDependencies(
+		repositories,
+		requestArchiver,
+		cfg.RequestRetentionDays(),
+		webhook.NewHTTPSender(),
+	)
 	log.Println("[INFO] TaskEngine dependencies initialized")
 
-	engine, err := taskengine.NewEngine(cfg.DBDNS(), taskEngineDeps)
+	var engine *taskengine.Engine
+	if driver == persistence.MemoryDriver {
+		engine, err = taskengine.NewMemoryEngine(taskEngineDeps)
+	} else {
+		engine, err = taskengine.NewEngine(cfg.DBDNS(), taskEngineDeps)
+	}
 	if err != nil {
 		log.Fatalf("[ERROR] Failed to create TaskEngine: %v", err)
 	}
 	log.Println("[INFO] TaskEngine created successfully")
 
-	engine.Run()
+	metricsSrv := metrics.NewServer(fmt.Sprintf(":%s", cfg.MetricsPort()))
+
+	var g errgroup.Group
+
+	g.Go(engine.Run)
+	g.Go(metricsSrv.Run)
+
+	if err := g.Wait(); err != nil {
+		log.Fatalf("[FATAL] One of the services failed: %v", err)
+	}
 }
@@ -0,0 +1,8 @@
+// Package db embeds the schema migrations of the Pandora and TaskEngine
+// databases, so the binary can apply them without the SQL files at hand.
+package db
+
+import "embed"
+
+//go:embed migrations
+var Migrations embed.FS
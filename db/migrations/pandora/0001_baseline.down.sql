@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS reservation;
+DROP TABLE IF EXISTS request;
+
+DROP TABLE IF EXISTS environment_service;
+DROP TABLE IF EXISTS project_service;
+DROP TABLE IF EXISTS api_key;
+DROP TABLE IF EXISTS environment;
+DROP TABLE IF EXISTS project;
+DROP TABLE IF EXISTS client;
+DROP TABLE IF EXISTS service;
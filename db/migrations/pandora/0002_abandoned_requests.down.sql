@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS idx_reservation_expires_at;
+
+-- Abandoned requests already logged are kept, the constraint only applies
+-- to new ones.
+ALTER TABLE request DROP CONSTRAINT IF EXISTS request_execution_status_check;
+ALTER TABLE request ADD CONSTRAINT request_execution_status_check
+    CHECK (
+        execution_status IN (
+            'success',
+            'forwarded',
+            'unauthorized',
+            'client_error',
+            'server_error'
+        )
+    ) NOT VALID;
@@ -0,0 +1,25 @@
+-- Requests whose reservation expired before being committed are logged as
+-- abandoned.
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_constraint
+        WHERE conname = 'request_execution_status_check'
+            AND pg_get_constraintdef(oid) LIKE '%abandoned%'
+    ) THEN
+        ALTER TABLE request DROP CONSTRAINT IF EXISTS request_execution_status_check;
+        ALTER TABLE request ADD CONSTRAINT request_execution_status_check
+            CHECK (
+                execution_status IN (
+                    'success',
+                    'forwarded',
+                    'unauthorized',
+                    'client_error',
+                    'server_error',
+                    'abandoned'
+                )
+            );
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_reservation_expires_at ON reservation(expires_at);
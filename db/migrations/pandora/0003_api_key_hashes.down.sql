@@ -0,0 +1,12 @@
+-- Keys stored only as a hash cannot be turned back into plaintext, so this
+-- fails until they are deleted. The summarized keys of the request log stay
+-- as they are.
+DROP INDEX IF EXISTS idx_api_key_key_prefix;
+DROP INDEX IF EXISTS idx_api_key_key_hash;
+
+ALTER TABLE api_key DROP CONSTRAINT IF EXISTS api_key_key_or_hash_check;
+ALTER TABLE api_key ALTER COLUMN key SET NOT NULL;
+
+ALTER TABLE api_key DROP COLUMN IF EXISTS encrypted_key;
+ALTER TABLE api_key DROP COLUMN IF EXISTS key_prefix;
+ALTER TABLE api_key DROP COLUMN IF EXISTS key_hash;
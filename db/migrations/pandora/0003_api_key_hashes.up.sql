@@ -0,0 +1,27 @@
+-- API keys are stored as keyed hashes, with an encrypted copy for the reveal
+-- endpoint. Plaintext keys are only kept for rows created by earlier versions
+-- until the TaskEngine replaces them with their hash.
+ALTER TABLE api_key ADD COLUMN IF NOT EXISTS key_hash TEXT;
+ALTER TABLE api_key ADD COLUMN IF NOT EXISTS key_prefix TEXT;
+ALTER TABLE api_key ADD COLUMN IF NOT EXISTS encrypted_key TEXT;
+ALTER TABLE api_key ALTER COLUMN key DROP NOT NULL;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_constraint WHERE conname = 'api_key_key_or_hash_check'
+    ) THEN
+        ALTER TABLE api_key ADD CONSTRAINT api_key_key_or_hash_check
+            CHECK (key IS NOT NULL OR key_hash IS NOT NULL);
+    END IF;
+END $$;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_key_key_hash ON api_key(key_hash);
+CREATE INDEX IF NOT EXISTS idx_api_key_key_prefix ON api_key(key_prefix);
+
+-- The request log only keeps a summary of the key used.
+UPDATE request SET api_key = left(api_key, 8) || '...'
+WHERE api_key NOT LIKE '%...';
+
+UPDATE reservation SET api_key = left(api_key, 8) || '...'
+WHERE api_key NOT LIKE '%...';
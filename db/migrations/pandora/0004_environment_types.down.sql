@@ -0,0 +1 @@
+ALTER TABLE environment DROP COLUMN IF EXISTS type;
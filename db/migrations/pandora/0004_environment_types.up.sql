@@ -0,0 +1,13 @@
+-- Environments are live or test, which shows in the keys generated for them.
+-- Existing environments are treated as live.
+ALTER TABLE environment ADD COLUMN IF NOT EXISTS type TEXT NOT NULL DEFAULT 'live';
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_constraint WHERE conname = 'environment_type_check'
+    ) THEN
+        ALTER TABLE environment ADD CONSTRAINT environment_type_check
+            CHECK (type IN ('live', 'test'));
+    END IF;
+END $$;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_api_key_grace_ends_at;
+DROP INDEX IF EXISTS idx_api_key_rotated_from_id;
+
+ALTER TABLE api_key DROP COLUMN IF EXISTS grace_ends_at;
+ALTER TABLE api_key DROP COLUMN IF EXISTS rotated_from_id;
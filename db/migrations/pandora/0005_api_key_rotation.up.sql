@@ -0,0 +1,10 @@
+-- A rotated key points to the key that replaced it through the successor's
+-- rotated_from_id, and stays valid until grace_ends_at.
+ALTER TABLE api_key ADD COLUMN IF NOT EXISTS rotated_from_id INTEGER
+    CONSTRAINT api_key_rotated_from_id_fk
+        REFERENCES api_key(id) ON DELETE SET NULL;
+ALTER TABLE api_key ADD COLUMN IF NOT EXISTS grace_ends_at TIMESTAMPTZ;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_key_rotated_from_id ON api_key(rotated_from_id);
+CREATE INDEX IF NOT EXISTS idx_api_key_grace_ends_at ON api_key(grace_ends_at)
+WHERE grace_ends_at IS NOT NULL AND status = 'enabled';
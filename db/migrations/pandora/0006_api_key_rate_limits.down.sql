@@ -0,0 +1,24 @@
+DROP TABLE IF EXISTS rate_limit;
+
+-- Rate limited requests already logged are kept, the constraint only
+-- applies to new ones.
+ALTER TABLE request DROP CONSTRAINT IF EXISTS request_unauthorized_reason_check;
+ALTER TABLE request ADD CONSTRAINT request_unauthorized_reason_check
+    CHECK (
+        unauthorized_reason IN (
+            'API_KEY_INVALID',
+            'QUOTA_EXCEEDED',
+            'API_KEY_EXPIRED',
+            'API_KEY_DISABLED',
+            'SERVICE_MISMATCH',
+            'SERVICE_DISABLED',
+            'SERVICE_DEPRECATED',
+            'SERVICE_NOT_ASSIGNED',
+            'ENVIRONMENT_DISABLED'
+        )
+    ) NOT VALID;
+
+ALTER TABLE api_key DROP CONSTRAINT IF EXISTS api_key_rate_limit_check;
+ALTER TABLE api_key DROP COLUMN IF EXISTS rate_limit_burst;
+ALTER TABLE api_key DROP COLUMN IF EXISTS rate_limit_period;
+ALTER TABLE api_key DROP COLUMN IF EXISTS rate_limit_requests;
@@ -0,0 +1,50 @@
+ALTER TABLE api_key ADD COLUMN IF NOT EXISTS rate_limit_requests INTEGER;
+ALTER TABLE api_key ADD COLUMN IF NOT EXISTS rate_limit_period TEXT;
+ALTER TABLE api_key ADD COLUMN IF NOT EXISTS rate_limit_burst INTEGER;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_constraint WHERE conname = 'api_key_rate_limit_check'
+    ) THEN
+        ALTER TABLE api_key ADD CONSTRAINT api_key_rate_limit_check
+            CHECK (
+                rate_limit_requests IS NULL
+                OR (
+                    rate_limit_requests > 0
+                    AND rate_limit_period IN ('second', 'minute')
+                    AND rate_limit_burst >= 0
+                )
+            );
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_constraint
+        WHERE conname = 'request_unauthorized_reason_check'
+            AND pg_get_constraintdef(oid) LIKE '%RATE_LIMITED%'
+    ) THEN
+        ALTER TABLE request DROP CONSTRAINT IF EXISTS request_unauthorized_reason_check;
+        ALTER TABLE request ADD CONSTRAINT request_unauthorized_reason_check
+            CHECK (
+                unauthorized_reason IN (
+                    'API_KEY_INVALID',
+                    'QUOTA_EXCEEDED',
+                    'API_KEY_EXPIRED',
+                    'API_KEY_DISABLED',
+                    'SERVICE_MISMATCH',
+                    'SERVICE_DISABLED',
+                    'SERVICE_DEPRECATED',
+                    'SERVICE_NOT_ASSIGNED',
+                    'ENVIRONMENT_DISABLED',
+                    'RATE_LIMITED'
+                )
+            );
+    END IF;
+END $$;
+
+-- Theoretical arrival times of the shared rate limiter, used when
+-- PANDORA_RATE_LIMIT_BACKEND is postgres.
+CREATE UNLOGGED TABLE IF NOT EXISTS rate_limit(
+    key TEXT PRIMARY KEY,
+    tat TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1 @@
+ALTER TABLE project_service DROP COLUMN IF EXISTS available_request;
@@ -0,0 +1,18 @@
+-- Requests consume the quota of the project service as well as the one of
+-- the environment service. Existing projects start the period with a full
+-- pool.
+ALTER TABLE project_service ADD COLUMN IF NOT EXISTS available_request INTEGER;
+UPDATE project_service SET available_request = max_requests
+WHERE available_request IS NULL;
+ALTER TABLE project_service ALTER COLUMN available_request SET NOT NULL;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_constraint
+        WHERE conname = 'project_service_available_request_check'
+    ) THEN
+        ALTER TABLE project_service ADD CONSTRAINT project_service_available_request_check
+            CHECK (available_request <= max_requests);
+    END IF;
+END $$;
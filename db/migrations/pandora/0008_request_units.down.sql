@@ -0,0 +1,2 @@
+ALTER TABLE reservation DROP COLUMN IF EXISTS units;
+ALTER TABLE request DROP COLUMN IF EXISTS units;
@@ -0,0 +1,6 @@
+-- Requests can consume several units of quota. Requests logged before then
+-- were not charged in units.
+ALTER TABLE request ADD COLUMN IF NOT EXISTS units INTEGER NOT NULL DEFAULT 0
+    CONSTRAINT request_units_check CHECK (units >= 0);
+ALTER TABLE reservation ADD COLUMN IF NOT EXISTS units INTEGER NOT NULL DEFAULT 1
+    CONSTRAINT reservation_units_check CHECK (units > 0);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_request_request_time;
+
+DROP TABLE IF EXISTS request_usage_rollup;
+DROP TABLE IF EXISTS request_usage_daily;
+DROP TABLE IF EXISTS request_usage_hourly;
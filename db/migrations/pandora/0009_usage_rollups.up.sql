@@ -0,0 +1,56 @@
+-- Request counts rolled up by hour and by day for the usage analytics. The
+-- TaskEngine refreshes the buckets of newly logged requests. Dimensions a
+-- request lacks are stored as 0 or '' so they can be part of the key, and no
+-- foreign keys are kept so usage outlives deleted entities.
+CREATE TABLE IF NOT EXISTS request_usage_hourly(
+    bucket TIMESTAMPTZ NOT NULL,
+    client_id INTEGER NOT NULL,
+    project_id INTEGER NOT NULL,
+    environment_id INTEGER NOT NULL,
+    service_id INTEGER NOT NULL,
+    api_key_id INTEGER NOT NULL,
+    execution_status TEXT NOT NULL,
+    unauthorized_reason TEXT NOT NULL,
+
+    requests BIGINT NOT NULL,
+    units BIGINT NOT NULL,
+
+    PRIMARY KEY (
+        bucket, client_id, project_id, environment_id, service_id,
+        api_key_id, execution_status, unauthorized_reason
+    )
+);
+
+CREATE TABLE IF NOT EXISTS request_usage_daily(
+    bucket TIMESTAMPTZ NOT NULL,
+    client_id INTEGER NOT NULL,
+    project_id INTEGER NOT NULL,
+    environment_id INTEGER NOT NULL,
+    service_id INTEGER NOT NULL,
+    api_key_id INTEGER NOT NULL,
+    execution_status TEXT NOT NULL,
+    unauthorized_reason TEXT NOT NULL,
+
+    requests BIGINT NOT NULL,
+    units BIGINT NOT NULL,
+
+    PRIMARY KEY (
+        bucket, client_id, project_id, environment_id, service_id,
+        api_key_id, execution_status, unauthorized_reason
+    )
+);
+
+-- Creation time up to which requests have been rolled up, and the hour
+-- before which retention has deleted requests. Hourly buckets before it are
+-- no longer rebuilt from the request log, late requests are added to them.
+CREATE TABLE IF NOT EXISTS request_usage_rollup(
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE,
+    CONSTRAINT request_usage_rollup_single_row_check CHECK (id),
+
+    watermark TIMESTAMPTZ NOT NULL,
+    sealed_before TIMESTAMPTZ
+);
+
+ALTER TABLE request_usage_rollup ADD COLUMN IF NOT EXISTS sealed_before TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_request_request_time ON request (request_time);
@@ -0,0 +1,36 @@
+-- Moves the requests of every partition back into a single table. Chains
+-- split by retention would break the start_point foreign key, which is
+-- therefore only enforced on new requests.
+CREATE TABLE request_unpartitioned (
+    LIKE request INCLUDING DEFAULTS INCLUDING CONSTRAINTS
+);
+
+INSERT INTO request_unpartitioned SELECT * FROM request;
+
+DROP TABLE request;
+ALTER TABLE request_unpartitioned RENAME TO request;
+
+ALTER TABLE request ADD PRIMARY KEY (id);
+ALTER TABLE request ALTER COLUMN created_at DROP NOT NULL;
+
+ALTER TABLE request
+    ADD CONSTRAINT request_start_point_fk
+        FOREIGN KEY (start_point) REFERENCES request(id) ON DELETE CASCADE NOT VALID,
+    ADD CONSTRAINT request_api_key_id_fk
+        FOREIGN KEY (api_key_id) REFERENCES api_key(id) ON DELETE SET NULL,
+    ADD CONSTRAINT request_project_id_fk
+        FOREIGN KEY (project_id) REFERENCES project(id) ON DELETE SET NULL,
+    ADD CONSTRAINT request_environment_id_fk
+        FOREIGN KEY (environment_id) REFERENCES environment(id) ON DELETE SET NULL,
+    ADD CONSTRAINT request_service_id_fk
+        FOREIGN KEY (service_id) REFERENCES service(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_start_point ON request(start_point);
+CREATE INDEX IF NOT EXISTS idx_request_api_key ON request(api_key);
+CREATE INDEX IF NOT EXISTS idx_request_project_id ON request(project_id);
+CREATE INDEX IF NOT EXISTS idx_request_service_id ON request(service_id);
+CREATE INDEX IF NOT EXISTS idx_request_environment_id ON request(environment_id);
+CREATE INDEX IF NOT EXISTS idx_request_created_at_desc ON request (created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_request_request_time ON request (request_time);
+
+ALTER TABLE service DROP COLUMN IF EXISTS request_retention_days;
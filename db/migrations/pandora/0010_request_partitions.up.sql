@@ -0,0 +1,97 @@
+-- Days the service's requests are kept, NULL to follow
+-- PANDORA_REQUEST_RETENTION_DAYS and 0 to keep them forever.
+ALTER TABLE service ADD COLUMN IF NOT EXISTS request_retention_days INTEGER
+    CONSTRAINT service_request_retention_days_check CHECK (request_retention_days >= 0);
+
+-- The request log is partitioned by month of created_at so retention can
+-- drop whole months. Partitions are created ahead by the TaskEngine; rows no
+-- partition covers land in request_default.
+--
+-- The existing table becomes the request_legacy partition, holding every
+-- request logged until the end of the current month, and is emptied by
+-- retention. An empty table is dropped instead. No foreign key can reference
+-- a partitioned table without the partition key, so the one chaining
+-- requests to their start_point is dropped and retention keeps chains whole
+-- instead.
+DO $$
+DECLARE
+    legacy_end TIMESTAMPTZ :=
+        (date_trunc('month', NOW() AT TIME ZONE 'UTC') + INTERVAL '1 month') AT TIME ZONE 'UTC';
+    idx RECORD;
+    index_defs TEXT[] := '{}';
+    index_def TEXT;
+BEGIN
+    IF (SELECT relkind FROM pg_class WHERE oid = 'request'::regclass) <> 'r' THEN
+        RETURN;
+    END IF;
+
+    ALTER TABLE request RENAME TO request_legacy;
+    ALTER TABLE request_legacy DROP CONSTRAINT IF EXISTS request_start_point_fk;
+    ALTER TABLE request_legacy DROP CONSTRAINT request_pkey;
+
+    UPDATE request_legacy SET created_at = request_time WHERE created_at IS NULL;
+    ALTER TABLE request_legacy ALTER COLUMN created_at SET NOT NULL;
+    ALTER TABLE request_legacy ADD PRIMARY KEY (id, created_at);
+
+    -- The indexes are recreated on the partitioned table, which adopts the
+    -- legacy ones on attach.
+    FOR idx IN
+        SELECT indexname, indexdef FROM pg_indexes
+        WHERE schemaname = current_schema()
+            AND tablename = 'request_legacy'
+            AND indexname <> 'request_legacy_pkey'
+    LOOP
+        EXECUTE format('ALTER INDEX %I RENAME TO %I', idx.indexname, idx.indexname || '_legacy');
+        index_defs := array_append(index_defs, idx.indexdef);
+    END LOOP;
+
+    CREATE TABLE request (
+        LIKE request_legacy INCLUDING DEFAULTS INCLUDING CONSTRAINTS,
+        PRIMARY KEY (id, created_at),
+        CONSTRAINT request_api_key_id_fk
+            FOREIGN KEY (api_key_id) REFERENCES api_key(id) ON DELETE SET NULL,
+        CONSTRAINT request_project_id_fk
+            FOREIGN KEY (project_id) REFERENCES project(id) ON DELETE SET NULL,
+        CONSTRAINT request_environment_id_fk
+            FOREIGN KEY (environment_id) REFERENCES environment(id) ON DELETE SET NULL,
+        CONSTRAINT request_service_id_fk
+            FOREIGN KEY (service_id) REFERENCES service(id) ON DELETE CASCADE
+    ) PARTITION BY RANGE (created_at);
+
+    FOREACH index_def IN ARRAY index_defs LOOP
+        EXECUTE regexp_replace(index_def, ' ON (\S+\.)?request_legacy ', ' ON \1request ');
+    END LOOP;
+
+    IF NOT EXISTS (SELECT 1 FROM request_legacy) THEN
+        DROP TABLE request_legacy;
+        RETURN;
+    END IF;
+
+    EXECUTE format(
+        'ALTER TABLE request ATTACH PARTITION request_legacy FOR VALUES FROM (MINVALUE) TO (%L)',
+        legacy_end
+    );
+END $$;
+
+CREATE TABLE IF NOT EXISTS request_default PARTITION OF request DEFAULT;
+
+-- Partitions for the current and next three months. Months another partition
+-- already covers are skipped.
+DO $$
+DECLARE
+    month_start TIMESTAMP;
+BEGIN
+    FOR i IN 0..3 LOOP
+        month_start := date_trunc('month', NOW() AT TIME ZONE 'UTC') + make_interval(months => i);
+        BEGIN
+            EXECUTE format(
+                'CREATE TABLE IF NOT EXISTS %I PARTITION OF request FOR VALUES FROM (%L) TO (%L)',
+                'request_' || to_char(month_start, 'YYYY_MM'),
+                month_start AT TIME ZONE 'UTC',
+                (month_start + INTERVAL '1 month') AT TIME ZONE 'UTC'
+            );
+        EXCEPTION WHEN invalid_object_definition THEN
+            NULL;
+        END;
+    END LOOP;
+END $$;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_api_key_expires_at;
+
+DROP TABLE IF EXISTS webhook_api_key_expiry;
+DROP TABLE IF EXISTS webhook_delivery;
+DROP TABLE IF EXISTS webhook;
@@ -0,0 +1,66 @@
+-- Webhooks subscribe a URL to the events of a client's projects or of a
+-- single project.
+CREATE TABLE IF NOT EXISTS webhook(
+    id SERIAL PRIMARY KEY,
+
+    client_id INTEGER,
+    CONSTRAINT webhook_client_id_fk
+        FOREIGN KEY (client_id) REFERENCES client(id) ON DELETE CASCADE,
+
+    project_id INTEGER,
+    CONSTRAINT webhook_project_id_fk
+        FOREIGN KEY (project_id) REFERENCES project(id) ON DELETE CASCADE,
+
+    CONSTRAINT webhook_owner_check
+        CHECK ((client_id IS NULL) <> (project_id IS NULL)),
+
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    events TEXT[] NOT NULL,
+
+    status TEXT NOT NULL,
+    CONSTRAINT webhook_status_check CHECK (status IN ('enabled', 'disabled')),
+
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Outbox of the events queued for each webhook. The TaskEngine sends the
+-- pending deliveries and keeps the outcome of their last attempt.
+CREATE TABLE IF NOT EXISTS webhook_delivery(
+    id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+
+    webhook_id INTEGER NOT NULL,
+    CONSTRAINT webhook_delivery_webhook_id_fk
+        FOREIGN KEY (webhook_id) REFERENCES webhook(id) ON DELETE CASCADE,
+
+    event TEXT NOT NULL,
+    payload JSONB NOT NULL,
+
+    status TEXT NOT NULL DEFAULT 'pending',
+    CONSTRAINT webhook_delivery_status_check
+        CHECK (status IN ('pending', 'delivered', 'failed')),
+
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_status_code INTEGER,
+    last_error TEXT,
+    delivered_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Expiration time up to which expired API keys have been notified.
+CREATE TABLE IF NOT EXISTS webhook_api_key_expiry(
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE,
+    CONSTRAINT webhook_api_key_expiry_single_row_check CHECK (id),
+
+    watermark TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_client_id ON webhook(client_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_project_id ON webhook(project_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_webhook_id
+    ON webhook_delivery(webhook_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_pending
+    ON webhook_delivery(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_api_key_expires_at ON api_key(expires_at);
@@ -0,0 +1,32 @@
+-- Thresholds, as a percentage of the quota consumed, that trigger a
+-- quota.alert_triggered webhook event. Alerts watch the quota of the
+-- environment service or the quota of its project service, and trigger
+-- once until that quota is reset.
+CREATE TABLE IF NOT EXISTS quota_alert(
+    id SERIAL PRIMARY KEY,
+
+    environment_id INTEGER NOT NULL,
+    service_id INTEGER NOT NULL,
+    CONSTRAINT quota_alert_environment_service_fk
+        FOREIGN KEY (environment_id, service_id)
+        REFERENCES environment_service(environment_id, service_id)
+        ON DELETE CASCADE,
+
+    level TEXT NOT NULL,
+    CONSTRAINT quota_alert_level_check
+        CHECK (level IN ('environment', 'project')),
+
+    threshold INTEGER NOT NULL,
+    CONSTRAINT quota_alert_threshold_check
+        CHECK (threshold BETWEEN 1 AND 100),
+
+    CONSTRAINT quota_alert_unique
+        UNIQUE (environment_id, service_id, level, threshold),
+
+    triggered_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_quota_alert_pending
+    ON quota_alert(environment_id, service_id) WHERE triggered_at IS NULL;
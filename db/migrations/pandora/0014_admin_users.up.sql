@@ -0,0 +1,21 @@
+-- Accounts of the admin API. Each account has a role that decides which
+-- endpoints it may call.
+CREATE TABLE IF NOT EXISTS admin_user(
+    id SERIAL PRIMARY KEY,
+
+    username VARCHAR(255) NOT NULL,
+    CONSTRAINT admin_user_username_unique UNIQUE (username),
+
+    password TEXT NOT NULL,
+
+    role VARCHAR(20) NOT NULL,
+    CONSTRAINT admin_user_role_check
+        CHECK (role IN ('owner', 'operator', 'viewer', 'billing')),
+
+    status VARCHAR(20) NOT NULL DEFAULT 'enabled',
+    CONSTRAINT admin_user_status_check CHECK (status IN ('enabled', 'disabled')),
+
+    force_password_reset BOOLEAN NOT NULL DEFAULT FALSE,
+
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS client_token;
+ALTER TABLE client DROP COLUMN IF EXISTS api_key_limit;
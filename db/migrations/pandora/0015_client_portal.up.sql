@@ -0,0 +1,27 @@
+-- How many usable API keys each environment of the client can hold for the
+-- portal. The default of 0 keeps the portal from creating and rotating keys.
+ALTER TABLE client ADD COLUMN IF NOT EXISTS api_key_limit INTEGER NOT NULL DEFAULT 0;
+
+-- Client tokens give a client access to the portal API. Only a keyed hash
+-- of each token is stored.
+CREATE TABLE IF NOT EXISTS client_token(
+    id SERIAL PRIMARY KEY,
+
+    client_id INTEGER NOT NULL,
+    CONSTRAINT client_token_client_id_fk
+        FOREIGN KEY (client_id) REFERENCES client(id) ON DELETE CASCADE,
+
+    name VARCHAR(255) NOT NULL,
+
+    token_hash TEXT NOT NULL,
+    CONSTRAINT client_token_token_hash_unique UNIQUE (token_hash),
+
+    token_prefix TEXT NOT NULL,
+
+    expires_at TIMESTAMPTZ,
+    last_used TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_client_token_client_id ON client_token(client_id);
@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS api_key_delete_cache_invalidate ON api_key;
+DROP TRIGGER IF EXISTS api_key_cache_invalidate ON api_key;
+DROP TRIGGER IF EXISTS environment_service_cache_invalidate ON environment_service;
+DROP TRIGGER IF EXISTS environment_cache_invalidate ON environment;
+DROP TRIGGER IF EXISTS project_cache_invalidate ON project;
+DROP TRIGGER IF EXISTS client_cache_invalidate ON client;
+DROP TRIGGER IF EXISTS service_cache_invalidate ON service;
+
+DROP FUNCTION IF EXISTS cache_invalidate();
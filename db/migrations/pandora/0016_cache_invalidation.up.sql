@@ -0,0 +1,65 @@
+-- Notifies the gRPC servers caching the API key validation lookups of the
+-- rows they must drop, as "<table>:<id>" on the pandora_cache channel. The
+-- trigger arguments are the table to report and the column holding the id.
+CREATE OR REPLACE FUNCTION cache_invalidate() RETURNS TRIGGER AS $$
+DECLARE
+    changed JSONB;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := to_jsonb(OLD);
+    ELSE
+        changed := to_jsonb(NEW);
+    END IF;
+
+    PERFORM pg_notify(
+        'pandora_cache', TG_ARGV[0] || ':' || (changed ->> TG_ARGV[1])
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS service_cache_invalidate ON service;
+CREATE TRIGGER service_cache_invalidate
+    AFTER UPDATE OR DELETE ON service
+    FOR EACH ROW EXECUTE FUNCTION cache_invalidate('service', 'id');
+
+DROP TRIGGER IF EXISTS client_cache_invalidate ON client;
+CREATE TRIGGER client_cache_invalidate
+    AFTER UPDATE OR DELETE ON client
+    FOR EACH ROW EXECUTE FUNCTION cache_invalidate('client', 'id');
+
+DROP TRIGGER IF EXISTS project_cache_invalidate ON project;
+CREATE TRIGGER project_cache_invalidate
+    AFTER UPDATE OR DELETE ON project
+    FOR EACH ROW EXECUTE FUNCTION cache_invalidate('project', 'id');
+
+DROP TRIGGER IF EXISTS environment_cache_invalidate ON environment;
+CREATE TRIGGER environment_cache_invalidate
+    AFTER UPDATE OR DELETE ON environment
+    FOR EACH ROW EXECUTE FUNCTION cache_invalidate('environment', 'id');
+
+-- Validation only reads which services are assigned, so the available
+-- requests updated on every consumption do not invalidate anything.
+DROP TRIGGER IF EXISTS environment_service_cache_invalidate ON environment_service;
+CREATE TRIGGER environment_service_cache_invalidate
+    AFTER INSERT OR DELETE ON environment_service
+    FOR EACH ROW EXECUTE FUNCTION cache_invalidate('environment', 'environment_id');
+
+-- Neither does last_used, set on every validation.
+DROP TRIGGER IF EXISTS api_key_cache_invalidate ON api_key;
+CREATE TRIGGER api_key_cache_invalidate
+    AFTER UPDATE ON api_key
+    FOR EACH ROW
+    WHEN (
+        (OLD.environment_id, OLD.status, OLD.expires_at, OLD.grace_ends_at,
+         OLD.rate_limit_requests, OLD.rate_limit_period, OLD.rate_limit_burst)
+        IS DISTINCT FROM
+        (NEW.environment_id, NEW.status, NEW.expires_at, NEW.grace_ends_at,
+         NEW.rate_limit_requests, NEW.rate_limit_period, NEW.rate_limit_burst)
+    )
+    EXECUTE FUNCTION cache_invalidate('api_key', 'id');
+
+DROP TRIGGER IF EXISTS api_key_delete_cache_invalidate ON api_key;
+CREATE TRIGGER api_key_delete_cache_invalidate
+    AFTER DELETE ON api_key
+    FOR EACH ROW EXECUTE FUNCTION cache_invalidate('api_key', 'id');
@@ -0,0 +1,24 @@
+-- Stores of the TaskEngine. The engine also creates them on start, so these
+-- definitions must be kept in line with go-taskengine.
+CREATE TABLE IF NOT EXISTS tasks (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    job TEXT NOT NULL,
+    trigger TEXT NOT NULL,
+    policy TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'idle',
+    iteration INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS executions (
+    id SERIAL PRIMARY KEY,
+    task_id INT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    iteration INT NOT NULL,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    duration BIGINT NOT NULL,
+    status TEXT NOT NULL,
+    tick TIMESTAMP NOT NULL,
+    error_msg TEXT
+);
@@ -0,0 +1,21 @@
+package archive
+
+import "github.com/MAD-py/pandora-core/internal/ports"
+
+const (
+	FormatNone   = "none"
+	FormatNDJSON = "ndjson"
+)
+
+// NewRequestArchiver returns the archiver writing expired requests under dir
+// in the format, or nil when requests are deleted without being archived.
+func NewRequestArchiver(format, dir string) ports.RequestArchiver {
+	switch format {
+	case FormatNone:
+		return nil
+	case FormatNDJSON:
+		return NewNDJSONArchiver(dir)
+	default:
+		panic("unsupported request archive format " + format)
+	}
+}
@@ -0,0 +1,160 @@
+package archive
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type archivedRequestMetadata struct {
+	Body            string                       `json:"body"`
+	BodyContentType enums.RequestBodyContentType `json:"body_content_type"`
+	Cookies         string                       `json:"cookies"`
+	Headers         string                       `json:"headers"`
+	QueryParams     string                       `json:"query_params"`
+}
+
+// archivedRequest is a request as written to the archive, one per line.
+type archivedRequest struct {
+	ID                 string                            `json:"id"`
+	StartPoint         string                            `json:"start_point,omitempty"`
+	APIKey             string                            `json:"api_key"`
+	APIKeyID           int                               `json:"api_key_id,omitempty"`
+	ProjectID          int                               `json:"project_id,omitempty"`
+	ProjectName        string                            `json:"project_name,omitempty"`
+	EnvironmentID      int                               `json:"environment_id,omitempty"`
+	EnvironmentName    string                            `json:"environment_name,omitempty"`
+	ServiceID          int                               `json:"service_id,omitempty"`
+	ServiceName        string                            `json:"service_name"`
+	ServiceVersion     string                            `json:"service_version"`
+	Detail             string                            `json:"detail,omitempty"`
+	StatusCode         int                               `json:"status_code,omitempty"`
+	ExecutionStatus    enums.RequestExecutionStatus      `json:"execution_status"`
+	UnauthorizedReason enums.APIKeyValidationFailureCode `json:"unauthorized_reason,omitempty"`
+	Units              int                               `json:"units"`
+	RequestTime        time.Time                         `json:"request_time"`
+	Path               string                            `json:"path"`
+	Method             string                            `json:"method,omitempty"`
+	IPAddress          string                            `json:"ip_address"`
+	Metadata           *archivedRequestMetadata          `json:"metadata,omitempty"`
+	CreatedAt          time.Time                         `json:"created_at"`
+}
+
+func newArchivedRequest(request *entities.Request) *archivedRequest {
+	archived := &archivedRequest{
+		ID:                 request.ID,
+		StartPoint:         request.StartPoint,
+		APIKey:             request.APIKey.Key,
+		APIKeyID:           request.APIKey.ID,
+		ProjectID:          request.Project.ID,
+		ProjectName:        request.Project.Name,
+		EnvironmentID:      request.Environment.ID,
+		EnvironmentName:    request.Environment.Name,
+		ServiceID:          request.Service.ID,
+		ServiceName:        request.Service.Name,
+		ServiceVersion:     request.Service.Version,
+		Detail:             request.Detail,
+		StatusCode:         request.StatusCode,
+		ExecutionStatus:    request.ExecutionStatus,
+		UnauthorizedReason: request.UnauthorizedReason,
+		Units:              request.Units,
+		RequestTime:        request.RequestTime,
+		Path:               request.Path,
+		Method:             request.Method,
+		IPAddress:          request.IPAddress,
+		CreatedAt:          request.CreatedAt,
+	}
+
+	if request.Metadata != nil {
+		archived.Metadata = &archivedRequestMetadata{
+			Body:            request.Metadata.Body,
+			BodyContentType: request.Metadata.BodyContentType,
+			Cookies:         request.Metadata.Cookies,
+			Headers:         request.Metadata.Headers,
+			QueryParams:     request.Metadata.QueryParams,
+		}
+	}
+
+	return archived
+}
+
+// NDJSONArchiver writes each batch of requests to its own gzip compressed
+// NDJSON file, named after the first request of the batch so archiving the
+// batch again replaces the file.
+type NDJSONArchiver struct {
+	dir string
+}
+
+func (a *NDJSONArchiver) Archive(
+	ctx context.Context, requests []*entities.Request,
+) errors.Error {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	if err := os.MkdirAll(a.dir, 0750); err != nil {
+		return errors.NewInternal("failed to create the archive directory", err)
+	}
+
+	first := requests[0]
+	name := filepath.Join(
+		a.dir,
+		fmt.Sprintf(
+			"requests-%s-%s.ndjson.gz",
+			first.CreatedAt.UTC().Format("20060102T150405.000000Z"),
+			first.ID,
+		),
+	)
+
+	if err := a.write(name, requests); err != nil {
+		return errors.NewInternal("failed to archive requests", err)
+	}
+	return nil
+}
+
+// write writes the file next to its final name and renames it once it is
+// complete, so a partial file is never left behind under that name.
+func (a *NDJSONArchiver) write(
+	name string, requests []*entities.Request,
+) error {
+	file, err := os.CreateTemp(a.dir, ".requests-*.tmp")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	writer := gzip.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, request := range requests {
+		if err := encoder.Encode(newArchivedRequest(request)); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), name)
+}
+
+func NewNDJSONArchiver(dir string) *NDJSONArchiver {
+	return &NDJSONArchiver{dir: dir}
+}
@@ -0,0 +1,98 @@
+// Package cache keeps the entities API key validation looks up on every
+// request in process memory, so validating a key does not take a database
+// round-trip per lookup.
+package cache
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+)
+
+type serviceKey struct {
+	name    string
+	version string
+}
+
+type lookupKey struct {
+	key            string
+	serviceName    string
+	serviceVersion string
+}
+
+// Cache holds the lookups of the validation use cases, each in its own
+// store bounded to size entries. Entries live for ttl at most and are
+// dropped earlier when Invalidate reports a change to the rows they were
+// built from.
+type Cache struct {
+	services     *store[serviceKey, *entities.Service]
+	apiKeys      *store[string, *entities.APIKey]
+	environments *store[int, *entities.Environment]
+	projects     *store[int, *dto.ProjectClientInfoResponse]
+	lookups      *store[lookupKey, *dto.APIKeyValidationLookup]
+}
+
+// Invalidate drops the entries built from the row of the table with the
+// id. Tables no entry is built from are ignored.
+func (c *Cache) Invalidate(table string, id int) {
+	switch table {
+	case "service":
+		c.services.removeFunc(
+			func(s *entities.Service) bool { return s.ID == id },
+		)
+	case "api_key":
+		c.apiKeys.removeFunc(
+			func(k *entities.APIKey) bool { return k.ID == id },
+		)
+	case "environment":
+		c.environments.remove(id)
+	case "project":
+		c.projects.remove(id)
+	case "client":
+		c.projects.removeFunc(
+			func(p *dto.ProjectClientInfoResponse) bool { return p.ClientID == id },
+		)
+	}
+
+	c.lookups.removeFunc(func(l *dto.APIKeyValidationLookup) bool {
+		return builtFrom(l, table, id)
+	})
+}
+
+// builtFrom reports whether the lookup was built from the row of the table
+// with the id. Only complete lookups are cached.
+func builtFrom(l *dto.APIKeyValidationLookup, table string, id int) bool {
+	switch table {
+	case "service":
+		return l.Service.ID == id
+	case "api_key":
+		return l.APIKey.ID == id
+	case "environment":
+		return l.Environment.ID == id
+	case "project":
+		return l.ProjectClient.ProjectID == id
+	case "client":
+		return l.ProjectClient.ClientID == id
+	}
+	return false
+}
+
+// Purge drops every entry, for when changes may have gone unreported.
+func (c *Cache) Purge() {
+	c.services.purge()
+	c.apiKeys.purge()
+	c.environments.purge()
+	c.projects.purge()
+	c.lookups.purge()
+}
+
+func New(ttl time.Duration, size int) *Cache {
+	return &Cache{
+		services:     newStore[serviceKey, *entities.Service](ttl, size),
+		apiKeys:      newStore[string, *entities.APIKey](ttl, size),
+		environments: newStore[int, *entities.Environment](ttl, size),
+		projects:     newStore[int, *dto.ProjectClientInfoResponse](ttl, size),
+		lookups:      newStore[lookupKey, *dto.APIKeyValidationLookup](ttl, size),
+	}
+}
@@ -0,0 +1,288 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+// countingServiceRepository serves the services it holds, counting the
+// lookups that reach it.
+type countingServiceRepository struct {
+	ports.ServiceRepository
+
+	services map[string]*entities.Service
+	lookups  int
+
+	// onLookup runs before the lookup returns, to change the data under it.
+	onLookup func()
+}
+
+func (r *countingServiceRepository) GetByNameAndVersion(
+	ctx context.Context, name, version string,
+) (*entities.Service, errors.Error) {
+	r.lookups++
+	if r.onLookup != nil {
+		r.onLookup()
+	}
+
+	service, ok := r.services[name+"@"+version]
+	if !ok {
+		return nil, errors.NewNotFound("Service not found", nil)
+	}
+
+	c := *service
+	return &c, nil
+}
+
+type countingProjectRepository struct {
+	ports.ProjectRepository
+
+	lookups int
+}
+
+func (r *countingProjectRepository) GetProjectClientInfoByID(
+	ctx context.Context, id int,
+) (*dto.ProjectClientInfoResponse, errors.Error) {
+	r.lookups++
+	return &dto.ProjectClientInfoResponse{
+		ProjectID: id, ProjectName: "project", ClientID: 10 * id, ClientName: "client",
+	}, nil
+}
+
+// countingValidationRepository looks up the key "known" alone, counting
+// the lookups that reach it.
+type countingValidationRepository struct {
+	ports.ValidationRepository
+
+	lookups int
+}
+
+func (r *countingValidationRepository) Lookup(
+	ctx context.Context, key, serviceName, serviceVersion string,
+) (*dto.APIKeyValidationLookup, errors.Error) {
+	r.lookups++
+
+	lookup := &dto.APIKeyValidationLookup{
+		Service: &entities.Service{ID: 1, Name: serviceName, Version: serviceVersion},
+	}
+	if key != "known" {
+		return lookup, nil
+	}
+
+	lookup.APIKey = &entities.APIKey{ID: 2, EnvironmentID: 3}
+	lookup.Environment = &entities.Environment{ID: 3, ProjectID: 4}
+	lookup.ProjectClient = &dto.ProjectClientInfoResponse{ProjectID: 4, ClientID: 5}
+	lookup.ServiceAssigned = true
+	return lookup, nil
+}
+
+type CacheSuite struct {
+	suite.Suite
+
+	cache *Cache
+
+	serviceRepo    *countingServiceRepository
+	projectRepo    *countingProjectRepository
+	validationRepo *countingValidationRepository
+
+	services    ports.ServiceRepository
+	projects    ports.ProjectRepository
+	validations ports.ValidationRepository
+
+	ctx context.Context
+}
+
+func (s *CacheSuite) SetupTest() {
+	s.cache = New(time.Minute, 2)
+
+	s.serviceRepo = &countingServiceRepository{
+		services: map[string]*entities.Service{
+			"billing@v1": {ID: 1, Name: "billing", Version: "v1", Status: enums.ServiceStatusEnabled},
+			"billing@v2": {ID: 2, Name: "billing", Version: "v2", Status: enums.ServiceStatusEnabled},
+			"search@v1":  {ID: 3, Name: "search", Version: "v1", Status: enums.ServiceStatusEnabled},
+		},
+	}
+	s.projectRepo = &countingProjectRepository{}
+	s.validationRepo = &countingValidationRepository{}
+
+	s.services = &serviceRepository{ServiceRepository: s.serviceRepo, cache: s.cache}
+	s.projects = &projectRepository{ProjectRepository: s.projectRepo, cache: s.cache}
+	s.validations = &validationRepository{
+		ValidationRepository: s.validationRepo, cache: s.cache,
+	}
+
+	s.ctx = context.Background()
+}
+
+func (s *CacheSuite) getService(name, version string) *entities.Service {
+	service, err := s.services.GetByNameAndVersion(s.ctx, name, version)
+	s.Require().Nil(err)
+	return service
+}
+
+func (s *CacheSuite) TestReadThrough() {
+	first := s.getService("billing", "v1")
+	second := s.getService("billing", "v1")
+
+	s.Equal(1, s.serviceRepo.lookups)
+	s.Equal(first, second)
+}
+
+func (s *CacheSuite) TestCallersDoNotShareEntries() {
+	s.getService("billing", "v1").Status = enums.ServiceStatusDisabled
+
+	s.Equal(enums.ServiceStatusEnabled, s.getService("billing", "v1").Status)
+}
+
+func (s *CacheSuite) TestErrorsAreNotCached() {
+	for range 2 {
+		_, err := s.services.GetByNameAndVersion(s.ctx, "billing", "v3")
+		s.Require().NotNil(err)
+		s.Equal(errors.CodeNotFound, err.Code())
+	}
+
+	s.Equal(2, s.serviceRepo.lookups)
+}
+
+func (s *CacheSuite) TestInvalidate() {
+	s.getService("billing", "v1")
+	s.getService("billing", "v2")
+
+	s.serviceRepo.services["billing@v1"].Status = enums.ServiceStatusDisabled
+	s.cache.Invalidate("service", 1)
+
+	s.Equal(enums.ServiceStatusDisabled, s.getService("billing", "v1").Status)
+	s.getService("billing", "v2")
+	s.Equal(3, s.serviceRepo.lookups)
+}
+
+func (s *CacheSuite) TestInvalidateClient() {
+	for _, id := range []int{1, 2} {
+		_, err := s.projects.GetProjectClientInfoByID(s.ctx, id)
+		s.Require().Nil(err)
+	}
+
+	s.cache.Invalidate("client", 20)
+	s.cache.Invalidate("request", 1)
+
+	for _, id := range []int{1, 2} {
+		_, err := s.projects.GetProjectClientInfoByID(s.ctx, id)
+		s.Require().Nil(err)
+	}
+	s.Equal(3, s.projectRepo.lookups)
+}
+
+func (s *CacheSuite) TestInvalidatedWhileLoading() {
+	s.serviceRepo.onLookup = func() {
+		s.serviceRepo.onLookup = nil
+		s.cache.Invalidate("service", 1)
+	}
+
+	s.getService("billing", "v1")
+	s.getService("billing", "v1")
+
+	s.Equal(2, s.serviceRepo.lookups)
+}
+
+func (s *CacheSuite) TestExpiry() {
+	now := time.Now()
+	s.cache.services.now = func() time.Time { return now }
+
+	s.getService("billing", "v1")
+
+	now = now.Add(time.Minute - time.Nanosecond)
+	s.getService("billing", "v1")
+	s.Equal(1, s.serviceRepo.lookups)
+
+	now = now.Add(time.Nanosecond)
+	s.getService("billing", "v1")
+	s.Equal(2, s.serviceRepo.lookups)
+}
+
+func (s *CacheSuite) TestEvictsLeastRecentlyUsed() {
+	s.getService("billing", "v1")
+	s.getService("billing", "v2")
+	s.getService("billing", "v1")
+	s.getService("search", "v1")
+
+	s.Equal(2, s.cache.services.len())
+
+	s.getService("billing", "v1")
+	s.Equal(3, s.serviceRepo.lookups)
+
+	s.getService("billing", "v2")
+	s.Equal(4, s.serviceRepo.lookups)
+}
+
+func (s *CacheSuite) TestPurge() {
+	s.getService("billing", "v1")
+	s.cache.Purge()
+	s.getService("billing", "v1")
+
+	s.Equal(2, s.serviceRepo.lookups)
+}
+
+func (s *CacheSuite) lookup(key string) *dto.APIKeyValidationLookup {
+	lookup, err := s.validations.Lookup(s.ctx, key, "billing", "v1")
+	s.Require().Nil(err)
+	return lookup
+}
+
+func (s *CacheSuite) TestLookupCachedOnceComplete() {
+	s.lookup("known")
+	s.True(s.lookup("known").ServiceAssigned)
+	s.Equal(1, s.validationRepo.lookups)
+
+	s.lookup("unknown")
+	s.Nil(s.lookup("unknown").APIKey)
+	s.Equal(3, s.validationRepo.lookups)
+}
+
+func (s *CacheSuite) TestInvalidateLookup() {
+	invalidations := []struct {
+		table string
+		id    int
+	}{
+		{"service", 1},
+		{"api_key", 2},
+		{"environment", 3},
+		{"project", 4},
+		{"client", 5},
+	}
+
+	s.lookup("known")
+	for i, invalidation := range invalidations {
+		s.cache.Invalidate(invalidation.table, 42)
+		s.lookup("known")
+		s.Equal(i+1, s.validationRepo.lookups, invalidation.table)
+
+		s.cache.Invalidate(invalidation.table, invalidation.id)
+		s.lookup("known")
+		s.Equal(i+2, s.validationRepo.lookups, invalidation.table)
+	}
+}
+
+func (s *CacheSuite) TestParsePayload() {
+	table, id, ok := parsePayload("api_key:42")
+	s.True(ok)
+	s.Equal("api_key", table)
+	s.Equal(42, id)
+
+	for _, payload := range []string{"", "api_key", "api_key:", "api_key:x"} {
+		_, _, ok := parsePayload(payload)
+		s.False(ok, payload)
+	}
+}
+
+func TestCacheSuite(t *testing.T) {
+	suite.Run(t, new(CacheSuite))
+}
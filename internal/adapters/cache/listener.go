@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Channel is the channel the pandora database notifies the changed rows
+// on, with payloads of the form "<table>:<id>".
+const Channel = "pandora_cache"
+
+const (
+	minRetryDelay = time.Second
+	maxRetryDelay = 30 * time.Second
+)
+
+// Listen invalidates the cache as the pandora database notifies changes,
+// so an update made by any Pandora process reaches every cache. The cache
+// is purged whenever notifications may have been missed, that is while
+// listening starts and after the connection is lost, which is retried
+// until ctx is done.
+func Listen(ctx context.Context, dns string, cache *Cache) {
+	delay := minRetryDelay
+	for {
+		listening, err := listen(ctx, dns, cache)
+		if ctx.Err() != nil {
+			return
+		}
+
+		cache.Purge()
+		if listening {
+			delay = minRetryDelay
+		}
+
+		log.Printf(
+			"[WARNING] Cache invalidation listener failed, retrying in %s: %v",
+			delay, err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, maxRetryDelay)
+	}
+}
+
+// listen returns once the connection fails, reporting whether it got to
+// listen before.
+func listen(ctx context.Context, dns string, cache *Cache) (bool, error) {
+	conn, err := pgx.Connect(ctx, dns)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+Channel); err != nil {
+		return false, err
+	}
+	cache.Purge()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return true, err
+		}
+
+		table, id, ok := parsePayload(notification.Payload)
+		if !ok {
+			log.Printf(
+				"[WARNING] Ignoring invalid cache invalidation %q",
+				notification.Payload,
+			)
+			continue
+		}
+		cache.Invalidate(table, id)
+	}
+}
+
+func parsePayload(payload string) (string, int, bool) {
+	table, rawID, found := strings.Cut(payload, ":")
+	if !found {
+		return "", 0, false
+	}
+
+	id, err := strconv.Atoi(rawID)
+	if err != nil {
+		return "", 0, false
+	}
+	return table, id, true
+}
@@ -0,0 +1,198 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/metrics"
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+// cachedRepositories reads the validation lookups through the cache and
+// leaves every other method to the wrapped repositories. Cached entities
+// are only copied shallowly, so callers must not change what they point to.
+type cachedRepositories struct {
+	persistence.Repositories
+
+	cache *Cache
+}
+
+func (r *cachedRepositories) APIKey() ports.APIKeyRepository {
+	return &apiKeyRepository{
+		APIKeyRepository: r.Repositories.APIKey(),
+		cache:            r.cache,
+	}
+}
+
+func (r *cachedRepositories) Project() ports.ProjectRepository {
+	return &projectRepository{
+		ProjectRepository: r.Repositories.Project(),
+		cache:             r.cache,
+	}
+}
+
+func (r *cachedRepositories) Service() ports.ServiceRepository {
+	return &serviceRepository{
+		ServiceRepository: r.Repositories.Service(),
+		cache:             r.cache,
+	}
+}
+
+func (r *cachedRepositories) Environment() ports.EnvironmentRepository {
+	return &environmentRepository{
+		EnvironmentRepository: r.Repositories.Environment(),
+		cache:                 r.cache,
+	}
+}
+
+func (r *cachedRepositories) Validation() ports.ValidationRepository {
+	return &validationRepository{
+		ValidationRepository: r.Repositories.Validation(),
+		cache:                r.cache,
+	}
+}
+
+type apiKeyRepository struct {
+	ports.APIKeyRepository
+
+	cache *Cache
+}
+
+func (r *apiKeyRepository) GetByKey(
+	ctx context.Context, key string,
+) (*entities.APIKey, errors.Error) {
+	return readThrough(
+		r.cache.apiKeys, "APIKey", key,
+		func() (*entities.APIKey, errors.Error) {
+			return r.APIKeyRepository.GetByKey(ctx, key)
+		},
+	)
+}
+
+type projectRepository struct {
+	ports.ProjectRepository
+
+	cache *Cache
+}
+
+func (r *projectRepository) GetProjectClientInfoByID(
+	ctx context.Context, id int,
+) (*dto.ProjectClientInfoResponse, errors.Error) {
+	return readThrough(
+		r.cache.projects, "Project", id,
+		func() (*dto.ProjectClientInfoResponse, errors.Error) {
+			return r.ProjectRepository.GetProjectClientInfoByID(ctx, id)
+		},
+	)
+}
+
+type serviceRepository struct {
+	ports.ServiceRepository
+
+	cache *Cache
+}
+
+func (r *serviceRepository) GetByNameAndVersion(
+	ctx context.Context, name, version string,
+) (*entities.Service, errors.Error) {
+	key := serviceKey{name: name, version: version}
+	return readThrough(
+		r.cache.services, "Service", key,
+		func() (*entities.Service, errors.Error) {
+			return r.ServiceRepository.GetByNameAndVersion(ctx, name, version)
+		},
+	)
+}
+
+type environmentRepository struct {
+	ports.EnvironmentRepository
+
+	cache *Cache
+}
+
+// GetByID is only cached for validation, which reads the assigned services
+// but not their available requests, as those change on every consumption.
+func (r *environmentRepository) GetByID(
+	ctx context.Context, id int,
+) (*entities.Environment, errors.Error) {
+	return readThrough(
+		r.cache.environments, "Environment", id,
+		func() (*entities.Environment, errors.Error) {
+			return r.EnvironmentRepository.GetByID(ctx, id)
+		},
+	)
+}
+
+type validationRepository struct {
+	ports.ValidationRepository
+
+	cache *Cache
+}
+
+// Lookup is only cached once both the key and the service exist, as a
+// missing row may be created at any time without invalidating anything.
+func (r *validationRepository) Lookup(
+	ctx context.Context, key, serviceName, serviceVersion string,
+) (*dto.APIKeyValidationLookup, errors.Error) {
+	cacheKey := lookupKey{
+		key: key, serviceName: serviceName, serviceVersion: serviceVersion,
+	}
+	if lookup, ok := r.cache.lookups.get(cacheKey); ok {
+		metrics.ObserveCacheLookup("Validation", true)
+		c := *lookup
+		return &c, nil
+	}
+	metrics.ObserveCacheLookup("Validation", false)
+
+	generation := r.cache.lookups.currentGeneration()
+
+	lookup, err := r.ValidationRepository.Lookup(
+		ctx, key, serviceName, serviceVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if lookup.APIKey != nil && lookup.Service != nil {
+		c := *lookup
+		r.cache.lookups.add(cacheKey, &c, generation)
+	}
+	return lookup, nil
+}
+
+// readThrough returns the cached value of the key, loading and caching it
+// on a miss. Errors, not found included, are never cached, so a row
+// created after a failed lookup is found right away.
+func readThrough[K comparable, V any](
+	s *store[K, *V], repository string, key K, load func() (*V, errors.Error),
+) (*V, errors.Error) {
+	if value, ok := s.get(key); ok {
+		metrics.ObserveCacheLookup(repository, true)
+		c := *value
+		return &c, nil
+	}
+	metrics.ObserveCacheLookup(repository, false)
+
+	generation := s.currentGeneration()
+
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	c := *value
+	s.add(key, &c, generation)
+	return value, nil
+}
+
+// NewRepositories wraps the repositories so the validation lookups are
+// read through the cache. It is meant for the gRPC server, whose use cases
+// only read these entities to validate API keys.
+func NewRepositories(
+	repositories persistence.Repositories, cache *Cache,
+) persistence.Repositories {
+	return &cachedRepositories{Repositories: repositories, cache: cache}
+}
@@ -0,0 +1,141 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// store keeps up to size values for ttl each, evicting the least recently
+// used one when full. Every removal bumps its generation, so a value loaded
+// while an invalidation ran is never stored stale.
+type store[K comparable, V any] struct {
+	mu sync.Mutex
+
+	ttl  time.Duration
+	size int
+
+	entries map[K]*list.Element
+	order   *list.List
+
+	generation uint64
+
+	now func() time.Time
+}
+
+func (s *store[K, V]) get(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var zero V
+
+	element, ok := s.entries[key]
+	if !ok {
+		return zero, false
+	}
+
+	e := element.Value.(*entry[K, V])
+	if !s.now().Before(e.expiresAt) {
+		s.removeElement(element)
+		return zero, false
+	}
+
+	s.order.MoveToFront(element)
+	return e.value, true
+}
+
+// currentGeneration must be read before loading a value that is then added
+// with it.
+func (s *store[K, V]) currentGeneration() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.generation
+}
+
+// add stores the value unless something was removed since generation was
+// read, as the value may predate that removal.
+func (s *store[K, V]) add(key K, value V, generation uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if generation != s.generation {
+		return
+	}
+
+	expiresAt := s.now().Add(s.ttl)
+	if element, ok := s.entries[key]; ok {
+		e := element.Value.(*entry[K, V])
+		e.value, e.expiresAt = value, expiresAt
+		s.order.MoveToFront(element)
+		return
+	}
+
+	s.entries[key] = s.order.PushFront(
+		&entry[K, V]{key: key, value: value, expiresAt: expiresAt},
+	)
+
+	if s.order.Len() > s.size {
+		s.removeElement(s.order.Back())
+	}
+}
+
+func (s *store[K, V]) remove(key K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.generation++
+	if element, ok := s.entries[key]; ok {
+		s.removeElement(element)
+	}
+}
+
+// removeFunc removes the values match returns true for.
+func (s *store[K, V]) removeFunc(match func(V) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.generation++
+	for _, element := range s.entries {
+		if match(element.Value.(*entry[K, V]).value) {
+			s.removeElement(element)
+		}
+	}
+}
+
+func (s *store[K, V]) purge() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.generation++
+	clear(s.entries)
+	s.order.Init()
+}
+
+func (s *store[K, V]) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.order.Len()
+}
+
+func (s *store[K, V]) removeElement(element *list.Element) {
+	s.order.Remove(element)
+	delete(s.entries, element.Value.(*entry[K, V]).key)
+}
+
+func newStore[K comparable, V any](ttl time.Duration, size int) *store[K, V] {
+	return &store[K, V]{
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[K]*list.Element),
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
@@ -1,7 +1,10 @@
 package bootstrap
 
 import (
+	"time"
+
 	"github.com/MAD-py/pandora-core/internal/adapters/persistence"
+	"github.com/MAD-py/pandora-core/internal/ports"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
 
@@ -9,13 +12,22 @@ type Dependencies struct {
 	Validator validator.Validator
 
 	Repositories persistence.Repositories
+
+	RateLimiter ports.RateLimiter
+
+	ReservationTTL time.Duration
 }
 
 func NewDependencies(
-	validator validator.Validator, repositories persistence.Repositories,
+	validator validator.Validator,
+	repositories persistence.Repositories,
+	rateLimiter ports.RateLimiter,
+	reservationTTL time.Duration,
 ) *Dependencies {
 	return &Dependencies{
-		Validator:    validator,
-		Repositories: repositories,
+		Validator:      validator,
+		Repositories:   repositories,
+		RateLimiter:    rateLimiter,
+		ReservationTTL: reservationTTL,
 	}
 }
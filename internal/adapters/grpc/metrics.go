@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/metrics"
+)
+
+func metricsInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		metrics.ObserveUseCase(
+			"grpc",
+			info.FullMethod,
+			status.Code(err).String(),
+			time.Since(start),
+		)
+		return resp, err
+	}
+}
@@ -10,6 +10,7 @@ import (
 	protovalidator "github.com/bufbuild/protovalidate-go"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/protovalidate"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -49,7 +50,9 @@ func (s *Server) Run() error {
 	logger := interceptorLogger()
 
 	s.server = grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.ChainUnaryInterceptor(
+			metricsInterceptor(),
 			logging.UnaryServerInterceptor(
 				logger,
 				logging.WithLogOnEvents(
@@ -77,6 +80,26 @@ func (s *Server) Run() error {
 	return nil
 }
 
+// Shutdown stops accepting calls and waits for the ongoing ones to finish,
+// cancelling them once ctx is done.
+func (s *Server) Shutdown(ctx context.Context) {
+	if s.server == nil {
+		return
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.server.Stop()
+	}
+}
+
 func NewServer(addr string, deps *bootstrap.Dependencies) *Server {
 	return &Server{addr: addr, deps: deps}
 }
@@ -1,6 +1,8 @@
 package apikey
 
 import (
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	pb "github.com/MAD-py/pandora-core/internal/adapters/grpc/services/api_key/v1"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
@@ -35,6 +37,7 @@ func validateRequestToDomain(req *pb.ValidateRequest) *dto.APIKeyValidate {
 		Request:        request,
 		ServiceName:    req.ServiceName,
 		ServiceVersion: req.ServiceVersion,
+		Units:          int(req.Units),
 	}
 }
 
@@ -63,13 +66,21 @@ func validateResponseFromDomain(response *dto.APIKeyValidateResponse) *pb.Valida
 		}
 	}
 
+	var retryAfter *durationpb.Duration
+	if response.RetryAfter > 0 {
+		retryAfter = durationpb.New(response.RetryAfter)
+	}
+
 	return &pb.ValidateResponse{
-		Valid:       response.Valid,
-		RequestId:   response.RequestID,
-		FailureCode: string(response.FailureCode),
-		Client:      client,
-		Project:     project,
-		Environment: environment,
+		Valid:          response.Valid,
+		RequestId:      response.RequestID,
+		FailureCode:    string(response.FailureCode),
+		Client:         client,
+		Project:        project,
+		Environment:    environment,
+		RetryAfter:     retryAfter,
+		QuotaLevel:     string(response.QuotaLevel),
+		RequestDropped: response.RequestDropped,
 	}
 }
 
@@ -100,14 +111,22 @@ func validateConsumeResponseFromDomain(
 		}
 	}
 
+	var retryAfter *durationpb.Duration
+	if response.RetryAfter > 0 {
+		retryAfter = durationpb.New(response.RetryAfter)
+	}
+
 	return &pb.ValidateConsumeResponse{
 		BaseResponse: &pb.ValidateResponse{
-			Valid:       response.Valid,
-			RequestId:   response.RequestID,
-			FailureCode: string(response.FailureCode),
-			Client:      client,
-			Project:     project,
-			Environment: environment,
+			Valid:          response.Valid,
+			RequestId:      response.RequestID,
+			FailureCode:    string(response.FailureCode),
+			Client:         client,
+			Project:        project,
+			Environment:    environment,
+			RetryAfter:     retryAfter,
+			QuotaLevel:     string(response.QuotaLevel),
+			RequestDropped: response.RequestDropped,
 		},
 		AvailableRequest: int64(response.AvailableRequest),
 	}
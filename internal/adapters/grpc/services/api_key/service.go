@@ -9,6 +9,7 @@ import (
 	"github.com/MAD-py/pandora-core/internal/adapters/grpc/bootstrap"
 	"github.com/MAD-py/pandora-core/internal/adapters/grpc/errors"
 	pb "github.com/MAD-py/pandora-core/internal/adapters/grpc/services/api_key/v1"
+	"github.com/MAD-py/pandora-core/internal/adapters/metrics"
 	apikey "github.com/MAD-py/pandora-core/internal/app/api_key"
 )
 
@@ -29,19 +30,31 @@ func (s *service) Validate(
 			err.Error(),
 		)
 	}
+
+	metrics.ObserveValidation("validate", response)
 	return validateResponseFromDomain(response), nil
 }
 
 func (s *service) ValidateConsume(
 	ctx context.Context, req *pb.ValidateRequest,
 ) (*pb.ValidateConsumeResponse, error) {
-	response, err := s.validateConsumeUC.Execute(ctx, validateRequestToDomain(req))
+	domainReq := validateRequestToDomain(req)
+
+	response, err := s.validateConsumeUC.Execute(ctx, domainReq)
 	if err != nil {
 		return nil, status.Error(
 			errors.CodeToGRPCCode(err.Code()),
 			err.Error(),
 		)
 	}
+
+	metrics.ObserveValidation("validate_consume", &response.APIKeyValidateResponse)
+	metrics.ObserveConsumption(
+		"validate_consume",
+		domainReq,
+		&response.APIKeyValidateResponse,
+		response.AvailableRequest,
+	)
 	return validateConsumeResponseFromDomain(response), nil
 }
 
@@ -57,11 +70,11 @@ func RegisterService(s *grpc.Server, deps *bootstrap.Dependencies) {
 		),
 		validateConsumeUC: apikey.NewValidateConsumeUseCase(
 			deps.Validator,
-			deps.Repositories.APIKey(),
-			deps.Repositories.Project(),
+			deps.Repositories.Validation(),
 			deps.Repositories.Request(),
-			deps.Repositories.Service(),
-			deps.Repositories.Environment(),
+			deps.Repositories.QuotaAlert(),
+			deps.Repositories.Webhook(),
+			deps.RateLimiter,
 		),
 	}
 	pb.RegisterAPIKeyServiceServer(s, &service)
@@ -10,6 +10,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -181,6 +182,7 @@ type ValidateRequest struct {
 	Request        *Request               `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 	ServiceName    string                 `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
 	ServiceVersion string                 `protobuf:"bytes,4,opt,name=service_version,json=serviceVersion,proto3" json:"service_version,omitempty"`
+	Units          int64                  `protobuf:"varint,5,opt,name=units,proto3" json:"units,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -243,6 +245,13 @@ func (x *ValidateRequest) GetServiceVersion() string {
 	return ""
 }
 
+func (x *ValidateRequest) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
 type Project struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -400,15 +409,18 @@ func (x *Environment) GetName() string {
 }
 
 type ValidateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	FailureCode   string                 `protobuf:"bytes,3,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
-	Project       *Project               `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
-	Client        *Client                `protobuf:"bytes,5,opt,name=client,proto3" json:"client,omitempty"`
-	Environment   *Environment           `protobuf:"bytes,6,opt,name=environment,proto3" json:"environment,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Valid          bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	RequestId      string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	FailureCode    string                 `protobuf:"bytes,3,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
+	Project        *Project               `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
+	Client         *Client                `protobuf:"bytes,5,opt,name=client,proto3" json:"client,omitempty"`
+	Environment    *Environment           `protobuf:"bytes,6,opt,name=environment,proto3" json:"environment,omitempty"`
+	RetryAfter     *durationpb.Duration   `protobuf:"bytes,7,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+	QuotaLevel     string                 `protobuf:"bytes,8,opt,name=quota_level,json=quotaLevel,proto3" json:"quota_level,omitempty"`
+	RequestDropped bool                   `protobuf:"varint,9,opt,name=request_dropped,json=requestDropped,proto3" json:"request_dropped,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ValidateResponse) Reset() {
@@ -483,6 +495,27 @@ func (x *ValidateResponse) GetEnvironment() *Environment {
 	return nil
 }
 
+func (x *ValidateResponse) GetRetryAfter() *durationpb.Duration {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetQuotaLevel() string {
+	if x != nil {
+		return x.QuotaLevel
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetRequestDropped() bool {
+	if x != nil {
+		return x.RequestDropped
+	}
+	return false
+}
+
 type ValidateConsumeResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	BaseResponse     *ValidateResponse      `protobuf:"bytes,1,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
@@ -540,7 +573,7 @@ var File_api_key_v1_api_key_proto protoreflect.FileDescriptor
 const file_api_key_v1_api_key_proto_rawDesc = "" +
 	"\n" +
 	"\x18api_key/v1/api_key.proto\x12\n" +
-	"api_key.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc1\x02\n" +
+	"api_key.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc1\x02\n" +
 	"\x0fRequestMetadata\x12\x12\n" +
 	"\x04body\x18\x01 \x01(\tR\x04body\x12\x18\n" +
 	"\acookies\x18\x02 \x01(\tR\acookies\x12\x18\n" +
@@ -554,12 +587,13 @@ const file_api_key_v1_api_key_proto_rawDesc = "" +
 	"\n" +
 	"ip_address\x18\x03 \x01(\tR\tipAddress\x127\n" +
 	"\bmetadata\x18\x04 \x01(\v2\x1b.api_key.v1.RequestMetadataR\bmetadata\x12=\n" +
-	"\frequest_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestTime\"\xa5\x01\n" +
+	"\frequest_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestTime\"\xbb\x01\n" +
 	"\x0fValidateRequest\x12\x17\n" +
 	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x12-\n" +
 	"\arequest\x18\x02 \x01(\v2\x13.api_key.v1.RequestR\arequest\x12!\n" +
 	"\fservice_name\x18\x03 \x01(\tR\vserviceName\x12'\n" +
-	"\x0fservice_version\x18\x04 \x01(\tR\x0eserviceVersion\"-\n" +
+	"\x0fservice_version\x18\x04 \x01(\tR\x0eserviceVersion\x12\x14\n" +
+	"\x05units\x18\x05 \x01(\x03R\x05units\"-\n" +
 	"\aProject\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\",\n" +
@@ -568,15 +602,20 @@ const file_api_key_v1_api_key_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"1\n" +
 	"\vEnvironment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\xb3\x03\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xe6\x04\n" +
 	"\x10ValidateResponse\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x02 \x01(\tR\trequestId\x12\xd3\x01\n" +
-	"\ffailure_code\x18\x03 \x01(\tB\xaf\x01\xbaH\xab\x01r\xa8\x01R\x0fAPI_KEY_INVALIDR\x0eQUOTA_EXCEEDEDR\x0fAPI_KEY_EXPIREDR\x10API_KEY_DISABLEDR\x10SERVICE_MISMATCHR\x10SERVICE_DISABLEDR\x12SERVICE_DEPRECATEDR\x14SERVICE_NOT_ASSIGNEDR\x14ENVIRONMENT_DISABLEDR\vfailureCode\x12-\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\xe1\x01\n" +
+	"\ffailure_code\x18\x03 \x01(\tB\xbd\x01\xbaH\xb9\x01r\xb6\x01R\x0fAPI_KEY_INVALIDR\x0eQUOTA_EXCEEDEDR\x0fAPI_KEY_EXPIREDR\x10API_KEY_DISABLEDR\x10SERVICE_MISMATCHR\x10SERVICE_DISABLEDR\x12SERVICE_DEPRECATEDR\x14SERVICE_NOT_ASSIGNEDR\x14ENVIRONMENT_DISABLEDR\fRATE_LIMITEDR\vfailureCode\x12-\n" +
 	"\aproject\x18\x04 \x01(\v2\x13.api_key.v1.ProjectR\aproject\x12*\n" +
 	"\x06client\x18\x05 \x01(\v2\x12.api_key.v1.ClientR\x06client\x129\n" +
-	"\venvironment\x18\x06 \x01(\v2\x17.api_key.v1.EnvironmentR\venvironment\"\x89\x01\n" +
+	"\venvironment\x18\x06 \x01(\v2\x17.api_key.v1.EnvironmentR\venvironment\x12:\n" +
+	"\vretry_after\x18\a \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"retryAfter\x12>\n" +
+	"\vquota_level\x18\b \x01(\tB\x1d\xbaH\x1ar\x18R\x00R\aprojectR\venvironmentR\n" +
+	"quotaLevel\x12'\n" +
+	"\x0frequest_dropped\x18\t \x01(\bR\x0erequestDropped\"\x89\x01\n" +
 	"\x17ValidateConsumeResponse\x12A\n" +
 	"\rbase_response\x18\x01 \x01(\v2\x1c.api_key.v1.ValidateResponseR\fbaseResponse\x12+\n" +
 	"\x11available_request\x18\x02 \x01(\x03R\x10availableRequest2\xab\x01\n" +
@@ -608,24 +647,26 @@ var file_api_key_v1_api_key_proto_goTypes = []any{
 	(*ValidateResponse)(nil),        // 6: api_key.v1.ValidateResponse
 	(*ValidateConsumeResponse)(nil), // 7: api_key.v1.ValidateConsumeResponse
 	(*timestamppb.Timestamp)(nil),   // 8: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),     // 9: google.protobuf.Duration
 }
 var file_api_key_v1_api_key_proto_depIdxs = []int32{
-	0, // 0: api_key.v1.Request.metadata:type_name -> api_key.v1.RequestMetadata
-	8, // 1: api_key.v1.Request.request_time:type_name -> google.protobuf.Timestamp
-	1, // 2: api_key.v1.ValidateRequest.request:type_name -> api_key.v1.Request
-	3, // 3: api_key.v1.ValidateResponse.project:type_name -> api_key.v1.Project
-	4, // 4: api_key.v1.ValidateResponse.client:type_name -> api_key.v1.Client
-	5, // 5: api_key.v1.ValidateResponse.environment:type_name -> api_key.v1.Environment
-	6, // 6: api_key.v1.ValidateConsumeResponse.base_response:type_name -> api_key.v1.ValidateResponse
-	2, // 7: api_key.v1.APIKeyService.Validate:input_type -> api_key.v1.ValidateRequest
-	2, // 8: api_key.v1.APIKeyService.ValidateConsume:input_type -> api_key.v1.ValidateRequest
-	6, // 9: api_key.v1.APIKeyService.Validate:output_type -> api_key.v1.ValidateResponse
-	7, // 10: api_key.v1.APIKeyService.ValidateConsume:output_type -> api_key.v1.ValidateConsumeResponse
-	9, // [9:11] is the sub-list for method output_type
-	7, // [7:9] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	0,  // 0: api_key.v1.Request.metadata:type_name -> api_key.v1.RequestMetadata
+	8,  // 1: api_key.v1.Request.request_time:type_name -> google.protobuf.Timestamp
+	1,  // 2: api_key.v1.ValidateRequest.request:type_name -> api_key.v1.Request
+	3,  // 3: api_key.v1.ValidateResponse.project:type_name -> api_key.v1.Project
+	4,  // 4: api_key.v1.ValidateResponse.client:type_name -> api_key.v1.Client
+	5,  // 5: api_key.v1.ValidateResponse.environment:type_name -> api_key.v1.Environment
+	9,  // 6: api_key.v1.ValidateResponse.retry_after:type_name -> google.protobuf.Duration
+	6,  // 7: api_key.v1.ValidateConsumeResponse.base_response:type_name -> api_key.v1.ValidateResponse
+	2,  // 8: api_key.v1.APIKeyService.Validate:input_type -> api_key.v1.ValidateRequest
+	2,  // 9: api_key.v1.APIKeyService.ValidateConsume:input_type -> api_key.v1.ValidateRequest
+	6,  // 10: api_key.v1.APIKeyService.Validate:output_type -> api_key.v1.ValidateResponse
+	7,  // 11: api_key.v1.APIKeyService.ValidateConsume:output_type -> api_key.v1.ValidateConsumeResponse
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_api_key_v1_api_key_proto_init() }
@@ -0,0 +1,93 @@
+package reservation
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/MAD-py/pandora-core/internal/adapters/grpc/services/reservation/v1"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+func reserveRequestToDomain(req *pb.ReserveRequest) *dto.APIKeyValidate {
+	var request *dto.RequestIncoming
+	if req.Request != nil {
+		var metadata *dto.RequestIncomingMetadata
+
+		if req.Request.Metadata != nil {
+			metadata = &dto.RequestIncomingMetadata{
+				Body:            req.Request.Metadata.Body,
+				Cookies:         req.Request.Metadata.Cookies,
+				Headers:         req.Request.Metadata.Headers,
+				QueryParams:     req.Request.Metadata.QueryParams,
+				BodyContentType: enums.RequestBodyContentType(req.Request.Metadata.BodyContentType),
+			}
+		}
+
+		request = &dto.RequestIncoming{
+			Path:        req.Request.Path,
+			Method:      req.Request.Method,
+			Metadata:    metadata,
+			IPAddress:   req.Request.IpAddress,
+			RequestTime: req.Request.RequestTime.AsTime(),
+		}
+	}
+
+	return &dto.APIKeyValidate{
+		APIKey:         req.ApiKey,
+		Request:        request,
+		ServiceName:    req.ServiceName,
+		ServiceVersion: req.ServiceVersion,
+		Units:          int(req.Units),
+	}
+}
+
+func reserveResponseFromDomain(response *dto.ReservationResponse) *pb.ReserveResponse {
+	var client *pb.Client
+	if response.Client != nil {
+		client = &pb.Client{
+			Id:   int64(response.Client.ID),
+			Name: response.Client.Name,
+		}
+	}
+
+	var project *pb.Project
+	if response.Project != nil {
+		project = &pb.Project{
+			Id:   int64(response.Project.ID),
+			Name: response.Project.Name,
+		}
+	}
+
+	var environment *pb.Environment
+	if response.Environment != nil {
+		environment = &pb.Environment{
+			Id:   int64(response.Environment.ID),
+			Name: response.Environment.Name,
+		}
+	}
+
+	var expiresAt *timestamppb.Timestamp
+	if !response.ExpiresAt.IsZero() {
+		expiresAt = timestamppb.New(response.ExpiresAt)
+	}
+
+	var retryAfter *durationpb.Duration
+	if response.RetryAfter > 0 {
+		retryAfter = durationpb.New(response.RetryAfter)
+	}
+
+	return &pb.ReserveResponse{
+		Valid:            response.Valid,
+		RequestId:        response.RequestID,
+		FailureCode:      string(response.FailureCode),
+		Client:           client,
+		Project:          project,
+		Environment:      environment,
+		ReservationId:    response.ReservationID,
+		AvailableRequest: int64(response.AvailableRequest),
+		ExpiresAt:        expiresAt,
+		RetryAfter:       retryAfter,
+		QuotaLevel:       string(response.QuotaLevel),
+	}
+}
@@ -10,18 +10,48 @@ import (
 	"github.com/MAD-py/pandora-core/internal/adapters/grpc/bootstrap"
 	"github.com/MAD-py/pandora-core/internal/adapters/grpc/errors"
 	pb "github.com/MAD-py/pandora-core/internal/adapters/grpc/services/reservation/v1"
+	"github.com/MAD-py/pandora-core/internal/adapters/metrics"
 	"github.com/MAD-py/pandora-core/internal/app/reservation"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
 )
 
 type service struct {
 	pb.ReservationServiceServer
 
+	reserveUC  reservation.ReserveUseCase
 	commitUC   reservation.CommitUseCase
 	rollbackUC reservation.RollbackUseCase
 }
 
+func (s *service) Reserve(ctx context.Context, req *pb.ReserveRequest) (*pb.ReserveResponse, error) {
+	domainReq := reserveRequestToDomain(req)
+
+	response, err := s.reserveUC.Execute(ctx, domainReq)
+	if err != nil {
+		return nil, status.Error(
+			errors.CodeToGRPCCode(err.Code()),
+			err.Error(),
+		)
+	}
+
+	metrics.ObserveValidation("reserve", &response.APIKeyValidateResponse)
+	metrics.ObserveConsumption(
+		"reserve",
+		domainReq,
+		&response.APIKeyValidateResponse,
+		response.AvailableRequest,
+	)
+	return reserveResponseFromDomain(response), nil
+}
+
 func (s *service) Commit(ctx context.Context, req *pb.CommitRequest) (*emptypb.Empty, error) {
-	err := s.commitUC.Execute(ctx, req.GetParams().Id)
+	commit := new(dto.ReservationCommit)
+	if req.Units != nil {
+		units := int(req.GetUnits())
+		commit.Units = &units
+	}
+
+	err := s.commitUC.Execute(ctx, req.GetParams().Id, commit)
 	if err != nil {
 		return nil, status.Error(
 			errors.CodeToGRPCCode(err.Code()),
@@ -29,7 +59,6 @@ func (s *service) Commit(ctx context.Context, req *pb.CommitRequest) (*emptypb.E
 		)
 	}
 	return &emptypb.Empty{}, nil
-
 }
 
 func (s *service) Rollback(ctx context.Context, req *pb.RollbackRequest) (*emptypb.Empty, error) {
@@ -45,6 +74,19 @@ func (s *service) Rollback(ctx context.Context, req *pb.RollbackRequest) (*empty
 
 func RegisterService(s *grpc.Server, deps *bootstrap.Dependencies) {
 	service := &service{
+		reserveUC: reservation.NewReserveUseCase(
+			deps.Validator,
+			deps.ReservationTTL,
+			deps.Repositories.APIKey(),
+			deps.Repositories.Project(),
+			deps.Repositories.Service(),
+			deps.Repositories.Request(),
+			deps.Repositories.Environment(),
+			deps.Repositories.Reservation(),
+			deps.Repositories.QuotaAlert(),
+			deps.Repositories.Webhook(),
+			deps.RateLimiter,
+		),
 		commitUC: reservation.NewCommitUseCase(
 			deps.Validator,
 			deps.Repositories.Reservation(),
@@ -52,7 +94,6 @@ func RegisterService(s *grpc.Server, deps *bootstrap.Dependencies) {
 		rollbackUC: reservation.NewRollbackUseCase(
 			deps.Validator,
 			deps.Repositories.Reservation(),
-			deps.Repositories.Environment(),
 		),
 	}
 	pb.RegisterReservationServiceServer(s, service)
@@ -7,9 +7,12 @@
 package pb
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -69,6 +72,7 @@ func (x *BaseReservationParams) GetId() string {
 type CommitRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Params        *BaseReservationParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Units         *int64                 `protobuf:"varint,2,opt,name=units,proto3,oneof" json:"units,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -110,6 +114,13 @@ func (x *CommitRequest) GetParams() *BaseReservationParams {
 	return nil
 }
 
+func (x *CommitRequest) GetUnits() int64 {
+	if x != nil && x.Units != nil {
+		return *x.Units
+	}
+	return 0
+}
+
 type RollbackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Params        *BaseReservationParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
@@ -154,18 +165,575 @@ func (x *RollbackRequest) GetParams() *BaseReservationParams {
 	return nil
 }
 
+type RequestMetadata struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Body            string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	Cookies         string                 `protobuf:"bytes,2,opt,name=cookies,proto3" json:"cookies,omitempty"`
+	Headers         string                 `protobuf:"bytes,3,opt,name=headers,proto3" json:"headers,omitempty"`
+	QueryParams     string                 `protobuf:"bytes,4,opt,name=query_params,json=queryParams,proto3" json:"query_params,omitempty"`
+	BodyContentType string                 `protobuf:"bytes,5,opt,name=body_content_type,json=bodyContentType,proto3" json:"body_content_type,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequestMetadata) Reset() {
+	*x = RequestMetadata{}
+	mi := &file_reservation_v1_reservation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMetadata) ProtoMessage() {}
+
+func (x *RequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_v1_reservation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestMetadata.ProtoReflect.Descriptor instead.
+func (*RequestMetadata) Descriptor() ([]byte, []int) {
+	return file_reservation_v1_reservation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RequestMetadata) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *RequestMetadata) GetCookies() string {
+	if x != nil {
+		return x.Cookies
+	}
+	return ""
+}
+
+func (x *RequestMetadata) GetHeaders() string {
+	if x != nil {
+		return x.Headers
+	}
+	return ""
+}
+
+func (x *RequestMetadata) GetQueryParams() string {
+	if x != nil {
+		return x.QueryParams
+	}
+	return ""
+}
+
+func (x *RequestMetadata) GetBodyContentType() string {
+	if x != nil {
+		return x.BodyContentType
+	}
+	return ""
+}
+
+type Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Metadata      *RequestMetadata       `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	RequestTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=request_time,json=requestTime,proto3" json:"request_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	mi := &file_reservation_v1_reservation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_v1_reservation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_reservation_v1_reservation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Request) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Request) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Request) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Request) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Request) GetRequestTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestTime
+	}
+	return nil
+}
+
+type ReserveRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey         string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Request        *Request               `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	ServiceName    string                 `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	ServiceVersion string                 `protobuf:"bytes,4,opt,name=service_version,json=serviceVersion,proto3" json:"service_version,omitempty"`
+	Units          int64                  `protobuf:"varint,5,opt,name=units,proto3" json:"units,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReserveRequest) Reset() {
+	*x = ReserveRequest{}
+	mi := &file_reservation_v1_reservation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveRequest) ProtoMessage() {}
+
+func (x *ReserveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_v1_reservation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveRequest.ProtoReflect.Descriptor instead.
+func (*ReserveRequest) Descriptor() ([]byte, []int) {
+	return file_reservation_v1_reservation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReserveRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *ReserveRequest) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ReserveRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ReserveRequest) GetServiceVersion() string {
+	if x != nil {
+		return x.ServiceVersion
+	}
+	return ""
+}
+
+func (x *ReserveRequest) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+type Project struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_reservation_v1_reservation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_v1_reservation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_reservation_v1_reservation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Project) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Client struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Client) Reset() {
+	*x = Client{}
+	mi := &file_reservation_v1_reservation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Client) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Client) ProtoMessage() {}
+
+func (x *Client) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_v1_reservation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Client.ProtoReflect.Descriptor instead.
+func (*Client) Descriptor() ([]byte, []int) {
+	return file_reservation_v1_reservation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Client) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Client) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Environment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Environment) Reset() {
+	*x = Environment{}
+	mi := &file_reservation_v1_reservation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Environment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Environment) ProtoMessage() {}
+
+func (x *Environment) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_v1_reservation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Environment.ProtoReflect.Descriptor instead.
+func (*Environment) Descriptor() ([]byte, []int) {
+	return file_reservation_v1_reservation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Environment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Environment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ReserveResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Valid            bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	RequestId        string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	FailureCode      string                 `protobuf:"bytes,3,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
+	Project          *Project               `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
+	Client           *Client                `protobuf:"bytes,5,opt,name=client,proto3" json:"client,omitempty"`
+	Environment      *Environment           `protobuf:"bytes,6,opt,name=environment,proto3" json:"environment,omitempty"`
+	ReservationId    string                 `protobuf:"bytes,7,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	AvailableRequest int64                  `protobuf:"varint,8,opt,name=available_request,json=availableRequest,proto3" json:"available_request,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RetryAfter       *durationpb.Duration   `protobuf:"bytes,10,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+	QuotaLevel       string                 `protobuf:"bytes,11,opt,name=quota_level,json=quotaLevel,proto3" json:"quota_level,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReserveResponse) Reset() {
+	*x = ReserveResponse{}
+	mi := &file_reservation_v1_reservation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveResponse) ProtoMessage() {}
+
+func (x *ReserveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_v1_reservation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveResponse.ProtoReflect.Descriptor instead.
+func (*ReserveResponse) Descriptor() ([]byte, []int) {
+	return file_reservation_v1_reservation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReserveResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ReserveResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ReserveResponse) GetFailureCode() string {
+	if x != nil {
+		return x.FailureCode
+	}
+	return ""
+}
+
+func (x *ReserveResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *ReserveResponse) GetClient() *Client {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *ReserveResponse) GetEnvironment() *Environment {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
+func (x *ReserveResponse) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReserveResponse) GetAvailableRequest() int64 {
+	if x != nil {
+		return x.AvailableRequest
+	}
+	return 0
+}
+
+func (x *ReserveResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ReserveResponse) GetRetryAfter() *durationpb.Duration {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return nil
+}
+
+func (x *ReserveResponse) GetQuotaLevel() string {
+	if x != nil {
+		return x.QuotaLevel
+	}
+	return ""
+}
+
 var File_reservation_v1_reservation_proto protoreflect.FileDescriptor
 
 const file_reservation_v1_reservation_proto_rawDesc = "" +
 	"\n" +
-	" reservation/v1/reservation.proto\x12\x0ereservation.v1\x1a\x1bgoogle/protobuf/empty.proto\"'\n" +
+	" reservation/v1/reservation.proto\x12\x0ereservation.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"'\n" +
 	"\x15BaseReservationParams\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"N\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"s\n" +
 	"\rCommitRequest\x12=\n" +
-	"\x06params\x18\x01 \x01(\v2%.reservation.v1.BaseReservationParamsR\x06params\"P\n" +
+	"\x06params\x18\x01 \x01(\v2%.reservation.v1.BaseReservationParamsR\x06params\x12\x19\n" +
+	"\x05units\x18\x02 \x01(\x03H\x00R\x05units\x88\x01\x01B\b\n" +
+	"\x06_units\"P\n" +
 	"\x0fRollbackRequest\x12=\n" +
-	"\x06params\x18\x01 \x01(\v2%.reservation.v1.BaseReservationParamsR\x06params2\x9a\x01\n" +
-	"\x12ReservationService\x12?\n" +
+	"\x06params\x18\x01 \x01(\v2%.reservation.v1.BaseReservationParamsR\x06params\"\xc1\x02\n" +
+	"\x0fRequestMetadata\x12\x12\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\x12\x18\n" +
+	"\acookies\x18\x02 \x01(\tR\acookies\x12\x18\n" +
+	"\aheaders\x18\x03 \x01(\tR\aheaders\x12!\n" +
+	"\fquery_params\x18\x04 \x01(\tR\vqueryParams\x12\xc2\x01\n" +
+	"\x11body_content_type\x18\x05 \x01(\tB\x95\x01\xbaH\x91\x01r\x8e\x01R\x00R\x0fapplication/xmlR\x10application/jsonR\n" +
+	"text/plainR\ttext/htmlR\x13multipart/form-dataR!application/x-www-form-urlencodedR\x18application/octet-streamR\x0fbodyContentType\"\x95\x02\n" +
+	"\aRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12[\n" +
+	"\x06method\x18\x02 \x01(\tBC\xbaH@r>R\x03GETR\x04HEADR\x04POSTR\x03PUTR\x05PATCHR\x06DELETER\aCONNECTR\aOPTIONSR\x05TRACER\x06method\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x12;\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x1f.reservation.v1.RequestMetadataR\bmetadata\x12=\n" +
+	"\frequest_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestTime\"\xbe\x01\n" +
+	"\x0eReserveRequest\x12\x17\n" +
+	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x121\n" +
+	"\arequest\x18\x02 \x01(\v2\x17.reservation.v1.RequestR\arequest\x12!\n" +
+	"\fservice_name\x18\x03 \x01(\tR\vserviceName\x12'\n" +
+	"\x0fservice_version\x18\x04 \x01(\tR\x0eserviceVersion\x12\x14\n" +
+	"\x05units\x18\x05 \x01(\x03R\x05units\"-\n" +
+	"\aProject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\",\n" +
+	"\x06Client\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"1\n" +
+	"\vEnvironment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xd7\x05\n" +
+	"\x0fReserveResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\xe1\x01\n" +
+	"\ffailure_code\x18\x03 \x01(\tB\xbd\x01\xbaH\xb9\x01r\xb6\x01R\x0fAPI_KEY_INVALIDR\x0eQUOTA_EXCEEDEDR\x0fAPI_KEY_EXPIREDR\x10API_KEY_DISABLEDR\x10SERVICE_MISMATCHR\x10SERVICE_DISABLEDR\x12SERVICE_DEPRECATEDR\x14SERVICE_NOT_ASSIGNEDR\x14ENVIRONMENT_DISABLEDR\fRATE_LIMITEDR\vfailureCode\x121\n" +
+	"\aproject\x18\x04 \x01(\v2\x17.reservation.v1.ProjectR\aproject\x12.\n" +
+	"\x06client\x18\x05 \x01(\v2\x16.reservation.v1.ClientR\x06client\x12=\n" +
+	"\venvironment\x18\x06 \x01(\v2\x1b.reservation.v1.EnvironmentR\venvironment\x12%\n" +
+	"\x0ereservation_id\x18\a \x01(\tR\rreservationId\x12+\n" +
+	"\x11available_request\x18\b \x01(\x03R\x10availableRequest\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12:\n" +
+	"\vretry_after\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"retryAfter\x12>\n" +
+	"\vquota_level\x18\v \x01(\tB\x1d\xbaH\x1ar\x18R\x00R\aprojectR\venvironmentR\n" +
+	"quotaLevel2\xe6\x01\n" +
+	"\x12ReservationService\x12J\n" +
+	"\aReserve\x12\x1e.reservation.v1.ReserveRequest\x1a\x1f.reservation.v1.ReserveResponse\x12?\n" +
 	"\x06Commit\x12\x1d.reservation.v1.CommitRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
 	"\bRollback\x12\x1f.reservation.v1.RollbackRequest\x1a\x16.google.protobuf.EmptyB\x10Z\x0ereservation/pbb\x06proto3"
 
@@ -181,25 +749,44 @@ func file_reservation_v1_reservation_proto_rawDescGZIP() []byte {
 	return file_reservation_v1_reservation_proto_rawDescData
 }
 
-var file_reservation_v1_reservation_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_reservation_v1_reservation_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_reservation_v1_reservation_proto_goTypes = []any{
 	(*BaseReservationParams)(nil), // 0: reservation.v1.BaseReservationParams
 	(*CommitRequest)(nil),         // 1: reservation.v1.CommitRequest
 	(*RollbackRequest)(nil),       // 2: reservation.v1.RollbackRequest
-	(*emptypb.Empty)(nil),         // 3: google.protobuf.Empty
+	(*RequestMetadata)(nil),       // 3: reservation.v1.RequestMetadata
+	(*Request)(nil),               // 4: reservation.v1.Request
+	(*ReserveRequest)(nil),        // 5: reservation.v1.ReserveRequest
+	(*Project)(nil),               // 6: reservation.v1.Project
+	(*Client)(nil),                // 7: reservation.v1.Client
+	(*Environment)(nil),           // 8: reservation.v1.Environment
+	(*ReserveResponse)(nil),       // 9: reservation.v1.ReserveResponse
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 11: google.protobuf.Duration
+	(*emptypb.Empty)(nil),         // 12: google.protobuf.Empty
 }
 var file_reservation_v1_reservation_proto_depIdxs = []int32{
-	0, // 0: reservation.v1.CommitRequest.params:type_name -> reservation.v1.BaseReservationParams
-	0, // 1: reservation.v1.RollbackRequest.params:type_name -> reservation.v1.BaseReservationParams
-	1, // 2: reservation.v1.ReservationService.Commit:input_type -> reservation.v1.CommitRequest
-	2, // 3: reservation.v1.ReservationService.Rollback:input_type -> reservation.v1.RollbackRequest
-	3, // 4: reservation.v1.ReservationService.Commit:output_type -> google.protobuf.Empty
-	3, // 5: reservation.v1.ReservationService.Rollback:output_type -> google.protobuf.Empty
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: reservation.v1.CommitRequest.params:type_name -> reservation.v1.BaseReservationParams
+	0,  // 1: reservation.v1.RollbackRequest.params:type_name -> reservation.v1.BaseReservationParams
+	3,  // 2: reservation.v1.Request.metadata:type_name -> reservation.v1.RequestMetadata
+	10, // 3: reservation.v1.Request.request_time:type_name -> google.protobuf.Timestamp
+	4,  // 4: reservation.v1.ReserveRequest.request:type_name -> reservation.v1.Request
+	6,  // 5: reservation.v1.ReserveResponse.project:type_name -> reservation.v1.Project
+	7,  // 6: reservation.v1.ReserveResponse.client:type_name -> reservation.v1.Client
+	8,  // 7: reservation.v1.ReserveResponse.environment:type_name -> reservation.v1.Environment
+	10, // 8: reservation.v1.ReserveResponse.expires_at:type_name -> google.protobuf.Timestamp
+	11, // 9: reservation.v1.ReserveResponse.retry_after:type_name -> google.protobuf.Duration
+	5,  // 10: reservation.v1.ReservationService.Reserve:input_type -> reservation.v1.ReserveRequest
+	1,  // 11: reservation.v1.ReservationService.Commit:input_type -> reservation.v1.CommitRequest
+	2,  // 12: reservation.v1.ReservationService.Rollback:input_type -> reservation.v1.RollbackRequest
+	9,  // 13: reservation.v1.ReservationService.Reserve:output_type -> reservation.v1.ReserveResponse
+	12, // 14: reservation.v1.ReservationService.Commit:output_type -> google.protobuf.Empty
+	12, // 15: reservation.v1.ReservationService.Rollback:output_type -> google.protobuf.Empty
+	13, // [13:16] is the sub-list for method output_type
+	10, // [10:13] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_reservation_v1_reservation_proto_init() }
@@ -207,13 +794,14 @@ func file_reservation_v1_reservation_proto_init() {
 	if File_reservation_v1_reservation_proto != nil {
 		return
 	}
+	file_reservation_v1_reservation_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reservation_v1_reservation_proto_rawDesc), len(file_reservation_v1_reservation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
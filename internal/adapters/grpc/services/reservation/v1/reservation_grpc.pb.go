@@ -20,6 +20,7 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
+	ReservationService_Reserve_FullMethodName  = "/reservation.v1.ReservationService/Reserve"
 	ReservationService_Commit_FullMethodName   = "/reservation.v1.ReservationService/Commit"
 	ReservationService_Rollback_FullMethodName = "/reservation.v1.ReservationService/Rollback"
 )
@@ -28,6 +29,7 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ReservationServiceClient interface {
+	Reserve(ctx context.Context, in *ReserveRequest, opts ...grpc.CallOption) (*ReserveResponse, error)
 	Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -40,6 +42,16 @@ func NewReservationServiceClient(cc grpc.ClientConnInterface) ReservationService
 	return &reservationServiceClient{cc}
 }
 
+func (c *reservationServiceClient) Reserve(ctx context.Context, in *ReserveRequest, opts ...grpc.CallOption) (*ReserveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveResponse)
+	err := c.cc.Invoke(ctx, ReservationService_Reserve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reservationServiceClient) Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -64,6 +76,7 @@ func (c *reservationServiceClient) Rollback(ctx context.Context, in *RollbackReq
 // All implementations must embed UnimplementedReservationServiceServer
 // for forward compatibility.
 type ReservationServiceServer interface {
+	Reserve(context.Context, *ReserveRequest) (*ReserveResponse, error)
 	Commit(context.Context, *CommitRequest) (*emptypb.Empty, error)
 	Rollback(context.Context, *RollbackRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedReservationServiceServer()
@@ -76,6 +89,9 @@ type ReservationServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedReservationServiceServer struct{}
 
+func (UnimplementedReservationServiceServer) Reserve(context.Context, *ReserveRequest) (*ReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reserve not implemented")
+}
 func (UnimplementedReservationServiceServer) Commit(context.Context, *CommitRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
 }
@@ -103,6 +119,24 @@ func RegisterReservationServiceServer(s grpc.ServiceRegistrar, srv ReservationSe
 	s.RegisterService(&ReservationService_ServiceDesc, srv)
 }
 
+func _ReservationService_Reserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).Reserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_Reserve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).Reserve(ctx, req.(*ReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReservationService_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CommitRequest)
 	if err := dec(in); err != nil {
@@ -146,6 +180,10 @@ var ReservationService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "reservation.v1.ReservationService",
 	HandlerType: (*ReservationServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Reserve",
+			Handler:    _ReservationService_Reserve_Handler,
+		},
 		{
 			MethodName: "Commit",
 			Handler:    _ReservationService_Commit_Handler,
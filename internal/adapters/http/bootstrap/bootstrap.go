@@ -1,6 +1,8 @@
 package bootstrap
 
 import (
+	"time"
+
 	"github.com/MAD-py/pandora-core/internal/adapters/persistence"
 	"github.com/MAD-py/pandora-core/internal/ports"
 	"github.com/MAD-py/pandora-core/internal/validator"
@@ -11,20 +13,25 @@ type Dependencies struct {
 
 	TokenProvider ports.TokenProvider
 
-	Repositories    persistence.Repositories
-	CredentialsRepo ports.CredentialsRepository
+	Repositories persistence.Repositories
+
+	APIKeyPrefix string
+
+	APIKeyMaxGracePeriod time.Duration
 }
 
 func NewDependencies(
 	validator validator.Validator,
 	repositories persistence.Repositories,
 	tokenProvider ports.TokenProvider,
-	credentialsRepo ports.CredentialsRepository,
+	apiKeyPrefix string,
+	apiKeyMaxGracePeriod time.Duration,
 ) *Dependencies {
 	return &Dependencies{
-		Validator:       validator,
-		Repositories:    repositories,
-		TokenProvider:   tokenProvider,
-		CredentialsRepo: credentialsRepo,
+		Validator:            validator,
+		Repositories:         repositories,
+		TokenProvider:        tokenProvider,
+		APIKeyPrefix:         apiKeyPrefix,
+		APIKeyMaxGracePeriod: apiKeyMaxGracePeriod,
 	}
 }
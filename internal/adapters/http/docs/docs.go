@@ -24,14 +24,86 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/api-keys": {
+        "/api/v1/admin-users": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Fetches a paginated list of admin panel users, optionally filtered by role and status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Users"
+                ],
+                "summary": "Retrieves all admin users",
+                "parameters": [
+                    {
+                        "enum": [
+                            "owner",
+                            "operator",
+                            "viewer",
+                            "billing"
+                        ],
+                        "type": "string",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "enabled",
+                            "disabled"
+                        ],
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Page-dto_AdminUserResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
             "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Generates an API Key for a specific environment",
+                "description": "Adds a new admin panel user with the given role. The user must change the password on first login",
                 "consumes": [
                     "application/json"
                 ],
@@ -39,17 +111,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "API Keys"
+                    "Admin Users"
                 ],
-                "summary": "Creates a new API Key",
+                "summary": "Creates a new admin user",
                 "parameters": [
                     {
-                        "description": "API Key creation data",
+                        "description": "Admin user data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.APIKeyCreate"
+                            "$ref": "#/definitions/dto.AdminUserCreate"
                         }
                     }
                 ],
@@ -57,7 +129,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.APIKeyResponse"
+                            "$ref": "#/definitions/dto.AdminUserResponse"
                         }
                     },
                     "default": {
@@ -69,14 +141,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/api-keys/{id}": {
-            "delete": {
+        "/api/v1/admin-users/{id}/disable": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes a specific API key by ID",
+                "description": "Disables a specific admin user by ID. The last enabled owner cannot be disabled",
                 "consumes": [
                     "application/json"
                 ],
@@ -84,13 +156,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "API Keys"
+                    "Admin Users"
                 ],
-                "summary": "Deletes an API key",
+                "summary": "Disables an admin user",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "API Key ID",
+                        "description": "Admin User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -107,14 +179,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/api/v1/analytics/usage": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Modifies the expiration date of a specific API key by ID",
+                "description": "Counts the requests and units consumed per hour, day or month, optionally grouped by client, project, environment, service, API key, execution status or unauthorized reason. Usage is read from rollups refreshed by the TaskEngine every few minutes.",
                 "consumes": [
                     "application/json"
                 ],
@@ -122,32 +196,91 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "API Keys"
+                    "Analytics"
                 ],
-                "summary": "Updates an API key",
+                "summary": "Retrieves request usage over time",
                 "parameters": [
                     {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "API Key ID",
-                        "name": "id",
-                        "in": "path",
+                        "name": "api_key_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "client_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "environment_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "from",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Updated API key data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.APIKeyUpdate"
-                        }
+                        "enum": [
+                            "hour",
+                            "day",
+                            "month"
+                        ],
+                        "type": "string",
+                        "name": "granularity",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "client",
+                                "project",
+                                "environment",
+                                "service",
+                                "api_key",
+                                "execution_status",
+                                "unauthorized_reason"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "service_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.APIKeyResponse"
+                            "$ref": "#/definitions/dto.UsageResponse"
                         }
                     },
                     "default": {
@@ -159,36 +292,118 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/api-keys/{id}/disable": {
-            "post": {
+        "/api/v1/analytics/usage/export": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Disables a specific API key by ID",
+                "description": "Streams the same usage buckets as the usage report, as CSV or NDJSON with one bucket per line, e.g. grouped by client or project for a monthly statement. Unlike the report, the range is not limited in buckets.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "API Keys"
+                    "Analytics"
                 ],
-                "summary": "Disables an API key",
+                "summary": "Exports request usage over time",
                 "parameters": [
                     {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "API Key ID",
-                        "name": "id",
-                        "in": "path",
+                        "name": "api_key_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "client_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "environment_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "hour",
+                            "day",
+                            "month"
+                        ],
+                        "type": "string",
+                        "name": "granularity",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "client",
+                                "project",
+                                "environment",
+                                "service",
+                                "api_key",
+                                "execution_status",
+                                "unauthorized_reason"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "service_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "to",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "default": "ndjson",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "Usage buckets as CSV or NDJSON",
+                        "schema": {
+                            "type": "file"
+                        }
                     },
                     "default": {
                         "description": "Default error response for all failures",
@@ -199,14 +414,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/api-keys/{id}/enable": {
+        "/api/v1/api-keys": {
             "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Enables a specific API key by ID",
+                "description": "Generates an API Key for a specific environment",
                 "consumes": [
                     "application/json"
                 ],
@@ -216,19 +431,24 @@ const docTemplate = `{
                 "tags": [
                     "API Keys"
                 ],
-                "summary": "Enables an API key",
+                "summary": "Creates a new API Key",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "API Key ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "API Key creation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyCreate"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyResponse"
+                        }
                     },
                     "default": {
                         "description": "Default error response for all failures",
@@ -239,14 +459,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/api-keys/{id}/reveal/key": {
-            "get": {
+        "/api/v1/api-keys/{id}": {
+            "delete": {
                 "security": [
                     {
-                        "ScopedToken": []
+                        "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves the actual API Key value for a specific API key by ID",
+                "description": "Deletes a specific API key by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -256,7 +476,7 @@ const docTemplate = `{
                 "tags": [
                     "API Keys"
                 ],
-                "summary": "Reveals the API Key",
+                "summary": "Deletes an API key",
                 "parameters": [
                     {
                         "type": "integer",
@@ -267,11 +487,8 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.APIKeyRevealKeyResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "default": {
                         "description": "Default error response for all failures",
@@ -280,16 +497,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/auth/change-password": {
-            "post": {
+            },
+            "patch": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Allows an authenticated user to change their password.",
+                "description": "Modifies the expiration date of a specific API key by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -297,23 +512,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "API Keys"
                 ],
-                "summary": "Change password",
+                "summary": "Updates an API key",
                 "parameters": [
                     {
-                        "description": "Change password request",
-                        "name": "body",
+                        "type": "integer",
+                        "description": "API Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated API key data",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ChangePassword"
+                            "$ref": "#/definitions/dto.APIKeyUpdate"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyResponse"
+                        }
                     },
                     "default": {
                         "description": "Default error response for all failures",
@@ -324,41 +549,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/login": {
+        "/api/v1/api-keys/{id}/disable": {
             "post": {
-                "description": "Authenticates the administrator and returns a token.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Disables a specific API key by ID",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "API Keys"
                 ],
-                "summary": "Authenticate user",
+                "summary": "Disables an API key",
                 "parameters": [
                     {
-                        "minLength": 12,
-                        "type": "string",
-                        "format": "password",
-                        "name": "password",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "name": "username",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "API Key ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.AuthenticateResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "default": {
                         "description": "Default error response for all failures",
@@ -369,14 +589,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/reauthenticate": {
+        "/api/v1/api-keys/{id}/enable": {
             "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Reauthenticates the user for sensitive actions like revealing API keys.",
+                "description": "Enables a specific API key by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -384,26 +604,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "API Keys"
                 ],
-                "summary": "Reauthenticate user",
+                "summary": "Enables an API key",
                 "parameters": [
                     {
-                        "description": "Reauthentication request",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.Reauthenticate"
-                        }
+                        "type": "integer",
+                        "description": "API Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReauthenticateResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "default": {
                         "description": "Default error response for all failures",
@@ -414,14 +629,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/clients": {
+        "/api/v1/api-keys/{id}/reveal/key": {
             "get": {
                 "security": [
                     {
-                        "OAuth2Password": []
+                        "ScopedToken": []
                     }
                 ],
-                "description": "Fetches a list of clients, optionally filtered by client type",
+                "description": "Decrypts and returns the API Key value for a specific API key by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -429,28 +644,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Clients"
+                    "API Keys"
                 ],
-                "summary": "Retrieves all clients with optional filtering by type",
+                "summary": "Reveals the API Key",
                 "parameters": [
                     {
-                        "enum": [
-                            "developer",
-                            "organization"
-                        ],
-                        "type": "string",
-                        "name": "type",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "API Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.ClientResponse"
-                            }
+                            "$ref": "#/definitions/dto.APIKeyRevealKeyResponse"
                         }
                     },
                     "default": {
@@ -460,14 +670,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/api-keys/{id}/rotate": {
             "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Adds a new client to the system",
+                "description": "Creates a successor for a specific API key by ID in the same environment. The rotated key stays valid until grace_ends_at and is disabled automatically afterwards",
                 "consumes": [
                     "application/json"
                 ],
@@ -475,17 +687,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Clients"
+                    "API Keys"
                 ],
-                "summary": "Creates a new client",
+                "summary": "Rotates an API key",
                 "parameters": [
                     {
-                        "description": "Client creation data",
+                        "type": "integer",
+                        "description": "API Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "API key rotation data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ClientCreate"
+                            "$ref": "#/definitions/dto.APIKeyRotate"
                         }
                     }
                 ],
@@ -493,7 +712,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.ClientResponse"
+                            "$ref": "#/definitions/dto.APIKeyRotateResponse"
                         }
                     },
                     "default": {
@@ -505,14 +724,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/clients/{id}": {
+        "/api/v1/audit": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Fetches the details of a specific client using its ID",
+                "description": "Fetches the admin mutations recorded in the audit log, newest first, optionally filtered by actor, action, entity and creation time",
                 "consumes": [
                     "application/json"
                 ],
@@ -520,23 +739,96 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Clients"
+                    "Audit"
                 ],
-                "summary": "Retrieves a client by ID",
+                "summary": "Retrieves the audit log",
                 "parameters": [
                     {
+                        "enum": [
+                            "create",
+                            "update",
+                            "delete",
+                            "assign_service",
+                            "update_service",
+                            "remove_service",
+                            "reset_requests",
+                            "update_status",
+                            "update_retention",
+                            "enable",
+                            "disable",
+                            "rotate",
+                            "reveal_key",
+                            "change_password"
+                        ],
+                        "type": "string",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 255,
+                        "type": "string",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "client",
+                            "project",
+                            "environment",
+                            "service",
+                            "api_key",
+                            "webhook",
+                            "quota_alert",
+                            "credentials"
+                        ],
+                        "type": "string",
+                        "name": "entity",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "Client ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "name": "entity_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ClientResponse"
+                            "$ref": "#/definitions/dto.Page-dto_AuditEntryResponse"
                         }
                     },
                     "default": {
@@ -546,14 +838,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/auth/change-password": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Removes a specific client from the system using its ID",
+                "description": "Allows an authenticated user to change their password.",
                 "consumes": [
                     "application/json"
                 ],
@@ -561,16 +855,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Clients"
+                    "Authentication"
                 ],
-                "summary": "Deletes a client by ID",
+                "summary": "Change password",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Client ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Change password request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChangePassword"
+                        }
                     }
                 ],
                 "responses": {
@@ -584,44 +880,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
+            }
+        },
+        "/api/v1/auth/login": {
+            "post": {
+                "description": "Authenticates the administrator and returns a token.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
                 ],
-                "description": "Modifies client data based on the provided ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Clients"
+                    "Authentication"
                 ],
-                "summary": "Updates an existing client",
+                "summary": "Authenticate user",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Client ID",
-                        "name": "id",
-                        "in": "path",
+                        "minLength": 12,
+                        "type": "string",
+                        "format": "password",
+                        "name": "password",
+                        "in": "formData",
                         "required": true
                     },
                     {
-                        "description": "Updated client data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ClientUpdate"
-                        }
+                        "type": "string",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ClientResponse"
+                            "$ref": "#/definitions/dto.AuthenticateResponse"
                         }
                     },
                     "default": {
@@ -633,14 +927,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/clients/{id}/projects": {
-            "get": {
+        "/api/v1/auth/reauthenticate": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Fetches a list of projects associated with a given client",
+                "description": "Reauthenticates the user for sensitive actions like revealing API keys.",
                 "consumes": [
                     "application/json"
                 ],
@@ -648,26 +942,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Clients"
+                    "Authentication"
                 ],
-                "summary": "Retrieves all projects for a specific client",
+                "summary": "Reauthenticate user",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Client ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Reauthentication request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.Reauthenticate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.ProjectResponse"
-                            }
+                            "$ref": "#/definitions/dto.ReauthenticateResponse"
                         }
                     },
                     "default": {
@@ -679,14 +972,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/environments": {
-            "post": {
+        "/api/v1/clients": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Adds a new environment to the system",
+                "description": "Fetches a list of clients, optionally filtered by client type",
                 "consumes": [
                     "application/json"
                 ],
@@ -694,17 +987,78 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Environments"
+                    "Clients"
                 ],
-                "summary": "Creates a new environment",
+                "summary": "Retrieves all clients with optional filtering by type",
                 "parameters": [
                     {
-                        "description": "Environment creation data",
+                        "enum": [
+                            "developer",
+                            "organization"
+                        ],
+                        "type": "string",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Page-dto_ClientResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Adds a new client to the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Clients"
+                ],
+                "summary": "Creates a new client",
+                "parameters": [
+                    {
+                        "description": "Client creation data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.EnvironmentCreate"
+                            "$ref": "#/definitions/dto.ClientCreate"
                         }
                     }
                 ],
@@ -712,7 +1066,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.EnvironmentResponse"
+                            "$ref": "#/definitions/dto.ClientResponse"
                         }
                     },
                     "default": {
@@ -724,14 +1078,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/environments/{id}": {
+        "/api/v1/clients/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Fetches the details of a specific environment using its ID",
+                "description": "Fetches the details of a specific client using its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -739,13 +1093,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Environments"
+                    "Clients"
                 ],
-                "summary": "Retrieves an environment by ID",
+                "summary": "Retrieves a client by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Environment ID",
+                        "description": "Client ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -755,7 +1109,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.EnvironmentResponse"
+                            "$ref": "#/definitions/dto.ClientResponse"
                         }
                     },
                     "default": {
@@ -772,7 +1126,7 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Removes an environment from the system by its ID",
+                "description": "Removes a specific client from the system using its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -780,13 +1134,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Environments"
+                    "Clients"
                 ],
-                "summary": "Deletes an environment",
+                "summary": "Deletes a client by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Environment ID",
+                        "description": "Client ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -810,32 +1164,29 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Modifies the details of a specific environment by ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Modifies client data based on the provided ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Environments"
+                    "Clients"
                 ],
-                "summary": "Updates an environment",
+                "summary": "Updates an existing client",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Environment ID",
+                        "description": "Client ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated environment data",
+                        "description": "Updated client data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.EnvironmentUpdate"
+                            "$ref": "#/definitions/dto.ClientUpdate"
                         }
                     }
                 ],
@@ -843,7 +1194,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.EnvironmentResponse"
+                            "$ref": "#/definitions/dto.ClientResponse"
                         }
                     },
                     "default": {
@@ -855,14 +1206,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/environments/{id}/api-keys": {
+        "/api/v1/clients/{id}/projects": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Returns a list of API Keys associated with a specific environment",
+                "description": "Fetches a list of projects associated with a given client",
                 "consumes": [
                     "application/json"
                 ],
@@ -870,13 +1221,74 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Environments"
+                    "Clients"
                 ],
-                "summary": "Retrieves all API Keys for an environment",
+                "summary": "Retrieves all projects for a specific client",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Environment ID",
+                        "description": "Client ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Page-dto_ProjectResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/clients/{id}/tokens": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Fetches the portal tokens issued to a specific client, with only the prefix of each token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Clients"
+                ],
+                "summary": "Retrieves all portal tokens of a client",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Client ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -888,7 +1300,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.APIKeyResponse"
+                                "$ref": "#/definitions/dto.ClientTokenResponse"
                             }
                         }
                     },
@@ -899,16 +1311,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/environments/{id}/services": {
+            },
             "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Associates a service with a given environment",
+                "description": "Creates a token the client can use to call the portal API, scoped to its own projects, environments, API keys and requests. The token is only returned in this response",
                 "consumes": [
                     "application/json"
                 ],
@@ -916,32 +1326,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Environments"
+                    "Clients"
                 ],
-                "summary": "Assigns a service to an environment",
+                "summary": "Issues a portal token to a client",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Environment ID",
+                        "description": "Client ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Service data",
+                        "description": "Client token creation data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.EnvironmentService"
+                            "$ref": "#/definitions/dto.ClientTokenCreate"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.EnvironmentServiceResponse"
+                            "$ref": "#/definitions/dto.ClientTokenResponse"
                         }
                     },
                     "default": {
@@ -953,14 +1363,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/environments/{id}/services/{service_id}": {
+        "/api/v1/clients/{id}/tokens/{token_id}": {
             "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Disassociates a service from a specific environment",
+                "description": "Deletes a specific portal token of a client, rejecting any further portal request made with it",
                 "consumes": [
                     "application/json"
                 ],
@@ -968,21 +1378,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Environments"
+                    "Clients"
                 ],
-                "summary": "Removes a service from an environment",
+                "summary": "Revokes a portal token of a client",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Environment ID",
+                        "description": "Client ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "integer",
-                        "description": "Service ID",
-                        "name": "service_id",
+                        "description": "Client Token ID",
+                        "name": "token_id",
                         "in": "path",
                         "required": true
                     }
@@ -998,14 +1408,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/api/v1/environments": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Modifies the configuration of a service within a specific environment",
+                "description": "Adds a new environment to the system",
                 "consumes": [
                     "application/json"
                 ],
@@ -1015,37 +1427,23 @@ const docTemplate = `{
                 "tags": [
                     "Environments"
                 ],
-                "summary": "Updates a service assigned to an environment",
+                "summary": "Creates a new environment",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Environment ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Service ID",
-                        "name": "service_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated service configuration",
+                        "description": "Environment creation data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.EnvironmentServiceUpdate"
+                            "$ref": "#/definitions/dto.EnvironmentCreate"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.EnvironmentServiceResponse"
+                            "$ref": "#/definitions/dto.EnvironmentResponse"
                         }
                     },
                     "default": {
@@ -1057,21 +1455,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/environments/{id}/services/{service_id}/reset-requests": {
-            "post": {
+        "/api/v1/environments/{id}": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Resets the available request count for a specific service within an environment",
+                "description": "Fetches the details of a specific environment using its ID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Environments"
                 ],
-                "summary": "Resets request quota for a service in an environment",
+                "summary": "Retrieves an environment by ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1079,20 +1480,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Service ID",
-                        "name": "service_id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.EnvironmentServiceResponse"
+                            "$ref": "#/definitions/dto.EnvironmentResponse"
                         }
                     },
                     "default": {
@@ -1102,11 +1496,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/health": {
-            "get": {
-                "description": "Check the health status of the application",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Removes an environment from the system by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1114,21 +1511,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Health"
+                    "Environments"
                 ],
-                "summary": "Health Check",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.HealthCheckResponse"
-                        }
-                    },
-                    "503": {
-                        "description": "Service Unavailable",
-                        "schema": {
-                            "$ref": "#/definitions/dto.HealthCheckResponse"
-                        }
+                "summary": "Deletes an environment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Environment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     },
                     "default": {
                         "description": "Default error response for all failures",
@@ -1137,31 +1534,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/projects": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Fetches a complete list of projects in the system",
+                "description": "Modifies the details of a specific environment by ID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Environments"
+                ],
+                "summary": "Updates an environment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Environment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated environment data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.EnvironmentUpdate"
+                        }
+                    }
                 ],
-                "summary": "Retrieves all projects",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.ProjectResponse"
-                            }
+                            "$ref": "#/definitions/dto.EnvironmentResponse"
                         }
                     },
                     "default": {
@@ -1171,14 +1584,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/v1/environments/{id}/api-keys": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Adds a new project to the system",
+                "description": "Returns a list of API Keys associated with a specific environment",
                 "consumes": [
                     "application/json"
                 ],
@@ -1186,25 +1601,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Environments"
                 ],
-                "summary": "Creates a new project",
+                "summary": "Retrieves all API Keys for an environment",
                 "parameters": [
                     {
-                        "description": "Project creation data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ProjectCreate"
-                        }
+                        "type": "integer",
+                        "description": "Environment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectResponse"
+                            "$ref": "#/definitions/dto.Page-dto_APIKeyResponse"
                         }
                     },
                     "default": {
@@ -1216,14 +1647,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/projects/{id}": {
-            "get": {
+        "/api/v1/environments/{id}/services": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Fetches the details of a specific project using its ID",
+                "description": "Associates a service with a given environment",
                 "consumes": [
                     "application/json"
                 ],
@@ -1231,23 +1662,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Environments"
                 ],
-                "summary": "Retrieves a project by ID",
+                "summary": "Assigns a service to an environment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Project ID",
+                        "description": "Environment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Service data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.EnvironmentService"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectResponse"
+                            "$ref": "#/definitions/dto.EnvironmentServiceResponse"
                         }
                     },
                     "default": {
@@ -1257,14 +1697,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/environments/{id}/services/{service_id}": {
             "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Removes a specific project from the system by ID",
+                "description": "Disassociates a service from a specific environment",
                 "consumes": [
                     "application/json"
                 ],
@@ -1272,16 +1714,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Environments"
                 ],
-                "summary": "Deletes a project",
+                "summary": "Removes a service from an environment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Project ID",
+                        "description": "Environment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Service ID",
+                        "name": "service_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1302,7 +1751,7 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Modifies the details of a specific project by ID",
+                "description": "Modifies the configuration of a service within a specific environment",
                 "consumes": [
                     "application/json"
                 ],
@@ -1310,24 +1759,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Environments"
                 ],
-                "summary": "Updates a project",
+                "summary": "Updates a service assigned to an environment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Project ID",
+                        "description": "Environment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated project data",
+                        "type": "integer",
+                        "description": "Service ID",
+                        "name": "service_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated service configuration",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectUpdate"
+                            "$ref": "#/definitions/dto.EnvironmentServiceUpdate"
                         }
                     }
                 ],
@@ -1335,7 +1791,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectResponse"
+                            "$ref": "#/definitions/dto.EnvironmentServiceResponse"
                         }
                     },
                     "default": {
@@ -1347,31 +1803,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/projects/{id}/environments": {
+        "/api/v1/environments/{id}/services/{service_id}/alerts": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Fetches a list of environments associated with a given project",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Fetches the quota alert thresholds configured for a service within an environment",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Environments"
                 ],
-                "summary": "Retrieves all environments for a specific project",
+                "summary": "Retrieves the quota alerts of a service in an environment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Project ID",
+                        "description": "Environment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Service ID",
+                        "name": "service_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1380,7 +1840,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.EnvironmentResponse"
+                                "$ref": "#/definitions/dto.QuotaAlertResponse"
                             }
                         }
                     },
@@ -1391,16 +1851,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/projects/{id}/services": {
+            },
             "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Associates a service with a given project",
+                "description": "Configures a threshold, as a percentage of the quota used, that fires once per reset period",
                 "consumes": [
                     "application/json"
                 ],
@@ -1408,32 +1866,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Environments"
                 ],
-                "summary": "Assigns a service to a project",
+                "summary": "Adds a quota alert to a service in an environment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Project ID",
+                        "description": "Environment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Service assignment data",
+                        "type": "integer",
+                        "description": "Service ID",
+                        "name": "service_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Quota alert data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectService"
+                            "$ref": "#/definitions/dto.QuotaAlertCreate"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectServiceResponse"
+                            "$ref": "#/definitions/dto.QuotaAlertResponse"
                         }
                     },
                     "default": {
@@ -1445,25 +1910,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/projects/{id}/services/{service_id}": {
+        "/api/v1/environments/{id}/services/{service_id}/alerts/{alert_id}": {
             "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Disassociates a service from a specific project",
+                "description": "Removes a quota alert threshold from a service within an environment",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Environments"
                 ],
-                "summary": "Removes a service from a project",
+                "summary": "Deletes a quota alert of a service in an environment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Project ID",
+                        "description": "Environment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1474,6 +1939,13 @@ const docTemplate = `{
                         "name": "service_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quota Alert ID",
+                        "name": "alert_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1487,28 +1959,27 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/api/v1/environments/{id}/services/{service_id}/reset-requests": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Modifies the configuration of a service within a specific project",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Resets the available request count for a specific service within an environment",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Environments"
                 ],
-                "summary": "Updates a service assigned to a project",
+                "summary": "Resets request quota for a service in an environment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Project ID",
+                        "description": "Environment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1519,22 +1990,13 @@ const docTemplate = `{
                         "name": "service_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Updated service configuration",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ProjectServiceUpdate"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectServiceResponse"
+                            "$ref": "#/definitions/dto.EnvironmentServiceResponse"
                         }
                     },
                     "default": {
@@ -1546,14 +2008,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/projects/{id}/services/{service_id}/reset-requests": {
-            "post": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Resets the request quota for a specific service assigned to a project",
+        "/api/v1/health": {
+            "get": {
+                "description": "Check the health status of the application",
                 "consumes": [
                     "application/json"
                 ],
@@ -1561,31 +2018,1827 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Health"
                 ],
-                "summary": "Resets available requests for a service in a project",
-                "parameters": [
+                "summary": "Health Check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HealthCheckResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HealthCheckResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/portal/api-keys": {
+            "post": {
+                "security": [
+                    {
+                        "ClientToken": []
+                    }
+                ],
+                "description": "Adds an API key to an environment owned by the client authenticated by the token, as long as the environment is below the API key limit set for the client. The key is returned in full",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "summary": "Creates an API key in an environment of the client",
+                "parameters": [
+                    {
+                        "description": "API key creation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/portal/api-keys/{id}/rotate": {
+            "post": {
+                "security": [
+                    {
+                        "ClientToken": []
+                    }
+                ],
+                "description": "Creates a successor for an API key owned by the client authenticated by the token. The rotated key stays valid until grace_ends_at and the successor is returned in full",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "summary": "Rotates an API key of the client",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "API Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "API key rotation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyRotate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyRotateResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/portal/environments/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ClientToken": []
+                    }
+                ],
+                "description": "Fetches an environment owned by the client authenticated by the token, with the remaining quota of its services",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "summary": "Retrieves an environment of the client",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Environment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EnvironmentResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/portal/environments/{id}/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "ClientToken": []
+                    }
+                ],
+                "description": "Fetches the API keys of an environment owned by the client authenticated by the token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "summary": "Retrieves the API keys of an environment of the client",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Environment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Page-dto_APIKeyResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/portal/projects": {
+            "get": {
+                "security": [
+                    {
+                        "ClientToken": []
+                    }
+                ],
+                "description": "Fetches the projects of the client authenticated by the token, with the quota of their services",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "summary": "Retrieves the projects of the client",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Page-dto_ProjectResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/portal/projects/{id}/environments": {
+            "get": {
+                "security": [
+                    {
+                        "ClientToken": []
+                    }
+                ],
+                "description": "Fetches the environments of a project owned by the client authenticated by the token, with the remaining quota of their services",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "summary": "Retrieves the environments of a project of the client",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Page-dto_EnvironmentResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/portal/requests": {
+            "get": {
+                "security": [
+                    {
+                        "ClientToken": []
+                    }
+                ],
+                "description": "Fetches the requests made with the API keys of the client authenticated by the token, matching the given filters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "summary": "Searches the request log of the client",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "api_key_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "environment_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "success",
+                            "forwarded",
+                            "client_error",
+                            "server_error",
+                            "unauthorized",
+                            "abandoned"
+                        ],
+                        "type": "string",
+                        "name": "execution_status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_rotated",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "10.0.0.0/8",
+                        "name": "ip_address",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "GET",
+                            "HEAD",
+                            "POST",
+                            "PUT",
+                            "PATCH",
+                            "DELETE",
+                            "CONNECT",
+                            "OPTIONS",
+                            "TRACE"
+                        ],
+                        "type": "string",
+                        "name": "method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "path_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "request_time_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "request_time_to",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "service_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "request_time"
+                        ],
+                        "type": "string",
+                        "default": "created_at",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 599,
+                        "minimum": 100,
+                        "type": "integer",
+                        "name": "status_code_from",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 599,
+                        "minimum": 100,
+                        "type": "integer",
+                        "name": "status_code_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "API_KEY_INVALID",
+                            "QUOTA_EXCEEDED",
+                            "API_KEY_EXPIRED",
+                            "API_KEY_DISABLED",
+                            "SERVICE_MISMATCH",
+                            "SERVICE_DISABLED",
+                            "SERVICE_DEPRECATED",
+                            "SERVICE_NOT_ASSIGNED",
+                            "ENVIRONMENT_DISABLED",
+                            "RATE_LIMITED"
+                        ],
+                        "type": "string",
+                        "name": "unauthorized_reason",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Page-dto_RequestResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/portal/usage": {
+            "get": {
+                "security": [
+                    {
+                        "ClientToken": []
+                    }
+                ],
+                "description": "Counts the requests and units consumed by the client authenticated by the token per hour, day or month, optionally grouped by project, environment, service, API key, execution status or unauthorized reason",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "summary": "Retrieves the usage of the client over time",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "api_key_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "environment_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "hour",
+                            "day",
+                            "month"
+                        ],
+                        "type": "string",
+                        "name": "granularity",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "project",
+                                "environment",
+                                "service",
+                                "api_key",
+                                "execution_status",
+                                "unauthorized_reason"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "service_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UsageResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Fetches a complete list of projects in the system",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Retrieves all projects",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Page-dto_ProjectResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Adds a new project to the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Creates a new project",
+                "parameters": [
+                    {
+                        "description": "Project creation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Fetches the details of a specific project using its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Retrieves a project by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Removes a specific project from the system by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Deletes a project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Modifies the details of a specific project by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Updates a project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated project data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/environments": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Fetches a list of environments associated with a given project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Retrieves all environments for a specific project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Page-dto_EnvironmentResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/services": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Associates a service with a given project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Assigns a service to a project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Service assignment data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectService"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectServiceResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/services/{service_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Disassociates a service from a specific project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Removes a service from a project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Service ID",
+                        "name": "service_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Modifies the configuration of a service within a specific project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Updates a service assigned to a project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Service ID",
+                        "name": "service_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated service configuration",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectServiceUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectServiceResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/services/{service_id}/reset-requests": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Resets the request quota for a specific service assigned to a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Resets available requests for a service in a project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Service ID",
+                        "name": "service_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reset configuration",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectResetRequestResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/requests": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Fetches the requests matching the given filters across clients, projects, environments, API keys and services",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Requests"
+                ],
+                "summary": "Searches the request log",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "api_key_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "client_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "environment_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "success",
+                            "forwarded",
+                            "client_error",
+                            "server_error",
+                            "unauthorized",
+                            "abandoned"
+                        ],
+                        "type": "string",
+                        "name": "execution_status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_rotated",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "10.0.0.0/8",
+                        "name": "ip_address",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "GET",
+                            "HEAD",
+                            "POST",
+                            "PUT",
+                            "PATCH",
+                            "DELETE",
+                            "CONNECT",
+                            "OPTIONS",
+                            "TRACE"
+                        ],
+                        "type": "string",
+                        "name": "method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "path_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "request_time_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "request_time_to",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "service_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "request_time"
+                        ],
+                        "type": "string",
+                        "default": "created_at",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 599,
+                        "minimum": 100,
+                        "type": "integer",
+                        "name": "status_code_from",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 599,
+                        "minimum": 100,
+                        "type": "integer",
+                        "name": "status_code_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "API_KEY_INVALID",
+                            "QUOTA_EXCEEDED",
+                            "API_KEY_EXPIRED",
+                            "API_KEY_DISABLED",
+                            "SERVICE_MISMATCH",
+                            "SERVICE_DISABLED",
+                            "SERVICE_DEPRECATED",
+                            "SERVICE_NOT_ASSIGNED",
+                            "ENVIRONMENT_DISABLED",
+                            "RATE_LIMITED"
+                        ],
+                        "type": "string",
+                        "name": "unauthorized_reason",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Page-dto_RequestResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/requests/export": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Streams every request matching the same filters as the request search, as CSV or NDJSON with one request per line. The export is read through a database cursor, so it is not limited in size.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "Requests"
+                ],
+                "summary": "Exports the request log",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "api_key_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "client_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "environment_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "success",
+                            "forwarded",
+                            "client_error",
+                            "server_error",
+                            "unauthorized",
+                            "abandoned"
+                        ],
+                        "type": "string",
+                        "name": "execution_status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_rotated",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "10.0.0.0/8",
+                        "name": "ip_address",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "GET",
+                            "HEAD",
+                            "POST",
+                            "PUT",
+                            "PATCH",
+                            "DELETE",
+                            "CONNECT",
+                            "OPTIONS",
+                            "TRACE"
+                        ],
+                        "type": "string",
+                        "name": "method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "path_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "request_time_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "request_time_to",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "service_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "request_time"
+                        ],
+                        "type": "string",
+                        "default": "created_at",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 599,
+                        "minimum": 100,
+                        "type": "integer",
+                        "name": "status_code_from",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 599,
+                        "minimum": 100,
+                        "type": "integer",
+                        "name": "status_code_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "API_KEY_INVALID",
+                            "QUOTA_EXCEEDED",
+                            "API_KEY_EXPIRED",
+                            "API_KEY_DISABLED",
+                            "SERVICE_MISMATCH",
+                            "SERVICE_DISABLED",
+                            "SERVICE_DEPRECATED",
+                            "SERVICE_NOT_ASSIGNED",
+                            "ENVIRONMENT_DISABLED",
+                            "RATE_LIMITED"
+                        ],
+                        "type": "string",
+                        "name": "unauthorized_reason",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "default": "ndjson",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Requests as CSV or NDJSON",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/requests/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Fetches a request with its stored metadata and every request of its start point chain",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Requests"
+                ],
+                "summary": "Retrieves a request by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RequestDetailResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/services": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Fetches a list of all registered services",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Services"
+                ],
+                "summary": "Retrieves all services",
+                "parameters": [
+                    {
+                        "enum": [
+                            "enabled",
+                            "disabled",
+                            "deprecated"
+                        ],
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Page-dto_ServiceResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Adds a new service to the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Services"
+                ],
+                "summary": "Creates a new service",
+                "parameters": [
+                    {
+                        "description": "Service creation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ServiceCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ServiceResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/services/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Permanently removes a service by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Services"
+                ],
+                "summary": "Deletes a service",
+                "parameters": [
                     {
                         "type": "integer",
-                        "description": "Project ID",
+                        "description": "Service ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/services/{id}/requests": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Fetches a list of all requests associated with a specific service",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Services"
+                ],
+                "summary": "Retrieves all requests for a service",
+                "parameters": [
                     {
                         "type": "integer",
                         "description": "Service ID",
-                        "name": "service_id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Reset configuration",
+                        "enum": [
+                            "success",
+                            "forwarded",
+                            "client_error",
+                            "server_error",
+                            "unauthorized",
+                            "quota_exceeded",
+                            "abandoned"
+                        ],
+                        "type": "string",
+                        "name": "execution_status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "request_time_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "x-timezone": "utc",
+                        "name": "request_time_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Page-dto_RequestResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/services/{id}/retention": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Sets how many days the requests of a service are kept in the request log, overriding the global retention. 0 keeps them forever, null restores the global retention.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Services"
+                ],
+                "summary": "Updates the request retention of a service",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Service ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request retention of the service",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectResetRequest"
+                            "$ref": "#/definitions/dto.ServiceRetentionUpdate"
                         }
                     }
                 ],
@@ -1593,7 +3846,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectResetRequestResponse"
+                            "$ref": "#/definitions/dto.ServiceResponse"
                         }
                     },
                     "default": {
@@ -1605,14 +3858,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/services": {
-            "get": {
+        "/api/v1/services/{id}/status": {
+            "patch": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Fetches a list of all registered services",
+                "description": "Changes the current status of a specific service by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1622,27 +3875,139 @@ const docTemplate = `{
                 "tags": [
                     "Services"
                 ],
-                "summary": "Retrieves all services",
+                "summary": "Updates the status of a service",
                 "parameters": [
                     {
-                        "enum": [
-                            "enabled",
-                            "disabled",
-                            "deprecated"
-                        ],
+                        "type": "integer",
+                        "description": "Service ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New service status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ServiceStatusUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ServiceResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Fetches a list of webhooks, optionally filtered by the client or project they are subscribed to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Retrieves all webhooks",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "client_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
                         "type": "string",
-                        "name": "status",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Page-dto_WebhookResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Default error response for all failures",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Subscribes a URL to the events of a client's projects or of a single project. The secret the payloads are signed with is only returned in this response.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Creates a new webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook creation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.ServiceResponse"
-                            }
+                            "$ref": "#/definitions/dto.WebhookResponse"
                         }
                     },
                     "default": {
@@ -1652,14 +4017,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/v1/webhooks/{id}": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Adds a new service to the system",
+                "description": "Fetches the details of a specific webhook using its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1667,25 +4034,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Services"
+                    "Webhooks"
                 ],
-                "summary": "Creates a new service",
+                "summary": "Retrieves a webhook by ID",
                 "parameters": [
                     {
-                        "description": "Service creation data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ServiceCreate"
-                        }
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ServiceResponse"
+                            "$ref": "#/definitions/dto.WebhookResponse"
                         }
                     },
                     "default": {
@@ -1695,27 +4060,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/services/{id}": {
+            },
             "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Permanently removes a service by its ID",
+                "description": "Removes a specific webhook along with its deliveries",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Services"
+                    "Webhooks"
                 ],
-                "summary": "Deletes a service",
+                "summary": "Deletes a webhook by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Service ID",
+                        "description": "Webhook ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1732,70 +4098,44 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/services/{id}/requests": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Fetches a list of all requests associated with a specific service",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Modifies the URL, events or status of a webhook. Deliveries of a disabled webhook are kept until it is enabled again.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Services"
+                    "Webhooks"
                 ],
-                "summary": "Retrieves all requests for a service",
+                "summary": "Updates an existing webhook",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Service ID",
+                        "description": "Webhook ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "enum": [
-                            "success",
-                            "forwarded",
-                            "client_error",
-                            "server_error",
-                            "unauthorized",
-                            "quota_exceeded"
-                        ],
-                        "type": "string",
-                        "name": "execution_status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "format": "date-time",
-                        "x-timezone": "utc",
-                        "name": "request_time_from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "format": "date-time",
-                        "x-timezone": "utc",
-                        "name": "request_time_to",
-                        "in": "query"
+                        "description": "Updated webhook data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookUpdate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.RequestResponse"
-                            }
+                            "$ref": "#/definitions/dto.WebhookResponse"
                         }
                     },
                     "default": {
@@ -1807,14 +4147,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/services/{id}/status": {
-            "patch": {
+        "/api/v1/webhooks/{id}/deliveries": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Changes the current status of a specific service by ID",
+                "description": "Fetches the delivery log of a webhook, newest first, optionally filtered by status",
                 "consumes": [
                     "application/json"
                 ],
@@ -1822,32 +4162,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Services"
+                    "Webhooks"
                 ],
-                "summary": "Updates the status of a service",
+                "summary": "Retrieves the deliveries of a webhook",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Service ID",
+                        "description": "Webhook ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "New service status",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ServiceStatusUpdate"
-                        }
+                        "enum": [
+                            "pending",
+                            "delivered",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ServiceResponse"
+                            "$ref": "#/definitions/dto.Page-dto_WebhookDeliveryResponse"
                         }
                     },
                     "default": {
@@ -1875,6 +4234,33 @@ const docTemplate = `{
                     "type": "string",
                     "format": "date-time",
                     "x-timezone": "utc"
+                },
+                "rate_limit": {
+                    "$ref": "#/definitions/dto.APIKeyRateLimit"
+                }
+            }
+        },
+        "dto.APIKeyRateLimit": {
+            "type": "object",
+            "required": [
+                "requests"
+            ],
+            "properties": {
+                "burst": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "period": {
+                    "type": "string",
+                    "default": "second",
+                    "enum": [
+                        "second",
+                        "minute"
+                    ]
+                },
+                "requests": {
+                    "type": "integer",
+                    "minimum": 0
                 }
             }
         },
@@ -1902,21 +4288,30 @@ const docTemplate = `{
                     "format": "date-time",
                     "x-timezone": "utc"
                 },
+                "grace_ends_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
                 "id": {
                     "type": "integer",
                     "minimum": 1
                 },
                 "key": {
                     "type": "string",
-                    "maxLength": 11,
-                    "minLength": 11,
-                    "example": "xxxx...xxxx"
+                    "example": "pdr_live_xxxx..."
                 },
                 "last_used": {
                     "type": "string",
                     "format": "date-time",
                     "x-timezone": "utc"
                 },
+                "rate_limit": {
+                    "$ref": "#/definitions/dto.APIKeyRateLimit"
+                },
+                "rotated_from_id": {
+                    "type": "integer"
+                },
                 "status": {
                     "type": "string",
                     "enum": [
@@ -1939,6 +4334,39 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.APIKeyRotate": {
+            "type": "object",
+            "required": [
+                "grace_ends_at"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "grace_ends_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                }
+            }
+        },
+        "dto.APIKeyRotateResponse": {
+            "type": "object",
+            "required": [
+                "api_key",
+                "rotated_api_key"
+            ],
+            "properties": {
+                "api_key": {
+                    "$ref": "#/definitions/dto.APIKeyResponse"
+                },
+                "rotated_api_key": {
+                    "$ref": "#/definitions/dto.APIKeyResponse"
+                }
+            }
+        },
         "dto.APIKeyUpdate": {
             "type": "object",
             "properties": {
@@ -1946,6 +4374,150 @@ const docTemplate = `{
                     "type": "string",
                     "format": "date-time",
                     "x-timezone": "utc"
+                },
+                "rate_limit": {
+                    "$ref": "#/definitions/dto.APIKeyRateLimit"
+                }
+            }
+        },
+        "dto.AdminUserCreate": {
+            "type": "object",
+            "required": [
+                "password",
+                "role",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "format": "password",
+                    "minLength": 12
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "operator",
+                        "viewer",
+                        "billing"
+                    ]
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.AdminUserResponse": {
+            "type": "object",
+            "required": [
+                "created_at",
+                "force_password_reset",
+                "id",
+                "role",
+                "status",
+                "username"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "force_password_reset": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "operator",
+                        "viewer",
+                        "billing"
+                    ]
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "enabled",
+                        "disabled"
+                    ]
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AuditEntryResponse": {
+            "type": "object",
+            "required": [
+                "action",
+                "actor",
+                "created_at",
+                "entity",
+                "id"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "create",
+                        "update",
+                        "delete",
+                        "assign_service",
+                        "update_service",
+                        "remove_service",
+                        "reset_requests",
+                        "update_status",
+                        "update_retention",
+                        "enable",
+                        "disable",
+                        "rotate",
+                        "reveal_key",
+                        "change_password"
+                    ]
+                },
+                "actor": {
+                    "type": "string"
+                },
+                "after": {
+                    "type": "object"
+                },
+                "before": {
+                    "type": "object"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "entity": {
+                    "type": "string",
+                    "enum": [
+                        "client",
+                        "project",
+                        "environment",
+                        "service",
+                        "api_key",
+                        "webhook",
+                        "quota_alert",
+                        "credentials"
+                    ]
+                },
+                "entity_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "ip": {
+                    "type": "string"
                 }
             }
         },
@@ -2033,6 +4605,10 @@ const docTemplate = `{
                 "type"
             ],
             "properties": {
+                "api_key_limit": {
+                    "type": "integer",
+                    "minimum": 0
+                },
                 "email": {
                     "type": "string",
                     "format": "email"
@@ -2059,6 +4635,10 @@ const docTemplate = `{
                 "type"
             ],
             "properties": {
+                "api_key_limit": {
+                    "type": "integer",
+                    "minimum": 0
+                },
                 "created_at": {
                     "type": "string",
                     "format": "date-time",
@@ -2084,9 +4664,72 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.ClientTokenCreate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.ClientTokenResponse": {
+            "type": "object",
+            "required": [
+                "client_id",
+                "created_at",
+                "id",
+                "name",
+                "token"
+            ],
+            "properties": {
+                "client_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "last_used": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "pct_xxxxxxxx..."
+                }
+            }
+        },
         "dto.ClientUpdate": {
             "type": "object",
             "properties": {
+                "api_key_limit": {
+                    "type": "integer",
+                    "minimum": 0
+                },
                 "email": {
                     "type": "string",
                     "format": "email"
@@ -2122,6 +4765,14 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/dto.EnvironmentService"
                     }
+                },
+                "type": {
+                    "type": "string",
+                    "default": "live",
+                    "enum": [
+                        "live",
+                        "test"
+                    ]
                 }
             }
         },
@@ -2132,7 +4783,8 @@ const docTemplate = `{
                 "id",
                 "name",
                 "project_id",
-                "status"
+                "status",
+                "type"
             ],
             "properties": {
                 "created_at": {
@@ -2164,127 +4816,344 @@ const docTemplate = `{
                         "disabled",
                         "deprecated"
                     ]
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "live",
+                        "test"
+                    ]
+                }
+            }
+        },
+        "dto.EnvironmentService": {
+            "type": "object",
+            "required": [
+                "id",
+                "max_requests"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "max_requests": {
+                    "type": "integer",
+                    "minimum": -1
+                }
+            }
+        },
+        "dto.EnvironmentServiceReset": {
+            "type": "object",
+            "required": [
+                "id",
+                "name",
+                "status"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "name": {
+                    "type": "string"
+                },
+                "service": {
+                    "$ref": "#/definitions/dto.EnvironmentServiceResponse"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "enabled",
+                        "disabled",
+                        "deprecated"
+                    ]
+                }
+            }
+        },
+        "dto.EnvironmentServiceResponse": {
+            "type": "object",
+            "required": [
+                "assigned_at",
+                "available_requests",
+                "id",
+                "max_requests",
+                "name",
+                "version"
+            ],
+            "properties": {
+                "assigned_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "available_requests": {
+                    "type": "integer",
+                    "minimum": -1
+                },
+                "id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "max_requests": {
+                    "type": "integer",
+                    "minimum": -1
+                },
+                "name": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string",
+                    "maxLength": 25
+                }
+            }
+        },
+        "dto.EnvironmentServiceUpdate": {
+            "type": "object",
+            "required": [
+                "max_requests"
+            ],
+            "properties": {
+                "max_requests": {
+                    "type": "integer",
+                    "minimum": -1
+                }
+            }
+        },
+        "dto.EnvironmentUpdate": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.HealthCheckResponse": {
+            "type": "object",
+            "required": [
+                "check",
+                "status",
+                "timestamp"
+            ],
+            "properties": {
+                "check": {
+                    "$ref": "#/definitions/dto.CheckResponse"
+                },
+                "status": {
+                    "$ref": "#/definitions/enums.HealthStatus"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.Page-dto_APIKeyResponse": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.APIKeyResponse"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.Page-dto_AdminUserResponse": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AdminUserResponse"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.Page-dto_AuditEntryResponse": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AuditEntryResponse"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.Page-dto_ClientResponse": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ClientResponse"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer",
+                    "minimum": 0
                 }
             }
         },
-        "dto.EnvironmentService": {
+        "dto.Page-dto_EnvironmentResponse": {
             "type": "object",
             "required": [
-                "id",
-                "max_requests"
+                "items"
             ],
             "properties": {
-                "id": {
-                    "type": "integer",
-                    "minimum": 1
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EnvironmentResponse"
+                    }
                 },
-                "max_requests": {
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
                     "type": "integer",
-                    "minimum": -1
+                    "minimum": 0
                 }
             }
         },
-        "dto.EnvironmentServiceReset": {
+        "dto.Page-dto_ProjectResponse": {
             "type": "object",
             "required": [
-                "id",
-                "name",
-                "status"
+                "items"
             ],
             "properties": {
-                "id": {
-                    "type": "integer",
-                    "minimum": 1
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProjectResponse"
+                    }
                 },
-                "name": {
+                "next_cursor": {
                     "type": "string"
                 },
-                "service": {
-                    "$ref": "#/definitions/dto.EnvironmentServiceResponse"
-                },
-                "status": {
-                    "type": "string",
-                    "enum": [
-                        "enabled",
-                        "disabled",
-                        "deprecated"
-                    ]
+                "total": {
+                    "type": "integer",
+                    "minimum": 0
                 }
             }
         },
-        "dto.EnvironmentServiceResponse": {
+        "dto.Page-dto_RequestResponse": {
             "type": "object",
             "required": [
-                "assigned_at",
-                "available_requests",
-                "id",
-                "max_requests",
-                "name",
-                "version"
+                "items"
             ],
             "properties": {
-                "assigned_at": {
-                    "type": "string",
-                    "format": "date-time",
-                    "x-timezone": "utc"
-                },
-                "available_requests": {
-                    "type": "integer",
-                    "minimum": -1
-                },
-                "id": {
-                    "type": "integer",
-                    "minimum": 1
-                },
-                "max_requests": {
-                    "type": "integer",
-                    "minimum": -1
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RequestResponse"
+                    }
                 },
-                "name": {
+                "next_cursor": {
                     "type": "string"
                 },
-                "version": {
-                    "type": "string",
-                    "maxLength": 25
+                "total": {
+                    "type": "integer",
+                    "minimum": 0
                 }
             }
         },
-        "dto.EnvironmentServiceUpdate": {
+        "dto.Page-dto_ServiceResponse": {
             "type": "object",
             "required": [
-                "max_requests"
+                "items"
             ],
             "properties": {
-                "max_requests": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ServiceResponse"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
                     "type": "integer",
-                    "minimum": -1
+                    "minimum": 0
                 }
             }
         },
-        "dto.EnvironmentUpdate": {
+        "dto.Page-dto_WebhookDeliveryResponse": {
             "type": "object",
+            "required": [
+                "items"
+            ],
             "properties": {
-                "name": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WebhookDeliveryResponse"
+                    }
+                },
+                "next_cursor": {
                     "type": "string"
+                },
+                "total": {
+                    "type": "integer",
+                    "minimum": 0
                 }
             }
         },
-        "dto.HealthCheckResponse": {
+        "dto.Page-dto_WebhookResponse": {
             "type": "object",
             "required": [
-                "check",
-                "status",
-                "timestamp"
+                "items"
             ],
             "properties": {
-                "check": {
-                    "$ref": "#/definitions/dto.CheckResponse"
-                },
-                "status": {
-                    "$ref": "#/definitions/enums.HealthStatus"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WebhookResponse"
+                    }
                 },
-                "timestamp": {
+                "next_cursor": {
                     "type": "string"
+                },
+                "total": {
+                    "type": "integer",
+                    "minimum": 0
                 }
             }
         },
@@ -2415,6 +5284,7 @@ const docTemplate = `{
             "type": "object",
             "required": [
                 "assigned_at",
+                "available_requests",
                 "id",
                 "max_requests",
                 "name",
@@ -2428,6 +5298,10 @@ const docTemplate = `{
                     "format": "date-time",
                     "x-timezone": "utc"
                 },
+                "available_requests": {
+                    "type": "integer",
+                    "minimum": -1
+                },
                 "id": {
                     "type": "integer",
                     "minimum": 1
@@ -2490,6 +5364,74 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.QuotaAlertCreate": {
+            "type": "object",
+            "required": [
+                "level",
+                "threshold"
+            ],
+            "properties": {
+                "level": {
+                    "type": "string",
+                    "enum": [
+                        "environment",
+                        "project"
+                    ]
+                },
+                "threshold": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                }
+            }
+        },
+        "dto.QuotaAlertResponse": {
+            "type": "object",
+            "required": [
+                "created_at",
+                "environment_id",
+                "id",
+                "level",
+                "service_id",
+                "threshold"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "environment_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "level": {
+                    "type": "string",
+                    "enum": [
+                        "environment",
+                        "project"
+                    ]
+                },
+                "service_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "threshold": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "triggered_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                }
+            }
+        },
         "dto.Reauthenticate": {
             "type": "object",
             "required": [
@@ -2549,6 +5491,117 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.RequestDetailResponse": {
+            "type": "object",
+            "required": [
+                "api_key",
+                "chain",
+                "created_at",
+                "execution_status",
+                "id",
+                "ip_address",
+                "method",
+                "path",
+                "request_time",
+                "service"
+            ],
+            "properties": {
+                "api_key": {
+                    "$ref": "#/definitions/dto.RequestAPIKeyResponse"
+                },
+                "chain": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RequestResponse"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "environment": {
+                    "$ref": "#/definitions/dto.RequestEnvironmentResponse"
+                },
+                "execution_status": {
+                    "type": "string",
+                    "enum": [
+                        "success",
+                        "forwarded",
+                        "client_error",
+                        "server_error",
+                        "unauthorized",
+                        "quota_exceeded",
+                        "abandoned"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "ip_address": {
+                    "type": "string",
+                    "format": "ipv4,ipv6"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/dto.RequestMetadataResponse"
+                },
+                "method": {
+                    "type": "string",
+                    "enum": [
+                        "GET",
+                        "HEAD",
+                        "POST",
+                        "PUT",
+                        "PATCH",
+                        "DELETE",
+                        "CONNECT",
+                        "OPTIONS",
+                        "TRACE"
+                    ]
+                },
+                "path": {
+                    "type": "string"
+                },
+                "project": {
+                    "$ref": "#/definitions/dto.RequestProjectResponse"
+                },
+                "request_time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "service": {
+                    "$ref": "#/definitions/dto.RequestServiceResponse"
+                },
+                "start_point": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "unauthorized_reason": {
+                    "type": "string",
+                    "enum": [
+                        "API_KEY_INVALID",
+                        "QUOTA_EXCEEDED",
+                        "API_KEY_EXPIRED",
+                        "API_KEY_DISABLED",
+                        "SERVICE_MISMATCH",
+                        "ENVIRONMENT_MISMATCH",
+                        "ENVIRONMENT_DISABLED",
+                        "RATE_LIMITED"
+                    ]
+                },
+                "units": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
         "dto.RequestEnvironmentResponse": {
             "type": "object",
             "required": [
@@ -2565,6 +5618,35 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.RequestMetadataResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "body_content_type": {
+                    "type": "string",
+                    "enum": [
+                        "application/xml",
+                        "application/json",
+                        "text/plain",
+                        "text/html",
+                        "multipart/form-data",
+                        "application/x-www-form-urlencoded",
+                        "application/octet-stream"
+                    ]
+                },
+                "cookies": {
+                    "type": "string"
+                },
+                "headers": {
+                    "type": "string"
+                },
+                "query_params": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.RequestProjectResponse": {
             "type": "object",
             "required": [
@@ -2603,6 +5685,9 @@ const docTemplate = `{
                     "format": "date-time",
                     "x-timezone": "utc"
                 },
+                "detail": {
+                    "type": "string"
+                },
                 "environment": {
                     "$ref": "#/definitions/dto.RequestEnvironmentResponse"
                 },
@@ -2614,7 +5699,8 @@ const docTemplate = `{
                         "client_error",
                         "server_error",
                         "unauthorized",
-                        "quota_exceeded"
+                        "quota_exceeded",
+                        "abandoned"
                     ]
                 },
                 "id": {
@@ -2668,8 +5754,13 @@ const docTemplate = `{
                         "API_KEY_DISABLED",
                         "SERVICE_MISMATCH",
                         "ENVIRONMENT_MISMATCH",
-                        "ENVIRONMENT_DISABLED"
+                        "ENVIRONMENT_DISABLED",
+                        "RATE_LIMITED"
                     ]
+                },
+                "units": {
+                    "type": "integer",
+                    "minimum": 0
                 }
             }
         },
@@ -2731,6 +5822,11 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "request_retention_days": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "x-nullable": true
+                },
                 "status": {
                     "type": "string",
                     "enum": [
@@ -2745,6 +5841,17 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.ServiceRetentionUpdate": {
+            "type": "object",
+            "properties": {
+                "retention_days": {
+                    "type": "integer",
+                    "maximum": 36500,
+                    "minimum": 0,
+                    "x-nullable": true
+                }
+            }
+        },
         "dto.ServiceStatusUpdate": {
             "type": "object",
             "required": [
@@ -2761,6 +5868,301 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UsageBucketResponse": {
+            "type": "object",
+            "required": [
+                "bucket",
+                "requests",
+                "units"
+            ],
+            "properties": {
+                "api_key_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "bucket": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "client_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "environment_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "execution_status": {
+                    "type": "string",
+                    "enum": [
+                        "success",
+                        "forwarded",
+                        "client_error",
+                        "server_error",
+                        "unauthorized",
+                        "abandoned"
+                    ]
+                },
+                "project_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "requests": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "service_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "unauthorized_reason": {
+                    "type": "string",
+                    "enum": [
+                        "API_KEY_INVALID",
+                        "QUOTA_EXCEEDED",
+                        "API_KEY_EXPIRED",
+                        "API_KEY_DISABLED",
+                        "SERVICE_MISMATCH",
+                        "SERVICE_DISABLED",
+                        "SERVICE_DEPRECATED",
+                        "SERVICE_NOT_ASSIGNED",
+                        "ENVIRONMENT_DISABLED",
+                        "RATE_LIMITED"
+                    ]
+                },
+                "units": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.UsageResponse": {
+            "type": "object",
+            "required": [
+                "buckets",
+                "from",
+                "granularity",
+                "to"
+            ],
+            "properties": {
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.UsageBucketResponse"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "granularity": {
+                    "type": "string",
+                    "enum": [
+                        "hour",
+                        "day",
+                        "month"
+                    ]
+                },
+                "to": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                }
+            }
+        },
+        "dto.WebhookCreate": {
+            "type": "object",
+            "required": [
+                "events",
+                "url"
+            ],
+            "properties": {
+                "client_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "enum": [
+                            "quota.threshold_reached",
+                            "quota.exhausted",
+                            "quota.reset",
+                            "api_key.expired",
+                            "api_key.disabled",
+                            "quota.alert_triggered"
+                        ]
+                    }
+                },
+                "project_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "url": {
+                    "type": "string",
+                    "format": "uri"
+                }
+            }
+        },
+        "dto.WebhookDeliveryResponse": {
+            "type": "object",
+            "required": [
+                "attempts",
+                "created_at",
+                "event",
+                "id",
+                "payload",
+                "status",
+                "webhook_id"
+            ],
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "delivered_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "event": {
+                    "type": "string",
+                    "enum": [
+                        "quota.threshold_reached",
+                        "quota.exhausted",
+                        "quota.reset",
+                        "api_key.expired",
+                        "api_key.disabled",
+                        "quota.alert_triggered"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "last_status_code": {
+                    "type": "integer"
+                },
+                "next_attempt_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "delivered",
+                        "failed"
+                    ]
+                },
+                "webhook_id": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "dto.WebhookResponse": {
+            "type": "object",
+            "required": [
+                "created_at",
+                "events",
+                "id",
+                "status",
+                "url"
+            ],
+            "properties": {
+                "client_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-timezone": "utc"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "enum": [
+                            "quota.threshold_reached",
+                            "quota.exhausted",
+                            "quota.reset",
+                            "api_key.expired",
+                            "api_key.disabled",
+                            "quota.alert_triggered"
+                        ]
+                    }
+                },
+                "id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "project_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "enabled",
+                        "disabled"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "format": "uri"
+                }
+            }
+        },
+        "dto.WebhookUpdate": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "enum": [
+                            "quota.threshold_reached",
+                            "quota.exhausted",
+                            "quota.reset",
+                            "api_key.expired",
+                            "api_key.disabled",
+                            "quota.alert_triggered"
+                        ]
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "enabled",
+                        "disabled"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "format": "uri"
+                }
+            }
+        },
         "enums.HealthStatus": {
             "type": "string",
             "enum": [
@@ -2826,6 +6228,11 @@ const docTemplate = `{
         }
     },
     "securityDefinitions": {
+        "ClientToken": {
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        },
         "OAuth2Password": {
             "type": "oauth2",
             "flow": "password",
@@ -2858,6 +6265,24 @@ const docTemplate = `{
         },
         {
             "name": "API Keys"
+        },
+        {
+            "name": "Requests"
+        },
+        {
+            "name": "Analytics"
+        },
+        {
+            "name": "Webhooks"
+        },
+        {
+            "name": "Audit"
+        },
+        {
+            "name": "Admin Users"
+        },
+        {
+            "name": "Portal"
         }
     ]
 }`
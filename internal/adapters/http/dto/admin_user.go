@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// ... Requests ...
+
+type AdminUserFilter struct {
+	Role string `form:"role" enums:"owner,operator,viewer,billing"`
+
+	Status string `form:"status" enums:"enabled,disabled"`
+}
+
+func (a *AdminUserFilter) ToDomain() *dto.AdminUserFilter {
+	return &dto.AdminUserFilter{
+		Role:   enums.AdminRole(a.Role),
+		Status: enums.CredentialsStatus(a.Status),
+	}
+}
+
+type AdminUserCreate struct {
+	Username string `json:"username" validate:"required" maxLength:"255"`
+
+	Password string `json:"password" validate:"required" format:"password" minLength:"12"`
+
+	Role string `json:"role" validate:"required" enums:"owner,operator,viewer,billing"`
+}
+
+func (a *AdminUserCreate) ToDomain() *dto.AdminUserCreate {
+	return &dto.AdminUserCreate{
+		Username: a.Username,
+		Password: a.Password,
+		Role:     enums.AdminRole(a.Role),
+	}
+}
+
+// ... Responses ...
+
+type AdminUserResponse struct {
+	ID int `json:"id" validate:"required" minimum:"1"`
+
+	Username string `json:"username" validate:"required"`
+
+	Role string `json:"role" validate:"required" enums:"owner,operator,viewer,billing"`
+
+	Status string `json:"status" validate:"required" enums:"enabled,disabled"`
+
+	ForcePasswordReset bool `json:"force_password_reset" validate:"required"`
+
+	CreatedAt time.Time `json:"created_at" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+}
+
+func AdminUserResponseFromDomain(user *dto.AdminUserResponse) *AdminUserResponse {
+	return &AdminUserResponse{
+		ID:                 user.ID,
+		Username:           user.Username,
+		Role:               string(user.Role),
+		Status:             string(user.Status),
+		ForcePasswordReset: user.ForcePasswordReset,
+		CreatedAt:          user.CreatedAt,
+	}
+}
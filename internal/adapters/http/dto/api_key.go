@@ -4,30 +4,84 @@ import (
 	"time"
 
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 )
 
 // ... Requests ...
 
+// APIKeyRateLimit allows requests per period, with bursts of up to burst
+// requests. A requests value of 0 removes the limit.
+type APIKeyRateLimit struct {
+	Requests int `json:"requests" validate:"required" minimum:"0"`
+
+	Period string `json:"period" enums:"second,minute" default:"second"`
+
+	Burst int `json:"burst" minimum:"0"`
+}
+
+func (a *APIKeyRateLimit) ToDomain() *dto.APIKeyRateLimit {
+	if a == nil {
+		return nil
+	}
+
+	return &dto.APIKeyRateLimit{
+		Requests: a.Requests,
+		Period:   enums.APIKeyRateLimitPeriod(a.Period),
+		Burst:    a.Burst,
+	}
+}
+
+func APIKeyRateLimitFromDomain(rateLimit *dto.APIKeyRateLimit) *APIKeyRateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+
+	return &APIKeyRateLimit{
+		Requests: rateLimit.Requests,
+		Period:   string(rateLimit.Period),
+		Burst:    rateLimit.Burst,
+	}
+}
+
 type APIKeyCreate struct {
 	ExpiresAt time.Time `json:"expires_at" format:"date-time" extensions:"x-timezone=utc"`
 
+	RateLimit *APIKeyRateLimit `json:"rate_limit"`
+
 	EnvironmentID int `json:"environment_id" validate:"required" minimum:"1"`
 }
 
 func (a *APIKeyCreate) ToDomain() *dto.APIKeyCreate {
 	return &dto.APIKeyCreate{
 		ExpiresAt:     a.ExpiresAt,
+		RateLimit:     a.RateLimit.ToDomain(),
 		EnvironmentID: a.EnvironmentID,
 	}
 }
 
 type APIKeyUpdate struct {
 	ExpiresAt time.Time `json:"expires_at" format:"date-time" extensions:"x-timezone=utc"`
+
+	RateLimit *APIKeyRateLimit `json:"rate_limit"`
 }
 
 func (a *APIKeyUpdate) ToDomain() *dto.APIKeyUpdate {
 	return &dto.APIKeyUpdate{
 		ExpiresAt: a.ExpiresAt,
+		RateLimit: a.RateLimit.ToDomain(),
+	}
+}
+
+type APIKeyRotate struct {
+	ExpiresAt time.Time `json:"expires_at" format:"date-time" extensions:"x-timezone=utc"`
+
+	GraceEndsAt time.Time `json:"grace_ends_at" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+}
+
+func (a *APIKeyRotate) ToDomain() *dto.APIKeyRotate {
+	return &dto.APIKeyRotate{
+		ExpiresAt:   a.ExpiresAt,
+		GraceEndsAt: a.GraceEndsAt,
 	}
 }
 
@@ -36,7 +90,7 @@ func (a *APIKeyUpdate) ToDomain() *dto.APIKeyUpdate {
 type APIKeyResponse struct {
 	ID int `json:"id" validate:"required" minimum:"1"`
 
-	Key string `json:"key" validate:"required" maxLength:"11" minLength:"11" example:"xxxx...xxxx"`
+	Key string `json:"key" validate:"required" example:"pdr_live_xxxx..."`
 
 	Status string `json:"status" validate:"required" enums:"enabled,disabled,deprecated"`
 
@@ -46,6 +100,12 @@ type APIKeyResponse struct {
 
 	EnvironmentID int `json:"environment_id" validate:"required" minimum:"1"`
 
+	RotatedFromID int `json:"rotated_from_id"`
+
+	GraceEndsAt time.Time `json:"grace_ends_at" format:"date-time" extensions:"x-timezone=utc"`
+
+	RateLimit *APIKeyRateLimit `json:"rate_limit"`
+
 	CreatedAt time.Time `json:"created_at" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
 }
 
@@ -57,10 +117,26 @@ func APIKeyResponseFromDomain(apiKey *dto.APIKeyResponse) *APIKeyResponse {
 		LastUsed:      apiKey.LastUsed,
 		ExpiresAt:     apiKey.ExpiresAt,
 		EnvironmentID: apiKey.EnvironmentID,
+		RotatedFromID: apiKey.RotatedFromID,
+		GraceEndsAt:   apiKey.GraceEndsAt,
+		RateLimit:     APIKeyRateLimitFromDomain(apiKey.RateLimit),
 		CreatedAt:     apiKey.CreatedAt,
 	}
 }
 
+type APIKeyRotateResponse struct {
+	APIKey *APIKeyResponse `json:"api_key" validate:"required"`
+
+	RotatedAPIKey *APIKeyResponse `json:"rotated_api_key" validate:"required"`
+}
+
+func APIKeyRotateResponseFromDomain(rotation *dto.APIKeyRotateResponse) *APIKeyRotateResponse {
+	return &APIKeyRotateResponse{
+		APIKey:        APIKeyResponseFromDomain(rotation.APIKey),
+		RotatedAPIKey: APIKeyResponseFromDomain(rotation.RotatedAPIKey),
+	}
+}
+
 type APIKeyRevealKeyResponse struct {
 	Key string `json:"key" validate:"required" example:"xxxxxxxxxxxxx"`
 }
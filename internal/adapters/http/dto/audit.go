@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// ... Requests ...
+
+type AuditFilter struct {
+	Actor string `form:"actor" maxLength:"255"`
+
+	Action string `form:"action" enums:"create,update,delete,assign_service,update_service,remove_service,reset_requests,update_status,update_retention,enable,disable,rotate,reveal_key,change_password"`
+
+	Entity string `form:"entity" enums:"client,project,environment,service,api_key,webhook,quota_alert,credentials,client_token"`
+
+	EntityID int `form:"entity_id" minimum:"1"`
+
+	CreatedFrom time.Time `form:"created_from" format:"date-time" extensions:"x-timezone=utc"`
+
+	CreatedTo time.Time `form:"created_to" format:"date-time" extensions:"x-timezone=utc"`
+}
+
+func (a *AuditFilter) ToDomain() *dto.AuditFilter {
+	return &dto.AuditFilter{
+		Actor:       a.Actor,
+		Action:      enums.AuditAction(a.Action),
+		Entity:      enums.AuditEntity(a.Entity),
+		EntityID:    a.EntityID,
+		CreatedFrom: a.CreatedFrom,
+		CreatedTo:   a.CreatedTo,
+	}
+}
+
+// ... Responses ...
+
+type AuditEntryResponse struct {
+	ID int64 `json:"id" validate:"required" minimum:"1"`
+
+	Actor string `json:"actor" validate:"required"`
+
+	Action string `json:"action" validate:"required" enums:"create,update,delete,assign_service,update_service,remove_service,reset_requests,update_status,update_retention,enable,disable,rotate,reveal_key,change_password"`
+
+	Entity string `json:"entity" validate:"required" enums:"client,project,environment,service,api_key,webhook,quota_alert,credentials,client_token"`
+
+	EntityID int `json:"entity_id,omitempty" minimum:"1"`
+
+	Before map[string]any `json:"before,omitempty" swaggertype:"object"`
+
+	After map[string]any `json:"after,omitempty" swaggertype:"object"`
+
+	IP string `json:"ip,omitempty"`
+
+	CreatedAt time.Time `json:"created_at" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+}
+
+func AuditEntryResponseFromDomain(entry *dto.AuditEntryResponse) *AuditEntryResponse {
+	return &AuditEntryResponse{
+		ID:        entry.ID,
+		Actor:     entry.Actor,
+		Action:    string(entry.Action),
+		Entity:    string(entry.Entity),
+		EntityID:  entry.EntityID,
+		Before:    entry.Before,
+		After:     entry.After,
+		IP:        entry.IP,
+		CreatedAt: entry.CreatedAt,
+	}
+}
@@ -25,13 +25,16 @@ type ClientCreate struct {
 	Name string `json:"name" validate:"required"`
 
 	Email string `json:"email" validate:"required" format:"email"`
+
+	APIKeyLimit int `json:"api_key_limit" minimum:"0"`
 }
 
 func (c *ClientCreate) ToDomain() *dto.ClientCreate {
 	return &dto.ClientCreate{
-		Type:  enums.ClientType(c.Type),
-		Name:  c.Name,
-		Email: c.Email,
+		Type:        enums.ClientType(c.Type),
+		Name:        c.Name,
+		Email:       c.Email,
+		APIKeyLimit: c.APIKeyLimit,
 	}
 }
 
@@ -41,13 +44,16 @@ type ClientUpdate struct {
 	Name string `json:"name"`
 
 	Email string `json:"email" format:"email"`
+
+	APIKeyLimit *int `json:"api_key_limit" minimum:"0"`
 }
 
 func (c *ClientUpdate) ToDomain() *dto.ClientUpdate {
 	return &dto.ClientUpdate{
-		Type:  enums.ClientType(c.Type),
-		Name:  c.Name,
-		Email: c.Email,
+		Type:        enums.ClientType(c.Type),
+		Name:        c.Name,
+		Email:       c.Email,
+		APIKeyLimit: c.APIKeyLimit,
 	}
 }
 
@@ -62,15 +68,18 @@ type ClientResponse struct {
 
 	Email string `json:"email" validate:"required" format:"email"`
 
+	APIKeyLimit int `json:"api_key_limit" minimum:"0"`
+
 	CreatedAt time.Time `json:"created_at" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
 }
 
 func ClientResponseFromDomain(client *dto.ClientResponse) *ClientResponse {
 	return &ClientResponse{
-		ID:        client.ID,
-		Type:      string(client.Type),
-		Name:      client.Name,
-		Email:     client.Email,
-		CreatedAt: client.CreatedAt,
+		ID:          client.ID,
+		Type:        string(client.Type),
+		Name:        client.Name,
+		Email:       client.Email,
+		APIKeyLimit: client.APIKeyLimit,
+		CreatedAt:   client.CreatedAt,
 	}
 }
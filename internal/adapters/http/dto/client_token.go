@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+)
+
+// ... Requests ...
+
+type ClientTokenCreate struct {
+	Name string `json:"name" validate:"required" maxLength:"255"`
+
+	ExpiresAt time.Time `json:"expires_at" format:"date-time" extensions:"x-timezone=utc"`
+}
+
+func (c *ClientTokenCreate) ToDomain() *dto.ClientTokenCreate {
+	return &dto.ClientTokenCreate{
+		Name:      c.Name,
+		ExpiresAt: c.ExpiresAt,
+	}
+}
+
+// ... Responses ...
+
+type ClientTokenResponse struct {
+	ID int `json:"id" validate:"required" minimum:"1"`
+
+	ClientID int `json:"client_id" validate:"required" minimum:"1"`
+
+	Name string `json:"name" validate:"required"`
+
+	Token string `json:"token" validate:"required" example:"pct_xxxxxxxx..."`
+
+	ExpiresAt time.Time `json:"expires_at" format:"date-time" extensions:"x-timezone=utc"`
+
+	LastUsed time.Time `json:"last_used" format:"date-time" extensions:"x-timezone=utc"`
+
+	CreatedAt time.Time `json:"created_at" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+}
+
+func ClientTokenResponseFromDomain(token *dto.ClientTokenResponse) *ClientTokenResponse {
+	return &ClientTokenResponse{
+		ID:        token.ID,
+		ClientID:  token.ClientID,
+		Name:      token.Name,
+		Token:     token.Token,
+		ExpiresAt: token.ExpiresAt,
+		LastUsed:  token.LastUsed,
+		CreatedAt: token.CreatedAt,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 )
 
 // ... Requests ...
@@ -24,6 +25,8 @@ func (e *EnvironmentService) ToDomain() *dto.EnvironmentService {
 type EnvironmentCreate struct {
 	Name string `json:"name" validate:"required"`
 
+	Type string `json:"type" enums:"live,test" default:"live"`
+
 	ProjectID int `json:"project_id" validate:"required" minimum:"1"`
 
 	Services []*EnvironmentService `json:"services"`
@@ -37,6 +40,7 @@ func (e *EnvironmentCreate) ToDomain() *dto.EnvironmentCreate {
 
 	return &dto.EnvironmentCreate{
 		Name:      e.Name,
+		Type:      enums.EnvironmentType(e.Type),
 		ProjectID: e.ProjectID,
 		Services:  services,
 	}
@@ -96,6 +100,8 @@ type EnvironmentResponse struct {
 
 	Name string `json:"name" validate:"required"`
 
+	Type string `json:"type" validate:"required" enums:"live,test"`
+
 	Status string `json:"status" validate:"required" enums:"enabled,disabled,deprecated"`
 
 	ProjectID int `json:"project_id" validate:"required" minimum:"1"`
@@ -116,6 +122,7 @@ func EnvironmentResponseFromDomain(
 	return &EnvironmentResponse{
 		ID:        env.ID,
 		Name:      env.Name,
+		Type:      string(env.Type),
 		Status:    string(env.Status),
 		ProjectID: env.ProjectID,
 		CreatedAt: env.CreatedAt,
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	ExportFormatCSV    = "csv"
+	ExportFormatNDJSON = "ndjson"
+)
+
+// ... Requests ...
+
+type Export struct {
+	Format string `form:"format" enums:"csv,ndjson" default:"ndjson"`
+}
+
+// ExportFormat returns the requested format, NDJSON when none was given.
+func (e *Export) ExportFormat() string {
+	if e.Format == "" {
+		return ExportFormatNDJSON
+	}
+	return e.Format
+}
+
+// csvID formats an optional ID as a CSV field, empty when it is not set.
+func csvID(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
+
+func csvTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
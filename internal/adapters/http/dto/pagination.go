@@ -0,0 +1,44 @@
+package dto
+
+import "github.com/MAD-py/pandora-core/internal/domain/dto"
+
+// ... Requests ...
+
+type Pagination struct {
+	Cursor string `form:"cursor"`
+
+	Limit int `form:"limit" minimum:"1" maximum:"500" default:"50"`
+
+	IncludeTotal bool `form:"include_total"`
+}
+
+func (p *Pagination) ToDomain() *dto.Pagination {
+	return &dto.Pagination{
+		Cursor:       p.Cursor,
+		Limit:        p.Limit,
+		IncludeTotal: p.IncludeTotal,
+	}
+}
+
+// ... Responses ...
+
+type Page[T any] struct {
+	Items []T `json:"items" validate:"required"`
+
+	NextCursor string `json:"next_cursor"`
+
+	Total *int `json:"total,omitempty" minimum:"0"`
+}
+
+func PageFromDomain[D, T any](page *dto.Page[D], fromDomain func(D) T) *Page[T] {
+	items := make([]T, len(page.Items))
+	for i, item := range page.Items {
+		items[i] = fromDomain(item)
+	}
+
+	return &Page[T]{
+		Items:      items,
+		NextCursor: page.NextCursor,
+		Total:      page.Total,
+	}
+}
@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// ... Requests ...
+
+// PortalRequestSearch is the RequestSearch of the admin API without the
+// client filter, which the portal always takes from the token.
+type PortalRequestSearch struct {
+	ProjectID int `form:"project_id" minimum:"1"`
+
+	EnvironmentID int `form:"environment_id" minimum:"1"`
+
+	APIKeyID int `form:"api_key_id" minimum:"1"`
+
+	IncludeRotated bool `form:"include_rotated"`
+
+	ServiceID int `form:"service_id" minimum:"1"`
+
+	PathPrefix string `form:"path_prefix"`
+
+	Method string `form:"method" enums:"GET,HEAD,POST,PUT,PATCH,DELETE,CONNECT,OPTIONS,TRACE"`
+
+	IPAddress string `form:"ip_address" example:"10.0.0.0/8"`
+
+	StatusCodeFrom int `form:"status_code_from" minimum:"100" maximum:"599"`
+
+	StatusCodeTo int `form:"status_code_to" minimum:"100" maximum:"599"`
+
+	UnauthorizedReason string `form:"unauthorized_reason" enums:"API_KEY_INVALID,QUOTA_EXCEEDED,API_KEY_EXPIRED,API_KEY_DISABLED,SERVICE_MISMATCH,SERVICE_DISABLED,SERVICE_DEPRECATED,SERVICE_NOT_ASSIGNED,ENVIRONMENT_DISABLED,RATE_LIMITED"`
+
+	ExecutionStatus string `form:"execution_status" enums:"success,forwarded,client_error,server_error,unauthorized,abandoned"`
+
+	RequestTimeFrom time.Time `form:"request_time_from" format:"date-time" extensions:"x-timezone=utc"`
+
+	RequestTimeTo time.Time `form:"request_time_to" format:"date-time" extensions:"x-timezone=utc"`
+
+	SortBy string `form:"sort_by" enums:"created_at,request_time" default:"created_at"`
+
+	SortOrder string `form:"sort_order" enums:"asc,desc" default:"desc"`
+}
+
+func (p *PortalRequestSearch) ToDomain() *dto.RequestSearch {
+	return &dto.RequestSearch{
+		ProjectID:          p.ProjectID,
+		EnvironmentID:      p.EnvironmentID,
+		APIKeyID:           p.APIKeyID,
+		IncludeRotated:     p.IncludeRotated,
+		ServiceID:          p.ServiceID,
+		PathPrefix:         p.PathPrefix,
+		Method:             p.Method,
+		IPAddress:          p.IPAddress,
+		StatusCodeFrom:     p.StatusCodeFrom,
+		StatusCodeTo:       p.StatusCodeTo,
+		UnauthorizedReason: enums.APIKeyValidationFailureCode(p.UnauthorizedReason),
+		ExecutionStatus:    enums.RequestExecutionStatus(p.ExecutionStatus),
+		RequestTimeFrom:    p.RequestTimeFrom,
+		RequestTimeTo:      p.RequestTimeTo,
+		SortBy:             enums.RequestSortField(p.SortBy),
+		SortOrder:          enums.SortOrder(p.SortOrder),
+	}
+}
+
+// PortalUsageFilter is the UsageFilter of the admin API without the client
+// filter, which the portal always takes from the token. Grouping by client
+// is left out as well, since there is only one.
+type PortalUsageFilter struct {
+	Granularity string `form:"granularity" validate:"required" enums:"hour,day,month"`
+
+	From time.Time `form:"from" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+
+	To time.Time `form:"to" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+
+	GroupBy []string `form:"group_by" collectionFormat:"multi" enums:"project,environment,service,api_key,execution_status,unauthorized_reason"`
+
+	ProjectID int `form:"project_id" minimum:"1"`
+
+	EnvironmentID int `form:"environment_id" minimum:"1"`
+
+	ServiceID int `form:"service_id" minimum:"1"`
+
+	APIKeyID int `form:"api_key_id" minimum:"1"`
+}
+
+func (p *PortalUsageFilter) ToDomain() *dto.UsageFilter {
+	var groupBy []enums.UsageGroupBy
+	for _, value := range p.GroupBy {
+		for _, g := range strings.Split(value, ",") {
+			groupBy = append(groupBy, enums.UsageGroupBy(strings.TrimSpace(g)))
+		}
+	}
+
+	return &dto.UsageFilter{
+		Granularity:   enums.UsageGranularity(p.Granularity),
+		From:          p.From,
+		To:            p.To,
+		GroupBy:       groupBy,
+		ProjectID:     p.ProjectID,
+		EnvironmentID: p.EnvironmentID,
+		ServiceID:     p.ServiceID,
+		APIKeyID:      p.APIKeyID,
+	}
+}
@@ -91,18 +91,21 @@ type ProjectServiceResponse struct {
 
 	ResetFrequency string `json:"reset_frequency" validate:"required" enums:"daily,weekly,biweekly,monthly"`
 
+	AvailableRequest int `json:"available_requests" validate:"required" minimum:"-1"`
+
 	AssignedAt time.Time `json:"assigned_at" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
 }
 
 func ProjectServiceResponseFromDomain(service *dto.ProjectServiceResponse) *ProjectServiceResponse {
 	return &ProjectServiceResponse{
-		ID:             service.ID,
-		Name:           service.Name,
-		Version:        service.Version,
-		NextReset:      service.NextReset,
-		MaxRequests:    service.MaxRequests,
-		ResetFrequency: string(service.ResetFrequency),
-		AssignedAt:     service.AssignedAt,
+		ID:               service.ID,
+		Name:             service.Name,
+		Version:          service.Version,
+		NextReset:        service.NextReset,
+		MaxRequests:      service.MaxRequests,
+		ResetFrequency:   string(service.ResetFrequency),
+		AvailableRequest: service.AvailableRequest,
+		AssignedAt:       service.AssignedAt,
 	}
 }
 
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// ... Requests ...
+
+type QuotaAlertCreate struct {
+	Level string `json:"level" validate:"required" enums:"environment,project"`
+
+	Threshold int `json:"threshold" validate:"required" minimum:"1" maximum:"100"`
+}
+
+func (q *QuotaAlertCreate) ToDomain() *dto.QuotaAlertCreate {
+	return &dto.QuotaAlertCreate{
+		Level:     enums.QuotaLevel(q.Level),
+		Threshold: q.Threshold,
+	}
+}
+
+// ... Responses ...
+
+type QuotaAlertResponse struct {
+	ID int `json:"id" validate:"required" minimum:"1"`
+
+	EnvironmentID int `json:"environment_id" validate:"required" minimum:"1"`
+
+	ServiceID int `json:"service_id" validate:"required" minimum:"1"`
+
+	Level string `json:"level" validate:"required" enums:"environment,project"`
+
+	Threshold int `json:"threshold" validate:"required" minimum:"1" maximum:"100"`
+
+	TriggeredAt time.Time `json:"triggered_at" format:"date-time" extensions:"x-timezone=utc"`
+
+	CreatedAt time.Time `json:"created_at" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+}
+
+func QuotaAlertResponseFromDomain(alert *dto.QuotaAlertResponse) *QuotaAlertResponse {
+	return &QuotaAlertResponse{
+		ID:            alert.ID,
+		EnvironmentID: alert.EnvironmentID,
+		ServiceID:     alert.ServiceID,
+		Level:         string(alert.Level),
+		Threshold:     alert.Threshold,
+		TriggeredAt:   alert.TriggeredAt,
+		CreatedAt:     alert.CreatedAt,
+	}
+}
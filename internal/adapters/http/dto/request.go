@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
@@ -14,7 +15,7 @@ type RequestFilter struct {
 
 	RequestTimeFrom time.Time `form:"request_time_from" format:"date-time" extensions:"x-timezone=utc"`
 
-	ExecutionStatus string `form:"execution_status" enums:"success,forwarded,client_error,server_error,unauthorized,quota_exceeded"`
+	ExecutionStatus string `form:"execution_status" enums:"success,forwarded,client_error,server_error,unauthorized,quota_exceeded,abandoned"`
 }
 
 func (r *RequestFilter) ToDomain() *dto.RequestFilter {
@@ -25,6 +26,64 @@ func (r *RequestFilter) ToDomain() *dto.RequestFilter {
 	}
 }
 
+type RequestSearch struct {
+	ClientID int `form:"client_id" minimum:"1"`
+
+	ProjectID int `form:"project_id" minimum:"1"`
+
+	EnvironmentID int `form:"environment_id" minimum:"1"`
+
+	APIKeyID int `form:"api_key_id" minimum:"1"`
+
+	IncludeRotated bool `form:"include_rotated"`
+
+	ServiceID int `form:"service_id" minimum:"1"`
+
+	PathPrefix string `form:"path_prefix"`
+
+	Method string `form:"method" enums:"GET,HEAD,POST,PUT,PATCH,DELETE,CONNECT,OPTIONS,TRACE"`
+
+	IPAddress string `form:"ip_address" example:"10.0.0.0/8"`
+
+	StatusCodeFrom int `form:"status_code_from" minimum:"100" maximum:"599"`
+
+	StatusCodeTo int `form:"status_code_to" minimum:"100" maximum:"599"`
+
+	UnauthorizedReason string `form:"unauthorized_reason" enums:"API_KEY_INVALID,QUOTA_EXCEEDED,API_KEY_EXPIRED,API_KEY_DISABLED,SERVICE_MISMATCH,SERVICE_DISABLED,SERVICE_DEPRECATED,SERVICE_NOT_ASSIGNED,ENVIRONMENT_DISABLED,RATE_LIMITED"`
+
+	ExecutionStatus string `form:"execution_status" enums:"success,forwarded,client_error,server_error,unauthorized,abandoned"`
+
+	RequestTimeFrom time.Time `form:"request_time_from" format:"date-time" extensions:"x-timezone=utc"`
+
+	RequestTimeTo time.Time `form:"request_time_to" format:"date-time" extensions:"x-timezone=utc"`
+
+	SortBy string `form:"sort_by" enums:"created_at,request_time" default:"created_at"`
+
+	SortOrder string `form:"sort_order" enums:"asc,desc" default:"desc"`
+}
+
+func (r *RequestSearch) ToDomain() *dto.RequestSearch {
+	return &dto.RequestSearch{
+		ClientID:           r.ClientID,
+		ProjectID:          r.ProjectID,
+		EnvironmentID:      r.EnvironmentID,
+		APIKeyID:           r.APIKeyID,
+		IncludeRotated:     r.IncludeRotated,
+		ServiceID:          r.ServiceID,
+		PathPrefix:         r.PathPrefix,
+		Method:             r.Method,
+		IPAddress:          r.IPAddress,
+		StatusCodeFrom:     r.StatusCodeFrom,
+		StatusCodeTo:       r.StatusCodeTo,
+		UnauthorizedReason: enums.APIKeyValidationFailureCode(r.UnauthorizedReason),
+		ExecutionStatus:    enums.RequestExecutionStatus(r.ExecutionStatus),
+		RequestTimeFrom:    r.RequestTimeFrom,
+		RequestTimeTo:      r.RequestTimeTo,
+		SortBy:             enums.RequestSortField(r.SortBy),
+		SortOrder:          enums.SortOrder(r.SortOrder),
+	}
+}
+
 // ... Responses ...
 
 type RequestAPIKeyResponse struct {
@@ -66,11 +125,15 @@ type RequestResponse struct {
 
 	Service *RequestServiceResponse `json:"service" validate:"required"`
 
+	Detail string `json:"detail"`
+
 	StatusCode int `json:"status_code"`
 
-	ExecutionStatus string `json:"execution_status" validate:"required" enums:"success,forwarded,client_error,server_error,unauthorized,quota_exceeded"`
+	ExecutionStatus string `json:"execution_status" validate:"required" enums:"success,forwarded,client_error,server_error,unauthorized,quota_exceeded,abandoned"`
 
-	UnauthorizedReason string `json:"unauthorized_reason" enums:"API_KEY_INVALID,QUOTA_EXCEEDED,API_KEY_EXPIRED,API_KEY_DISABLED,SERVICE_MISMATCH,ENVIRONMENT_MISMATCH,ENVIRONMENT_DISABLED"`
+	UnauthorizedReason string `json:"unauthorized_reason" enums:"API_KEY_INVALID,QUOTA_EXCEEDED,API_KEY_EXPIRED,API_KEY_DISABLED,SERVICE_MISMATCH,ENVIRONMENT_MISMATCH,ENVIRONMENT_DISABLED,RATE_LIMITED"`
+
+	Units int `json:"units" minimum:"0"`
 
 	RequestTime time.Time `json:"request_time" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
 
@@ -104,9 +167,11 @@ func RequestResponseFromDomain(request *dto.RequestResponse) *RequestResponse {
 			Name:    request.Service.Name,
 			Version: request.Service.Version,
 		},
+		Detail:             request.Detail,
 		StatusCode:         request.StatusCode,
 		ExecutionStatus:    string(request.ExecutionStatus),
 		UnauthorizedReason: string(request.UnauthorizedReason),
+		Units:              request.Units,
 		RequestTime:        request.RequestTime,
 		Path:               request.Path,
 		Method:             request.Method,
@@ -114,3 +179,84 @@ func RequestResponseFromDomain(request *dto.RequestResponse) *RequestResponse {
 		CreateAt:           request.CreatedAt,
 	}
 }
+
+// RequestCSVHeader names the columns of the records CSVRecord returns.
+var RequestCSVHeader = []string{
+	"id", "start_point", "api_key_id", "api_key", "project_id",
+	"project_name", "environment_id", "environment_name", "service_id",
+	"service_name", "service_version", "detail", "status_code",
+	"execution_status", "unauthorized_reason", "units", "request_time",
+	"path", "method", "ip_address", "created_at",
+}
+
+func (r *RequestResponse) CSVRecord() []string {
+	return []string{
+		r.ID,
+		r.StartPoint,
+		csvID(r.APIKey.ID),
+		r.APIKey.Key,
+		csvID(r.Project.ID),
+		r.Project.Name,
+		csvID(r.Environment.ID),
+		r.Environment.Name,
+		csvID(r.Service.ID),
+		r.Service.Name,
+		r.Service.Version,
+		r.Detail,
+		csvID(r.StatusCode),
+		r.ExecutionStatus,
+		r.UnauthorizedReason,
+		strconv.Itoa(r.Units),
+		csvTime(r.RequestTime),
+		r.Path,
+		r.Method,
+		r.IPAddress,
+		csvTime(r.CreateAt),
+	}
+}
+
+type RequestMetadataResponse struct {
+	Body string `json:"body"`
+
+	BodyContentType string `json:"body_content_type" enums:"application/xml,application/json,text/plain,text/html,multipart/form-data,application/x-www-form-urlencoded,application/octet-stream"`
+
+	Cookies string `json:"cookies"`
+
+	Headers string `json:"headers"`
+
+	QueryParams string `json:"query_params"`
+}
+
+type RequestDetailResponse struct {
+	RequestResponse
+
+	Metadata *RequestMetadataResponse `json:"metadata"`
+
+	Chain []*RequestResponse `json:"chain" validate:"required"`
+}
+
+func RequestDetailResponseFromDomain(
+	request *dto.RequestCompleteResponse,
+) *RequestDetailResponse {
+	chain := make([]*RequestResponse, len(request.Chain))
+	for i, link := range request.Chain {
+		chain[i] = RequestResponseFromDomain(link)
+	}
+
+	resp := &RequestDetailResponse{
+		RequestResponse: *RequestResponseFromDomain(&request.RequestResponse),
+		Chain:           chain,
+	}
+
+	if request.Metadata != nil {
+		resp.Metadata = &RequestMetadataResponse{
+			Body:            request.Metadata.Body,
+			BodyContentType: string(request.Metadata.BodyContentType),
+			Cookies:         request.Metadata.Cookies,
+			Headers:         request.Metadata.Headers,
+			QueryParams:     request.Metadata.QueryParams,
+		}
+	}
+
+	return resp
+}
@@ -36,6 +36,14 @@ type ServiceStatusUpdate struct {
 	Status string `json:"status" validate:"required" enums:"enabled,disabled,deprecated"`
 }
 
+type ServiceRetentionUpdate struct {
+	RetentionDays *int `json:"retention_days" minimum:"0" maximum:"36500" extensions:"x-nullable"`
+}
+
+func (s *ServiceRetentionUpdate) ToDomain() *dto.ServiceRetentionUpdate {
+	return &dto.ServiceRetentionUpdate{RetentionDays: s.RetentionDays}
+}
+
 // ... Responses ...
 
 type ServiceResponse struct {
@@ -48,6 +56,8 @@ type ServiceResponse struct {
 	Version string `json:"version" validate:"required" maxLength:"25"`
 
 	CreatedAt time.Time `json:"created_at" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+
+	RequestRetentionDays *int `json:"request_retention_days" minimum:"0" extensions:"x-nullable"`
 }
 
 func ServiceResponseFromDomain(service *dto.ServiceResponse) *ServiceResponse {
@@ -57,5 +67,7 @@ func ServiceResponseFromDomain(service *dto.ServiceResponse) *ServiceResponse {
 		Status:    string(service.Status),
 		Version:   service.Version,
 		CreatedAt: service.CreatedAt,
+
+		RequestRetentionDays: service.RequestRetentionDays,
 	}
 }
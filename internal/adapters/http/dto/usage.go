@@ -0,0 +1,141 @@
+package dto
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// ... Requests ...
+
+type UsageFilter struct {
+	Granularity string `form:"granularity" validate:"required" enums:"hour,day,month"`
+
+	From time.Time `form:"from" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+
+	To time.Time `form:"to" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+
+	GroupBy []string `form:"group_by" collectionFormat:"multi" enums:"client,project,environment,service,api_key,execution_status,unauthorized_reason"`
+
+	ClientID int `form:"client_id" minimum:"1"`
+
+	ProjectID int `form:"project_id" minimum:"1"`
+
+	EnvironmentID int `form:"environment_id" minimum:"1"`
+
+	ServiceID int `form:"service_id" minimum:"1"`
+
+	APIKeyID int `form:"api_key_id" minimum:"1"`
+}
+
+func (u *UsageFilter) ToDomain() *dto.UsageFilter {
+	var groupBy []enums.UsageGroupBy
+	for _, value := range u.GroupBy {
+		for _, g := range strings.Split(value, ",") {
+			groupBy = append(groupBy, enums.UsageGroupBy(strings.TrimSpace(g)))
+		}
+	}
+
+	return &dto.UsageFilter{
+		Granularity:   enums.UsageGranularity(u.Granularity),
+		From:          u.From,
+		To:            u.To,
+		GroupBy:       groupBy,
+		ClientID:      u.ClientID,
+		ProjectID:     u.ProjectID,
+		EnvironmentID: u.EnvironmentID,
+		ServiceID:     u.ServiceID,
+		APIKeyID:      u.APIKeyID,
+	}
+}
+
+// ... Responses ...
+
+type UsageBucketResponse struct {
+	Bucket time.Time `json:"bucket" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+
+	ClientID int `json:"client_id,omitempty" minimum:"1"`
+
+	ProjectID int `json:"project_id,omitempty" minimum:"1"`
+
+	EnvironmentID int `json:"environment_id,omitempty" minimum:"1"`
+
+	ServiceID int `json:"service_id,omitempty" minimum:"1"`
+
+	APIKeyID int `json:"api_key_id,omitempty" minimum:"1"`
+
+	ExecutionStatus string `json:"execution_status,omitempty" enums:"success,forwarded,client_error,server_error,unauthorized,abandoned"`
+
+	UnauthorizedReason string `json:"unauthorized_reason,omitempty" enums:"API_KEY_INVALID,QUOTA_EXCEEDED,API_KEY_EXPIRED,API_KEY_DISABLED,SERVICE_MISMATCH,SERVICE_DISABLED,SERVICE_DEPRECATED,SERVICE_NOT_ASSIGNED,ENVIRONMENT_DISABLED,RATE_LIMITED"`
+
+	Requests int `json:"requests" validate:"required" minimum:"0"`
+
+	Units int `json:"units" validate:"required" minimum:"0"`
+}
+
+// UsageCSVHeader names the columns of the records CSVRecord returns.
+// Dimensions the usage was not grouped by are left empty.
+var UsageCSVHeader = []string{
+	"bucket", "client_id", "project_id", "environment_id", "service_id",
+	"api_key_id", "execution_status", "unauthorized_reason", "requests",
+	"units",
+}
+
+func (u *UsageBucketResponse) CSVRecord() []string {
+	return []string{
+		csvTime(u.Bucket),
+		csvID(u.ClientID),
+		csvID(u.ProjectID),
+		csvID(u.EnvironmentID),
+		csvID(u.ServiceID),
+		csvID(u.APIKeyID),
+		u.ExecutionStatus,
+		u.UnauthorizedReason,
+		strconv.Itoa(u.Requests),
+		strconv.Itoa(u.Units),
+	}
+}
+
+func UsageBucketResponseFromDomain(
+	bucket *dto.UsageBucketResponse,
+) *UsageBucketResponse {
+	return &UsageBucketResponse{
+		Bucket:             bucket.Bucket,
+		ClientID:           bucket.ClientID,
+		ProjectID:          bucket.ProjectID,
+		EnvironmentID:      bucket.EnvironmentID,
+		ServiceID:          bucket.ServiceID,
+		APIKeyID:           bucket.APIKeyID,
+		ExecutionStatus:    string(bucket.ExecutionStatus),
+		UnauthorizedReason: string(bucket.UnauthorizedReason),
+		Requests:           bucket.Requests,
+		Units:              bucket.Units,
+	}
+}
+
+type UsageResponse struct {
+	Granularity string `json:"granularity" validate:"required" enums:"hour,day,month"`
+
+	From time.Time `json:"from" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+
+	To time.Time `json:"to" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+
+	Buckets []*UsageBucketResponse `json:"buckets" validate:"required"`
+}
+
+func UsageResponseFromDomain(usage *dto.UsageResponse) *UsageResponse {
+	buckets := make([]*UsageBucketResponse, len(usage.Buckets))
+	for i, bucket := range usage.Buckets {
+		buckets[i] = UsageBucketResponseFromDomain(bucket)
+	}
+
+	return &UsageResponse{
+		Granularity: string(usage.Granularity),
+		From:        usage.From,
+		To:          usage.To,
+		Buckets:     buckets,
+	}
+}
@@ -0,0 +1,161 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// ... Requests ...
+
+type WebhookFilter struct {
+	ClientID int `form:"client_id" minimum:"1"`
+
+	ProjectID int `form:"project_id" minimum:"1"`
+}
+
+func (w *WebhookFilter) ToDomain() *dto.WebhookFilter {
+	return &dto.WebhookFilter{
+		ClientID:  w.ClientID,
+		ProjectID: w.ProjectID,
+	}
+}
+
+type WebhookCreate struct {
+	ClientID int `json:"client_id" minimum:"1"`
+
+	ProjectID int `json:"project_id" minimum:"1"`
+
+	URL string `json:"url" validate:"required" format:"uri"`
+
+	Events []string `json:"events" validate:"required" enums:"quota.threshold_reached,quota.exhausted,quota.reset,api_key.expired,api_key.disabled,quota.alert_triggered"`
+}
+
+func (w *WebhookCreate) ToDomain() *dto.WebhookCreate {
+	return &dto.WebhookCreate{
+		ClientID:  w.ClientID,
+		ProjectID: w.ProjectID,
+		URL:       w.URL,
+		Events:    webhookEventTypesToDomain(w.Events),
+	}
+}
+
+type WebhookUpdate struct {
+	URL string `json:"url" format:"uri"`
+
+	Events []string `json:"events" enums:"quota.threshold_reached,quota.exhausted,quota.reset,api_key.expired,api_key.disabled,quota.alert_triggered"`
+
+	Status string `json:"status" enums:"enabled,disabled"`
+}
+
+func (w *WebhookUpdate) ToDomain() *dto.WebhookUpdate {
+	return &dto.WebhookUpdate{
+		URL:    w.URL,
+		Events: webhookEventTypesToDomain(w.Events),
+		Status: enums.WebhookStatus(w.Status),
+	}
+}
+
+type WebhookDeliveryFilter struct {
+	Status string `form:"status" enums:"pending,delivered,failed"`
+}
+
+func (w *WebhookDeliveryFilter) ToDomain() *dto.WebhookDeliveryFilter {
+	return &dto.WebhookDeliveryFilter{
+		Status: enums.WebhookDeliveryStatus(w.Status),
+	}
+}
+
+// ... Responses ...
+
+type WebhookResponse struct {
+	ID int `json:"id" validate:"required" minimum:"1"`
+
+	ClientID int `json:"client_id,omitempty" minimum:"1"`
+
+	ProjectID int `json:"project_id,omitempty" minimum:"1"`
+
+	URL string `json:"url" validate:"required" format:"uri"`
+
+	Events []string `json:"events" validate:"required" enums:"quota.threshold_reached,quota.exhausted,quota.reset,api_key.expired,api_key.disabled,quota.alert_triggered"`
+
+	Status string `json:"status" validate:"required" enums:"enabled,disabled"`
+
+	Secret string `json:"secret,omitempty"`
+
+	CreatedAt time.Time `json:"created_at" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+}
+
+func WebhookResponseFromDomain(webhook *dto.WebhookResponse) *WebhookResponse {
+	events := make([]string, len(webhook.Events))
+	for i, event := range webhook.Events {
+		events[i] = string(event)
+	}
+
+	return &WebhookResponse{
+		ID:        webhook.ID,
+		ClientID:  webhook.ClientID,
+		ProjectID: webhook.ProjectID,
+		URL:       webhook.URL,
+		Events:    events,
+		Status:    string(webhook.Status),
+		Secret:    webhook.Secret,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+type WebhookDeliveryResponse struct {
+	ID string `json:"id" validate:"required" format:"uuid"`
+
+	WebhookID int `json:"webhook_id" validate:"required" minimum:"1"`
+
+	Event string `json:"event" validate:"required" enums:"quota.threshold_reached,quota.exhausted,quota.reset,api_key.expired,api_key.disabled,quota.alert_triggered"`
+
+	Payload json.RawMessage `json:"payload" validate:"required" swaggertype:"object"`
+
+	Status string `json:"status" validate:"required" enums:"pending,delivered,failed"`
+
+	Attempts int `json:"attempts" validate:"required" minimum:"0"`
+
+	NextAttemptAt time.Time `json:"next_attempt_at" format:"date-time" extensions:"x-timezone=utc"`
+
+	LastStatusCode int `json:"last_status_code,omitempty"`
+
+	LastError string `json:"last_error,omitempty"`
+
+	DeliveredAt time.Time `json:"delivered_at" format:"date-time" extensions:"x-timezone=utc"`
+
+	CreatedAt time.Time `json:"created_at" validate:"required" format:"date-time" extensions:"x-timezone=utc"`
+}
+
+func WebhookDeliveryResponseFromDomain(
+	delivery *dto.WebhookDeliveryResponse,
+) *WebhookDeliveryResponse {
+	return &WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		Event:          string(delivery.Event),
+		Payload:        json.RawMessage(delivery.Payload),
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func webhookEventTypesToDomain(events []string) []enums.WebhookEventType {
+	if events == nil {
+		return nil
+	}
+
+	eventTypes := make([]enums.WebhookEventType, len(events))
+	for i, event := range events {
+		eventTypes[i] = enums.WebhookEventType(event)
+	}
+	return eventTypes
+}
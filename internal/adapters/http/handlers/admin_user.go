@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/http/dto"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/errors"
+	adminuser "github.com/MAD-py/pandora-core/internal/app/admin_user"
+)
+
+// AdminUserList godoc
+// @Summary Retrieves all admin users
+// @Description Fetches a paginated list of admin panel users, optionally filtered by role and status
+// @Tags Admin Users
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param query query dto.AdminUserFilter false "Query parameters"
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.AdminUserResponse]
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/admin-users [get]
+func AdminUserList(useCase adminuser.ListUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.AdminUserFilter
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errors.BindQueryToHTTPError(req, err))
+			return
+		}
+
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		users, err := useCase.Execute(
+			c.Request.Context(), req.ToDomain(), page.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK,
+			dto.PageFromDomain(users, dto.AdminUserResponseFromDomain),
+		)
+	}
+}
+
+// AdminUserCreate godoc
+// @Summary Creates a new admin user
+// @Description Adds a new admin panel user with the given role. The user must change the password on first login
+// @Tags Admin Users
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param request body dto.AdminUserCreate true "Admin user data"
+// @Success 201 {object} dto.AdminUserResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/admin-users [post]
+func AdminUserCreate(useCase adminuser.CreateUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.AdminUserCreate
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(errors.BindJSONToHTTPError(req, err))
+			return
+		}
+
+		user, err := useCase.Execute(c.Request.Context(), req.ToDomain())
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, dto.AdminUserResponseFromDomain(user))
+	}
+}
+
+// AdminUserDisable godoc
+// @Summary Disables an admin user
+// @Description Disables a specific admin user by ID. The last enabled owner cannot be disabled
+// @Tags Admin Users
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param id path int true "Admin User ID"
+// @Success 204 "No Content"
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/admin-users/{id}/disable [post]
+func AdminUserDisable(useCase adminuser.DisableUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid admin user id",
+				),
+			)
+			return
+		}
+
+		if err := useCase.Execute(c.Request.Context(), userID); err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
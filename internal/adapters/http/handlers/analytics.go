@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/http/dto"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/errors"
+	"github.com/MAD-py/pandora-core/internal/app/analytics"
+	domaindto "github.com/MAD-py/pandora-core/internal/domain/dto"
+	domainerr "github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// AnalyticsUsage godoc
+// @Summary Retrieves request usage over time
+// @Description Counts the requests and units consumed per hour, day or month, optionally grouped by client, project, environment, service, API key, execution status or unauthorized reason. Usage is read from rollups refreshed by the TaskEngine every few minutes.
+// @Tags Analytics
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param query query dto.UsageFilter true "Query parameters"
+// @Success 200 {object} dto.UsageResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/analytics/usage [get]
+func AnalyticsUsage(useCase analytics.UsageUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.UsageFilter
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errors.BindQueryToHTTPError(req, err))
+			return
+		}
+
+		usage, err := useCase.Execute(c.Request.Context(), req.ToDomain())
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.UsageResponseFromDomain(usage))
+	}
+}
+
+// AnalyticsUsageExport godoc
+// @Summary Exports request usage over time
+// @Description Streams the same usage buckets as the usage report, as CSV or NDJSON with one bucket per line, e.g. grouped by client or project for a monthly statement. Unlike the report, the range is not limited in buckets.
+// @Tags Analytics
+// @Security OAuth2Password
+// @Accept json
+// @Produce text/csv,application/x-ndjson
+// @Param query query dto.UsageFilter true "Query parameters"
+// @Param export query dto.Export false "Export parameters"
+// @Success 200 {file} file "Usage buckets as CSV or NDJSON"
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/analytics/usage/export [get]
+func AnalyticsUsageExport(useCase analytics.ExportUsageUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.UsageFilter
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errors.BindQueryToHTTPError(req, err))
+			return
+		}
+
+		var export dto.Export
+		if err := c.ShouldBindQuery(&export); err != nil {
+			c.Error(errors.BindQueryToHTTPError(export, err))
+			return
+		}
+
+		writer, writerErr := newExportWriter(
+			c, &export, "usage", dto.UsageCSVHeader,
+		)
+		if writerErr != nil {
+			c.Error(writerErr)
+			return
+		}
+
+		err := useCase.Execute(
+			c.Request.Context(),
+			req.ToDomain(),
+			func(bucket *domaindto.UsageBucketResponse) domainerr.Error {
+				item := dto.UsageBucketResponseFromDomain(bucket)
+				return writer.Write(item, item.CSVRecord())
+			},
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		writer.Close()
+	}
+}
@@ -177,9 +177,49 @@ func APIKeyEnable(useCase apikey.EnableUseCase) gin.HandlerFunc {
 	}
 }
 
+// APIKeyRotate godoc
+// @Summary Rotates an API key
+// @Description Creates a successor for a specific API key by ID in the same environment. The rotated key stays valid until grace_ends_at and is disabled automatically afterwards
+// @Tags API Keys
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param id path int true "API Key ID"
+// @Param request body dto.APIKeyRotate true "API key rotation data"
+// @Success 201 {object} dto.APIKeyRotateResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/api-keys/{id}/rotate [post]
+func APIKeyRotate(useCase apikey.RotateUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		apiKeyID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid api key id",
+				),
+			)
+			return
+		}
+
+		var req dto.APIKeyRotate
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(errors.BindJSONToHTTPError(req, err))
+			return
+		}
+
+		rotation, err := useCase.Execute(c.Request.Context(), apiKeyID, req.ToDomain())
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, dto.APIKeyRotateResponseFromDomain(rotation))
+	}
+}
+
 // APIKeyRevealKey godoc
 // @Summary Reveals the API Key
-// @Description Retrieves the actual API Key value for a specific API key by ID
+// @Description Decrypts and returns the API Key value for a specific API key by ID
 // @Tags API Keys
 // @Security ScopedToken
 // @Accept json
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/http/dto"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/errors"
+	"github.com/MAD-py/pandora-core/internal/app/audit"
+)
+
+// AuditList godoc
+// @Summary Retrieves the audit log
+// @Description Fetches the admin mutations recorded in the audit log, newest first, optionally filtered by actor, action, entity and creation time
+// @Tags Audit
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param query query dto.AuditFilter false "Query parameters"
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.AuditEntryResponse]
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/audit [get]
+func AuditList(useCase audit.ListUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.AuditFilter
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errors.BindQueryToHTTPError(req, err))
+			return
+		}
+
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		entries, err := useCase.Execute(
+			c.Request.Context(), req.ToDomain(), page.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK,
+			dto.PageFromDomain(entries, dto.AuditEntryResponseFromDomain),
+		)
+	}
+}
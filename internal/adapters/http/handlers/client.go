@@ -19,23 +19,30 @@ import (
 // @Accept json
 // @Produce json
 // @Param query query dto.ClientFilter false "Query parameters"
-// @Success 200 {array} dto.ClientResponse
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.ClientResponse]
 // @Failure default {object} errors.HTTPError "Default error response for all failures"
 // @Router /api/v1/clients [get]
 func ClientList(useCase client.ListUseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		req := dto.ClientFilter{Type: c.Query("type")}
-		clients, err := useCase.Execute(c.Request.Context(), req.ToDomain())
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		clients, err := useCase.Execute(
+			c.Request.Context(), req.ToDomain(), page.ToDomain(),
+		)
 		if err != nil {
 			c.Error(err)
 			return
 		}
 
-		resp := make([]*dto.ClientResponse, len(clients))
-		for i, client := range clients {
-			resp[i] = dto.ClientResponseFromDomain(client)
-		}
-		c.JSON(http.StatusOK, resp)
+		c.JSON(
+			http.StatusOK, dto.PageFromDomain(clients, dto.ClientResponseFromDomain),
+		)
 	}
 }
 
@@ -182,7 +189,8 @@ func ClientDelete(useCase client.DeleteUseCase) gin.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param id path int true "Client ID"
-// @Success 200 {array} dto.ProjectResponse
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.ProjectResponse]
 // @Failure default {object} errors.HTTPError "Default error response for all failures"
 // @Router /api/v1/clients/{id}/projects [get]
 func ClientListProjects(useCase client.ListProjectsUseCase) gin.HandlerFunc {
@@ -197,16 +205,146 @@ func ClientListProjects(useCase client.ListProjectsUseCase) gin.HandlerFunc {
 			return
 		}
 
-		projects, err := useCase.Execute(c.Request.Context(), clientID)
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		projects, err := useCase.Execute(
+			c.Request.Context(), clientID, page.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK, dto.PageFromDomain(projects, dto.ProjectResponseFromDomain),
+		)
+	}
+}
+
+// ClientTokenCreate godoc
+// @Summary Issues a portal token to a client
+// @Description Creates a token the client can use to call the portal API, scoped to its own projects, environments, API keys and requests. The token is only returned in this response
+// @Tags Clients
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param id path int true "Client ID"
+// @Param request body dto.ClientTokenCreate true "Client token creation data"
+// @Success 201 {object} dto.ClientTokenResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/clients/{id}/tokens [post]
+func ClientTokenCreate(useCase client.CreateTokenUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		clientID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid client id",
+				),
+			)
+			return
+		}
+
+		var req dto.ClientTokenCreate
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(errors.BindJSONToHTTPError(req, err))
+			return
+		}
+
+		token, err := useCase.Execute(
+			c.Request.Context(), clientID, req.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, dto.ClientTokenResponseFromDomain(token))
+	}
+}
+
+// ClientTokenList godoc
+// @Summary Retrieves all portal tokens of a client
+// @Description Fetches the portal tokens issued to a specific client, with only the prefix of each token
+// @Tags Clients
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param id path int true "Client ID"
+// @Success 200 {array} dto.ClientTokenResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/clients/{id}/tokens [get]
+func ClientTokenList(useCase client.ListTokensUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		clientID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid client id",
+				),
+			)
+			return
+		}
+
+		tokens, err := useCase.Execute(c.Request.Context(), clientID)
 		if err != nil {
 			c.Error(err)
 			return
 		}
 
-		resp := make([]*dto.ProjectResponse, len(projects))
-		for i, project := range projects {
-			resp[i] = dto.ProjectResponseFromDomain(project)
+		tokenResponses := make([]*dto.ClientTokenResponse, len(tokens))
+		for i, token := range tokens {
+			tokenResponses[i] = dto.ClientTokenResponseFromDomain(token)
+		}
+
+		c.JSON(http.StatusOK, tokenResponses)
+	}
+}
+
+// ClientTokenDelete godoc
+// @Summary Revokes a portal token of a client
+// @Description Deletes a specific portal token of a client, rejecting any further portal request made with it
+// @Tags Clients
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param id path int true "Client ID"
+// @Param token_id path int true "Client Token ID"
+// @Success 204 "No Content"
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/clients/{id}/tokens/{token_id} [delete]
+func ClientTokenDelete(useCase client.DeleteTokenUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		clientID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid client id",
+				),
+			)
+			return
+		}
+
+		tokenID, paramErr := strconv.Atoi(c.Param("token_id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "token_id", "Invalid client token id",
+				),
+			)
+			return
 		}
-		c.JSON(http.StatusOK, resp)
+
+		err := useCase.Execute(c.Request.Context(), clientID, tokenID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
 	}
 }
@@ -155,7 +155,8 @@ func EnvironmentDelete(useCase environment.DeleteUseCase) gin.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param id path int true "Environment ID"
-// @Success 200 {array} dto.APIKeyResponse
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.APIKeyResponse]
 // @Failure default {object} errors.HTTPError "Default error response for all failures"
 // @Router /api/v1/environments/{id}/api-keys [get]
 func EnvironmentListAPIKeys(useCase environment.ListAPIKeyUseCase) gin.HandlerFunc {
@@ -170,17 +171,23 @@ func EnvironmentListAPIKeys(useCase environment.ListAPIKeyUseCase) gin.HandlerFu
 			return
 		}
 
-		apiKeys, err := useCase.Execute(c.Request.Context(), environmentID)
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		apiKeys, err := useCase.Execute(
+			c.Request.Context(), environmentID, page.ToDomain(),
+		)
 		if err != nil {
 			c.Error(err)
 			return
 		}
 
-		resp := make([]*dto.APIKeyResponse, len(apiKeys))
-		for i, apiKey := range apiKeys {
-			resp[i] = dto.APIKeyResponseFromDomain(apiKey)
-		}
-		c.JSON(http.StatusOK, resp)
+		c.JSON(
+			http.StatusOK, dto.PageFromDomain(apiKeys, dto.APIKeyResponseFromDomain),
+		)
 	}
 }
 
@@ -369,3 +376,162 @@ func EnvironmentResetRequest(useCase environment.ResetRequestUseCase) gin.Handle
 		c.JSON(http.StatusOK, dto.EnvironmentServiceResponseFromDomain(service))
 	}
 }
+
+// EnvironmentListAlerts godoc
+// @Summary Retrieves the quota alerts of a service in an environment
+// @Description Fetches the quota alert thresholds configured for a service within an environment
+// @Tags Environments
+// @Security OAuth2Password
+// @Produce json
+// @Param id path int true "Environment ID"
+// @Param service_id path int true "Service ID"
+// @Success 200 {array} dto.QuotaAlertResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/environments/{id}/services/{service_id}/alerts [get]
+func EnvironmentListAlerts(useCase environment.ListAlertsUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		environmentID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid environment id",
+				),
+			)
+			return
+		}
+
+		serviceID, paramErr := strconv.Atoi(c.Param("service_id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "service_id", "Invalid service id",
+				),
+			)
+			return
+		}
+
+		alerts, err := useCase.Execute(
+			c.Request.Context(), environmentID, serviceID,
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		alertResponses := make([]*dto.QuotaAlertResponse, len(alerts))
+		for i, alert := range alerts {
+			alertResponses[i] = dto.QuotaAlertResponseFromDomain(alert)
+		}
+
+		c.JSON(http.StatusOK, alertResponses)
+	}
+}
+
+// EnvironmentCreateAlert godoc
+// @Summary Adds a quota alert to a service in an environment
+// @Description Configures a threshold, as a percentage of the quota used, that fires once per reset period
+// @Tags Environments
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param id path int true "Environment ID"
+// @Param service_id path int true "Service ID"
+// @Param request body dto.QuotaAlertCreate true "Quota alert data"
+// @Success 201 {object} dto.QuotaAlertResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/environments/{id}/services/{service_id}/alerts [post]
+func EnvironmentCreateAlert(useCase environment.CreateAlertUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		environmentID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid environment id",
+				),
+			)
+			return
+		}
+
+		serviceID, paramErr := strconv.Atoi(c.Param("service_id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "service_id", "Invalid service id",
+				),
+			)
+			return
+		}
+
+		var req dto.QuotaAlertCreate
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(errors.BindJSONToHTTPError(req, err))
+			return
+		}
+
+		alert, err := useCase.Execute(
+			c.Request.Context(), environmentID, serviceID, req.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, dto.QuotaAlertResponseFromDomain(alert))
+	}
+}
+
+// EnvironmentDeleteAlert godoc
+// @Summary Deletes a quota alert of a service in an environment
+// @Description Removes a quota alert threshold from a service within an environment
+// @Tags Environments
+// @Security OAuth2Password
+// @Produce json
+// @Param id path int true "Environment ID"
+// @Param service_id path int true "Service ID"
+// @Param alert_id path int true "Quota Alert ID"
+// @Success 204 "No Content"
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/environments/{id}/services/{service_id}/alerts/{alert_id} [delete]
+func EnvironmentDeleteAlert(useCase environment.DeleteAlertUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		environmentID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid environment id",
+				),
+			)
+			return
+		}
+
+		serviceID, paramErr := strconv.Atoi(c.Param("service_id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "service_id", "Invalid service id",
+				),
+			)
+			return
+		}
+
+		alertID, paramErr := strconv.Atoi(c.Param("alert_id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "alert_id", "Invalid quota alert id",
+				),
+			)
+			return
+		}
+
+		err := useCase.Execute(
+			c.Request.Context(), environmentID, serviceID, alertID,
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
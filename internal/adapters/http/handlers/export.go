@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/http/dto"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/errors"
+	domainerr "github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// exportFlushInterval is how many records are written between flushes of
+// the response.
+const exportFlushInterval = 100
+
+// exportWriter streams the records of an export as CSV or NDJSON. The
+// response is only started by the first record, so an error raised before
+// any is written is still answered with a regular error response.
+type exportWriter struct {
+	c *gin.Context
+
+	format   string
+	filename string
+	header   []string
+
+	csv *csv.Writer
+
+	started bool
+	written int
+}
+
+// Write writes a record, as the JSON encoding of item in NDJSON, or as the
+// CSV fields in record.
+func (w *exportWriter) Write(item any, record []string) domainerr.Error {
+	w.start()
+
+	var err error
+	if w.format == dto.ExportFormatCSV {
+		err = w.csv.Write(record)
+	} else {
+		err = json.NewEncoder(w.c.Writer).Encode(item)
+	}
+
+	if err != nil {
+		return domainerr.NewInternal("failed to write export", err)
+	}
+
+	w.written++
+	if w.written%exportFlushInterval == 0 {
+		w.flush()
+	}
+	return nil
+}
+
+// Close starts the response if no record was written, so an empty export
+// is still a file, and flushes what is left of it.
+func (w *exportWriter) Close() {
+	w.start()
+	w.flush()
+}
+
+func (w *exportWriter) start() {
+	if w.started {
+		return
+	}
+	w.started = true
+
+	contentType := "application/x-ndjson"
+	if w.format == dto.ExportFormatCSV {
+		contentType = "text/csv; charset=utf-8"
+	}
+
+	w.c.Header("Content-Type", contentType)
+	w.c.Header(
+		"Content-Disposition",
+		fmt.Sprintf(
+			`attachment; filename="%s-%s.%s"`,
+			w.filename, time.Now().UTC().Format("20060102T150405Z"), w.format,
+		),
+	)
+	w.c.Status(http.StatusOK)
+
+	if w.format == dto.ExportFormatCSV {
+		w.csv = csv.NewWriter(w.c.Writer)
+		w.csv.Write(w.header)
+	}
+}
+
+func (w *exportWriter) flush() {
+	if w.csv != nil {
+		w.csv.Flush()
+	}
+	w.c.Writer.Flush()
+}
+
+// newExportWriter returns the writer of the export in the requested format,
+// named after filename, or an error when the format is not supported.
+func newExportWriter(
+	c *gin.Context, req *dto.Export, filename string, header []string,
+) (*exportWriter, *errors.HTTPError) {
+	format := req.ExportFormat()
+	if format != dto.ExportFormatCSV && format != dto.ExportFormatNDJSON {
+		return nil, errors.NewValidationFailed(
+			"query", "format", "format must be one of the following: csv, ndjson",
+		)
+	}
+
+	return &exportWriter{
+		c:        c,
+		format:   format,
+		filename: filename,
+		header:   header,
+	}, nil
+}
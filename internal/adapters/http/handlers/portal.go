@@ -0,0 +1,313 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/http/dto"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/errors"
+	"github.com/MAD-py/pandora-core/internal/app/portal"
+)
+
+// PortalListProjects godoc
+// @Summary Retrieves the projects of the client
+// @Description Fetches the projects of the client authenticated by the token, with the quota of their services
+// @Tags Portal
+// @Security ClientToken
+// @Accept json
+// @Produce json
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.ProjectResponse]
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/portal/projects [get]
+func PortalListProjects(useCase portal.ListProjectsUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		projects, err := useCase.Execute(
+			c.Request.Context(), c.GetInt("client_id"), page.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK, dto.PageFromDomain(projects, dto.ProjectResponseFromDomain),
+		)
+	}
+}
+
+// PortalListEnvironments godoc
+// @Summary Retrieves the environments of a project of the client
+// @Description Fetches the environments of a project owned by the client authenticated by the token, with the remaining quota of their services
+// @Tags Portal
+// @Security ClientToken
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.EnvironmentResponse]
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/portal/projects/{id}/environments [get]
+func PortalListEnvironments(useCase portal.ListEnvironmentsUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid project id",
+				),
+			)
+			return
+		}
+
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		environments, err := useCase.Execute(
+			c.Request.Context(), c.GetInt("client_id"), projectID, page.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK,
+			dto.PageFromDomain(environments, dto.EnvironmentResponseFromDomain),
+		)
+	}
+}
+
+// PortalGetEnvironment godoc
+// @Summary Retrieves an environment of the client
+// @Description Fetches an environment owned by the client authenticated by the token, with the remaining quota of its services
+// @Tags Portal
+// @Security ClientToken
+// @Accept json
+// @Produce json
+// @Param id path int true "Environment ID"
+// @Success 200 {object} dto.EnvironmentResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/portal/environments/{id} [get]
+func PortalGetEnvironment(useCase portal.GetEnvironmentUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		environmentID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid environment id",
+				),
+			)
+			return
+		}
+
+		environment, err := useCase.Execute(
+			c.Request.Context(), c.GetInt("client_id"), environmentID,
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.EnvironmentResponseFromDomain(environment))
+	}
+}
+
+// PortalListAPIKeys godoc
+// @Summary Retrieves the API keys of an environment of the client
+// @Description Fetches the API keys of an environment owned by the client authenticated by the token
+// @Tags Portal
+// @Security ClientToken
+// @Accept json
+// @Produce json
+// @Param id path int true "Environment ID"
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.APIKeyResponse]
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/portal/environments/{id}/api-keys [get]
+func PortalListAPIKeys(useCase portal.ListAPIKeysUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		environmentID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid environment id",
+				),
+			)
+			return
+		}
+
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		apiKeys, err := useCase.Execute(
+			c.Request.Context(), c.GetInt("client_id"), environmentID, page.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK, dto.PageFromDomain(apiKeys, dto.APIKeyResponseFromDomain),
+		)
+	}
+}
+
+// PortalCreateAPIKey godoc
+// @Summary Creates an API key in an environment of the client
+// @Description Adds an API key to an environment owned by the client authenticated by the token, as long as the environment is below the API key limit set for the client. The key is returned in full
+// @Tags Portal
+// @Security ClientToken
+// @Accept json
+// @Produce json
+// @Param request body dto.APIKeyCreate true "API key creation data"
+// @Success 201 {object} dto.APIKeyResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/portal/api-keys [post]
+func PortalCreateAPIKey(useCase portal.CreateAPIKeyUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.APIKeyCreate
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(errors.BindJSONToHTTPError(req, err))
+			return
+		}
+
+		apiKey, err := useCase.Execute(
+			c.Request.Context(), c.GetInt("client_id"), req.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, dto.APIKeyResponseFromDomain(apiKey))
+	}
+}
+
+// PortalRotateAPIKey godoc
+// @Summary Rotates an API key of the client
+// @Description Creates a successor for an API key owned by the client authenticated by the token. The rotated key stays valid until grace_ends_at and the successor is returned in full
+// @Tags Portal
+// @Security ClientToken
+// @Accept json
+// @Produce json
+// @Param id path int true "API Key ID"
+// @Param request body dto.APIKeyRotate true "API key rotation data"
+// @Success 201 {object} dto.APIKeyRotateResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/portal/api-keys/{id}/rotate [post]
+func PortalRotateAPIKey(useCase portal.RotateAPIKeyUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		apiKeyID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid api key id",
+				),
+			)
+			return
+		}
+
+		var req dto.APIKeyRotate
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(errors.BindJSONToHTTPError(req, err))
+			return
+		}
+
+		rotation, err := useCase.Execute(
+			c.Request.Context(), c.GetInt("client_id"), apiKeyID, req.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, dto.APIKeyRotateResponseFromDomain(rotation))
+	}
+}
+
+// PortalSearchRequests godoc
+// @Summary Searches the request log of the client
+// @Description Fetches the requests made with the API keys of the client authenticated by the token, matching the given filters
+// @Tags Portal
+// @Security ClientToken
+// @Accept json
+// @Produce json
+// @Param query query dto.PortalRequestSearch false "Query parameters"
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.RequestResponse]
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/portal/requests [get]
+func PortalSearchRequests(useCase portal.SearchRequestsUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.PortalRequestSearch
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errors.BindQueryToHTTPError(req, err))
+			return
+		}
+
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		requests, err := useCase.Execute(
+			c.Request.Context(), c.GetInt("client_id"), req.ToDomain(), page.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK, dto.PageFromDomain(requests, dto.RequestResponseFromDomain),
+		)
+	}
+}
+
+// PortalUsage godoc
+// @Summary Retrieves the usage of the client over time
+// @Description Counts the requests and units consumed by the client authenticated by the token per hour, day or month, optionally grouped by project, environment, service, API key, execution status or unauthorized reason
+// @Tags Portal
+// @Security ClientToken
+// @Accept json
+// @Produce json
+// @Param query query dto.PortalUsageFilter true "Query parameters"
+// @Success 200 {object} dto.UsageResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/portal/usage [get]
+func PortalUsage(useCase portal.UsageUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.PortalUsageFilter
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errors.BindQueryToHTTPError(req, err))
+			return
+		}
+
+		usage, err := useCase.Execute(
+			c.Request.Context(), c.GetInt("client_id"), req.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.UsageResponseFromDomain(usage))
+	}
+}
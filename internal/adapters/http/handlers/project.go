@@ -17,23 +17,27 @@ import (
 // @Tags Projects
 // @Security OAuth2Password
 // @Produce json
-// @Success 200 {array} dto.ProjectResponse
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.ProjectResponse]
 // @Failure default {object} errors.HTTPError "Default error response for all failures"
 // @Router /api/v1/projects [get]
 func ProjectList(useCase project.ListUseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		projects, err := useCase.Execute(c.Request.Context())
-		if err != nil {
-			c.Error(err)
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
 			return
 		}
 
-		resp := make([]*dto.ProjectResponse, len(projects))
-		for i, project := range projects {
-			resp[i] = dto.ProjectResponseFromDomain(project)
+		projects, err := useCase.Execute(c.Request.Context(), page.ToDomain())
+		if err != nil {
+			c.Error(err)
+			return
 		}
 
-		c.JSON(http.StatusOK, resp)
+		c.JSON(
+			http.StatusOK, dto.PageFromDomain(projects, dto.ProjectResponseFromDomain),
+		)
 	}
 }
 
@@ -181,7 +185,8 @@ func ProjectDelete(useCase project.DeleteUseCase) gin.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param id path int true "Project ID"
-// @Success 200 {array} dto.EnvironmentResponse
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.EnvironmentResponse]
 // @Failure default {object} errors.HTTPError "Default error response for all failures"
 // @Router /api/v1/projects/{id}/environments [get]
 func ProjectListEnvironments(useCase project.ListEnvironmentsUseCase) gin.HandlerFunc {
@@ -196,18 +201,23 @@ func ProjectListEnvironments(useCase project.ListEnvironmentsUseCase) gin.Handle
 			return
 		}
 
-		environments, err := useCase.Execute(c.Request.Context(), projectID)
-		if err != nil {
-			c.Error(err)
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
 			return
 		}
 
-		resp := make([]*dto.EnvironmentResponse, len(environments))
-		for i, environment := range environments {
-			resp[i] = dto.EnvironmentResponseFromDomain(environment)
+		environments, err := useCase.Execute(
+			c.Request.Context(), projectID, page.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
 		}
 
-		c.JSON(http.StatusOK, resp)
+		c.JSON(
+			http.StatusOK, dto.PageFromDomain(environments, dto.EnvironmentResponseFromDomain),
+		)
 	}
 }
 
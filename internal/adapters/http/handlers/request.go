@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/http/dto"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/errors"
+	"github.com/MAD-py/pandora-core/internal/app/request"
+	domaindto "github.com/MAD-py/pandora-core/internal/domain/dto"
+	domainerr "github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// RequestSearch godoc
+// @Summary Searches the request log
+// @Description Fetches the requests matching the given filters across clients, projects, environments, API keys and services
+// @Tags Requests
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param query query dto.RequestSearch false "Query parameters"
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.RequestResponse]
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/requests [get]
+func RequestSearch(useCase request.SearchUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.RequestSearch
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errors.BindQueryToHTTPError(req, err))
+			return
+		}
+
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		requests, err := useCase.Execute(
+			c.Request.Context(), req.ToDomain(), page.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK, dto.PageFromDomain(requests, dto.RequestResponseFromDomain),
+		)
+	}
+}
+
+// RequestExport godoc
+// @Summary Exports the request log
+// @Description Streams every request matching the same filters as the request search, as CSV or NDJSON with one request per line. The export is read through a database cursor, so it is not limited in size.
+// @Tags Requests
+// @Security OAuth2Password
+// @Accept json
+// @Produce text/csv,application/x-ndjson
+// @Param query query dto.RequestSearch false "Query parameters"
+// @Param export query dto.Export false "Export parameters"
+// @Success 200 {file} file "Requests as CSV or NDJSON"
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/requests/export [get]
+func RequestExport(useCase request.ExportUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.RequestSearch
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errors.BindQueryToHTTPError(req, err))
+			return
+		}
+
+		var export dto.Export
+		if err := c.ShouldBindQuery(&export); err != nil {
+			c.Error(errors.BindQueryToHTTPError(export, err))
+			return
+		}
+
+		writer, writerErr := newExportWriter(
+			c, &export, "requests", dto.RequestCSVHeader,
+		)
+		if writerErr != nil {
+			c.Error(writerErr)
+			return
+		}
+
+		err := useCase.Execute(
+			c.Request.Context(),
+			req.ToDomain(),
+			func(request *domaindto.RequestResponse) domainerr.Error {
+				item := dto.RequestResponseFromDomain(request)
+				return writer.Write(item, item.CSVRecord())
+			},
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		writer.Close()
+	}
+}
+
+// RequestGet godoc
+// @Summary Retrieves a request by ID
+// @Description Fetches a request with its stored metadata and every request of its start point chain
+// @Tags Requests
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param id path string true "Request ID"
+// @Success 200 {object} dto.RequestDetailResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/requests/{id} [get]
+func RequestGet(useCase request.GetUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		detail, err := useCase.Execute(c.Request.Context(), c.Param("id"))
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.RequestDetailResponseFromDomain(detail))
+	}
+}
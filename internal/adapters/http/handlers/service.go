@@ -20,23 +20,30 @@ import (
 // @Accept json
 // @Produce json
 // @Param query query dto.ServiceFilter false "Query parameters"
-// @Success 200 {array} dto.ServiceResponse
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.ServiceResponse]
 // @Failure default {object} errors.HTTPError "Default error response for all failures"
 // @Router /api/v1/services [get]
 func ServiceList(useCase service.ListUseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		req := dto.ServiceFilter{Status: c.Query("status")}
-		services, err := useCase.Execute(c.Request.Context(), req.ToDomain())
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		services, err := useCase.Execute(
+			c.Request.Context(), req.ToDomain(), page.ToDomain(),
+		)
 		if err != nil {
 			c.Error(err)
 			return
 		}
 
-		resp := make([]*dto.ServiceResponse, len(services))
-		for i, service := range services {
-			resp[i] = dto.ServiceResponseFromDomain(service)
-		}
-		c.JSON(http.StatusOK, resp)
+		c.JSON(
+			http.StatusOK, dto.PageFromDomain(services, dto.ServiceResponseFromDomain),
+		)
 	}
 }
 
@@ -109,7 +116,8 @@ func ServiceDelete(useCase service.DeleteUseCase) gin.HandlerFunc {
 // @Produce json
 // @Param id path int true "Service ID"
 // @Param query query dto.RequestFilter false "Query parameters"
-// @Success 200 {array} dto.RequestResponse
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.RequestResponse]
 // @Failure default {object} errors.HTTPError "Default error response for all failures"
 // @Router /api/v1/services/{id}/requests [get]
 func ServiceListRequests(useCase service.ListRequestsUseCase) gin.HandlerFunc {
@@ -130,17 +138,70 @@ func ServiceListRequests(useCase service.ListRequestsUseCase) gin.HandlerFunc {
 			return
 		}
 
-		requests, err := useCase.Execute(c.Request.Context(), serviceID, req.ToDomain())
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		requests, err := useCase.Execute(
+			c.Request.Context(),
+			serviceID,
+			req.ToDomain(),
+			page.ToDomain(),
+		)
 		if err != nil {
 			c.Error(err)
 			return
 		}
 
-		resp := make([]*dto.RequestResponse, len(requests))
-		for i, request := range requests {
-			resp[i] = dto.RequestResponseFromDomain(request)
+		c.JSON(
+			http.StatusOK, dto.PageFromDomain(requests, dto.RequestResponseFromDomain),
+		)
+	}
+}
+
+// ServiceUpdateRetention godoc
+// @Summary Updates the request retention of a service
+// @Description Sets how many days the requests of a service are kept in the request log, overriding the global retention. 0 keeps them forever, null restores the global retention.
+// @Tags Services
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param id path int true "Service ID"
+// @Param request body dto.ServiceRetentionUpdate true "Request retention of the service"
+// @Success 200 {object} dto.ServiceResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/services/{id}/retention [patch]
+func ServiceUpdateRetention(
+	useCase service.UpdateRetentionUseCase,
+) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		serviceID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid service id",
+				),
+			)
+			return
+		}
+
+		var req dto.ServiceRetentionUpdate
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(errors.BindJSONToHTTPError(req, err))
+			return
+		}
+
+		service, err := useCase.Execute(
+			c.Request.Context(), serviceID, req.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
 		}
-		c.JSON(http.StatusOK, resp)
+
+		c.JSON(http.StatusOK, dto.ServiceResponseFromDomain(service))
 	}
 }
 
@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/http/dto"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/errors"
+	"github.com/MAD-py/pandora-core/internal/app/webhook"
+)
+
+// WebhookList godoc
+// @Summary Retrieves all webhooks
+// @Description Fetches a list of webhooks, optionally filtered by the client or project they are subscribed to
+// @Tags Webhooks
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param query query dto.WebhookFilter false "Query parameters"
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.WebhookResponse]
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/webhooks [get]
+func WebhookList(useCase webhook.ListUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.WebhookFilter
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errors.BindQueryToHTTPError(req, err))
+			return
+		}
+
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		webhooks, err := useCase.Execute(
+			c.Request.Context(), req.ToDomain(), page.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK,
+			dto.PageFromDomain(webhooks, dto.WebhookResponseFromDomain),
+		)
+	}
+}
+
+// WebhookCreate godoc
+// @Summary Creates a new webhook
+// @Description Subscribes a URL to the events of a client's projects or of a single project. The secret the payloads are signed with is only returned in this response.
+// @Tags Webhooks
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param request body dto.WebhookCreate true "Webhook creation data"
+// @Success 201 {object} dto.WebhookResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/webhooks [post]
+func WebhookCreate(useCase webhook.CreateUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.WebhookCreate
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(errors.BindJSONToHTTPError(req, err))
+			return
+		}
+
+		webhook, err := useCase.Execute(c.Request.Context(), req.ToDomain())
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, dto.WebhookResponseFromDomain(webhook))
+	}
+}
+
+// WebhookGet godoc
+// @Summary Retrieves a webhook by ID
+// @Description Fetches the details of a specific webhook using its ID
+// @Tags Webhooks
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} dto.WebhookResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/webhooks/{id} [get]
+func WebhookGet(useCase webhook.GetUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		webhookID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid webhook id",
+				),
+			)
+			return
+		}
+
+		webhook, err := useCase.Execute(c.Request.Context(), webhookID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.WebhookResponseFromDomain(webhook))
+	}
+}
+
+// WebhookUpdate godoc
+// @Summary Updates an existing webhook
+// @Description Modifies the URL, events or status of a webhook. Deliveries of a disabled webhook are kept until it is enabled again.
+// @Tags Webhooks
+// @Security OAuth2Password
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param request body dto.WebhookUpdate true "Updated webhook data"
+// @Success 200 {object} dto.WebhookResponse
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/webhooks/{id} [patch]
+func WebhookUpdate(useCase webhook.UpdateUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		webhookID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid webhook id",
+				),
+			)
+			return
+		}
+
+		var req dto.WebhookUpdate
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(errors.BindJSONToHTTPError(req, err))
+			return
+		}
+
+		webhook, err := useCase.Execute(
+			c.Request.Context(), webhookID, req.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.WebhookResponseFromDomain(webhook))
+	}
+}
+
+// WebhookDelete godoc
+// @Summary Deletes a webhook by ID
+// @Description Removes a specific webhook along with its deliveries
+// @Tags Webhooks
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 204 "No Content"
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/webhooks/{id} [delete]
+func WebhookDelete(useCase webhook.DeleteUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		webhookID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid webhook id",
+				),
+			)
+			return
+		}
+
+		if err := useCase.Execute(c.Request.Context(), webhookID); err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// WebhookListDeliveries godoc
+// @Summary Retrieves the deliveries of a webhook
+// @Description Fetches the delivery log of a webhook, newest first, optionally filtered by status
+// @Tags Webhooks
+// @Security OAuth2Password
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param query query dto.WebhookDeliveryFilter false "Query parameters"
+// @Param page query dto.Pagination false "Pagination parameters"
+// @Success 200 {object} dto.Page[dto.WebhookDeliveryResponse]
+// @Failure default {object} errors.HTTPError "Default error response for all failures"
+// @Router /api/v1/webhooks/{id}/deliveries [get]
+func WebhookListDeliveries(useCase webhook.ListDeliveriesUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		webhookID, paramErr := strconv.Atoi(c.Param("id"))
+		if paramErr != nil {
+			c.Error(
+				errors.NewValidationFailed(
+					"path", "id", "Invalid webhook id",
+				),
+			)
+			return
+		}
+
+		var req dto.WebhookDeliveryFilter
+		if err := c.ShouldBindQuery(&req); err != nil {
+			c.Error(errors.BindQueryToHTTPError(req, err))
+			return
+		}
+
+		var page dto.Pagination
+		if err := c.ShouldBindQuery(&page); err != nil {
+			c.Error(errors.BindQueryToHTTPError(page, err))
+			return
+		}
+
+		deliveries, err := useCase.Execute(
+			c.Request.Context(), webhookID, req.ToDomain(), page.ToDomain(),
+		)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK,
+			dto.PageFromDomain(deliveries, dto.WebhookDeliveryResponseFromDomain),
+		)
+	}
+}
@@ -1,11 +1,13 @@
 package middlewares
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/MAD-py/pandora-core/internal/adapters/http/errors"
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/app/auth"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
 )
@@ -37,6 +39,12 @@ func ValidateAccessToken(useCase auth.TokenValidationUseCase) gin.HandlerFunc {
 		}
 
 		c.Set("username", username)
+		c.Request = c.Request.WithContext(
+			auditshared.WithActor(
+				c.Request.Context(),
+				&auditshared.Actor{Username: username, IP: c.ClientIP()},
+			),
+		)
 		c.Next()
 	}
 }
@@ -68,6 +76,99 @@ func ValidateScopedToken(useCase auth.ScopedTokenValidationUseCase, scope enums.
 		}
 
 		c.Set("username", username)
+		c.Request = c.Request.WithContext(
+			auditshared.WithActor(
+				c.Request.Context(),
+				&auditshared.Actor{Username: username, IP: c.ClientIP()},
+			),
+		)
+		c.Next()
+	}
+}
+
+// ValidateClientToken authenticates the client of a portal token, leaving
+// its ID in the context for the portal handlers to scope every request.
+func ValidateClientToken(useCase auth.ClientTokenValidationUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			c.Header("WWW-Authenticate", `Bearer realm="Access to the portal"`)
+			c.Error(errors.NewUnauthorized("Authorization header missing"))
+			c.Abort()
+			return
+		}
+
+		parts := strings.Split(authHeader, " ")
+		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+			c.Error(
+				errors.NewUnauthorized("Invalid token type, expected 'Bearer'"),
+			)
+			c.Abort()
+			return
+		}
+
+		clientID, err := useCase.Execute(c.Request.Context(), parts[1])
+		if err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+
+		c.Set("client_id", clientID)
+		c.Request = c.Request.WithContext(
+			auditshared.WithActor(
+				c.Request.Context(),
+				&auditshared.Actor{
+					Username: fmt.Sprintf("client:%d", clientID),
+					IP:       c.ClientIP(),
+				},
+			),
+		)
+		c.Next()
+	}
+}
+
+// Authorize loads the role of the admin user authenticated by the token,
+// rejecting users that no longer exist or are disabled.
+func Authorize(useCase auth.AuthorizationUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username := c.GetString("username")
+		if username == "" {
+			c.Error(errors.NewInternal("Username not found in context"))
+			c.Abort()
+			return
+		}
+
+		role, err := useCase.Execute(c.Request.Context(), username)
+		if err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+
+		c.Set("role", string(role))
+		c.Next()
+	}
+}
+
+// RequirePermission rejects the request unless the role loaded by
+// Authorize is granted the permission.
+func RequirePermission(permission enums.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := enums.AdminRole(c.GetString("role"))
+		if !role.Can(permission) {
+			c.Error(
+				errors.NewForbidden(
+					fmt.Sprintf(
+						"Role %q is not allowed to perform this action (%s)",
+						role, permission,
+					),
+				),
+			)
+			c.Abort()
+			return
+		}
+
 		c.Next()
 	}
 }
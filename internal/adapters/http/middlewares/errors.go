@@ -11,7 +11,9 @@ func ErrorHandler() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Next()
 
-		if len(c.Errors) == 0 {
+		// A streamed response that failed midway has already been started,
+		// so the error can no longer be written.
+		if len(c.Errors) == 0 || c.Writer.Written() {
 			return
 		}
 
@@ -0,0 +1,31 @@
+package middlewares
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/metrics"
+)
+
+// Metrics records the latency of every matched route. Unmatched paths are
+// skipped so arbitrary URLs cannot grow the number of series.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			return
+		}
+
+		metrics.ObserveUseCase(
+			"http",
+			c.Request.Method+" "+route,
+			strconv.Itoa(c.Writer.Status()),
+			time.Since(start),
+		)
+	}
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"github.com/MAD-py/pandora-core/internal/adapters/http/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/handlers"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/middlewares"
+	adminuser "github.com/MAD-py/pandora-core/internal/app/admin_user"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterAdminUserRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
+	listUC := adminuser.NewListUseCase(
+		deps.Validator, deps.Repositories.Credentials(),
+	)
+	createUC := adminuser.NewCreateUseCase(
+		deps.Validator,
+		deps.Repositories.Credentials(),
+		deps.Repositories.Audit(),
+	)
+	disableUC := adminuser.NewDisableUseCase(
+		deps.Validator,
+		deps.Repositories.Credentials(),
+		deps.Repositories.Audit(),
+	)
+
+	canManage := middlewares.RequirePermission(enums.PermissionAdminUsersManage)
+
+	adminUsers := rg.Group("/admin-users")
+	{
+		adminUsers.GET("", canManage, handlers.AdminUserList(listUC))
+		adminUsers.POST("", canManage, handlers.AdminUserCreate(createUC))
+		adminUsers.POST("/:id/disable", canManage, handlers.AdminUserDisable(disableUC))
+	}
+}
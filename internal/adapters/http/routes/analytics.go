@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"github.com/MAD-py/pandora-core/internal/adapters/http/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/handlers"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/middlewares"
+	"github.com/MAD-py/pandora-core/internal/app/analytics"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterAnalyticsRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
+	usageUC := analytics.NewUsageUseCase(
+		deps.Validator, deps.Repositories.Usage(),
+	)
+	exportUsageUC := analytics.NewExportUsageUseCase(
+		deps.Validator, deps.Repositories.Usage(),
+	)
+
+	canReadUsage := middlewares.RequirePermission(enums.PermissionUsageRead)
+
+	analyticsGroup := rg.Group("/analytics")
+	{
+		analyticsGroup.GET(
+			"/usage",
+			canReadUsage,
+			handlers.AnalyticsUsage(usageUC),
+		)
+		analyticsGroup.GET(
+			"/usage/export",
+			canReadUsage,
+			handlers.AnalyticsUsageExport(exportUsageUC),
+		)
+	}
+}
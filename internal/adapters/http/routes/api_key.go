@@ -14,31 +14,57 @@ import (
 
 func RegisterAPIKeyRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
 	createUC := apikey.NewCreateUseCase(
-		deps.Validator, deps.Repositories.APIKey(),
+		deps.Validator,
+		deps.APIKeyPrefix,
+		deps.Repositories.APIKey(),
+		deps.Repositories.Environment(),
+		deps.Repositories.Audit(),
 	)
 	updateUC := apikey.NewUpdateUseCase(
-		deps.Validator, deps.Repositories.APIKey(),
+		deps.Validator,
+		deps.Repositories.APIKey(),
+		deps.Repositories.Audit(),
 	)
 	deleteUC := apikey.NewDeleteUseCase(
-		deps.Validator, deps.Repositories.APIKey(),
+		deps.Validator,
+		deps.Repositories.APIKey(),
+		deps.Repositories.Audit(),
 	)
 	disableUC := disable.NewUseCase(
 		deps.Validator,
 		deps.Repositories.APIKey(),
+		deps.Repositories.Webhook(),
+		deps.Repositories.Audit(),
+	)
+	rotateUC := apikey.NewRotateUseCase(
+		deps.Validator,
+		deps.APIKeyPrefix,
+		deps.APIKeyMaxGracePeriod,
+		deps.Repositories.APIKey(),
+		deps.Repositories.Environment(),
+		deps.Repositories.Audit(),
 	)
 	enableUC := enable.NewUseCase(
 		deps.Validator,
 		deps.Repositories.APIKey(),
 		deps.Repositories.Environment(),
+		deps.Repositories.Audit(),
 	)
 
+	canWrite := middlewares.RequirePermission(enums.PermissionResourcesWrite)
+
 	apiKeys := rg.Group("/api-keys")
 	{
-		apiKeys.POST("", handlers.APIKeyCreate(createUC))
-		apiKeys.PATCH("/:id", handlers.APIKeyUpdate(updateUC))
-		apiKeys.DELETE("/:id", handlers.APIKeyDelete(deleteUC))
-		apiKeys.POST("/:id/disable", handlers.APIKeyDisable(disableUC))
-		apiKeys.POST("/:id/enable", handlers.APIKeyEnable(enableUC))
+		apiKeys.POST("", canWrite, handlers.APIKeyCreate(createUC))
+		apiKeys.PATCH("/:id", canWrite, handlers.APIKeyUpdate(updateUC))
+		apiKeys.DELETE("/:id", canWrite, handlers.APIKeyDelete(deleteUC))
+		apiKeys.POST(
+			"/:id/disable",
+			canWrite,
+			handlers.APIKeyDisable(disableUC),
+		)
+		apiKeys.POST("/:id/enable", canWrite, handlers.APIKeyEnable(enableUC))
+		apiKeys.POST("/:id/rotate", canWrite, handlers.APIKeyRotate(rotateUC))
 	}
 }
 
@@ -46,7 +72,9 @@ func RegisterAPIKeySensitiveRoutes(
 	rg *gin.RouterGroup, deps *bootstrap.Dependencies, middleware ...gin.HandlerFunc,
 ) {
 	revealKeyUC := apikey.NewRevealKeyUseCase(
-		deps.Validator, deps.Repositories.APIKey(),
+		deps.Validator,
+		deps.Repositories.APIKey(),
+		deps.Repositories.Audit(),
 	)
 
 	apiKey := rg.Group("/api-keys")
@@ -62,6 +90,10 @@ func RegisterAPIKeySensitiveRoutes(
 				),
 			}
 			revealKeyHandlers = append(revealKeyHandlers, middleware...)
+			revealKeyHandlers = append(
+				revealKeyHandlers,
+				middlewares.RequirePermission(enums.PermissionAPIKeyReveal),
+			)
 			revealKeyHandlers = append(revealKeyHandlers, handlers.APIKeyRevealKey(revealKeyUC))
 			apiKey.GET("/:id/reveal/key", revealKeyHandlers...)
 		}
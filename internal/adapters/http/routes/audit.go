@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/MAD-py/pandora-core/internal/adapters/http/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/handlers"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/middlewares"
+	"github.com/MAD-py/pandora-core/internal/app/audit"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterAuditRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
+	listUC := audit.NewListUseCase(
+		deps.Validator, deps.Repositories.Audit(),
+	)
+
+	canReadAudit := middlewares.RequirePermission(enums.PermissionAuditRead)
+
+	auditGroup := rg.Group("/audit")
+	{
+		auditGroup.GET("", canReadAudit, handlers.AuditList(listUC))
+	}
+}
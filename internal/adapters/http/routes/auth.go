@@ -9,7 +9,9 @@ import (
 
 func RegisterLoginRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
 	authUC := auth.NewAutenticateUseCase(
-		deps.Validator, deps.TokenProvider, deps.CredentialsRepo,
+		deps.Validator,
+		deps.TokenProvider,
+		deps.Repositories.Credentials(),
 	)
 
 	auth := rg.Group("/auth")
@@ -20,10 +22,14 @@ func RegisterLoginRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
 
 func RegisterAuthRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
 	passChangeUC := auth.NewPasswordChangeUseCase(
-		deps.Validator, deps.CredentialsRepo,
+		deps.Validator,
+		deps.Repositories.Credentials(),
+		deps.Repositories.Audit(),
 	)
 	reauthenticateUC := auth.NewReauthenticateUseCase(
-		deps.Validator, deps.TokenProvider, deps.CredentialsRepo,
+		deps.Validator,
+		deps.TokenProvider,
+		deps.Repositories.Credentials(),
 	)
 
 	auth := rg.Group("/auth")
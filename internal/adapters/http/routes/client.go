@@ -3,7 +3,9 @@ package routes
 import (
 	"github.com/MAD-py/pandora-core/internal/adapters/http/bootstrap"
 	"github.com/MAD-py/pandora-core/internal/adapters/http/handlers"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/middlewares"
 	"github.com/MAD-py/pandora-core/internal/app/client"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/gin-gonic/gin"
 )
 
@@ -15,30 +17,65 @@ func RegisterClientRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
 		deps.Validator, deps.Repositories.Client(),
 	)
 	createUC := client.NewCreateUseCase(
-		deps.Validator, deps.Repositories.Client(),
+		deps.Validator,
+		deps.Repositories.Client(),
+		deps.Repositories.Audit(),
 	)
 	updateUC := client.NewUpdateUseCase(
-		deps.Validator, deps.Repositories.Client(),
+		deps.Validator,
+		deps.Repositories.Client(),
+		deps.Repositories.Audit(),
 	)
 	deleteUC := client.NewDeleteUseCase(
-		deps.Validator, deps.Repositories.Client(),
+		deps.Validator,
+		deps.Repositories.Client(),
+		deps.Repositories.Audit(),
 	)
 	listProjectsUC := client.NewListProjectsUseCase(
 		deps.Validator,
 		deps.Repositories.Client(),
 		deps.Repositories.Project(),
 	)
+	createTokenUC := client.NewCreateTokenUseCase(
+		deps.Validator,
+		deps.Repositories.Client(),
+		deps.Repositories.ClientToken(),
+		deps.Repositories.Audit(),
+	)
+	listTokensUC := client.NewListTokensUseCase(
+		deps.Validator,
+		deps.Repositories.Client(),
+		deps.Repositories.ClientToken(),
+	)
+	deleteTokenUC := client.NewDeleteTokenUseCase(
+		deps.Validator,
+		deps.Repositories.ClientToken(),
+		deps.Repositories.Audit(),
+	)
+
+	canRead := middlewares.RequirePermission(enums.PermissionResourcesRead)
+	canWrite := middlewares.RequirePermission(enums.PermissionResourcesWrite)
 
 	clients := rg.Group("/clients")
 	{
-		clients.GET("", handlers.ClientList(listUC))
-		clients.POST("", handlers.ClientCreate(createUC))
-		clients.GET("/:id", handlers.ClientGet(getUC))
-		clients.PATCH("/:id", handlers.ClientUpdate(updateUC))
-		clients.DELETE("/:id", handlers.ClientDelete(deleteUC))
+		clients.GET("", canRead, handlers.ClientList(listUC))
+		clients.POST("", canWrite, handlers.ClientCreate(createUC))
+		clients.GET("/:id", canRead, handlers.ClientGet(getUC))
+		clients.PATCH("/:id", canWrite, handlers.ClientUpdate(updateUC))
+		clients.DELETE("/:id", canWrite, handlers.ClientDelete(deleteUC))
 		clients.GET(
 			"/:id/projects",
+			canRead,
 			handlers.ClientListProjects(listProjectsUC),
 		)
+		clients.GET("/:id/tokens", canRead, handlers.ClientTokenList(listTokensUC))
+		clients.POST(
+			"/:id/tokens", canWrite, handlers.ClientTokenCreate(createTokenUC),
+		)
+		clients.DELETE(
+			"/:id/tokens/:token_id",
+			canWrite,
+			handlers.ClientTokenDelete(deleteTokenUC),
+		)
 	}
 }
@@ -3,7 +3,9 @@ package routes
 import (
 	"github.com/MAD-py/pandora-core/internal/adapters/http/bootstrap"
 	"github.com/MAD-py/pandora-core/internal/adapters/http/handlers"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/middlewares"
 	"github.com/MAD-py/pandora-core/internal/app/environment"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/gin-gonic/gin"
 )
 
@@ -15,12 +17,17 @@ func RegisterEnvironmentRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies
 		deps.Validator,
 		deps.Repositories.Project(),
 		deps.Repositories.Environment(),
+		deps.Repositories.Audit(),
 	)
 	updateUC := environment.NewUpdateUseCase(
-		deps.Validator, deps.Repositories.Environment(),
+		deps.Validator,
+		deps.Repositories.Environment(),
+		deps.Repositories.Audit(),
 	)
 	deleteUC := environment.NewDeleteUseCase(
-		deps.Validator, deps.Repositories.Environment(),
+		deps.Validator,
+		deps.Repositories.Environment(),
+		deps.Repositories.Audit(),
 	)
 	listAPIKeysUC := environment.NewListAPIKeyUseCase(
 		deps.Validator,
@@ -28,51 +35,98 @@ func RegisterEnvironmentRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies
 		deps.Repositories.Environment(),
 	)
 	resetRequestUC := environment.NewResetRequestUseCase(
-		deps.Validator, deps.Repositories.Environment(),
+		deps.Validator,
+		deps.Repositories.Environment(),
+		deps.Repositories.Audit(),
 	)
 	assignServiceUC := environment.NewAssignServiceUseCase(
-		deps.Validator, deps.Repositories.Environment(),
+		deps.Validator,
+		deps.Repositories.Environment(),
+		deps.Repositories.Audit(),
 	)
 	removeServiceUC := environment.NewRemoveServiceUseCase(
-		deps.Validator, deps.Repositories.Environment(),
+		deps.Validator,
+		deps.Repositories.Environment(),
+		deps.Repositories.Audit(),
 	)
 	updateServiceUC := environment.NewUpdateServiceUseCase(
-		deps.Validator, deps.Repositories.Environment(),
+		deps.Validator,
+		deps.Repositories.Environment(),
+		deps.Repositories.Audit(),
+	)
+	listAlertsUC := environment.NewListAlertsUseCase(
+		deps.Validator,
+		deps.Repositories.Environment(),
+		deps.Repositories.QuotaAlert(),
+	)
+	createAlertUC := environment.NewCreateAlertUseCase(
+		deps.Validator,
+		deps.Repositories.Environment(),
+		deps.Repositories.QuotaAlert(),
+		deps.Repositories.Audit(),
+	)
+	deleteAlertUC := environment.NewDeleteAlertUseCase(
+		deps.Validator,
+		deps.Repositories.QuotaAlert(),
+		deps.Repositories.Audit(),
 	)
 
+	canRead := middlewares.RequirePermission(enums.PermissionResourcesRead)
+	canWrite := middlewares.RequirePermission(enums.PermissionResourcesWrite)
+
 	environments := rg.Group("/environments")
 	{
 		environments.POST(
-			"", handlers.EnvironmentCreate(createUc),
+			"", canWrite, handlers.EnvironmentCreate(createUc),
 		)
 		environments.GET(
-			"/:id", handlers.EnvironmentGet(getUC),
+			"/:id", canRead, handlers.EnvironmentGet(getUC),
 		)
 		environments.PATCH(
-			"/:id", handlers.EnvironmentUpdate(updateUC),
+			"/:id", canWrite, handlers.EnvironmentUpdate(updateUC),
 		)
 		environments.DELETE(
-			"/:id", handlers.EnvironmentDelete(deleteUC),
+			"/:id", canWrite, handlers.EnvironmentDelete(deleteUC),
 		)
 		environments.GET(
 			"/:id/api-keys",
+			canRead,
 			handlers.EnvironmentListAPIKeys(listAPIKeysUC),
 		)
 		environments.POST(
 			"/:id/services",
+			canWrite,
 			handlers.EnvironmentAssignService(assignServiceUC),
 		)
 		environments.DELETE(
 			"/:id/services/:service_id",
+			canWrite,
 			handlers.EnvironmentRemoveService(removeServiceUC),
 		)
 		environments.PATCH(
 			"/:id/services/:service_id",
+			canWrite,
 			handlers.EnvironmentUpdateService(updateServiceUC),
 		)
 		environments.POST(
 			"/:id/services/:service_id/reset-requests",
+			canWrite,
 			handlers.EnvironmentResetRequest(resetRequestUC),
 		)
+		environments.GET(
+			"/:id/services/:service_id/alerts",
+			canRead,
+			handlers.EnvironmentListAlerts(listAlertsUC),
+		)
+		environments.POST(
+			"/:id/services/:service_id/alerts",
+			canWrite,
+			handlers.EnvironmentCreateAlert(createAlertUC),
+		)
+		environments.DELETE(
+			"/:id/services/:service_id/alerts/:alert_id",
+			canWrite,
+			handlers.EnvironmentDeleteAlert(deleteAlertUC),
+		)
 	}
 }
@@ -0,0 +1,88 @@
+package routes
+
+import (
+	"github.com/MAD-py/pandora-core/internal/adapters/http/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/handlers"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/middlewares"
+	"github.com/MAD-py/pandora-core/internal/app/auth"
+	"github.com/MAD-py/pandora-core/internal/app/portal"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterPortalRoutes registers the API of the client portal. It is
+// authenticated with client tokens instead of admin access tokens, and every
+// use case behind it is scoped to the client of the token.
+func RegisterPortalRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
+	listProjectsUC := portal.NewListProjectsUseCase(
+		deps.Validator, deps.Repositories.Project(),
+	)
+	listEnvironmentsUC := portal.NewListEnvironmentsUseCase(
+		deps.Validator,
+		deps.Repositories.Project(),
+		deps.Repositories.Environment(),
+	)
+	getEnvironmentUC := portal.NewGetEnvironmentUseCase(
+		deps.Validator,
+		deps.Repositories.Project(),
+		deps.Repositories.Environment(),
+	)
+	listAPIKeysUC := portal.NewListAPIKeysUseCase(
+		deps.Validator,
+		deps.Repositories.APIKey(),
+		deps.Repositories.Project(),
+		deps.Repositories.Environment(),
+	)
+	createAPIKeyUC := portal.NewCreateAPIKeyUseCase(
+		deps.Validator,
+		deps.APIKeyPrefix,
+		deps.Repositories.Client(),
+		deps.Repositories.Project(),
+		deps.Repositories.Environment(),
+		deps.Repositories.APIKey(),
+		deps.Repositories.Audit(),
+	)
+	rotateAPIKeyUC := portal.NewRotateAPIKeyUseCase(
+		deps.Validator,
+		deps.APIKeyPrefix,
+		deps.APIKeyMaxGracePeriod,
+		deps.Repositories.APIKey(),
+		deps.Repositories.Client(),
+		deps.Repositories.Project(),
+		deps.Repositories.Environment(),
+		deps.Repositories.Audit(),
+	)
+	searchRequestsUC := portal.NewSearchRequestsUseCase(
+		deps.Validator, deps.Repositories.Request(),
+	)
+	usageUC := portal.NewUsageUseCase(
+		deps.Validator, deps.Repositories.Usage(),
+	)
+
+	portalGroup := rg.Group("/portal")
+	portalGroup.Use(
+		middlewares.ValidateClientToken(
+			auth.NewClientTokenValidationUseCase(
+				deps.Validator, deps.Repositories.ClientToken(),
+			),
+		),
+	)
+	{
+		portalGroup.GET("/projects", handlers.PortalListProjects(listProjectsUC))
+		portalGroup.GET(
+			"/projects/:id/environments",
+			handlers.PortalListEnvironments(listEnvironmentsUC),
+		)
+		portalGroup.GET(
+			"/environments/:id", handlers.PortalGetEnvironment(getEnvironmentUC),
+		)
+		portalGroup.GET(
+			"/environments/:id/api-keys", handlers.PortalListAPIKeys(listAPIKeysUC),
+		)
+		portalGroup.POST("/api-keys", handlers.PortalCreateAPIKey(createAPIKeyUC))
+		portalGroup.POST(
+			"/api-keys/:id/rotate", handlers.PortalRotateAPIKey(rotateAPIKeyUC),
+		)
+		portalGroup.GET("/requests", handlers.PortalSearchRequests(searchRequestsUC))
+		portalGroup.GET("/usage", handlers.PortalUsage(usageUC))
+	}
+}
@@ -3,7 +3,9 @@ package routes
 import (
 	"github.com/MAD-py/pandora-core/internal/adapters/http/bootstrap"
 	"github.com/MAD-py/pandora-core/internal/adapters/http/handlers"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/middlewares"
 	"github.com/MAD-py/pandora-core/internal/app/project"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/gin-gonic/gin"
 )
 
@@ -11,31 +13,44 @@ func RegisterProjectRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
 	getUC := project.NewGetUseCase(
 		deps.Validator, deps.Repositories.Project(),
 	)
-	listUC := project.NewListUseCase(deps.Repositories.Project())
-	createUC := project.NewCreateUseCase(
+	listUC := project.NewListUseCase(
 		deps.Validator, deps.Repositories.Project(),
 	)
+	createUC := project.NewCreateUseCase(
+		deps.Validator,
+		deps.Repositories.Project(),
+		deps.Repositories.Audit(),
+	)
 	updateUC := project.NewUpdateUseCase(
-		deps.Validator, deps.Repositories.Project(),
+		deps.Validator,
+		deps.Repositories.Project(),
+		deps.Repositories.Audit(),
 	)
 	deleteUC := project.NewDeleteUseCase(
-		deps.Validator, deps.Repositories.Project(),
+		deps.Validator,
+		deps.Repositories.Project(),
+		deps.Repositories.Audit(),
 	)
 	resetRequest := project.NewResetRequestUseCase(
-		deps.Validator, deps.Repositories.Project(),
+		deps.Validator,
+		deps.Repositories.Project(),
+		deps.Repositories.Audit(),
 	)
 	assignService := project.NewAssignServiceUseCase(
-		deps.Validator, deps.Repositories.Project(),
+		deps.Validator,
+		deps.Repositories.Project(),
+		deps.Repositories.Audit(),
 	)
 	removeService := project.NewRemoveServiceUseCase(
 		deps.Validator,
 		deps.Repositories.Project(),
 		deps.Repositories.Environment(),
+		deps.Repositories.Audit(),
 	)
 	updateService := project.NewUpdateServiceUseCase(
 		deps.Validator,
 		deps.Repositories.Project(),
-		deps.Repositories.Environment(),
+		deps.Repositories.Audit(),
 	)
 	listEvntiromentsUC := project.NewListEnvironmentsUseCase(
 		deps.Validator,
@@ -43,31 +58,39 @@ func RegisterProjectRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
 		deps.Repositories.Environment(),
 	)
 
+	canRead := middlewares.RequirePermission(enums.PermissionResourcesRead)
+	canWrite := middlewares.RequirePermission(enums.PermissionResourcesWrite)
+
 	projects := rg.Group("/projects")
 	{
-		projects.GET("", handlers.ProjectList(listUC))
-		projects.POST("", handlers.ProjectCreate(createUC))
-		projects.GET("/:id", handlers.ProjectGet(getUC))
-		projects.PATCH("/:id", handlers.ProjectUpdate(updateUC))
-		projects.DELETE("/:id", handlers.ProjectDelete(deleteUC))
+		projects.GET("", canRead, handlers.ProjectList(listUC))
+		projects.POST("", canWrite, handlers.ProjectCreate(createUC))
+		projects.GET("/:id", canRead, handlers.ProjectGet(getUC))
+		projects.PATCH("/:id", canWrite, handlers.ProjectUpdate(updateUC))
+		projects.DELETE("/:id", canWrite, handlers.ProjectDelete(deleteUC))
 		projects.GET(
 			"/:id/environments",
+			canRead,
 			handlers.ProjectListEnvironments(listEvntiromentsUC),
 		)
 		projects.POST(
 			"/:id/services",
+			canWrite,
 			handlers.ProjectAssignService(assignService),
 		)
 		projects.DELETE(
 			"/:id/services/:service_id",
+			canWrite,
 			handlers.ProjectRemoveService(removeService),
 		)
 		projects.PATCH(
 			"/:id/services/:service_id",
+			canWrite,
 			handlers.ProjectUpdateService(updateService),
 		)
 		projects.POST(
 			"/:id/services/:service_id/reset-requests",
+			canWrite,
 			handlers.ProjectResetRequest(resetRequest),
 		)
 	}
@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"github.com/MAD-py/pandora-core/internal/adapters/http/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/handlers"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/middlewares"
+	"github.com/MAD-py/pandora-core/internal/app/request"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterRequestRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
+	searchUC := request.NewSearchUseCase(
+		deps.Validator, deps.Repositories.Request(),
+	)
+	getUC := request.NewGetUseCase(
+		deps.Validator, deps.Repositories.Request(),
+	)
+	exportUC := request.NewExportUseCase(
+		deps.Validator, deps.Repositories.Request(),
+	)
+
+	canReadUsage := middlewares.RequirePermission(enums.PermissionUsageRead)
+
+	requests := rg.Group("/requests")
+	{
+		requests.GET("", canReadUsage, handlers.RequestSearch(searchUC))
+		requests.GET("/export", canReadUsage, handlers.RequestExport(exportUC))
+		requests.GET("/:id", canReadUsage, handlers.RequestGet(getUC))
+	}
+}
@@ -3,7 +3,9 @@ package routes
 import (
 	"github.com/MAD-py/pandora-core/internal/adapters/http/bootstrap"
 	"github.com/MAD-py/pandora-core/internal/adapters/http/handlers"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/middlewares"
 	"github.com/MAD-py/pandora-core/internal/app/service"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,29 +19,50 @@ func RegisterServiceRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
 		deps.Repositories.Request(),
 	)
 	createUC := service.NewCreateUseCase(
-		deps.Validator, deps.Repositories.Service(),
+		deps.Validator,
+		deps.Repositories.Service(),
+		deps.Repositories.Audit(),
 	)
 	deleteUC := service.NewDeleteUseCase(
 		deps.Validator,
 		deps.Repositories.Service(),
 		deps.Repositories.Project(),
+		deps.Repositories.Audit(),
 	)
 	updateStatusUC := service.NewUpdateStatusUseCase(
-		deps.Validator, deps.Repositories.Service(),
+		deps.Validator,
+		deps.Repositories.Service(),
+		deps.Repositories.Audit(),
+	)
+	updateRetentionUC := service.NewUpdateRetentionUseCase(
+		deps.Validator,
+		deps.Repositories.Service(),
+		deps.Repositories.Audit(),
 	)
 
+	canRead := middlewares.RequirePermission(enums.PermissionResourcesRead)
+	canWrite := middlewares.RequirePermission(enums.PermissionResourcesWrite)
+	canReadUsage := middlewares.RequirePermission(enums.PermissionUsageRead)
+
 	services := rg.Group("/services")
 	{
-		services.GET("", handlers.ServiceList(listUC))
-		services.POST("", handlers.ServiceCreate(createUC))
-		services.DELETE("/:id", handlers.ServiceDelete(deleteUC))
+		services.GET("", canRead, handlers.ServiceList(listUC))
+		services.POST("", canWrite, handlers.ServiceCreate(createUC))
+		services.DELETE("/:id", canWrite, handlers.ServiceDelete(deleteUC))
 		services.GET(
 			"/:id/requests",
+			canReadUsage,
 			handlers.ServiceListRequests(listRequestUC),
 		)
 		services.PATCH(
 			"/:id/status",
+			canWrite,
 			handlers.ServiceUpdateStatus(updateStatusUC),
 		)
+		services.PATCH(
+			"/:id/retention",
+			canWrite,
+			handlers.ServiceUpdateRetention(updateRetentionUC),
+		)
 	}
 }
@@ -0,0 +1,54 @@
+package routes
+
+import (
+	"github.com/MAD-py/pandora-core/internal/adapters/http/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/handlers"
+	"github.com/MAD-py/pandora-core/internal/adapters/http/middlewares"
+	"github.com/MAD-py/pandora-core/internal/app/webhook"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterWebhookRoutes(rg *gin.RouterGroup, deps *bootstrap.Dependencies) {
+	getUC := webhook.NewGetUseCase(
+		deps.Validator, deps.Repositories.Webhook(),
+	)
+	listUC := webhook.NewListUseCase(
+		deps.Validator, deps.Repositories.Webhook(),
+	)
+	createUC := webhook.NewCreateUseCase(
+		deps.Validator,
+		deps.Repositories.Webhook(),
+		deps.Repositories.Audit(),
+	)
+	updateUC := webhook.NewUpdateUseCase(
+		deps.Validator,
+		deps.Repositories.Webhook(),
+		deps.Repositories.Audit(),
+	)
+	deleteUC := webhook.NewDeleteUseCase(
+		deps.Validator,
+		deps.Repositories.Webhook(),
+		deps.Repositories.Audit(),
+	)
+	listDeliveriesUC := webhook.NewListDeliveriesUseCase(
+		deps.Validator, deps.Repositories.Webhook(),
+	)
+
+	canRead := middlewares.RequirePermission(enums.PermissionResourcesRead)
+	canWrite := middlewares.RequirePermission(enums.PermissionResourcesWrite)
+
+	webhooks := rg.Group("/webhooks")
+	{
+		webhooks.GET("", canRead, handlers.WebhookList(listUC))
+		webhooks.POST("", canWrite, handlers.WebhookCreate(createUC))
+		webhooks.GET("/:id", canRead, handlers.WebhookGet(getUC))
+		webhooks.PATCH("/:id", canWrite, handlers.WebhookUpdate(updateUC))
+		webhooks.DELETE("/:id", canWrite, handlers.WebhookDelete(deleteUC))
+		webhooks.GET(
+			"/:id/deliveries",
+			canRead,
+			handlers.WebhookListDeliveries(listDeliveriesUC),
+		)
+	}
+}
@@ -6,10 +6,12 @@ import (
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 
 	"github.com/MAD-py/pandora-core/internal/adapters/http/bootstrap"
 	"github.com/MAD-py/pandora-core/internal/adapters/http/middlewares"
 	"github.com/MAD-py/pandora-core/internal/adapters/http/routes"
+	"github.com/MAD-py/pandora-core/internal/adapters/tracing"
 	"github.com/MAD-py/pandora-core/internal/app/auth"
 )
 
@@ -25,6 +27,12 @@ import (
 // @tag.name Projects
 // @tag.name Environments
 // @tag.name API Keys
+// @tag.name Requests
+// @tag.name Analytics
+// @tag.name Webhooks
+// @tag.name Audit
+// @tag.name Admin Users
+// @tag.name Portal
 
 // @contact.name Pandora Core Support
 // @contact.url http://example.com/support
@@ -40,6 +48,10 @@ import (
 // @in header
 // @name Authorization
 
+// @securitydefinitions.apikey ClientToken
+// @in header
+// @name Authorization
+
 type Server struct {
 	addr string
 
@@ -72,6 +84,8 @@ func (s *Server) Run() error {
 		engine.Use(middlewares.VersionHeader())
 	}
 
+	engine.Use(otelgin.Middleware(tracing.ServiceName))
+	engine.Use(middlewares.Metrics())
 	engine.Use(middlewares.ErrorHandler())
 
 	v1 := engine.Group("/api/v1")
@@ -81,6 +95,12 @@ func (s *Server) Run() error {
 		routes.RegisterLoginRoutes(v1, s.deps)
 	}
 
+	authorizationMiddleware := middlewares.Authorize(
+		auth.NewAuthorizationUseCase(
+			s.deps.Validator, s.deps.Repositories.Credentials(),
+		),
+	)
+
 	v1Protected := v1.Group("")
 	v1Protected.Use(
 		middlewares.ValidateAccessToken(
@@ -88,6 +108,7 @@ func (s *Server) Run() error {
 				s.deps.Validator, s.deps.TokenProvider,
 			),
 		),
+		authorizationMiddleware,
 	)
 
 	{
@@ -96,7 +117,7 @@ func (s *Server) Run() error {
 
 	passwordResetMiddleware := middlewares.ForcePasswordReset(
 		auth.NewResetPasswordUseCase(
-			s.deps.Validator, s.deps.CredentialsRepo,
+			s.deps.Validator, s.deps.Repositories.Credentials(),
 		),
 	)
 	v1Protected.Use(passwordResetMiddleware)
@@ -107,10 +128,18 @@ func (s *Server) Run() error {
 		routes.RegisterProjectRoutes(v1Protected, s.deps)
 		routes.RegisterEnvironmentRoutes(v1Protected, s.deps)
 		routes.RegisterAPIKeyRoutes(v1Protected, s.deps)
+		routes.RegisterRequestRoutes(v1Protected, s.deps)
+		routes.RegisterAnalyticsRoutes(v1Protected, s.deps)
+		routes.RegisterWebhookRoutes(v1Protected, s.deps)
+		routes.RegisterAuditRoutes(v1Protected, s.deps)
+		routes.RegisterAdminUserRoutes(v1Protected, s.deps)
 	}
 
 	{
-		routes.RegisterAPIKeySensitiveRoutes(v1, s.deps, passwordResetMiddleware)
+		routes.RegisterAPIKeySensitiveRoutes(
+			v1, s.deps, authorizationMiddleware, passwordResetMiddleware,
+		)
+		routes.RegisterPortalRoutes(v1, s.deps)
 	}
 
 	s.server = &http.Server{
@@ -0,0 +1,304 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+const namespace = "pandora"
+
+var registry = prometheus.NewRegistry()
+
+var (
+	validations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "api_key_validations_total",
+			Help:      "API key validations by operation, result and failure code.",
+		},
+		[]string{"operation", "result", "failure_code"},
+	)
+
+	consumptions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "quota_consumptions_total",
+			Help:      "Requests that consumed quota, by operation and service.",
+		},
+		[]string{"operation", "service", "version"},
+	)
+
+	consumedUnits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "quota_consumed_units_total",
+			Help:      "Quota units consumed, by operation and service.",
+		},
+		[]string{"operation", "service", "version"},
+	)
+
+	availableRequests = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "quota_available_requests",
+			Help:      "Requests an environment can still consume from a service, as of its last consumption.",
+		},
+		[]string{"environment_id", "service", "version"},
+	)
+
+	useCaseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "use_case_duration_seconds",
+			Help:      "Latency of the use case behind each gRPC method and HTTP route.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"transport", "operation", "code"},
+	)
+
+	repositoryDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "repository_query_duration_seconds",
+			Help:      "Latency of the database queries issued by each repository method.",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+		},
+		[]string{"repository", "method", "result"},
+	)
+
+	cacheLookups = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_lookups_total",
+			Help:      "Validation lookups read through the cache, by repository and result.",
+		},
+		[]string{"repository", "result"},
+	)
+
+	requestLogQueue = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "request_log_queue_length",
+			Help:      "Requests waiting in the asynchronous request log queue.",
+		},
+	)
+
+	requestLogBackpressure = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "request_log_backpressure_total",
+			Help:      "Requests that waited for room in the full asynchronous request log queue.",
+		},
+	)
+
+	requestLogDropped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "request_log_dropped_total",
+			Help:      "Requests the asynchronous request log dropped, by reason.",
+		},
+		[]string{"reason"},
+	)
+
+	requestLogFlushSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_log_flush_size",
+			Help:      "Requests written by each flush of the asynchronous request log, by result.",
+			Buckets:   prometheus.ExponentialBuckets(1, 4, 8),
+		},
+		[]string{"result"},
+	)
+
+	requestLogFlushDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_log_flush_duration_seconds",
+			Help:      "Duration of the flushes of the asynchronous request log.",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+		},
+	)
+
+	jobRuns = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "taskengine_job_runs_total",
+			Help:      "Task engine job runs by job and outcome.",
+		},
+		[]string{"job", "outcome"},
+	)
+
+	jobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "taskengine_job_duration_seconds",
+			Help:      "Duration of task engine job runs.",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+		},
+		[]string{"job"},
+	)
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		validations,
+		consumptions,
+		consumedUnits,
+		availableRequests,
+		useCaseDuration,
+		repositoryDuration,
+		cacheLookups,
+		requestLogQueue,
+		requestLogBackpressure,
+		requestLogDropped,
+		requestLogFlushSize,
+		requestLogFlushDuration,
+		jobRuns,
+		jobDuration,
+	)
+}
+
+// Handler serves every Pandora metric in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// ObserveValidation counts the outcome of an API key validation.
+func ObserveValidation(operation string, response *dto.APIKeyValidateResponse) {
+	result := "valid"
+	if !response.Valid {
+		result = "invalid"
+	}
+
+	validations.
+		WithLabelValues(operation, result, string(response.FailureCode)).
+		Inc()
+}
+
+// ObserveConsumption records the quota taken by a validation and what is
+// left of it. Requests without units consume one. An exhausted quota is
+// reported as zero available requests and an unlimited one is not reported
+// at all.
+func ObserveConsumption(
+	operation string,
+	req *dto.APIKeyValidate,
+	response *dto.APIKeyValidateResponse,
+	availableRequest int,
+) {
+	if response.Environment == nil {
+		return
+	}
+
+	gauge := prometheus.Labels{
+		"environment_id": strconv.Itoa(response.Environment.ID),
+		"service":        req.ServiceName,
+		"version":        req.ServiceVersion,
+	}
+
+	if response.FailureCode == enums.APIKeyValidationFailureCodeQuotaExceeded {
+		availableRequests.With(gauge).Set(0)
+		return
+	}
+
+	if !response.Valid {
+		return
+	}
+
+	consumptions.
+		WithLabelValues(operation, req.ServiceName, req.ServiceVersion).
+		Inc()
+	consumedUnits.
+		WithLabelValues(operation, req.ServiceName, req.ServiceVersion).
+		Add(float64(max(req.Units, 1)))
+
+	if availableRequest < 0 {
+		availableRequests.Delete(gauge)
+		return
+	}
+
+	availableRequests.With(gauge).Set(float64(availableRequest))
+}
+
+// ObserveUseCase records how long the use case behind an operation took.
+func ObserveUseCase(transport, operation, code string, duration time.Duration) {
+	useCaseDuration.
+		WithLabelValues(transport, operation, code).
+		Observe(duration.Seconds())
+}
+
+// ObserveRepositoryQuery records how long a query of a repository method
+// took.
+func ObserveRepositoryQuery(
+	repository, method string, duration time.Duration, err error,
+) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+
+	repositoryDuration.
+		WithLabelValues(repository, method, result).
+		Observe(duration.Seconds())
+}
+
+// ObserveCacheLookup counts a lookup of a repository read through the
+// cache, as a hit when it was served without querying the database.
+func ObserveCacheLookup(repository string, hit bool) {
+	result := "hit"
+	if !hit {
+		result = "miss"
+	}
+
+	cacheLookups.WithLabelValues(repository, result).Inc()
+}
+
+// ObserveRequestLogQueue records how many requests wait in the
+// asynchronous request log queue.
+func ObserveRequestLogQueue(length int) {
+	requestLogQueue.Set(float64(length))
+}
+
+// ObserveRequestLogBackpressure counts a request that had to wait for room
+// in the asynchronous request log queue.
+func ObserveRequestLogBackpressure() {
+	requestLogBackpressure.Inc()
+}
+
+// ObserveRequestLogDropped counts the requests the asynchronous request log
+// dropped for the reason.
+func ObserveRequestLogDropped(reason string, count int) {
+	requestLogDropped.WithLabelValues(reason).Add(float64(count))
+}
+
+// ObserveRequestLogFlush records the size and duration of a flush of the
+// asynchronous request log.
+func ObserveRequestLogFlush(size int, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+
+	requestLogFlushSize.WithLabelValues(result).Observe(float64(size))
+	requestLogFlushDuration.Observe(duration.Seconds())
+}
+
+// ObserveJob records the outcome and duration of a task engine job run.
+func ObserveJob(job string, duration time.Duration, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+	}
+
+	jobRuns.WithLabelValues(job, outcome).Inc()
+	jobDuration.WithLabelValues(job).Observe(duration.Seconds())
+}
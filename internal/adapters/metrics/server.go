@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"log"
+	"net/http"
+)
+
+type Server struct {
+	addr string
+
+	server *http.Server
+}
+
+func (s *Server) Run() error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+
+	s.server = &http.Server{
+		Addr:    s.addr,
+		Handler: mux,
+	}
+
+	log.Printf("[INFO] Metrics server is running on port: %s\n", s.addr)
+	if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.Printf("[ERROR] Failed to start metrics server: %v\n", err)
+		return err
+	}
+
+	return nil
+}
+
+func NewServer(addr string) *Server {
+	return &Server{addr: addr}
+}
@@ -0,0 +1,136 @@
+package persistence_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// TestPostgresProtectLegacyKeys stores a key in clear text, as versions
+// before hashed keys did, and checks that protecting it leaves only its
+// hash, prefix and encrypted copy, and that a second run changes nothing.
+func TestPostgresProtectLegacyKeys(t *testing.T) {
+	dns, exists := os.LookupEnv("PANDORA_TEST_DB_DNS")
+	if !exists {
+		t.Skip("PANDORA_TEST_DB_DNS is not set")
+	}
+
+	ctx := context.Background()
+	repos := newPostgresRepositories(t, dns)
+	defer repos.Close()
+
+	pool, err := pgxpool.New(ctx, dns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+
+	client := &entities.Client{
+		Type: enums.ClientTypeOrganization, Name: "acme", Email: "acme@example.com",
+	}
+	if err := repos.Client().Create(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+
+	project := &entities.Project{
+		Name: "project", Status: enums.ProjectStatusEnabled, ClientID: client.ID,
+	}
+	if err := repos.Project().Create(ctx, project); err != nil {
+		t.Fatal(err)
+	}
+
+	environment := &entities.Environment{
+		Name:      "production",
+		Type:      enums.EnvironmentTypeLive,
+		Status:    enums.EnvironmentStatusEnabled,
+		ProjectID: project.ID,
+	}
+	if err := repos.Environment().Create(ctx, environment); err != nil {
+		t.Fatal(err)
+	}
+
+	legacy := new(entities.APIKey)
+	if err := legacy.GenerateKey("pdr", enums.EnvironmentTypeLive); err != nil {
+		t.Fatal(err)
+	}
+
+	var id int
+	err = pool.QueryRow(
+		ctx,
+		`INSERT INTO api_key (environment_id, key, status)
+		VALUES ($1, $2, 'enabled') RETURNING id;`,
+		environment.ID, legacy.Key,
+	).Scan(&id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	protected, protectErr := repos.APIKey().ProtectLegacyKeys(ctx)
+	if protectErr != nil {
+		t.Fatal(protectErr)
+	}
+	if protected != 1 {
+		t.Fatalf("protected %d keys, want 1", protected)
+	}
+
+	type storedKey struct {
+		key                              *string
+		keyHash, keyPrefix, encryptedKey string
+	}
+	read := func() storedKey {
+		var stored storedKey
+		err := pool.QueryRow(
+			ctx,
+			`SELECT key, key_hash, key_prefix, encrypted_key
+			FROM api_key WHERE id = $1;`,
+			id,
+		).Scan(&stored.key, &stored.keyHash, &stored.keyPrefix, &stored.encryptedKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return stored
+	}
+
+	stored := read()
+	if stored.key != nil {
+		t.Fatal("the clear text key was not cleared")
+	}
+	if stored.keyHash != apiKeyProtector.Hash(legacy.Key) {
+		t.Fatalf("key_hash is %q, want the hash of the key", stored.keyHash)
+	}
+	if stored.keyPrefix != legacy.Key[:entities.APIKeyPrefixLength] {
+		t.Fatalf("key_prefix is %q", stored.keyPrefix)
+	}
+
+	decrypted, decryptErr := apiKeyProtector.Decrypt(stored.encryptedKey)
+	if decryptErr != nil {
+		t.Fatal(decryptErr)
+	}
+	if decrypted != legacy.Key {
+		t.Fatal("the encrypted key does not decrypt to the key")
+	}
+
+	found, findErr := repos.APIKey().GetByKey(ctx, legacy.Key)
+	if findErr != nil {
+		t.Fatal(findErr)
+	}
+	if found.ID != id {
+		t.Fatalf("found key %d, want %d", found.ID, id)
+	}
+
+	protected, protectErr = repos.APIKey().ProtectLegacyKeys(ctx)
+	if protectErr != nil {
+		t.Fatal(protectErr)
+	}
+	if protected != 0 {
+		t.Fatalf("rerun protected %d keys, want 0", protected)
+	}
+	if read() != stored {
+		t.Fatal("rerun changed the protected key")
+	}
+}
@@ -0,0 +1,230 @@
+package conformance
+
+import (
+	"sync"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+func (s *Suite) TestAPIKeyLookup() {
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, nil)
+	environment := s.createEnvironment(project.ID, nil)
+	apiKey := s.createAPIKey(environment.ID)
+
+	found, err := s.repos.APIKey().GetByKey(s.ctx, apiKey.Key)
+	s.requireNoError(err)
+	s.Equal(apiKey.ID, found.ID)
+	s.Equal(apiKey.KeyPrefix, found.KeyPrefix)
+
+	key, err := s.repos.APIKey().GetKeyByID(s.ctx, apiKey.ID)
+	s.requireNoError(err)
+	s.Equal(apiKey.Key, key)
+
+	exists, err := s.repos.APIKey().Exists(s.ctx, apiKey.Key)
+	s.requireNoError(err)
+	s.True(exists)
+
+	err = s.repos.APIKey().Create(s.ctx, &entities.APIKey{
+		Key:           apiKey.Key,
+		KeyPrefix:     apiKey.KeyPrefix,
+		Status:        enums.APIKeyStatusEnabled,
+		EnvironmentID: environment.ID,
+	})
+	s.requireCode(errors.CodeAlreadyExists, err)
+
+	s.requireNoError(s.repos.APIKey().UpdateStatus(
+		s.ctx, apiKey.ID, enums.APIKeyStatusDisabled,
+	))
+
+	usable, err := s.repos.APIKey().CountUsableByEnvironment(s.ctx, environment.ID)
+	s.requireNoError(err)
+	s.Zero(usable)
+
+	s.requireNoError(s.repos.APIKey().Delete(s.ctx, apiKey.ID))
+
+	_, err = s.repos.APIKey().GetByKey(s.ctx, apiKey.Key)
+	s.requireCode(errors.CodeNotFound, err)
+}
+
+func (s *Suite) TestAPIKeyMissingEnvironment() {
+	apiKey := &entities.APIKey{
+		Status:        enums.APIKeyStatusEnabled,
+		EnvironmentID: 999,
+	}
+	s.requireNoError(apiKey.GenerateKey("pdr", enums.EnvironmentTypeLive))
+
+	s.requireCode(errors.CodeNotFound, s.repos.APIKey().Create(s.ctx, apiKey))
+}
+
+func (s *Suite) TestAPIKeyRotation() {
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, nil)
+	environment := s.createEnvironment(project.ID, nil)
+	rotated := s.createAPIKey(environment.ID)
+
+	graceEndsAt := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+
+	successor := &entities.APIKey{
+		Status:        enums.APIKeyStatusEnabled,
+		EnvironmentID: environment.ID,
+	}
+	s.requireNoError(successor.GenerateKey("pdr", enums.EnvironmentTypeLive))
+	s.requireNoError(s.repos.APIKey().Rotate(
+		s.ctx, rotated.ID, graceEndsAt, successor, 0,
+	))
+	s.NotZero(successor.ID)
+
+	found, err := s.repos.APIKey().GetByID(s.ctx, successor.ID)
+	s.requireNoError(err)
+	s.Equal(rotated.ID, found.RotatedFromID)
+
+	found, err = s.repos.APIKey().GetByID(s.ctx, rotated.ID)
+	s.requireNoError(err)
+	s.True(found.GraceEndsAt.Equal(graceEndsAt))
+
+	// The replaced key is usable until its grace period ends.
+	usable, err := s.repos.APIKey().CountUsableByEnvironment(s.ctx, environment.ID)
+	s.requireNoError(err)
+	s.Equal(2, usable)
+
+	// A key is replaced once.
+	other := &entities.APIKey{
+		Status:        enums.APIKeyStatusEnabled,
+		EnvironmentID: environment.ID,
+	}
+	s.requireNoError(other.GenerateKey("pdr", enums.EnvironmentTypeLive))
+	s.requireCode(
+		errors.CodeAlreadyExists,
+		s.repos.APIKey().Rotate(s.ctx, rotated.ID, graceEndsAt, other, 0),
+	)
+
+	disabled, err := s.repos.APIKey().DisableRotated(s.ctx, time.Now())
+	s.requireNoError(err)
+	s.Empty(disabled)
+
+	disabled, err = s.repos.APIKey().DisableRotated(s.ctx, graceEndsAt)
+	s.requireNoError(err)
+	s.Require().Len(disabled, 1)
+	s.Equal(rotated.ID, disabled[0].ID)
+
+	found, err = s.repos.APIKey().GetByID(s.ctx, rotated.ID)
+	s.requireNoError(err)
+	s.Equal(enums.APIKeyStatusDisabled, found.Status)
+}
+
+func (s *Suite) TestAPIKeyLimit() {
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, nil)
+	environment := s.createEnvironment(project.ID, nil)
+
+	newAPIKey := func() *entities.APIKey {
+		apiKey := &entities.APIKey{
+			Status:        enums.APIKeyStatusEnabled,
+			EnvironmentID: environment.ID,
+		}
+		s.requireNoError(apiKey.GenerateKey("pdr", enums.EnvironmentTypeLive))
+		return apiKey
+	}
+
+	first := newAPIKey()
+	s.requireNoError(s.repos.APIKey().CreateWithinLimit(s.ctx, first, 2))
+	s.NotZero(first.ID)
+	s.requireNoError(s.repos.APIKey().CreateWithinLimit(s.ctx, newAPIKey(), 2))
+
+	err := s.repos.APIKey().CreateWithinLimit(s.ctx, newAPIKey(), 2)
+	s.requireCode(errors.CodeValidationFailed, err)
+
+	// Only usable keys count toward the limit.
+	s.requireNoError(s.repos.APIKey().UpdateStatus(
+		s.ctx, first.ID, enums.APIKeyStatusDisabled,
+	))
+	s.requireNoError(s.repos.APIKey().CreateWithinLimit(s.ctx, newAPIKey(), 2))
+
+	// A rotation counts the replaced key until its grace period ends.
+	rotated := newAPIKey()
+	s.requireNoError(s.repos.APIKey().Create(s.ctx, rotated))
+
+	graceEndsAt := time.Now().Add(time.Hour)
+	err = s.repos.APIKey().Rotate(s.ctx, rotated.ID, graceEndsAt, newAPIKey(), 3)
+	s.requireCode(errors.CodeValidationFailed, err)
+
+	found, err := s.repos.APIKey().GetByID(s.ctx, rotated.ID)
+	s.requireNoError(err)
+	s.True(found.GraceEndsAt.IsZero())
+
+	s.requireNoError(
+		s.repos.APIKey().Rotate(s.ctx, rotated.ID, graceEndsAt, newAPIKey(), 4),
+	)
+
+	usable, err := s.repos.APIKey().CountUsableByEnvironment(s.ctx, environment.ID)
+	s.requireNoError(err)
+	s.Equal(4, usable)
+}
+
+func (s *Suite) TestAPIKeyLimitUnderConcurrency() {
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, nil)
+	environment := s.createEnvironment(project.ID, nil)
+
+	const limit, callers = 3, 20
+
+	var (
+		mu      sync.Mutex
+		created int
+	)
+
+	var wg sync.WaitGroup
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			apiKey := &entities.APIKey{
+				Status:        enums.APIKeyStatusEnabled,
+				EnvironmentID: environment.ID,
+			}
+			if apiKey.GenerateKey("pdr", enums.EnvironmentTypeLive) != nil {
+				return
+			}
+
+			err := s.repos.APIKey().CreateWithinLimit(s.ctx, apiKey, limit)
+			if err != nil {
+				return
+			}
+
+			mu.Lock()
+			created++
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	s.Equal(limit, created)
+
+	usable, err := s.repos.APIKey().CountUsableByEnvironment(s.ctx, environment.ID)
+	s.requireNoError(err)
+	s.Equal(limit, usable)
+}
+
+func (s *Suite) TestAPIKeyProtectLegacyKeysLeavesProtectedKeys() {
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, nil)
+	environment := s.createEnvironment(project.ID, nil)
+	apiKey := s.createAPIKey(environment.ID)
+
+	protected, err := s.repos.APIKey().ProtectLegacyKeys(s.ctx)
+	s.requireNoError(err)
+	s.Zero(protected)
+
+	found, err := s.repos.APIKey().GetByKey(s.ctx, apiKey.Key)
+	s.requireNoError(err)
+	s.Equal(apiKey.ID, found.ID)
+
+	key, err := s.repos.APIKey().GetKeyByID(s.ctx, apiKey.ID)
+	s.requireNoError(err)
+	s.Equal(apiKey.Key, key)
+}
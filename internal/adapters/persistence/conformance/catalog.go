@@ -0,0 +1,201 @@
+package conformance
+
+import (
+	"strconv"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+func (s *Suite) TestServiceLifecycle() {
+	service := s.createService("billing")
+	s.NotZero(service.ID)
+	s.False(service.CreatedAt.IsZero())
+
+	found, err := s.repos.Service().GetByNameAndVersion(s.ctx, "billing", "v1")
+	s.requireNoError(err)
+	s.Equal(service.ID, found.ID)
+
+	updated, err := s.repos.Service().UpdateStatus(
+		s.ctx, service.ID, enums.ServiceStatusDeprecated,
+	)
+	s.requireNoError(err)
+	s.Equal(enums.ServiceStatusDeprecated, updated.Status)
+
+	total, err := s.repos.Service().Count(
+		s.ctx, &dto.ServiceFilter{Status: enums.ServiceStatusEnabled},
+	)
+	s.requireNoError(err)
+	s.Zero(total)
+
+	s.requireNoError(s.repos.Service().Delete(s.ctx, service.ID))
+
+	_, err = s.repos.Service().GetByID(s.ctx, service.ID)
+	s.requireCode(errors.CodeNotFound, err)
+
+	s.requireCode(errors.CodeNotFound, s.repos.Service().Delete(s.ctx, service.ID))
+}
+
+func (s *Suite) TestServiceUniqueNameAndVersion() {
+	s.createService("billing")
+
+	err := s.repos.Service().Create(s.ctx, &entities.Service{
+		Name:    "billing",
+		Version: "v1",
+		Status:  enums.ServiceStatusEnabled,
+	})
+	s.requireCode(errors.CodeAlreadyExists, err)
+}
+
+func (s *Suite) TestServicePagination() {
+	var services []*entities.Service
+	for _, name := range []string{"a", "b", "c"} {
+		services = append(services, s.createService(name))
+	}
+
+	page := &dto.Pagination{Limit: 2}
+	first, err := s.repos.Service().List(s.ctx, nil, page)
+	s.requireNoError(err)
+
+	// One service more than the page holds tells a next page exists.
+	s.Require().Len(first, 3)
+	s.Equal(services[2].ID, first[0].ID)
+	s.Equal(services[1].ID, first[1].ID)
+
+	first, cursor := dto.TrimPage(first, page, func(s *entities.Service) *dto.Cursor {
+		return &dto.Cursor{Time: s.CreatedAt, ID: strconv.Itoa(s.ID)}
+	})
+	s.Require().NotEmpty(cursor)
+
+	second, err := s.repos.Service().List(
+		s.ctx, nil, &dto.Pagination{Limit: 2, Cursor: cursor},
+	)
+	s.requireNoError(err)
+	s.Require().Len(second, 1)
+	s.Equal(services[0].ID, second[0].ID)
+
+	_, err = s.repos.Service().List(s.ctx, nil, &dto.Pagination{Cursor: "@"})
+	s.requireCode(errors.CodeValidationFailed, err)
+}
+
+func (s *Suite) TestClientUniqueNameAndUpdate() {
+	client := s.createClient("acme")
+
+	err := s.repos.Client().Create(s.ctx, &entities.Client{
+		Type:  enums.ClientTypeDeveloper,
+		Name:  "acme",
+		Email: "other@example.com",
+	})
+	s.requireCode(errors.CodeAlreadyExists, err)
+
+	updated, err := s.repos.Client().Update(
+		s.ctx, client.ID, &dto.ClientUpdate{Email: "billing@example.com"},
+	)
+	s.requireNoError(err)
+	s.Equal("acme", updated.Name)
+	s.Equal("billing@example.com", updated.Email)
+}
+
+func (s *Suite) TestProjectMissingClient() {
+	err := s.repos.Project().Create(s.ctx, &entities.Project{
+		Name:     "orphan",
+		Status:   enums.ProjectStatusEnabled,
+		ClientID: 999,
+	})
+	s.requireCode(errors.CodeNotFound, err)
+
+	total, err := s.repos.Project().Count(s.ctx)
+	s.requireNoError(err)
+	s.Zero(total)
+}
+
+func (s *Suite) TestProjectWithServices() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 100})
+
+	s.Require().Len(project.Services, 1)
+	s.Equal("billing", project.Services[0].Name)
+
+	found, err := s.repos.Project().GetByID(s.ctx, project.ID)
+	s.requireNoError(err)
+	s.Require().Len(found.Services, 1)
+	s.Equal(100, found.Services[0].MaxRequests)
+	s.Equal(100, found.Services[0].AvailableRequest)
+
+	exists, err := s.repos.Project().ExistsServiceIn(s.ctx, service.ID)
+	s.requireNoError(err)
+	s.True(exists)
+
+	err = s.repos.Project().AddService(s.ctx, project.ID, &entities.ProjectService{
+		ID:             service.ID,
+		MaxRequests:    10,
+		ResetFrequency: enums.ProjectServiceResetFrequencyDaily,
+	})
+	s.requireCode(errors.CodeAlreadyExists, err)
+}
+
+func (s *Suite) TestDeleteClientCascades() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 100})
+	environment := s.createEnvironment(project.ID, map[int]int{service.ID: 10})
+	apiKey := s.createAPIKey(environment.ID)
+
+	s.requireNoError(s.repos.Client().Delete(s.ctx, client.ID))
+
+	exists, err := s.repos.Project().Exists(s.ctx, project.ID)
+	s.requireNoError(err)
+	s.False(exists)
+
+	exists, err = s.repos.Environment().Exists(s.ctx, environment.ID)
+	s.requireNoError(err)
+	s.False(exists)
+
+	_, err = s.repos.APIKey().GetByID(s.ctx, apiKey.ID)
+	s.requireCode(errors.CodeNotFound, err)
+
+	exists, err = s.repos.Service().Exists(s.ctx, service.ID)
+	s.requireNoError(err)
+	s.True(exists)
+}
+
+func (s *Suite) TestRemoveServiceFromProjectEnvironments() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 100})
+	s.createEnvironment(project.ID, map[int]int{service.ID: 10})
+	s.createEnvironment(project.ID, map[int]int{service.ID: 10})
+
+	removed, err := s.repos.Environment().RemoveServiceFromProjectEnvironments(
+		s.ctx, project.ID, service.ID,
+	)
+	s.requireNoError(err)
+	s.EqualValues(2, removed)
+
+	removed, err = s.repos.Project().RemoveService(s.ctx, project.ID, service.ID)
+	s.requireNoError(err)
+	s.EqualValues(1, removed)
+}
+
+func (s *Suite) TestEnvironmentMissingService() {
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, nil)
+
+	err := s.repos.Environment().Create(s.ctx, &entities.Environment{
+		Name:      "production",
+		Type:      enums.EnvironmentTypeLive,
+		Status:    enums.EnvironmentStatusEnabled,
+		ProjectID: project.ID,
+		Services: []*entities.EnvironmentService{
+			{ID: 999, MaxRequests: 10, AvailableRequest: 10},
+		},
+	})
+	s.requireCode(errors.CodeNotFound, err)
+
+	total, err := s.repos.Environment().CountByProject(s.ctx, project.ID)
+	s.requireNoError(err)
+	s.Zero(total)
+}
@@ -0,0 +1,467 @@
+package conformance
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+func (s *Suite) TestDecrementAvailableRequest() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 10})
+	environment := s.createEnvironment(project.ID, map[int]int{service.ID: 5})
+
+	result, err := s.repos.Environment().DecrementAvailableRequest(
+		s.ctx, environment.ID, service.ID, 3,
+	)
+	s.requireNoError(err)
+	s.Equal(enums.QuotaLevelNull, result.ExceededLevel)
+	s.Equal(2, result.AvailableRequest)
+	s.Equal(5, result.Environment.MaxRequests)
+	s.Equal(2, result.Environment.AvailableRequest)
+	s.Equal(10, result.Project.MaxRequests)
+	s.Equal(7, result.Project.AvailableRequest)
+
+	// Nothing is consumed when a pool has fewer units left.
+	result, err = s.repos.Environment().DecrementAvailableRequest(
+		s.ctx, environment.ID, service.ID, 3,
+	)
+	s.requireNoError(err)
+	s.Equal(enums.QuotaLevelEnvironment, result.ExceededLevel)
+
+	environmentService, err := s.repos.Environment().GetServiceByID(
+		s.ctx, environment.ID, service.ID,
+	)
+	s.requireNoError(err)
+	s.Equal(2, environmentService.AvailableRequest)
+
+	projectService, err := s.repos.Project().GetServiceByID(
+		s.ctx, project.ID, service.ID,
+	)
+	s.requireNoError(err)
+	s.Equal(7, projectService.AvailableRequest)
+
+	s.requireNoError(s.repos.Environment().IncreaseAvailableRequest(
+		s.ctx, environment.ID, service.ID, 10,
+	))
+
+	environmentService, err = s.repos.Environment().GetServiceByID(
+		s.ctx, environment.ID, service.ID,
+	)
+	s.requireNoError(err)
+	s.Equal(5, environmentService.AvailableRequest)
+
+	_, err = s.repos.Environment().DecrementAvailableRequest(
+		s.ctx, environment.ID, 999, 1,
+	)
+	s.requireCode(errors.CodeNotFound, err)
+}
+
+func (s *Suite) TestDecrementAvailableRequestProjectPool() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 3})
+	production := s.createEnvironment(project.ID, map[int]int{service.ID: -1})
+	staging := s.createEnvironment(project.ID, map[int]int{service.ID: -1})
+
+	result, err := s.repos.Environment().DecrementAvailableRequest(
+		s.ctx, production.ID, service.ID, 2,
+	)
+	s.requireNoError(err)
+	s.Equal(1, result.AvailableRequest)
+	s.Equal(-1, result.Environment.MaxRequests)
+
+	result, err = s.repos.Environment().DecrementAvailableRequest(
+		s.ctx, staging.ID, service.ID, 2,
+	)
+	s.requireNoError(err)
+	s.Equal(enums.QuotaLevelProject, result.ExceededLevel)
+}
+
+// TestDecrementAvailableRequestConcurrently checks that concurrent calls
+// never consume more units than a pool holds.
+func (s *Suite) TestDecrementAvailableRequestConcurrently() {
+	const quota, callers = 20, 50
+
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: quota})
+	production := s.createEnvironment(project.ID, map[int]int{service.ID: -1})
+	staging := s.createEnvironment(project.ID, map[int]int{service.ID: -1})
+
+	var mu sync.Mutex
+	var consumed int
+
+	var wg sync.WaitGroup
+	for i := range callers {
+		environmentID := production.ID
+		if i%2 == 0 {
+			environmentID = staging.ID
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			result, err := s.repos.Environment().DecrementAvailableRequest(
+				s.ctx, environmentID, service.ID, 1,
+			)
+			if err != nil || result.ExceededLevel != enums.QuotaLevelNull {
+				return
+			}
+
+			mu.Lock()
+			consumed++
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	s.Equal(quota, consumed)
+
+	projectService, err := s.repos.Project().GetServiceByID(
+		s.ctx, project.ID, service.ID,
+	)
+	s.requireNoError(err)
+	s.Zero(projectService.AvailableRequest)
+}
+
+func (s *Suite) TestQuotaAlertRearmedOnReset() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 100})
+	environment := s.createEnvironment(project.ID, map[int]int{service.ID: 10})
+
+	alert := &entities.QuotaAlert{
+		EnvironmentID: environment.ID,
+		ServiceID:     service.ID,
+		Level:         enums.QuotaLevelEnvironment,
+		Threshold:     50,
+	}
+	s.requireNoError(s.repos.QuotaAlert().Create(s.ctx, alert))
+
+	err := s.repos.QuotaAlert().Create(s.ctx, &entities.QuotaAlert{
+		EnvironmentID: environment.ID,
+		ServiceID:     service.ID,
+		Level:         enums.QuotaLevelEnvironment,
+		Threshold:     50,
+	})
+	s.requireCode(errors.CodeAlreadyExists, err)
+
+	triggered, err := s.repos.QuotaAlert().Trigger(
+		s.ctx, environment.ID, service.ID, 40, 0,
+	)
+	s.requireNoError(err)
+	s.Empty(triggered)
+
+	triggered, err = s.repos.QuotaAlert().Trigger(
+		s.ctx, environment.ID, service.ID, 60, 0,
+	)
+	s.requireNoError(err)
+	s.Require().Len(triggered, 1)
+	s.Equal(alert.ID, triggered[0].ID)
+
+	// An alert triggers once until its quota is reset.
+	triggered, err = s.repos.QuotaAlert().Trigger(
+		s.ctx, environment.ID, service.ID, 70, 0,
+	)
+	s.requireNoError(err)
+	s.Empty(triggered)
+
+	_, err = s.repos.Environment().ResetAvailableRequests(
+		s.ctx, environment.ID, service.ID,
+	)
+	s.requireNoError(err)
+
+	alerts, err := s.repos.QuotaAlert().ListByEnvironmentService(
+		s.ctx, environment.ID, service.ID,
+	)
+	s.requireNoError(err)
+	s.Require().Len(alerts, 1)
+	s.True(alerts[0].TriggeredAt.IsZero())
+}
+
+func (s *Suite) TestReservationLifecycle() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 100})
+	environment := s.createEnvironment(project.ID, map[int]int{service.ID: 10})
+
+	now := time.Now().Truncate(time.Microsecond)
+	reservation := &entities.Reservation{
+		EnvironmentID:  environment.ID,
+		ServiceID:      service.ID,
+		APIKey:         "pdr_live_key",
+		StartRequestID: uuid.NewString(),
+		RequestTime:    now,
+		ExpiresAt:      now.Add(time.Minute),
+		Units:          2,
+	}
+	s.requireNoError(s.repos.Reservation().Create(s.ctx, reservation))
+	s.NotEmpty(reservation.ID)
+
+	found, err := s.repos.Reservation().GetByID(s.ctx, reservation.ID)
+	s.requireNoError(err)
+	s.Equal(2, found.Units)
+	s.True(found.ExpiresAt.Equal(reservation.ExpiresAt))
+
+	expired, err := s.repos.Reservation().ListExpired(s.ctx, now)
+	s.requireNoError(err)
+	s.Empty(expired)
+
+	expired, err = s.repos.Reservation().ListExpired(s.ctx, now.Add(time.Hour))
+	s.requireNoError(err)
+	s.Require().Len(expired, 1)
+
+	s.requireNoError(s.repos.Reservation().Delete(s.ctx, reservation.ID))
+
+	_, err = s.repos.Reservation().GetByID(s.ctx, reservation.ID)
+	s.requireCode(errors.CodeNotFound, err)
+
+	err = s.repos.Reservation().Create(s.ctx, &entities.Reservation{
+		EnvironmentID:  environment.ID,
+		ServiceID:      999,
+		StartRequestID: uuid.NewString(),
+		RequestTime:    now,
+		ExpiresAt:      now.Add(time.Minute),
+		Units:          1,
+	})
+	s.requireCode(errors.CodeNotFound, err)
+}
+
+// reserve takes units from the environment service and reserves them for
+// a new start request, as a reservation is made.
+func (s *Suite) reserve(
+	environment *entities.Environment, service *entities.Service, units int,
+) *entities.Reservation {
+	s.T().Helper()
+
+	_, err := s.repos.Environment().DecrementAvailableRequest(
+		s.ctx, environment.ID, service.ID, units,
+	)
+	s.requireNoError(err)
+
+	request := s.newRequest(service.ID, time.Now().Truncate(time.Microsecond))
+	request.Units = units
+	s.requireNoError(s.repos.Request().CreateAsInitialPoint(s.ctx, request))
+
+	now := time.Now().Truncate(time.Microsecond)
+	reservation := &entities.Reservation{
+		EnvironmentID:  environment.ID,
+		ServiceID:      service.ID,
+		APIKey:         "pdr_live_key",
+		StartRequestID: request.ID,
+		RequestTime:    now,
+		ExpiresAt:      now.Add(time.Minute),
+		Units:          units,
+	}
+	s.requireNoError(s.repos.Reservation().Create(s.ctx, reservation))
+	return reservation
+}
+
+func (s *Suite) TestReservationCommit() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 100})
+	environment := s.createEnvironment(project.ID, map[int]int{service.ID: 10})
+
+	available := func() (int, int) {
+		environmentService, err := s.repos.Environment().GetServiceByID(
+			s.ctx, environment.ID, service.ID,
+		)
+		s.requireNoError(err)
+
+		projectService, err := s.repos.Project().GetServiceByID(
+			s.ctx, project.ID, service.ID,
+		)
+		s.requireNoError(err)
+		return environmentService.AvailableRequest, projectService.AvailableRequest
+	}
+
+	// Committing everything reserved keeps it consumed.
+	reservation := s.reserve(environment, service, 3)
+	s.requireNoError(s.repos.Reservation().Commit(s.ctx, reservation.ID, 3))
+
+	environmentRequests, projectRequests := available()
+	s.Equal(7, environmentRequests)
+	s.Equal(97, projectRequests)
+
+	_, err := s.repos.Reservation().GetByID(s.ctx, reservation.ID)
+	s.requireCode(errors.CodeNotFound, err)
+
+	// Committing fewer units gives the rest back and charges the request.
+	reservation = s.reserve(environment, service, 5)
+	s.requireNoError(s.repos.Reservation().Commit(s.ctx, reservation.ID, 2))
+
+	environmentRequests, projectRequests = available()
+	s.Equal(5, environmentRequests)
+	s.Equal(95, projectRequests)
+
+	request, err := s.repos.Request().GetByID(s.ctx, reservation.StartRequestID)
+	s.requireNoError(err)
+	s.Equal(2, request.Units)
+
+	// Zero units release the reservation entirely.
+	reservation = s.reserve(environment, service, 4)
+	s.requireNoError(s.repos.Reservation().Commit(s.ctx, reservation.ID, 0))
+
+	environmentRequests, projectRequests = available()
+	s.Equal(5, environmentRequests)
+	s.Equal(95, projectRequests)
+
+	request, err = s.repos.Request().GetByID(s.ctx, reservation.StartRequestID)
+	s.requireNoError(err)
+	s.Zero(request.Units)
+
+	// A reservation can only be committed once.
+	err = s.repos.Reservation().Commit(s.ctx, reservation.ID, 0)
+	s.requireCode(errors.CodeNotFound, err)
+
+	environmentRequests, _ = available()
+	s.Equal(5, environmentRequests)
+}
+
+func (s *Suite) TestReservationCommitIsAtomic() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 100})
+	environment := s.createEnvironment(project.ID, map[int]int{service.ID: 10})
+
+	// A reservation whose start request is gone cannot charge it, so it is
+	// neither deleted nor refunded.
+	now := time.Now().Truncate(time.Microsecond)
+	reservation := &entities.Reservation{
+		EnvironmentID:  environment.ID,
+		ServiceID:      service.ID,
+		APIKey:         "pdr_live_key",
+		StartRequestID: uuid.NewString(),
+		RequestTime:    now,
+		ExpiresAt:      now.Add(time.Minute),
+		Units:          4,
+	}
+	_, err := s.repos.Environment().DecrementAvailableRequest(
+		s.ctx, environment.ID, service.ID, 4,
+	)
+	s.requireNoError(err)
+	s.requireNoError(s.repos.Reservation().Create(s.ctx, reservation))
+
+	err = s.repos.Reservation().Commit(s.ctx, reservation.ID, 1)
+	s.requireCode(errors.CodeNotFound, err)
+
+	_, err = s.repos.Reservation().GetByID(s.ctx, reservation.ID)
+	s.requireNoError(err)
+
+	environmentService, err := s.repos.Environment().GetServiceByID(
+		s.ctx, environment.ID, service.ID,
+	)
+	s.requireNoError(err)
+	s.Equal(6, environmentService.AvailableRequest)
+}
+
+func (s *Suite) TestReservationRelease() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 100})
+	environment := s.createEnvironment(project.ID, map[int]int{service.ID: 10})
+
+	available := func() (int, int) {
+		environmentService, err := s.repos.Environment().GetServiceByID(
+			s.ctx, environment.ID, service.ID,
+		)
+		s.requireNoError(err)
+
+		projectService, err := s.repos.Project().GetServiceByID(
+			s.ctx, project.ID, service.ID,
+		)
+		s.requireNoError(err)
+		return environmentService.AvailableRequest, projectService.AvailableRequest
+	}
+
+	// Releasing gives everything back and resets the request.
+	reservation := s.reserve(environment, service, 3)
+	s.requireNoError(s.repos.Reservation().Release(s.ctx, reservation.ID, nil))
+
+	environmentRequests, projectRequests := available()
+	s.Equal(10, environmentRequests)
+	s.Equal(100, projectRequests)
+
+	_, err := s.repos.Reservation().GetByID(s.ctx, reservation.ID)
+	s.requireCode(errors.CodeNotFound, err)
+
+	request, err := s.repos.Request().GetByID(s.ctx, reservation.StartRequestID)
+	s.requireNoError(err)
+	s.Zero(request.Units)
+
+	// An update settles the execution status of the request as well.
+	reservation = s.reserve(environment, service, 4)
+	err = s.repos.Reservation().Release(
+		s.ctx,
+		reservation.ID,
+		&dto.RequestExecutionStatusUpdate{
+			Detail:          "expired",
+			ExecutionStatus: enums.RequestExecutionStatusAbandoned,
+		},
+	)
+	s.requireNoError(err)
+
+	environmentRequests, projectRequests = available()
+	s.Equal(10, environmentRequests)
+	s.Equal(100, projectRequests)
+
+	request, err = s.repos.Request().GetByID(s.ctx, reservation.StartRequestID)
+	s.requireNoError(err)
+	s.Zero(request.Units)
+	s.Equal(enums.RequestExecutionStatusAbandoned, request.ExecutionStatus)
+	s.Equal("expired", request.Detail)
+
+	// A reservation can only be released once.
+	err = s.repos.Reservation().Release(s.ctx, reservation.ID, nil)
+	s.requireCode(errors.CodeNotFound, err)
+
+	environmentRequests, _ = available()
+	s.Equal(10, environmentRequests)
+}
+
+func (s *Suite) TestReservationReleaseIsAtomic() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 100})
+	environment := s.createEnvironment(project.ID, map[int]int{service.ID: 10})
+
+	// A reservation whose start request is gone cannot reset it, so it is
+	// neither deleted nor refunded.
+	now := time.Now().Truncate(time.Microsecond)
+	reservation := &entities.Reservation{
+		EnvironmentID:  environment.ID,
+		ServiceID:      service.ID,
+		APIKey:         "pdr_live_key",
+		StartRequestID: uuid.NewString(),
+		RequestTime:    now,
+		ExpiresAt:      now.Add(time.Minute),
+		Units:          4,
+	}
+	_, err := s.repos.Environment().DecrementAvailableRequest(
+		s.ctx, environment.ID, service.ID, 4,
+	)
+	s.requireNoError(err)
+	s.requireNoError(s.repos.Reservation().Create(s.ctx, reservation))
+
+	err = s.repos.Reservation().Release(s.ctx, reservation.ID, nil)
+	s.requireCode(errors.CodeNotFound, err)
+
+	_, err = s.repos.Reservation().GetByID(s.ctx, reservation.ID)
+	s.requireNoError(err)
+
+	environmentService, err := s.repos.Environment().GetServiceByID(
+		s.ctx, environment.ID, service.ID,
+	)
+	s.requireNoError(err)
+	s.Equal(6, environmentService.AvailableRequest)
+}
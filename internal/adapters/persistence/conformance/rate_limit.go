@@ -0,0 +1,29 @@
+package conformance
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+func (s *Suite) TestRateLimiter() {
+	// A request a second, so none is earned back while the test runs.
+	limit := entities.NewAPIKeyRateLimit(60, enums.APIKeyRateLimitPeriodMinute, 2)
+
+	for range 2 {
+		retryAfter, err := s.repos.RateLimiter().Allow(s.ctx, "api_key:1", limit)
+		s.requireNoError(err)
+		s.Zero(retryAfter)
+	}
+
+	retryAfter, err := s.repos.RateLimiter().Allow(s.ctx, "api_key:1", limit)
+	s.requireNoError(err)
+	s.Positive(retryAfter)
+	s.LessOrEqual(retryAfter, time.Second)
+
+	// Other keys have buckets of their own.
+	retryAfter, err = s.repos.RateLimiter().Allow(s.ctx, "api_key:2", limit)
+	s.requireNoError(err)
+	s.Zero(retryAfter)
+}
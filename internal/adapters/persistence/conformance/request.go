@@ -0,0 +1,325 @@
+package conformance
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+func (s *Suite) newRequest(serviceID int, requestTime time.Time) *entities.Request {
+	return &entities.Request{
+		APIKey:          &entities.RequestAPIKey{},
+		Project:         &entities.RequestProject{},
+		Environment:     &entities.RequestEnvironment{},
+		Service:         &entities.RequestService{ID: serviceID},
+		ExecutionStatus: enums.RequestExecutionStatusForwarded,
+		Units:           1,
+		RequestTime:     requestTime,
+		Path:            "/v1/invoices",
+		Method:          "GET",
+		IPAddress:       "10.0.0.1",
+		Metadata: &entities.RequestMetadata{
+			Headers:         `{"Accept":["application/json"]}`,
+			BodyContentType: enums.RequestBodyContentTypeJSON,
+		},
+	}
+}
+
+func (s *Suite) TestRequestChain() {
+	service := s.createService("billing")
+	now := time.Now().Truncate(time.Microsecond)
+
+	initial := s.newRequest(service.ID, now)
+	s.requireNoError(s.repos.Request().CreateAsInitialPoint(s.ctx, initial))
+	s.Equal(initial.ID, initial.StartPoint)
+
+	followUp := s.newRequest(service.ID, now.Add(time.Second))
+	followUp.StartPoint = initial.ID
+	s.requireNoError(s.repos.Request().Create(s.ctx, followUp))
+
+	chain, err := s.repos.Request().ListChain(s.ctx, followUp.ID)
+	s.requireNoError(err)
+	s.Require().Len(chain, 2)
+	s.Equal(initial.ID, chain[0].ID)
+	s.Equal(followUp.ID, chain[1].ID)
+
+	found, err := s.repos.Request().GetByID(s.ctx, initial.ID)
+	s.requireNoError(err)
+	s.Require().NotNil(found.Metadata)
+	s.Equal(initial.Metadata.Headers, found.Metadata.Headers)
+
+	s.requireNoError(s.repos.Request().UpdateExecutionStatus(
+		s.ctx, initial.ID, &dto.RequestExecutionStatusUpdate{
+			ExecutionStatus: enums.RequestExecutionStatusSuccess,
+			StatusCode:      200,
+		},
+	))
+
+	found, err = s.repos.Request().GetByID(s.ctx, initial.ID)
+	s.requireNoError(err)
+	s.Equal(enums.RequestExecutionStatusSuccess, found.ExecutionStatus)
+	s.Equal(200, found.StatusCode)
+
+	s.requireCode(
+		errors.CodeNotFound,
+		s.repos.Request().UpdateUnits(s.ctx, "6f1c1f4e-8a43-4a8e-9d5e-3c4b1b0b7c11", 2),
+	)
+}
+
+func (s *Suite) TestRequestCreateBatch() {
+	service := s.createService("billing")
+	now := time.Now().Truncate(time.Microsecond)
+
+	requests := make([]*entities.Request, 3)
+	for i := range requests {
+		requests[i] = s.newRequest(service.ID, now.Add(time.Duration(i)*time.Second))
+		requests[i].ID = uuid.NewString()
+		requests[i].CreatedAt = now
+	}
+	requests[2].ExecutionStatus = enums.RequestExecutionStatusUnauthorized
+	requests[2].UnauthorizedReason = enums.APIKeyValidationFailureCodeQuotaExceeded
+	requests[2].StatusCode = 401
+	requests[2].Units = 0
+
+	s.requireNoError(s.repos.Request().CreateBatch(s.ctx, requests))
+
+	for _, request := range requests {
+		found, err := s.repos.Request().GetByID(s.ctx, request.ID)
+		s.requireNoError(err)
+		s.Equal(request.ExecutionStatus, found.ExecutionStatus)
+		s.Equal(request.UnauthorizedReason, found.UnauthorizedReason)
+		s.Equal(request.Units, found.Units)
+		s.True(request.CreatedAt.Equal(found.CreatedAt))
+		s.True(request.RequestTime.Equal(found.RequestTime))
+	}
+
+	s.requireNoError(s.repos.Request().UpdateExecutionStatus(
+		s.ctx, requests[0].ID, &dto.RequestExecutionStatusUpdate{
+			ExecutionStatus: enums.RequestExecutionStatusSuccess,
+			StatusCode:      200,
+		},
+	))
+
+	// A batch with a missing reference creates none of its requests.
+	valid := s.newRequest(service.ID, now)
+	valid.ID, valid.CreatedAt = uuid.NewString(), now
+	missing := s.newRequest(999, now)
+	missing.ID, missing.CreatedAt = uuid.NewString(), now
+
+	err := s.repos.Request().CreateBatch(
+		s.ctx, []*entities.Request{valid, missing},
+	)
+	s.Require().NotNil(err)
+
+	_, err = s.repos.Request().GetByID(s.ctx, valid.ID)
+	s.requireCode(errors.CodeNotFound, err)
+}
+
+func (s *Suite) TestRequestSearch() {
+	billing := s.createService("billing")
+	shipping := s.createService("shipping")
+	now := time.Now().Truncate(time.Microsecond)
+
+	for i := range 3 {
+		request := s.newRequest(billing.ID, now.Add(time.Duration(i)*time.Minute))
+		s.requireNoError(s.repos.Request().CreateAsInitialPoint(s.ctx, request))
+	}
+
+	request := s.newRequest(shipping.ID, now)
+	request.IPAddress = "192.168.1.20"
+	request.Method = "POST"
+	s.requireNoError(s.repos.Request().CreateAsInitialPoint(s.ctx, request))
+
+	total, err := s.repos.Request().Count(
+		s.ctx, &dto.RequestSearch{ServiceID: billing.ID},
+	)
+	s.requireNoError(err)
+	s.Equal(3, total)
+
+	found, err := s.repos.Request().Search(
+		s.ctx,
+		&dto.RequestSearch{IPAddress: "192.168.1.0/24", Method: "POST"},
+		nil,
+	)
+	s.requireNoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(request.ID, found[0].ID)
+	s.Nil(found[0].Metadata)
+
+	found, err = s.repos.Request().Search(
+		s.ctx,
+		&dto.RequestSearch{
+			ServiceID: billing.ID,
+			SortBy:    enums.RequestSortFieldRequestTime,
+			SortOrder: enums.SortOrderAsc,
+		},
+		nil,
+	)
+	s.requireNoError(err)
+	s.Require().Len(found, 3)
+	s.True(found[0].RequestTime.Equal(now))
+
+	var streamed int
+	s.requireNoError(s.repos.Request().StreamSearch(
+		s.ctx,
+		&dto.RequestSearch{ServiceID: billing.ID},
+		func(*entities.Request) errors.Error {
+			streamed++
+			return nil
+		},
+	))
+	s.Equal(3, streamed)
+
+	deleted, err := s.repos.Request().DeleteByIDs(
+		s.ctx, []string{request.ID, found[0].ID},
+	)
+	s.requireNoError(err)
+	s.Equal(2, deleted)
+}
+
+func (s *Suite) TestRequestSearchOverRotatedKeys() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, nil)
+	environment := s.createEnvironment(project.ID, nil)
+	now := time.Now().Truncate(time.Microsecond)
+
+	rotate := func(rotated *entities.APIKey) *entities.APIKey {
+		successor := &entities.APIKey{
+			Status:        enums.APIKeyStatusEnabled,
+			EnvironmentID: environment.ID,
+		}
+		s.requireNoError(successor.GenerateKey("pdr", enums.EnvironmentTypeLive))
+		s.requireNoError(s.repos.APIKey().Rotate(
+			s.ctx, rotated.ID, now.Add(time.Hour), successor, 0,
+		))
+		return successor
+	}
+
+	first := s.createAPIKey(environment.ID)
+	second := rotate(first)
+	third := rotate(second)
+	other := s.createAPIKey(environment.ID)
+
+	for _, apiKey := range []*entities.APIKey{first, second, third, other} {
+		request := s.newRequest(service.ID, now)
+		request.APIKey.ID = apiKey.ID
+		s.requireNoError(s.repos.Request().CreateAsInitialPoint(s.ctx, request))
+	}
+
+	count := func(filter *dto.RequestSearch) int {
+		found, err := s.repos.Request().Search(s.ctx, filter, nil)
+		s.requireNoError(err)
+
+		total, err := s.repos.Request().Count(s.ctx, filter)
+		s.requireNoError(err)
+		s.Equal(len(found), total)
+		return total
+	}
+
+	s.Equal(1, count(&dto.RequestSearch{APIKeyID: second.ID}))
+
+	// The chain is followed both ways from any key in it.
+	for _, apiKey := range []*entities.APIKey{first, second, third} {
+		s.Equal(
+			3,
+			count(&dto.RequestSearch{APIKeyID: apiKey.ID, IncludeRotated: true}),
+		)
+	}
+
+	s.Equal(
+		1, count(&dto.RequestSearch{APIKeyID: other.ID, IncludeRotated: true}),
+	)
+
+	// Without an API key there is no chain to follow.
+	s.Equal(4, count(&dto.RequestSearch{IncludeRotated: true}))
+}
+
+func (s *Suite) TestRequestRetentionKeepsChainsWhole() {
+	service := s.createService("billing")
+	now := time.Now().Truncate(time.Microsecond)
+
+	initial := s.newRequest(service.ID, now)
+	s.requireNoError(s.repos.Request().CreateAsInitialPoint(s.ctx, initial))
+
+	standalone := s.newRequest(service.ID, now)
+	s.requireNoError(s.repos.Request().Create(s.ctx, standalone))
+
+	time.Sleep(10 * time.Millisecond)
+	between := time.Now()
+	time.Sleep(10 * time.Millisecond)
+
+	followUp := s.newRequest(service.ID, now.Add(time.Second))
+	followUp.StartPoint = initial.ID
+	s.requireNoError(s.repos.Request().Create(s.ctx, followUp))
+
+	ids := func(requests []*entities.Request) []string {
+		ids := make([]string, 0, len(requests))
+		for _, request := range requests {
+			ids = append(ids, request.ID)
+		}
+		return ids
+	}
+
+	// The initial request has expired, but not the rest of its chain.
+	expired, err := s.repos.Request().ListExpired(
+		s.ctx, &dto.RequestRetention{Before: between}, 10,
+	)
+	s.requireNoError(err)
+	s.Equal([]string{standalone.ID}, ids(expired))
+
+	expired, err = s.repos.Request().ListExpired(
+		s.ctx, &dto.RequestRetention{Before: time.Now().Add(time.Second)}, 10,
+	)
+	s.requireNoError(err)
+	s.ElementsMatch(
+		[]string{initial.ID, standalone.ID, followUp.ID}, ids(expired),
+	)
+
+	// Once the initial request is gone, as when its partition is dropped,
+	// the rest of the chain is orphaned.
+	orphaned, err := s.repos.Request().ListOrphaned(
+		s.ctx, time.Now().Add(time.Second), 10,
+	)
+	s.requireNoError(err)
+	s.Empty(orphaned)
+
+	deleted, err := s.repos.Request().DeleteByIDs(s.ctx, []string{initial.ID})
+	s.requireNoError(err)
+	s.Equal(1, deleted)
+
+	orphaned, err = s.repos.Request().ListOrphaned(s.ctx, between, 10)
+	s.requireNoError(err)
+	s.Empty(orphaned)
+
+	orphaned, err = s.repos.Request().ListOrphaned(
+		s.ctx, time.Now().Add(time.Second), 10,
+	)
+	s.requireNoError(err)
+	s.Equal([]string{followUp.ID}, ids(orphaned))
+	s.Require().NotNil(orphaned[0].Metadata)
+}
+
+func (s *Suite) TestDeleteServiceDeletesRequests() {
+	service := s.createService("billing")
+
+	request := s.newRequest(service.ID, time.Now())
+	s.requireNoError(s.repos.Request().CreateAsInitialPoint(s.ctx, request))
+
+	s.requireNoError(s.repos.Service().Delete(s.ctx, service.ID))
+
+	_, err := s.repos.Request().GetByID(s.ctx, request.ID)
+	s.requireCode(errors.CodeNotFound, err)
+}
+
+func (s *Suite) TestRequestMissingService() {
+	request := s.newRequest(999, time.Now())
+	s.requireCode(
+		errors.CodeNotFound, s.repos.Request().CreateAsInitialPoint(s.ctx, request),
+	)
+}
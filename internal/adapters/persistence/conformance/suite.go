@@ -0,0 +1,148 @@
+// Package conformance holds the behaviour every persistence driver must
+// share, as a test suite each driver runs against its own repositories.
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// Suite runs against the repositories NewRepositories returns for each
+// test, which must hold no data.
+type Suite struct {
+	suite.Suite
+
+	NewRepositories func(t *testing.T) persistence.Repositories
+
+	repos persistence.Repositories
+
+	ctx context.Context
+}
+
+func (s *Suite) SetupTest() {
+	s.repos = s.NewRepositories(s.T())
+	s.ctx = context.Background()
+}
+
+func (s *Suite) TearDownTest() {
+	s.repos.Close()
+}
+
+// Run runs the suite against the repositories newRepositories returns.
+func Run(t *testing.T, newRepositories func(t *testing.T) persistence.Repositories) {
+	suite.Run(t, &Suite{NewRepositories: newRepositories})
+}
+
+// requireCode fails the test unless err carries the code.
+func (s *Suite) requireCode(code errors.ErrorCode, err errors.Error) {
+	s.T().Helper()
+
+	s.Require().NotNil(err)
+	s.Require().Equal(code, err.Code(), err.Error())
+}
+
+func (s *Suite) requireNoError(err errors.Error) {
+	s.T().Helper()
+
+	if err != nil {
+		s.Require().FailNow(err.Error())
+	}
+}
+
+func (s *Suite) createService(name string) *entities.Service {
+	s.T().Helper()
+
+	service := &entities.Service{
+		Name:    name,
+		Version: "v1",
+		Status:  enums.ServiceStatusEnabled,
+	}
+	s.requireNoError(s.repos.Service().Create(s.ctx, service))
+	return service
+}
+
+func (s *Suite) createClient(name string) *entities.Client {
+	s.T().Helper()
+
+	client := &entities.Client{
+		Type:  enums.ClientTypeOrganization,
+		Name:  name,
+		Email: fmt.Sprintf("%s@example.com", name),
+	}
+	s.requireNoError(s.repos.Client().Create(s.ctx, client))
+	return client
+}
+
+// createProject creates a project of the client drawing on each service
+// with the given quota, -1 for unlimited.
+func (s *Suite) createProject(
+	clientID int, quotas map[int]int,
+) *entities.Project {
+	s.T().Helper()
+
+	project := &entities.Project{
+		Name:     fmt.Sprintf("project-%d", time.Now().UnixNano()),
+		Status:   enums.ProjectStatusEnabled,
+		ClientID: clientID,
+	}
+
+	for serviceID, maxRequests := range quotas {
+		project.Services = append(project.Services, &entities.ProjectService{
+			ID:               serviceID,
+			MaxRequests:      maxRequests,
+			AvailableRequest: maxRequests,
+			ResetFrequency:   enums.ProjectServiceResetFrequencyMonthly,
+			NextReset:        time.Now().AddDate(0, 1, 0).Truncate(time.Hour),
+		})
+	}
+
+	s.requireNoError(s.repos.Project().Create(s.ctx, project))
+	return project
+}
+
+// createEnvironment creates an environment of the project consuming each
+// service with the given quota, -1 for unlimited.
+func (s *Suite) createEnvironment(
+	projectID int, quotas map[int]int,
+) *entities.Environment {
+	s.T().Helper()
+
+	environment := &entities.Environment{
+		Name:      fmt.Sprintf("environment-%d", time.Now().UnixNano()),
+		Type:      enums.EnvironmentTypeLive,
+		Status:    enums.EnvironmentStatusEnabled,
+		ProjectID: projectID,
+	}
+
+	for serviceID, maxRequests := range quotas {
+		environment.Services = append(environment.Services, &entities.EnvironmentService{
+			ID:               serviceID,
+			MaxRequests:      maxRequests,
+			AvailableRequest: maxRequests,
+		})
+	}
+
+	s.requireNoError(s.repos.Environment().Create(s.ctx, environment))
+	return environment
+}
+
+func (s *Suite) createAPIKey(environmentID int) *entities.APIKey {
+	s.T().Helper()
+
+	apiKey := &entities.APIKey{
+		Status:        enums.APIKeyStatusEnabled,
+		EnvironmentID: environmentID,
+	}
+	s.requireNoError(apiKey.GenerateKey("pdr", enums.EnvironmentTypeLive))
+	s.requireNoError(s.repos.APIKey().Create(s.ctx, apiKey))
+	return apiKey
+}
@@ -0,0 +1,74 @@
+package conformance
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// hourlyRequests returns the requests rolled up in the hourly bucket.
+func (s *Suite) hourlyRequests(hour time.Time) int {
+	s.T().Helper()
+
+	buckets, err := s.repos.Usage().List(s.ctx, &dto.UsageFilter{
+		Granularity: enums.UsageGranularityHour,
+		From:        hour,
+		To:          hour.Add(time.Hour),
+	})
+	s.requireNoError(err)
+
+	var requests int
+	for _, bucket := range buckets {
+		requests += bucket.Requests
+	}
+	return requests
+}
+
+func (s *Suite) TestUsageRollupKeepsDeletedRequests() {
+	service := s.createService("billing")
+	hour := time.Now().UTC().Add(-48 * time.Hour).Truncate(time.Hour)
+
+	first := s.newRequest(service.ID, hour.Add(10*time.Minute))
+	s.requireNoError(s.repos.Request().CreateAsInitialPoint(s.ctx, first))
+
+	watermark := time.Now().Add(time.Second)
+	_, err := s.repos.Usage().RefreshRollups(s.ctx, time.Time{}, watermark)
+	s.requireNoError(err)
+	s.Equal(1, s.hourlyRequests(hour))
+
+	// Retention deletes the rolled up request, then a late one arrives for
+	// the same hour.
+	deleted, err := s.repos.Request().DeleteByIDs(s.ctx, []string{first.ID})
+	s.requireNoError(err)
+	s.Equal(1, deleted)
+
+	time.Sleep(time.Until(watermark))
+	late := s.newRequest(service.ID, hour.Add(20*time.Minute))
+	s.requireNoError(s.repos.Request().CreateAsInitialPoint(s.ctx, late))
+
+	// Refreshes look back before the watermark, which must not count the
+	// late request twice.
+	for range 2 {
+		refreshed, err := s.repos.Usage().RefreshRollups(
+			s.ctx, watermark.Add(-time.Hour), time.Now().Add(time.Second),
+		)
+		s.requireNoError(err)
+		s.Equal(1, refreshed)
+		s.Equal(2, s.hourlyRequests(hour))
+	}
+
+	// Hours retention has not deleted requests of are still rebuilt whole.
+	current := time.Now().UTC().Truncate(time.Hour)
+	request := s.newRequest(service.ID, current)
+	s.requireNoError(s.repos.Request().CreateAsInitialPoint(s.ctx, request))
+
+	for range 2 {
+		_, err := s.repos.Usage().RefreshRollups(
+			s.ctx, time.Time{}, time.Now().Add(time.Second),
+		)
+		s.requireNoError(err)
+		s.Equal(1, s.hourlyRequests(current))
+		s.Equal(2, s.hourlyRequests(hour))
+	}
+}
@@ -0,0 +1,207 @@
+package conformance
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// newConsumedRequest returns a forwarded request of the key for the
+// service, as validation hands it to Consume.
+func (s *Suite) newConsumedRequest(
+	apiKey *entities.APIKey, environment *entities.Environment, service *entities.Service, units int,
+) *entities.Request {
+	request := s.newRequest(service.ID, time.Now().Truncate(time.Microsecond))
+	request.APIKey = &entities.RequestAPIKey{ID: apiKey.ID, Key: apiKey.Key}
+	request.Environment = &entities.RequestEnvironment{ID: environment.ID, Name: environment.Name}
+	request.Project = &entities.RequestProject{ID: environment.ProjectID}
+	request.Service.Name = service.Name
+	request.Service.Version = service.Version
+	request.Units = units
+	return request
+}
+
+func (s *Suite) TestValidationLookup() {
+	service := s.createService("billing")
+	unassigned := s.createService("search")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 10, unassigned.ID: 10})
+	environment := s.createEnvironment(project.ID, map[int]int{service.ID: 5})
+	apiKey := s.createAPIKey(environment.ID)
+
+	lookup, err := s.repos.Validation().Lookup(s.ctx, apiKey.Key, "billing", "v1")
+	s.requireNoError(err)
+	s.Require().NotNil(lookup.Service)
+	s.Equal(service.ID, lookup.Service.ID)
+	s.Equal(enums.ServiceStatusEnabled, lookup.Service.Status)
+	s.Require().NotNil(lookup.APIKey)
+	s.Equal(apiKey.ID, lookup.APIKey.ID)
+	s.Equal(enums.APIKeyStatusEnabled, lookup.APIKey.Status)
+	s.Require().NotNil(lookup.Environment)
+	s.Equal(environment.ID, lookup.Environment.ID)
+	s.Equal(environment.Name, lookup.Environment.Name)
+	s.Equal(enums.EnvironmentStatusEnabled, lookup.Environment.Status)
+	s.Require().NotNil(lookup.ProjectClient)
+	s.Equal(project.ID, lookup.ProjectClient.ProjectID)
+	s.Equal(project.Name, lookup.ProjectClient.ProjectName)
+	s.Equal(client.ID, lookup.ProjectClient.ClientID)
+	s.Equal(client.Name, lookup.ProjectClient.ClientName)
+	s.True(lookup.ServiceAssigned)
+
+	lookup, err = s.repos.Validation().Lookup(s.ctx, apiKey.Key, "search", "v1")
+	s.requireNoError(err)
+	s.Require().NotNil(lookup.Service)
+	s.Require().NotNil(lookup.APIKey)
+	s.False(lookup.ServiceAssigned)
+
+	lookup, err = s.repos.Validation().Lookup(s.ctx, apiKey.Key, "billing", "v2")
+	s.requireNoError(err)
+	s.Nil(lookup.Service)
+	s.Require().NotNil(lookup.APIKey)
+	s.False(lookup.ServiceAssigned)
+
+	lookup, err = s.repos.Validation().Lookup(
+		s.ctx, "pdr_live_unknown00000000000000000000000000000000000_00000000",
+		"billing", "v1",
+	)
+	s.requireNoError(err)
+	s.NotNil(lookup.Service)
+	s.Nil(lookup.APIKey)
+	s.Nil(lookup.Environment)
+	s.Nil(lookup.ProjectClient)
+}
+
+func (s *Suite) TestValidationConsume() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 10})
+	environment := s.createEnvironment(project.ID, map[int]int{service.ID: 5})
+	apiKey := s.createAPIKey(environment.ID)
+
+	request := s.newConsumedRequest(apiKey, environment, service, 3)
+	result, err := s.repos.Validation().Consume(s.ctx, request)
+	s.requireNoError(err)
+	s.Equal(enums.QuotaLevelNull, result.ExceededLevel)
+	s.Equal(2, result.AvailableRequest)
+	s.Equal(5, result.MaxRequests)
+	s.Equal(5, result.Environment.MaxRequests)
+	s.Equal(2, result.Environment.AvailableRequest)
+	s.Equal(10, result.Project.MaxRequests)
+	s.Equal(7, result.Project.AvailableRequest)
+	s.Require().NotEmpty(request.ID)
+
+	logged, err := s.repos.Request().GetByID(s.ctx, request.ID)
+	s.requireNoError(err)
+	s.Equal(enums.RequestExecutionStatusForwarded, logged.ExecutionStatus)
+	s.Equal(3, logged.Units)
+	s.Equal(apiKey.ID, logged.APIKey.ID)
+	s.Equal(environment.ID, logged.Environment.ID)
+	s.Equal(project.ID, logged.Project.ID)
+	s.Equal(service.ID, logged.Service.ID)
+
+	used, err := s.repos.APIKey().GetByID(s.ctx, apiKey.ID)
+	s.requireNoError(err)
+	s.False(used.LastUsed.IsZero())
+
+	// Nothing is consumed when a pool has fewer units left, and the
+	// request is logged rejected instead.
+	request = s.newConsumedRequest(apiKey, environment, service, 3)
+	result, err = s.repos.Validation().Consume(s.ctx, request)
+	s.requireNoError(err)
+	s.Equal(enums.QuotaLevelEnvironment, result.ExceededLevel)
+	s.Equal(enums.RequestExecutionStatusUnauthorized, request.ExecutionStatus)
+	s.Equal(enums.APIKeyValidationFailureCodeQuotaExceeded, request.UnauthorizedReason)
+	s.Equal(http.StatusUnauthorized, request.StatusCode)
+	s.Zero(request.Units)
+
+	logged, err = s.repos.Request().GetByID(s.ctx, request.ID)
+	s.requireNoError(err)
+	s.Equal(enums.RequestExecutionStatusUnauthorized, logged.ExecutionStatus)
+	s.Equal(enums.APIKeyValidationFailureCodeQuotaExceeded, logged.UnauthorizedReason)
+	s.Zero(logged.Units)
+
+	environmentService, err := s.repos.Environment().GetServiceByID(
+		s.ctx, environment.ID, service.ID,
+	)
+	s.requireNoError(err)
+	s.Equal(2, environmentService.AvailableRequest)
+
+	projectService, err := s.repos.Project().GetServiceByID(
+		s.ctx, project.ID, service.ID,
+	)
+	s.requireNoError(err)
+	s.Equal(7, projectService.AvailableRequest)
+}
+
+func (s *Suite) TestValidationConsumeProjectPool() {
+	service := s.createService("billing")
+	unassigned := s.createService("search")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 3})
+	production := s.createEnvironment(project.ID, map[int]int{service.ID: -1})
+	staging := s.createEnvironment(project.ID, map[int]int{service.ID: -1})
+	productionKey := s.createAPIKey(production.ID)
+	stagingKey := s.createAPIKey(staging.ID)
+
+	result, err := s.repos.Validation().Consume(
+		s.ctx, s.newConsumedRequest(productionKey, production, service, 2),
+	)
+	s.requireNoError(err)
+	s.Equal(1, result.AvailableRequest)
+	s.Equal(-1, result.Environment.MaxRequests)
+
+	request := s.newConsumedRequest(stagingKey, staging, service, 2)
+	result, err = s.repos.Validation().Consume(s.ctx, request)
+	s.requireNoError(err)
+	s.Equal(enums.QuotaLevelProject, result.ExceededLevel)
+	s.Equal(enums.APIKeyValidationFailureCodeQuotaExceeded, request.UnauthorizedReason)
+
+	// A key that only consumed rejected requests was never used.
+	unused, err := s.repos.APIKey().GetByID(s.ctx, stagingKey.ID)
+	s.requireNoError(err)
+	s.True(unused.LastUsed.IsZero())
+
+	// A service the environment is not assigned is out of its quota.
+	result, err = s.repos.Validation().Consume(
+		s.ctx, s.newConsumedRequest(productionKey, production, unassigned, 1),
+	)
+	s.requireNoError(err)
+	s.Equal(enums.QuotaLevelEnvironment, result.ExceededLevel)
+}
+
+func (s *Suite) TestValidationConsumeQuota() {
+	service := s.createService("billing")
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, map[int]int{service.ID: 10})
+	environment := s.createEnvironment(project.ID, map[int]int{service.ID: 2})
+	apiKey := s.createAPIKey(environment.ID)
+
+	request := s.newConsumedRequest(apiKey, environment, service, 2)
+	result, err := s.repos.Validation().ConsumeQuota(s.ctx, request)
+	s.requireNoError(err)
+	s.Equal(enums.QuotaLevelNull, result.ExceededLevel)
+	s.Zero(result.AvailableRequest)
+	s.Equal(8, result.Project.AvailableRequest)
+
+	// The request is left to the caller to log.
+	s.Empty(request.ID)
+
+	used, err := s.repos.APIKey().GetByID(s.ctx, apiKey.ID)
+	s.requireNoError(err)
+	s.False(used.LastUsed.IsZero())
+
+	request = s.newConsumedRequest(apiKey, environment, service, 1)
+	result, err = s.repos.Validation().ConsumeQuota(s.ctx, request)
+	s.requireNoError(err)
+	s.Equal(enums.QuotaLevelEnvironment, result.ExceededLevel)
+	s.Equal(enums.APIKeyValidationFailureCodeQuotaExceeded, request.UnauthorizedReason)
+	s.Zero(request.Units)
+
+	projectService, err := s.repos.Project().GetServiceByID(
+		s.ctx, project.ID, service.ID,
+	)
+	s.requireNoError(err)
+	s.Equal(8, projectService.AvailableRequest)
+}
@@ -0,0 +1,82 @@
+package conformance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+func (s *Suite) TestWebhookDeliveries() {
+	client := s.createClient("acme")
+	project := s.createProject(client.ID, nil)
+	environment := s.createEnvironment(project.ID, nil)
+
+	webhook := &entities.Webhook{
+		ClientID: client.ID,
+		URL:      "https://example.com/hooks",
+		Events:   []enums.WebhookEventType{enums.WebhookEventTypeQuotaExhausted},
+		Status:   enums.WebhookStatusEnabled,
+	}
+	s.requireNoError(webhook.GenerateSecret())
+	s.requireNoError(s.repos.Webhook().Create(s.ctx, webhook))
+
+	created, err := s.repos.Webhook().CreateDeliveries(s.ctx, []*entities.WebhookEvent{
+		{
+			Type:          enums.WebhookEventTypeQuotaExhausted,
+			EnvironmentID: environment.ID,
+			OccurredAt:    time.Now(),
+		},
+		{
+			Type:       enums.WebhookEventTypeQuotaReset,
+			ProjectID:  project.ID,
+			OccurredAt: time.Now(),
+		},
+	})
+	s.requireNoError(err)
+	s.Equal(1, created)
+
+	now := time.Now()
+	claimed, err := s.repos.Webhook().ClaimDueDeliveries(s.ctx, now, time.Minute, 10)
+	s.requireNoError(err)
+	s.Require().Len(claimed, 1)
+	s.Equal(webhook.URL, claimed[0].URL)
+	s.Equal(webhook.Secret, claimed[0].Secret)
+
+	var payload struct {
+		Data struct {
+			ClientID      int `json:"client_id"`
+			ProjectID     int `json:"project_id"`
+			EnvironmentID int `json:"environment_id"`
+		} `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal(claimed[0].Payload, &payload))
+	s.Equal(client.ID, payload.Data.ClientID)
+	s.Equal(project.ID, payload.Data.ProjectID)
+	s.Equal(environment.ID, payload.Data.EnvironmentID)
+
+	// Claimed deliveries are leased to the dispatcher that claimed them.
+	again, err := s.repos.Webhook().ClaimDueDeliveries(s.ctx, now, time.Minute, 10)
+	s.requireNoError(err)
+	s.Empty(again)
+
+	delivery := claimed[0]
+	delivery.RecordAttempt(now, 204, "")
+	s.requireNoError(s.repos.Webhook().UpdateDelivery(s.ctx, delivery))
+
+	total, err := s.repos.Webhook().CountDeliveries(
+		s.ctx,
+		webhook.ID,
+		&dto.WebhookDeliveryFilter{Status: enums.WebhookDeliveryStatusDelivered},
+	)
+	s.requireNoError(err)
+	s.Equal(1, total)
+
+	s.requireNoError(s.repos.Client().Delete(s.ctx, client.ID))
+
+	exists, err := s.repos.Webhook().Exists(s.ctx, webhook.ID)
+	s.requireNoError(err)
+	s.False(exists)
+}
@@ -1,13 +1,25 @@
 package persistence
 
 import (
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence/memory"
 	"github.com/MAD-py/pandora-core/internal/adapters/persistence/postgres"
+	"github.com/MAD-py/pandora-core/internal/ports"
 )
 
-func NewRepositories(driver DriverType, dns string) Repositories {
+func NewRepositories(
+	driver DriverType, dns string, apiKeyProtector ports.APIKeyProtector,
+) Repositories {
 	switch driver {
 	case PostgresDriver:
-		return &postgresRepositories{driver: postgres.NewDriver(dns)}
+		return &postgresRepositories{
+			driver:          postgres.NewDriver(dns),
+			apiKeyProtector: apiKeyProtector,
+		}
+	case MemoryDriver:
+		return &memoryRepositories{
+			driver:          memory.NewDriver(),
+			apiKeyProtector: apiKeyProtector,
+		}
 	default:
 		panic("unsupported driver type " + string(driver))
 	}
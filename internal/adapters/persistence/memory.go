@@ -0,0 +1,154 @@
+package persistence
+
+import (
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence/memory"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+// memoryRepositories keeps everything in process memory, for local
+// development and tests. Its state is lost when the process exits and is
+// not shared with other processes.
+type memoryRepositories struct {
+	driver *memory.Driver
+
+	apiKeyProtector ports.APIKeyProtector
+
+	apiKeyRepo      ports.APIKeyRepository
+	clientRepo      ports.ClientRepository
+	projectRepo     ports.ProjectRepository
+	serviceRepo     ports.ServiceRepository
+	requestRepo     ports.RequestRepository
+	environmentRepo ports.EnvironmentRepository
+	reservationRepo ports.ReservationRepository
+	usageRepo       ports.UsageRepository
+	webhookRepo     ports.WebhookRepository
+	quotaAlertRepo  ports.QuotaAlertRepository
+	auditRepo       ports.AuditRepository
+	credentialsRepo ports.CredentialsRepository
+	clientTokenRepo ports.ClientTokenRepository
+	validationRepo  ports.ValidationRepository
+
+	rateLimiter ports.RateLimiter
+}
+
+func (r *memoryRepositories) Close() {}
+
+func (r *memoryRepositories) Ping() errors.Error {
+	return nil
+}
+
+func (r *memoryRepositories) Latency() (int64, errors.Error) {
+	return 0, nil
+}
+
+func (r *memoryRepositories) APIKey() ports.APIKeyRepository {
+	if r.apiKeyRepo == nil {
+		r.apiKeyRepo = memory.NewAPIKeyRepository(
+			r.driver, r.apiKeyProtector,
+		)
+	}
+	return r.apiKeyRepo
+}
+
+func (r *memoryRepositories) Client() ports.ClientRepository {
+	if r.clientRepo == nil {
+		r.clientRepo = memory.NewClientRepository(r.driver)
+	}
+	return r.clientRepo
+}
+
+func (r *memoryRepositories) ClientToken() ports.ClientTokenRepository {
+	if r.clientTokenRepo == nil {
+		r.clientTokenRepo = memory.NewClientTokenRepository(
+			r.driver, r.apiKeyProtector,
+		)
+	}
+	return r.clientTokenRepo
+}
+
+func (r *memoryRepositories) Project() ports.ProjectRepository {
+	if r.projectRepo == nil {
+		r.projectRepo = memory.NewProjectRepository(r.driver)
+	}
+	return r.projectRepo
+}
+
+func (r *memoryRepositories) Service() ports.ServiceRepository {
+	if r.serviceRepo == nil {
+		r.serviceRepo = memory.NewServiceRepository(r.driver)
+	}
+	return r.serviceRepo
+}
+
+func (r *memoryRepositories) Request() ports.RequestRepository {
+	if r.requestRepo == nil {
+		r.requestRepo = memory.NewRequestRepository(r.driver)
+	}
+	return r.requestRepo
+}
+
+func (r *memoryRepositories) Environment() ports.EnvironmentRepository {
+	if r.environmentRepo == nil {
+		r.environmentRepo = memory.NewEnvironmentRepository(r.driver)
+	}
+	return r.environmentRepo
+}
+
+func (r *memoryRepositories) Reservation() ports.ReservationRepository {
+	if r.reservationRepo == nil {
+		r.reservationRepo = memory.NewReservationRepository(r.driver)
+	}
+	return r.reservationRepo
+}
+
+func (r *memoryRepositories) Usage() ports.UsageRepository {
+	if r.usageRepo == nil {
+		r.usageRepo = memory.NewUsageRepository(r.driver)
+	}
+	return r.usageRepo
+}
+
+func (r *memoryRepositories) Webhook() ports.WebhookRepository {
+	if r.webhookRepo == nil {
+		r.webhookRepo = memory.NewWebhookRepository(r.driver)
+	}
+	return r.webhookRepo
+}
+
+func (r *memoryRepositories) QuotaAlert() ports.QuotaAlertRepository {
+	if r.quotaAlertRepo == nil {
+		r.quotaAlertRepo = memory.NewQuotaAlertRepository(r.driver)
+	}
+	return r.quotaAlertRepo
+}
+
+func (r *memoryRepositories) Audit() ports.AuditRepository {
+	if r.auditRepo == nil {
+		r.auditRepo = memory.NewAuditRepository(r.driver)
+	}
+	return r.auditRepo
+}
+
+func (r *memoryRepositories) Credentials() ports.CredentialsRepository {
+	if r.credentialsRepo == nil {
+		r.credentialsRepo = memory.NewCredentialsRepository(r.driver)
+	}
+	return r.credentialsRepo
+}
+
+func (r *memoryRepositories) Validation() ports.ValidationRepository {
+	if r.validationRepo == nil {
+		r.validationRepo = memory.NewValidationRepository(
+			r.driver, r.apiKeyProtector,
+		)
+	}
+	return r.validationRepo
+}
+
+func (r *memoryRepositories) RateLimiter() ports.RateLimiter {
+	if r.rateLimiter == nil {
+		r.rateLimiter = memory.NewRateLimiter(r.driver)
+	}
+	return r.rateLimiter
+}
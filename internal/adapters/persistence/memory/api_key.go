@@ -0,0 +1,530 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+// apiKey is an API key as stored: like the postgres driver, only a keyed
+// hash of the key is kept for lookup, along with an encrypted copy for
+// reveal.
+type apiKey struct {
+	entities.APIKey
+
+	keyHash      string
+	encryptedKey string
+}
+
+func (k *apiKey) entity() *entities.APIKey {
+	key := k.APIKey
+	key.Key = ""
+	key.RateLimit = copyPointer(k.RateLimit)
+	return &key
+}
+
+// summary returns the fields of the key the jobs notifying about expired
+// and rotated keys need.
+func (k *apiKey) summary() *entities.APIKey {
+	return &entities.APIKey{
+		ID:            k.ID,
+		EnvironmentID: k.EnvironmentID,
+		KeyPrefix:     k.KeyPrefix,
+		Status:        k.Status,
+		ExpiresAt:     k.ExpiresAt,
+		GraceEndsAt:   k.GraceEndsAt,
+	}
+}
+
+type APIKeyRepository struct {
+	*Driver
+
+	protector ports.APIKeyProtector
+}
+
+func (r *APIKeyRepository) Delete(
+	ctx context.Context, id int,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.apiKeys[id]; !ok {
+		return entityNotFoundError("APIKey", map[string]any{"id": id})
+	}
+
+	r.deleteAPIKey(id)
+	return nil
+}
+
+func (r *APIKeyRepository) UpdateStatus(
+	ctx context.Context, id int, status enums.APIKeyStatus,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.apiKeys[id]
+	if !ok {
+		return entityNotFoundError("APIKey", map[string]any{"id": id})
+	}
+
+	key.Status = status
+	return nil
+}
+
+func (r *APIKeyRepository) Update(
+	ctx context.Context, id int, update *dto.APIKeyUpdate,
+) (*entities.APIKey, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.apiKeys[id]
+	if !ok {
+		return nil, notFoundError("APIKey")
+	}
+
+	if update == nil {
+		return key.entity(), nil
+	}
+
+	if !update.ExpiresAt.IsZero() {
+		key.ExpiresAt = update.ExpiresAt
+	}
+
+	if update.RateLimit != nil {
+		key.RateLimit = entities.NewAPIKeyRateLimit(
+			update.RateLimit.Requests, update.RateLimit.Period, update.RateLimit.Burst,
+		)
+	}
+
+	return key.entity(), nil
+}
+
+func (r *APIKeyRepository) UpdateLastUsed(
+	ctx context.Context, key string,
+) errors.Error {
+	hash := r.protector.Hash(key)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	apiKey := r.apiKeyByHash(hash)
+	if apiKey == nil {
+		return entityNotFoundError(
+			"APIKey", map[string]any{"key": (&entities.RequestAPIKey{Key: key}).KeySummary()},
+		)
+	}
+
+	apiKey.LastUsed = r.now()
+	return nil
+}
+
+func (r *APIKeyRepository) ListByEnvironment(
+	ctx context.Context, environmentID int, page *dto.Pagination,
+) ([]*entities.APIKey, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var keys []*apiKey
+	for _, key := range r.apiKeys {
+		if key.EnvironmentID == environmentID {
+			keys = append(keys, key)
+		}
+	}
+
+	keys, err := paginate(
+		keys, page,
+		func(k *apiKey) keyset { return intKeyset(k.CreatedAt, k.ID) },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiKeys []*entities.APIKey
+	for _, key := range keys {
+		apiKeys = append(apiKeys, key.entity())
+	}
+	return apiKeys, nil
+}
+
+func (r *APIKeyRepository) CountByEnvironment(
+	ctx context.Context, environmentID int,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var total int
+	for _, key := range r.apiKeys {
+		if key.EnvironmentID == environmentID {
+			total++
+		}
+	}
+
+	return total, nil
+}
+
+// CountUsableByEnvironment counts the keys of the environment that are
+// enabled, not expired and, when replaced by a rotation, still in its grace
+// period.
+func (r *APIKeyRepository) CountUsableByEnvironment(
+	ctx context.Context, environmentID int,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.countUsable(environmentID), nil
+}
+
+func (r *APIKeyRepository) countUsable(environmentID int) int {
+	now := r.now()
+
+	var total int
+	for _, key := range r.apiKeys {
+		if key.EnvironmentID == environmentID &&
+			key.Status == enums.APIKeyStatusEnabled &&
+			(key.ExpiresAt.IsZero() || key.ExpiresAt.After(now)) &&
+			(key.GraceEndsAt.IsZero() || key.GraceEndsAt.After(now)) {
+			total++
+		}
+	}
+
+	return total
+}
+
+func (r *APIKeyRepository) GetByKey(
+	ctx context.Context, key string,
+) (*entities.APIKey, errors.Error) {
+	hash := r.protector.Hash(key)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	apiKey := r.apiKeyByHash(hash)
+	if apiKey == nil {
+		return nil, notFoundError("APIKey")
+	}
+
+	return apiKey.entity(), nil
+}
+
+func (r *APIKeyRepository) GetByID(
+	ctx context.Context, id int,
+) (*entities.APIKey, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.apiKeys[id]
+	if !ok {
+		return nil, notFoundError("APIKey")
+	}
+
+	return key.entity(), nil
+}
+
+func (r *APIKeyRepository) GetKeyByID(
+	ctx context.Context, id int,
+) (string, errors.Error) {
+	r.mu.Lock()
+	key, ok := r.apiKeys[id]
+	var encryptedKey string
+	if ok {
+		encryptedKey = key.encryptedKey
+	}
+	r.mu.Unlock()
+
+	if !ok {
+		return "", notFoundError("APIKey")
+	}
+
+	return r.protector.Decrypt(encryptedKey)
+}
+
+func (r *APIKeyRepository) Exists(
+	ctx context.Context, key string,
+) (bool, errors.Error) {
+	hash := r.protector.Hash(key)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.apiKeyByHash(hash) != nil, nil
+}
+
+func (r *APIKeyRepository) Create(
+	ctx context.Context, apiKey *entities.APIKey,
+) errors.Error {
+	return r.create(apiKey, 0)
+}
+
+// CreateWithinLimit creates the key as long as its environment has fewer
+// than limit usable keys.
+func (r *APIKeyRepository) CreateWithinLimit(
+	ctx context.Context, apiKey *entities.APIKey, limit int,
+) errors.Error {
+	return r.create(apiKey, limit)
+}
+
+func (r *APIKeyRepository) create(apiKey *entities.APIKey, limit int) errors.Error {
+	stored, err := r.protect(apiKey)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkAPIKey(stored); err != nil {
+		return err
+	}
+
+	if err := r.checkLimit(apiKey.EnvironmentID, limit); err != nil {
+		return err
+	}
+
+	r.createAPIKey(apiKey, stored)
+	return nil
+}
+
+// Rotate creates the successor of the key with the given id and sets the
+// grace deadline of the replaced key at once. A key can only be replaced
+// once. With a limit, the successor is only created while the environment
+// has fewer than limit usable keys, the replaced key included.
+func (r *APIKeyRepository) Rotate(
+	ctx context.Context,
+	id int,
+	graceEndsAt time.Time,
+	successor *entities.APIKey,
+	limit int,
+) errors.Error {
+	successor.RotatedFromID = id
+
+	stored, err := r.protect(successor)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rotated, ok := r.apiKeys[id]
+	if !ok {
+		return entityNotFoundError("APIKey", map[string]any{"id": id})
+	}
+
+	if err := r.checkAPIKey(stored); err != nil {
+		return err
+	}
+
+	// The replaced key stays usable through its grace period, so it counts
+	// the same before and after the rotation.
+	if err := r.checkLimit(successor.EnvironmentID, limit); err != nil {
+		return err
+	}
+
+	rotated.GraceEndsAt = graceEndsAt
+	r.createAPIKey(successor, stored)
+	return nil
+}
+
+// checkLimit fails when the environment has limit usable keys or more. A
+// limit of 0 is no limit.
+func (r *APIKeyRepository) checkLimit(environmentID, limit int) errors.Error {
+	if limit <= 0 {
+		return nil
+	}
+
+	if usable := r.countUsable(environmentID); usable >= limit {
+		return apiKeyLimitError(environmentID, usable)
+	}
+
+	return nil
+}
+
+// DisableRotated disables the rotated keys whose grace period is over and
+// returns them.
+func (r *APIKeyRepository) DisableRotated(
+	ctx context.Context, now time.Time,
+) ([]*entities.APIKey, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var apiKeys []*entities.APIKey
+	for _, key := range r.apiKeys {
+		if key.Status == enums.APIKeyStatusEnabled &&
+			!key.GraceEndsAt.IsZero() &&
+			!key.GraceEndsAt.After(now) {
+			key.Status = enums.APIKeyStatusDisabled
+			apiKeys = append(apiKeys, key.summary())
+		}
+	}
+
+	return apiKeys, nil
+}
+
+// ListExpiredBetween returns the keys whose expiration time is in
+// (from, to].
+func (r *APIKeyRepository) ListExpiredBetween(
+	ctx context.Context, from, to time.Time,
+) ([]*entities.APIKey, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var apiKeys []*entities.APIKey
+	for _, key := range r.apiKeys {
+		if !key.ExpiresAt.IsZero() &&
+			key.ExpiresAt.After(from) &&
+			!key.ExpiresAt.After(to) {
+			apiKeys = append(apiKeys, key.summary())
+		}
+	}
+
+	slices.SortFunc(apiKeys, func(a, b *entities.APIKey) int {
+		return cmp.Or(a.ExpiresAt.Compare(b.ExpiresAt), cmp.Compare(a.ID, b.ID))
+	})
+	return apiKeys, nil
+}
+
+// ProtectLegacyKeys has nothing to do, as keys are never stored in clear
+// text by this driver.
+func (r *APIKeyRepository) ProtectLegacyKeys(
+	ctx context.Context,
+) (int, errors.Error) {
+	return 0, nil
+}
+
+// protect returns the key as it is stored. Hashing and encryption happen
+// before the driver is locked.
+func (r *APIKeyRepository) protect(key *entities.APIKey) (*apiKey, errors.Error) {
+	encryptedKey, err := r.protector.Encrypt(key.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := &apiKey{
+		APIKey:       *key,
+		keyHash:      r.protector.Hash(key.Key),
+		encryptedKey: encryptedKey,
+	}
+	stored.Key = ""
+	stored.GraceEndsAt = time.Time{}
+	stored.RateLimit = copyPointer(key.RateLimit)
+	return stored, nil
+}
+
+// checkAPIKey enforces the keys of an API key about to be created.
+func (d *Driver) checkAPIKey(key *apiKey) errors.Error {
+	if _, ok := d.environments[key.EnvironmentID]; !ok {
+		return referenceNotFoundError(
+			"APIKey", "environment_id", key.EnvironmentID, "environment",
+		)
+	}
+
+	if key.RotatedFromID != 0 {
+		if _, ok := d.apiKeys[key.RotatedFromID]; !ok {
+			return referenceNotFoundError(
+				"APIKey", "rotated_from_id", key.RotatedFromID, "api_key",
+			)
+		}
+	}
+
+	for _, k := range d.apiKeys {
+		if k.keyHash == key.keyHash {
+			return alreadyExistsError("APIKey", "key_hash", key.keyHash)
+		}
+
+		if key.RotatedFromID != 0 && k.RotatedFromID == key.RotatedFromID {
+			return alreadyExistsError("APIKey", "rotated_from_id", key.RotatedFromID)
+		}
+	}
+
+	return nil
+}
+
+// createAPIKey stores the key, whose keys are checked, and sets the ID and
+// creation time of the entity.
+func (d *Driver) createAPIKey(apiKey *entities.APIKey, stored *apiKey) {
+	apiKey.ID = d.nextID("api_key")
+	apiKey.CreatedAt = d.now()
+
+	stored.ID, stored.CreatedAt = apiKey.ID, apiKey.CreatedAt
+	d.apiKeys[apiKey.ID] = stored
+}
+
+func (d *Driver) apiKeyByHash(hash string) *apiKey {
+	for _, key := range d.apiKeys {
+		if key.keyHash == hash {
+			return key
+		}
+	}
+
+	return nil
+}
+
+// rotationChain returns the ids of the key, the keys it replaced by
+// rotation and the keys that replaced it. A missing key has no chain.
+func (d *Driver) rotationChain(id int) map[int]bool {
+	chain := make(map[int]bool)
+
+	key, ok := d.apiKeys[id]
+	if !ok {
+		return chain
+	}
+	chain[id] = true
+
+	for replaced := key.RotatedFromID; replaced != 0; {
+		key, ok := d.apiKeys[replaced]
+		if !ok || chain[replaced] {
+			break
+		}
+		chain[replaced] = true
+		replaced = key.RotatedFromID
+	}
+
+	for replacing := id; ; {
+		next := 0
+		for _, key := range d.apiKeys {
+			if key.RotatedFromID == replacing && !chain[key.ID] {
+				next = key.ID
+				break
+			}
+		}
+
+		if next == 0 {
+			break
+		}
+		chain[next] = true
+		replacing = next
+	}
+
+	return chain
+}
+
+// deleteAPIKey deletes the key. The keys that replaced it and its requests
+// are kept without it.
+func (d *Driver) deleteAPIKey(id int) {
+	delete(d.apiKeys, id)
+
+	for _, key := range d.apiKeys {
+		if key.RotatedFromID == id {
+			key.RotatedFromID = 0
+		}
+	}
+
+	for _, request := range d.requests {
+		if request.APIKey.ID == id {
+			request.APIKey.ID = 0
+		}
+	}
+}
+
+func NewAPIKeyRepository(
+	driver *Driver, protector ports.APIKeyProtector,
+) *APIKeyRepository {
+	return &APIKeyRepository{Driver: driver, protector: protector}
+}
@@ -0,0 +1,127 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// auditEntry is an audit entry as stored. Before and After are kept as JSON,
+// so they read back the way the JSONB columns of the postgres driver do.
+type auditEntry struct {
+	entities.AuditEntry
+
+	before []byte
+	after  []byte
+}
+
+func (e *auditEntry) entity() *entities.AuditEntry {
+	entry := e.AuditEntry
+	entry.Before, entry.After = nil, nil
+
+	_ = json.Unmarshal(e.before, &entry.Before)
+	_ = json.Unmarshal(e.after, &entry.After)
+	return &entry
+}
+
+type AuditRepository struct {
+	*Driver
+}
+
+func (r *AuditRepository) Count(
+	ctx context.Context, filter *dto.AuditFilter,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.filter(filter)), nil
+}
+
+func (r *AuditRepository) List(
+	ctx context.Context, filter *dto.AuditFilter, page *dto.Pagination,
+) ([]*entities.AuditEntry, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries, err := paginate(
+		r.filter(filter), page,
+		func(e *auditEntry) keyset { return intKeyset(e.CreatedAt, int(e.ID)) },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var auditEntries []*entities.AuditEntry
+	for _, entry := range entries {
+		auditEntries = append(auditEntries, entry.entity())
+	}
+	return auditEntries, nil
+}
+
+func (r *AuditRepository) filter(filter *dto.AuditFilter) []*auditEntry {
+	var entries []*auditEntry
+	for _, entry := range r.auditLog {
+		if filter != nil {
+			if filter.Actor != "" && entry.Actor != filter.Actor {
+				continue
+			}
+
+			if filter.Action != "" && entry.Action != filter.Action {
+				continue
+			}
+
+			if filter.Entity != "" && entry.Entity != filter.Entity {
+				continue
+			}
+
+			if filter.EntityID != 0 && entry.EntityID != filter.EntityID {
+				continue
+			}
+
+			if !filter.CreatedFrom.IsZero() && entry.CreatedAt.Before(filter.CreatedFrom) {
+				continue
+			}
+
+			if !filter.CreatedTo.IsZero() && entry.CreatedAt.After(filter.CreatedTo) {
+				continue
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func (r *AuditRepository) Create(
+	ctx context.Context, entry *entities.AuditEntry,
+) errors.Error {
+	before, err := json.Marshal(entry.Before)
+	if err != nil {
+		return errors.NewInternal("failed to encode the audit entry", err)
+	}
+
+	after, err := json.Marshal(entry.After)
+	if err != nil {
+		return errors.NewInternal("failed to encode the audit entry", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry.ID = int64(r.nextID("audit_log"))
+	entry.CreatedAt = r.now()
+
+	stored := &auditEntry{AuditEntry: *entry, before: before, after: after}
+	stored.Before, stored.After = nil, nil
+
+	r.auditLog[entry.ID] = stored
+	return nil
+}
+
+func NewAuditRepository(driver *Driver) *AuditRepository {
+	return &AuditRepository{Driver: driver}
+}
@@ -0,0 +1,201 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ClientRepository struct {
+	*Driver
+}
+
+func (r *ClientRepository) Delete(
+	ctx context.Context, id int,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.clients[id]; !ok {
+		return entityNotFoundError("Client", map[string]any{"id": id})
+	}
+
+	r.deleteClient(id)
+	return nil
+}
+
+func (r *ClientRepository) Update(
+	ctx context.Context, id int, update *dto.ClientUpdate,
+) (*entities.Client, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	client, ok := r.clients[id]
+	if !ok {
+		return nil, notFoundError("Client")
+	}
+
+	if update == nil {
+		return copyPointer(client), nil
+	}
+
+	updated := *client
+
+	if update.Name != "" {
+		updated.Name = update.Name
+	}
+
+	if update.Email != "" {
+		updated.Email = update.Email
+	}
+
+	if update.APIKeyLimit != nil {
+		updated.APIKeyLimit = *update.APIKeyLimit
+	}
+
+	if update.Type != enums.ClientTypeNull {
+		updated.Type = update.Type
+	}
+
+	if err := r.checkClientUnique(&updated); err != nil {
+		return nil, err
+	}
+
+	*client = updated
+	return copyPointer(client), nil
+}
+
+func (r *ClientRepository) Exists(
+	ctx context.Context, id int,
+) (bool, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.clients[id]
+	return ok, nil
+}
+
+func (r *ClientRepository) GetByID(
+	ctx context.Context, id int,
+) (*entities.Client, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	client, ok := r.clients[id]
+	if !ok {
+		return nil, notFoundError("Client")
+	}
+
+	return copyPointer(client), nil
+}
+
+func (r *ClientRepository) List(
+	ctx context.Context, filter *dto.ClientFilter, page *dto.Pagination,
+) ([]*entities.Client, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	clients, err := paginate(
+		r.filter(filter), page,
+		func(c *entities.Client) keyset { return intKeyset(c.CreatedAt, c.ID) },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyAll(clients, copyPointer), nil
+}
+
+func (r *ClientRepository) Count(
+	ctx context.Context, filter *dto.ClientFilter,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.filter(filter)), nil
+}
+
+func (r *ClientRepository) filter(
+	filter *dto.ClientFilter,
+) []*entities.Client {
+	var clients []*entities.Client
+	for _, client := range r.clients {
+		if filter != nil &&
+			filter.Type != enums.ClientTypeNull &&
+			client.Type != filter.Type {
+			continue
+		}
+
+		clients = append(clients, client)
+	}
+
+	return clients
+}
+
+func (r *ClientRepository) Create(
+	ctx context.Context, client *entities.Client,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkClientUnique(client); err != nil {
+		return err
+	}
+
+	client.ID = r.nextID("client")
+	client.CreatedAt = r.now()
+
+	r.clients[client.ID] = copyPointer(client)
+	return nil
+}
+
+// checkClientUnique enforces the unique name and email of the clients other
+// than the given one.
+func (d *Driver) checkClientUnique(client *entities.Client) errors.Error {
+	for _, c := range d.clients {
+		if c.ID == client.ID {
+			continue
+		}
+
+		if c.Name == client.Name {
+			return alreadyExistsError("Client", "name", client.Name)
+		}
+
+		if c.Email == client.Email {
+			return alreadyExistsError("Client", "email", client.Email)
+		}
+	}
+
+	return nil
+}
+
+// deleteClient deletes the client along with its projects, webhooks and
+// portal tokens.
+func (d *Driver) deleteClient(id int) {
+	delete(d.clients, id)
+
+	for projectID, project := range d.projects {
+		if project.ClientID == id {
+			d.deleteProject(projectID)
+		}
+	}
+
+	for webhookID, webhook := range d.webhooks {
+		if webhook.ClientID == id {
+			d.deleteWebhook(webhookID)
+		}
+	}
+
+	for tokenID, token := range d.clientTokens {
+		if token.ClientID == id {
+			delete(d.clientTokens, tokenID)
+		}
+	}
+}
+
+func NewClientRepository(driver *Driver) *ClientRepository {
+	return &ClientRepository{Driver: driver}
+}
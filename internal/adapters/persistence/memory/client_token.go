@@ -0,0 +1,153 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+// clientToken is a client token as stored: only the keyed hash of the token
+// is kept, like the postgres driver does.
+type clientToken struct {
+	entities.ClientToken
+
+	tokenHash string
+}
+
+func (t *clientToken) entity() *entities.ClientToken {
+	token := t.ClientToken
+	token.Token = ""
+	return &token
+}
+
+type ClientTokenRepository struct {
+	*Driver
+
+	protector ports.APIKeyProtector
+}
+
+func (r *ClientTokenRepository) GetByID(
+	ctx context.Context, id int,
+) (*entities.ClientToken, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, ok := r.clientTokens[id]
+	if !ok {
+		return nil, notFoundError("ClientToken")
+	}
+
+	return token.entity(), nil
+}
+
+func (r *ClientTokenRepository) GetByToken(
+	ctx context.Context, token string,
+) (*entities.ClientToken, errors.Error) {
+	hash := r.protector.Hash(token)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, t := range r.clientTokens {
+		if t.tokenHash == hash {
+			return t.entity(), nil
+		}
+	}
+
+	return nil, notFoundError("ClientToken")
+}
+
+func (r *ClientTokenRepository) ListByClient(
+	ctx context.Context, clientID int,
+) ([]*entities.ClientToken, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var tokens []*clientToken
+	for _, token := range r.clientTokens {
+		if token.ClientID == clientID {
+			tokens = append(tokens, token)
+		}
+	}
+
+	sortByKeyset(
+		tokens,
+		func(t *clientToken) keyset { return intKeyset(t.CreatedAt, t.ID) },
+		false,
+	)
+
+	var clientTokens []*entities.ClientToken
+	for _, token := range tokens {
+		clientTokens = append(clientTokens, token.entity())
+	}
+	return clientTokens, nil
+}
+
+func (r *ClientTokenRepository) Create(
+	ctx context.Context, token *entities.ClientToken,
+) errors.Error {
+	hash := r.protector.Hash(token.Token)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.clients[token.ClientID]; !ok {
+		return referenceNotFoundError(
+			"ClientToken", "client_id", token.ClientID, "client",
+		)
+	}
+
+	for _, t := range r.clientTokens {
+		if t.tokenHash == hash {
+			return alreadyExistsError("ClientToken", "token_hash", hash)
+		}
+	}
+
+	token.ID = r.nextID("client_token")
+	token.CreatedAt = r.now()
+
+	stored := &clientToken{ClientToken: *token, tokenHash: hash}
+	stored.Token = ""
+	stored.LastUsed = time.Time{}
+
+	r.clientTokens[token.ID] = stored
+	return nil
+}
+
+func (r *ClientTokenRepository) UpdateLastUsed(
+	ctx context.Context, id int,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, ok := r.clientTokens[id]
+	if !ok {
+		return entityNotFoundError("ClientToken", map[string]any{"id": id})
+	}
+
+	token.LastUsed = r.now()
+	return nil
+}
+
+func (r *ClientTokenRepository) Delete(
+	ctx context.Context, id int,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.clientTokens[id]; !ok {
+		return entityNotFoundError("ClientToken", map[string]any{"id": id})
+	}
+
+	delete(r.clientTokens, id)
+	return nil
+}
+
+func NewClientTokenRepository(
+	driver *Driver, protector ports.APIKeyProtector,
+) *ClientTokenRepository {
+	return &ClientTokenRepository{Driver: driver, protector: protector}
+}
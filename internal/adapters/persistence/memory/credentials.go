@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type CredentialsRepository struct {
+	*Driver
+}
+
+func (r *CredentialsRepository) GetByID(
+	ctx context.Context, id int,
+) (*entities.Credentials, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	credentials, ok := r.credentials[id]
+	if !ok {
+		return nil, notFoundError("Credentials")
+	}
+
+	return copyPointer(credentials), nil
+}
+
+func (r *CredentialsRepository) GetByUsername(
+	ctx context.Context, username string,
+) (*entities.Credentials, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, credentials := range r.credentials {
+		if credentials.Username == username {
+			return copyPointer(credentials), nil
+		}
+	}
+
+	return nil, notFoundError("Credentials")
+}
+
+func (r *CredentialsRepository) Count(
+	ctx context.Context, filter *dto.AdminUserFilter,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.filter(filter)), nil
+}
+
+func (r *CredentialsRepository) List(
+	ctx context.Context, filter *dto.AdminUserFilter, page *dto.Pagination,
+) ([]*entities.Credentials, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	credentials, err := paginate(
+		r.filter(filter), page,
+		func(c *entities.Credentials) keyset { return intKeyset(c.CreatedAt, c.ID) },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyAll(credentials, copyPointer), nil
+}
+
+func (r *CredentialsRepository) filter(
+	filter *dto.AdminUserFilter,
+) []*entities.Credentials {
+	var credentials []*entities.Credentials
+	for _, c := range r.credentials {
+		if filter != nil {
+			if filter.Role != enums.AdminRoleNull && c.Role != filter.Role {
+				continue
+			}
+
+			if filter.Status != enums.CredentialsStatusNull &&
+				c.Status != filter.Status {
+				continue
+			}
+		}
+
+		credentials = append(credentials, c)
+	}
+
+	return credentials
+}
+
+func (r *CredentialsRepository) Create(
+	ctx context.Context, credentials *entities.Credentials,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range r.credentials {
+		if c.Username == credentials.Username {
+			return alreadyExistsError("Credentials", "username", credentials.Username)
+		}
+	}
+
+	credentials.ID = r.nextID("admin_user")
+	credentials.CreatedAt = r.now()
+
+	r.credentials[credentials.ID] = copyPointer(credentials)
+	return nil
+}
+
+func (r *CredentialsRepository) ChangePassword(
+	ctx context.Context, credentials *entities.Credentials,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range r.credentials {
+		if c.Username == credentials.Username {
+			c.HashedPassword = credentials.HashedPassword
+			c.ForcePasswordReset = false
+			return nil
+		}
+	}
+
+	return entityNotFoundError(
+		"Credentials", map[string]any{"username": credentials.Username},
+	)
+}
+
+func (r *CredentialsRepository) UpdateStatus(
+	ctx context.Context, id int, status enums.CredentialsStatus,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	credentials, ok := r.credentials[id]
+	if !ok {
+		return entityNotFoundError("Credentials", map[string]any{"id": id})
+	}
+
+	credentials.Status = status
+	return nil
+}
+
+func NewCredentialsRepository(driver *Driver) *CredentialsRepository {
+	return &CredentialsRepository{Driver: driver}
+}
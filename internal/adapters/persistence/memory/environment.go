@@ -0,0 +1,614 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// environmentService is the assignment of a service to an environment. The
+// name and version of the service are joined when read.
+type environmentService struct {
+	maxRequests      int
+	availableRequest int
+
+	createdAt time.Time
+}
+
+type EnvironmentRepository struct {
+	*Driver
+}
+
+func (r *EnvironmentRepository) Delete(
+	ctx context.Context, id int,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.environments[id]; !ok {
+		return entityNotFoundError("Environment", map[string]any{"id": id})
+	}
+
+	r.deleteEnvironment(id)
+	return nil
+}
+
+func (r *EnvironmentRepository) ResetAvailableRequests(
+	ctx context.Context, id, serviceID int,
+) (*entities.EnvironmentService, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := serviceKey{ownerID: id, serviceID: serviceID}
+	service, ok := r.environmentServices[key]
+	if !ok {
+		return nil, notFoundError("EnvironmentService")
+	}
+
+	service.availableRequest = service.maxRequests
+	r.rearmQuotaAlerts(enums.QuotaLevelEnvironment, serviceID, id)
+
+	return r.environmentServiceEntity(key), nil
+}
+
+func (r *EnvironmentRepository) RemoveService(
+	ctx context.Context, id, serviceID int,
+) (int64, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := serviceKey{ownerID: id, serviceID: serviceID}
+	if _, ok := r.environmentServices[key]; !ok {
+		return 0, nil
+	}
+
+	r.deleteEnvironmentService(key)
+	return 1, nil
+}
+
+func (r *EnvironmentRepository) RemoveServiceFromProjectEnvironments(
+	ctx context.Context, projectID, serviceID int,
+) (int64, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var removed int64
+	for key := range r.environmentServices {
+		environment := r.environments[key.ownerID]
+		if key.serviceID == serviceID && environment.ProjectID == projectID {
+			r.deleteEnvironmentService(key)
+			removed++
+		}
+	}
+
+	return removed, nil
+}
+
+func (r *EnvironmentRepository) UpdateStatus(
+	ctx context.Context, id int, status enums.EnvironmentStatus,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	environment, ok := r.environments[id]
+	if !ok {
+		return entityNotFoundError("Environment", map[string]any{"id": id})
+	}
+
+	environment.Status = status
+	return nil
+}
+
+func (r *EnvironmentRepository) UpdateService(
+	ctx context.Context,
+	id, serviceID int,
+	update *dto.EnvironmentServiceUpdate,
+) (*entities.EnvironmentService, errors.Error) {
+	if update == nil {
+		return r.GetServiceByID(ctx, id, serviceID)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := serviceKey{ownerID: id, serviceID: serviceID}
+	service, ok := r.environmentServices[key]
+	if !ok {
+		return nil, notFoundError("EnvironmentService")
+	}
+
+	service.maxRequests = update.MaxRequests
+	service.availableRequest = update.AvailableRequest
+
+	return r.environmentServiceEntity(key), nil
+}
+
+func (r *EnvironmentRepository) Update(
+	ctx context.Context, id int, update *dto.EnvironmentUpdate,
+) (*entities.Environment, errors.Error) {
+	if update == nil || update.Name == "" {
+		return r.GetByID(ctx, id)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	environment, ok := r.environments[id]
+	if !ok {
+		return nil, entityNotFoundError("Environment", map[string]any{"id": id})
+	}
+
+	if err := r.checkEnvironmentUnique(
+		id, update.Name, environment.ProjectID,
+	); err != nil {
+		return nil, err
+	}
+
+	environment.Name = update.Name
+	return r.environmentEntity(environment), nil
+}
+
+func (r *EnvironmentRepository) Exists(
+	ctx context.Context, id int,
+) (bool, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.environments[id]
+	return ok, nil
+}
+
+func (r *EnvironmentRepository) IsEnabled(
+	ctx context.Context, id int,
+) (bool, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	environment, ok := r.environments[id]
+	return ok && environment.IsEnabled(), nil
+}
+
+func (r *EnvironmentRepository) MissingResourceDiagnosis(
+	ctx context.Context, id int, serviceID int,
+) (bool, bool, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	service, ok := r.environmentServices[serviceKey{ownerID: id, serviceID: serviceID}]
+	if !ok {
+		return false, false, nil
+	}
+
+	return true, service.availableRequest > 0, nil
+}
+
+func (r *EnvironmentRepository) GetProjectServiceQuotaUsage(
+	ctx context.Context, id, serviceID int,
+) (*dto.QuotaUsage, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	environment, ok := r.environments[id]
+	if !ok {
+		return nil, notFoundError("Environment")
+	}
+
+	quota, ok := r.projectServiceQuotaUsage(environment.ProjectID, serviceID)
+	if !ok {
+		return nil, notFoundError("Environment")
+	}
+
+	return quota, nil
+}
+
+// IncreaseAvailableRequest gives units back to both the environment
+// service and its project pool, never above their max requests.
+func (r *EnvironmentRepository) IncreaseAvailableRequest(
+	ctx context.Context, id, serviceID, units int,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.increaseAvailableRequest(id, serviceID, units)
+}
+
+func (d *Driver) increaseAvailableRequest(id, serviceID, units int) errors.Error {
+	environmentService, projectService := d.servicePools(id, serviceID)
+	if environmentService == nil || projectService == nil {
+		return entityNotFoundError(
+			"EnvironmentService",
+			map[string]any{"environment_id": id, "service_id": serviceID},
+		)
+	}
+
+	if environmentService.maxRequests != -1 {
+		environmentService.availableRequest = min(
+			environmentService.availableRequest+units, environmentService.maxRequests,
+		)
+	}
+
+	if projectService.maxRequests != -1 {
+		projectService.availableRequest = min(
+			projectService.availableRequest+units, projectService.maxRequests,
+		)
+	}
+
+	return nil
+}
+
+// DecrementAvailableRequest consumes units from the environment service
+// and from its project pool, or from neither if either has fewer left.
+// The driver lock is held throughout, so concurrent calls cannot overdraw
+// the pool shared by the environments of a project.
+func (r *EnvironmentRepository) DecrementAvailableRequest(
+	ctx context.Context, id, serviceID, units int,
+) (*dto.DecrementAvailableRequest, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.decrementAvailableRequest(id, serviceID, units)
+}
+
+func (d *Driver) decrementAvailableRequest(
+	id, serviceID, units int,
+) (*dto.DecrementAvailableRequest, errors.Error) {
+	environmentService, projectService := d.servicePools(id, serviceID)
+	if environmentService == nil || projectService == nil {
+		return nil, notFoundError("EnvironmentService")
+	}
+
+	environmentAvailable := environmentService.availableRequest >= units ||
+		environmentService.maxRequests == -1
+	projectAvailable := projectService.availableRequest >= units ||
+		projectService.maxRequests == -1
+
+	var environmentRequests, projectRequests int
+	if environmentAvailable && projectAvailable {
+		if environmentService.maxRequests != -1 {
+			environmentService.availableRequest -= units
+		}
+		if projectService.maxRequests != -1 {
+			projectService.availableRequest -= units
+		}
+
+		environmentRequests = environmentService.availableRequest
+		projectRequests = projectService.availableRequest
+	}
+
+	result := &dto.DecrementAvailableRequest{
+		MaxRequests: environmentService.maxRequests,
+	}
+
+	switch {
+	case !environmentAvailable:
+		result.ExceededLevel = enums.QuotaLevelEnvironment
+	case !projectAvailable:
+		result.ExceededLevel = enums.QuotaLevelProject
+	case projectRequests == -1:
+		result.AvailableRequest = environmentRequests
+	case environmentRequests == -1:
+		result.AvailableRequest = projectRequests
+	default:
+		result.AvailableRequest = min(environmentRequests, projectRequests)
+	}
+
+	if result.ExceededLevel == enums.QuotaLevelNull {
+		result.Environment = dto.QuotaBalance{
+			MaxRequests:      environmentService.maxRequests,
+			AvailableRequest: environmentRequests,
+		}
+		result.Project = dto.QuotaBalance{
+			MaxRequests:      projectService.maxRequests,
+			AvailableRequest: projectRequests,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *EnvironmentRepository) ExistsServiceIn(
+	ctx context.Context, id, serviceID int,
+) (bool, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.environmentServices[serviceKey{ownerID: id, serviceID: serviceID}]
+	return ok, nil
+}
+
+func (r *EnvironmentRepository) GetServiceByID(
+	ctx context.Context, id, serviceID int,
+) (*entities.EnvironmentService, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := serviceKey{ownerID: id, serviceID: serviceID}
+	if _, ok := r.environmentServices[key]; !ok {
+		return nil, notFoundError("EnvironmentService")
+	}
+
+	return r.environmentServiceEntity(key), nil
+}
+
+func (r *EnvironmentRepository) GetByID(
+	ctx context.Context, id int,
+) (*entities.Environment, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	environment, ok := r.environments[id]
+	if !ok {
+		return nil, notFoundError("Environment")
+	}
+
+	return r.environmentEntity(environment), nil
+}
+
+func (r *EnvironmentRepository) ListByProject(
+	ctx context.Context, projectID int, page *dto.Pagination,
+) ([]*entities.Environment, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	environments, err := paginate(
+		r.projectEnvironments(projectID), page,
+		func(e *entities.Environment) keyset { return intKeyset(e.CreatedAt, e.ID) },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyAll(environments, r.environmentEntity), nil
+}
+
+func (r *EnvironmentRepository) CountByProject(
+	ctx context.Context, projectID int,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.projectEnvironments(projectID)), nil
+}
+
+func (r *EnvironmentRepository) AddService(
+	ctx context.Context, id int, service *entities.EnvironmentService,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkEnvironmentService(id, service.ID); err != nil {
+		return err
+	}
+
+	r.createEnvironmentService(id, service)
+	return nil
+}
+
+func (r *EnvironmentRepository) Create(
+	ctx context.Context, environment *entities.Environment,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Every row is checked before anything is written, which stands for the
+	// transaction of the postgres driver.
+	if _, ok := r.projects[environment.ProjectID]; !ok {
+		return referenceNotFoundError(
+			"Environment", "project_id", environment.ProjectID, "project",
+		)
+	}
+
+	if err := r.checkEnvironmentUnique(
+		0, environment.Name, environment.ProjectID,
+	); err != nil {
+		return err
+	}
+
+	environment.ID = r.nextID("environment")
+	environment.CreatedAt = r.now()
+
+	// The environment does not exist yet, so the services are only checked
+	// against the service table and each other.
+	for i, service := range environment.Services {
+		if _, ok := r.services[service.ID]; !ok {
+			return referenceNotFoundError(
+				"EnvironmentService", "service_id", service.ID, "service",
+			)
+		}
+
+		if slices.ContainsFunc(
+			environment.Services[:i],
+			func(s *entities.EnvironmentService) bool { return s.ID == service.ID },
+		) {
+			return alreadyExistsError(
+				"EnvironmentService", "environment_id, service_id",
+				environment.ID, service.ID,
+			)
+		}
+	}
+
+	stored := *environment
+	stored.Services = nil
+	r.environments[environment.ID] = &stored
+
+	if len(environment.Services) == 0 {
+		environment.Services = nil
+		return nil
+	}
+
+	services := make([]*entities.EnvironmentService, len(environment.Services))
+	for i, service := range environment.Services {
+		services[i] = copyPointer(service)
+		r.createEnvironmentService(environment.ID, services[i])
+	}
+
+	environment.Services = services
+	return nil
+}
+
+// checkEnvironmentUnique enforces the unique name of the environments of a
+// project, other than the one with the given ID.
+func (d *Driver) checkEnvironmentUnique(id int, name string, projectID int) errors.Error {
+	for _, e := range d.environments {
+		if e.ID != id && e.Name == name && e.ProjectID == projectID {
+			return alreadyExistsError("Environment", "name, project_id", name, projectID)
+		}
+	}
+
+	return nil
+}
+
+// checkEnvironmentService enforces the keys of an environment service about
+// to be created.
+func (d *Driver) checkEnvironmentService(id, serviceID int) errors.Error {
+	if _, ok := d.environments[id]; !ok {
+		return referenceNotFoundError(
+			"EnvironmentService", "environment_id", id, "environment",
+		)
+	}
+
+	if _, ok := d.services[serviceID]; !ok {
+		return referenceNotFoundError(
+			"EnvironmentService", "service_id", serviceID, "service",
+		)
+	}
+
+	if _, ok := d.environmentServices[serviceKey{ownerID: id, serviceID: serviceID}]; ok {
+		return alreadyExistsError(
+			"EnvironmentService", "environment_id, service_id", id, serviceID,
+		)
+	}
+
+	return nil
+}
+
+// createEnvironmentService stores the service, whose keys are checked, and
+// fills its name, version and assignment time.
+func (d *Driver) createEnvironmentService(
+	id int, service *entities.EnvironmentService,
+) {
+	key := serviceKey{ownerID: id, serviceID: service.ID}
+	d.environmentServices[key] = &environmentService{
+		maxRequests:      service.MaxRequests,
+		availableRequest: service.AvailableRequest,
+		createdAt:        d.now(),
+	}
+
+	*service = *d.environmentServiceEntity(key)
+}
+
+// servicePools returns the environment service along with the project
+// service it draws from, either nil when missing.
+func (d *Driver) servicePools(
+	id, serviceID int,
+) (*environmentService, *projectService) {
+	environmentService, ok := d.environmentServices[serviceKey{ownerID: id, serviceID: serviceID}]
+	if !ok {
+		return nil, nil
+	}
+
+	projectID := d.environments[id].ProjectID
+	return environmentService, d.projectServices[serviceKey{ownerID: projectID, serviceID: serviceID}]
+}
+
+func (d *Driver) projectEnvironments(projectID int) []*entities.Environment {
+	var environments []*entities.Environment
+	for _, environment := range d.environments {
+		if environment.ProjectID == projectID {
+			environments = append(environments, environment)
+		}
+	}
+
+	return environments
+}
+
+// environmentEntity copies the environment along with its services, the
+// last assigned first.
+func (d *Driver) environmentEntity(
+	environment *entities.Environment,
+) *entities.Environment {
+	e := *environment
+	e.Services = make([]*entities.EnvironmentService, 0)
+
+	for key := range d.environmentServices {
+		if key.ownerID == environment.ID {
+			e.Services = append(e.Services, d.environmentServiceEntity(key))
+		}
+	}
+
+	slices.SortFunc(e.Services, func(a, b *entities.EnvironmentService) int {
+		if c := b.AssignedAt.Compare(a.AssignedAt); c != 0 {
+			return c
+		}
+		return b.ID - a.ID
+	})
+	return &e
+}
+
+func (d *Driver) environmentServiceEntity(
+	key serviceKey,
+) *entities.EnvironmentService {
+	row := d.environmentServices[key]
+	service := d.services[key.serviceID]
+
+	return &entities.EnvironmentService{
+		ID:               service.ID,
+		Name:             service.Name,
+		Version:          service.Version,
+		MaxRequests:      row.maxRequests,
+		AvailableRequest: row.availableRequest,
+		AssignedAt:       row.createdAt,
+	}
+}
+
+// deleteEnvironment deletes the environment along with its API keys and
+// services. Its requests are kept without it.
+func (d *Driver) deleteEnvironment(id int) {
+	delete(d.environments, id)
+
+	for apiKeyID, apiKey := range d.apiKeys {
+		if apiKey.EnvironmentID == id {
+			d.deleteAPIKey(apiKeyID)
+		}
+	}
+
+	for key := range d.environmentServices {
+		if key.ownerID == id {
+			d.deleteEnvironmentService(key)
+		}
+	}
+
+	for _, request := range d.requests {
+		if request.Environment.ID == id {
+			request.Environment.ID = 0
+		}
+	}
+}
+
+// deleteEnvironmentService deletes the environment service along with its
+// reservations and quota alerts.
+func (d *Driver) deleteEnvironmentService(key serviceKey) {
+	delete(d.environmentServices, key)
+
+	for id, reservation := range d.reservations {
+		if reservation.EnvironmentID == key.ownerID &&
+			reservation.ServiceID == key.serviceID {
+			delete(d.reservations, id)
+		}
+	}
+
+	for id, alert := range d.quotaAlerts {
+		if alert.EnvironmentID == key.ownerID && alert.ServiceID == key.serviceID {
+			delete(d.quotaAlerts, id)
+		}
+	}
+}
+
+func NewEnvironmentRepository(driver *Driver) *EnvironmentRepository {
+	return &EnvironmentRepository{Driver: driver}
+}
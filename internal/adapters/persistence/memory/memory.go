@@ -0,0 +1,185 @@
+package memory
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// serviceKey identifies the assignment of a service to a project or to an
+// environment.
+type serviceKey struct {
+	ownerID   int
+	serviceID int
+}
+
+// Driver keeps every table in process memory behind a single lock, so each
+// repository call is atomic like a statement or a transaction of the
+// postgres driver. Primary keys, unique constraints and foreign keys, along
+// with their cascades, are enforced as the schema declares them; other check
+// constraints are left to the use cases. Nothing outlives the process.
+type Driver struct {
+	mu sync.Mutex
+
+	sequences map[string]int
+
+	services            map[int]*entities.Service
+	clients             map[int]*entities.Client
+	clientTokens        map[int]*clientToken
+	projects            map[int]*entities.Project
+	projectServices     map[serviceKey]*projectService
+	environments        map[int]*entities.Environment
+	environmentServices map[serviceKey]*environmentService
+	apiKeys             map[int]*apiKey
+	reservations        map[string]*entities.Reservation
+	quotaAlerts         map[int]*entities.QuotaAlert
+	credentials         map[int]*entities.Credentials
+	auditLog            map[int64]*auditEntry
+
+	requests          map[string]*entities.Request
+	requestPartitions map[string]*entities.RequestPartition
+
+	usageHourly       map[usageKey]*entities.UsageBucket
+	usageDaily        map[usageKey]*entities.UsageBucket
+	usageWatermark    time.Time
+	usageSealedBefore time.Time
+	webhooks          map[int]*entities.Webhook
+	deliveries        map[string]*entities.WebhookDelivery
+	expiryWatermark   time.Time
+	rateLimits        map[string]time.Time
+}
+
+// now returns the current time with the microsecond precision PostgreSQL
+// stores timestamps with.
+func (d *Driver) now() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
+
+func (d *Driver) nextID(table string) int {
+	d.sequences[table]++
+	return d.sequences[table]
+}
+
+func (d *Driver) reset() {
+	d.sequences = make(map[string]int)
+
+	d.services = make(map[int]*entities.Service)
+	d.clients = make(map[int]*entities.Client)
+	d.clientTokens = make(map[int]*clientToken)
+	d.projects = make(map[int]*entities.Project)
+	d.projectServices = make(map[serviceKey]*projectService)
+	d.environments = make(map[int]*entities.Environment)
+	d.environmentServices = make(map[serviceKey]*environmentService)
+	d.apiKeys = make(map[int]*apiKey)
+	d.reservations = make(map[string]*entities.Reservation)
+	d.quotaAlerts = make(map[int]*entities.QuotaAlert)
+	d.credentials = make(map[int]*entities.Credentials)
+	d.auditLog = make(map[int64]*auditEntry)
+
+	d.requests = make(map[string]*entities.Request)
+	d.requestPartitions = map[string]*entities.RequestPartition{
+		requestDefaultPartition: {Name: requestDefaultPartition},
+	}
+
+	d.usageHourly = make(map[usageKey]*entities.UsageBucket)
+	d.usageDaily = make(map[usageKey]*entities.UsageBucket)
+	d.usageWatermark = time.Time{}
+	d.usageSealedBefore = time.Time{}
+	d.webhooks = make(map[int]*entities.Webhook)
+	d.deliveries = make(map[string]*entities.WebhookDelivery)
+	d.expiryWatermark = time.Time{}
+	d.rateLimits = make(map[string]time.Time)
+}
+
+// The errors below carry the same codes and messages the postgres driver
+// maps its errors to, so use cases cannot tell both drivers apart.
+
+func notFoundError(entity string) errors.Error {
+	return errors.NewNotFound(fmt.Sprintf("%s not found", entity), nil)
+}
+
+func entityNotFoundError(entity string, identifiers map[string]any) errors.Error {
+	return errors.NewEntityNotFound(
+		entity, fmt.Sprintf("%s not found", entity), identifiers, nil,
+	)
+}
+
+// apiKeyLimitError reports that the environment already has as many usable
+// keys as the API key limit allows.
+func apiKeyLimitError(environmentID, usable int) errors.Error {
+	return errors.NewEntityValidationFailed(
+		"APIKey",
+		fmt.Sprintf(
+			"environment already has %d usable API keys, the limit of the client",
+			usable,
+		),
+		map[string]any{"environment_id": environmentID},
+		nil,
+	)
+}
+
+// alreadyExistsError reports a unique violation on the columns, given as
+// "a, b", holding the values.
+func alreadyExistsError(entity, columns string, values ...any) errors.Error {
+	return errors.NewEntityAlreadyExists(
+		entity,
+		fmt.Sprintf("Key (%s)=(%s) already exists.", columns, joinValues(values)),
+		map[string]any{},
+		nil,
+	)
+}
+
+// referenceNotFoundError reports a foreign key violation of the column,
+// whose value is missing from the referenced table.
+func referenceNotFoundError(entity, column string, value any, table string) errors.Error {
+	return errors.NewAttributeNotFound(
+		entity,
+		column,
+		fmt.Sprintf(
+			"Key (%s)=(%v) is not present in table %q.", column, value, table,
+		),
+		nil,
+	)
+}
+
+// copyAll copies each row, so callers never share memory with the tables.
+func copyAll[T any](rows []*T, copy func(*T) *T) []*T {
+	if rows == nil {
+		return nil
+	}
+
+	copies := make([]*T, len(rows))
+	for i, row := range rows {
+		copies[i] = copy(row)
+	}
+	return copies
+}
+
+func copyPointer[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+
+	c := *value
+	return &c
+}
+
+func joinValues(values []any) string {
+	var joined string
+	for i, value := range values {
+		if i > 0 {
+			joined += ", "
+		}
+		joined += fmt.Sprint(value)
+	}
+	return joined
+}
+
+func NewDriver() *Driver {
+	d := new(Driver)
+	d.reset()
+	return d
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// keyset is the position of a row in a list, ordered by a time and then by
+// an ID compared as a number when numeric is set, as a string otherwise.
+type keyset struct {
+	time    time.Time
+	id      string
+	numeric bool
+}
+
+func intKeyset(t time.Time, id int) keyset {
+	return keyset{time: t, id: strconv.Itoa(id), numeric: true}
+}
+
+func stringKeyset(t time.Time, id string) keyset {
+	return keyset{time: t, id: id}
+}
+
+func (k keyset) compare(other keyset) int {
+	if c := k.time.Compare(other.time); c != 0 {
+		return c
+	}
+
+	if k.numeric {
+		a, _ := strconv.Atoi(k.id)
+		b, _ := strconv.Atoi(other.id)
+		return cmp.Compare(a, b)
+	}
+	return cmp.Compare(k.id, other.id)
+}
+
+// sortByKeyset sorts the rows newest first, or oldest first when ascending
+// is set.
+func sortByKeyset[T any](rows []T, key func(T) keyset, ascending bool) {
+	slices.SortFunc(rows, func(a, b T) int {
+		if ascending {
+			return key(a).compare(key(b))
+		}
+		return key(b).compare(key(a))
+	})
+}
+
+// paginate sorts the rows newest first and returns those of the page, after
+// the page cursor. Like the postgres driver, one row more than the page holds
+// is returned so the caller can tell whether a next page exists.
+func paginate[T any](
+	rows []T, page *dto.Pagination, key func(T) keyset,
+) ([]T, errors.Error) {
+	return paginateBy(rows, page, key, false)
+}
+
+// paginateBy is paginate for lists that can be sorted in ascending order.
+func paginateBy[T any](
+	rows []T, page *dto.Pagination, key func(T) keyset, ascending bool,
+) ([]T, errors.Error) {
+	cursor, err := page.DecodeCursor()
+	if err != nil {
+		return nil, invalidCursorError(err)
+	}
+
+	sortByKeyset(rows, key, ascending)
+
+	if cursor != nil {
+		var after keyset
+		if len(rows) > 0 {
+			after = key(rows[0])
+		}
+		after.time, after.id = cursor.Time, cursor.ID
+
+		if after.numeric {
+			if _, err := strconv.Atoi(cursor.ID); err != nil {
+				return nil, invalidCursorError(err)
+			}
+		}
+
+		rows = slices.DeleteFunc(rows, func(row T) bool {
+			c := key(row).compare(after)
+			return c == 0 || (c > 0) != ascending
+		})
+	}
+
+	if limit := page.PageLimit() + 1; len(rows) > limit {
+		rows = rows[:limit]
+	}
+	return rows, nil
+}
+
+func invalidCursorError(err error) errors.Error {
+	return errors.NewAttributeValidationFailed(
+		"Pagination", "cursor", "cursor is invalid", err,
+	)
+}
@@ -0,0 +1,596 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// projectService is the assignment of a service to a project, whose pool is
+// shared by the environments of the project. The name and version of the
+// service are joined when read.
+type projectService struct {
+	maxRequests      int
+	availableRequest int
+	resetFrequency   enums.ProjectServiceResetFrequency
+	nextReset        time.Time
+
+	createdAt time.Time
+}
+
+type ProjectRepository struct {
+	*Driver
+}
+
+func (r *ProjectRepository) ListProjectServiceDueForReset(
+	ctx context.Context, today time.Time,
+) ([]*entities.Project, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var projects []*entities.Project
+	for _, project := range r.projects {
+		p := *project
+		p.Services = nil
+
+		for key, service := range r.projectServices {
+			if key.ownerID == project.ID && !service.nextReset.After(today) {
+				p.Services = append(p.Services, r.projectServiceEntity(key))
+			}
+		}
+
+		if len(p.Services) > 0 {
+			projects = append(projects, &p)
+		}
+	}
+
+	return projects, nil
+}
+
+func (r *ProjectRepository) Delete(
+	ctx context.Context, id int,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.projects[id]; !ok {
+		return entityNotFoundError("Project", map[string]any{"id": id})
+	}
+
+	r.deleteProject(id)
+	return nil
+}
+
+func (r *ProjectRepository) GetProjectClientInfoByID(
+	ctx context.Context, id int,
+) (*dto.ProjectClientInfoResponse, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	project, ok := r.projects[id]
+	if !ok {
+		return nil, notFoundError("ProjectService")
+	}
+
+	client := r.clients[project.ClientID]
+	return &dto.ProjectClientInfoResponse{
+		ProjectID:   project.ID,
+		ProjectName: project.Name,
+		ClientID:    client.ID,
+		ClientName:  client.Name,
+	}, nil
+}
+
+func (r *ProjectRepository) ResetAvailableRequestsForEnvsService(
+	ctx context.Context, id, serviceID int,
+) ([]*dto.EnvironmentServiceReset, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	service, ok := r.projectServices[serviceKey{ownerID: id, serviceID: serviceID}]
+	if !ok {
+		return nil, entityNotFoundError(
+			"ProjectService",
+			map[string]any{"project_id": id, "service_id": serviceID},
+		)
+	}
+
+	service.availableRequest = service.maxRequests
+	return r.resetEnvironmentServices(id, serviceID), nil
+}
+
+func (r *ProjectRepository) ResetProjectServiceUsage(
+	ctx context.Context, id, serviceID int, nextReset time.Time,
+) ([]*dto.EnvironmentServiceReset, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	service, ok := r.projectServices[serviceKey{ownerID: id, serviceID: serviceID}]
+	if !ok {
+		return nil, entityNotFoundError(
+			"ProjectService",
+			map[string]any{"project_id": id, "service_id": serviceID},
+		)
+	}
+
+	service.nextReset = nextReset
+	service.availableRequest = service.maxRequests
+	return r.resetEnvironmentServices(id, serviceID), nil
+}
+
+// resetEnvironmentServices refills the service in every environment of the
+// project and lets the quota alerts watching it trigger again, as both the
+// project and the environment quotas have been reset.
+func (d *Driver) resetEnvironmentServices(
+	id, serviceID int,
+) []*dto.EnvironmentServiceReset {
+	var environmentIDs []int
+	var resets []*dto.EnvironmentServiceReset
+
+	for key, service := range d.environmentServices {
+		environment := d.environments[key.ownerID]
+		if key.serviceID != serviceID || environment.ProjectID != id {
+			continue
+		}
+
+		service.availableRequest = service.maxRequests
+		environmentIDs = append(environmentIDs, environment.ID)
+
+		s := d.environmentServiceEntity(key)
+		resets = append(resets, &dto.EnvironmentServiceReset{
+			ID:     environment.ID,
+			Name:   environment.Name,
+			Status: environment.Status,
+			Service: &dto.EnvironmentServiceResponse{
+				ID:               s.ID,
+				Name:             s.Name,
+				Version:          s.Version,
+				MaxRequests:      s.MaxRequests,
+				AvailableRequest: s.AvailableRequest,
+				AssignedAt:       s.AssignedAt,
+			},
+		})
+	}
+
+	d.rearmQuotaAlerts(enums.QuotaLevelNull, serviceID, environmentIDs...)
+	return resets
+}
+
+func (r *ProjectRepository) GetServiceByID(
+	ctx context.Context, id, serviceID int,
+) (*entities.ProjectService, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := serviceKey{ownerID: id, serviceID: serviceID}
+	if _, ok := r.projectServices[key]; !ok {
+		return nil, notFoundError("ProjectService")
+	}
+
+	return r.projectServiceEntity(key), nil
+}
+
+func (r *ProjectRepository) UpdateService(
+	ctx context.Context, id, serviceID int, update *dto.ProjectServiceUpdate,
+) (*entities.ProjectService, errors.Error) {
+	if update == nil {
+		return r.GetServiceByID(ctx, id, serviceID)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := serviceKey{ownerID: id, serviceID: serviceID}
+	service, ok := r.projectServices[key]
+	if !ok {
+		return nil, notFoundError("ProjectService")
+	}
+
+	// Lowering max_requests caps what is left of the current period, as
+	// environment services do.
+	if update.MaxRequests == -1 ||
+		service.availableRequest == -1 ||
+		service.availableRequest > update.MaxRequests {
+		service.availableRequest = update.MaxRequests
+	}
+	service.maxRequests = update.MaxRequests
+
+	if update.ResetFrequency != enums.ProjectServiceResetFrequencyNull {
+		service.resetFrequency = update.ResetFrequency
+	}
+
+	if !update.NextReset.IsZero() {
+		service.nextReset = update.NextReset
+	}
+
+	return r.projectServiceEntity(key), nil
+}
+
+func (r *ProjectRepository) ExistsServiceIn(
+	ctx context.Context, serviceID int,
+) (bool, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key := range r.projectServices {
+		if key.serviceID == serviceID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *ProjectRepository) RemoveService(
+	ctx context.Context, id, serviceID int,
+) (int64, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := serviceKey{ownerID: id, serviceID: serviceID}
+	if _, ok := r.projectServices[key]; !ok {
+		return 0, nil
+	}
+
+	delete(r.projectServices, key)
+	return 1, nil
+}
+
+func (r *ProjectRepository) UpdateStatus(
+	ctx context.Context, id int, status enums.ProjectStatus,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	project, ok := r.projects[id]
+	if !ok {
+		return entityNotFoundError("Project", map[string]any{"id": id})
+	}
+
+	project.Status = status
+	return nil
+}
+
+func (r *ProjectRepository) Update(
+	ctx context.Context, id int, update *dto.ProjectUpdate,
+) (*entities.Project, errors.Error) {
+	if update == nil || update.Name == "" {
+		return r.GetByID(ctx, id)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	project, ok := r.projects[id]
+	if !ok {
+		return nil, entityNotFoundError("Project", map[string]any{"id": id})
+	}
+
+	if err := r.checkProjectUnique(id, update.Name, project.ClientID); err != nil {
+		return nil, err
+	}
+
+	project.Name = update.Name
+	return r.projectEntity(project), nil
+}
+
+func (r *ProjectRepository) Exists(
+	ctx context.Context, id int,
+) (bool, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.projects[id]
+	return ok, nil
+}
+
+func (r *ProjectRepository) GetProjectServiceQuotaUsage(
+	ctx context.Context, id, serviceID int,
+) (*dto.QuotaUsage, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	quota, ok := r.projectServiceQuotaUsage(id, serviceID)
+	if !ok {
+		return nil, notFoundError("ProjectService")
+	}
+
+	return quota, nil
+}
+
+// projectServiceQuotaUsage returns the max requests of the project service
+// along with those allocated to the service by the environments of the
+// project, whose unlimited allocations are left out.
+func (d *Driver) projectServiceQuotaUsage(
+	id, serviceID int,
+) (*dto.QuotaUsage, bool) {
+	service, ok := d.projectServices[serviceKey{ownerID: id, serviceID: serviceID}]
+	if !ok {
+		return nil, false
+	}
+
+	quota := &dto.QuotaUsage{MaxAllowed: service.maxRequests}
+	for key, es := range d.environmentServices {
+		if key.serviceID == serviceID &&
+			d.environments[key.ownerID].ProjectID == id &&
+			es.maxRequests >= 0 {
+			quota.CurrentAllocated += es.maxRequests
+		}
+	}
+
+	return quota, true
+}
+
+func (r *ProjectRepository) GetByID(
+	ctx context.Context, id int,
+) (*entities.Project, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	project, ok := r.projects[id]
+	if !ok {
+		return nil, notFoundError("Project")
+	}
+
+	return r.projectEntity(project), nil
+}
+
+func (r *ProjectRepository) List(
+	ctx context.Context, page *dto.Pagination,
+) ([]*entities.Project, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var projects []*entities.Project
+	for _, project := range r.projects {
+		projects = append(projects, project)
+	}
+
+	return r.paginateProjects(projects, page)
+}
+
+func (r *ProjectRepository) Count(ctx context.Context) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.projects), nil
+}
+
+func (r *ProjectRepository) ListByClient(
+	ctx context.Context, clientID int, page *dto.Pagination,
+) ([]*entities.Project, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.paginateProjects(r.clientProjects(clientID), page)
+}
+
+func (r *ProjectRepository) CountByClient(
+	ctx context.Context, clientID int,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.clientProjects(clientID)), nil
+}
+
+func (r *ProjectRepository) paginateProjects(
+	projects []*entities.Project, page *dto.Pagination,
+) ([]*entities.Project, errors.Error) {
+	projects, err := paginate(
+		projects, page,
+		func(p *entities.Project) keyset { return intKeyset(p.CreatedAt, p.ID) },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyAll(projects, r.projectEntity), nil
+}
+
+func (r *ProjectRepository) AddService(
+	ctx context.Context, id int, service *entities.ProjectService,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.projects[id]; !ok {
+		return referenceNotFoundError(
+			"ProjectService", "project_id", id, "project",
+		)
+	}
+
+	if err := r.checkProjectService(id, service.ID); err != nil {
+		return err
+	}
+
+	r.createProjectService(id, service)
+	return nil
+}
+
+func (r *ProjectRepository) Create(
+	ctx context.Context, project *entities.Project,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Every row is checked before anything is written, which stands for the
+	// transaction of the postgres driver.
+	if _, ok := r.clients[project.ClientID]; !ok {
+		return referenceNotFoundError(
+			"Project", "client_id", project.ClientID, "client",
+		)
+	}
+
+	if err := r.checkProjectUnique(0, project.Name, project.ClientID); err != nil {
+		return err
+	}
+
+	project.ID = r.nextID("project")
+	project.CreatedAt = r.now()
+
+	for i, service := range project.Services {
+		if err := r.checkProjectService(project.ID, service.ID); err != nil {
+			return err
+		}
+
+		if slices.ContainsFunc(
+			project.Services[:i],
+			func(s *entities.ProjectService) bool { return s.ID == service.ID },
+		) {
+			return alreadyExistsError(
+				"ProjectService", "project_id, service_id", project.ID, service.ID,
+			)
+		}
+	}
+
+	stored := *project
+	stored.Services = nil
+	r.projects[project.ID] = &stored
+
+	if len(project.Services) == 0 {
+		project.Services = nil
+		return nil
+	}
+
+	services := make([]*entities.ProjectService, len(project.Services))
+	for i, service := range project.Services {
+		services[i] = copyPointer(service)
+		r.createProjectService(project.ID, services[i])
+	}
+
+	project.Services = services
+	return nil
+}
+
+// checkProjectUnique enforces the unique name of the projects of a client,
+// other than the one with the given ID.
+func (d *Driver) checkProjectUnique(id int, name string, clientID int) errors.Error {
+	for _, p := range d.projects {
+		if p.ID != id && p.Name == name && p.ClientID == clientID {
+			return alreadyExistsError("Project", "name, client_id", name, clientID)
+		}
+	}
+
+	return nil
+}
+
+// checkProjectService enforces the keys of a project service about to be
+// created, but the one referencing the project.
+func (d *Driver) checkProjectService(id, serviceID int) errors.Error {
+	if _, ok := d.services[serviceID]; !ok {
+		return referenceNotFoundError(
+			"ProjectService", "service_id", serviceID, "service",
+		)
+	}
+
+	if _, ok := d.projectServices[serviceKey{ownerID: id, serviceID: serviceID}]; ok {
+		return alreadyExistsError(
+			"ProjectService", "project_id, service_id", id, serviceID,
+		)
+	}
+
+	return nil
+}
+
+// createProjectService stores the service, whose keys are checked, and
+// fills its name, version and assignment time.
+func (d *Driver) createProjectService(id int, service *entities.ProjectService) {
+	key := serviceKey{ownerID: id, serviceID: service.ID}
+	d.projectServices[key] = &projectService{
+		maxRequests:      service.MaxRequests,
+		availableRequest: service.AvailableRequest,
+		resetFrequency:   service.ResetFrequency,
+		nextReset:        service.NextReset,
+		createdAt:        d.now(),
+	}
+
+	*service = *d.projectServiceEntity(key)
+}
+
+func (d *Driver) clientProjects(clientID int) []*entities.Project {
+	var projects []*entities.Project
+	for _, project := range d.projects {
+		if project.ClientID == clientID {
+			projects = append(projects, project)
+		}
+	}
+
+	return projects
+}
+
+// projectEntity copies the project along with its services, the last
+// assigned first.
+func (d *Driver) projectEntity(project *entities.Project) *entities.Project {
+	p := *project
+	p.Services = make([]*entities.ProjectService, 0)
+
+	for key := range d.projectServices {
+		if key.ownerID == project.ID {
+			p.Services = append(p.Services, d.projectServiceEntity(key))
+		}
+	}
+
+	slices.SortFunc(p.Services, func(a, b *entities.ProjectService) int {
+		if c := b.AssignedAt.Compare(a.AssignedAt); c != 0 {
+			return c
+		}
+		return b.ID - a.ID
+	})
+	return &p
+}
+
+func (d *Driver) projectServiceEntity(key serviceKey) *entities.ProjectService {
+	row := d.projectServices[key]
+	service := d.services[key.serviceID]
+
+	return &entities.ProjectService{
+		ID:               service.ID,
+		Name:             service.Name,
+		Version:          service.Version,
+		NextReset:        row.nextReset,
+		MaxRequests:      row.maxRequests,
+		ResetFrequency:   row.resetFrequency,
+		AvailableRequest: row.availableRequest,
+		AssignedAt:       row.createdAt,
+	}
+}
+
+// deleteProject deletes the project along with its environments, services
+// and webhooks. Its requests are kept without it.
+func (d *Driver) deleteProject(id int) {
+	delete(d.projects, id)
+
+	for environmentID, environment := range d.environments {
+		if environment.ProjectID == id {
+			d.deleteEnvironment(environmentID)
+		}
+	}
+
+	for key := range d.projectServices {
+		if key.ownerID == id {
+			delete(d.projectServices, key)
+		}
+	}
+
+	for webhookID, webhook := range d.webhooks {
+		if webhook.ProjectID == id {
+			d.deleteWebhook(webhookID)
+		}
+	}
+
+	for _, request := range d.requests {
+		if request.Project.ID == id {
+			request.Project.ID = 0
+		}
+	}
+}
+
+func NewProjectRepository(driver *Driver) *ProjectRepository {
+	return &ProjectRepository{Driver: driver}
+}
@@ -0,0 +1,146 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type QuotaAlertRepository struct {
+	*Driver
+}
+
+func (r *QuotaAlertRepository) ListByEnvironmentService(
+	ctx context.Context, environmentID, serviceID int,
+) ([]*entities.QuotaAlert, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var alerts []*entities.QuotaAlert
+	for _, alert := range r.quotaAlerts {
+		if alert.EnvironmentID == environmentID && alert.ServiceID == serviceID {
+			alerts = append(alerts, copyPointer(alert))
+		}
+	}
+
+	slices.SortFunc(alerts, func(a, b *entities.QuotaAlert) int {
+		return cmp.Or(
+			cmp.Compare(a.Level, b.Level), cmp.Compare(a.Threshold, b.Threshold),
+		)
+	})
+	return alerts, nil
+}
+
+func (r *QuotaAlertRepository) Create(
+	ctx context.Context, alert *entities.QuotaAlert,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := serviceKey{ownerID: alert.EnvironmentID, serviceID: alert.ServiceID}
+	if _, ok := r.environmentServices[key]; !ok {
+		return referenceNotFoundError(
+			"QuotaAlert",
+			"environment_id, service_id",
+			joinValues([]any{alert.EnvironmentID, alert.ServiceID}),
+			"environment_service",
+		)
+	}
+
+	for _, a := range r.quotaAlerts {
+		if a.EnvironmentID == alert.EnvironmentID &&
+			a.ServiceID == alert.ServiceID &&
+			a.Level == alert.Level &&
+			a.Threshold == alert.Threshold {
+			return alreadyExistsError(
+				"QuotaAlert",
+				"environment_id, service_id, level, threshold",
+				alert.EnvironmentID, alert.ServiceID, alert.Level, alert.Threshold,
+			)
+		}
+	}
+
+	alert.ID = r.nextID("quota_alert")
+	alert.CreatedAt = r.now()
+
+	stored := copyPointer(alert)
+	stored.TriggeredAt = time.Time{}
+
+	r.quotaAlerts[alert.ID] = stored
+	return nil
+}
+
+// Trigger marks as triggered the alerts of the environment service that
+// have not triggered since their quota was reset and whose threshold is
+// at most the percentage consumed of the quota they watch, and returns
+// them. A percentage of 0 triggers no alert of its level. Alerts are only
+// returned by the call that triggered them.
+func (r *QuotaAlertRepository) Trigger(
+	ctx context.Context,
+	environmentID, serviceID, environmentPercent, projectPercent int,
+) ([]*entities.QuotaAlert, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+
+	var alerts []*entities.QuotaAlert
+	for _, alert := range r.quotaAlerts {
+		if alert.EnvironmentID != environmentID ||
+			alert.ServiceID != serviceID ||
+			!alert.TriggeredAt.IsZero() {
+			continue
+		}
+
+		percent := projectPercent
+		if alert.Level == enums.QuotaLevelEnvironment {
+			percent = environmentPercent
+		}
+
+		if alert.Threshold <= percent {
+			alert.TriggeredAt = now
+			alerts = append(alerts, copyPointer(alert))
+		}
+	}
+
+	return alerts, nil
+}
+
+func (r *QuotaAlertRepository) Delete(
+	ctx context.Context, environmentID, serviceID, id int,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	alert, ok := r.quotaAlerts[id]
+	if !ok || alert.EnvironmentID != environmentID || alert.ServiceID != serviceID {
+		return entityNotFoundError("QuotaAlert", map[string]any{"id": id})
+	}
+
+	delete(r.quotaAlerts, id)
+	return nil
+}
+
+// rearmQuotaAlerts clears when the alerts set on the service in the
+// environments triggered, as their quota was reset. Only the alerts of the
+// level are rearmed, or those of every level when it is QuotaLevelNull.
+func (d *Driver) rearmQuotaAlerts(
+	level enums.QuotaLevel, serviceID int, environmentIDs ...int,
+) {
+	for _, alert := range d.quotaAlerts {
+		if (level == enums.QuotaLevelNull || alert.Level == level) &&
+			alert.ServiceID == serviceID &&
+			slices.Contains(environmentIDs, alert.EnvironmentID) {
+			alert.TriggeredAt = time.Time{}
+		}
+	}
+}
+
+func NewQuotaAlertRepository(driver *Driver) *QuotaAlertRepository {
+	return &QuotaAlertRepository{Driver: driver}
+}
@@ -0,0 +1,34 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// RateLimiter keeps the theoretical arrival time of each key along with the
+// other tables, so it is shared by every repository set of the driver.
+type RateLimiter struct {
+	*Driver
+}
+
+func (r *RateLimiter) Allow(
+	ctx context.Context, key string, limit *entities.APIKeyRateLimit,
+) (time.Duration, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tat, retryAfter := limit.Take(r.rateLimits[key], r.now())
+	if retryAfter > 0 {
+		return max(retryAfter, time.Millisecond), nil
+	}
+
+	r.rateLimits[key] = tat
+	return 0, nil
+}
+
+func NewRateLimiter(driver *Driver) *RateLimiter {
+	return &RateLimiter{Driver: driver}
+}
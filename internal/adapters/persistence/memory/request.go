@@ -0,0 +1,721 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// requestDefaultPartition holds the requests no other partition covers.
+const requestDefaultPartition = "request_default"
+
+type RequestRepository struct {
+	*Driver
+}
+
+func (r *RequestRepository) DeleteByService(
+	ctx context.Context, serviceID int,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, request := range r.requests {
+		if request.Service.ID == serviceID {
+			delete(r.requests, id)
+		}
+	}
+
+	return nil
+}
+
+func (r *RequestRepository) DeleteByIDs(
+	ctx context.Context, ids []string,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int
+	for _, id := range ids {
+		if request, ok := r.requests[id]; ok {
+			r.sealUsage(request)
+			delete(r.requests, id)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+// DropPartition drops the partition along with the requests it holds.
+func (r *RequestRepository) DropPartition(
+	ctx context.Context, partition string,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.requestPartitions[partition]; !ok {
+		return undefinedPartitionError(partition)
+	}
+
+	for id, request := range r.requests {
+		if r.requestPartition(request) == partition {
+			r.sealUsage(request)
+			delete(r.requests, id)
+		}
+	}
+
+	delete(r.requestPartitions, partition)
+	return nil
+}
+
+func (r *RequestRepository) UpdateExecutionStatus(
+	ctx context.Context, id string, update *dto.RequestExecutionStatusUpdate,
+) errors.Error {
+	if update == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request, ok := r.requests[id]
+	if !ok {
+		return entityNotFoundError("Request", map[string]any{"id": id})
+	}
+
+	request.ExecutionStatus = update.ExecutionStatus
+	request.StatusCode = update.StatusCode
+	request.Detail = update.Detail
+	return nil
+}
+
+// UpdateUnits sets the units charged for a request, as settled when its
+// reservation is committed or released.
+func (r *RequestRepository) UpdateUnits(
+	ctx context.Context, id string, units int,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request, ok := r.requests[id]
+	if !ok {
+		return entityNotFoundError("Request", map[string]any{"id": id})
+	}
+
+	request.Units = units
+	return nil
+}
+
+func (r *RequestRepository) ListByService(
+	ctx context.Context,
+	serviceID int,
+	filter *dto.RequestFilter,
+	page *dto.Pagination,
+) ([]*entities.Request, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	requests, err := paginate(
+		r.filterByService(serviceID, filter), page, requestCreatedAtKeyset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyAll(requests, copyRequest), nil
+}
+
+// ListExpired returns, oldest first, up to limit of the requests selected by
+// the retention, with their metadata.
+func (r *RequestRepository) ListExpired(
+	ctx context.Context, retention *dto.RequestRetention, limit int,
+) ([]*entities.Request, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Chains are only expired whole.
+	kept := make(map[string]bool)
+	for _, request := range r.requests {
+		if !request.CreatedAt.Before(retention.Before) && request.StartPoint != "" {
+			kept[request.StartPoint] = true
+		}
+	}
+
+	var requests []*entities.Request
+	for _, request := range r.requests {
+		if !request.CreatedAt.Before(retention.Before) ||
+			(request.StartPoint != "" && kept[request.StartPoint]) {
+			continue
+		}
+
+		if retention.ServiceID != 0 {
+			if request.Service.ID != retention.ServiceID {
+				continue
+			}
+		} else if request.Service.ID != 0 &&
+			slices.Contains(retention.ExcludedServiceIDs, request.Service.ID) {
+			continue
+		}
+
+		requests = append(requests, request)
+	}
+
+	sortByKeyset(requests, requestCreatedAtKeyset, true)
+	if len(requests) > limit {
+		requests = requests[:limit]
+	}
+
+	return copyAll(requests, copyRequestWithMetadata), nil
+}
+
+func (r *RequestRepository) ListOrphaned(
+	ctx context.Context, before time.Time, limit int,
+) ([]*entities.Request, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var requests []*entities.Request
+	for _, request := range r.requests {
+		if request.CreatedAt.Before(before) && request.StartPoint != "" {
+			if _, ok := r.requests[request.StartPoint]; !ok {
+				requests = append(requests, request)
+			}
+		}
+	}
+
+	sortByKeyset(requests, requestCreatedAtKeyset, true)
+	if len(requests) > limit {
+		requests = requests[:limit]
+	}
+
+	return copyAll(requests, copyRequestWithMetadata), nil
+}
+
+// ListByPartition returns a page of the requests held by the partition,
+// oldest first, with their metadata.
+func (r *RequestRepository) ListByPartition(
+	ctx context.Context, partition string, page *dto.Pagination,
+) ([]*entities.Request, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.requestPartitions[partition]; !ok {
+		return nil, undefinedPartitionError(partition)
+	}
+
+	var requests []*entities.Request
+	for _, request := range r.requests {
+		if r.requestPartition(request) == partition {
+			requests = append(requests, request)
+		}
+	}
+
+	requests, err := paginateBy(requests, page, requestCreatedAtKeyset, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyAll(requests, copyRequestWithMetadata), nil
+}
+
+// ListPartitions returns the partitions of the request log, ordered by the
+// requests they hold, with the default partition last.
+func (r *RequestRepository) ListPartitions(
+	ctx context.Context,
+) ([]*entities.RequestPartition, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var partitions []*entities.RequestPartition
+	for _, partition := range r.requestPartitions {
+		partitions = append(partitions, copyPointer(partition))
+	}
+
+	slices.SortFunc(partitions, func(a, b *entities.RequestPartition) int {
+		switch {
+		case a.To.IsZero() && !b.To.IsZero():
+			return 1
+		case !a.To.IsZero() && b.To.IsZero():
+			return -1
+		}
+		return cmp.Or(a.To.Compare(b.To), cmp.Compare(a.Name, b.Name))
+	})
+	return partitions, nil
+}
+
+func (r *RequestRepository) Search(
+	ctx context.Context, filter *dto.RequestSearch, page *dto.Pagination,
+) ([]*entities.Request, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	requests, err := paginateBy(
+		r.search(filter), page,
+		requestSearchKeyset(filter), filter.SortOrder == enums.SortOrderAsc,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyAll(requests, copyRequest), nil
+}
+
+// StreamSearch hands each request matching the filter to yield, in the
+// order Search lists them, stopping at the first error yield returns. The
+// requests are copied before the first one is yielded, so yield may call
+// the driver.
+func (r *RequestRepository) StreamSearch(
+	ctx context.Context,
+	filter *dto.RequestSearch,
+	yield func(*entities.Request) errors.Error,
+) errors.Error {
+	r.mu.Lock()
+	requests := r.search(filter)
+	sortByKeyset(
+		requests,
+		requestSearchKeyset(filter),
+		filter.SortOrder == enums.SortOrderAsc,
+	)
+	requests = copyAll(requests, copyRequest)
+	r.mu.Unlock()
+
+	for _, request := range requests {
+		if err := yield(request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListChain returns every request of the chain the request belongs to, from
+// the request that started it, in the order they were made.
+func (r *RequestRepository) ListChain(
+	ctx context.Context, id string,
+) ([]*entities.Request, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request, ok := r.requests[id]
+	if !ok {
+		return nil, nil
+	}
+
+	startPoint := cmp.Or(request.StartPoint, request.ID)
+
+	var chain []*entities.Request
+	for _, request := range r.requests {
+		if request.ID == startPoint || request.StartPoint == startPoint {
+			chain = append(chain, copyRequest(request))
+		}
+	}
+
+	slices.SortFunc(chain, func(a, b *entities.Request) int {
+		return cmp.Or(
+			a.RequestTime.Compare(b.RequestTime), a.CreatedAt.Compare(b.CreatedAt),
+		)
+	})
+	return chain, nil
+}
+
+func (r *RequestRepository) GetByID(
+	ctx context.Context, id string,
+) (*entities.Request, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request, ok := r.requests[id]
+	if !ok {
+		return nil, notFoundError("Request")
+	}
+
+	return copyRequestWithMetadata(request), nil
+}
+
+func (r *RequestRepository) CountByService(
+	ctx context.Context, serviceID int, filter *dto.RequestFilter,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.filterByService(serviceID, filter)), nil
+}
+
+func (r *RequestRepository) Count(
+	ctx context.Context, filter *dto.RequestSearch,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.search(filter)), nil
+}
+
+func (r *RequestRepository) filterByService(
+	serviceID int, filter *dto.RequestFilter,
+) []*entities.Request {
+	var requests []*entities.Request
+	for _, request := range r.requests {
+		if request.Service.ID != serviceID {
+			continue
+		}
+
+		if filter != nil {
+			if !filter.RequestTimeTo.IsZero() &&
+				request.RequestTime.After(filter.RequestTimeTo) {
+				continue
+			}
+
+			if !filter.RequestTimeFrom.IsZero() &&
+				request.RequestTime.Before(filter.RequestTimeFrom) {
+				continue
+			}
+
+			if filter.ExecutionStatus != enums.RequestExecutionStatusNull &&
+				request.ExecutionStatus != filter.ExecutionStatus {
+				continue
+			}
+		}
+
+		requests = append(requests, request)
+	}
+
+	return requests
+}
+
+func (r *RequestRepository) search(filter *dto.RequestSearch) []*entities.Request {
+	var apiKeyIDs map[int]bool
+	if filter != nil && filter.APIKeyID != 0 {
+		apiKeyIDs = map[int]bool{filter.APIKeyID: true}
+		if filter.IncludeRotated {
+			apiKeyIDs = r.rotationChain(filter.APIKeyID)
+		}
+	}
+
+	var requests []*entities.Request
+	for _, request := range r.requests {
+		if filter == nil || r.matchSearch(request, filter, apiKeyIDs) {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests
+}
+
+// matchSearch reports whether the request matches the filter, whose
+// APIKeyID filter is expanded into apiKeyIDs.
+func (r *RequestRepository) matchSearch(
+	request *entities.Request, filter *dto.RequestSearch, apiKeyIDs map[int]bool,
+) bool {
+	if filter.ClientID != 0 {
+		project, ok := r.projects[request.Project.ID]
+		if !ok || project.ClientID != filter.ClientID {
+			return false
+		}
+	}
+
+	if filter.ProjectID != 0 && request.Project.ID != filter.ProjectID {
+		return false
+	}
+
+	if filter.EnvironmentID != 0 && request.Environment.ID != filter.EnvironmentID {
+		return false
+	}
+
+	if filter.APIKeyID != 0 && !apiKeyIDs[request.APIKey.ID] {
+		return false
+	}
+
+	if filter.ServiceID != 0 && request.Service.ID != filter.ServiceID {
+		return false
+	}
+
+	if filter.PathPrefix != "" && !strings.HasPrefix(request.Path, filter.PathPrefix) {
+		return false
+	}
+
+	if filter.Method != "" && request.Method != filter.Method {
+		return false
+	}
+
+	if filter.IPAddress != "" && !ipAddressIn(request.IPAddress, filter.IPAddress) {
+		return false
+	}
+
+	if filter.StatusCodeFrom != 0 && request.StatusCode < filter.StatusCodeFrom {
+		return false
+	}
+
+	// Requests without a status code have none to compare.
+	if filter.StatusCodeTo != 0 &&
+		(request.StatusCode == 0 || request.StatusCode > filter.StatusCodeTo) {
+		return false
+	}
+
+	if filter.UnauthorizedReason != "" &&
+		request.UnauthorizedReason != filter.UnauthorizedReason {
+		return false
+	}
+
+	if filter.ExecutionStatus != enums.RequestExecutionStatusNull &&
+		request.ExecutionStatus != filter.ExecutionStatus {
+		return false
+	}
+
+	if !filter.RequestTimeFrom.IsZero() &&
+		request.RequestTime.Before(filter.RequestTimeFrom) {
+		return false
+	}
+
+	if !filter.RequestTimeTo.IsZero() &&
+		request.RequestTime.After(filter.RequestTimeTo) {
+		return false
+	}
+
+	return true
+}
+
+// ipAddressIn reports whether the address is in the network. A plain IP
+// address is taken as a network of one address.
+func ipAddressIn(address, network string) bool {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return false
+	}
+
+	prefix, err := netip.ParsePrefix(network)
+	if err != nil {
+		networkAddr, err := netip.ParseAddr(network)
+		if err != nil {
+			return false
+		}
+		prefix = netip.PrefixFrom(networkAddr, networkAddr.BitLen())
+	}
+
+	return prefix.Contains(addr.Unmap())
+}
+
+func (r *RequestRepository) Create(
+	ctx context.Context, request *entities.Request,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.createRequest(request, false)
+}
+
+func (r *RequestRepository) CreateAsInitialPoint(
+	ctx context.Context, request *entities.Request,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.createRequest(request, true)
+}
+
+func (r *RequestRepository) CreateBatch(
+	ctx context.Context, requests []*entities.Request,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, request := range requests {
+		if err := r.checkRequest(request); err != nil {
+			return err
+		}
+	}
+
+	for _, request := range requests {
+		r.storeRequest(request)
+	}
+	return nil
+}
+
+// createRequest stores the request, which starts a chain of its own when
+// initialPoint is set.
+func (d *Driver) createRequest(
+	request *entities.Request, initialPoint bool,
+) errors.Error {
+	if err := d.checkRequest(request); err != nil {
+		return err
+	}
+
+	request.ID = uuid.NewString()
+	request.CreatedAt = d.now()
+	if initialPoint {
+		request.StartPoint = request.ID
+	}
+
+	d.storeRequest(request)
+	return nil
+}
+
+// storeRequest stores a copy of the request under its id.
+func (d *Driver) storeRequest(request *entities.Request) {
+	stored := copyRequestWithMetadata(request)
+	stored.APIKey.Key = request.APIKey.KeySummary()
+	if stored.Metadata == nil {
+		stored.Metadata = new(entities.RequestMetadata)
+	}
+
+	d.requests[request.ID] = stored
+}
+
+// checkRequest checks the foreign keys of the request.
+func (d *Driver) checkRequest(request *entities.Request) errors.Error {
+	references := []struct {
+		column string
+		id     int
+		table  string
+		exists func(int) bool
+	}{
+		{"api_key_id", request.APIKey.ID, "api_key", d.hasAPIKey},
+		{"project_id", request.Project.ID, "project", d.hasProject},
+		{"environment_id", request.Environment.ID, "environment", d.hasEnvironment},
+		{"service_id", request.Service.ID, "service", d.hasService},
+	}
+
+	for _, reference := range references {
+		if reference.id != 0 && !reference.exists(reference.id) {
+			return referenceNotFoundError(
+				"Request", reference.column, reference.id, reference.table,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (d *Driver) hasAPIKey(id int) bool      { _, ok := d.apiKeys[id]; return ok }
+func (d *Driver) hasProject(id int) bool     { _, ok := d.projects[id]; return ok }
+func (d *Driver) hasEnvironment(id int) bool { _, ok := d.environments[id]; return ok }
+func (d *Driver) hasService(id int) bool     { _, ok := d.services[id]; return ok }
+
+// CreatePartition creates the partition holding the requests created in
+// [from, to), named after the month it starts in. It reports false when the
+// partition exists already, or when another partition holds part of the
+// range.
+func (r *RequestRepository) CreatePartition(
+	ctx context.Context, from, to time.Time,
+) (bool, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := fmt.Sprintf("request_%s", from.UTC().Format("2006_01"))
+	if _, ok := r.requestPartitions[name]; ok {
+		return false, nil
+	}
+
+	for _, partition := range r.requestPartitions {
+		if partition.From.IsZero() && partition.To.IsZero() {
+			continue
+		}
+
+		if partition.From.Before(to) && from.Before(partition.To) {
+			return false, nil
+		}
+	}
+
+	// Like PostgreSQL, rows of the default partition are not moved to a new
+	// partition.
+	for _, request := range r.requests {
+		if r.requestPartition(request) == requestDefaultPartition &&
+			!request.CreatedAt.Before(from) && request.CreatedAt.Before(to) {
+			return false, errors.NewAttributeValidationFailed(
+				"Request",
+				"",
+				fmt.Sprintf(
+					"updated partition constraint for default partition %q would be violated by some row",
+					requestDefaultPartition,
+				),
+				nil,
+			)
+		}
+	}
+
+	r.requestPartitions[name] = &entities.RequestPartition{
+		Name: name, From: from, To: to,
+	}
+	return true, nil
+}
+
+// requestPartition returns the name of the partition holding the request.
+func (d *Driver) requestPartition(request *entities.Request) string {
+	for _, partition := range d.requestPartitions {
+		if partition.To.IsZero() {
+			continue
+		}
+
+		if !request.CreatedAt.Before(partition.From) &&
+			request.CreatedAt.Before(partition.To) {
+			return partition.Name
+		}
+	}
+
+	return requestDefaultPartition
+}
+
+func undefinedPartitionError(partition string) errors.Error {
+	return errors.NewInternal(
+		fmt.Sprintf("relation %q does not exist", partition), nil,
+	)
+}
+
+func requestCreatedAtKeyset(r *entities.Request) keyset {
+	return stringKeyset(r.CreatedAt, r.ID)
+}
+
+func requestSearchKeyset(filter *dto.RequestSearch) func(*entities.Request) keyset {
+	if filter.SortBy == enums.RequestSortFieldRequestTime {
+		return func(r *entities.Request) keyset {
+			return stringKeyset(r.RequestTime, r.ID)
+		}
+	}
+
+	return requestCreatedAtKeyset
+}
+
+// copyRequest copies the request without its metadata, which only some
+// queries of the postgres driver select.
+func copyRequest(request *entities.Request) *entities.Request {
+	c := copyRequestWithMetadata(request)
+	c.Metadata = nil
+	return c
+}
+
+func copyRequestWithMetadata(request *entities.Request) *entities.Request {
+	c := *request
+	c.APIKey = copyPointer(request.APIKey)
+	c.Project = copyPointer(request.Project)
+	c.Environment = copyPointer(request.Environment)
+	c.Service = copyPointer(request.Service)
+	c.Metadata = copyPointer(request.Metadata)
+
+	if c.APIKey == nil {
+		c.APIKey = new(entities.RequestAPIKey)
+	}
+	if c.Project == nil {
+		c.Project = new(entities.RequestProject)
+	}
+	if c.Environment == nil {
+		c.Environment = new(entities.RequestEnvironment)
+	}
+	if c.Service == nil {
+		c.Service = new(entities.RequestService)
+	}
+	return &c
+}
+
+func NewRequestRepository(driver *Driver) *RequestRepository {
+	return &RequestRepository{Driver: driver}
+}
@@ -0,0 +1,212 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ReservationRepository struct {
+	*Driver
+}
+
+func (r *ReservationRepository) Create(
+	ctx context.Context, reservation *entities.Reservation,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := serviceKey{ownerID: reservation.EnvironmentID, serviceID: reservation.ServiceID}
+	if _, ok := r.environmentServices[key]; !ok {
+		return referenceNotFoundError(
+			"Reservation",
+			"environment_id, service_id",
+			joinValues([]any{reservation.EnvironmentID, reservation.ServiceID}),
+			"environment_service",
+		)
+	}
+
+	reservation.ID = uuid.NewString()
+
+	r.reservations[reservation.ID] = copyPointer(reservation)
+	return nil
+}
+
+func (r *ReservationRepository) GetByID(
+	ctx context.Context, id string,
+) (*entities.Reservation, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reservation, ok := r.reservations[id]
+	if !ok {
+		return nil, notFoundError("Reservation")
+	}
+
+	return copyPointer(reservation), nil
+}
+
+func (r *ReservationRepository) GetByIDWithDetails(
+	ctx context.Context, id string,
+) (*dto.ReservationWithDetails, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reservation, ok := r.reservations[id]
+	if !ok {
+		return nil, notFoundError("Reservation")
+	}
+
+	// The environment service a reservation references always exists, and
+	// so do its environment and service.
+	environment := r.environments[reservation.EnvironmentID]
+	service := r.services[reservation.ServiceID]
+
+	return &dto.ReservationWithDetails{
+		ID:                reservation.ID,
+		StartRequestID:    reservation.StartRequestID,
+		APIKey:            reservation.APIKey,
+		ServiceID:         service.ID,
+		ServiceName:       service.Name,
+		ServiceVersion:    service.Version,
+		ServiceStatus:     service.Status,
+		EnvironmentID:     environment.ID,
+		EnvironmentName:   environment.Name,
+		EnvironmentStatus: environment.Status,
+	}, nil
+}
+
+func (r *ReservationRepository) CountByEnvironmentAndService(
+	ctx context.Context, environmentID, serviceID int,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var count int
+	for _, reservation := range r.reservations {
+		if reservation.EnvironmentID == environmentID &&
+			reservation.ServiceID == serviceID {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *ReservationRepository) ListExpired(
+	ctx context.Context, now time.Time,
+) ([]*entities.Reservation, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var reservations []*entities.Reservation
+	for _, reservation := range r.reservations {
+		if !reservation.ExpiresAt.After(now) {
+			reservations = append(reservations, copyPointer(reservation))
+		}
+	}
+
+	slices.SortFunc(reservations, func(a, b *entities.Reservation) int {
+		return a.ExpiresAt.Compare(b.ExpiresAt)
+	})
+	return reservations, nil
+}
+
+func (r *ReservationRepository) Delete(
+	ctx context.Context, id string,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.reservations[id]; !ok {
+		return entityNotFoundError("Reservation", map[string]any{"id": id})
+	}
+
+	delete(r.reservations, id)
+	return nil
+}
+
+// Commit settles the reservation for units: it deletes the reservation
+// and, when it held more, gives the rest back to its pools and charges
+// units to its start request. Either all of it happens or none.
+func (r *ReservationRepository) Commit(
+	ctx context.Context, id string, units int,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reservation, ok := r.reservations[id]
+	if !ok {
+		return entityNotFoundError("Reservation", map[string]any{"id": id})
+	}
+
+	if units < reservation.Units {
+		request, ok := r.requests[reservation.StartRequestID]
+		if !ok {
+			return entityNotFoundError(
+				"Request", map[string]any{"id": reservation.StartRequestID},
+			)
+		}
+
+		err := r.increaseAvailableRequest(
+			reservation.EnvironmentID, reservation.ServiceID, reservation.Units-units,
+		)
+		if err != nil {
+			return err
+		}
+		request.Units = units
+	}
+
+	delete(r.reservations, id)
+	return nil
+}
+
+// Release settles the reservation without charging it: it deletes the
+// reservation, gives all its units back to its pools and resets the units
+// of its start request, which also takes update when given. Either all of
+// it happens or none.
+func (r *ReservationRepository) Release(
+	ctx context.Context, id string, update *dto.RequestExecutionStatusUpdate,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reservation, ok := r.reservations[id]
+	if !ok {
+		return entityNotFoundError("Reservation", map[string]any{"id": id})
+	}
+
+	request, ok := r.requests[reservation.StartRequestID]
+	if !ok {
+		return entityNotFoundError(
+			"Request", map[string]any{"id": reservation.StartRequestID},
+		)
+	}
+
+	err := r.increaseAvailableRequest(
+		reservation.EnvironmentID, reservation.ServiceID, reservation.Units,
+	)
+	if err != nil {
+		return err
+	}
+
+	request.Units = 0
+	if update != nil {
+		request.ExecutionStatus = update.ExecutionStatus
+		request.StatusCode = update.StatusCode
+		request.Detail = update.Detail
+	}
+
+	delete(r.reservations, id)
+	return nil
+}
+
+func NewReservationRepository(driver *Driver) *ReservationRepository {
+	return &ReservationRepository{Driver: driver}
+}
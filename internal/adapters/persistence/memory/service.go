@@ -0,0 +1,223 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ServiceRepository struct {
+	*Driver
+}
+
+func (r *ServiceRepository) Exists(
+	ctx context.Context, id int,
+) (bool, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.services[id]
+	return ok, nil
+}
+
+func (r *ServiceRepository) Delete(
+	ctx context.Context, id int,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.services[id]; !ok {
+		return entityNotFoundError("Service", map[string]any{"id": id})
+	}
+
+	r.deleteService(id)
+	return nil
+}
+
+func (r *ServiceRepository) UpdateStatus(
+	ctx context.Context, id int, status enums.ServiceStatus,
+) (*entities.Service, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	service, ok := r.services[id]
+	if !ok {
+		return nil, notFoundError("Service")
+	}
+
+	service.Status = status
+	return copyService(service), nil
+}
+
+func (r *ServiceRepository) UpdateRetention(
+	ctx context.Context, id int, days *int,
+) (*entities.Service, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	service, ok := r.services[id]
+	if !ok {
+		return nil, notFoundError("Service")
+	}
+
+	service.RequestRetentionDays = copyPointer(days)
+	return copyService(service), nil
+}
+
+func (r *ServiceRepository) GetByID(
+	ctx context.Context, id int,
+) (*entities.Service, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	service, ok := r.services[id]
+	if !ok {
+		return nil, notFoundError("Service")
+	}
+
+	return copyService(service), nil
+}
+
+func (r *ServiceRepository) GetByNameAndVersion(
+	ctx context.Context, name, version string,
+) (*entities.Service, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, service := range r.services {
+		if service.Name == name && service.Version == version {
+			return copyService(service), nil
+		}
+	}
+
+	return nil, notFoundError("Service")
+}
+
+func (r *ServiceRepository) List(
+	ctx context.Context, filter *dto.ServiceFilter, page *dto.Pagination,
+) ([]*entities.Service, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	services, err := paginate(
+		r.filter(filter), page,
+		func(s *entities.Service) keyset { return intKeyset(s.CreatedAt, s.ID) },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyAll(services, copyService), nil
+}
+
+// ListWithRetention returns the services overriding the global request log
+// retention.
+func (r *ServiceRepository) ListWithRetention(
+	ctx context.Context,
+) ([]*entities.Service, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var services []*entities.Service
+	for _, service := range r.services {
+		if service.RequestRetentionDays != nil {
+			services = append(services, copyService(service))
+		}
+	}
+
+	slices.SortFunc(services, func(a, b *entities.Service) int {
+		return a.ID - b.ID
+	})
+	return services, nil
+}
+
+func (r *ServiceRepository) Count(
+	ctx context.Context, filter *dto.ServiceFilter,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.filter(filter)), nil
+}
+
+func (r *ServiceRepository) filter(
+	filter *dto.ServiceFilter,
+) []*entities.Service {
+	var services []*entities.Service
+	for _, service := range r.services {
+		if filter != nil &&
+			filter.Status != enums.ServiceStatusNull &&
+			service.Status != filter.Status {
+			continue
+		}
+
+		services = append(services, service)
+	}
+
+	return services
+}
+
+func (r *ServiceRepository) Create(
+	ctx context.Context, service *entities.Service,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, s := range r.services {
+		if s.Name == service.Name && s.Version == service.Version {
+			return alreadyExistsError(
+				"Service", "name, version", service.Name, service.Version,
+			)
+		}
+	}
+
+	service.ID = r.nextID("service")
+	service.CreatedAt = r.now()
+
+	r.services[service.ID] = &entities.Service{
+		ID:        service.ID,
+		Name:      service.Name,
+		Version:   service.Version,
+		Status:    service.Status,
+		CreatedAt: service.CreatedAt,
+	}
+	return nil
+}
+
+// deleteService deletes the service along with its assignments and
+// requests.
+func (d *Driver) deleteService(id int) {
+	delete(d.services, id)
+
+	for key := range d.projectServices {
+		if key.serviceID == id {
+			delete(d.projectServices, key)
+		}
+	}
+
+	for key := range d.environmentServices {
+		if key.serviceID == id {
+			d.deleteEnvironmentService(key)
+		}
+	}
+
+	for requestID, request := range d.requests {
+		if request.Service != nil && request.Service.ID == id {
+			delete(d.requests, requestID)
+		}
+	}
+}
+
+func copyService(service *entities.Service) *entities.Service {
+	c := *service
+	c.RequestRetentionDays = copyPointer(service.RequestRetentionDays)
+	return &c
+}
+
+func NewServiceRepository(driver *Driver) *ServiceRepository {
+	return &ServiceRepository{Driver: driver}
+}
@@ -0,0 +1,316 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// usageKey identifies a rollup bucket: its start and every dimension usage
+// can be grouped by.
+type usageKey struct {
+	bucket time.Time
+
+	clientID           int
+	projectID          int
+	environmentID      int
+	serviceID          int
+	apiKeyID           int
+	executionStatus    enums.RequestExecutionStatus
+	unauthorizedReason enums.APIKeyValidationFailureCode
+}
+
+// usageKeyOf returns the key of the bucket, keeping only the dimensions the
+// usage is grouped by.
+func usageKeyOf(
+	bucket *entities.UsageBucket, groupBy map[enums.UsageGroupBy]bool,
+) usageKey {
+	key := usageKey{bucket: bucket.Bucket}
+	if groupBy[enums.UsageGroupByClient] {
+		key.clientID = bucket.ClientID
+	}
+	if groupBy[enums.UsageGroupByProject] {
+		key.projectID = bucket.ProjectID
+	}
+	if groupBy[enums.UsageGroupByEnvironment] {
+		key.environmentID = bucket.EnvironmentID
+	}
+	if groupBy[enums.UsageGroupByService] {
+		key.serviceID = bucket.ServiceID
+	}
+	if groupBy[enums.UsageGroupByAPIKey] {
+		key.apiKeyID = bucket.APIKeyID
+	}
+	if groupBy[enums.UsageGroupByExecutionStatus] {
+		key.executionStatus = bucket.ExecutionStatus
+	}
+	if groupBy[enums.UsageGroupByUnauthorizedReason] {
+		key.unauthorizedReason = bucket.UnauthorizedReason
+	}
+	return key
+}
+
+func (k usageKey) compare(other usageKey) int {
+	return cmp.Or(
+		k.bucket.Compare(other.bucket),
+		cmp.Compare(k.clientID, other.clientID),
+		cmp.Compare(k.projectID, other.projectID),
+		cmp.Compare(k.environmentID, other.environmentID),
+		cmp.Compare(k.serviceID, other.serviceID),
+		cmp.Compare(k.apiKeyID, other.apiKeyID),
+		cmp.Compare(k.executionStatus, other.executionStatus),
+		cmp.Compare(k.unauthorizedReason, other.unauthorizedReason),
+	)
+}
+
+func (k usageKey) entity() *entities.UsageBucket {
+	return &entities.UsageBucket{
+		Bucket:             k.bucket,
+		ClientID:           k.clientID,
+		ProjectID:          k.projectID,
+		EnvironmentID:      k.environmentID,
+		ServiceID:          k.serviceID,
+		APIKeyID:           k.apiKeyID,
+		ExecutionStatus:    k.executionStatus,
+		UnauthorizedReason: k.unauthorizedReason,
+	}
+}
+
+type UsageRepository struct {
+	*Driver
+}
+
+func (r *UsageRepository) GetRollupWatermark(
+	ctx context.Context,
+) (time.Time, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.usageWatermark, nil
+}
+
+func (r *UsageRepository) List(
+	ctx context.Context, filter *dto.UsageFilter,
+) ([]*entities.UsageBucket, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.list(filter), nil
+}
+
+// StreamList hands each bucket List would return to yield, stopping at the
+// first error yield returns.
+func (r *UsageRepository) StreamList(
+	ctx context.Context,
+	filter *dto.UsageFilter,
+	yield func(*entities.UsageBucket) errors.Error,
+) errors.Error {
+	r.mu.Lock()
+	buckets := r.list(filter)
+	r.mu.Unlock()
+
+	for _, bucket := range buckets {
+		if err := yield(bucket); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// list sums the buckets of the filter by the granularity and the dimensions
+// it groups by, ordered by bucket and then by those dimensions.
+func (r *UsageRepository) list(filter *dto.UsageFilter) []*entities.UsageBucket {
+	rollup := r.usageDaily
+	if filter.Granularity == enums.UsageGranularityHour {
+		rollup = r.usageHourly
+	}
+
+	groupBy := make(map[enums.UsageGroupBy]bool, len(filter.GroupBy))
+	for _, g := range filter.GroupBy {
+		groupBy[g] = true
+	}
+
+	sums := make(map[usageKey]*entities.UsageBucket)
+	for key, bucket := range rollup {
+		if key.bucket.Before(filter.From) || !key.bucket.Before(filter.To) {
+			continue
+		}
+
+		if (filter.ClientID != 0 && key.clientID != filter.ClientID) ||
+			(filter.ProjectID != 0 && key.projectID != filter.ProjectID) ||
+			(filter.EnvironmentID != 0 && key.environmentID != filter.EnvironmentID) ||
+			(filter.ServiceID != 0 && key.serviceID != filter.ServiceID) ||
+			(filter.APIKeyID != 0 && key.apiKeyID != filter.APIKeyID) {
+			continue
+		}
+
+		truncated := *bucket
+		truncated.Bucket = filter.Granularity.Truncate(key.bucket)
+
+		sumKey := usageKeyOf(&truncated, groupBy)
+		sum, ok := sums[sumKey]
+		if !ok {
+			sum = sumKey.entity()
+			sums[sumKey] = sum
+		}
+
+		sum.Requests += bucket.Requests
+		sum.Units += bucket.Units
+	}
+
+	keys := make([]usageKey, 0, len(sums))
+	for key := range sums {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, usageKey.compare)
+
+	var buckets []*entities.UsageBucket
+	for _, key := range keys {
+		buckets = append(buckets, sums[key])
+	}
+	return buckets
+}
+
+// RefreshRollups refreshes the hourly and daily buckets holding the
+// requests created in [from, to), and records to as the watermark. Buckets
+// are rebuilt whole from the request log, so refreshing one again picks up
+// the status and units of requests updated since. Hours before the ones
+// retention has deleted requests of are not rebuilt, as that would drop the
+// deleted requests from them; the requests created since the last refresh
+// are added to them instead. It returns the number of hourly buckets
+// refreshed.
+func (r *UsageRepository) RefreshRollups(
+	ctx context.Context, from, to time.Time,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The requests created before the watermark were rolled up by the
+	// previous refreshes.
+	since := from
+	if r.usageWatermark.After(since) {
+		since = r.usageWatermark
+	}
+
+	hours := make(map[time.Time]bool)
+	rebuilt := make(map[time.Time]bool)
+	for _, request := range r.requests {
+		if request.CreatedAt.Before(from) || !request.CreatedAt.Before(to) {
+			continue
+		}
+
+		hour := enums.UsageGranularityHour.Truncate(request.RequestTime)
+		hours[hour] = true
+
+		if !hour.Before(r.usageSealedBefore) {
+			rebuilt[hour] = true
+		} else if !request.CreatedAt.Before(since) {
+			r.addHourly(hour, request)
+		}
+	}
+
+	if len(rebuilt) > 0 {
+		r.refreshHourly(rebuilt)
+	}
+
+	if len(hours) > 0 {
+		r.refreshDaily(hours)
+	}
+
+	r.usageWatermark = to
+	return len(hours), nil
+}
+
+func (r *UsageRepository) refreshHourly(hours map[time.Time]bool) {
+	for key := range r.usageHourly {
+		if hours[key.bucket] {
+			delete(r.usageHourly, key)
+		}
+	}
+
+	for _, request := range r.requests {
+		hour := enums.UsageGranularityHour.Truncate(request.RequestTime)
+		if hours[hour] {
+			r.addHourly(hour, request)
+		}
+	}
+}
+
+// addHourly adds the request to its bucket of the hour.
+func (r *UsageRepository) addHourly(hour time.Time, request *entities.Request) {
+	key := usageKey{
+		bucket:             hour,
+		projectID:          request.Project.ID,
+		environmentID:      request.Environment.ID,
+		serviceID:          request.Service.ID,
+		apiKeyID:           request.APIKey.ID,
+		executionStatus:    request.ExecutionStatus,
+		unauthorizedReason: request.UnauthorizedReason,
+	}
+	if project, ok := r.projects[request.Project.ID]; ok {
+		key.clientID = project.ClientID
+	}
+
+	bucket, ok := r.usageHourly[key]
+	if !ok {
+		bucket = key.entity()
+		r.usageHourly[key] = bucket
+	}
+
+	bucket.Requests++
+	bucket.Units += request.Units
+}
+
+func (r *UsageRepository) refreshDaily(hours map[time.Time]bool) {
+	days := make(map[time.Time]bool)
+	for hour := range hours {
+		days[enums.UsageGranularityDay.Truncate(hour)] = true
+	}
+
+	for key := range r.usageDaily {
+		if days[key.bucket] {
+			delete(r.usageDaily, key)
+		}
+	}
+
+	for key, hourly := range r.usageHourly {
+		day := enums.UsageGranularityDay.Truncate(key.bucket)
+		if !days[day] {
+			continue
+		}
+
+		key.bucket = day
+		bucket, ok := r.usageDaily[key]
+		if !ok {
+			bucket = key.entity()
+			r.usageDaily[key] = bucket
+		}
+
+		bucket.Requests += hourly.Requests
+		bucket.Units += hourly.Units
+	}
+}
+
+// sealUsage seals the usage buckets up to the hour of the request, which is
+// being deleted. Buckets are only sealed once requests have been rolled up.
+func (d *Driver) sealUsage(request *entities.Request) {
+	if d.usageWatermark.IsZero() {
+		return
+	}
+
+	hour := enums.UsageGranularityHour.Truncate(request.RequestTime).Add(time.Hour)
+	if hour.After(d.usageSealedBefore) {
+		d.usageSealedBefore = hour
+	}
+}
+
+func NewUsageRepository(driver *Driver) *UsageRepository {
+	return &UsageRepository{Driver: driver}
+}
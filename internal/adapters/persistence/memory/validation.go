@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+type ValidationRepository struct {
+	*Driver
+
+	protector ports.APIKeyProtector
+}
+
+func (r *ValidationRepository) Lookup(
+	ctx context.Context, key, serviceName, serviceVersion string,
+) (*dto.APIKeyValidationLookup, errors.Error) {
+	hash := r.protector.Hash(key)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lookup := new(dto.APIKeyValidationLookup)
+	for _, service := range r.services {
+		if service.Name == serviceName && service.Version == serviceVersion {
+			lookup.Service = copyService(service)
+			break
+		}
+	}
+
+	apiKey := r.apiKeyByHash(hash)
+	if apiKey == nil {
+		return lookup, nil
+	}
+	lookup.APIKey = apiKey.entity()
+
+	environment := *r.environments[apiKey.EnvironmentID]
+	lookup.Environment = &environment
+
+	project := r.projects[environment.ProjectID]
+	client := r.clients[project.ClientID]
+	lookup.ProjectClient = &dto.ProjectClientInfoResponse{
+		ProjectID:   project.ID,
+		ProjectName: project.Name,
+		ClientID:    client.ID,
+		ClientName:  client.Name,
+	}
+
+	if lookup.Service != nil {
+		_, lookup.ServiceAssigned = r.environmentServices[serviceKey{
+			ownerID: environment.ID, serviceID: lookup.Service.ID,
+		}]
+	}
+
+	return lookup, nil
+}
+
+func (r *ValidationRepository) Consume(
+	ctx context.Context, request *entities.Request,
+) (*dto.DecrementAvailableRequest, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkRequest(request); err != nil {
+		return nil, err
+	}
+
+	result := r.consumeQuota(request)
+	if err := r.createRequest(request, false); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *ValidationRepository) ConsumeQuota(
+	ctx context.Context, request *entities.Request,
+) (*dto.DecrementAvailableRequest, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.consumeQuota(request), nil
+}
+
+// consumeQuota takes the units of the request, rejecting it when they are
+// not available, and marks its API key as used when they are.
+func (d *Driver) consumeQuota(
+	request *entities.Request,
+) *dto.DecrementAvailableRequest {
+	result, err := d.decrementAvailableRequest(
+		request.Environment.ID, request.Service.ID, request.Units,
+	)
+	if err != nil {
+		result = &dto.DecrementAvailableRequest{
+			ExceededLevel: enums.QuotaLevelEnvironment,
+		}
+	}
+
+	if result.ExceededLevel != enums.QuotaLevelNull {
+		request.Reject(enums.APIKeyValidationFailureCodeQuotaExceeded)
+		return result
+	}
+
+	if apiKey, ok := d.apiKeys[request.APIKey.ID]; ok {
+		apiKey.LastUsed = d.now()
+	}
+	return result
+}
+
+func NewValidationRepository(
+	driver *Driver, protector ports.APIKeyProtector,
+) *ValidationRepository {
+	return &ValidationRepository{Driver: driver, protector: protector}
+}
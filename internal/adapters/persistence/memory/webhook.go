@@ -0,0 +1,446 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// webhookPayload is the body posted to webhooks, the one the postgres driver
+// builds.
+type webhookPayload struct {
+	Type       enums.WebhookEventType `json:"type"`
+	OccurredAt time.Time              `json:"occurred_at"`
+	Data       webhookPayloadData     `json:"data"`
+}
+
+type webhookPayloadData struct {
+	ClientID      int                  `json:"client_id"`
+	ProjectID     int                  `json:"project_id"`
+	EnvironmentID int                  `json:"environment_id,omitempty"`
+	ServiceID     int                  `json:"service_id,omitempty"`
+	APIKeyID      int                  `json:"api_key_id,omitempty"`
+	Quota         *webhookPayloadQuota `json:"quota,omitempty"`
+}
+
+type webhookPayloadQuota struct {
+	Level            enums.QuotaLevel `json:"level"`
+	AlertID          int              `json:"alert_id,omitempty"`
+	Threshold        int              `json:"threshold,omitempty"`
+	MaxRequests      int              `json:"max_requests"`
+	AvailableRequest int              `json:"available_request"`
+}
+
+func newWebhookPayload(
+	event *entities.WebhookEvent, project *entities.Project,
+) *webhookPayload {
+	payload := &webhookPayload{
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt.UTC(),
+		Data: webhookPayloadData{
+			ClientID:      project.ClientID,
+			ProjectID:     project.ID,
+			EnvironmentID: event.EnvironmentID,
+			ServiceID:     event.ServiceID,
+			APIKeyID:      event.APIKeyID,
+		},
+	}
+
+	if event.Quota != nil {
+		payload.Data.Quota = &webhookPayloadQuota{
+			Level:            event.Quota.Level,
+			AlertID:          event.Quota.AlertID,
+			Threshold:        event.Quota.Threshold,
+			MaxRequests:      event.Quota.MaxRequests,
+			AvailableRequest: event.Quota.AvailableRequest,
+		}
+	}
+
+	return payload
+}
+
+type WebhookRepository struct {
+	*Driver
+}
+
+func (r *WebhookRepository) Exists(
+	ctx context.Context, id int,
+) (bool, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.webhooks[id]
+	return ok, nil
+}
+
+func (r *WebhookRepository) GetByID(
+	ctx context.Context, id int,
+) (*entities.Webhook, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhook, ok := r.webhooks[id]
+	if !ok {
+		return nil, notFoundError("Webhook")
+	}
+
+	return copyWebhook(webhook), nil
+}
+
+func (r *WebhookRepository) Count(
+	ctx context.Context, filter *dto.WebhookFilter,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.filter(filter)), nil
+}
+
+func (r *WebhookRepository) List(
+	ctx context.Context, filter *dto.WebhookFilter, page *dto.Pagination,
+) ([]*entities.Webhook, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhooks, err := paginate(
+		r.filter(filter), page,
+		func(w *entities.Webhook) keyset { return intKeyset(w.CreatedAt, w.ID) },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyAll(webhooks, copyWebhook), nil
+}
+
+func (r *WebhookRepository) filter(
+	filter *dto.WebhookFilter,
+) []*entities.Webhook {
+	var webhooks []*entities.Webhook
+	for _, webhook := range r.webhooks {
+		if filter != nil {
+			if filter.ClientID != 0 && webhook.ClientID != filter.ClientID {
+				continue
+			}
+
+			if filter.ProjectID != 0 && webhook.ProjectID != filter.ProjectID {
+				continue
+			}
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks
+}
+
+func (r *WebhookRepository) Create(
+	ctx context.Context, webhook *entities.Webhook,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if webhook.ClientID != 0 {
+		if _, ok := r.clients[webhook.ClientID]; !ok {
+			return referenceNotFoundError(
+				"Webhook", "client_id", webhook.ClientID, "client",
+			)
+		}
+	}
+
+	if webhook.ProjectID != 0 {
+		if _, ok := r.projects[webhook.ProjectID]; !ok {
+			return referenceNotFoundError(
+				"Webhook", "project_id", webhook.ProjectID, "project",
+			)
+		}
+	}
+
+	webhook.ID = r.nextID("webhook")
+	webhook.CreatedAt = r.now()
+
+	r.webhooks[webhook.ID] = copyWebhook(webhook)
+	return nil
+}
+
+func (r *WebhookRepository) Update(
+	ctx context.Context, id int, update *dto.WebhookUpdate,
+) (*entities.Webhook, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhook, ok := r.webhooks[id]
+	if !ok {
+		return nil, notFoundError("Webhook")
+	}
+
+	if update != nil {
+		if update.URL != "" {
+			webhook.URL = update.URL
+		}
+
+		if len(update.Events) > 0 {
+			webhook.Events = slices.Clone(update.Events)
+		}
+
+		if update.Status != enums.WebhookStatusNull {
+			webhook.Status = update.Status
+		}
+	}
+
+	return copyWebhook(webhook), nil
+}
+
+func (r *WebhookRepository) Delete(ctx context.Context, id int) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.webhooks[id]; !ok {
+		return entityNotFoundError("Webhook", map[string]any{"id": id})
+	}
+
+	r.deleteWebhook(id)
+	return nil
+}
+
+// CreateDeliveries queues each event for the enabled webhooks subscribed
+// to its type through its project or the project's client, and returns the
+// number of deliveries created.
+func (r *WebhookRepository) CreateDeliveries(
+	ctx context.Context, events []*entities.WebhookEvent,
+) (int, errors.Error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deliveries []*entities.WebhookDelivery
+	for _, event := range events {
+		projectID := event.ProjectID
+		if projectID == 0 {
+			if environment, ok := r.environments[event.EnvironmentID]; ok {
+				projectID = environment.ProjectID
+			}
+		}
+
+		project, ok := r.projects[projectID]
+		if !ok {
+			continue
+		}
+
+		payload, err := json.Marshal(newWebhookPayload(event, project))
+		if err != nil {
+			return 0, errors.NewInternal("failed to encode webhook payload", err)
+		}
+
+		for _, webhook := range r.webhooks {
+			if webhook.Status != enums.WebhookStatusEnabled ||
+				!slices.Contains(webhook.Events, event.Type) {
+				continue
+			}
+
+			if webhook.ProjectID != project.ID && webhook.ClientID != project.ClientID {
+				continue
+			}
+
+			now := r.now()
+			deliveries = append(deliveries, &entities.WebhookDelivery{
+				ID:            uuid.NewString(),
+				WebhookID:     webhook.ID,
+				Event:         event.Type,
+				Payload:       payload,
+				Status:        enums.WebhookDeliveryStatusPending,
+				NextAttemptAt: now,
+				CreatedAt:     now,
+			})
+		}
+	}
+
+	for _, delivery := range deliveries {
+		r.deliveries[delivery.ID] = delivery
+	}
+
+	return len(deliveries), nil
+}
+
+func (r *WebhookRepository) CountDeliveries(
+	ctx context.Context, id int, filter *dto.WebhookDeliveryFilter,
+) (int, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.filterDeliveries(id, filter)), nil
+}
+
+func (r *WebhookRepository) ListDeliveries(
+	ctx context.Context,
+	id int,
+	filter *dto.WebhookDeliveryFilter,
+	page *dto.Pagination,
+) ([]*entities.WebhookDelivery, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deliveries, err := paginate(
+		r.filterDeliveries(id, filter), page,
+		func(d *entities.WebhookDelivery) keyset {
+			return stringKeyset(d.CreatedAt, d.ID)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries = copyAll(deliveries, copyWebhookDelivery)
+	for _, delivery := range deliveries {
+		delivery.URL, delivery.Secret = "", ""
+	}
+	return deliveries, nil
+}
+
+func (r *WebhookRepository) filterDeliveries(
+	id int, filter *dto.WebhookDeliveryFilter,
+) []*entities.WebhookDelivery {
+	var deliveries []*entities.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.WebhookID != id {
+			continue
+		}
+
+		if filter != nil &&
+			filter.Status != enums.WebhookDeliveryStatusNull &&
+			delivery.Status != filter.Status {
+			continue
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries
+}
+
+// ClaimDueDeliveries returns up to limit pending deliveries of enabled
+// webhooks due at now, oldest first, with the URL and secret of their
+// webhook. Their next attempt is pushed lease into the future, so a
+// delivery whose outcome is never recorded is sent again once the lease
+// expires.
+func (r *WebhookRepository) ClaimDueDeliveries(
+	ctx context.Context, now time.Time, lease time.Duration, limit int,
+) ([]*entities.WebhookDelivery, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var due []*entities.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.Status != enums.WebhookDeliveryStatusPending ||
+			delivery.NextAttemptAt.After(now) {
+			continue
+		}
+
+		if webhook := r.webhooks[delivery.WebhookID]; webhook.Status == enums.WebhookStatusEnabled {
+			due = append(due, delivery)
+		}
+	}
+
+	slices.SortFunc(due, func(a, b *entities.WebhookDelivery) int {
+		return cmp.Or(
+			a.NextAttemptAt.Compare(b.NextAttemptAt), a.CreatedAt.Compare(b.CreatedAt),
+		)
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	deliveries := make([]*entities.WebhookDelivery, len(due))
+	for i, delivery := range due {
+		delivery.NextAttemptAt = now.Add(lease)
+
+		webhook := r.webhooks[delivery.WebhookID]
+		deliveries[i] = copyWebhookDelivery(delivery)
+		deliveries[i].URL, deliveries[i].Secret = webhook.URL, webhook.Secret
+	}
+
+	return deliveries, nil
+}
+
+// UpdateDelivery records the outcome of the last attempt of the delivery.
+func (r *WebhookRepository) UpdateDelivery(
+	ctx context.Context, delivery *entities.WebhookDelivery,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.deliveries[delivery.ID]
+	if !ok {
+		return entityNotFoundError(
+			"WebhookDelivery", map[string]any{"id": delivery.ID},
+		)
+	}
+
+	stored.Status = delivery.Status
+	stored.Attempts = delivery.Attempts
+	stored.NextAttemptAt = delivery.NextAttemptAt
+	stored.LastStatusCode = delivery.LastStatusCode
+	stored.LastError = delivery.LastError
+	stored.DeliveredAt = delivery.DeliveredAt
+	return nil
+}
+
+// GetExpiryWatermark returns the expiration time up to which expired API
+// keys have been notified, zero if they never were.
+func (r *WebhookRepository) GetExpiryWatermark(
+	ctx context.Context,
+) (time.Time, errors.Error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.expiryWatermark, nil
+}
+
+func (r *WebhookRepository) UpdateExpiryWatermark(
+	ctx context.Context, watermark time.Time,
+) errors.Error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expiryWatermark = watermark
+	return nil
+}
+
+// deleteWebhook deletes the webhook along with its deliveries.
+func (d *Driver) deleteWebhook(id int) {
+	delete(d.webhooks, id)
+
+	for deliveryID, delivery := range d.deliveries {
+		if delivery.WebhookID == id {
+			delete(d.deliveries, deliveryID)
+		}
+	}
+}
+
+func copyWebhook(webhook *entities.Webhook) *entities.Webhook {
+	c := *webhook
+	c.Events = slices.Clone(webhook.Events)
+	return &c
+}
+
+func copyWebhookDelivery(delivery *entities.WebhookDelivery) *entities.WebhookDelivery {
+	c := *delivery
+	c.Payload = slices.Clone(delivery.Payload)
+	return &c
+}
+
+func NewWebhookRepository(driver *Driver) *WebhookRepository {
+	return &WebhookRepository{Driver: driver}
+}
@@ -0,0 +1,117 @@
+package migrations
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/MAD-py/pandora-core/db"
+)
+
+// Set identifies the migrations of one database, each kept in its own
+// directory under db/migrations and tracked in its own table.
+type Set string
+
+const (
+	SetPandora    Set = "pandora"
+	SetTaskEngine Set = "taskengine"
+)
+
+func (s Set) table() string {
+	switch s {
+	case SetTaskEngine:
+		return "taskengine_schema_migration"
+	default:
+		return "schema_migration"
+	}
+}
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is a pair of up and down SQL scripts. Versions are applied in
+// ascending order and reverted in descending order.
+type Migration struct {
+	Version int
+	Name    string
+
+	Up   string
+	Down string
+}
+
+// Load reads the migrations of the set from the embedded files. Every
+// version must have both scripts and appear only once.
+func Load(set Set) ([]*Migration, error) {
+	return load(db.Migrations, path.Join("migrations", string(set)))
+}
+
+func load(fsys fs.FS, dir string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations of %s: %w", dir, err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf(
+				"invalid migration file name %q, expected <version>_<name>.<up|down>.sql",
+				entry.Name(),
+			)
+		}
+
+		version, _ := strconv.Atoi(match[1])
+		if version <= 0 {
+			return nil, fmt.Errorf(
+				"invalid migration file name %q, version must be greater than 0",
+				entry.Name(),
+			)
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf(
+				"migration version %d is used by both %q and %q",
+				version, migration.Name, match[2],
+			)
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf(
+				"migration %d_%s must have both an up and a down script",
+				migration.Version, migration.Name,
+			)
+		}
+		migrations = append(migrations, migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
@@ -0,0 +1,86 @@
+package migrations
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MigrationsSuite struct {
+	suite.Suite
+}
+
+func (s *MigrationsSuite) TestEmbeddedSets() {
+	for _, set := range []Set{SetPandora, SetTaskEngine} {
+		migrations, err := Load(set)
+
+		s.Require().NoError(err, set)
+		s.Require().NotEmpty(migrations, set)
+		for i, migration := range migrations {
+			s.Equal(i+1, migration.Version, set)
+		}
+	}
+}
+
+func (s *MigrationsSuite) TestOrderedByVersion() {
+	fsys := fstest.MapFS{
+		"sql/0010_third.up.sql":    {Data: []byte("SELECT 10;")},
+		"sql/0010_third.down.sql":  {Data: []byte("SELECT -10;")},
+		"sql/0002_second.up.sql":   {Data: []byte("SELECT 2;")},
+		"sql/0002_second.down.sql": {Data: []byte("SELECT -2;")},
+		"sql/0001_first.up.sql":    {Data: []byte("SELECT 1;")},
+		"sql/0001_first.down.sql":  {Data: []byte("SELECT -1;")},
+	}
+
+	migrations, err := load(fsys, "sql")
+
+	s.Require().NoError(err)
+	s.Require().Len(migrations, 3)
+	s.Equal(1, migrations[0].Version)
+	s.Equal("first", migrations[0].Name)
+	s.Equal("SELECT 1;", migrations[0].Up)
+	s.Equal("SELECT -1;", migrations[0].Down)
+	s.Equal(2, migrations[1].Version)
+	s.Equal(10, migrations[2].Version)
+}
+
+func (s *MigrationsSuite) TestMissingDownScript() {
+	fsys := fstest.MapFS{
+		"sql/0001_first.up.sql": {Data: []byte("SELECT 1;")},
+	}
+
+	migrations, err := load(fsys, "sql")
+
+	s.Require().Error(err)
+	s.Nil(migrations)
+}
+
+func (s *MigrationsSuite) TestDuplicatedVersion() {
+	fsys := fstest.MapFS{
+		"sql/0001_first.up.sql":   {Data: []byte("SELECT 1;")},
+		"sql/0001_first.down.sql": {Data: []byte("SELECT -1;")},
+		"sql/0001_other.up.sql":   {Data: []byte("SELECT 1;")},
+		"sql/0001_other.down.sql": {Data: []byte("SELECT -1;")},
+	}
+
+	migrations, err := load(fsys, "sql")
+
+	s.Require().Error(err)
+	s.Nil(migrations)
+}
+
+func (s *MigrationsSuite) TestInvalidFileName() {
+	fsys := fstest.MapFS{
+		"sql/first.sql": {Data: []byte("SELECT 1;")},
+	}
+
+	migrations, err := load(fsys, "sql")
+
+	s.Require().Error(err)
+	s.Nil(migrations)
+}
+
+func TestMigrationsSuite(t *testing.T) {
+	suite.Run(t, new(MigrationsSuite))
+}
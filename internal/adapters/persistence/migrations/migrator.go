@@ -0,0 +1,265 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// lockKey is the key of the advisory lock held while migrating. The HTTP,
+// gRPC and TaskEngine processes may all migrate on start, and the lock makes
+// them take turns: the first one applies the migrations and the others find
+// nothing left to do.
+const lockKey int64 = 0x70616e646f7261
+
+// Status tells whether a migration has been applied to the database.
+type Status struct {
+	Version int
+	Name    string
+
+	AppliedAt time.Time
+}
+
+func (s *Status) Applied() bool { return !s.AppliedAt.IsZero() }
+
+// Migrator applies and reverts the migrations of a set on a single
+// connection, which holds the advisory lock during each operation.
+type Migrator struct {
+	set        Set
+	conn       *pgx.Conn
+	migrations []*Migration
+}
+
+// Up applies every pending migration.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.To(ctx, m.latest())
+}
+
+// Down reverts the last applied migration.
+func (m *Migrator) Down(ctx context.Context) error {
+	return m.withLock(ctx, func(applied map[int]time.Time) error {
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			if _, ok := applied[m.migrations[i].Version]; ok {
+				return m.revert(ctx, m.migrations[i])
+			}
+		}
+
+		log.Printf("[INFO] No %s migrations to revert", m.set)
+		return nil
+	})
+}
+
+// To migrates the database up or down until the given version is the last
+// applied one. Version 0 reverts every migration.
+func (m *Migrator) To(ctx context.Context, version int) error {
+	if version != 0 && m.find(version) == nil {
+		return fmt.Errorf("unknown %s migration version %d", m.set, version)
+	}
+
+	return m.withLock(ctx, func(applied map[int]time.Time) error {
+		changed := false
+
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; ok && migration.Version > version {
+				if err := m.revert(ctx, migration); err != nil {
+					return err
+				}
+				changed = true
+			}
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; !ok && migration.Version <= version {
+				if err := m.apply(ctx, migration); err != nil {
+					return err
+				}
+				changed = true
+			}
+		}
+
+		if !changed {
+			log.Printf("[INFO] %s schema is up to date at version %d", m.set, version)
+		}
+
+		return nil
+	})
+}
+
+// Status lists every known migration along with when it was applied.
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	var statuses []*Status
+
+	err := m.withLock(ctx, func(applied map[int]time.Time) error {
+		statuses = make([]*Status, len(m.migrations))
+		for i, migration := range m.migrations {
+			statuses[i] = &Status{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: applied[migration.Version],
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+func (m *Migrator) Close(ctx context.Context) error {
+	return m.conn.Close(ctx)
+}
+
+func (m *Migrator) latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+func (m *Migrator) find(version int) *Migration {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) withLock(
+	ctx context.Context, fn func(applied map[int]time.Time) error,
+) error {
+	if _, err := m.conn.Exec(ctx, "SELECT pg_advisory_lock($1);", lockKey); err != nil {
+		return fmt.Errorf("failed to acquire the migration lock: %w", err)
+	}
+	defer func() {
+		_, err := m.conn.Exec(
+			context.Background(), "SELECT pg_advisory_unlock($1);", lockKey,
+		)
+		if err != nil {
+			log.Printf("[WARN] Failed to release the migration lock: %v", err)
+		}
+	}()
+
+	query := fmt.Sprintf(
+		`CREATE TABLE IF NOT EXISTS %s (
+			version INTEGER PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);`,
+		m.set.table(),
+	)
+	if _, err := m.conn.Exec(ctx, query); err != nil {
+		return fmt.Errorf("failed to create %s: %w", m.set.table(), err)
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return err
+	}
+
+	return fn(applied)
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int]time.Time, error) {
+	query := fmt.Sprintf("SELECT version, applied_at FROM %s;", m.set.table())
+
+	rows, err := m.conn.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", m.set.table(), err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", m.set.table(), err)
+		}
+		applied[version] = appliedAt
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", m.set.table(), err)
+	}
+
+	return applied, nil
+}
+
+// apply runs the up script of the migration and records it in the same
+// transaction, so a failed migration leaves no trace.
+func (m *Migrator) apply(ctx context.Context, migration *Migration) error {
+	log.Printf(
+		"[INFO] Applying %s migration %d_%s", m.set, migration.Version, migration.Name,
+	)
+
+	query := fmt.Sprintf(
+		"INSERT INTO %s (version, name) VALUES ($1, $2);", m.set.table(),
+	)
+
+	return pgx.BeginFunc(ctx, m.conn, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, migration.Up); err != nil {
+			return fmt.Errorf(
+				"failed to apply %s migration %d_%s: %w",
+				m.set, migration.Version, migration.Name, err,
+			)
+		}
+
+		_, err := tx.Exec(ctx, query, migration.Version, migration.Name)
+		return err
+	})
+}
+
+// revert runs the down script of the migration and forgets it in the same
+// transaction.
+func (m *Migrator) revert(ctx context.Context, migration *Migration) error {
+	log.Printf(
+		"[INFO] Reverting %s migration %d_%s", m.set, migration.Version, migration.Name,
+	)
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE version = $1;", m.set.table())
+
+	return pgx.BeginFunc(ctx, m.conn, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, migration.Down); err != nil {
+			return fmt.Errorf(
+				"failed to revert %s migration %d_%s: %w",
+				m.set, migration.Version, migration.Name, err,
+			)
+		}
+
+		_, err := tx.Exec(ctx, query, migration.Version)
+		return err
+	})
+}
+
+func NewMigrator(ctx context.Context, dns string, set Set) (*Migrator, error) {
+	migrations, err := Load(set)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := pgx.Connect(ctx, dns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the %s database: %w", set, err)
+	}
+
+	return &Migrator{set: set, conn: conn, migrations: migrations}, nil
+}
+
+// Up applies the pending migrations of the set, for processes that migrate
+// on start.
+func Up(ctx context.Context, dns string, set Set) error {
+	migrator, err := NewMigrator(ctx, dns, set)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close(ctx)
+
+	return migrator.Up(ctx)
+}
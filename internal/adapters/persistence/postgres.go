@@ -12,6 +12,8 @@ import (
 type postgresRepositories struct {
 	driver *postgres.Driver
 
+	apiKeyProtector ports.APIKeyProtector
+
 	apiKeyRepo      ports.APIKeyRepository
 	clientRepo      ports.ClientRepository
 	projectRepo     ports.ProjectRepository
@@ -19,6 +21,15 @@ type postgresRepositories struct {
 	requestRepo     ports.RequestRepository
 	environmentRepo ports.EnvironmentRepository
 	reservationRepo ports.ReservationRepository
+	usageRepo       ports.UsageRepository
+	webhookRepo     ports.WebhookRepository
+	quotaAlertRepo  ports.QuotaAlertRepository
+	auditRepo       ports.AuditRepository
+	credentialsRepo ports.CredentialsRepository
+	clientTokenRepo ports.ClientTokenRepository
+	validationRepo  ports.ValidationRepository
+
+	rateLimiter ports.RateLimiter
 }
 
 func (r *postgresRepositories) Close() {
@@ -65,7 +76,9 @@ func (r *postgresRepositories) Latency() (int64, errors.Error) {
 
 func (r *postgresRepositories) APIKey() ports.APIKeyRepository {
 	if r.apiKeyRepo == nil {
-		r.apiKeyRepo = postgres.NewAPIKeyRepository(r.driver)
+		r.apiKeyRepo = postgres.NewAPIKeyRepository(
+			r.driver, r.apiKeyProtector,
+		)
 	}
 	return r.apiKeyRepo
 }
@@ -77,6 +90,15 @@ func (r *postgresRepositories) Client() ports.ClientRepository {
 	return r.clientRepo
 }
 
+func (r *postgresRepositories) ClientToken() ports.ClientTokenRepository {
+	if r.clientTokenRepo == nil {
+		r.clientTokenRepo = postgres.NewClientTokenRepository(
+			r.driver, r.apiKeyProtector,
+		)
+	}
+	return r.clientTokenRepo
+}
+
 func (r *postgresRepositories) Project() ports.ProjectRepository {
 	if r.projectRepo == nil {
 		r.projectRepo = postgres.NewProjectRepository(r.driver)
@@ -111,3 +133,54 @@ func (r *postgresRepositories) Reservation() ports.ReservationRepository {
 	}
 	return r.reservationRepo
 }
+
+func (r *postgresRepositories) Usage() ports.UsageRepository {
+	if r.usageRepo == nil {
+		r.usageRepo = postgres.NewUsageRepository(r.driver)
+	}
+	return r.usageRepo
+}
+
+func (r *postgresRepositories) Webhook() ports.WebhookRepository {
+	if r.webhookRepo == nil {
+		r.webhookRepo = postgres.NewWebhookRepository(r.driver)
+	}
+	return r.webhookRepo
+}
+
+func (r *postgresRepositories) QuotaAlert() ports.QuotaAlertRepository {
+	if r.quotaAlertRepo == nil {
+		r.quotaAlertRepo = postgres.NewQuotaAlertRepository(r.driver)
+	}
+	return r.quotaAlertRepo
+}
+
+func (r *postgresRepositories) Audit() ports.AuditRepository {
+	if r.auditRepo == nil {
+		r.auditRepo = postgres.NewAuditRepository(r.driver)
+	}
+	return r.auditRepo
+}
+
+func (r *postgresRepositories) Credentials() ports.CredentialsRepository {
+	if r.credentialsRepo == nil {
+		r.credentialsRepo = postgres.NewCredentialsRepository(r.driver)
+	}
+	return r.credentialsRepo
+}
+
+func (r *postgresRepositories) Validation() ports.ValidationRepository {
+	if r.validationRepo == nil {
+		r.validationRepo = postgres.NewValidationRepository(
+			r.driver, r.apiKeyProtector,
+		)
+	}
+	return r.validationRepo
+}
+
+func (r *postgresRepositories) RateLimiter() ports.RateLimiter {
+	if r.rateLimiter == nil {
+		r.rateLimiter = postgres.NewRateLimiter(r.driver)
+	}
+	return r.rateLimiter
+}
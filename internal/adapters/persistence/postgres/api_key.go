@@ -4,22 +4,33 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
 
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
 )
 
+// Keys are stored as a keyed hash for lookup, a clear text prefix for
+// display and an encrypted copy for reveal. The legacy plaintext `key`
+// column is only read for rows not yet processed by ProtectLegacyKeys.
 type APIKeyRepository struct {
 	*Driver
 
 	talbeName string
+
+	protector ports.APIKeyProtector
 }
 
 func (r *APIKeyRepository) Delete(
 	ctx context.Context, id int,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "Delete")
+
 	query := `
 		DELETE FROM api_key
 		WHERE id = $1;
@@ -40,6 +51,8 @@ func (r *APIKeyRepository) Delete(
 func (r *APIKeyRepository) UpdateStatus(
 	ctx context.Context, id int, status enums.APIKeyStatus,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "UpdateStatus")
+
 	query := `
 		UPDATE api_key
 		SET status = $1
@@ -61,6 +74,8 @@ func (r *APIKeyRepository) UpdateStatus(
 func (r *APIKeyRepository) Update(
 	ctx context.Context, id int, update *dto.APIKeyUpdate,
 ) (*entities.APIKey, errors.Error) {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "Update")
+
 	if update == nil {
 		return r.GetByID(ctx, id)
 	}
@@ -75,6 +90,26 @@ func (r *APIKeyRepository) Update(
 		argIndex++
 	}
 
+	if update.RateLimit != nil {
+		var requests, period, burst any
+
+		rateLimit := entities.NewAPIKeyRateLimit(
+			update.RateLimit.Requests, update.RateLimit.Period, update.RateLimit.Burst,
+		)
+		if rateLimit != nil {
+			requests, period, burst = rateLimit.Requests, rateLimit.Period, rateLimit.Burst
+		}
+
+		updates = append(
+			updates,
+			fmt.Sprintf("rate_limit_requests = $%d", argIndex),
+			fmt.Sprintf("rate_limit_period = $%d", argIndex+1),
+			fmt.Sprintf("rate_limit_burst = $%d", argIndex+2),
+		)
+		args = append(args, requests, period, burst)
+		argIndex += 3
+	}
+
 	if len(updates) == 0 {
 		return r.GetByID(ctx, id)
 	}
@@ -84,64 +119,105 @@ func (r *APIKeyRepository) Update(
 			UPDATE api_key
 			SET %s
 			WHERE id = $1
-			RETURNING id, environment_id, key, status, created_at,
+			RETURNING id, environment_id, COALESCE(key_prefix, left(key, 8)), status, created_at,
 				COALESCE(expires_at, '0001-01-01 00:00:00.0+00'),
-				COALESCE(last_used, '0001-01-01 00:00:00.0+00');
+				COALESCE(last_used, '0001-01-01 00:00:00.0+00'),
+				COALESCE(rotated_from_id, 0),
+				COALESCE(grace_ends_at, '0001-01-01 00:00:00.0+00'),
+				COALESCE(rate_limit_requests, 0),
+				COALESCE(rate_limit_period, ''),
+				COALESCE(rate_limit_burst, 0);
 		`,
 		strings.Join(updates, ", "),
 	)
 
 	apiKey := new(entities.APIKey)
+	rateLimit := new(entities.APIKeyRateLimit)
 	err := r.pool.QueryRow(ctx, query, args...).Scan(
 		&apiKey.ID,
 		&apiKey.EnvironmentID,
-		&apiKey.Key,
+		&apiKey.KeyPrefix,
 		&apiKey.Status,
 		&apiKey.CreatedAt,
 		&apiKey.ExpiresAt,
 		&apiKey.LastUsed,
+		&apiKey.RotatedFromID,
+		&apiKey.GraceEndsAt,
+		&rateLimit.Requests,
+		&rateLimit.Period,
+		&rateLimit.Burst,
 	)
 	if err != nil {
 		return nil, r.errorMapper(err, r.talbeName)
 	}
 
+	if rateLimit.Requests > 0 {
+		apiKey.RateLimit = rateLimit
+	}
+
 	return apiKey, nil
 }
 
 func (r *APIKeyRepository) UpdateLastUsed(
 	ctx context.Context, key string,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "UpdateLastUsed")
+
 	query := `
 		UPDATE api_key
 		SET last_used = NOW()
-		WHERE key = $1;
+		WHERE key_hash = $1 OR (key_hash IS NULL AND key = $2);
 	`
 
-	result, err := r.pool.Exec(ctx, query, key)
+	result, err := r.pool.Exec(ctx, query, r.protector.Hash(key), key)
 	if err != nil {
 		return r.errorMapper(err, r.talbeName)
 	}
 
 	if result.RowsAffected() == 0 {
-		return r.entityNotFoundError(r.talbeName, map[string]any{"key": key})
+		return r.entityNotFoundError(
+			r.talbeName, map[string]any{"key": (&entities.RequestAPIKey{Key: key}).KeySummary()},
+		)
 	}
 
 	return nil
 }
 
 func (r *APIKeyRepository) ListByEnvironment(
-	ctx context.Context, environmentID int,
+	ctx context.Context, environmentID int, page *dto.Pagination,
 ) ([]*entities.APIKey, errors.Error) {
-	query := `
-		SELECT id, environment_id, key, status, created_at,
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "ListByEnvironment")
+
+	where, args, clauses, pageErr := paginate(
+		page,
+		"created_at",
+		"id",
+		true,
+		[]string{"environment_id = $1"},
+		[]any{environmentID},
+	)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
+	query := fmt.Sprintf(
+		`
+		SELECT id, environment_id, COALESCE(key_prefix, left(key, 8)), status, created_at,
 			COALESCE(expires_at, '0001-01-01 00:00:00.0+00'),
-			COALESCE(last_used, '0001-01-01 00:00:00.0+00')
+			COALESCE(last_used, '0001-01-01 00:00:00.0+00'),
+			COALESCE(rotated_from_id, 0),
+			COALESCE(grace_ends_at, '0001-01-01 00:00:00.0+00'),
+			COALESCE(rate_limit_requests, 0),
+			COALESCE(rate_limit_period, ''),
+			COALESCE(rate_limit_burst, 0)
 		FROM api_key
-		WHERE environment_id = $1
-		ORDER BY created_at DESC;
-	`
+		WHERE %s%s;
+		`,
+		strings.Join(where, " AND "),
+		clauses,
+	)
 
-	rows, err := r.pool.Query(ctx, query, environmentID)
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, r.errorMapper(err, r.talbeName)
 	}
@@ -151,20 +227,30 @@ func (r *APIKeyRepository) ListByEnvironment(
 	var apiKeys []*entities.APIKey
 	for rows.Next() {
 		apiKey := new(entities.APIKey)
+		rateLimit := new(entities.APIKeyRateLimit)
 
 		err = rows.Scan(
 			&apiKey.ID,
 			&apiKey.EnvironmentID,
-			&apiKey.Key,
+			&apiKey.KeyPrefix,
 			&apiKey.Status,
 			&apiKey.CreatedAt,
 			&apiKey.ExpiresAt,
 			&apiKey.LastUsed,
+			&apiKey.RotatedFromID,
+			&apiKey.GraceEndsAt,
+			&rateLimit.Requests,
+			&rateLimit.Period,
+			&rateLimit.Burst,
 		)
 		if err != nil {
 			return nil, r.errorMapper(err, r.talbeName)
 		}
 
+		if rateLimit.Requests > 0 {
+			apiKey.RateLimit = rateLimit
+		}
+
 		apiKeys = append(apiKeys, apiKey)
 	}
 
@@ -175,75 +261,187 @@ func (r *APIKeyRepository) ListByEnvironment(
 	return apiKeys, nil
 }
 
+func (r *APIKeyRepository) CountByEnvironment(
+	ctx context.Context, environmentID int,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "CountByEnvironment")
+
+	query := `
+		SELECT count(*)
+		FROM api_key
+		WHERE environment_id = $1;
+	`
+
+	var total int
+	err := r.pool.QueryRow(ctx, query, environmentID).Scan(&total)
+	if err != nil {
+		return 0, r.errorMapper(err, r.talbeName)
+	}
+
+	return total, nil
+}
+
+// countUsableQuery counts the usable keys of the environment $1, which are
+// the ones the API key limit of a client applies to.
+const countUsableQuery = `
+	SELECT count(*)
+	FROM api_key
+	WHERE environment_id = $1
+		AND status = 'enabled'
+		AND (expires_at IS NULL OR expires_at > NOW())
+		AND (grace_ends_at IS NULL OR grace_ends_at > NOW());
+`
+
+// CountUsableByEnvironment counts the keys of the environment that are
+// enabled, not expired and, when replaced by a rotation, still in its grace
+// period.
+func (r *APIKeyRepository) CountUsableByEnvironment(
+	ctx context.Context, environmentID int,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "CountUsableByEnvironment")
+
+	var total int
+	err := r.pool.QueryRow(ctx, countUsableQuery, environmentID).Scan(&total)
+	if err != nil {
+		return 0, r.errorMapper(err, r.talbeName)
+	}
+
+	return total, nil
+}
+
 func (r *APIKeyRepository) GetByKey(
 	ctx context.Context, key string,
 ) (*entities.APIKey, errors.Error) {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "GetByKey")
+
 	query := `
-		SELECT id, environment_id, key, status, created_at,
+		SELECT id, environment_id, COALESCE(key_prefix, left(key, 8)), status, created_at,
 			COALESCE(expires_at, '0001-01-01 00:00:00.0+00'),
-			COALESCE(last_used, '0001-01-01 00:00:00.0+00')
+			COALESCE(last_used, '0001-01-01 00:00:00.0+00'),
+			COALESCE(rotated_from_id, 0),
+			COALESCE(grace_ends_at, '0001-01-01 00:00:00.0+00'),
+			COALESCE(rate_limit_requests, 0),
+			COALESCE(rate_limit_period, ''),
+			COALESCE(rate_limit_burst, 0)
 		FROM api_key
-		WHERE key = $1;
+		WHERE key_hash = $1 OR (key_hash IS NULL AND key = $2);
 	`
 
 	apiKey := new(entities.APIKey)
-	err := r.pool.QueryRow(ctx, query, key).Scan(
+	rateLimit := new(entities.APIKeyRateLimit)
+	err := r.pool.QueryRow(ctx, query, r.protector.Hash(key), key).Scan(
 		&apiKey.ID,
 		&apiKey.EnvironmentID,
-		&apiKey.Key,
+		&apiKey.KeyPrefix,
 		&apiKey.Status,
 		&apiKey.CreatedAt,
 		&apiKey.ExpiresAt,
 		&apiKey.LastUsed,
+		&apiKey.RotatedFromID,
+		&apiKey.GraceEndsAt,
+		&rateLimit.Requests,
+		&rateLimit.Period,
+		&rateLimit.Burst,
 	)
 	if err != nil {
 		return nil, r.errorMapper(err, r.talbeName)
 	}
 
+	if rateLimit.Requests > 0 {
+		apiKey.RateLimit = rateLimit
+	}
+
 	return apiKey, nil
 }
 
 func (r *APIKeyRepository) GetByID(
 	ctx context.Context, id int,
 ) (*entities.APIKey, errors.Error) {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "GetByID")
+
 	query := `
-		SELECT id, environment_id, key, status, created_at,
+		SELECT id, environment_id, COALESCE(key_prefix, left(key, 8)), status, created_at,
 			COALESCE(expires_at, '0001-01-01 00:00:00.0+00'),
-			COALESCE(last_used, '0001-01-01 00:00:00.0+00')
+			COALESCE(last_used, '0001-01-01 00:00:00.0+00'),
+			COALESCE(rotated_from_id, 0),
+			COALESCE(grace_ends_at, '0001-01-01 00:00:00.0+00'),
+			COALESCE(rate_limit_requests, 0),
+			COALESCE(rate_limit_period, ''),
+			COALESCE(rate_limit_burst, 0)
 		FROM api_key
 		WHERE id = $1;
 	`
 
 	apiKey := new(entities.APIKey)
+	rateLimit := new(entities.APIKeyRateLimit)
 	err := r.pool.QueryRow(ctx, query, id).Scan(
 		&apiKey.ID,
 		&apiKey.EnvironmentID,
-		&apiKey.Key,
+		&apiKey.KeyPrefix,
 		&apiKey.Status,
 		&apiKey.CreatedAt,
 		&apiKey.ExpiresAt,
 		&apiKey.LastUsed,
+		&apiKey.RotatedFromID,
+		&apiKey.GraceEndsAt,
+		&rateLimit.Requests,
+		&rateLimit.Period,
+		&rateLimit.Burst,
 	)
 	if err != nil {
 		return nil, r.errorMapper(err, r.talbeName)
 	}
 
+	if rateLimit.Requests > 0 {
+		apiKey.RateLimit = rateLimit
+	}
+
 	return apiKey, nil
 }
 
+func (r *APIKeyRepository) GetKeyByID(
+	ctx context.Context, id int,
+) (string, errors.Error) {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "GetKeyByID")
+
+	query := `
+		SELECT encrypted_key, key
+		FROM api_key
+		WHERE id = $1;
+	`
+
+	var encryptedKey, legacyKey *string
+	err := r.pool.QueryRow(ctx, query, id).Scan(&encryptedKey, &legacyKey)
+	if err != nil {
+		return "", r.errorMapper(err, r.talbeName)
+	}
+
+	if encryptedKey != nil {
+		return r.protector.Decrypt(*encryptedKey)
+	}
+
+	if legacyKey != nil {
+		return *legacyKey, nil
+	}
+
+	return "", errors.NewInternal("api key is not recoverable", nil)
+}
+
 func (r *APIKeyRepository) Exists(
 	ctx context.Context, key string,
 ) (bool, errors.Error) {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "Exists")
+
 	query := `
 		SELECT EXISTS (
 			SELECT 1
 			FROM api_key
-			WHERE key = $1
+			WHERE key_hash = $1 OR (key_hash IS NULL AND key = $2)
 		);
 	`
 
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, key).Scan(&exists)
+	err := r.pool.QueryRow(ctx, query, r.protector.Hash(key), key).Scan(&exists)
 	if err != nil {
 		return false, r.errorMapper(err, r.talbeName)
 	}
@@ -253,12 +451,244 @@ func (r *APIKeyRepository) Exists(
 
 func (r *APIKeyRepository) Create(
 	ctx context.Context, apiKey *entities.APIKey,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "Create")
+
+	return r.createAPIKey(ctx, nil, apiKey, 0)
+}
+
+// CreateWithinLimit creates the key as long as its environment has fewer
+// than limit usable keys. The environment is locked for the check and the
+// insert, so concurrent creations cannot exceed the limit together.
+func (r *APIKeyRepository) CreateWithinLimit(
+	ctx context.Context, apiKey *entities.APIKey, limit int,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "CreateWithinLimit")
+
+	tx, txErr := r.pool.Begin(ctx)
+	if txErr != nil {
+		return r.errorMapper(txErr, r.talbeName)
+	}
+
+	if err := r.lockEnvironment(ctx, tx, apiKey.EnvironmentID); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	if err := r.createAPIKey(ctx, tx, apiKey, limit); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	return r.errorMapper(tx.Commit(ctx), r.talbeName)
+}
+
+// Rotate creates the successor of the key with the given id and sets the
+// grace deadline of the replaced key in a single transaction. A key can
+// only be replaced once. With a limit, the environment is locked and the
+// successor only created while it has fewer than limit usable keys, the
+// replaced key included.
+func (r *APIKeyRepository) Rotate(
+	ctx context.Context,
+	id int,
+	graceEndsAt time.Time,
+	successor *entities.APIKey,
+	limit int,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "Rotate")
+
+	tx, txErr := r.pool.Begin(ctx)
+	if txErr != nil {
+		return r.errorMapper(txErr, r.talbeName)
+	}
+
+	if limit > 0 {
+		err := r.lockEnvironment(ctx, tx, successor.EnvironmentID)
+		if err != nil {
+			tx.Rollback(ctx)
+			return err
+		}
+	}
+
+	query := `
+		UPDATE api_key
+		SET grace_ends_at = $2
+		WHERE id = $1;
+	`
+
+	result, err := tx.Exec(ctx, query, id, graceEndsAt)
+	if err != nil {
+		tx.Rollback(ctx)
+		return r.errorMapper(err, r.talbeName)
+	}
+
+	if result.RowsAffected() == 0 {
+		tx.Rollback(ctx)
+		return r.entityNotFoundError(r.talbeName, map[string]any{"id": id})
+	}
+
+	successor.RotatedFromID = id
+	if err := r.createAPIKey(ctx, tx, successor, limit); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	return r.errorMapper(tx.Commit(ctx), r.talbeName)
+}
+
+// lockEnvironment locks the environment row until the end of tx, which
+// serializes the transactions enforcing the API key limit on it. A missing
+// environment is left for the insert to report.
+func (r *APIKeyRepository) lockEnvironment(
+	ctx context.Context, tx pgx.Tx, environmentID int,
 ) errors.Error {
 	query := `
-		INSERT INTO api_key (environment_id, key, expires_at, last_used, status)
-		VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at;
+		SELECT id
+		FROM environment
+		WHERE id = $1
+		FOR UPDATE;
 	`
 
+	var id int
+	err := tx.QueryRow(ctx, query, environmentID).Scan(&id)
+	if err == pgx.ErrNoRows {
+		return nil
+	}
+
+	return r.errorMapper(err, "environment")
+}
+
+// apiKeyLimitError reports that the environment already has as many usable
+// keys as the API key limit allows.
+func apiKeyLimitError(environmentID, usable int) errors.Error {
+	return errors.NewEntityValidationFailed(
+		"APIKey",
+		fmt.Sprintf(
+			"environment already has %d usable API keys, the limit of the client",
+			usable,
+		),
+		map[string]any{"environment_id": environmentID},
+		nil,
+	)
+}
+
+// DisableRotated disables the rotated keys whose grace period is over and
+// returns them.
+func (r *APIKeyRepository) DisableRotated(
+	ctx context.Context, now time.Time,
+) ([]*entities.APIKey, errors.Error) {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "DisableRotated")
+
+	query := `
+		UPDATE api_key
+		SET status = 'disabled'
+		WHERE status = 'enabled' AND grace_ends_at <= $1
+		RETURNING id, environment_id, COALESCE(key_prefix, left(key, 8)),
+			status, COALESCE(expires_at, '0001-01-01 00:00:00.0+00'),
+			COALESCE(grace_ends_at, '0001-01-01 00:00:00.0+00');
+	`
+
+	return r.listSummaries(ctx, query, now)
+}
+
+// ListExpiredBetween returns the keys whose expiration time is in
+// (from, to].
+func (r *APIKeyRepository) ListExpiredBetween(
+	ctx context.Context, from, to time.Time,
+) ([]*entities.APIKey, errors.Error) {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "ListExpiredBetween")
+
+	query := `
+		SELECT id, environment_id, COALESCE(key_prefix, left(key, 8)),
+			status, expires_at,
+			COALESCE(grace_ends_at, '0001-01-01 00:00:00.0+00')
+		FROM api_key
+		WHERE expires_at > $1 AND expires_at <= $2
+		ORDER BY expires_at, id;
+	`
+
+	return r.listSummaries(ctx, query, from, to)
+}
+
+// listSummaries returns the keys selected by a query returning their id,
+// environment, prefix, status, expiration and end of grace period.
+func (r *APIKeyRepository) listSummaries(
+	ctx context.Context, query string, args ...any,
+) ([]*entities.APIKey, errors.Error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, r.errorMapper(err, r.talbeName)
+	}
+
+	defer rows.Close()
+
+	var apiKeys []*entities.APIKey
+	for rows.Next() {
+		apiKey := new(entities.APIKey)
+
+		err = rows.Scan(
+			&apiKey.ID,
+			&apiKey.EnvironmentID,
+			&apiKey.KeyPrefix,
+			&apiKey.Status,
+			&apiKey.ExpiresAt,
+			&apiKey.GraceEndsAt,
+		)
+		if err != nil {
+			return nil, r.errorMapper(err, r.talbeName)
+		}
+
+		apiKeys = append(apiKeys, apiKey)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.errorMapper(err, r.talbeName)
+	}
+
+	return apiKeys, nil
+}
+
+// createAPIKey inserts the key. With a limit, the key is only inserted
+// while its environment has fewer than limit usable keys, which tx must
+// have locked.
+func (r *APIKeyRepository) createAPIKey(
+	ctx context.Context, tx pgx.Tx, apiKey *entities.APIKey, limit int,
+) errors.Error {
+	query := `
+		INSERT INTO api_key (
+			environment_id, key_hash, key_prefix, encrypted_key,
+			expires_at, last_used, status, rotated_from_id,
+			rate_limit_requests, rate_limit_period, rate_limit_burst
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id, created_at;
+	`
+
+	if limit > 0 {
+		query = `
+			INSERT INTO api_key (
+				environment_id, key_hash, key_prefix, encrypted_key,
+				expires_at, last_used, status, rotated_from_id,
+				rate_limit_requests, rate_limit_period, rate_limit_burst
+			)
+			SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+			WHERE (
+				SELECT count(*)
+				FROM api_key
+				WHERE environment_id = $1
+					AND status = 'enabled'
+					AND (expires_at IS NULL OR expires_at > NOW())
+					AND (grace_ends_at IS NULL OR grace_ends_at > NOW())
+			) < $12
+			RETURNING id, created_at;
+		`
+	}
+
+	encryptedKey, encErr := r.protector.Encrypt(apiKey.Key)
+	if encErr != nil {
+		return encErr
+	}
+
 	var expiresAt any
 	if !apiKey.ExpiresAt.IsZero() {
 		expiresAt = apiKey.ExpiresAt
@@ -269,19 +699,129 @@ func (r *APIKeyRepository) Create(
 		lastUsed = apiKey.LastUsed
 	}
 
-	err := r.pool.QueryRow(
-		ctx,
-		query,
+	var rotatedFromID any
+	if apiKey.RotatedFromID != 0 {
+		rotatedFromID = apiKey.RotatedFromID
+	}
+
+	var rateLimitRequests, rateLimitPeriod, rateLimitBurst any
+	if apiKey.RateLimit != nil {
+		rateLimitRequests = apiKey.RateLimit.Requests
+		rateLimitPeriod = apiKey.RateLimit.Period
+		rateLimitBurst = apiKey.RateLimit.Burst
+	}
+
+	args := []any{
 		apiKey.EnvironmentID,
-		apiKey.Key,
+		r.protector.Hash(apiKey.Key),
+		apiKey.KeyPrefix,
+		encryptedKey,
 		expiresAt,
 		lastUsed,
 		apiKey.Status,
-	).Scan(&apiKey.ID, &apiKey.CreatedAt)
+		rotatedFromID,
+		rateLimitRequests,
+		rateLimitPeriod,
+		rateLimitBurst,
+	}
+
+	if limit > 0 {
+		args = append(args, limit)
+	}
+
+	var row pgx.Row
+	if tx != nil {
+		row = tx.QueryRow(ctx, query, args...)
+	} else {
+		row = r.pool.QueryRow(ctx, query, args...)
+	}
+
+	err := row.Scan(&apiKey.ID, &apiKey.CreatedAt)
+	if err == pgx.ErrNoRows && limit > 0 {
+		var usable int
+		err = tx.QueryRow(ctx, countUsableQuery, apiKey.EnvironmentID).Scan(&usable)
+		if err != nil {
+			return r.errorMapper(err, r.talbeName)
+		}
+
+		return apiKeyLimitError(apiKey.EnvironmentID, usable)
+	}
 
 	return r.errorMapper(err, r.talbeName)
 }
 
-func NewAPIKeyRepository(driver *Driver) *APIKeyRepository {
-	return &APIKeyRepository{Driver: driver, talbeName: "api_key"}
+// ProtectLegacyKeys hashes and encrypts the keys still stored in clear text
+// and clears the plaintext column. It returns the number of keys migrated.
+func (r *APIKeyRepository) ProtectLegacyKeys(
+	ctx context.Context,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "APIKeyRepository", "ProtectLegacyKeys")
+
+	query := `
+		SELECT id, key
+		FROM api_key
+		WHERE key_hash IS NULL AND key IS NOT NULL;
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return 0, r.errorMapper(err, r.talbeName)
+	}
+
+	legacyKeys := make(map[int]string)
+	for rows.Next() {
+		var id int
+		var key string
+		if err := rows.Scan(&id, &key); err != nil {
+			rows.Close()
+			return 0, r.errorMapper(err, r.talbeName)
+		}
+
+		legacyKeys[id] = key
+	}
+
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, r.errorMapper(err, r.talbeName)
+	}
+
+	query = `
+		UPDATE api_key
+		SET key_hash = $2, key_prefix = $3, encrypted_key = $4, key = NULL
+		WHERE id = $1 AND key_hash IS NULL;
+	`
+
+	protected := 0
+	for id, key := range legacyKeys {
+		encryptedKey, encErr := r.protector.Encrypt(key)
+		if encErr != nil {
+			return protected, encErr
+		}
+
+		result, err := r.pool.Exec(
+			ctx,
+			query,
+			id,
+			r.protector.Hash(key),
+			key[:min(len(key), entities.APIKeyPrefixLength)],
+			encryptedKey,
+		)
+		if err != nil {
+			return protected, r.errorMapper(err, r.talbeName)
+		}
+
+		protected += int(result.RowsAffected())
+	}
+
+	return protected, nil
+}
+
+func NewAPIKeyRepository(
+	driver *Driver, protector ports.APIKeyProtector,
+) *APIKeyRepository {
+	return &APIKeyRepository{
+		Driver:    driver,
+		talbeName: "api_key",
+		protector: protector,
+	}
 }
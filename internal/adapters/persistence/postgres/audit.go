@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type AuditRepository struct {
+	*Driver
+
+	tableName string
+}
+
+func (r *AuditRepository) Count(
+	ctx context.Context, filter *dto.AuditFilter,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "AuditRepository", "Count")
+
+	where, args := r.filterConditions(filter)
+
+	query := "SELECT count(*) FROM audit_log"
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
+
+	var total int
+	err := r.pool.QueryRow(ctx, query+";", args...).Scan(&total)
+	if err != nil {
+		return 0, r.errorMapper(err, r.tableName)
+	}
+
+	return total, nil
+}
+
+func (r *AuditRepository) List(
+	ctx context.Context, filter *dto.AuditFilter, page *dto.Pagination,
+) ([]*entities.AuditEntry, errors.Error) {
+	ctx = withQueryLabel(ctx, "AuditRepository", "List")
+
+	where, args := r.filterConditions(filter)
+
+	where, args, clauses, pageErr := paginate(
+		page, "created_at", "id", true, where, args,
+	)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
+	query := `
+		SELECT id, actor, action, entity, COALESCE(entity_id, 0), before,
+			after, COALESCE(ip, ''), created_at
+		FROM audit_log
+	`
+
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
+
+	query += clauses + ";"
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	defer rows.Close()
+
+	var entries []*entities.AuditEntry
+	for rows.Next() {
+		entry, err := scanAuditEntry(rows)
+		if err != nil {
+			return nil, r.errorMapper(err, r.tableName)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return entries, nil
+}
+
+func (r *AuditRepository) filterConditions(
+	filter *dto.AuditFilter,
+) ([]string, []any) {
+	var where []string
+	var args []any
+
+	if filter == nil {
+		return where, args
+	}
+
+	if filter.Actor != "" {
+		where = append(where, fmt.Sprintf("actor = $%d", len(args)+1))
+		args = append(args, filter.Actor)
+	}
+
+	if filter.Action != "" {
+		where = append(where, fmt.Sprintf("action = $%d", len(args)+1))
+		args = append(args, filter.Action)
+	}
+
+	if filter.Entity != "" {
+		where = append(where, fmt.Sprintf("entity = $%d", len(args)+1))
+		args = append(args, filter.Entity)
+	}
+
+	if filter.EntityID != 0 {
+		where = append(where, fmt.Sprintf("entity_id = $%d", len(args)+1))
+		args = append(args, filter.EntityID)
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		where = append(where, fmt.Sprintf("created_at >= $%d", len(args)+1))
+		args = append(args, filter.CreatedFrom)
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		where = append(where, fmt.Sprintf("created_at <= $%d", len(args)+1))
+		args = append(args, filter.CreatedTo)
+	}
+
+	return where, args
+}
+
+func (r *AuditRepository) Create(
+	ctx context.Context, entry *entities.AuditEntry,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "AuditRepository", "Create")
+
+	query := `
+		INSERT INTO audit_log (actor, action, entity, entity_id, before, after, ip)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at;
+	`
+
+	var entityID, ip any
+	if entry.EntityID != 0 {
+		entityID = entry.EntityID
+	}
+	if entry.IP != "" {
+		ip = entry.IP
+	}
+
+	err := r.pool.QueryRow(
+		ctx,
+		query,
+		entry.Actor,
+		entry.Action,
+		entry.Entity,
+		entityID,
+		entry.Before,
+		entry.After,
+		ip,
+	).Scan(&entry.ID, &entry.CreatedAt)
+	if err != nil {
+		return r.errorMapper(err, r.tableName)
+	}
+
+	return nil
+}
+
+func scanAuditEntry(row pgx.Row) (*entities.AuditEntry, error) {
+	entry := new(entities.AuditEntry)
+	err := row.Scan(
+		&entry.ID,
+		&entry.Actor,
+		&entry.Action,
+		&entry.Entity,
+		&entry.EntityID,
+		&entry.Before,
+		&entry.After,
+		&entry.IP,
+		&entry.CreatedAt,
+	)
+	return entry, err
+}
+
+func NewAuditRepository(driver *Driver) *AuditRepository {
+	return &AuditRepository{
+		Driver:    driver,
+		tableName: "audit_log",
+	}
+}
@@ -20,6 +20,8 @@ type ClientRepository struct {
 func (r *ClientRepository) Delete(
 	ctx context.Context, id int,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "ClientRepository", "Delete")
+
 	query := `
 		DELETE FROM client
 		WHERE id = $1;
@@ -40,6 +42,8 @@ func (r *ClientRepository) Delete(
 func (r *ClientRepository) Update(
 	ctx context.Context, id int, update *dto.ClientUpdate,
 ) (*entities.Client, errors.Error) {
+	ctx = withQueryLabel(ctx, "ClientRepository", "Update")
+
 	if update == nil {
 		return r.GetByID(ctx, id)
 	}
@@ -60,6 +64,12 @@ func (r *ClientRepository) Update(
 		argIndex++
 	}
 
+	if update.APIKeyLimit != nil {
+		updates = append(updates, fmt.Sprintf("api_key_limit = $%d", argIndex))
+		args = append(args, *update.APIKeyLimit)
+		argIndex++
+	}
+
 	if update.Type != enums.ClientTypeNull {
 		updates = append(updates, fmt.Sprintf("type = $%d", argIndex))
 		args = append(args, update.Type)
@@ -75,7 +85,7 @@ func (r *ClientRepository) Update(
 			UPDATE client
 			SET %s
 			WHERE id = $1
-			RETURNING id, type, name, email, created_at;
+			RETURNING id, type, name, email, api_key_limit, created_at;
 		`,
 		strings.Join(updates, ", "),
 	)
@@ -86,6 +96,7 @@ func (r *ClientRepository) Update(
 		&client.Type,
 		&client.Name,
 		&client.Email,
+		&client.APIKeyLimit,
 		&client.CreatedAt,
 	)
 	if err != nil {
@@ -98,6 +109,8 @@ func (r *ClientRepository) Update(
 func (r *ClientRepository) Exists(
 	ctx context.Context, id int,
 ) (bool, errors.Error) {
+	ctx = withQueryLabel(ctx, "ClientRepository", "Exists")
+
 	query := `
 		SELECT EXISTS (
 			SELECT 1
@@ -118,8 +131,10 @@ func (r *ClientRepository) Exists(
 func (r *ClientRepository) GetByID(
 	ctx context.Context, id int,
 ) (*entities.Client, errors.Error) {
+	ctx = withQueryLabel(ctx, "ClientRepository", "GetByID")
+
 	query := `
-		SELECT id, type, name, email, created_at
+		SELECT id, type, name, email, api_key_limit, created_at
 		FROM client
 		WHERE id = $1;
 	`
@@ -130,6 +145,7 @@ func (r *ClientRepository) GetByID(
 		&client.Type,
 		&client.Name,
 		&client.Email,
+		&client.APIKeyLimit,
 		&client.CreatedAt,
 	)
 	if err != nil {
@@ -140,33 +156,29 @@ func (r *ClientRepository) GetByID(
 }
 
 func (r *ClientRepository) List(
-	ctx context.Context, filter *dto.ClientFilter,
+	ctx context.Context, filter *dto.ClientFilter, page *dto.Pagination,
 ) ([]*entities.Client, errors.Error) {
+	ctx = withQueryLabel(ctx, "ClientRepository", "List")
+
+	where, args := r.filterConditions(filter)
+
+	where, args, clauses, pageErr := paginate(
+		page, "created_at", "id", true, where, args,
+	)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
 	query := `
-		SELECT id, type, name, email, created_at
+		SELECT id, type, name, email, api_key_limit, created_at
 		FROM client
-		ORDER BY created_at DESC;
 	`
 
-	var args []any
-	if filter != nil {
-		var where []string
-		argIndex := 1
-
-		if filter.Type != enums.ClientTypeNull {
-			where = append(where, fmt.Sprintf("type = $%d", argIndex))
-			args = append(args, filter.Type)
-			argIndex++
-		}
-
-		if len(where) > 0 {
-			query = fmt.Sprintf(
-				"%s WHERE %s", query, strings.Join(where, " AND "),
-			)
-		}
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
 	}
 
-	query += ";"
+	query += clauses + ";"
 
 	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
@@ -184,6 +196,7 @@ func (r *ClientRepository) List(
 			&client.Type,
 			&client.Name,
 			&client.Email,
+			&client.APIKeyLimit,
 			&client.CreatedAt,
 		)
 		if err != nil {
@@ -200,12 +213,53 @@ func (r *ClientRepository) List(
 	return clients, nil
 }
 
+func (r *ClientRepository) Count(
+	ctx context.Context, filter *dto.ClientFilter,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "ClientRepository", "Count")
+
+	where, args := r.filterConditions(filter)
+
+	query := "SELECT count(*) FROM client"
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
+
+	var total int
+	err := r.pool.QueryRow(ctx, query+";", args...).Scan(&total)
+	if err != nil {
+		return 0, r.errorMapper(err, r.tableName)
+	}
+
+	return total, nil
+}
+
+func (r *ClientRepository) filterConditions(
+	filter *dto.ClientFilter,
+) ([]string, []any) {
+	var where []string
+	var args []any
+
+	if filter == nil {
+		return where, args
+	}
+
+	if filter.Type != enums.ClientTypeNull {
+		where = append(where, fmt.Sprintf("type = $%d", len(args)+1))
+		args = append(args, filter.Type)
+	}
+
+	return where, args
+}
+
 func (r *ClientRepository) Create(
 	ctx context.Context, client *entities.Client,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "ClientRepository", "Create")
+
 	query := `
-		INSERT INTO client (type, name, email)
-		VALUES ($1, $2, $3) RETURNING id, created_at;
+		INSERT INTO client (type, name, email, api_key_limit)
+		VALUES ($1, $2, $3, $4) RETURNING id, created_at;
 	`
 
 	err := r.pool.QueryRow(
@@ -214,6 +268,7 @@ func (r *ClientRepository) Create(
 		client.Type,
 		client.Name,
 		client.Email,
+		client.APIKeyLimit,
 	).Scan(&client.ID, &client.CreatedAt)
 
 	return r.errorMapper(err, r.tableName)
@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+// Tokens are only stored as a keyed hash, computed with the API key
+// protector, and a clear text prefix for display.
+type ClientTokenRepository struct {
+	*Driver
+
+	tableName string
+
+	protector ports.APIKeyProtector
+}
+
+func (r *ClientTokenRepository) GetByID(
+	ctx context.Context, id int,
+) (*entities.ClientToken, errors.Error) {
+	ctx = withQueryLabel(ctx, "ClientTokenRepository", "GetByID")
+
+	query := `
+		SELECT id, client_id, name, token_prefix,
+			COALESCE(expires_at, '0001-01-01 00:00:00.0+00'),
+			COALESCE(last_used, '0001-01-01 00:00:00.0+00'),
+			created_at
+		FROM client_token
+		WHERE id = $1;
+	`
+
+	clientToken, err := scanClientToken(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return clientToken, nil
+}
+
+func (r *ClientTokenRepository) GetByToken(
+	ctx context.Context, token string,
+) (*entities.ClientToken, errors.Error) {
+	ctx = withQueryLabel(ctx, "ClientTokenRepository", "GetByToken")
+
+	query := `
+		SELECT id, client_id, name, token_prefix,
+			COALESCE(expires_at, '0001-01-01 00:00:00.0+00'),
+			COALESCE(last_used, '0001-01-01 00:00:00.0+00'),
+			created_at
+		FROM client_token
+		WHERE token_hash = $1;
+	`
+
+	clientToken, err := scanClientToken(
+		r.pool.QueryRow(ctx, query, r.protector.Hash(token)),
+	)
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return clientToken, nil
+}
+
+func (r *ClientTokenRepository) ListByClient(
+	ctx context.Context, clientID int,
+) ([]*entities.ClientToken, errors.Error) {
+	ctx = withQueryLabel(ctx, "ClientTokenRepository", "ListByClient")
+
+	query := `
+		SELECT id, client_id, name, token_prefix,
+			COALESCE(expires_at, '0001-01-01 00:00:00.0+00'),
+			COALESCE(last_used, '0001-01-01 00:00:00.0+00'),
+			created_at
+		FROM client_token
+		WHERE client_id = $1
+		ORDER BY created_at DESC, id DESC;
+	`
+
+	rows, err := r.pool.Query(ctx, query, clientID)
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	defer rows.Close()
+
+	var clientTokens []*entities.ClientToken
+	for rows.Next() {
+		clientToken, err := scanClientToken(rows)
+		if err != nil {
+			return nil, r.errorMapper(err, r.tableName)
+		}
+
+		clientTokens = append(clientTokens, clientToken)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return clientTokens, nil
+}
+
+func (r *ClientTokenRepository) Create(
+	ctx context.Context, clientToken *entities.ClientToken,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "ClientTokenRepository", "Create")
+
+	query := `
+		INSERT INTO client_token (client_id, name, token_hash, token_prefix, expires_at)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at;
+	`
+
+	var expiresAt any
+	if !clientToken.ExpiresAt.IsZero() {
+		expiresAt = clientToken.ExpiresAt
+	}
+
+	err := r.pool.QueryRow(
+		ctx,
+		query,
+		clientToken.ClientID,
+		clientToken.Name,
+		r.protector.Hash(clientToken.Token),
+		clientToken.TokenPrefix,
+		expiresAt,
+	).Scan(&clientToken.ID, &clientToken.CreatedAt)
+
+	return r.errorMapper(err, r.tableName)
+}
+
+func (r *ClientTokenRepository) UpdateLastUsed(
+	ctx context.Context, id int,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "ClientTokenRepository", "UpdateLastUsed")
+
+	query := `
+		UPDATE client_token
+		SET last_used = NOW()
+		WHERE id = $1;
+	`
+
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return r.errorMapper(err, r.tableName)
+	}
+
+	if result.RowsAffected() == 0 {
+		return r.entityNotFoundError(r.tableName, map[string]any{"id": id})
+	}
+
+	return nil
+}
+
+func (r *ClientTokenRepository) Delete(
+	ctx context.Context, id int,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "ClientTokenRepository", "Delete")
+
+	query := `
+		DELETE FROM client_token
+		WHERE id = $1;
+	`
+
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return r.errorMapper(err, r.tableName)
+	}
+
+	if result.RowsAffected() == 0 {
+		return r.entityNotFoundError(r.tableName, map[string]any{"id": id})
+	}
+
+	return nil
+}
+
+func scanClientToken(row pgx.Row) (*entities.ClientToken, error) {
+	clientToken := new(entities.ClientToken)
+	err := row.Scan(
+		&clientToken.ID,
+		&clientToken.ClientID,
+		&clientToken.Name,
+		&clientToken.TokenPrefix,
+		&clientToken.ExpiresAt,
+		&clientToken.LastUsed,
+		&clientToken.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientToken, nil
+}
+
+func NewClientTokenRepository(
+	driver *Driver, protector ports.APIKeyProtector,
+) *ClientTokenRepository {
+	return &ClientTokenRepository{
+		Driver:    driver,
+		tableName: "client_token",
+		protector: protector,
+	}
+}
@@ -0,0 +1,256 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type CredentialsRepository struct {
+	*Driver
+
+	tableName string
+}
+
+func (r *CredentialsRepository) GetByID(
+	ctx context.Context, id int,
+) (*entities.Credentials, errors.Error) {
+	ctx = withQueryLabel(ctx, "CredentialsRepository", "GetByID")
+
+	query := `
+		SELECT id, username, password, role, status, force_password_reset,
+			created_at
+		FROM admin_user
+		WHERE id = $1;
+	`
+
+	credentials, err := scanCredentials(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return credentials, nil
+}
+
+func (r *CredentialsRepository) GetByUsername(
+	ctx context.Context, username string,
+) (*entities.Credentials, errors.Error) {
+	ctx = withQueryLabel(ctx, "CredentialsRepository", "GetByUsername")
+
+	query := `
+		SELECT id, username, password, role, status, force_password_reset,
+			created_at
+		FROM admin_user
+		WHERE username = $1;
+	`
+
+	credentials, err := scanCredentials(r.pool.QueryRow(ctx, query, username))
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return credentials, nil
+}
+
+func (r *CredentialsRepository) Count(
+	ctx context.Context, filter *dto.AdminUserFilter,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "CredentialsRepository", "Count")
+
+	where, args := r.filterConditions(filter)
+
+	query := "SELECT count(*) FROM admin_user"
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
+
+	var total int
+	err := r.pool.QueryRow(ctx, query+";", args...).Scan(&total)
+	if err != nil {
+		return 0, r.errorMapper(err, r.tableName)
+	}
+
+	return total, nil
+}
+
+func (r *CredentialsRepository) List(
+	ctx context.Context, filter *dto.AdminUserFilter, page *dto.Pagination,
+) ([]*entities.Credentials, errors.Error) {
+	ctx = withQueryLabel(ctx, "CredentialsRepository", "List")
+
+	where, args := r.filterConditions(filter)
+
+	where, args, clauses, pageErr := paginate(
+		page, "created_at", "id", true, where, args,
+	)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
+	query := `
+		SELECT id, username, password, role, status, force_password_reset,
+			created_at
+		FROM admin_user
+	`
+
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
+
+	query += clauses + ";"
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	defer rows.Close()
+
+	var credentials []*entities.Credentials
+	for rows.Next() {
+		c, err := scanCredentials(rows)
+		if err != nil {
+			return nil, r.errorMapper(err, r.tableName)
+		}
+
+		credentials = append(credentials, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return credentials, nil
+}
+
+func (r *CredentialsRepository) filterConditions(
+	filter *dto.AdminUserFilter,
+) ([]string, []any) {
+	var where []string
+	var args []any
+
+	if filter == nil {
+		return where, args
+	}
+
+	if filter.Role != enums.AdminRoleNull {
+		where = append(where, fmt.Sprintf("role = $%d", len(args)+1))
+		args = append(args, filter.Role)
+	}
+
+	if filter.Status != enums.CredentialsStatusNull {
+		where = append(where, fmt.Sprintf("status = $%d", len(args)+1))
+		args = append(args, filter.Status)
+	}
+
+	return where, args
+}
+
+func (r *CredentialsRepository) Create(
+	ctx context.Context, credentials *entities.Credentials,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "CredentialsRepository", "Create")
+
+	query := `
+		INSERT INTO admin_user (
+			username, password, role, status, force_password_reset
+		)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at;
+	`
+
+	err := r.pool.QueryRow(
+		ctx,
+		query,
+		credentials.Username,
+		credentials.HashedPassword,
+		credentials.Role,
+		credentials.Status,
+		credentials.ForcePasswordReset,
+	).Scan(&credentials.ID, &credentials.CreatedAt)
+
+	return r.errorMapper(err, r.tableName)
+}
+
+func (r *CredentialsRepository) ChangePassword(
+	ctx context.Context, credentials *entities.Credentials,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "CredentialsRepository", "ChangePassword")
+
+	query := `
+		UPDATE admin_user
+		SET password = $1, force_password_reset = FALSE
+		WHERE username = $2;
+	`
+
+	result, err := r.pool.Exec(
+		ctx, query, credentials.HashedPassword, credentials.Username,
+	)
+	if err != nil {
+		return r.errorMapper(err, r.tableName)
+	}
+
+	if result.RowsAffected() == 0 {
+		return r.entityNotFoundError(
+			r.tableName, map[string]any{"username": credentials.Username},
+		)
+	}
+
+	return nil
+}
+
+func (r *CredentialsRepository) UpdateStatus(
+	ctx context.Context, id int, status enums.CredentialsStatus,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "CredentialsRepository", "UpdateStatus")
+
+	query := `
+		UPDATE admin_user
+		SET status = $1
+		WHERE id = $2;
+	`
+
+	result, err := r.pool.Exec(ctx, query, status, id)
+	if err != nil {
+		return r.errorMapper(err, r.tableName)
+	}
+
+	if result.RowsAffected() == 0 {
+		return r.entityNotFoundError(r.tableName, map[string]any{"id": id})
+	}
+
+	return nil
+}
+
+func scanCredentials(row pgx.Row) (*entities.Credentials, error) {
+	credentials := new(entities.Credentials)
+
+	err := row.Scan(
+		&credentials.ID,
+		&credentials.Username,
+		&credentials.HashedPassword,
+		&credentials.Role,
+		&credentials.Status,
+		&credentials.ForcePasswordReset,
+		&credentials.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials, nil
+}
+
+func NewCredentialsRepository(driver *Driver) *CredentialsRepository {
+	return &CredentialsRepository{
+		Driver:    driver,
+		tableName: "admin_user",
+	}
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// cursorFetchSize is how many rows are fetched from a cursor at a time.
+const cursorFetchSize = 1000
+
+// streamCursor runs the query through a server side cursor in a read only
+// transaction and hands each row to scan as it is fetched, so results of
+// any size are streamed without being held in memory. It stops at the first
+// error scan returns.
+func (d *Driver) streamCursor(
+	ctx context.Context,
+	tableName string,
+	query string,
+	args []any,
+	scan func(rows pgx.Rows) errors.Error,
+) errors.Error {
+	tx, err := d.pool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return d.errorMapper(err, tableName)
+	}
+
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, "DECLARE stream NO SCROLL CURSOR FOR "+query, args...)
+	if err != nil {
+		return d.errorMapper(err, tableName)
+	}
+
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM stream", cursorFetchSize)
+	for {
+		rows, err := tx.Query(ctx, fetch)
+		if err != nil {
+			return d.errorMapper(err, tableName)
+		}
+
+		var fetched int
+		for rows.Next() {
+			fetched++
+			if err := scan(rows); err != nil {
+				rows.Close()
+				return err
+			}
+		}
+
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return d.errorMapper(err, tableName)
+		}
+
+		if fetched < cursorFetchSize {
+			return nil
+		}
+	}
+}
@@ -23,6 +23,8 @@ type EnvironmentRepository struct {
 func (r *EnvironmentRepository) Delete(
 	ctx context.Context, id int,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "Delete")
+
 	query := `
 		DELETE FROM environment
 		WHERE id = $1;
@@ -40,37 +42,23 @@ func (r *EnvironmentRepository) Delete(
 	return nil
 }
 
-func (r *EnvironmentRepository) ExistsServiceWithInfiniteMaxRequest(
-	ctx context.Context, projectID, serviceID int,
-) (bool, errors.Error) {
-	query := `
-		SELECT EXISTS (
-			SELECT 1
-			FROM environment_service es
-				JOIN environment e ON es.environment_id = e.id
-			WHERE e.project_id = $1 AND es.service_id = $2
-				AND es.max_requests IS NULL
-		);
-	`
-
-	var hasInfinite bool
-	err := r.pool.QueryRow(ctx, query, projectID, serviceID).Scan(&hasInfinite)
-	if err != nil {
-		return false, r.errorMapper(err, r.tableName)
-	}
-
-	return hasInfinite, nil
-}
-
 func (r *EnvironmentRepository) ResetAvailableRequests(
 	ctx context.Context, id, serviceID int,
 ) (*entities.EnvironmentService, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "ResetAvailableRequests")
+
 	query := `
 		WITH updated AS (
 			UPDATE environment_service
 			SET available_request = max_requests
 			WHERE environment_id = $1 AND service_id = $2
 			RETURNING *
+		),
+		reset_alerts AS (
+			UPDATE quota_alert
+			SET triggered_at = NULL
+			WHERE environment_id = $1 AND service_id = $2
+				AND level = 'environment' AND triggered_at IS NOT NULL
 		)
 		SELECT s.id, s.name, s.version, u.created_at,
 			u.max_requests, u.available_request
@@ -93,6 +81,8 @@ func (r *EnvironmentRepository) ResetAvailableRequests(
 func (r *EnvironmentRepository) RemoveService(
 	ctx context.Context, id, serviceID int,
 ) (int64, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "RemoveService")
+
 	query := `
 		DELETE FROM environment_service
 		WHERE environment_id = $1 AND service_id = $2;
@@ -109,6 +99,8 @@ func (r *EnvironmentRepository) RemoveService(
 func (r *EnvironmentRepository) RemoveServiceFromProjectEnvironments(
 	ctx context.Context, projectID, serviceID int,
 ) (int64, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "RemoveServiceFromProjectEnvironments")
+
 	query := `
 		DELETE FROM environment_service
 		WHERE service_id = $2
@@ -130,6 +122,8 @@ func (r *EnvironmentRepository) RemoveServiceFromProjectEnvironments(
 func (r *EnvironmentRepository) UpdateStatus(
 	ctx context.Context, id int, status enums.EnvironmentStatus,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "UpdateStatus")
+
 	query := `
 		UPDATE environment
 		SET status = $1
@@ -153,6 +147,8 @@ func (r *EnvironmentRepository) UpdateService(
 	id, serviceID int,
 	update *dto.EnvironmentServiceUpdate,
 ) (*entities.EnvironmentService, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "UpdateService")
+
 	if update == nil {
 		return r.GetServiceByID(ctx, id, serviceID)
 	}
@@ -197,6 +193,8 @@ func (r *EnvironmentRepository) UpdateService(
 func (r *EnvironmentRepository) Update(
 	ctx context.Context, id int, update *dto.EnvironmentUpdate,
 ) (*entities.Environment, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "Update")
+
 	if update == nil {
 		return r.GetByID(ctx, id)
 	}
@@ -239,6 +237,8 @@ func (r *EnvironmentRepository) Update(
 func (r *EnvironmentRepository) Exists(
 	ctx context.Context, id int,
 ) (bool, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "Exists")
+
 	query := `
 		SELECT EXISTS (
 			SELECT 1
@@ -259,6 +259,8 @@ func (r *EnvironmentRepository) Exists(
 func (r *EnvironmentRepository) IsEnabled(
 	ctx context.Context, id int,
 ) (bool, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "IsEnabled")
+
 	query := `
 		SELECT EXISTS (
 			SELECT 1
@@ -281,6 +283,8 @@ func (r *EnvironmentRepository) IsEnabled(
 func (r *EnvironmentRepository) MissingResourceDiagnosis(
 	ctx context.Context, id int, serviceID int,
 ) (bool, bool, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "MissingResourceDiagnosis")
+
 	query := `
 		SELECT
 		EXISTS (
@@ -313,6 +317,8 @@ func (r *EnvironmentRepository) MissingResourceDiagnosis(
 func (r *EnvironmentRepository) GetProjectServiceQuotaUsage(
 	ctx context.Context, id, serviceID int,
 ) (*dto.QuotaUsage, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "GetProjectServiceQuotaUsage")
+
 	query := `
 		SELECT ps.max_requests,
 			COALESCE((
@@ -338,29 +344,63 @@ func (r *EnvironmentRepository) GetProjectServiceQuotaUsage(
 	return quota, r.errorMapper(err, r.tableName)
 }
 
-func (r *EnvironmentRepository) IncreaseAvailableRequest(
-	ctx context.Context, id, serviceID int,
-) errors.Error {
-	query := `
-		UPDATE environment_service
+// increaseAvailableRequestQuery gives $3 units back to environment $1 and
+// service $2 and to its project pool, never above their max requests. It
+// returns how many environment services were found.
+const increaseAvailableRequestQuery = `
+	WITH target AS (
+		SELECT es.environment_id, es.service_id, ps.project_id
+		FROM environment_service es
+			JOIN environment e
+				ON e.id = es.environment_id
+			JOIN project_service ps
+				ON ps.project_id = e.project_id AND ps.service_id = es.service_id
+		WHERE es.environment_id = $1 AND es.service_id = $2
+		FOR UPDATE OF es, ps
+	),
+	environment_updated AS (
+		UPDATE environment_service es
 		SET available_request =
 			CASE
-				WHEN available_request > 0
-				THEN available_request + 1
-				ELSE available_request
+				WHEN es.max_requests = -1
+				THEN es.available_request
+				ELSE LEAST(es.available_request + $3, es.max_requests)
 			END
-		WHERE environment_id = $1 AND service_id = $2
-			AND available_request > 0
-		RETURNING max_requests, available_request, -1;
-	`
+		FROM target t
+		WHERE es.environment_id = t.environment_id
+			AND es.service_id = t.service_id
+		RETURNING es.environment_id
+	),
+	project_updated AS (
+		UPDATE project_service ps
+		SET available_request =
+			CASE
+				WHEN ps.max_requests = -1
+				THEN ps.available_request
+				ELSE LEAST(ps.available_request + $3, ps.max_requests)
+			END
+		FROM target t
+		WHERE ps.project_id = t.project_id AND ps.service_id = t.service_id
+	)
+	SELECT COUNT(*) FROM environment_updated;
+`
 
-	result, err := r.pool.Exec(
-		ctx, query, id, serviceID)
+// IncreaseAvailableRequest gives units back to both the environment
+// service and its project pool, never above their max requests.
+func (r *EnvironmentRepository) IncreaseAvailableRequest(
+	ctx context.Context, id, serviceID, units int,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "IncreaseAvailableRequest")
+
+	var updated int
+	err := r.pool.QueryRow(
+		ctx, increaseAvailableRequestQuery, id, serviceID, units,
+	).Scan(&updated)
 	if err != nil {
 		return r.errorMapper(err, r.auxServiceTableName)
 	}
 
-	if result.RowsAffected() == 0 {
+	if updated == 0 {
 		return r.entityNotFoundError(
 			r.auxServiceTableName,
 			map[string]any{"environment_id": id, "service_id": serviceID},
@@ -370,39 +410,116 @@ func (r *EnvironmentRepository) IncreaseAvailableRequest(
 	return nil
 }
 
+// DecrementAvailableRequest consumes units from the environment service
+// and from its project pool, or from neither if either has fewer left.
+// Both rows are locked, so concurrent calls cannot overdraw the pool
+// shared by the environments of a project.
 func (r *EnvironmentRepository) DecrementAvailableRequest(
-	ctx context.Context, id, serviceID int,
+	ctx context.Context, id, serviceID, units int,
 ) (*dto.DecrementAvailableRequest, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "DecrementAvailableRequest")
+
 	query := `
-		UPDATE environment_service
-		SET available_request =
-			CASE
-				WHEN available_request > 0
-				THEN available_request - 1
-				ELSE available_request
-			END
-		WHERE environment_id = $1 AND service_id = $2
-			AND available_request > 0
-		RETURNING max_requests, available_request;
+		WITH target AS (
+			SELECT es.environment_id, es.service_id, ps.project_id,
+				es.max_requests, ps.max_requests AS project_max_requests,
+				es.available_request >= $3 OR es.max_requests = -1
+					AS environment_available,
+				ps.available_request >= $3 OR ps.max_requests = -1
+					AS project_available
+			FROM environment_service es
+				JOIN environment e
+					ON e.id = es.environment_id
+				JOIN project_service ps
+					ON ps.project_id = e.project_id AND ps.service_id = es.service_id
+			WHERE es.environment_id = $1 AND es.service_id = $2
+			FOR UPDATE OF es, ps
+		),
+		environment_updated AS (
+			UPDATE environment_service es
+			SET available_request =
+				CASE
+					WHEN es.max_requests = -1
+					THEN es.available_request
+					ELSE es.available_request - $3
+				END
+			FROM target t
+			WHERE es.environment_id = t.environment_id
+				AND es.service_id = t.service_id
+				AND t.environment_available AND t.project_available
+			RETURNING es.available_request
+		),
+		project_updated AS (
+			UPDATE project_service ps
+			SET available_request =
+				CASE
+					WHEN ps.max_requests = -1
+					THEN ps.available_request
+					ELSE ps.available_request - $3
+				END
+			FROM target t
+			WHERE ps.project_id = t.project_id AND ps.service_id = t.service_id
+				AND t.environment_available AND t.project_available
+			RETURNING ps.available_request
+		)
+		SELECT t.max_requests, t.project_max_requests,
+			t.environment_available, t.project_available,
+			COALESCE(eu.available_request, 0), COALESCE(pu.available_request, 0)
+		FROM target t
+			LEFT JOIN environment_updated eu ON TRUE
+			LEFT JOIN project_updated pu ON TRUE;
 	`
 
+	var environmentAvailable, projectAvailable bool
+	var environmentRequests, projectRequests, projectMaxRequests int
+
 	result := new(dto.DecrementAvailableRequest)
-	err := r.pool.QueryRow(ctx, query, id, serviceID).
+	err := r.pool.QueryRow(ctx, query, id, serviceID, units).
 		Scan(
 			&result.MaxRequests,
-			&result.AvailableRequest,
+			&projectMaxRequests,
+			&environmentAvailable,
+			&projectAvailable,
+			&environmentRequests,
+			&projectRequests,
 		)
 
 	if err != nil {
 		return nil, r.errorMapper(err, r.auxServiceTableName)
 	}
 
+	switch {
+	case !environmentAvailable:
+		result.ExceededLevel = enums.QuotaLevelEnvironment
+	case !projectAvailable:
+		result.ExceededLevel = enums.QuotaLevelProject
+	case projectRequests == -1:
+		result.AvailableRequest = environmentRequests
+	case environmentRequests == -1:
+		result.AvailableRequest = projectRequests
+	default:
+		result.AvailableRequest = min(environmentRequests, projectRequests)
+	}
+
+	if result.ExceededLevel == enums.QuotaLevelNull {
+		result.Environment = dto.QuotaBalance{
+			MaxRequests:      result.MaxRequests,
+			AvailableRequest: environmentRequests,
+		}
+		result.Project = dto.QuotaBalance{
+			MaxRequests:      projectMaxRequests,
+			AvailableRequest: projectRequests,
+		}
+	}
+
 	return result, nil
 }
 
 func (r *EnvironmentRepository) ExistsServiceIn(
 	ctx context.Context, id, serviceID int,
 ) (bool, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "ExistsServiceIn")
+
 	query := `
 		SELECT EXISTS (
 			SELECT 1
@@ -423,6 +540,8 @@ func (r *EnvironmentRepository) ExistsServiceIn(
 func (r *EnvironmentRepository) GetServiceByID(
 	ctx context.Context, id, serviceID int,
 ) (*entities.EnvironmentService, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "GetServiceByID")
+
 	query := `
 		SELECT s.id, s.name, s.version, es.created_at,
 			es.max_requests, es.available_request
@@ -450,8 +569,10 @@ func (r *EnvironmentRepository) GetServiceByID(
 func (r *EnvironmentRepository) GetByID(
 	ctx context.Context, id int,
 ) (*entities.Environment, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "GetByID")
+
 	query := `
-		SELECT e.id, e.name, e.status, e.project_id, e.created_at,
+		SELECT e.id, e.name, e.type, e.status, e.project_id, e.created_at,
 			COALESCE(
 				JSON_AGG(
 					JSON_BUILD_OBJECT(
@@ -477,6 +598,7 @@ func (r *EnvironmentRepository) GetByID(
 	err := r.pool.QueryRow(ctx, query, id).Scan(
 		&environment.ID,
 		&environment.Name,
+		&environment.Type,
 		&environment.Status,
 		&environment.ProjectID,
 		&environment.CreatedAt,
@@ -490,10 +612,25 @@ func (r *EnvironmentRepository) GetByID(
 }
 
 func (r *EnvironmentRepository) ListByProject(
-	ctx context.Context, projectID int,
+	ctx context.Context, projectID int, page *dto.Pagination,
 ) ([]*entities.Environment, errors.Error) {
-	query := `
-		SELECT e.id, e.name, e.status, e.project_id, e.created_at,
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "ListByProject")
+
+	where, args, clauses, pageErr := paginate(
+		page,
+		"e.created_at",
+		"e.id",
+		true,
+		[]string{"e.project_id = $1"},
+		[]any{projectID},
+	)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
+	query := fmt.Sprintf(
+		`
+		SELECT e.id, e.name, e.type, e.status, e.project_id, e.created_at,
 			COALESCE(
 				JSON_AGG(
 					JSON_BUILD_OBJECT(
@@ -508,18 +645,18 @@ func (r *EnvironmentRepository) ListByProject(
 				) FILTER (WHERE s.id IS NOT NULL), '[]'
 			)
 		FROM environment e
-			JOIN project p
-				ON p.id = e.project_id
 			LEFT JOIN environment_service es
 				ON es.environment_id = e.id
 			LEFT JOIN service s
 				ON s.id = es.service_id
-		WHERE p.id = $1
-		GROUP BY e.id
-		ORDER BY created_at DESC;
-	`
+		WHERE %s
+		GROUP BY e.id%s;
+		`,
+		strings.Join(where, " AND "),
+		clauses,
+	)
 
-	rows, err := r.pool.Query(ctx, query, projectID)
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, r.errorMapper(err, r.tableName)
 	}
@@ -533,6 +670,7 @@ func (r *EnvironmentRepository) ListByProject(
 		err = rows.Scan(
 			&environment.ID,
 			&environment.Name,
+			&environment.Type,
 			&environment.Status,
 			&environment.ProjectID,
 			&environment.CreatedAt,
@@ -552,9 +690,30 @@ func (r *EnvironmentRepository) ListByProject(
 	return environments, nil
 }
 
+func (r *EnvironmentRepository) CountByProject(
+	ctx context.Context, projectID int,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "CountByProject")
+
+	query := `
+		SELECT count(*)
+		FROM environment
+		WHERE project_id = $1;
+	`
+
+	var total int
+	if err := r.pool.QueryRow(ctx, query, projectID).Scan(&total); err != nil {
+		return 0, r.errorMapper(err, r.tableName)
+	}
+
+	return total, nil
+}
+
 func (r *EnvironmentRepository) AddService(
 	ctx context.Context, id int, service *entities.EnvironmentService,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "AddService")
+
 	query := `
 		WITH inserted AS (
 			INSERT INTO environment_service (
@@ -583,6 +742,8 @@ func (r *EnvironmentRepository) AddService(
 func (r *EnvironmentRepository) Create(
 	ctx context.Context, environment *entities.Environment,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "EnvironmentRepository", "Create")
+
 	tx, txErr := r.pool.Begin(ctx)
 	if txErr != nil {
 		return r.errorMapper(txErr, r.tableName)
@@ -609,8 +770,8 @@ func (r *EnvironmentRepository) createEnvironment(
 	ctx context.Context, tx pgx.Tx, environment *entities.Environment,
 ) errors.Error {
 	query := `
-		INSERT INTO environment (project_id, name, status)
-		VALUES ($1, $2, $3) RETURNING id, created_at;
+		INSERT INTO environment (project_id, name, type, status)
+		VALUES ($1, $2, $3, $4) RETURNING id, created_at;
 	`
 
 	err := tx.QueryRow(
@@ -618,6 +779,7 @@ func (r *EnvironmentRepository) createEnvironment(
 		query,
 		environment.ProjectID,
 		environment.Name,
+		environment.Type,
 		environment.Status,
 	).Scan(&environment.ID, &environment.CreatedAt)
 
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// paginate adds to where the keyset condition that skips the rows up to the
+// page cursor, and returns the ORDER BY and LIMIT clauses of the page, newest
+// first. One row more than the page holds is fetched so the caller can tell
+// whether a next page exists. The keyset columns are expected to be indexed
+// by created_at DESC.
+func paginate(
+	page *dto.Pagination,
+	createdAtColumn, idColumn string,
+	intID bool,
+	where []string,
+	args []any,
+) ([]string, []any, string, errors.Error) {
+	return paginateBy(
+		page, createdAtColumn, idColumn, intID, false, where, args,
+	)
+}
+
+// paginateBy is paginate for lists that can be sorted by another time
+// column or in ascending order.
+func paginateBy(
+	page *dto.Pagination,
+	timeColumn, idColumn string,
+	intID, ascending bool,
+	where []string,
+	args []any,
+) ([]string, []any, string, errors.Error) {
+	cursor, err := page.DecodeCursor()
+	if err != nil {
+		return nil, nil, "", invalidCursorError(err)
+	}
+
+	comparison, order := "<", "DESC"
+	if ascending {
+		comparison, order = ">", "ASC"
+	}
+
+	if cursor != nil {
+		var id any = cursor.ID
+		if intID {
+			intValue, err := strconv.Atoi(cursor.ID)
+			if err != nil {
+				return nil, nil, "", invalidCursorError(err)
+			}
+			id = intValue
+		}
+
+		where = append(
+			where,
+			fmt.Sprintf(
+				"(%s, %s) %s ($%d, $%d)",
+				timeColumn, idColumn, comparison, len(args)+1, len(args)+2,
+			),
+		)
+		args = append(args, cursor.Time, id)
+	}
+
+	clauses := fmt.Sprintf(
+		" ORDER BY %s %s, %s %s LIMIT %d",
+		timeColumn, order, idColumn, order, page.PageLimit()+1,
+	)
+	return where, args, clauses, nil
+}
+
+func invalidCursorError(err error) errors.Error {
+	return errors.NewAttributeValidationFailed(
+		"Pagination", "cursor", "cursor is invalid", err,
+	)
+}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/exaring/otelpgx"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/multitracer"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 
@@ -41,6 +43,22 @@ func (d *Driver) entityMapper(table string) string {
 		return "Request"
 	case "reservation":
 		return "Reservation"
+	case "rate_limit":
+		return "RateLimit"
+	case "request_usage_hourly", "request_usage_daily":
+		return "Usage"
+	case "webhook":
+		return "Webhook"
+	case "webhook_delivery":
+		return "WebhookDelivery"
+	case "quota_alert":
+		return "QuotaAlert"
+	case "audit_log":
+		return "AuditEntry"
+	case "admin_user":
+		return "Credentials"
+	case "client_token":
+		return "ClientToken"
 	default:
 		return table
 	}
@@ -107,6 +125,12 @@ func (d *Driver) errorMapper(err error, tableName string) domainErr.Error {
 	return domainErr.NewInternal(err.Error(), err)
 }
 
+// isPgError reports whether err is a PostgreSQL error with the code.
+func isPgError(err error, code string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
+
 func (d *Driver) entityNotFoundError(
 	tableName string, identifiers map[string]any,
 ) domainErr.Error {
@@ -123,6 +147,7 @@ func NewDriver(dns string) *Driver {
 	}
 
 	config.HealthCheckPeriod = 1 * time.Minute
+	config.ConnConfig.Tracer = multitracer.New(queryTracer{}, otelpgx.NewTracer())
 
 	pool, err := pgxpool.NewWithConfig(context.Background(), config)
 	if err != nil {
@@ -24,6 +24,8 @@ type ProjectRepository struct {
 func (r *ProjectRepository) ListProjectServiceDueForReset(
 	ctx context.Context, today time.Time,
 ) ([]*entities.Project, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "ListProjectServiceDueForReset")
+
 	query := `
 		SELECT p.id, p.name, p.status, p.client_id, p.created_at,
 			COALESCE(
@@ -34,6 +36,7 @@ func (r *ProjectRepository) ListProjectServiceDueForReset(
 						'version', s.version,
 						'nextReset', ps.next_reset,
 						'maxRequests', ps.max_requests,
+						'availableRequest', ps.available_request,
 						'resetFrequency', ps.reset_frequency,
 						'assignedAt', ps.created_at
 					)
@@ -84,6 +87,8 @@ func (r *ProjectRepository) ListProjectServiceDueForReset(
 func (r *ProjectRepository) Delete(
 	ctx context.Context, id int,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "Delete")
+
 	query := `
 		DELETE FROM project
 		WHERE id = $1;
@@ -104,6 +109,8 @@ func (r *ProjectRepository) Delete(
 func (r *ProjectRepository) GetProjectClientInfoByID(
 	ctx context.Context, id int,
 ) (*dto.ProjectClientInfoResponse, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "GetProjectClientInfoByID")
+
 	query := `
 		SELECT p.id, p.name, c.id, c.name
 		FROM project p
@@ -124,14 +131,39 @@ func (r *ProjectRepository) GetProjectClientInfoByID(
 func (r *ProjectRepository) ResetAvailableRequestsForEnvsService(
 	ctx context.Context, id, serviceID int,
 ) ([]*dto.EnvironmentServiceReset, errors.Error) {
-	return r.resetAvailableRequestsForEnvsService(
-		ctx, nil, id, serviceID,
+	ctx = withQueryLabel(ctx, "ProjectRepository", "ResetAvailableRequestsForEnvsService")
+
+	tx, txErr := r.pool.Begin(ctx)
+	if txErr != nil {
+		return nil, r.errorMapper(txErr, r.tableName)
+	}
+
+	if err := r.resetAvailableRequest(ctx, tx, id, serviceID); err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+
+	environmentsService, err := r.resetAvailableRequestsForEnvsService(
+		ctx, tx, id, serviceID,
 	)
+	if err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+
+	if err := r.resetQuotaAlerts(ctx, tx, id, serviceID); err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+
+	return environmentsService, r.errorMapper(tx.Commit(ctx), r.tableName)
 }
 
 func (r *ProjectRepository) ResetProjectServiceUsage(
 	ctx context.Context, id, serviceID int, nextReset time.Time,
 ) ([]*dto.EnvironmentServiceReset, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "ResetProjectServiceUsage")
+
 	tx, txErr := r.pool.Begin(ctx)
 	if txErr != nil {
 		return nil, r.errorMapper(txErr, r.tableName)
@@ -142,6 +174,11 @@ func (r *ProjectRepository) ResetProjectServiceUsage(
 		return nil, err
 	}
 
+	if err := r.resetAvailableRequest(ctx, tx, id, serviceID); err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+
 	environmentsService, err := r.resetAvailableRequestsForEnvsService(
 		ctx, tx, id, serviceID,
 	)
@@ -150,6 +187,11 @@ func (r *ProjectRepository) ResetProjectServiceUsage(
 		return nil, err
 	}
 
+	if err := r.resetQuotaAlerts(ctx, tx, id, serviceID); err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+
 	return environmentsService, r.errorMapper(tx.Commit(ctx), r.tableName)
 }
 
@@ -182,6 +224,30 @@ func (r *ProjectRepository) updateNextReset(
 	return nil
 }
 
+func (r *ProjectRepository) resetAvailableRequest(
+	ctx context.Context, tx pgx.Tx, id, serviceID int,
+) errors.Error {
+	query := `
+		UPDATE project_service
+		SET available_request = max_requests
+		WHERE project_id = $1 AND service_id = $2;
+	`
+
+	result, err := tx.Exec(ctx, query, id, serviceID)
+	if err != nil {
+		return r.errorMapper(err, r.auxServiceTableName)
+	}
+
+	if result.RowsAffected() == 0 {
+		return r.entityNotFoundError(
+			r.auxServiceTableName,
+			map[string]any{"project_id": id, "service_id": serviceID},
+		)
+	}
+
+	return nil
+}
+
 func (r *ProjectRepository) resetAvailableRequestsForEnvsService(
 	ctx context.Context, tx pgx.Tx, id, serviceID int,
 ) ([]*dto.EnvironmentServiceReset, errors.Error) {
@@ -207,14 +273,7 @@ func (r *ProjectRepository) resetAvailableRequestsForEnvsService(
 			JOIN service s ON s.id = u.service_id;
 	`
 
-	var err error
-	var rows pgx.Rows
-	if tx != nil {
-		rows, err = tx.Query(ctx, query, id, serviceID)
-	} else {
-		rows, err = r.pool.Query(ctx, query, id, serviceID)
-	}
-
+	rows, err := tx.Query(ctx, query, id, serviceID)
 	if err != nil {
 		return nil, r.errorMapper(err, r.tableName)
 	}
@@ -245,12 +304,36 @@ func (r *ProjectRepository) resetAvailableRequestsForEnvsService(
 	return environmentsService, nil
 }
 
+// resetQuotaAlerts lets the quota alerts of the service in the project's
+// environments trigger again, as both the project and the environment
+// quotas they watch have been reset.
+func (r *ProjectRepository) resetQuotaAlerts(
+	ctx context.Context, tx pgx.Tx, id, serviceID int,
+) errors.Error {
+	query := `
+		UPDATE quota_alert qa
+		SET triggered_at = NULL
+		FROM environment e
+		WHERE qa.environment_id = e.id AND e.project_id = $1
+			AND qa.service_id = $2 AND qa.triggered_at IS NOT NULL;
+	`
+
+	if _, err := tx.Exec(ctx, query, id, serviceID); err != nil {
+		return r.errorMapper(err, "quota_alert")
+	}
+
+	return nil
+}
+
 func (r *ProjectRepository) GetServiceByID(
 	ctx context.Context, id, serviceID int,
 ) (*entities.ProjectService, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "GetServiceByID")
+
 	query := `
 		SELECT s.id, s.name, s.version, ps.max_requests,
-			ps.reset_frequency, ps.next_reset, ps.created_at
+			ps.available_request, ps.reset_frequency, ps.next_reset,
+			ps.created_at
 		FROM project_service ps
 			JOIN service s
 				ON s.id = ps.service_id
@@ -263,6 +346,7 @@ func (r *ProjectRepository) GetServiceByID(
 		&service.Name,
 		&service.Version,
 		&service.MaxRequests,
+		&service.AvailableRequest,
 		&service.ResetFrequency,
 		&service.NextReset,
 		&service.AssignedAt,
@@ -277,11 +361,23 @@ func (r *ProjectRepository) GetServiceByID(
 func (r *ProjectRepository) UpdateService(
 	ctx context.Context, id, serviceID int, update *dto.ProjectServiceUpdate,
 ) (*entities.ProjectService, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "UpdateService")
+
 	if update == nil {
 		return r.GetServiceByID(ctx, id, serviceID)
 	}
 
-	updates := []string{"max_requests = $3"}
+	// Lowering max_requests caps what is left of the current period, as
+	// environment services do.
+	updates := []string{
+		"max_requests = $3",
+		`available_request =
+			CASE
+				WHEN $3 = -1 OR available_request = -1 OR available_request > $3
+				THEN $3
+				ELSE available_request
+			END`,
+	}
 	args := []any{id, serviceID, update.MaxRequests}
 	argIndex := 4
 
@@ -306,7 +402,8 @@ func (r *ProjectRepository) UpdateService(
 				RETURNING *
 			)
 			SELECT s.id, s.name, s.version, u.max_requests,
-				u.reset_frequency, u.next_reset, u.created_at
+				u.available_request, u.reset_frequency, u.next_reset,
+				u.created_at
 			FROM updated u
 				JOIN service s ON s.id = u.service_id;
 		`,
@@ -320,6 +417,7 @@ func (r *ProjectRepository) UpdateService(
 			&service.Name,
 			&service.Version,
 			&service.MaxRequests,
+			&service.AvailableRequest,
 			&service.ResetFrequency,
 			&service.NextReset,
 			&service.AssignedAt,
@@ -334,6 +432,8 @@ func (r *ProjectRepository) UpdateService(
 func (r *ProjectRepository) ExistsServiceIn(
 	ctx context.Context, serviceID int,
 ) (bool, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "ExistsServiceIn")
+
 	query := `
 		SELECT EXISTS (
 			SELECT 1
@@ -354,6 +454,8 @@ func (r *ProjectRepository) ExistsServiceIn(
 func (r *ProjectRepository) RemoveService(
 	ctx context.Context, id, serviceID int,
 ) (int64, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "RemoveService")
+
 	query := `
 		DELETE FROM project_service
 		WHERE project_id = $1 AND service_id = $2;
@@ -370,6 +472,8 @@ func (r *ProjectRepository) RemoveService(
 func (r *ProjectRepository) UpdateStatus(
 	ctx context.Context, id int, status enums.ProjectStatus,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "UpdateStatus")
+
 	query := `
 		UPDATE project
 		SET status = $1
@@ -391,6 +495,8 @@ func (r *ProjectRepository) UpdateStatus(
 func (r *ProjectRepository) Update(
 	ctx context.Context, id int, update *dto.ProjectUpdate,
 ) (*entities.Project, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "Update")
+
 	if update == nil {
 		return r.GetByID(ctx, id)
 	}
@@ -433,6 +539,8 @@ func (r *ProjectRepository) Update(
 func (r *ProjectRepository) Exists(
 	ctx context.Context, id int,
 ) (bool, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "Exists")
+
 	query := `
 		SELECT EXISTS (
 			SELECT 1
@@ -453,6 +561,8 @@ func (r *ProjectRepository) Exists(
 func (r *ProjectRepository) GetProjectServiceQuotaUsage(
 	ctx context.Context, id, serviceID int,
 ) (*dto.QuotaUsage, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "GetProjectServiceQuotaUsage")
+
 	query := `
 		SELECT ps.max_requests,
 			COALESCE((
@@ -477,6 +587,8 @@ func (r *ProjectRepository) GetProjectServiceQuotaUsage(
 func (r *ProjectRepository) GetByID(
 	ctx context.Context, id int,
 ) (*entities.Project, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "GetByID")
+
 	query := `
 		SELECT p.id, p.name, p.status, p.client_id, p.created_at,
 			COALESCE(
@@ -487,6 +599,7 @@ func (r *ProjectRepository) GetByID(
 						'version', s.version,
 						'nextReset', ps.next_reset,
 						'maxRequests', ps.max_requests,
+						'availableRequest', ps.available_request,
 						'resetFrequency', ps.reset_frequency,
 						'assignedAt', ps.created_at
 					)
@@ -517,7 +630,18 @@ func (r *ProjectRepository) GetByID(
 	return project, nil
 }
 
-func (r *ProjectRepository) List(ctx context.Context) ([]*entities.Project, errors.Error) {
+func (r *ProjectRepository) List(
+	ctx context.Context, page *dto.Pagination,
+) ([]*entities.Project, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "List")
+
+	where, args, clauses, pageErr := paginate(
+		page, "p.created_at", "p.id", true, nil, nil,
+	)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
 	query := `
 		SELECT p.id, p.name, p.status, p.client_id, p.created_at,
 			COALESCE(
@@ -528,6 +652,7 @@ func (r *ProjectRepository) List(ctx context.Context) ([]*entities.Project, erro
 						'version', s.version,
 						'nextReset', ps.next_reset,
 						'maxRequests', ps.max_requests,
+						'availableRequest', ps.available_request,
 						'resetFrequency', ps.reset_frequency,
 						'assignedAt', ps.created_at
 					)
@@ -539,11 +664,15 @@ func (r *ProjectRepository) List(ctx context.Context) ([]*entities.Project, erro
 				ON ps.project_id = p.id
 			LEFT JOIN service s
 				ON s.id = ps.service_id
-		GROUP BY p.id
-		ORDER BY created_at DESC;
 	`
 
-	rows, err := r.pool.Query(ctx, query)
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
+
+	query += " GROUP BY p.id" + clauses + ";"
+
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, r.errorMapper(err, r.tableName)
 	}
@@ -576,10 +705,41 @@ func (r *ProjectRepository) List(ctx context.Context) ([]*entities.Project, erro
 	return projects, nil
 }
 
+func (r *ProjectRepository) Count(ctx context.Context) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "Count")
+
+	query := `
+		SELECT count(*)
+		FROM project;
+	`
+
+	var total int
+	if err := r.pool.QueryRow(ctx, query).Scan(&total); err != nil {
+		return 0, r.errorMapper(err, r.tableName)
+	}
+
+	return total, nil
+}
+
 func (r *ProjectRepository) ListByClient(
-	ctx context.Context, clientID int,
+	ctx context.Context, clientID int, page *dto.Pagination,
 ) ([]*entities.Project, errors.Error) {
-	query := `
+	ctx = withQueryLabel(ctx, "ProjectRepository", "ListByClient")
+
+	where, args, clauses, pageErr := paginate(
+		page,
+		"p.created_at",
+		"p.id",
+		true,
+		[]string{"p.client_id = $1"},
+		[]any{clientID},
+	)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
+	query := fmt.Sprintf(
+		`
 		SELECT p.id, p.name, p.status, p.client_id, p.created_at,
 			COALESCE(
 				JSON_AGG(
@@ -589,6 +749,7 @@ func (r *ProjectRepository) ListByClient(
 						'version', s.version,
 						'nextReset', ps.next_reset,
 						'maxRequests', ps.max_requests,
+						'availableRequest', ps.available_request,
 						'resetFrequency', ps.reset_frequency,
 						'assignedAt', ps.created_at
 					)
@@ -596,18 +757,18 @@ func (r *ProjectRepository) ListByClient(
 				) FILTER (WHERE s.id IS NOT NULL), '[]'
 			)
 		FROM project p
-			JOIN client c
-				ON c.id = p.client_id
 			LEFT JOIN project_service ps
 				ON ps.project_id = p.id
 			LEFT JOIN service s
 				ON s.id = ps.service_id
-		WHERE c.id = $1
-		GROUP BY p.id
-		ORDER BY created_at DESC;
-	`
+		WHERE %s
+		GROUP BY p.id%s;
+		`,
+		strings.Join(where, " AND "),
+		clauses,
+	)
 
-	rows, err := r.pool.Query(ctx, query, clientID)
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, r.errorMapper(err, r.tableName)
 	}
@@ -640,16 +801,37 @@ func (r *ProjectRepository) ListByClient(
 	return projects, nil
 }
 
+func (r *ProjectRepository) CountByClient(
+	ctx context.Context, clientID int,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "CountByClient")
+
+	query := `
+		SELECT count(*)
+		FROM project
+		WHERE client_id = $1;
+	`
+
+	var total int
+	if err := r.pool.QueryRow(ctx, query, clientID).Scan(&total); err != nil {
+		return 0, r.errorMapper(err, r.tableName)
+	}
+
+	return total, nil
+}
+
 func (r *ProjectRepository) AddService(
 	ctx context.Context, id int, service *entities.ProjectService,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "AddService")
+
 	query := `
 		WITH inserted AS (
 			INSERT INTO project_service (
 				project_id, service_id, max_requests,
-				reset_frequency, next_reset
+				available_request, reset_frequency, next_reset
 			)
-			VALUES ($1, $2, $3, $4, $5)
+			VALUES ($1, $2, $3, $4, $5, $6)
 			RETURNING service_id, created_at
 		)
 		SELECT s.name, s.version, i.created_at
@@ -664,6 +846,7 @@ func (r *ProjectRepository) AddService(
 		id,
 		service.ID,
 		service.MaxRequests,
+		service.AvailableRequest,
 		service.ResetFrequency,
 		service.NextReset,
 	).Scan(&service.Name, &service.Version, &service.AssignedAt)
@@ -674,6 +857,8 @@ func (r *ProjectRepository) AddService(
 func (r *ProjectRepository) Create(
 	ctx context.Context, project *entities.Project,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "ProjectRepository", "Create")
+
 	tx, txErr := r.pool.Begin(ctx)
 	if txErr != nil {
 		return r.errorMapper(txErr, r.tableName)
@@ -733,12 +918,13 @@ func (r *ProjectRepository) createProjectServices(
 		values = append(
 			values,
 			fmt.Sprintf(
-				"($%d, $%d, $%d, $%d, $%d)",
+				"($%d, $%d, $%d, $%d, $%d, $%d)",
 				argIndex,
 				argIndex+1,
 				argIndex+2,
 				argIndex+3,
 				argIndex+4,
+				argIndex+5,
 			),
 		)
 
@@ -747,10 +933,11 @@ func (r *ProjectRepository) createProjectServices(
 			projectID,
 			service.ID,
 			service.MaxRequests,
+			service.AvailableRequest,
 			service.ResetFrequency,
 			service.NextReset,
 		)
-		argIndex += 5
+		argIndex += 6
 	}
 
 	query := fmt.Sprintf(
@@ -758,13 +945,14 @@ func (r *ProjectRepository) createProjectServices(
 			WITH inserted AS (
 				INSERT INTO project_service (
 					project_id, service_id, max_requests,
-					reset_frequency, next_reset
+					available_request, reset_frequency, next_reset
 				)
 				VALUES %s
 				RETURNING *
 			)
 			SELECT s.id, s.name, s.version, i.created_at,
-				i.reset_frequency, i.max_requests, i.next_reset
+				i.reset_frequency, i.max_requests, i.available_request,
+				i.next_reset
 			FROM inserted i
 				JOIN service s ON i.service_id = s.id;
 		`,
@@ -789,6 +977,7 @@ func (r *ProjectRepository) createProjectServices(
 			&service.AssignedAt,
 			&service.ResetFrequency,
 			&service.MaxRequests,
+			&service.AvailableRequest,
 			&service.NextReset,
 		)
 		if err != nil {
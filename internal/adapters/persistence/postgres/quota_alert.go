@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type QuotaAlertRepository struct {
+	*Driver
+
+	tableName string
+}
+
+func (r *QuotaAlertRepository) ListByEnvironmentService(
+	ctx context.Context, environmentID, serviceID int,
+) ([]*entities.QuotaAlert, errors.Error) {
+	ctx = withQueryLabel(ctx, "QuotaAlertRepository", "ListByEnvironmentService")
+
+	query := `
+		SELECT id, environment_id, service_id, level, threshold,
+			COALESCE(triggered_at, '0001-01-01 00:00:00.0+00'), created_at
+		FROM quota_alert
+		WHERE environment_id = $1 AND service_id = $2
+		ORDER BY level, threshold;
+	`
+
+	return r.list(ctx, query, environmentID, serviceID)
+}
+
+func (r *QuotaAlertRepository) Create(
+	ctx context.Context, alert *entities.QuotaAlert,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "QuotaAlertRepository", "Create")
+
+	query := `
+		INSERT INTO quota_alert (environment_id, service_id, level, threshold)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at;
+	`
+
+	err := r.pool.QueryRow(
+		ctx,
+		query,
+		alert.EnvironmentID,
+		alert.ServiceID,
+		alert.Level,
+		alert.Threshold,
+	).Scan(&alert.ID, &alert.CreatedAt)
+
+	return r.errorMapper(err, r.tableName)
+}
+
+// Trigger marks as triggered the alerts of the environment service that
+// have not triggered since their quota was reset and whose threshold is
+// at most the percentage consumed of the quota they watch, and returns
+// them. A percentage of 0 triggers no alert of its level. Alerts are only
+// returned by the call that triggered them.
+func (r *QuotaAlertRepository) Trigger(
+	ctx context.Context,
+	environmentID, serviceID, environmentPercent, projectPercent int,
+) ([]*entities.QuotaAlert, errors.Error) {
+	ctx = withQueryLabel(ctx, "QuotaAlertRepository", "Trigger")
+
+	query := `
+		UPDATE quota_alert
+		SET triggered_at = NOW()
+		WHERE environment_id = $1 AND service_id = $2
+			AND triggered_at IS NULL
+			AND (
+				(level = 'environment' AND threshold <= $3)
+				OR (level = 'project' AND threshold <= $4)
+			)
+		RETURNING id, environment_id, service_id, level, threshold,
+			triggered_at, created_at;
+	`
+
+	return r.list(
+		ctx, query, environmentID, serviceID, environmentPercent, projectPercent,
+	)
+}
+
+func (r *QuotaAlertRepository) Delete(
+	ctx context.Context, environmentID, serviceID, id int,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "QuotaAlertRepository", "Delete")
+
+	query := `
+		DELETE FROM quota_alert
+		WHERE id = $3 AND environment_id = $1 AND service_id = $2;
+	`
+
+	result, err := r.pool.Exec(ctx, query, environmentID, serviceID, id)
+	if err != nil {
+		return r.errorMapper(err, r.tableName)
+	}
+
+	if result.RowsAffected() == 0 {
+		return r.entityNotFoundError(r.tableName, map[string]any{"id": id})
+	}
+
+	return nil
+}
+
+func (r *QuotaAlertRepository) list(
+	ctx context.Context, query string, args ...any,
+) ([]*entities.QuotaAlert, errors.Error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	defer rows.Close()
+
+	var alerts []*entities.QuotaAlert
+	for rows.Next() {
+		alert, err := scanQuotaAlert(rows)
+		if err != nil {
+			return nil, r.errorMapper(err, r.tableName)
+		}
+
+		alerts = append(alerts, alert)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return alerts, nil
+}
+
+func scanQuotaAlert(row pgx.Row) (*entities.QuotaAlert, error) {
+	alert := new(entities.QuotaAlert)
+	err := row.Scan(
+		&alert.ID,
+		&alert.EnvironmentID,
+		&alert.ServiceID,
+		&alert.Level,
+		&alert.Threshold,
+		&alert.TriggeredAt,
+		&alert.CreatedAt,
+	)
+	return alert, err
+}
+
+func NewQuotaAlertRepository(driver *Driver) *QuotaAlertRepository {
+	return &QuotaAlertRepository{
+		Driver:    driver,
+		tableName: "quota_alert",
+	}
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// RateLimiter shares the theoretical arrival time of each key between
+// every instance through the rate_limit table. Times come from the
+// database clock so instances with skewed clocks agree.
+type RateLimiter struct {
+	*Driver
+
+	tableName string
+}
+
+func (r *RateLimiter) Allow(
+	ctx context.Context, key string, limit *entities.APIKeyRateLimit,
+) (time.Duration, errors.Error) {
+	ctx = withQueryLabel(ctx, "RateLimiter", "Allow")
+
+	query := `
+		INSERT INTO rate_limit AS rl (key, tat)
+		VALUES ($1, NOW() + $2 * INTERVAL '1 microsecond')
+		ON CONFLICT (key) DO UPDATE
+		SET tat = GREATEST(rl.tat, NOW()) + $2 * INTERVAL '1 microsecond'
+		WHERE GREATEST(rl.tat, NOW()) + ($2 - $3) * INTERVAL '1 microsecond' <= NOW()
+		RETURNING tat;
+	`
+
+	interval := limit.Interval().Microseconds()
+	tolerance := interval * int64(limit.Capacity())
+
+	// No row is returned when the update is skipped, that is, when the
+	// request is over the limit.
+	var tat time.Time
+	err := r.errorMapper(
+		r.pool.QueryRow(ctx, query, key, interval, tolerance).Scan(&tat),
+		r.tableName,
+	)
+	if err == nil {
+		return 0, nil
+	}
+
+	if err.Code() != errors.CodeNotFound {
+		return 0, err
+	}
+
+	query = `
+		SELECT CEIL(
+			EXTRACT(
+				EPOCH FROM GREATEST(tat, NOW())
+					+ ($2 - $3) * INTERVAL '1 microsecond' - NOW()
+			) * 1000
+		)::BIGINT
+		FROM rate_limit
+		WHERE key = $1;
+	`
+
+	var retryAfter int64
+	scanErr := r.pool.QueryRow(ctx, query, key, interval, tolerance).Scan(&retryAfter)
+	if scanErr != nil {
+		return 0, r.errorMapper(scanErr, r.tableName)
+	}
+
+	// The bucket may have refilled between both statements.
+	return max(time.Duration(retryAfter)*time.Millisecond, time.Millisecond), nil
+}
+
+func NewRateLimiter(driver *Driver) *RateLimiter {
+	return &RateLimiter{
+		Driver:    driver,
+		tableName: "rate_limit",
+	}
+}
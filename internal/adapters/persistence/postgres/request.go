@@ -4,6 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
@@ -11,6 +16,78 @@ import (
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
+const requestColumns = `
+	id, COALESCE(start_point::text, ''), api_key, COALESCE(api_key_id, 0),
+	COALESCE(project_name, ''), COALESCE(project_id, 0),
+	COALESCE(environment_name, ''), COALESCE(environment_id, 0),
+	service_name, service_version, COALESCE(service_id, 0), COALESCE(detail, ''),
+	COALESCE(status_code, 0), execution_status, request_time, path, method, ip_address,
+	COALESCE(unauthorized_reason, ''), units, created_at
+`
+
+// scanRequest scans a row holding the requestColumns followed by the extra
+// columns.
+func scanRequest(row pgx.Row, extra ...any) (*entities.Request, error) {
+	request := &entities.Request{
+		APIKey:      &entities.RequestAPIKey{},
+		Project:     &entities.RequestProject{},
+		Environment: &entities.RequestEnvironment{},
+		Service:     &entities.RequestService{},
+	}
+
+	dest := []any{
+		&request.ID,
+		&request.StartPoint,
+		&request.APIKey.Key,
+		&request.APIKey.ID,
+		&request.Project.Name,
+		&request.Project.ID,
+		&request.Environment.Name,
+		&request.Environment.ID,
+		&request.Service.Name,
+		&request.Service.Version,
+		&request.Service.ID,
+		&request.Detail,
+		&request.StatusCode,
+		&request.ExecutionStatus,
+		&request.RequestTime,
+		&request.Path,
+		&request.Method,
+		&request.IPAddress,
+		&request.UnauthorizedReason,
+		&request.Units,
+		&request.CreatedAt,
+	}
+
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// requestMetadata is the metadata column of a request, as stored.
+type requestMetadata struct {
+	Body            string                       `json:"body"`
+	Cookies         string                       `json:"cookies"`
+	Headers         string                       `json:"headers"`
+	QueryParams     string                       `json:"queryParams"`
+	BodyContentType enums.RequestBodyContentType `json:"bodyContentType"`
+}
+
+func (m *requestMetadata) entity() *entities.RequestMetadata {
+	if m == nil {
+		return nil
+	}
+
+	return &entities.RequestMetadata{
+		Body:            m.Body,
+		Cookies:         m.Cookies,
+		Headers:         m.Headers,
+		QueryParams:     m.QueryParams,
+		BodyContentType: m.BodyContentType,
+	}
+}
+
 type RequestRepository struct {
 	*Driver
 
@@ -20,6 +97,8 @@ type RequestRepository struct {
 func (r *RequestRepository) Delete(
 	ctx context.Context, id string,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "RequestRepository", "Delete")
+
 	query := `
 		DELETE FROM request
 		WHERE id = $1;
@@ -40,6 +119,8 @@ func (r *RequestRepository) Delete(
 func (r *RequestRepository) DeleteByService(
 	ctx context.Context, serviceID int,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "RequestRepository", "DeleteByService")
+
 	query := `
 		DELETE FROM request
 		WHERE service_id = $1;
@@ -53,9 +134,94 @@ func (r *RequestRepository) DeleteByService(
 	return nil
 }
 
+// DeleteByIDs deletes the requests and seals the usage buckets of their
+// hours, so rolling up late requests does not rebuild those buckets without
+// them.
+func (r *RequestRepository) DeleteByIDs(
+	ctx context.Context, ids []string,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "RequestRepository", "DeleteByIDs")
+
+	query := `
+		WITH deleted AS (
+			DELETE FROM request
+			WHERE id = ANY($1::uuid[])
+			RETURNING request_time
+		), sealed AS (
+			UPDATE request_usage_rollup
+			SET sealed_before = GREATEST(
+				sealed_before,
+				(
+					SELECT date_trunc('hour', max(request_time), 'UTC')
+						+ interval '1 hour'
+					FROM deleted
+				)
+			)
+		)
+		SELECT count(*)
+		FROM deleted;
+	`
+
+	var deleted int
+	if err := r.pool.QueryRow(ctx, query, ids).Scan(&deleted); err != nil {
+		return 0, r.errorMapper(err, r.tableName)
+	}
+
+	return deleted, nil
+}
+
+// DropPartition detaches the partition from the request log before dropping
+// it, so only partitions of the request log can be dropped. Like DeleteByIDs,
+// it seals the usage buckets of the hours of the dropped requests.
+func (r *RequestRepository) DropPartition(
+	ctx context.Context, partition string,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "RequestRepository", "DropPartition")
+
+	name := pgx.Identifier{partition}.Sanitize()
+
+	tx, txErr := r.pool.Begin(ctx)
+	if txErr != nil {
+		return r.errorMapper(txErr, r.tableName)
+	}
+
+	_, err := tx.Exec(ctx, "ALTER TABLE request DETACH PARTITION "+name+";")
+	if err != nil {
+		tx.Rollback(ctx)
+		return r.errorMapper(err, r.tableName)
+	}
+
+	sealQuery := `
+		UPDATE request_usage_rollup
+		SET sealed_before = GREATEST(
+			sealed_before,
+			(
+				SELECT date_trunc('hour', max(request_time), 'UTC')
+					+ interval '1 hour'
+				FROM ` + name + `
+			)
+		);
+	`
+
+	_, err = tx.Exec(ctx, sealQuery)
+	if err != nil {
+		tx.Rollback(ctx)
+		return r.errorMapper(err, r.tableName)
+	}
+
+	if _, err := tx.Exec(ctx, "DROP TABLE "+name+";"); err != nil {
+		tx.Rollback(ctx)
+		return r.errorMapper(err, r.tableName)
+	}
+
+	return r.errorMapper(tx.Commit(ctx), r.tableName)
+}
+
 func (r *RequestRepository) UpdateExecutionStatus(
 	ctx context.Context, id string, update *dto.RequestExecutionStatusUpdate,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "RequestRepository", "UpdateExecutionStatus")
+
 	if update == nil {
 		return nil
 	}
@@ -65,6 +231,11 @@ func (r *RequestRepository) UpdateExecutionStatus(
 		detail = update.Detail
 	}
 
+	var statusCode any
+	if update.StatusCode != 0 {
+		statusCode = update.StatusCode
+	}
+
 	query := `
 		UPDATE request
 		SET execution_status = $2, status_code = $3, detail = $4
@@ -72,7 +243,7 @@ func (r *RequestRepository) UpdateExecutionStatus(
 	`
 
 	result, err := r.pool.Exec(
-		ctx, query, id, update.ExecutionStatus, update.StatusCode, detail,
+		ctx, query, id, update.ExecutionStatus, statusCode, detail,
 	)
 	if err != nil {
 		return r.errorMapper(err, r.tableName)
@@ -85,50 +256,310 @@ func (r *RequestRepository) UpdateExecutionStatus(
 	return nil
 }
 
+// UpdateUnits sets the units charged for a request, as settled when its
+// reservation is committed or released.
+func (r *RequestRepository) UpdateUnits(
+	ctx context.Context, id string, units int,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "RequestRepository", "UpdateUnits")
+
+	query := `
+		UPDATE request
+		SET units = $2
+		WHERE id = $1;
+	`
+
+	result, err := r.pool.Exec(ctx, query, id, units)
+	if err != nil {
+		return r.errorMapper(err, r.tableName)
+	}
+
+	if result.RowsAffected() == 0 {
+		return r.entityNotFoundError(r.tableName, map[string]any{"id": id})
+	}
+
+	return nil
+}
+
 func (r *RequestRepository) ListByService(
-	ctx context.Context, serviceID int, filter *dto.RequestFilter,
+	ctx context.Context,
+	serviceID int,
+	filter *dto.RequestFilter,
+	page *dto.Pagination,
 ) ([]*entities.Request, errors.Error) {
-	query := `
-		SELECT id, COALESCE(start_point::text, ''), api_key, COALESCE(api_key_id, 0),
-			COALESCE(project_name, ''), COALESCE(project_id, 0),
-			COALESCE(environment_name, ''), COALESCE(environment_id, 0),
-			service_name, service_version, COALESCE(service_id, 0), COALESCE(detail, ''),
-			COALESCE(status_code, 0), execution_status, request_time, path, method, ip_address,
-			COALESCE(unauthorized_reason, ''), created_at
+	ctx = withQueryLabel(ctx, "RequestRepository", "ListByService")
+
+	where, args := r.filterConditions(serviceID, filter)
+
+	where, args, clauses, pageErr := paginate(
+		page, "created_at", "id", false, where, args,
+	)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM request WHERE %s%s;",
+		requestColumns,
+		strings.Join(where, " AND "),
+		clauses,
+	)
+
+	return r.list(ctx, false, query, args...)
+}
+
+// ListExpired returns, oldest first, up to limit of the requests selected by
+// the retention, with their metadata. Chains are only expired whole, so the
+// requests of a chain are kept until its latest request expires.
+func (r *RequestRepository) ListExpired(
+	ctx context.Context, retention *dto.RequestRetention, limit int,
+) ([]*entities.Request, errors.Error) {
+	ctx = withQueryLabel(ctx, "RequestRepository", "ListExpired")
+
+	where := []string{
+		"created_at < $1",
+		`NOT EXISTS (
+			SELECT 1 FROM request c
+			WHERE c.start_point = request.start_point AND c.created_at >= $1
+		)`,
+	}
+	args := []any{retention.Before}
+
+	if retention.ServiceID != 0 {
+		where = append(where, fmt.Sprintf("service_id = $%d", len(args)+1))
+		args = append(args, retention.ServiceID)
+	} else if len(retention.ExcludedServiceIDs) > 0 {
+		where = append(
+			where,
+			fmt.Sprintf(
+				"(service_id IS NULL OR service_id <> ALL($%d))", len(args)+1,
+			),
+		)
+		args = append(args, retention.ExcludedServiceIDs)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s, metadata FROM request WHERE %s ORDER BY created_at LIMIT %d;",
+		requestColumns,
+		strings.Join(where, " AND "),
+		limit,
+	)
+
+	return r.list(ctx, true, query, args...)
+}
+
+// ListOrphaned returns, oldest first, up to limit of the requests created
+// before the time whose chain lost its initial request, with their metadata.
+func (r *RequestRepository) ListOrphaned(
+	ctx context.Context, before time.Time, limit int,
+) ([]*entities.Request, errors.Error) {
+	ctx = withQueryLabel(ctx, "RequestRepository", "ListOrphaned")
+
+	query := fmt.Sprintf(
+		`
+		SELECT %s, metadata
 		FROM request
-		WHERE service_id = $1
+		WHERE created_at < $1 AND start_point IS NOT NULL
+			AND NOT EXISTS (
+				SELECT 1 FROM request s WHERE s.id = request.start_point
+			)
+		ORDER BY created_at
+		LIMIT %d;
+		`,
+		requestColumns,
+		limit,
+	)
+
+	return r.list(ctx, true, query, before)
+}
+
+// ListByPartition returns a page of the requests held by the partition,
+// oldest first, with their metadata.
+func (r *RequestRepository) ListByPartition(
+	ctx context.Context, partition string, page *dto.Pagination,
+) ([]*entities.Request, errors.Error) {
+	ctx = withQueryLabel(ctx, "RequestRepository", "ListByPartition")
+
+	where, args, clauses, pageErr := paginateBy(
+		page, "created_at", "id", false, true, nil, nil,
+	)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s, metadata FROM %s",
+		requestColumns,
+		pgx.Identifier{partition}.Sanitize(),
+	)
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
+
+	return r.list(ctx, true, query+clauses+";", args...)
+}
+
+// ListPartitions returns the partitions of the request log, ordered by the
+// requests they hold, with the default partition last.
+func (r *RequestRepository) ListPartitions(
+	ctx context.Context,
+) ([]*entities.RequestPartition, errors.Error) {
+	ctx = withQueryLabel(ctx, "RequestRepository", "ListPartitions")
+
+	query := `
+		WITH partition AS (
+			SELECT c.relname AS name,
+				pg_get_expr(c.relpartbound, c.oid) AS bound
+			FROM pg_inherits i
+				JOIN pg_class c ON c.oid = i.inhrelid
+			WHERE i.inhparent = 'request'::regclass
+		)
+		SELECT name,
+			(regexp_match(bound, 'FROM \(''([^'']+)''\)'))[1]::timestamptz,
+			(regexp_match(bound, 'TO \(''([^'']+)''\)'))[1]::timestamptz
+		FROM partition
+		ORDER BY 3 NULLS LAST, 1;
 	`
 
-	args := []any{serviceID}
-	if filter != nil {
-		var where []string
-		argIndex := 2
-
-		if !filter.RequestTimeTo.IsZero() {
-			where = append(where, fmt.Sprintf("request_time <= $%d", argIndex))
-			args = append(args, filter.RequestTimeTo)
-			argIndex++
-		}
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	defer rows.Close()
+
+	var partitions []*entities.RequestPartition
+	for rows.Next() {
+		var from, to *time.Time
 
-		if !filter.RequestTimeFrom.IsZero() {
-			where = append(where, fmt.Sprintf("request_time >= $%d", argIndex))
-			args = append(args, filter.RequestTimeFrom)
-			argIndex++
+		partition := new(entities.RequestPartition)
+		if err := rows.Scan(&partition.Name, &from, &to); err != nil {
+			return nil, r.errorMapper(err, r.tableName)
 		}
 
-		if filter.ExecutionStatus != enums.RequestExecutionStatusNull {
-			where = append(where, fmt.Sprintf("execution_status = $%d", argIndex))
-			args = append(args, filter.ExecutionStatus)
-			argIndex++
+		if from != nil {
+			partition.From = *from
 		}
 
-		if len(where) > 0 {
-			query = fmt.Sprintf("%s AND %s", query, strings.Join(where, " AND "))
+		if to != nil {
+			partition.To = *to
 		}
+
+		partitions = append(partitions, partition)
 	}
 
-	query += " ORDER BY request_time DESC;"
+	if err := rows.Err(); err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+	return partitions, nil
+}
+
+func (r *RequestRepository) Search(
+	ctx context.Context, filter *dto.RequestSearch, page *dto.Pagination,
+) ([]*entities.Request, errors.Error) {
+	ctx = withQueryLabel(ctx, "RequestRepository", "Search")
+
+	where, args := r.searchConditions(filter)
 
+	timeColumn := "created_at"
+	if filter.SortBy == enums.RequestSortFieldRequestTime {
+		timeColumn = "request_time"
+	}
+
+	where, args, clauses, pageErr := paginateBy(
+		page,
+		timeColumn,
+		"id",
+		false,
+		filter.SortOrder == enums.SortOrderAsc,
+		where,
+		args,
+	)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM request", requestColumns)
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
+
+	return r.list(ctx, false, query+clauses+";", args...)
+}
+
+// StreamSearch hands each request matching the filter to yield, in the
+// order Search lists them, stopping at the first error yield returns.
+func (r *RequestRepository) StreamSearch(
+	ctx context.Context,
+	filter *dto.RequestSearch,
+	yield func(*entities.Request) errors.Error,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "RequestRepository", "StreamSearch")
+
+	where, args := r.searchConditions(filter)
+
+	timeColumn := "created_at"
+	if filter.SortBy == enums.RequestSortFieldRequestTime {
+		timeColumn = "request_time"
+	}
+
+	order := "DESC"
+	if filter.SortOrder == enums.SortOrderAsc {
+		order = "ASC"
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM request", requestColumns)
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
+	query += fmt.Sprintf(" ORDER BY %s %s, id %s", timeColumn, order, order)
+
+	return r.streamCursor(
+		ctx,
+		r.tableName,
+		query,
+		args,
+		func(rows pgx.Rows) errors.Error {
+			request, err := scanRequest(rows)
+			if err != nil {
+				return r.errorMapper(err, r.tableName)
+			}
+			return yield(request)
+		},
+	)
+}
+
+// ListChain returns every request of the chain the request belongs to, from
+// the request that started it, in the order they were made.
+func (r *RequestRepository) ListChain(
+	ctx context.Context, id string,
+) ([]*entities.Request, errors.Error) {
+	ctx = withQueryLabel(ctx, "RequestRepository", "ListChain")
+
+	query := fmt.Sprintf(
+		`
+		WITH chain AS (
+			SELECT COALESCE(start_point, id) AS start_point
+			FROM request
+			WHERE id = $1
+		)
+		SELECT %s
+		FROM request
+		WHERE id = (SELECT start_point FROM chain)
+			OR start_point = (SELECT start_point FROM chain)
+		ORDER BY request_time, created_at;
+		`,
+		requestColumns,
+	)
+
+	return r.list(ctx, false, query, id)
+}
+
+// list runs a query selecting the requestColumns, followed by the metadata
+// column when withMetadata is set.
+func (r *RequestRepository) list(
+	ctx context.Context, withMetadata bool, query string, args ...any,
+) ([]*entities.Request, errors.Error) {
 	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, r.errorMapper(err, r.tableName)
@@ -138,39 +569,19 @@ func (r *RequestRepository) ListByService(
 
 	var requests []*entities.Request
 	for rows.Next() {
-		request := &entities.Request{
-			APIKey:      &entities.RequestAPIKey{},
-			Project:     &entities.RequestProject{},
-			Environment: &entities.RequestEnvironment{},
-			Service:     &entities.RequestService{},
+		var metadata *requestMetadata
+
+		var extra []any
+		if withMetadata {
+			extra = append(extra, &metadata)
 		}
 
-		err := rows.Scan(
-			&request.ID,
-			&request.StartPoint,
-			&request.APIKey.Key,
-			&request.APIKey.ID,
-			&request.Project.Name,
-			&request.Project.ID,
-			&request.Environment.Name,
-			&request.Environment.ID,
-			&request.Service.Name,
-			&request.Service.Version,
-			&request.Service.ID,
-			&request.Detail,
-			&request.StatusCode,
-			&request.ExecutionStatus,
-			&request.RequestTime,
-			&request.Path,
-			&request.Method,
-			&request.IPAddress,
-			&request.UnauthorizedReason,
-			&request.CreatedAt,
-		)
+		request, err := scanRequest(rows, extra...)
 		if err != nil {
 			return nil, r.errorMapper(err, r.tableName)
 		}
 
+		request.Metadata = metadata.entity()
 		requests = append(requests, request)
 	}
 
@@ -180,20 +591,245 @@ func (r *RequestRepository) ListByService(
 	return requests, nil
 }
 
+func (r *RequestRepository) GetByID(
+	ctx context.Context, id string,
+) (*entities.Request, errors.Error) {
+	ctx = withQueryLabel(ctx, "RequestRepository", "GetByID")
+
+	query := fmt.Sprintf(
+		`
+		SELECT %s, metadata
+		FROM request
+		WHERE id = $1;
+		`,
+		requestColumns,
+	)
+
+	var metadata *requestMetadata
+
+	request, err := scanRequest(r.pool.QueryRow(ctx, query, id), &metadata)
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	request.Metadata = metadata.entity()
+	return request, nil
+}
+
+func (r *RequestRepository) CountByService(
+	ctx context.Context, serviceID int, filter *dto.RequestFilter,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "RequestRepository", "CountByService")
+
+	where, args := r.filterConditions(serviceID, filter)
+
+	query := fmt.Sprintf(
+		"SELECT count(*) FROM request WHERE %s;",
+		strings.Join(where, " AND "),
+	)
+
+	var total int
+	err := r.pool.QueryRow(ctx, query, args...).Scan(&total)
+	if err != nil {
+		return 0, r.errorMapper(err, r.tableName)
+	}
+
+	return total, nil
+}
+
+func (r *RequestRepository) Count(
+	ctx context.Context, filter *dto.RequestSearch,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "RequestRepository", "Count")
+
+	where, args := r.searchConditions(filter)
+
+	query := "SELECT count(*) FROM request"
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
+
+	var total int
+	err := r.pool.QueryRow(ctx, query+";", args...).Scan(&total)
+	if err != nil {
+		return 0, r.errorMapper(err, r.tableName)
+	}
+
+	return total, nil
+}
+
+func (r *RequestRepository) filterConditions(
+	serviceID int, filter *dto.RequestFilter,
+) ([]string, []any) {
+	where := []string{"service_id = $1"}
+	args := []any{serviceID}
+
+	if filter == nil {
+		return where, args
+	}
+
+	if !filter.RequestTimeTo.IsZero() {
+		where = append(where, fmt.Sprintf("request_time <= $%d", len(args)+1))
+		args = append(args, filter.RequestTimeTo)
+	}
+
+	if !filter.RequestTimeFrom.IsZero() {
+		where = append(where, fmt.Sprintf("request_time >= $%d", len(args)+1))
+		args = append(args, filter.RequestTimeFrom)
+	}
+
+	if filter.ExecutionStatus != enums.RequestExecutionStatusNull {
+		where = append(where, fmt.Sprintf("execution_status = $%d", len(args)+1))
+		args = append(args, filter.ExecutionStatus)
+	}
+
+	return where, args
+}
+
+func (r *RequestRepository) searchConditions(
+	filter *dto.RequestSearch,
+) ([]string, []any) {
+	var where []string
+	var args []any
+
+	if filter == nil {
+		return where, args
+	}
+
+	if filter.ClientID != 0 {
+		where = append(
+			where,
+			fmt.Sprintf(
+				"project_id IN (SELECT id FROM project WHERE client_id = $%d)",
+				len(args)+1,
+			),
+		)
+		args = append(args, filter.ClientID)
+	}
+
+	if filter.ProjectID != 0 {
+		where = append(where, fmt.Sprintf("project_id = $%d", len(args)+1))
+		args = append(args, filter.ProjectID)
+	}
+
+	if filter.EnvironmentID != 0 {
+		where = append(where, fmt.Sprintf("environment_id = $%d", len(args)+1))
+		args = append(args, filter.EnvironmentID)
+	}
+
+	// A rotation chain is linear, as a key is replaced once, so it is
+	// walked back over rotated_from_id and forward over the keys that
+	// point to it.
+	if filter.APIKeyID != 0 && filter.IncludeRotated {
+		where = append(
+			where,
+			fmt.Sprintf(
+				`api_key_id IN (
+					WITH RECURSIVE
+					replaced AS (
+						SELECT id, rotated_from_id
+						FROM api_key
+						WHERE id = $%[1]d
+						UNION
+						SELECT k.id, k.rotated_from_id
+						FROM api_key k
+						JOIN replaced r ON k.id = r.rotated_from_id
+					),
+					replacing AS (
+						SELECT id
+						FROM api_key
+						WHERE id = $%[1]d
+						UNION
+						SELECT k.id
+						FROM api_key k
+						JOIN replacing r ON k.rotated_from_id = r.id
+					)
+					SELECT id FROM replaced
+					UNION
+					SELECT id FROM replacing
+				)`,
+				len(args)+1,
+			),
+		)
+		args = append(args, filter.APIKeyID)
+	} else if filter.APIKeyID != 0 {
+		where = append(where, fmt.Sprintf("api_key_id = $%d", len(args)+1))
+		args = append(args, filter.APIKeyID)
+	}
+
+	if filter.ServiceID != 0 {
+		where = append(where, fmt.Sprintf("service_id = $%d", len(args)+1))
+		args = append(args, filter.ServiceID)
+	}
+
+	if filter.PathPrefix != "" {
+		where = append(where, fmt.Sprintf("starts_with(path, $%d)", len(args)+1))
+		args = append(args, filter.PathPrefix)
+	}
+
+	if filter.Method != "" {
+		where = append(where, fmt.Sprintf("method = $%d", len(args)+1))
+		args = append(args, filter.Method)
+	}
+
+	// A plain IP address is taken as a network of one address, so a single
+	// condition covers both.
+	if filter.IPAddress != "" {
+		where = append(
+			where, fmt.Sprintf("ip_address::inet <<= $%d::inet", len(args)+1),
+		)
+		args = append(args, filter.IPAddress)
+	}
+
+	if filter.StatusCodeFrom != 0 {
+		where = append(where, fmt.Sprintf("status_code >= $%d", len(args)+1))
+		args = append(args, filter.StatusCodeFrom)
+	}
+
+	if filter.StatusCodeTo != 0 {
+		where = append(where, fmt.Sprintf("status_code <= $%d", len(args)+1))
+		args = append(args, filter.StatusCodeTo)
+	}
+
+	if filter.UnauthorizedReason != "" {
+		where = append(where, fmt.Sprintf("unauthorized_reason = $%d", len(args)+1))
+		args = append(args, filter.UnauthorizedReason)
+	}
+
+	if filter.ExecutionStatus != enums.RequestExecutionStatusNull {
+		where = append(where, fmt.Sprintf("execution_status = $%d", len(args)+1))
+		args = append(args, filter.ExecutionStatus)
+	}
+
+	if !filter.RequestTimeFrom.IsZero() {
+		where = append(where, fmt.Sprintf("request_time >= $%d", len(args)+1))
+		args = append(args, filter.RequestTimeFrom)
+	}
+
+	if !filter.RequestTimeTo.IsZero() {
+		where = append(where, fmt.Sprintf("request_time <= $%d", len(args)+1))
+		args = append(args, filter.RequestTimeTo)
+	}
+
+	return where, args
+}
+
 func (r *RequestRepository) Create(
 	ctx context.Context, request *entities.Request,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "RequestRepository", "Create")
+
 	query := `
 		INSERT INTO request (
 			start_point, api_key, api_key_id, project_name, project_id,
 			environment_name, environment_id, service_name, service_version,
 			service_id, status_code, execution_status, request_time, path,
-			method, ip_address, metadata, unauthorized_reason
+			method, ip_address, metadata, unauthorized_reason, units
 		)
 		VALUES (
 			$1, $2, $3, $4, $5, $6, $7, $8, $9,
 			$10, $11, $12, $13, $14, $15, $16,
-			$17, $18
+			$17, $18, $19
 		) RETURNING id, created_at;
 	`
 
@@ -202,6 +838,61 @@ func (r *RequestRepository) Create(
 		startPoint = request.StartPoint
 	}
 
+	err := r.pool.QueryRow(
+		ctx, query, append([]any{startPoint}, requestValues(request)...)...,
+	).Scan(&request.ID, &request.CreatedAt)
+
+	return r.errorMapper(err, r.tableName)
+}
+
+// CreateBatch copies the requests, whose id and created_at are already set,
+// in a single COPY. Either all of them are created or none.
+func (r *RequestRepository) CreateBatch(
+	ctx context.Context, requests []*entities.Request,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "RequestRepository", "CreateBatch")
+
+	columns := []string{
+		"id", "start_point", "api_key", "api_key_id", "project_name",
+		"project_id", "environment_name", "environment_id", "service_name",
+		"service_version", "service_id", "status_code", "execution_status",
+		"request_time", "path", "method", "ip_address", "metadata",
+		"unauthorized_reason", "units", "created_at",
+	}
+
+	rows := make([][]any, 0, len(requests))
+	for _, request := range requests {
+		id, err := uuid.Parse(request.ID)
+		if err != nil {
+			return errors.NewInternal("Invalid request id", err)
+		}
+
+		// COPY takes UUIDs in binary, which strings are not encoded to.
+		var startPoint pgtype.UUID
+		if request.StartPoint != "" {
+			point, err := uuid.Parse(request.StartPoint)
+			if err != nil {
+				return errors.NewInternal("Invalid request start point", err)
+			}
+			startPoint = pgtype.UUID{Bytes: point, Valid: true}
+		}
+
+		row := append(
+			[]any{pgtype.UUID{Bytes: id, Valid: true}, startPoint},
+			requestValues(request)...,
+		)
+		rows = append(rows, append(row, request.CreatedAt))
+	}
+
+	_, err := r.pool.CopyFrom(
+		ctx, pgx.Identifier{r.tableName}, columns, pgx.CopyFromRows(rows),
+	)
+	return r.errorMapper(err, r.tableName)
+}
+
+// requestValues returns the values of the request columns, from api_key
+// to units in the order Create inserts them, with NULL for unset ones.
+func requestValues(request *entities.Request) []any {
 	var apiKeyID any
 	if request.APIKey.ID != 0 {
 		apiKeyID = request.APIKey.ID
@@ -227,6 +918,11 @@ func (r *RequestRepository) Create(
 		environmentName = request.Environment.Name
 	}
 
+	var serviceID any
+	if request.Service.ID != 0 {
+		serviceID = request.Service.ID
+	}
+
 	var statusCode any
 	if request.StatusCode != 0 {
 		statusCode = request.StatusCode
@@ -251,11 +947,8 @@ func (r *RequestRepository) Create(
 		metadata["bodyContentType"] = request.Metadata.BodyContentType
 	}
 
-	err := r.pool.QueryRow(
-		ctx,
-		query,
-		startPoint,
-		request.APIKey.Key,
+	return []any{
+		request.APIKey.KeySummary(),
 		apiKeyID,
 		projectName,
 		projectID,
@@ -263,7 +956,7 @@ func (r *RequestRepository) Create(
 		environmentID,
 		request.Service.Name,
 		request.Service.Version,
-		request.Service.ID,
+		serviceID,
 		statusCode,
 		request.ExecutionStatus,
 		request.RequestTime,
@@ -272,14 +965,15 @@ func (r *RequestRepository) Create(
 		request.IPAddress,
 		metadata,
 		UnauthorizedReason,
-	).Scan(&request.ID, &request.CreatedAt)
-
-	return r.errorMapper(err, r.tableName)
+		request.Units,
+	}
 }
 
 func (r *RequestRepository) CreateAsInitialPoint(
 	ctx context.Context, request *entities.Request,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "RequestRepository", "CreateAsInitialPoint")
+
 	query := `
 		WITH temp_table AS (
 			SELECT gen_random_uuid() AS uuid
@@ -288,19 +982,14 @@ func (r *RequestRepository) CreateAsInitialPoint(
 			id, start_point, api_key, api_key_id, project_name, project_id,
 			environment_name, environment_id, service_name, service_version,
 			service_id, status_code, execution_status, request_time, path,
-			method, ip_address, metadata, unauthorized_reason
+			method, ip_address, metadata, unauthorized_reason, units
 		)
 		SELECT uuid, uuid, $1, $2, $3, $4, $5, $6, $7, $8, $9,
-			$10, $11, $12, $13, $14, $15, $16, $17
-		) RETURNING id, created_at;
-		FROM temp_table RETURNING id;
+			$10, $11, $12, $13, $14, $15, $16, $17, $18
+		FROM temp_table
+		RETURNING id, start_point, created_at;
 	`
 
-	var startPoint any
-	if request.StartPoint != "" {
-		startPoint = request.StartPoint
-	}
-
 	var apiKeyID any
 	if request.APIKey.ID != 0 {
 		apiKeyID = request.APIKey.ID
@@ -326,6 +1015,11 @@ func (r *RequestRepository) CreateAsInitialPoint(
 		environmentName = request.Environment.Name
 	}
 
+	var serviceID any
+	if request.Service.ID != 0 {
+		serviceID = request.Service.ID
+	}
+
 	var statusCode any
 	if request.StatusCode != 0 {
 		statusCode = request.StatusCode
@@ -359,8 +1053,7 @@ func (r *RequestRepository) CreateAsInitialPoint(
 	err := r.pool.QueryRow(
 		ctx,
 		query,
-		startPoint,
-		request.APIKey,
+		request.APIKey.KeySummary(),
 		apiKeyID,
 		projectName,
 		projectID,
@@ -368,7 +1061,7 @@ func (r *RequestRepository) CreateAsInitialPoint(
 		environmentID,
 		request.Service.Name,
 		request.Service.Version,
-		request.Service.ID,
+		serviceID,
 		statusCode,
 		request.ExecutionStatus,
 		request.RequestTime,
@@ -377,11 +1070,52 @@ func (r *RequestRepository) CreateAsInitialPoint(
 		request.IPAddress,
 		metadata,
 		UnauthorizedReason,
-	).Scan(&request.ID, &request.CreatedAt)
+		request.Units,
+	).Scan(&request.ID, &request.StartPoint, &request.CreatedAt)
 
 	return r.errorMapper(err, r.tableName)
 }
 
+// CreatePartition creates the partition holding the requests created in
+// [from, to), named after the month it starts in. It reports false when the
+// partition exists already, or when another partition holds part of the
+// range.
+func (r *RequestRepository) CreatePartition(
+	ctx context.Context, from, to time.Time,
+) (bool, errors.Error) {
+	ctx = withQueryLabel(ctx, "RequestRepository", "CreatePartition")
+
+	name := fmt.Sprintf("request_%s", from.UTC().Format("2006_01"))
+
+	var exists bool
+	err := r.pool.QueryRow(
+		ctx, "SELECT to_regclass($1) IS NOT NULL;", name,
+	).Scan(&exists)
+	if err != nil {
+		return false, r.errorMapper(err, r.tableName)
+	}
+
+	if exists {
+		return false, nil
+	}
+
+	query := fmt.Sprintf(
+		"CREATE TABLE %s PARTITION OF request FOR VALUES FROM ('%s') TO ('%s');",
+		pgx.Identifier{name}.Sanitize(),
+		from.UTC().Format(time.RFC3339),
+		to.UTC().Format(time.RFC3339),
+	)
+
+	if _, err := r.pool.Exec(ctx, query); err != nil {
+		if isPgError(err, "42P17") { // INVALID_OBJECT_DEFINITION
+			return false, nil
+		}
+		return false, r.errorMapper(err, r.tableName)
+	}
+
+	return true, nil
+}
+
 func NewRequestRepository(driver *Driver) *RequestRepository {
 	return &RequestRepository{Driver: driver, tableName: "request"}
 }
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
@@ -17,9 +18,11 @@ type ReservationRepository struct {
 func (r *ReservationRepository) Create(
 	ctx context.Context, Reservation *entities.Reservation,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "ReservationRepository", "Create")
+
 	query := `
-		INSERT INTO reservation (environment_id, service_id, api_key, start_request_id, request_time, expires_at)
-		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id;
+		INSERT INTO reservation (environment_id, service_id, api_key, start_request_id, request_time, expires_at, units)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id;
 	`
 
 	err := r.pool.QueryRow(
@@ -31,6 +34,7 @@ func (r *ReservationRepository) Create(
 		Reservation.StartRequestID,
 		Reservation.RequestTime,
 		Reservation.ExpiresAt,
+		Reservation.Units,
 	).Scan(&Reservation.ID)
 
 	return r.errorMapper(err, r.tableName)
@@ -39,8 +43,11 @@ func (r *ReservationRepository) Create(
 func (r *ReservationRepository) GetByID(
 	ctx context.Context, id string,
 ) (*entities.Reservation, errors.Error) {
+	ctx = withQueryLabel(ctx, "ReservationRepository", "GetByID")
+
 	query := `
-		SELECT *
+		SELECT id, environment_id, service_id, api_key, start_request_id,
+			request_time, COALESCE(expires_at, '0001-01-01 00:00:00.0+00'), units
 		FROM reservation
 		WHERE id = $1;
 	`
@@ -51,8 +58,10 @@ func (r *ReservationRepository) GetByID(
 		&reservation.EnvironmentID,
 		&reservation.ServiceID,
 		&reservation.APIKey,
+		&reservation.StartRequestID,
 		&reservation.RequestTime,
 		&reservation.ExpiresAt,
+		&reservation.Units,
 	)
 	if err != nil {
 		return nil, r.errorMapper(err, r.tableName)
@@ -64,6 +73,8 @@ func (r *ReservationRepository) GetByID(
 func (r *ReservationRepository) GetByIDWithDetails(
 	ctx context.Context, id string,
 ) (*dto.ReservationWithDetails, errors.Error) {
+	ctx = withQueryLabel(ctx, "ReservationRepository", "GetByIDWithDetails")
+
 	query := `
 		SELECT r.id, r.start_request_id, r.api_key, 
 		s.id, s.name, s.version, s.status, 
@@ -99,6 +110,8 @@ func (r *ReservationRepository) GetByIDWithDetails(
 func (r *ReservationRepository) CountByEnvironmentAndService(
 	ctx context.Context, environment_id, service_id int,
 ) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "ReservationRepository", "CountByEnvironmentAndService")
+
 	query := `
 		SELECT count(*)
 		FROM reservation
@@ -120,9 +133,58 @@ func (r *ReservationRepository) CountByEnvironmentAndService(
 	return currentReservations, nil
 }
 
+func (r *ReservationRepository) ListExpired(
+	ctx context.Context, now time.Time,
+) ([]*entities.Reservation, errors.Error) {
+	ctx = withQueryLabel(ctx, "ReservationRepository", "ListExpired")
+
+	query := `
+		SELECT id, environment_id, service_id, api_key, start_request_id,
+			request_time, expires_at, units
+		FROM reservation
+		WHERE expires_at IS NOT NULL AND expires_at <= $1
+		ORDER BY expires_at;
+	`
+
+	rows, err := r.pool.Query(ctx, query, now)
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	defer rows.Close()
+
+	var reservations []*entities.Reservation
+	for rows.Next() {
+		reservation := new(entities.Reservation)
+		err = rows.Scan(
+			&reservation.ID,
+			&reservation.EnvironmentID,
+			&reservation.ServiceID,
+			&reservation.APIKey,
+			&reservation.StartRequestID,
+			&reservation.RequestTime,
+			&reservation.ExpiresAt,
+			&reservation.Units,
+		)
+		if err != nil {
+			return nil, r.errorMapper(err, r.tableName)
+		}
+
+		reservations = append(reservations, reservation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return reservations, nil
+}
+
 func (r *ReservationRepository) Delete(
 	ctx context.Context, id string,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "ReservationRepository", "Delete")
+
 	query := `
 		DELETE FROM reservation
 		WHERE id = $1;
@@ -140,6 +202,187 @@ func (r *ReservationRepository) Delete(
 	return nil
 }
 
+// Commit settles the reservation for units in one transaction: it deletes
+// the reservation and, when it held more, gives the rest back to its
+// environment service and project pool and charges units to its start
+// request. Either all of it happens or none.
+func (r *ReservationRepository) Commit(
+	ctx context.Context, id string, units int,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "ReservationRepository", "Commit")
+
+	tx, txErr := r.pool.Begin(ctx)
+	if txErr != nil {
+		return r.errorMapper(txErr, r.tableName)
+	}
+
+	query := `
+		DELETE FROM reservation
+		WHERE id = $1
+		RETURNING environment_id, service_id, start_request_id, units;
+	`
+
+	reservation := new(entities.Reservation)
+	err := tx.QueryRow(ctx, query, id).Scan(
+		&reservation.EnvironmentID,
+		&reservation.ServiceID,
+		&reservation.StartRequestID,
+		&reservation.Units,
+	)
+	if err != nil {
+		tx.Rollback(ctx)
+		return r.errorMapper(err, r.tableName)
+	}
+
+	if units >= reservation.Units {
+		return r.errorMapper(tx.Commit(ctx), r.tableName)
+	}
+
+	var updated int
+	err = tx.QueryRow(
+		ctx,
+		increaseAvailableRequestQuery,
+		reservation.EnvironmentID,
+		reservation.ServiceID,
+		reservation.Units-units,
+	).Scan(&updated)
+	if err != nil {
+		tx.Rollback(ctx)
+		return r.errorMapper(err, "environment_service")
+	}
+
+	if updated == 0 {
+		tx.Rollback(ctx)
+		return r.entityNotFoundError(
+			"environment_service",
+			map[string]any{
+				"environment_id": reservation.EnvironmentID,
+				"service_id":     reservation.ServiceID,
+			},
+		)
+	}
+
+	query = `
+		UPDATE request
+		SET units = $2
+		WHERE id = $1;
+	`
+
+	result, err := tx.Exec(ctx, query, reservation.StartRequestID, units)
+	if err != nil {
+		tx.Rollback(ctx)
+		return r.errorMapper(err, "request")
+	}
+
+	if result.RowsAffected() == 0 {
+		tx.Rollback(ctx)
+		return r.entityNotFoundError(
+			"request", map[string]any{"id": reservation.StartRequestID},
+		)
+	}
+
+	return r.errorMapper(tx.Commit(ctx), r.tableName)
+}
+
+// Release settles the reservation without charging it in one transaction:
+// it deletes the reservation, gives all its units back to its environment
+// service and project pool and resets the units of its start request. With
+// an update the start request also takes its execution status. Either all
+// of it happens or none.
+func (r *ReservationRepository) Release(
+	ctx context.Context, id string, update *dto.RequestExecutionStatusUpdate,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "ReservationRepository", "Release")
+
+	tx, txErr := r.pool.Begin(ctx)
+	if txErr != nil {
+		return r.errorMapper(txErr, r.tableName)
+	}
+
+	query := `
+		DELETE FROM reservation
+		WHERE id = $1
+		RETURNING environment_id, service_id, start_request_id, units;
+	`
+
+	reservation := new(entities.Reservation)
+	err := tx.QueryRow(ctx, query, id).Scan(
+		&reservation.EnvironmentID,
+		&reservation.ServiceID,
+		&reservation.StartRequestID,
+		&reservation.Units,
+	)
+	if err != nil {
+		tx.Rollback(ctx)
+		return r.errorMapper(err, r.tableName)
+	}
+
+	var updated int
+	err = tx.QueryRow(
+		ctx,
+		increaseAvailableRequestQuery,
+		reservation.EnvironmentID,
+		reservation.ServiceID,
+		reservation.Units,
+	).Scan(&updated)
+	if err != nil {
+		tx.Rollback(ctx)
+		return r.errorMapper(err, "environment_service")
+	}
+
+	if updated == 0 {
+		tx.Rollback(ctx)
+		return r.entityNotFoundError(
+			"environment_service",
+			map[string]any{
+				"environment_id": reservation.EnvironmentID,
+				"service_id":     reservation.ServiceID,
+			},
+		)
+	}
+
+	query = `
+		UPDATE request
+		SET units = 0
+		WHERE id = $1;
+	`
+	args := []any{reservation.StartRequestID}
+
+	if update != nil {
+		var detail any
+		if update.Detail != "" {
+			detail = update.Detail
+		}
+
+		var statusCode any
+		if update.StatusCode != 0 {
+			statusCode = update.StatusCode
+		}
+
+		query = `
+			UPDATE request
+			SET units = 0, execution_status = $2, status_code = $3, detail = $4
+			WHERE id = $1;
+		`
+		args = append(args, update.ExecutionStatus, statusCode, detail)
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		tx.Rollback(ctx)
+		return r.errorMapper(err, "request")
+	}
+
+	if result.RowsAffected() == 0 {
+		tx.Rollback(ctx)
+		return r.entityNotFoundError(
+			"request", map[string]any{"id": reservation.StartRequestID},
+		)
+	}
+
+	return r.errorMapper(tx.Commit(ctx), r.tableName)
+}
+
 func NewReservationRepository(driver *Driver) *ReservationRepository {
 	return &ReservationRepository{Driver: driver, tableName: "reservation"}
 }
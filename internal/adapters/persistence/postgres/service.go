@@ -20,6 +20,8 @@ type ServiceRepository struct {
 func (r *ServiceRepository) Exists(
 	ctx context.Context, id int,
 ) (bool, errors.Error) {
+	ctx = withQueryLabel(ctx, "ServiceRepository", "Exists")
+
 	query := `
 		SELECT EXISTS (
 			SELECT 1
@@ -40,6 +42,8 @@ func (r *ServiceRepository) Exists(
 func (r *ServiceRepository) Delete(
 	ctx context.Context, id int,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "ServiceRepository", "Delete")
+
 	query := `
 		DELETE FROM service
 		WHERE id = $1;
@@ -60,11 +64,13 @@ func (r *ServiceRepository) Delete(
 func (r *ServiceRepository) UpdateStatus(
 	ctx context.Context, id int, status enums.ServiceStatus,
 ) (*entities.Service, errors.Error) {
+	ctx = withQueryLabel(ctx, "ServiceRepository", "UpdateStatus")
+
 	query := `
 		UPDATE service
 		SET status = $1
 		WHERE id = $2
-		RETURNING id, name, version, status, created_at;
+		RETURNING id, name, version, status, request_retention_days, created_at;
 	`
 
 	service := new(entities.Service)
@@ -73,6 +79,62 @@ func (r *ServiceRepository) UpdateStatus(
 		&service.Name,
 		&service.Version,
 		&service.Status,
+		&service.RequestRetentionDays,
+		&service.CreatedAt,
+	)
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return service, nil
+}
+
+func (r *ServiceRepository) UpdateRetention(
+	ctx context.Context, id int, days *int,
+) (*entities.Service, errors.Error) {
+	ctx = withQueryLabel(ctx, "ServiceRepository", "UpdateRetention")
+
+	query := `
+		UPDATE service
+		SET request_retention_days = $1
+		WHERE id = $2
+		RETURNING id, name, version, status, request_retention_days, created_at;
+	`
+
+	service := new(entities.Service)
+	err := r.pool.QueryRow(ctx, query, days, id).Scan(
+		&service.ID,
+		&service.Name,
+		&service.Version,
+		&service.Status,
+		&service.RequestRetentionDays,
+		&service.CreatedAt,
+	)
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return service, nil
+}
+
+func (r *ServiceRepository) GetByID(
+	ctx context.Context, id int,
+) (*entities.Service, errors.Error) {
+	ctx = withQueryLabel(ctx, "ServiceRepository", "GetByID")
+
+	query := `
+		SELECT id, name, version, status, request_retention_days, created_at
+		FROM service
+		WHERE id = $1;
+	`
+
+	service := new(entities.Service)
+	err := r.pool.QueryRow(ctx, query, id).Scan(
+		&service.ID,
+		&service.Name,
+		&service.Version,
+		&service.Status,
+		&service.RequestRetentionDays,
 		&service.CreatedAt,
 	)
 	if err != nil {
@@ -85,8 +147,10 @@ func (r *ServiceRepository) UpdateStatus(
 func (r *ServiceRepository) GetByNameAndVersion(
 	ctx context.Context, name, version string,
 ) (*entities.Service, errors.Error) {
+	ctx = withQueryLabel(ctx, "ServiceRepository", "GetByNameAndVersion")
+
 	query := `
-		SELECT id, name, version, status, created_at
+		SELECT id, name, version, status, request_retention_days, created_at
 		FROM service
 		WHERE name = $1 AND version = $2;
 	`
@@ -97,6 +161,7 @@ func (r *ServiceRepository) GetByNameAndVersion(
 		&service.Name,
 		&service.Version,
 		&service.Status,
+		&service.RequestRetentionDays,
 		&service.CreatedAt,
 	)
 	if err != nil {
@@ -107,35 +172,78 @@ func (r *ServiceRepository) GetByNameAndVersion(
 }
 
 func (r *ServiceRepository) List(
-	ctx context.Context, filter *dto.ServiceFilter,
+	ctx context.Context, filter *dto.ServiceFilter, page *dto.Pagination,
 ) ([]*entities.Service, errors.Error) {
+	ctx = withQueryLabel(ctx, "ServiceRepository", "List")
+
+	where, args := r.filterConditions(filter)
+
+	where, args, clauses, pageErr := paginate(
+		page, "created_at", "id", true, where, args,
+	)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
 	query := `
-		SELECT id, name, version, status, created_at
+		SELECT id, name, version, status, request_retention_days, created_at
 		FROM service
-		ORDER BY created_at DESC;
 	`
 
-	var args []any
-	if filter != nil {
-		var where []string
-		argIndex := 1
-
-		if filter.Status != enums.ServiceStatusNull {
-			where = append(where, fmt.Sprintf("status = $%d", argIndex))
-			args = append(args, filter.Status)
-			argIndex++
-		}
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
 
-		if len(where) > 0 {
-			query = fmt.Sprintf(
-				"%s WHERE %s", query, strings.Join(where, " AND "),
-			)
+	query += clauses + ";"
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	defer rows.Close()
+
+	var services []*entities.Service
+	for rows.Next() {
+		service := new(entities.Service)
+
+		err = rows.Scan(
+			&service.ID,
+			&service.Name,
+			&service.Version,
+			&service.Status,
+			&service.RequestRetentionDays,
+			&service.CreatedAt,
+		)
+		if err != nil {
+			return nil, r.errorMapper(err, r.tableName)
 		}
+
+		services = append(services, service)
 	}
 
-	query += ";"
+	if err := rows.Err(); err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
 
-	rows, err := r.pool.Query(ctx, query, args...)
+	return services, nil
+}
+
+// ListWithRetention returns the services overriding the global request log
+// retention.
+func (r *ServiceRepository) ListWithRetention(
+	ctx context.Context,
+) ([]*entities.Service, errors.Error) {
+	ctx = withQueryLabel(ctx, "ServiceRepository", "ListWithRetention")
+
+	query := `
+		SELECT id, name, version, status, request_retention_days, created_at
+		FROM service
+		WHERE request_retention_days IS NOT NULL
+		ORDER BY id;
+	`
+
+	rows, err := r.pool.Query(ctx, query)
 	if err != nil {
 		return nil, r.errorMapper(err, r.tableName)
 	}
@@ -151,6 +259,7 @@ func (r *ServiceRepository) List(
 			&service.Name,
 			&service.Version,
 			&service.Status,
+			&service.RequestRetentionDays,
 			&service.CreatedAt,
 		)
 		if err != nil {
@@ -167,9 +276,50 @@ func (r *ServiceRepository) List(
 	return services, nil
 }
 
+func (r *ServiceRepository) Count(
+	ctx context.Context, filter *dto.ServiceFilter,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "ServiceRepository", "Count")
+
+	where, args := r.filterConditions(filter)
+
+	query := "SELECT count(*) FROM service"
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
+
+	var total int
+	err := r.pool.QueryRow(ctx, query+";", args...).Scan(&total)
+	if err != nil {
+		return 0, r.errorMapper(err, r.tableName)
+	}
+
+	return total, nil
+}
+
+func (r *ServiceRepository) filterConditions(
+	filter *dto.ServiceFilter,
+) ([]string, []any) {
+	var where []string
+	var args []any
+
+	if filter == nil {
+		return where, args
+	}
+
+	if filter.Status != enums.ServiceStatusNull {
+		where = append(where, fmt.Sprintf("status = $%d", len(args)+1))
+		args = append(args, filter.Status)
+	}
+
+	return where, args
+}
+
 func (r *ServiceRepository) Create(
 	ctx context.Context, service *entities.Service,
 ) errors.Error {
+	ctx = withQueryLabel(ctx, "ServiceRepository", "Create")
+
 	query := `
 		INSERT INTO service (name, version, status)
 		VALUES ($1, $2, $3) RETURNING id, created_at;
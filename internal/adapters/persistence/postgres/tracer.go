@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/metrics"
+)
+
+type queryLabelKey struct{}
+
+// queryLabel names the repository method a query is issued by.
+type queryLabel struct {
+	repository string
+	method     string
+}
+
+type queryTraceKey struct{}
+
+type queryTrace struct {
+	queryLabel
+	startedAt time.Time
+}
+
+// withQueryLabel attributes the queries issued with the returned context
+// to the method of the repository. Every exported repository method labels
+// its context first, so the queries of the helpers it calls count as its
+// own.
+func withQueryLabel(
+	ctx context.Context, repository, method string,
+) context.Context {
+	return context.WithValue(
+		ctx, queryLabelKey{}, queryLabel{repository: repository, method: method},
+	)
+}
+
+// queryTracer times every query issued with a labelled context.
+type queryTracer struct{}
+
+func (queryTracer) TraceQueryStart(
+	ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData,
+) context.Context {
+	label, ok := ctx.Value(queryLabelKey{}).(queryLabel)
+	if !ok {
+		return ctx
+	}
+
+	return context.WithValue(
+		ctx,
+		queryTraceKey{},
+		&queryTrace{queryLabel: label, startedAt: time.Now()},
+	)
+}
+
+func (queryTracer) TraceQueryEnd(
+	ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData,
+) {
+	trace, ok := ctx.Value(queryTraceKey{}).(*queryTrace)
+	if !ok {
+		return
+	}
+
+	metrics.ObserveRepositoryQuery(
+		trace.repository,
+		trace.method,
+		time.Since(trace.startedAt),
+		data.Err,
+	)
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func TestQueryTracerUsesLabel(t *testing.T) {
+	ctx := withQueryLabel(context.Background(), "APIKeyRepository", "GetByID")
+
+	traced := queryTracer{}.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{})
+
+	trace, ok := traced.Value(queryTraceKey{}).(*queryTrace)
+	if !ok {
+		t.Fatal("labelled query was not traced")
+	}
+	if trace.repository != "APIKeyRepository" || trace.method != "GetByID" {
+		t.Fatalf("traced as %s.%s", trace.repository, trace.method)
+	}
+}
+
+func TestQueryTracerSkipsUnlabelledQueries(t *testing.T) {
+	ctx := context.Background()
+
+	traced := queryTracer{}.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{})
+	if traced != ctx {
+		t.Fatal("unlabelled query was traced")
+	}
+}
+
+// TestRepositoryMethodsLabelQueries checks that every exported repository
+// method taking a context labels it first with its own name.
+func TestRepositoryMethodsLabelQueries(t *testing.T) {
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(fset, ".", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range packages["postgres"].Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+
+			receiver, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+
+			repository := receiver.X.(*ast.Ident).Name
+			params := fn.Type.Params.List
+			if repository == "Driver" || len(params) == 0 ||
+				len(params[0].Names) == 0 || params[0].Names[0].Name != "ctx" {
+				continue
+			}
+
+			if !labelsQuery(fn, repository) {
+				t.Errorf(
+					"%s.%s does not start with withQueryLabel(ctx, %q, %q)",
+					repository, fn.Name.Name, repository, fn.Name.Name,
+				)
+			}
+		}
+	}
+}
+
+func labelsQuery(fn *ast.FuncDecl, repository string) bool {
+	if len(fn.Body.List) == 0 {
+		return false
+	}
+
+	assign, ok := fn.Body.List[0].(*ast.AssignStmt)
+	if !ok || len(assign.Rhs) != 1 {
+		return false
+	}
+
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) != 3 {
+		return false
+	}
+
+	if name, ok := call.Fun.(*ast.Ident); !ok || name.Name != "withQueryLabel" {
+		return false
+	}
+
+	return stringLiteral(call.Args[1]) == repository &&
+		stringLiteral(call.Args[2]) == fn.Name.Name
+}
+
+func stringLiteral(expr ast.Expr) string {
+	literal, ok := expr.(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return ""
+	}
+
+	value, _ := strconv.Unquote(literal.Value)
+	return value
+}
@@ -0,0 +1,449 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// usageDimensions are the columns usage can be grouped by, with the value
+// selected in their place when it is not.
+var usageDimensions = []struct {
+	groupBy enums.UsageGroupBy
+	column  string
+	zero    string
+}{
+	{enums.UsageGroupByClient, "client_id", "0"},
+	{enums.UsageGroupByProject, "project_id", "0"},
+	{enums.UsageGroupByEnvironment, "environment_id", "0"},
+	{enums.UsageGroupByService, "service_id", "0"},
+	{enums.UsageGroupByAPIKey, "api_key_id", "0"},
+	{enums.UsageGroupByExecutionStatus, "execution_status", "''"},
+	{enums.UsageGroupByUnauthorizedReason, "unauthorized_reason", "''"},
+}
+
+type UsageRepository struct {
+	*Driver
+
+	tableName      string
+	dailyTableName string
+}
+
+func (r *UsageRepository) GetRollupWatermark(
+	ctx context.Context,
+) (time.Time, errors.Error) {
+	ctx = withQueryLabel(ctx, "UsageRepository", "GetRollupWatermark")
+
+	query := `
+		SELECT max(watermark)
+		FROM request_usage_rollup;
+	`
+
+	var watermark *time.Time
+	err := r.pool.QueryRow(ctx, query).Scan(&watermark)
+	if err != nil {
+		return time.Time{}, r.errorMapper(err, r.tableName)
+	}
+
+	if watermark == nil {
+		return time.Time{}, nil
+	}
+	return *watermark, nil
+}
+
+// scanUsageBucket scans a row selected by the query listQuery builds.
+func scanUsageBucket(row pgx.Row) (*entities.UsageBucket, error) {
+	bucket := new(entities.UsageBucket)
+	err := row.Scan(
+		&bucket.Bucket,
+		&bucket.ClientID,
+		&bucket.ProjectID,
+		&bucket.EnvironmentID,
+		&bucket.ServiceID,
+		&bucket.APIKeyID,
+		&bucket.ExecutionStatus,
+		&bucket.UnauthorizedReason,
+		&bucket.Requests,
+		&bucket.Units,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return bucket, nil
+}
+
+func (r *UsageRepository) List(
+	ctx context.Context, filter *dto.UsageFilter,
+) ([]*entities.UsageBucket, errors.Error) {
+	ctx = withQueryLabel(ctx, "UsageRepository", "List")
+
+	query, args, table := r.listQuery(filter)
+
+	rows, err := r.pool.Query(ctx, query+";", args...)
+	if err != nil {
+		return nil, r.errorMapper(err, table)
+	}
+
+	defer rows.Close()
+
+	var buckets []*entities.UsageBucket
+	for rows.Next() {
+		bucket, err := scanUsageBucket(rows)
+		if err != nil {
+			return nil, r.errorMapper(err, table)
+		}
+
+		buckets = append(buckets, bucket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.errorMapper(err, table)
+	}
+	return buckets, nil
+}
+
+// StreamList hands each bucket List would return to yield, stopping at the
+// first error yield returns.
+func (r *UsageRepository) StreamList(
+	ctx context.Context,
+	filter *dto.UsageFilter,
+	yield func(*entities.UsageBucket) errors.Error,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "UsageRepository", "StreamList")
+
+	query, args, table := r.listQuery(filter)
+
+	return r.streamCursor(
+		ctx,
+		table,
+		query,
+		args,
+		func(rows pgx.Rows) errors.Error {
+			bucket, err := scanUsageBucket(rows)
+			if err != nil {
+				return r.errorMapper(err, table)
+			}
+			return yield(bucket)
+		},
+	)
+}
+
+// listQuery returns the query listing the buckets of the filter, its
+// arguments and the rollup table it reads.
+func (r *UsageRepository) listQuery(
+	filter *dto.UsageFilter,
+) (string, []any, string) {
+	table := r.dailyTableName
+	if filter.Granularity == enums.UsageGranularityHour {
+		table = r.tableName
+	}
+
+	groupBy := make(map[enums.UsageGroupBy]bool, len(filter.GroupBy))
+	for _, g := range filter.GroupBy {
+		groupBy[g] = true
+	}
+
+	columns := []string{"date_trunc($1::text, bucket, 'UTC')"}
+	groups := []string{"1"}
+	for i, dimension := range usageDimensions {
+		if !groupBy[dimension.groupBy] {
+			columns = append(columns, dimension.zero)
+			continue
+		}
+
+		columns = append(columns, dimension.column)
+		groups = append(groups, fmt.Sprint(i+2))
+	}
+
+	where := []string{"bucket >= $2", "bucket < $3"}
+	args := []any{string(filter.Granularity), filter.From, filter.To}
+
+	for _, condition := range []struct {
+		column string
+		value  int
+	}{
+		{"client_id", filter.ClientID},
+		{"project_id", filter.ProjectID},
+		{"environment_id", filter.EnvironmentID},
+		{"service_id", filter.ServiceID},
+		{"api_key_id", filter.APIKeyID},
+	} {
+		if condition.value != 0 {
+			where = append(
+				where, fmt.Sprintf("%s = $%d", condition.column, len(args)+1),
+			)
+			args = append(args, condition.value)
+		}
+	}
+
+	query := fmt.Sprintf(
+		`
+		SELECT %s, sum(requests)::bigint, sum(units)::bigint
+		FROM %s
+		WHERE %s
+		GROUP BY %s
+		ORDER BY %s
+		`,
+		strings.Join(columns, ", "),
+		table,
+		strings.Join(where, " AND "),
+		strings.Join(groups, ", "),
+		strings.Join(groups, ", "),
+	)
+
+	return query, args, table
+}
+
+// RefreshRollups refreshes the hourly and daily buckets holding the
+// requests created in [from, to), and records to as the watermark. Buckets
+// are rebuilt whole from the request log, so refreshing one again picks up
+// the status and units of requests updated since. Hours before the ones
+// retention has deleted requests of are not rebuilt, as that would drop the
+// deleted requests from them; the requests created since the last refresh
+// are added to them instead. It returns the number of hourly buckets
+// refreshed.
+func (r *UsageRepository) RefreshRollups(
+	ctx context.Context, from, to time.Time,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "UsageRepository", "RefreshRollups")
+
+	tx, txErr := r.pool.Begin(ctx)
+	if txErr != nil {
+		return 0, r.errorMapper(txErr, r.tableName)
+	}
+
+	watermark, sealedBefore, err := r.rollupState(ctx, tx)
+	if err != nil {
+		tx.Rollback(ctx)
+		return 0, err
+	}
+
+	hours, err := r.touchedHours(ctx, tx, from, to)
+	if err != nil {
+		tx.Rollback(ctx)
+		return 0, err
+	}
+
+	var rebuilt, sealed []time.Time
+	for _, hour := range hours {
+		if hour.Before(sealedBefore) {
+			sealed = append(sealed, hour)
+		} else {
+			rebuilt = append(rebuilt, hour)
+		}
+	}
+
+	if len(rebuilt) > 0 {
+		if err := r.refreshHourly(ctx, tx, rebuilt); err != nil {
+			tx.Rollback(ctx)
+			return 0, err
+		}
+	}
+
+	if len(sealed) > 0 {
+		// The requests created before the watermark were rolled up by the
+		// previous refreshes.
+		since := from
+		if watermark.After(since) {
+			since = watermark
+		}
+
+		if err := r.addHourly(ctx, tx, since, to, sealedBefore); err != nil {
+			tx.Rollback(ctx)
+			return 0, err
+		}
+	}
+
+	if len(hours) > 0 {
+		if err := r.refreshDaily(ctx, tx, hours); err != nil {
+			tx.Rollback(ctx)
+			return 0, err
+		}
+	}
+
+	if err := r.updateWatermark(ctx, tx, to); err != nil {
+		tx.Rollback(ctx)
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, r.errorMapper(err, r.tableName)
+	}
+	return len(hours), nil
+}
+
+// rollupState returns the watermark and the hour before which buckets are
+// sealed, zero when there are none.
+func (r *UsageRepository) rollupState(
+	ctx context.Context, tx pgx.Tx,
+) (time.Time, time.Time, errors.Error) {
+	query := `
+		SELECT max(watermark), max(sealed_before)
+		FROM request_usage_rollup;
+	`
+
+	var watermark, sealedBefore pgtype.Timestamptz
+	err := tx.QueryRow(ctx, query).Scan(&watermark, &sealedBefore)
+	if err != nil {
+		return time.Time{}, time.Time{}, r.errorMapper(err, "request_usage_rollup")
+	}
+	return watermark.Time, sealedBefore.Time, nil
+}
+
+func (r *UsageRepository) touchedHours(
+	ctx context.Context, tx pgx.Tx, from, to time.Time,
+) ([]time.Time, errors.Error) {
+	query := `
+		SELECT DISTINCT date_trunc('hour', request_time, 'UTC')
+		FROM request
+		WHERE created_at >= $1 AND created_at < $2;
+	`
+
+	rows, err := tx.Query(ctx, query, from, to)
+	if err != nil {
+		return nil, r.errorMapper(err, "request")
+	}
+
+	hours, err := pgx.CollectRows(rows, pgx.RowTo[time.Time])
+	if err != nil {
+		return nil, r.errorMapper(err, "request")
+	}
+	return hours, nil
+}
+
+func (r *UsageRepository) refreshHourly(
+	ctx context.Context, tx pgx.Tx, hours []time.Time,
+) errors.Error {
+	deleteQuery := `
+		DELETE FROM request_usage_hourly
+		WHERE bucket = ANY($1);
+	`
+
+	if _, err := tx.Exec(ctx, deleteQuery, hours); err != nil {
+		return r.errorMapper(err, r.tableName)
+	}
+
+	insertQuery := `
+		INSERT INTO request_usage_hourly (
+			bucket, client_id, project_id, environment_id, service_id,
+			api_key_id, execution_status, unauthorized_reason, requests, units
+		)
+		SELECT h.bucket, COALESCE(p.client_id, 0), COALESCE(r.project_id, 0),
+			COALESCE(r.environment_id, 0), COALESCE(r.service_id, 0),
+			COALESCE(r.api_key_id, 0), r.execution_status,
+			COALESCE(r.unauthorized_reason, ''), count(*), sum(r.units)
+		FROM unnest($1::timestamptz[]) AS h(bucket)
+			JOIN request r
+				ON r.request_time >= h.bucket
+				AND r.request_time < h.bucket + interval '1 hour'
+			LEFT JOIN project p ON p.id = r.project_id
+		GROUP BY 1, 2, 3, 4, 5, 6, 7, 8;
+	`
+
+	_, err := tx.Exec(ctx, insertQuery, hours)
+	return r.errorMapper(err, r.tableName)
+}
+
+// addHourly adds the requests created in [from, to) to the hourly buckets
+// before sealedBefore.
+func (r *UsageRepository) addHourly(
+	ctx context.Context, tx pgx.Tx, from, to, sealedBefore time.Time,
+) errors.Error {
+	query := `
+		INSERT INTO request_usage_hourly AS h (
+			bucket, client_id, project_id, environment_id, service_id,
+			api_key_id, execution_status, unauthorized_reason, requests, units
+		)
+		SELECT date_trunc('hour', r.request_time, 'UTC'),
+			COALESCE(p.client_id, 0), COALESCE(r.project_id, 0),
+			COALESCE(r.environment_id, 0), COALESCE(r.service_id, 0),
+			COALESCE(r.api_key_id, 0), r.execution_status,
+			COALESCE(r.unauthorized_reason, ''), count(*), sum(r.units)
+		FROM request r
+			LEFT JOIN project p ON p.id = r.project_id
+		WHERE r.created_at >= $1 AND r.created_at < $2
+			AND r.request_time < $3
+		GROUP BY 1, 2, 3, 4, 5, 6, 7, 8
+		ON CONFLICT (
+			bucket, client_id, project_id, environment_id, service_id,
+			api_key_id, execution_status, unauthorized_reason
+		) DO UPDATE SET
+			requests = h.requests + EXCLUDED.requests,
+			units = h.units + EXCLUDED.units;
+	`
+
+	_, err := tx.Exec(ctx, query, from, to, sealedBefore)
+	return r.errorMapper(err, r.tableName)
+}
+
+func (r *UsageRepository) refreshDaily(
+	ctx context.Context, tx pgx.Tx, hours []time.Time,
+) errors.Error {
+	seen := make(map[time.Time]bool)
+
+	var days []time.Time
+	for _, hour := range hours {
+		day := enums.UsageGranularityDay.Truncate(hour)
+		if !seen[day] {
+			seen[day] = true
+			days = append(days, day)
+		}
+	}
+
+	deleteQuery := `
+		DELETE FROM request_usage_daily
+		WHERE bucket = ANY($1);
+	`
+
+	if _, err := tx.Exec(ctx, deleteQuery, days); err != nil {
+		return r.errorMapper(err, r.dailyTableName)
+	}
+
+	insertQuery := `
+		INSERT INTO request_usage_daily (
+			bucket, client_id, project_id, environment_id, service_id,
+			api_key_id, execution_status, unauthorized_reason, requests, units
+		)
+		SELECT d.bucket, h.client_id, h.project_id, h.environment_id,
+			h.service_id, h.api_key_id, h.execution_status,
+			h.unauthorized_reason, sum(h.requests), sum(h.units)
+		FROM unnest($1::timestamptz[]) AS d(bucket)
+			JOIN request_usage_hourly h
+				ON h.bucket >= d.bucket
+				AND h.bucket < d.bucket + interval '1 day'
+		GROUP BY 1, 2, 3, 4, 5, 6, 7, 8;
+	`
+
+	_, err := tx.Exec(ctx, insertQuery, days)
+	return r.errorMapper(err, r.dailyTableName)
+}
+
+func (r *UsageRepository) updateWatermark(
+	ctx context.Context, tx pgx.Tx, watermark time.Time,
+) errors.Error {
+	query := `
+		INSERT INTO request_usage_rollup (id, watermark)
+		VALUES (TRUE, $1)
+		ON CONFLICT (id) DO UPDATE SET watermark = EXCLUDED.watermark;
+	`
+
+	_, err := tx.Exec(ctx, query, watermark)
+	return r.errorMapper(err, "request_usage_rollup")
+}
+
+func NewUsageRepository(driver *Driver) *UsageRepository {
+	return &UsageRepository{
+		Driver:         driver,
+		tableName:      "request_usage_hourly",
+		dailyTableName: "request_usage_daily",
+	}
+}
@@ -0,0 +1,349 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+// ValidationRepository takes a single round-trip for each step of a
+// validation that consumes quota: one query reads every row the checks
+// need, and one statement consumes the quota, logs the request and marks
+// the key as used.
+type ValidationRepository struct {
+	*Driver
+
+	apiKeyTableName  string
+	requestTableName string
+
+	protector ports.APIKeyProtector
+}
+
+// Lookup reads the service, the API key along with its environment,
+// project and client, and whether the service is assigned to the
+// environment. It always returns a lookup, leaving out what does not
+// exist.
+func (r *ValidationRepository) Lookup(
+	ctx context.Context, key, serviceName, serviceVersion string,
+) (*dto.APIKeyValidationLookup, errors.Error) {
+	ctx = withQueryLabel(ctx, "ValidationRepository", "Lookup")
+
+	query := `
+		SELECT COALESCE(s.id, 0), COALESCE(s.name, ''), COALESCE(s.version, ''),
+			COALESCE(s.status, ''), s.request_retention_days,
+			COALESCE(s.created_at, '0001-01-01 00:00:00.0+00'),
+			COALESCE(k.id, 0), COALESCE(k.environment_id, 0),
+			COALESCE(k.key_prefix, left(k.key, 8), ''), COALESCE(k.status, ''),
+			COALESCE(k.created_at, '0001-01-01 00:00:00.0+00'),
+			COALESCE(k.expires_at, '0001-01-01 00:00:00.0+00'),
+			COALESCE(k.last_used, '0001-01-01 00:00:00.0+00'),
+			COALESCE(k.rotated_from_id, 0),
+			COALESCE(k.grace_ends_at, '0001-01-01 00:00:00.0+00'),
+			COALESCE(k.rate_limit_requests, 0),
+			COALESCE(k.rate_limit_period, ''),
+			COALESCE(k.rate_limit_burst, 0),
+			COALESCE(e.name, ''), COALESCE(e.type, ''), COALESCE(e.status, ''),
+			COALESCE(e.project_id, 0),
+			COALESCE(e.created_at, '0001-01-01 00:00:00.0+00'),
+			COALESCE(p.name, ''), COALESCE(c.id, 0), COALESCE(c.name, ''),
+			es.service_id IS NOT NULL
+		FROM (SELECT 1) AS lookup
+			LEFT JOIN service s
+				ON s.name = $3 AND s.version = $4
+			LEFT JOIN api_key k
+				ON k.key_hash = $1 OR (k.key_hash IS NULL AND k.key = $2)
+			LEFT JOIN environment e
+				ON e.id = k.environment_id
+			LEFT JOIN project p
+				ON p.id = e.project_id
+			LEFT JOIN client c
+				ON c.id = p.client_id
+			LEFT JOIN environment_service es
+				ON es.environment_id = e.id AND es.service_id = s.id;
+	`
+
+	service := new(entities.Service)
+	apiKey := new(entities.APIKey)
+	rateLimit := new(entities.APIKeyRateLimit)
+	environment := new(entities.Environment)
+	projectClient := new(dto.ProjectClientInfoResponse)
+
+	lookup := new(dto.APIKeyValidationLookup)
+	err := r.pool.QueryRow(
+		ctx, query, r.protector.Hash(key), key, serviceName, serviceVersion,
+	).Scan(
+		&service.ID,
+		&service.Name,
+		&service.Version,
+		&service.Status,
+		&service.RequestRetentionDays,
+		&service.CreatedAt,
+		&apiKey.ID,
+		&apiKey.EnvironmentID,
+		&apiKey.KeyPrefix,
+		&apiKey.Status,
+		&apiKey.CreatedAt,
+		&apiKey.ExpiresAt,
+		&apiKey.LastUsed,
+		&apiKey.RotatedFromID,
+		&apiKey.GraceEndsAt,
+		&rateLimit.Requests,
+		&rateLimit.Period,
+		&rateLimit.Burst,
+		&environment.Name,
+		&environment.Type,
+		&environment.Status,
+		&environment.ProjectID,
+		&environment.CreatedAt,
+		&projectClient.ProjectName,
+		&projectClient.ClientID,
+		&projectClient.ClientName,
+		&lookup.ServiceAssigned,
+	)
+	if err != nil {
+		return nil, r.errorMapper(err, r.apiKeyTableName)
+	}
+
+	if service.ID != 0 {
+		lookup.Service = service
+	}
+
+	if apiKey.ID == 0 {
+		return lookup, nil
+	}
+
+	if rateLimit.Requests > 0 {
+		apiKey.RateLimit = rateLimit
+	}
+	lookup.APIKey = apiKey
+
+	environment.ID = apiKey.EnvironmentID
+	lookup.Environment = environment
+
+	projectClient.ProjectID = environment.ProjectID
+	lookup.ProjectClient = projectClient
+
+	return lookup, nil
+}
+
+// consumeQuotaQuery takes $4 units of environment $2 and service $3 from
+// both of its pools and marks API key $1 as used when neither has fewer
+// units left, locking them meanwhile. The outcome CTE tells whether they
+// were taken.
+const consumeQuotaQuery = `
+	WITH target AS (
+		SELECT es.environment_id, es.service_id, ps.project_id,
+			es.max_requests, ps.max_requests AS project_max_requests,
+			es.available_request >= $4 OR es.max_requests = -1
+				AS environment_available,
+			ps.available_request >= $4 OR ps.max_requests = -1
+				AS project_available
+		FROM environment_service es
+			JOIN environment e
+				ON e.id = es.environment_id
+			JOIN project_service ps
+				ON ps.project_id = e.project_id AND ps.service_id = es.service_id
+		WHERE es.environment_id = $2 AND es.service_id = $3
+		FOR UPDATE OF es, ps
+	),
+	consumable AS (
+		SELECT * FROM target
+		WHERE environment_available AND project_available
+	),
+	environment_updated AS (
+		UPDATE environment_service es
+		SET available_request =
+			CASE
+				WHEN es.max_requests = -1
+				THEN es.available_request
+				ELSE es.available_request - $4
+			END
+		FROM consumable t
+		WHERE es.environment_id = t.environment_id
+			AND es.service_id = t.service_id
+		RETURNING es.available_request
+	),
+	project_updated AS (
+		UPDATE project_service ps
+		SET available_request =
+			CASE
+				WHEN ps.max_requests = -1
+				THEN ps.available_request
+				ELSE ps.available_request - $4
+			END
+		FROM consumable t
+		WHERE ps.project_id = t.project_id AND ps.service_id = t.service_id
+		RETURNING ps.available_request
+	),
+	outcome AS (
+		SELECT EXISTS (SELECT 1 FROM consumable) AS consumed
+	),
+	api_key_updated AS (
+		UPDATE api_key k
+		SET last_used = NOW()
+		FROM outcome o
+		WHERE k.id = $1 AND o.consumed
+	)
+`
+
+// consumeQuotaColumns are the columns of the outcome of consumeQuotaQuery,
+// in the order consume reads them.
+const consumeQuotaColumns = `
+	o.consumed,
+	COALESCE(t.max_requests, 0), COALESCE(t.project_max_requests, 0),
+	COALESCE(t.environment_available, FALSE),
+	COALESCE(eu.available_request, 0), COALESCE(pu.available_request, 0)
+`
+
+const consumeQuotaJoins = `
+	LEFT JOIN target t ON TRUE
+	LEFT JOIN environment_updated eu ON TRUE
+	LEFT JOIN project_updated pu ON TRUE
+`
+
+// Consume takes the units of the request from its environment service and
+// project pool, logs the request and marks its API key as used, all in one
+// statement. When either pool has fewer units left, or the service is not
+// assigned to both, nothing is consumed and the request is logged rejected
+// for QUOTA_EXCEEDED instead, the level reported being the environment
+// when the service is not assigned. The request is updated to what was
+// logged.
+func (r *ValidationRepository) Consume(
+	ctx context.Context, request *entities.Request,
+) (*dto.DecrementAvailableRequest, errors.Error) {
+	ctx = withQueryLabel(ctx, "ValidationRepository", "Consume")
+
+	query := consumeQuotaQuery + `,
+		request_created AS (
+			INSERT INTO request (
+				api_key, api_key_id, project_name, project_id,
+				environment_name, environment_id, service_name, service_version,
+				service_id, status_code, execution_status, request_time, path,
+				method, ip_address, metadata, unauthorized_reason, units
+			)
+			SELECT $5::TEXT, $6::INTEGER, $7::TEXT, $8::INTEGER,
+				$9::TEXT, $10::INTEGER, $11::TEXT, $12::TEXT,
+				$13::INTEGER,
+				CASE WHEN o.consumed THEN $14::INTEGER ELSE $23::INTEGER END,
+				CASE WHEN o.consumed THEN $15::TEXT ELSE $24::TEXT END,
+				$16::TIMESTAMPTZ, $17::TEXT, $18::TEXT, $19::TEXT, $20::JSONB,
+				CASE WHEN o.consumed THEN $21::TEXT ELSE $25::TEXT END,
+				CASE WHEN o.consumed THEN $22::INTEGER ELSE 0 END
+			FROM outcome o
+			RETURNING id, created_at
+		)
+		SELECT rc.id, rc.created_at,` + consumeQuotaColumns + `
+		FROM request_created rc
+			CROSS JOIN outcome o` + consumeQuotaJoins + `;
+	`
+
+	rejected := *request
+	rejected.Reject(enums.APIKeyValidationFailureCodeQuotaExceeded)
+
+	args := append(consumeQuotaArgs(request), requestValues(request)...)
+	args = append(
+		args,
+		rejected.StatusCode,
+		rejected.ExecutionStatus,
+		rejected.UnauthorizedReason,
+	)
+
+	return r.consume(
+		ctx, request, query, args, &request.ID, &request.CreatedAt,
+	)
+}
+
+// ConsumeQuota takes the units of the request like Consume, leaving the
+// request to be logged by the caller.
+func (r *ValidationRepository) ConsumeQuota(
+	ctx context.Context, request *entities.Request,
+) (*dto.DecrementAvailableRequest, errors.Error) {
+	ctx = withQueryLabel(ctx, "ValidationRepository", "ConsumeQuota")
+
+	query := consumeQuotaQuery + `
+		SELECT` + consumeQuotaColumns + `
+		FROM outcome o` + consumeQuotaJoins + `;
+	`
+
+	return r.consume(ctx, request, query, consumeQuotaArgs(request))
+}
+
+func consumeQuotaArgs(request *entities.Request) []any {
+	return []any{
+		request.APIKey.ID,
+		request.Environment.ID,
+		request.Service.ID,
+		request.Units,
+	}
+}
+
+// consume runs a query built on consumeQuotaQuery, scanning the columns
+// before the consumeQuotaColumns into dest, and rejects the request when
+// nothing was consumed.
+func (r *ValidationRepository) consume(
+	ctx context.Context,
+	request *entities.Request,
+	query string,
+	args []any,
+	dest ...any,
+) (*dto.DecrementAvailableRequest, errors.Error) {
+	var consumed, environmentAvailable bool
+	var environmentRequests, projectRequests, projectMaxRequests int
+
+	result := new(dto.DecrementAvailableRequest)
+	err := r.pool.QueryRow(ctx, query, args...).Scan(append(
+		dest,
+		&consumed,
+		&result.MaxRequests,
+		&projectMaxRequests,
+		&environmentAvailable,
+		&environmentRequests,
+		&projectRequests,
+	)...)
+	if err != nil {
+		return nil, r.errorMapper(err, r.requestTableName)
+	}
+
+	switch {
+	case !consumed && !environmentAvailable:
+		result.ExceededLevel = enums.QuotaLevelEnvironment
+	case !consumed:
+		result.ExceededLevel = enums.QuotaLevelProject
+	case projectRequests == -1:
+		result.AvailableRequest = environmentRequests
+	case environmentRequests == -1:
+		result.AvailableRequest = projectRequests
+	default:
+		result.AvailableRequest = min(environmentRequests, projectRequests)
+	}
+
+	if result.ExceededLevel != enums.QuotaLevelNull {
+		request.Reject(enums.APIKeyValidationFailureCodeQuotaExceeded)
+		return result, nil
+	}
+
+	result.Environment = dto.QuotaBalance{
+		MaxRequests:      result.MaxRequests,
+		AvailableRequest: environmentRequests,
+	}
+	result.Project = dto.QuotaBalance{
+		MaxRequests:      projectMaxRequests,
+		AvailableRequest: projectRequests,
+	}
+	return result, nil
+}
+
+func NewValidationRepository(
+	driver *Driver, protector ports.APIKeyProtector,
+) *ValidationRepository {
+	return &ValidationRepository{
+		Driver:           driver,
+		apiKeyTableName:  "api_key",
+		requestTableName: "request",
+		protector:        protector,
+	}
+}
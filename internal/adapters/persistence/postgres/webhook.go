@@ -0,0 +1,668 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// webhookPayload is the body posted to webhooks. The client and project
+// of the event are added to its data when the deliveries are created.
+type webhookPayload struct {
+	Type       enums.WebhookEventType `json:"type"`
+	OccurredAt time.Time              `json:"occurred_at"`
+	Data       webhookPayloadData     `json:"data"`
+}
+
+type webhookPayloadData struct {
+	EnvironmentID int                  `json:"environment_id,omitempty"`
+	ServiceID     int                  `json:"service_id,omitempty"`
+	APIKeyID      int                  `json:"api_key_id,omitempty"`
+	Quota         *webhookPayloadQuota `json:"quota,omitempty"`
+}
+
+type webhookPayloadQuota struct {
+	Level            enums.QuotaLevel `json:"level"`
+	AlertID          int              `json:"alert_id,omitempty"`
+	Threshold        int              `json:"threshold,omitempty"`
+	MaxRequests      int              `json:"max_requests"`
+	AvailableRequest int              `json:"available_request"`
+}
+
+func newWebhookPayload(event *entities.WebhookEvent) *webhookPayload {
+	payload := &webhookPayload{
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt.UTC(),
+		Data: webhookPayloadData{
+			EnvironmentID: event.EnvironmentID,
+			ServiceID:     event.ServiceID,
+			APIKeyID:      event.APIKeyID,
+		},
+	}
+
+	if event.Quota != nil {
+		payload.Data.Quota = &webhookPayloadQuota{
+			Level:            event.Quota.Level,
+			AlertID:          event.Quota.AlertID,
+			Threshold:        event.Quota.Threshold,
+			MaxRequests:      event.Quota.MaxRequests,
+			AvailableRequest: event.Quota.AvailableRequest,
+		}
+	}
+
+	return payload
+}
+
+type WebhookRepository struct {
+	*Driver
+
+	tableName         string
+	deliveryTableName string
+}
+
+func (r *WebhookRepository) Exists(
+	ctx context.Context, id int,
+) (bool, errors.Error) {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "Exists")
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM webhook
+			WHERE id = $1
+		);
+	`
+
+	var exists bool
+	err := r.pool.QueryRow(ctx, query, id).Scan(&exists)
+	if err != nil {
+		return false, r.errorMapper(err, r.tableName)
+	}
+
+	return exists, nil
+}
+
+func (r *WebhookRepository) GetByID(
+	ctx context.Context, id int,
+) (*entities.Webhook, errors.Error) {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "GetByID")
+
+	query := `
+		SELECT id, COALESCE(client_id, 0), COALESCE(project_id, 0), url,
+			secret, events, status, created_at
+		FROM webhook
+		WHERE id = $1;
+	`
+
+	webhook, err := scanWebhook(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return webhook, nil
+}
+
+func (r *WebhookRepository) Count(
+	ctx context.Context, filter *dto.WebhookFilter,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "Count")
+
+	where, args := r.filterConditions(filter)
+
+	query := "SELECT count(*) FROM webhook"
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
+
+	var total int
+	err := r.pool.QueryRow(ctx, query+";", args...).Scan(&total)
+	if err != nil {
+		return 0, r.errorMapper(err, r.tableName)
+	}
+
+	return total, nil
+}
+
+func (r *WebhookRepository) List(
+	ctx context.Context, filter *dto.WebhookFilter, page *dto.Pagination,
+) ([]*entities.Webhook, errors.Error) {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "List")
+
+	where, args := r.filterConditions(filter)
+
+	where, args, clauses, pageErr := paginate(
+		page, "created_at", "id", true, where, args,
+	)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
+	query := `
+		SELECT id, COALESCE(client_id, 0), COALESCE(project_id, 0), url,
+			secret, events, status, created_at
+		FROM webhook
+	`
+
+	if len(where) > 0 {
+		query = fmt.Sprintf("%s WHERE %s", query, strings.Join(where, " AND "))
+	}
+
+	query += clauses + ";"
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	defer rows.Close()
+
+	var webhooks []*entities.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, r.errorMapper(err, r.tableName)
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return webhooks, nil
+}
+
+func (r *WebhookRepository) filterConditions(
+	filter *dto.WebhookFilter,
+) ([]string, []any) {
+	var where []string
+	var args []any
+
+	if filter == nil {
+		return where, args
+	}
+
+	if filter.ClientID != 0 {
+		where = append(where, fmt.Sprintf("client_id = $%d", len(args)+1))
+		args = append(args, filter.ClientID)
+	}
+
+	if filter.ProjectID != 0 {
+		where = append(where, fmt.Sprintf("project_id = $%d", len(args)+1))
+		args = append(args, filter.ProjectID)
+	}
+
+	return where, args
+}
+
+func (r *WebhookRepository) Create(
+	ctx context.Context, webhook *entities.Webhook,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "Create")
+
+	query := `
+		INSERT INTO webhook (client_id, project_id, url, secret, events, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at;
+	`
+
+	var clientID, projectID any
+	if webhook.ClientID != 0 {
+		clientID = webhook.ClientID
+	}
+	if webhook.ProjectID != 0 {
+		projectID = webhook.ProjectID
+	}
+
+	err := r.pool.QueryRow(
+		ctx,
+		query,
+		clientID,
+		projectID,
+		webhook.URL,
+		webhook.Secret,
+		webhookEventsToText(webhook.Events),
+		webhook.Status,
+	).Scan(&webhook.ID, &webhook.CreatedAt)
+
+	return r.errorMapper(err, r.tableName)
+}
+
+func (r *WebhookRepository) Update(
+	ctx context.Context, id int, update *dto.WebhookUpdate,
+) (*entities.Webhook, errors.Error) {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "Update")
+
+	if update == nil {
+		return r.GetByID(ctx, id)
+	}
+
+	var updates []string
+	args := []any{id}
+	argIndex := 2
+
+	if update.URL != "" {
+		updates = append(updates, fmt.Sprintf("url = $%d", argIndex))
+		args = append(args, update.URL)
+		argIndex++
+	}
+
+	if len(update.Events) > 0 {
+		updates = append(updates, fmt.Sprintf("events = $%d", argIndex))
+		args = append(args, webhookEventsToText(update.Events))
+		argIndex++
+	}
+
+	if update.Status != enums.WebhookStatusNull {
+		updates = append(updates, fmt.Sprintf("status = $%d", argIndex))
+		args = append(args, update.Status)
+		argIndex++
+	}
+
+	if len(updates) == 0 {
+		return r.GetByID(ctx, id)
+	}
+
+	query := fmt.Sprintf(
+		`
+			UPDATE webhook
+			SET %s
+			WHERE id = $1
+			RETURNING id, COALESCE(client_id, 0), COALESCE(project_id, 0),
+				url, secret, events, status, created_at;
+		`,
+		strings.Join(updates, ", "),
+	)
+
+	webhook, err := scanWebhook(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, r.errorMapper(err, r.tableName)
+	}
+
+	return webhook, nil
+}
+
+func (r *WebhookRepository) Delete(ctx context.Context, id int) errors.Error {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "Delete")
+
+	query := `
+		DELETE FROM webhook
+		WHERE id = $1;
+	`
+
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return r.errorMapper(err, r.tableName)
+	}
+
+	if result.RowsAffected() == 0 {
+		return r.entityNotFoundError(r.tableName, map[string]any{"id": id})
+	}
+
+	return nil
+}
+
+// CreateDeliveries queues each event for the enabled webhooks subscribed
+// to its type through its project or the project's client, and returns the
+// number of deliveries created.
+func (r *WebhookRepository) CreateDeliveries(
+	ctx context.Context, events []*entities.WebhookEvent,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "CreateDeliveries")
+
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	types := make([]string, len(events))
+	projectIDs := make([]int, len(events))
+	environmentIDs := make([]int, len(events))
+	payloads := make([]string, len(events))
+	for i, event := range events {
+		payload, err := json.Marshal(newWebhookPayload(event))
+		if err != nil {
+			return 0, errors.NewInternal("failed to encode webhook payload", err)
+		}
+
+		types[i] = string(event.Type)
+		projectIDs[i] = event.ProjectID
+		environmentIDs[i] = event.EnvironmentID
+		payloads[i] = string(payload)
+	}
+
+	query := `
+		INSERT INTO webhook_delivery (webhook_id, event, payload)
+		SELECT w.id, e.event,
+			jsonb_set(
+				jsonb_set(e.payload::jsonb, '{data,client_id}', to_jsonb(p.client_id)),
+				'{data,project_id}', to_jsonb(p.id)
+			)
+		FROM unnest($1::text[], $2::int[], $3::int[], $4::text[])
+				AS e(event, project_id, environment_id, payload)
+			LEFT JOIN environment env ON env.id = e.environment_id
+			JOIN project p
+				ON p.id = COALESCE(NULLIF(e.project_id, 0), env.project_id)
+			JOIN webhook w
+				ON w.project_id = p.id OR w.client_id = p.client_id
+		WHERE w.status = 'enabled' AND e.event = ANY(w.events);
+	`
+
+	result, err := r.pool.Exec(
+		ctx, query, types, projectIDs, environmentIDs, payloads,
+	)
+	if err != nil {
+		return 0, r.errorMapper(err, r.deliveryTableName)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+func (r *WebhookRepository) CountDeliveries(
+	ctx context.Context, id int, filter *dto.WebhookDeliveryFilter,
+) (int, errors.Error) {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "CountDeliveries")
+
+	where, args := r.deliveryFilterConditions(id, filter)
+
+	query := fmt.Sprintf(
+		"SELECT count(*) FROM webhook_delivery WHERE %s;",
+		strings.Join(where, " AND "),
+	)
+
+	var total int
+	err := r.pool.QueryRow(ctx, query, args...).Scan(&total)
+	if err != nil {
+		return 0, r.errorMapper(err, r.deliveryTableName)
+	}
+
+	return total, nil
+}
+
+func (r *WebhookRepository) ListDeliveries(
+	ctx context.Context,
+	id int,
+	filter *dto.WebhookDeliveryFilter,
+	page *dto.Pagination,
+) ([]*entities.WebhookDelivery, errors.Error) {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "ListDeliveries")
+
+	where, args := r.deliveryFilterConditions(id, filter)
+
+	where, args, clauses, pageErr := paginate(
+		page, "created_at", "id", false, where, args,
+	)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+
+	query := fmt.Sprintf(
+		`
+		SELECT id, webhook_id, event, payload, status, attempts,
+			next_attempt_at, COALESCE(last_status_code, 0),
+			COALESCE(last_error, ''),
+			COALESCE(delivered_at, '0001-01-01 00:00:00.0+00'), created_at
+		FROM webhook_delivery
+		WHERE %s%s;
+		`,
+		strings.Join(where, " AND "),
+		clauses,
+	)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, r.errorMapper(err, r.deliveryTableName)
+	}
+
+	defer rows.Close()
+
+	var deliveries []*entities.WebhookDelivery
+	for rows.Next() {
+		delivery := new(entities.WebhookDelivery)
+
+		err = rows.Scan(
+			&delivery.ID,
+			&delivery.WebhookID,
+			&delivery.Event,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptAt,
+			&delivery.LastStatusCode,
+			&delivery.LastError,
+			&delivery.DeliveredAt,
+			&delivery.CreatedAt,
+		)
+		if err != nil {
+			return nil, r.errorMapper(err, r.deliveryTableName)
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.errorMapper(err, r.deliveryTableName)
+	}
+
+	return deliveries, nil
+}
+
+func (r *WebhookRepository) deliveryFilterConditions(
+	id int, filter *dto.WebhookDeliveryFilter,
+) ([]string, []any) {
+	where := []string{"webhook_id = $1"}
+	args := []any{id}
+
+	if filter != nil && filter.Status != enums.WebhookDeliveryStatusNull {
+		where = append(where, fmt.Sprintf("status = $%d", len(args)+1))
+		args = append(args, filter.Status)
+	}
+
+	return where, args
+}
+
+// ClaimDueDeliveries returns up to limit pending deliveries of enabled
+// webhooks due at now, oldest first, with the URL and secret of their
+// webhook. Their next attempt is pushed lease into the future, so a
+// delivery whose outcome is never recorded is sent again once the lease
+// expires, and concurrent dispatchers skip the deliveries claimed.
+func (r *WebhookRepository) ClaimDueDeliveries(
+	ctx context.Context, now time.Time, lease time.Duration, limit int,
+) ([]*entities.WebhookDelivery, errors.Error) {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "ClaimDueDeliveries")
+
+	query := `
+		WITH due AS (
+			SELECT d.id
+			FROM webhook_delivery d
+				JOIN webhook w ON w.id = d.webhook_id
+			WHERE d.status = 'pending' AND d.next_attempt_at <= $1
+				AND w.status = 'enabled'
+			ORDER BY d.next_attempt_at
+			LIMIT $3
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		UPDATE webhook_delivery d
+		SET next_attempt_at = $2
+		FROM due, webhook w
+		WHERE d.id = due.id AND w.id = d.webhook_id
+		RETURNING d.id, d.webhook_id, d.event, d.payload, d.status,
+			d.attempts, d.next_attempt_at, d.created_at, w.url, w.secret;
+	`
+
+	rows, err := r.pool.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, r.errorMapper(err, r.deliveryTableName)
+	}
+
+	defer rows.Close()
+
+	var deliveries []*entities.WebhookDelivery
+	for rows.Next() {
+		delivery := new(entities.WebhookDelivery)
+
+		err = rows.Scan(
+			&delivery.ID,
+			&delivery.WebhookID,
+			&delivery.Event,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptAt,
+			&delivery.CreatedAt,
+			&delivery.URL,
+			&delivery.Secret,
+		)
+		if err != nil {
+			return nil, r.errorMapper(err, r.deliveryTableName)
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.errorMapper(err, r.deliveryTableName)
+	}
+
+	return deliveries, nil
+}
+
+// UpdateDelivery records the outcome of the last attempt of the delivery.
+func (r *WebhookRepository) UpdateDelivery(
+	ctx context.Context, delivery *entities.WebhookDelivery,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "UpdateDelivery")
+
+	query := `
+		UPDATE webhook_delivery
+		SET status = $2, attempts = $3, next_attempt_at = $4,
+			last_status_code = $5, last_error = $6, delivered_at = $7
+		WHERE id = $1;
+	`
+
+	var lastStatusCode, lastError, deliveredAt any
+	if delivery.LastStatusCode != 0 {
+		lastStatusCode = delivery.LastStatusCode
+	}
+	if delivery.LastError != "" {
+		lastError = delivery.LastError
+	}
+	if !delivery.DeliveredAt.IsZero() {
+		deliveredAt = delivery.DeliveredAt
+	}
+
+	result, err := r.pool.Exec(
+		ctx,
+		query,
+		delivery.ID,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		lastStatusCode,
+		lastError,
+		deliveredAt,
+	)
+	if err != nil {
+		return r.errorMapper(err, r.deliveryTableName)
+	}
+
+	if result.RowsAffected() == 0 {
+		return r.entityNotFoundError(
+			r.deliveryTableName, map[string]any{"id": delivery.ID},
+		)
+	}
+
+	return nil
+}
+
+// GetExpiryWatermark returns the expiration time up to which expired API
+// keys have been notified, zero if they never were.
+func (r *WebhookRepository) GetExpiryWatermark(
+	ctx context.Context,
+) (time.Time, errors.Error) {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "GetExpiryWatermark")
+
+	query := `
+		SELECT max(watermark)
+		FROM webhook_api_key_expiry;
+	`
+
+	var watermark *time.Time
+	err := r.pool.QueryRow(ctx, query).Scan(&watermark)
+	if err != nil {
+		return time.Time{}, r.errorMapper(err, "webhook_api_key_expiry")
+	}
+
+	if watermark == nil {
+		return time.Time{}, nil
+	}
+	return *watermark, nil
+}
+
+func (r *WebhookRepository) UpdateExpiryWatermark(
+	ctx context.Context, watermark time.Time,
+) errors.Error {
+	ctx = withQueryLabel(ctx, "WebhookRepository", "UpdateExpiryWatermark")
+
+	query := `
+		INSERT INTO webhook_api_key_expiry (id, watermark)
+		VALUES (TRUE, $1)
+		ON CONFLICT (id) DO UPDATE SET watermark = EXCLUDED.watermark;
+	`
+
+	_, err := r.pool.Exec(ctx, query, watermark)
+	return r.errorMapper(err, "webhook_api_key_expiry")
+}
+
+// scanWebhook scans a row holding the id, client, project, URL, secret,
+// events, status and creation time of a webhook.
+func scanWebhook(row pgx.Row) (*entities.Webhook, error) {
+	webhook := new(entities.Webhook)
+
+	var events []string
+	err := row.Scan(
+		&webhook.ID,
+		&webhook.ClientID,
+		&webhook.ProjectID,
+		&webhook.URL,
+		&webhook.Secret,
+		&events,
+		&webhook.Status,
+		&webhook.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.Events = make([]enums.WebhookEventType, len(events))
+	for i, event := range events {
+		webhook.Events[i] = enums.WebhookEventType(event)
+	}
+
+	return webhook, nil
+}
+
+func webhookEventsToText(events []enums.WebhookEventType) []string {
+	text := make([]string, len(events))
+	for i, event := range events {
+		text[i] = string(event)
+	}
+	return text
+}
+
+func NewWebhookRepository(driver *Driver) *WebhookRepository {
+	return &WebhookRepository{
+		Driver:            driver,
+		tableName:         "webhook",
+		deliveryTableName: "webhook_delivery",
+	}
+}
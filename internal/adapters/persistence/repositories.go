@@ -9,6 +9,7 @@ type DriverType string
 
 const (
 	PostgresDriver DriverType = "postgres"
+	MemoryDriver   DriverType = "memory"
 )
 
 type Repositories interface {
@@ -25,4 +26,14 @@ type Repositories interface {
 	Request() ports.RequestRepository
 	Environment() ports.EnvironmentRepository
 	Reservation() ports.ReservationRepository
+	Usage() ports.UsageRepository
+	Webhook() ports.WebhookRepository
+	QuotaAlert() ports.QuotaAlertRepository
+	Audit() ports.AuditRepository
+	Credentials() ports.CredentialsRepository
+	ClientToken() ports.ClientTokenRepository
+	Validation() ports.ValidationRepository
+
+	// ... Rate Limiting ...
+	RateLimiter() ports.RateLimiter
 }
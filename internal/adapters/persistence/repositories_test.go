@@ -0,0 +1,59 @@
+package persistence_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence"
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence/conformance"
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence/migrations"
+	"github.com/MAD-py/pandora-core/internal/adapters/security"
+)
+
+var apiKeyProtector = security.NewAPIKeyProtector([]byte("conformance"))
+
+func TestMemoryRepositories(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) persistence.Repositories {
+		return persistence.NewRepositories(
+			persistence.MemoryDriver, "", apiKeyProtector,
+		)
+	})
+}
+
+// TestPostgresRepositories runs against the database PANDORA_TEST_DB_DNS
+// points to, whose schema is dropped and migrated again before each test.
+func TestPostgresRepositories(t *testing.T) {
+	dns, exists := os.LookupEnv("PANDORA_TEST_DB_DNS")
+	if !exists {
+		t.Skip("PANDORA_TEST_DB_DNS is not set")
+	}
+
+	conformance.Run(t, func(t *testing.T) persistence.Repositories {
+		return newPostgresRepositories(t, dns)
+	})
+}
+
+// newPostgresRepositories drops the schema of the database and migrates it
+// again, returning repositories of the empty database.
+func newPostgresRepositories(tb testing.TB, dns string) persistence.Repositories {
+	ctx := context.Background()
+
+	migrator, err := migrations.NewMigrator(ctx, dns, migrations.SetPandora)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer migrator.Close(ctx)
+
+	if err := migrator.To(ctx, 0); err != nil {
+		tb.Fatal(err)
+	}
+
+	if err := migrator.Up(ctx); err != nil {
+		tb.Fatal(err)
+	}
+
+	return persistence.NewRepositories(
+		persistence.PostgresDriver, dns, apiKeyProtector,
+	)
+}
@@ -0,0 +1,187 @@
+package persistence_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// BenchmarkValidateConsume compares the queries of a validation that
+// consumes quota taken one row at a time against the single lookup and
+// statement of the validation repository. The postgres driver runs against
+// the database PANDORA_TEST_DB_DNS points to, whose schema is dropped.
+func BenchmarkValidateConsume(b *testing.B) {
+	b.Run("memory", func(b *testing.B) {
+		benchmarkValidateConsume(b, persistence.NewRepositories(
+			persistence.MemoryDriver, "", apiKeyProtector,
+		))
+	})
+
+	b.Run("postgres", func(b *testing.B) {
+		dns, exists := os.LookupEnv("PANDORA_TEST_DB_DNS")
+		if !exists {
+			b.Skip("PANDORA_TEST_DB_DNS is not set")
+		}
+
+		benchmarkValidateConsume(b, newPostgresRepositories(b, dns))
+	})
+}
+
+func benchmarkValidateConsume(b *testing.B, repos persistence.Repositories) {
+	defer repos.Close()
+
+	ctx := context.Background()
+	apiKey, service := seedValidation(b, ctx, repos)
+
+	b.Run("SeparateQueries", func(b *testing.B) {
+		for range b.N {
+			foundService, err := repos.Service().GetByNameAndVersion(
+				ctx, service.Name, service.Version,
+			)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			foundKey, err := repos.APIKey().GetByKey(ctx, apiKey.Key)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			environment, err := repos.Environment().GetByID(ctx, foundKey.EnvironmentID)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			_, err = repos.Project().GetProjectClientInfoByID(ctx, environment.ProjectID)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			_, err = repos.Environment().DecrementAvailableRequest(
+				ctx, environment.ID, foundService.ID, 1,
+			)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			request := newBenchmarkRequest(apiKey, environment, foundService)
+			if err := repos.Request().Create(ctx, request); err != nil {
+				b.Fatal(err)
+			}
+
+			if err := repos.APIKey().UpdateLastUsed(ctx, apiKey.Key); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("SingleStatement", func(b *testing.B) {
+		for range b.N {
+			lookup, err := repos.Validation().Lookup(
+				ctx, apiKey.Key, service.Name, service.Version,
+			)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			request := newBenchmarkRequest(
+				apiKey, lookup.Environment, lookup.Service,
+			)
+			if _, err := repos.Validation().Consume(ctx, request); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// seedValidation creates an API key able to consume a service without
+// limit.
+func seedValidation(
+	b *testing.B, ctx context.Context, repos persistence.Repositories,
+) (*entities.APIKey, *entities.Service) {
+	b.Helper()
+
+	service := &entities.Service{
+		Name: "billing", Version: "v1", Status: enums.ServiceStatusEnabled,
+	}
+	if err := repos.Service().Create(ctx, service); err != nil {
+		b.Fatal(err)
+	}
+
+	client := &entities.Client{
+		Type: enums.ClientTypeOrganization, Name: "acme", Email: "acme@example.com",
+	}
+	if err := repos.Client().Create(ctx, client); err != nil {
+		b.Fatal(err)
+	}
+
+	project := &entities.Project{
+		Name:     "project",
+		Status:   enums.ProjectStatusEnabled,
+		ClientID: client.ID,
+		Services: []*entities.ProjectService{{
+			ID:               service.ID,
+			MaxRequests:      -1,
+			AvailableRequest: -1,
+			ResetFrequency:   enums.ProjectServiceResetFrequencyMonthly,
+			NextReset:        time.Now().AddDate(0, 1, 0).Truncate(time.Hour),
+		}},
+	}
+	if err := repos.Project().Create(ctx, project); err != nil {
+		b.Fatal(err)
+	}
+
+	environment := &entities.Environment{
+		Name:      "production",
+		Type:      enums.EnvironmentTypeLive,
+		Status:    enums.EnvironmentStatusEnabled,
+		ProjectID: project.ID,
+		Services: []*entities.EnvironmentService{{
+			ID: service.ID, MaxRequests: -1, AvailableRequest: -1,
+		}},
+	}
+	if err := repos.Environment().Create(ctx, environment); err != nil {
+		b.Fatal(err)
+	}
+
+	apiKey := &entities.APIKey{
+		Status: enums.APIKeyStatusEnabled, EnvironmentID: environment.ID,
+	}
+	if err := apiKey.GenerateKey("pdr", enums.EnvironmentTypeLive); err != nil {
+		b.Fatal(err)
+	}
+	if err := repos.APIKey().Create(ctx, apiKey); err != nil {
+		b.Fatal(err)
+	}
+
+	return apiKey, service
+}
+
+func newBenchmarkRequest(
+	apiKey *entities.APIKey, environment *entities.Environment, service *entities.Service,
+) *entities.Request {
+	return &entities.Request{
+		APIKey: &entities.RequestAPIKey{ID: apiKey.ID, Key: apiKey.Key},
+		Environment: &entities.RequestEnvironment{
+			ID: environment.ID, Name: environment.Name,
+		},
+		Project: &entities.RequestProject{ID: environment.ProjectID},
+		Service: &entities.RequestService{
+			ID: service.ID, Name: service.Name, Version: service.Version,
+		},
+		ExecutionStatus: enums.RequestExecutionStatusForwarded,
+		StatusCode:      http.StatusOK,
+		Units:           1,
+		RequestTime:     time.Now().UTC(),
+		Path:            "/v1/invoices",
+		Method:          http.MethodGet,
+		IPAddress:       "10.0.0.1",
+		Metadata:        &entities.RequestMetadata{},
+	}
+}
@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+const sweepInterval = time.Minute
+
+// memoryRateLimiter keeps the theoretical arrival time of each key in
+// process memory. Limits are enforced per instance.
+type memoryRateLimiter struct {
+	mu sync.Mutex
+
+	tats      map[string]time.Time
+	lastSweep time.Time
+
+	now func() time.Time
+}
+
+func (l *memoryRateLimiter) Allow(
+	ctx context.Context, key string, limit *entities.APIKeyRateLimit,
+) (time.Duration, errors.Error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	tat, retryAfter := limit.Take(l.tats[key], now)
+	if retryAfter > 0 {
+		return retryAfter, nil
+	}
+
+	l.tats[key] = tat
+	return 0, nil
+}
+
+// sweep drops the keys whose bucket is full again, as they behave the
+// same as keys never seen.
+func (l *memoryRateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+
+	for key, tat := range l.tats {
+		if !tat.After(now) {
+			delete(l.tats, key)
+		}
+	}
+
+	l.lastSweep = now
+}
+
+func NewMemoryRateLimiter() ports.RateLimiter {
+	return &memoryRateLimiter{
+		tats:      make(map[string]time.Time),
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+}
@@ -0,0 +1,140 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// fakeClock is a clock tests move by hand.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestLimiter(clock *fakeClock) *memoryRateLimiter {
+	return &memoryRateLimiter{
+		tats:      make(map[string]time.Time),
+		lastSweep: clock.Now(),
+		now:       clock.Now,
+	}
+}
+
+func TestMemoryRateLimiterAllow(t *testing.T) {
+	ctx := context.Background()
+
+	// 10 requests a second earn one back every 100ms, up to a burst of 3.
+	limit := entities.NewAPIKeyRateLimit(10, enums.APIKeyRateLimitPeriodSecond, 3)
+
+	type step struct {
+		advance    time.Duration
+		key        string
+		retryAfter time.Duration
+	}
+
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "burst then retry after",
+			steps: []step{
+				{key: "a"},
+				{key: "a"},
+				{key: "a"},
+				{key: "a", retryAfter: 100 * time.Millisecond},
+				{advance: 40 * time.Millisecond, key: "a", retryAfter: 60 * time.Millisecond},
+			},
+		},
+		{
+			name: "steady refill",
+			steps: []step{
+				{key: "a"},
+				{key: "a"},
+				{key: "a"},
+				{advance: 100 * time.Millisecond, key: "a"},
+				{key: "a", retryAfter: 100 * time.Millisecond},
+				{advance: 100 * time.Millisecond, key: "a"},
+				{advance: 300 * time.Millisecond, key: "a"},
+				{key: "a"},
+				{key: "a"},
+				{key: "a", retryAfter: 100 * time.Millisecond},
+			},
+		},
+		{
+			name: "keys are limited apart",
+			steps: []step{
+				{key: "a"},
+				{key: "a"},
+				{key: "a"},
+				{key: "a", retryAfter: 100 * time.Millisecond},
+				{key: "b"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{now: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)}
+			limiter := newTestLimiter(clock)
+
+			for i, step := range tt.steps {
+				clock.Advance(step.advance)
+
+				retryAfter, err := limiter.Allow(ctx, step.key, limit)
+				if err != nil {
+					t.Fatalf("step %d: %v", i, err)
+				}
+				if retryAfter != step.retryAfter {
+					t.Fatalf(
+						"step %d: retryAfter = %s, want %s",
+						i, retryAfter, step.retryAfter,
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryRateLimiterSweep(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)}
+	limiter := newTestLimiter(clock)
+
+	second := entities.NewAPIKeyRateLimit(10, enums.APIKeyRateLimitPeriodSecond, 0)
+	minute := entities.NewAPIKeyRateLimit(1, enums.APIKeyRateLimitPeriodMinute, 0)
+
+	limiter.Allow(ctx, "refilled", second)
+
+	// Keys are only swept once every sweepInterval.
+	clock.Advance(sweepInterval / 2)
+	limiter.Allow(ctx, "waiting", minute)
+	if _, ok := limiter.tats["refilled"]; !ok {
+		t.Fatal("key swept before the sweep interval")
+	}
+
+	clock.Advance(sweepInterval / 2)
+	limiter.Allow(ctx, "other", second)
+
+	if _, ok := limiter.tats["refilled"]; ok {
+		t.Error("refilled key was not swept")
+	}
+	if _, ok := limiter.tats["waiting"]; !ok {
+		t.Error("key still refilling was swept")
+	}
+	if _, ok := limiter.tats["other"]; !ok {
+		t.Error("key allowed by this call was swept")
+	}
+
+	// Once swept, a key behaves as never seen.
+	retryAfter, _ := limiter.Allow(ctx, "refilled", second)
+	if retryAfter != 0 {
+		t.Errorf("swept key was limited for %s", retryAfter)
+	}
+}
@@ -0,0 +1,27 @@
+package ratelimit
+
+import (
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+const (
+	BackendMemory   = "memory"
+	BackendPostgres = "postgres"
+)
+
+// NewRateLimiter returns the in-memory limiter unless the shared postgres
+// backend is requested, which is needed to enforce a limit across several
+// gRPC instances.
+func NewRateLimiter(
+	backend string, repositories persistence.Repositories,
+) ports.RateLimiter {
+	switch backend {
+	case BackendPostgres:
+		return repositories.RateLimiter()
+	case BackendMemory:
+		return NewMemoryRateLimiter()
+	default:
+		panic("unsupported rate limit backend " + backend)
+	}
+}
@@ -0,0 +1,112 @@
+package requestlog
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+// loggedRepositories logs the validated requests through the writer and
+// leaves every other method to the wrapped repositories.
+type loggedRepositories struct {
+	persistence.Repositories
+
+	writer *Writer
+}
+
+func (r *loggedRepositories) Request() ports.RequestRepository {
+	return &requestRepository{
+		RequestRepository: r.Repositories.Request(),
+		writer:            r.writer,
+	}
+}
+
+func (r *loggedRepositories) Validation() ports.ValidationRepository {
+	return &validationRepository{
+		ValidationRepository: r.Repositories.Validation(),
+		writer:               r.writer,
+	}
+}
+
+// requestRepository queues the requests it creates, and waits for a
+// request to be written before reading or updating it.
+type requestRepository struct {
+	ports.RequestRepository
+
+	writer *Writer
+}
+
+func (r *requestRepository) GetByID(
+	ctx context.Context, id string,
+) (*entities.Request, errors.Error) {
+	r.writer.Wait(ctx, id)
+	return r.RequestRepository.GetByID(ctx, id)
+}
+
+func (r *requestRepository) ListChain(
+	ctx context.Context, id string,
+) ([]*entities.Request, errors.Error) {
+	r.writer.Wait(ctx, id)
+	return r.RequestRepository.ListChain(ctx, id)
+}
+
+func (r *requestRepository) Create(
+	ctx context.Context, request *entities.Request,
+) errors.Error {
+	dropped, err := r.writer.Enqueue(ctx, request)
+	request.Dropped = dropped
+	return err
+}
+
+func (r *requestRepository) UpdateExecutionStatus(
+	ctx context.Context, id string, update *dto.RequestExecutionStatusUpdate,
+) errors.Error {
+	r.writer.Wait(ctx, id)
+	return r.RequestRepository.UpdateExecutionStatus(ctx, id, update)
+}
+
+func (r *requestRepository) UpdateUnits(
+	ctx context.Context, id string, units int,
+) errors.Error {
+	r.writer.Wait(ctx, id)
+	return r.RequestRepository.UpdateUnits(ctx, id, units)
+}
+
+// validationRepository consumes the quota of a request in one statement
+// and queues the request, instead of logging it in the same statement.
+type validationRepository struct {
+	ports.ValidationRepository
+
+	writer *Writer
+}
+
+func (r *validationRepository) Consume(
+	ctx context.Context, request *entities.Request,
+) (*dto.DecrementAvailableRequest, errors.Error) {
+	result, err := r.ValidationRepository.ConsumeQuota(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	dropped, err := r.writer.Enqueue(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Dropped = dropped
+	return result, nil
+}
+
+// NewRepositories wraps the repositories so the requests the validations
+// log go through the writer. It is meant for the gRPC server, the only one
+// validating API keys; requests created as the initial point of a
+// reservation are still written right away.
+func NewRepositories(
+	repositories persistence.Repositories, writer *Writer,
+) persistence.Repositories {
+	return &loggedRepositories{Repositories: repositories, writer: writer}
+}
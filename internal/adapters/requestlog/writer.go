@@ -0,0 +1,269 @@
+package requestlog
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/MAD-py/pandora-core/internal/adapters/metrics"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// writeTimeout bounds each batch the writer hands to the repository.
+const writeTimeout = 30 * time.Second
+
+// Repository writes the batches of a Writer.
+type Repository interface {
+	CreateBatch(ctx context.Context, requests []*entities.Request) errors.Error
+}
+
+// Writer logs requests in the background, queueing them in memory and
+// writing them in batches once the queue holds flushSize of them or every
+// flushInterval. Queued requests are lost if the process dies before they
+// are written.
+type Writer struct {
+	repository Repository
+
+	queue     chan *entities.Request
+	flushSize int
+	interval  time.Duration
+	maxWait   time.Duration
+
+	// sendMu keeps requests from being queued once the writer is closed,
+	// so draining the queue on close leaves nothing behind.
+	sendMu sync.RWMutex
+	closed bool
+
+	// pending holds the ids of the queued requests, and flushed is closed
+	// and replaced after each flush to wake whoever waits on them.
+	mu      sync.Mutex
+	pending map[string]struct{}
+	flushed chan struct{}
+
+	flushNow  chan struct{}
+	closing   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// Enqueue gives the request its id and creation time and queues it to be
+// written. When the queue is full it waits up to maxWait for room, and
+// drops the request if there is none by then, reporting it dropped. Once
+// the writer is closed, requests are written right away.
+func (w *Writer) Enqueue(
+	ctx context.Context, request *entities.Request,
+) (bool, errors.Error) {
+	request.ID = uuid.NewString()
+	request.CreatedAt = time.Now().Truncate(time.Microsecond)
+
+	// The caller keeps using the request after it is queued.
+	queued := *request
+
+	w.sendMu.RLock()
+	defer w.sendMu.RUnlock()
+
+	if w.closed {
+		return false, w.repository.CreateBatch(ctx, []*entities.Request{&queued})
+	}
+
+	w.mu.Lock()
+	w.pending[queued.ID] = struct{}{}
+	w.mu.Unlock()
+
+	select {
+	case w.queue <- &queued:
+		return false, nil
+	default:
+	}
+
+	metrics.ObserveRequestLogBackpressure()
+	if w.maxWait > 0 {
+		timer := time.NewTimer(w.maxWait)
+		defer timer.Stop()
+
+		select {
+		case w.queue <- &queued:
+			return false, nil
+		case <-timer.C:
+		case <-ctx.Done():
+		}
+	}
+
+	// Whoever waits on the request would otherwise wait for the next flush,
+	// which the full queue may hold up for long.
+	w.mu.Lock()
+	delete(w.pending, queued.ID)
+	close(w.flushed)
+	w.flushed = make(chan struct{})
+	w.mu.Unlock()
+
+	metrics.ObserveRequestLogDropped("queue_full", 1)
+	log.Printf("[WARNING] Request log queue is full, dropped request %s", queued.ID)
+	return true, nil
+}
+
+// Wait returns once the request with the id is written or dropped,
+// flushing the queue right away instead of at the next interval, or when
+// ctx is done. Requests that were never queued return at once.
+func (w *Writer) Wait(ctx context.Context, id string) {
+	for {
+		w.mu.Lock()
+		_, pending := w.pending[id]
+		flushed := w.flushed
+		w.mu.Unlock()
+
+		if !pending {
+			return
+		}
+
+		select {
+		case w.flushNow <- struct{}{}:
+		default:
+		}
+
+		select {
+		case <-flushed:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Close writes every queued request and stops the writer, giving up when
+// ctx is done.
+func (w *Writer) Close(ctx context.Context) error {
+	w.closeOnce.Do(func() {
+		w.sendMu.Lock()
+		w.closed = true
+		w.sendMu.Unlock()
+
+		close(w.closing)
+	})
+
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *Writer) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	batch := make([]*entities.Request, 0, w.flushSize)
+	for {
+		select {
+		case request := <-w.queue:
+			batch = append(batch, request)
+			if len(batch) < w.flushSize {
+				continue
+			}
+		case <-ticker.C:
+		case <-w.flushNow:
+			batch = w.drain(batch)
+		case <-w.closing:
+			w.flush(w.drain(batch))
+			return
+		}
+
+		w.flush(batch)
+		batch = batch[:0]
+	}
+}
+
+// drain appends every request waiting in the queue to the batch.
+func (w *Writer) drain(batch []*entities.Request) []*entities.Request {
+	for {
+		select {
+		case request := <-w.queue:
+			batch = append(batch, request)
+		default:
+			return batch
+		}
+	}
+}
+
+// flush writes the batch flushSize requests at a time and wakes whoever
+// waits on them.
+func (w *Writer) flush(batch []*entities.Request) {
+	for start := 0; start < len(batch); start += w.flushSize {
+		w.write(batch[start:min(start+w.flushSize, len(batch))])
+	}
+	metrics.ObserveRequestLogQueue(len(w.queue))
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, request := range batch {
+		delete(w.pending, request.ID)
+	}
+	close(w.flushed)
+	w.flushed = make(chan struct{})
+}
+
+func (w *Writer) write(batch []*entities.Request) {
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := w.repository.CreateBatch(ctx, batch)
+	metrics.ObserveRequestLogFlush(len(batch), time.Since(start), err)
+	if err == nil {
+		return
+	}
+
+	// A single request that cannot be written, such as one whose API key
+	// was deleted meanwhile, fails the whole batch, so the requests are
+	// retried one by one to only drop that one.
+	dropped := 0
+	if len(batch) > 1 {
+		for _, request := range batch {
+			err := w.repository.CreateBatch(ctx, []*entities.Request{request})
+			if err != nil {
+				dropped++
+			}
+		}
+	} else {
+		dropped = 1
+	}
+
+	if dropped > 0 {
+		metrics.ObserveRequestLogDropped("write_failed", dropped)
+		log.Printf(
+			"[ERROR] Failed to write %d of %d logged requests: %v",
+			dropped, len(batch), err,
+		)
+	}
+}
+
+// NewWriter returns a running Writer that queues up to queueSize requests,
+// and waits up to maxWait for room when the queue is full, zero dropping
+// requests right away.
+func NewWriter(
+	repository Repository,
+	queueSize, flushSize int,
+	flushInterval, maxWait time.Duration,
+) *Writer {
+	w := &Writer{
+		repository: repository,
+		queue:      make(chan *entities.Request, queueSize),
+		flushSize:  flushSize,
+		interval:   flushInterval,
+		maxWait:    maxWait,
+		pending:    make(map[string]struct{}),
+		flushed:    make(chan struct{}),
+		flushNow:   make(chan struct{}, 1),
+		closing:    make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
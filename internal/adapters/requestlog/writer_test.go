@@ -0,0 +1,314 @@
+package requestlog
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+// recordingRequestRepository records the batches written to it.
+type recordingRequestRepository struct {
+	ports.RequestRepository
+
+	mu      sync.Mutex
+	batches [][]string
+	updated []string
+
+	// block, when set, holds every write until it is closed, and writing
+	// is told about each held write.
+	block   chan struct{}
+	writing chan struct{}
+
+	// fail fails the batches holding the request with this path.
+	fail string
+}
+
+func (r *recordingRequestRepository) CreateBatch(
+	ctx context.Context, requests []*entities.Request,
+) errors.Error {
+	if r.block != nil {
+		r.writing <- struct{}{}
+		<-r.block
+	}
+
+	ids := make([]string, 0, len(requests))
+	for _, request := range requests {
+		if r.fail != "" && request.Path == r.fail {
+			return errors.NewInternal("Failed to write request", nil)
+		}
+		ids = append(ids, request.ID)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, ids)
+	return nil
+}
+
+func (r *recordingRequestRepository) UpdateExecutionStatus(
+	ctx context.Context, id string, update *dto.RequestExecutionStatusUpdate,
+) errors.Error {
+	if !slices.Contains(r.written(), id) {
+		return errors.NewNotFound("Request not found", nil)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.updated = append(r.updated, id)
+	return nil
+}
+
+func (r *recordingRequestRepository) written() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Concat(r.batches...)
+}
+
+func (r *recordingRequestRepository) batchSizes() []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sizes := make([]int, 0, len(r.batches))
+	for _, batch := range r.batches {
+		sizes = append(sizes, len(batch))
+	}
+	return sizes
+}
+
+type WriterSuite struct {
+	suite.Suite
+
+	ctx        context.Context
+	repository *recordingRequestRepository
+}
+
+func (s *WriterSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.repository = new(recordingRequestRepository)
+}
+
+func (s *WriterSuite) newWriter(
+	queueSize, flushSize int, flushInterval, maxWait time.Duration,
+) *Writer {
+	w := NewWriter(s.repository, queueSize, flushSize, flushInterval, maxWait)
+	s.T().Cleanup(func() { w.Close(s.ctx) })
+	return w
+}
+
+func (s *WriterSuite) enqueue(w *Writer, path string) *entities.Request {
+	request := &entities.Request{Path: path}
+	dropped, err := w.Enqueue(s.ctx, request)
+	s.Require().Nil(err)
+	s.Require().False(dropped)
+	s.Require().NotEmpty(request.ID)
+	s.Require().False(request.CreatedAt.IsZero())
+	return request
+}
+
+func (s *WriterSuite) TestFlushesFullBatch() {
+	w := s.newWriter(10, 2, time.Hour, 0)
+
+	first := s.enqueue(w, "/a")
+	second := s.enqueue(w, "/b")
+
+	s.Eventually(func() bool {
+		return len(s.repository.written()) == 2
+	}, time.Second, time.Millisecond)
+	s.Equal([]string{first.ID, second.ID}, s.repository.written())
+	s.Equal([]int{2}, s.repository.batchSizes())
+}
+
+func (s *WriterSuite) TestFlushesOnInterval() {
+	w := s.newWriter(10, 100, 10*time.Millisecond, 0)
+
+	request := s.enqueue(w, "/a")
+
+	s.Eventually(func() bool {
+		return slices.Equal(s.repository.written(), []string{request.ID})
+	}, time.Second, time.Millisecond)
+}
+
+func (s *WriterSuite) TestWaitFlushesPendingRequest() {
+	w := s.newWriter(10, 100, time.Hour, 0)
+
+	request := s.enqueue(w, "/a")
+	w.Wait(s.ctx, request.ID)
+
+	s.Equal([]string{request.ID}, s.repository.written())
+
+	// Requests that were never queued are not waited for.
+	w.Wait(s.ctx, "unknown")
+}
+
+func (s *WriterSuite) TestWaitGivesUpWhenContextIsDone() {
+	s.repository.block = make(chan struct{})
+	s.repository.writing = make(chan struct{}, 10)
+	w := s.newWriter(10, 1, time.Hour, 0)
+	defer close(s.repository.block)
+
+	request := s.enqueue(w, "/a")
+	<-s.repository.writing
+
+	ctx, cancel := context.WithTimeout(s.ctx, 10*time.Millisecond)
+	defer cancel()
+
+	w.Wait(ctx, request.ID)
+	s.Empty(s.repository.written())
+}
+
+func (s *WriterSuite) TestDropsWhenQueueIsFull() {
+	s.repository.block = make(chan struct{})
+	s.repository.writing = make(chan struct{}, 10)
+	w := s.newWriter(1, 1, time.Hour, 0)
+
+	// The first request is held being written and the second fills the
+	// queue, leaving no room for the third.
+	first := s.enqueue(w, "/a")
+	<-s.repository.writing
+	second := s.enqueue(w, "/b")
+
+	third := &entities.Request{Path: "/c"}
+	dropped, err := w.Enqueue(s.ctx, third)
+	s.Require().Nil(err)
+	s.True(dropped)
+
+	w.Wait(s.ctx, third.ID)
+
+	close(s.repository.block)
+	s.Require().NoError(w.Close(s.ctx))
+	s.Equal([]string{first.ID, second.ID}, s.repository.written())
+}
+
+func (s *WriterSuite) TestWaitsForRoomInFullQueue() {
+	s.repository.block = make(chan struct{})
+	s.repository.writing = make(chan struct{}, 10)
+	w := s.newWriter(1, 1, time.Hour, time.Second)
+
+	first := s.enqueue(w, "/a")
+	<-s.repository.writing
+	second := s.enqueue(w, "/b")
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(s.repository.block)
+	}()
+	third := s.enqueue(w, "/c")
+
+	s.Require().NoError(w.Close(s.ctx))
+	s.Equal(
+		[]string{first.ID, second.ID, third.ID}, s.repository.written(),
+	)
+}
+
+func (s *WriterSuite) TestCloseDrainsQueue() {
+	w := s.newWriter(10, 2, time.Hour, 0)
+
+	first := s.enqueue(w, "/a")
+	second := s.enqueue(w, "/b")
+	third := s.enqueue(w, "/c")
+
+	s.Require().NoError(w.Close(s.ctx))
+	s.ElementsMatch(
+		[]string{first.ID, second.ID, third.ID}, s.repository.written(),
+	)
+
+	// Once closed, requests are written right away.
+	late := s.enqueue(w, "/d")
+	s.Contains(s.repository.written(), late.ID)
+}
+
+func (s *WriterSuite) TestRetriesFailedBatchOneByOne() {
+	s.repository.fail = "/bad"
+	w := s.newWriter(10, 3, time.Hour, 0)
+
+	first := s.enqueue(w, "/a")
+	bad := s.enqueue(w, "/bad")
+	third := s.enqueue(w, "/c")
+
+	s.Require().NoError(w.Close(s.ctx))
+	s.Equal([]string{first.ID, third.ID}, s.repository.written())
+	s.NotContains(s.repository.written(), bad.ID)
+}
+
+func (s *WriterSuite) TestUpdateWaitsForQueuedRequest() {
+	w := s.newWriter(10, 100, time.Hour, 0)
+	repository := &requestRepository{
+		RequestRepository: s.repository,
+		writer:            w,
+	}
+
+	request := &entities.Request{Path: "/a"}
+	s.Require().Nil(repository.Create(s.ctx, request))
+
+	err := repository.UpdateExecutionStatus(
+		s.ctx, request.ID, &dto.RequestExecutionStatusUpdate{},
+	)
+	s.Require().Nil(err)
+	s.Equal([]string{request.ID}, s.repository.updated)
+}
+
+func (s *WriterSuite) TestUpdateOfDroppedRequestIsNotFound() {
+	s.repository.block = make(chan struct{})
+	s.repository.writing = make(chan struct{}, 10)
+	w := s.newWriter(1, 1, time.Hour, 200*time.Millisecond)
+	defer close(s.repository.block)
+
+	repository := &requestRepository{
+		RequestRepository: s.repository,
+		writer:            w,
+	}
+
+	// The first request is held being written and the second fills the
+	// queue, so the third waits for room until it is dropped.
+	first := s.enqueue(w, "/a")
+	<-s.repository.writing
+	second := s.enqueue(w, "/b")
+
+	request := &entities.Request{Path: "/c"}
+	created := make(chan errors.Error, 1)
+	go func() { created <- repository.Create(s.ctx, request) }()
+
+	var id string
+	s.Require().Eventually(func() bool {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		for pending := range w.pending {
+			if pending != first.ID && pending != second.ID {
+				id = pending
+			}
+		}
+		return id != ""
+	}, time.Second, time.Millisecond)
+
+	updated := make(chan errors.Error, 1)
+	go func() {
+		updated <- repository.UpdateExecutionStatus(
+			s.ctx, id, &dto.RequestExecutionStatusUpdate{},
+		)
+	}()
+
+	select {
+	case err := <-updated:
+		s.Require().NotNil(err)
+		s.Equal(errors.CodeNotFound, err.Code())
+	case <-time.After(time.Second):
+		s.FailNow("update of a dropped request did not return")
+	}
+
+	s.Nil(<-created)
+	s.True(request.Dropped)
+}
+
+func TestWriterSuite(t *testing.T) {
+	suite.Run(t, new(WriterSuite))
+}
@@ -0,0 +1,79 @@
+package security
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+type apiKeyProtector struct {
+	hashKey []byte
+	aead    cipher.AEAD
+}
+
+func (p *apiKeyProtector) Hash(key string) string {
+	mac := hmac.New(sha256.New, p.hashKey)
+	mac.Write([]byte(key))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (p *apiKeyProtector) Encrypt(key string) (string, errors.Error) {
+	nonce := make([]byte, p.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.NewInternal("failed to encrypt api key", err)
+	}
+
+	sealed := p.aead.Seal(nonce, nonce, []byte(key), nil)
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (p *apiKeyProtector) Decrypt(encryptedKey string) (string, errors.Error) {
+	sealed, err := base64.RawStdEncoding.DecodeString(encryptedKey)
+	if err != nil {
+		return "", errors.NewInternal("failed to decrypt api key", err)
+	}
+
+	nonceSize := p.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", errors.NewInternal("failed to decrypt api key", nil)
+	}
+
+	key, err := p.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return "", errors.NewInternal("failed to decrypt api key", err)
+	}
+
+	return string(key), nil
+}
+
+// deriveKey derives an independent 256-bit key for each purpose, so a
+// single configured secret can back both hashing and escrow.
+func deriveKey(secret []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+func NewAPIKeyProtector(secret []byte) ports.APIKeyProtector {
+	block, err := aes.NewCipher(deriveKey(secret, "pandora-api-key-escrow"))
+	if err != nil {
+		panic(err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+
+	return &apiKeyProtector{
+		hashKey: deriveKey(secret, "pandora-api-key-hash"),
+		aead:    aead,
+	}
+}
@@ -1,7 +1,6 @@
 package security
 
 import (
-	"context"
 	"encoding/json"
 	"os"
 
@@ -17,85 +16,34 @@ type credentials struct {
 	ForcePasswordReset bool `json:"force_password_reset"`
 }
 
-type credentialsRepository struct {
+// legacyCredentialsLoader reads the single admin user of the credentials
+// file used before admin users were stored in the database.
+type legacyCredentialsLoader struct {
 	credentialsFile string
-
-	credentials *credentials
-}
-
-func (r *credentialsRepository) GetByUsername(
-	ctx context.Context, username string,
-) (*entities.Credentials, errors.Error) {
-	if username != r.credentials.Username {
-		return nil, errors.NewEntityNotFound(
-			"Credentials",
-			"crdentials not found",
-			map[string]any{"username": username},
-			nil,
-		)
-	}
-
-	return &entities.Credentials{
-		Username:           r.credentials.Username,
-		HashedPassword:     r.credentials.Password,
-		ForcePasswordReset: r.credentials.ForcePasswordReset,
-	}, nil
-}
-
-func (r *credentialsRepository) ChangePassword(
-	ctx context.Context, credentials *entities.Credentials,
-) errors.Error {
-	if credentials.Username != r.credentials.Username {
-		return errors.NewEntityNotFound(
-			"Credentials",
-			"crdentials not found",
-			map[string]any{"username": credentials.Username},
-			nil,
-		)
-	}
-
-	oldPassword := r.credentials.Password
-	r.credentials.Password = credentials.HashedPassword
-
-	if r.credentials.ForcePasswordReset {
-		r.credentials.ForcePasswordReset = false
-	}
-
-	if err := r.saveCredentials(); err != nil {
-		r.credentials.Password = oldPassword
-		return errors.NewInternal("failed to write to disk", err)
-	}
-	return nil
-}
-
-func (r *credentialsRepository) saveCredentials() error {
-	data, err := json.Marshal(r.credentials)
-	if err != nil {
-		return err
-	}
-
-	err = os.WriteFile(r.credentialsFile, data, 0644)
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
 
-func NewCredentialsRepository(credentialsFile string) ports.CredentialsRepository {
-	file, err := os.Open(credentialsFile)
+func (l *legacyCredentialsLoader) Load() (*entities.Credentials, errors.Error) {
+	file, err := os.Open(l.credentialsFile)
 	if err != nil {
-		panic(err)
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.NewInternal("failed to read credentials file", err)
 	}
 	defer file.Close()
 
 	var credentials credentials
 	if err := json.NewDecoder(file).Decode(&credentials); err != nil {
-		panic(err)
+		return nil, errors.NewInternal("failed to decode credentials file", err)
 	}
 
-	return &credentialsRepository{
-		credentials:     &credentials,
-		credentialsFile: credentialsFile,
-	}
+	return &entities.Credentials{
+		Username:           credentials.Username,
+		HashedPassword:     credentials.Password,
+		ForcePasswordReset: credentials.ForcePasswordReset,
+	}, nil
+}
+
+func NewLegacyCredentialsLoader(credentialsFile string) ports.LegacyCredentialsLoader {
+	return &legacyCredentialsLoader{credentialsFile: credentialsFile}
 }
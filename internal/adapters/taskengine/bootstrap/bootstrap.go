@@ -1,11 +1,32 @@
 package bootstrap
 
-import "github.com/MAD-py/pandora-core/internal/adapters/persistence"
+import (
+	"github.com/MAD-py/pandora-core/internal/adapters/persistence"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
 
 type Dependencies struct {
 	Repositories persistence.Repositories
+
+	// RequestArchiver is nil when expired requests are deleted without
+	// being archived.
+	RequestArchiver ports.RequestArchiver
+
+	RequestRetentionDays int
+
+	WebhookSender ports.WebhookSender
 }
 
-func NewDependencies(repositories persistence.Repositories) *Dependencies {
-	return &Dependencies{Repositories: repositories}
+func NewDependencies(
+	repositories persistence.Repositories,
+	requestArchiver ports.RequestArchiver,
+	requestRetentionDays int,
+	webhookSender ports.WebhookSender,
+) *Dependencies {
+	return &Dependencies{
+		Repositories:         repositories,
+		RequestArchiver:      requestArchiver,
+		RequestRetentionDays: requestRetentionDays,
+		WebhookSender:        webhookSender,
+	}
 }
@@ -7,6 +7,7 @@ import (
 	_ "github.com/jackc/pgx/v5/stdlib"
 
 	"github.com/MAD-py/go-taskengine/taskengine"
+	"github.com/MAD-py/go-taskengine/taskengine/store"
 	"github.com/MAD-py/go-taskengine/taskengine/store/postgresql"
 	"github.com/MAD-py/pandora-core/internal/adapters/taskengine/bootstrap"
 	"github.com/MAD-py/pandora-core/internal/adapters/taskengine/registry"
@@ -33,6 +34,118 @@ func (e *Engine) Run() error {
 		}
 	}
 
+	{
+		task, err := tasks.ReservationExpiredRelease(e.deps)
+		if err != nil {
+			log.Printf("[ERROR] Failed to create reservation expired release task: %v\n", err)
+			return err
+		}
+
+		err = registry.ReservationExpiredRelease(e.engine, task)
+		if err != nil {
+			log.Printf("[ERROR] Failed to register reservation expired release task: %v\n", err)
+			return err
+		}
+	}
+
+	{
+		task, err := tasks.APIKeyLegacyProtection(e.deps)
+		if err != nil {
+			log.Printf("[ERROR] Failed to create api key legacy protection task: %v\n", err)
+			return err
+		}
+
+		err = registry.APIKeyLegacyProtection(e.engine, task)
+		if err != nil {
+			log.Printf("[ERROR] Failed to register api key legacy protection task: %v\n", err)
+			return err
+		}
+	}
+
+	{
+		task, err := tasks.APIKeyRotationDisable(e.deps)
+		if err != nil {
+			log.Printf("[ERROR] Failed to create api key rotation disable task: %v\n", err)
+			return err
+		}
+
+		err = registry.APIKeyRotationDisable(e.engine, task)
+		if err != nil {
+			log.Printf("[ERROR] Failed to register api key rotation disable task: %v\n", err)
+			return err
+		}
+	}
+
+	{
+		task, err := tasks.UsageRollup(e.deps)
+		if err != nil {
+			log.Printf("[ERROR] Failed to create usage rollup task: %v\n", err)
+			return err
+		}
+
+		err = registry.UsageRollup(e.engine, task)
+		if err != nil {
+			log.Printf("[ERROR] Failed to register usage rollup task: %v\n", err)
+			return err
+		}
+	}
+
+	{
+		task, err := tasks.RequestPartitionCreation(e.deps)
+		if err != nil {
+			log.Printf("[ERROR] Failed to create request partition creation task: %v\n", err)
+			return err
+		}
+
+		err = registry.RequestPartitionCreation(e.engine, task)
+		if err != nil {
+			log.Printf("[ERROR] Failed to register request partition creation task: %v\n", err)
+			return err
+		}
+	}
+
+	{
+		task, err := tasks.RequestRetention(e.deps)
+		if err != nil {
+			log.Printf("[ERROR] Failed to create request retention task: %v\n", err)
+			return err
+		}
+
+		err = registry.RequestRetention(e.engine, task)
+		if err != nil {
+			log.Printf("[ERROR] Failed to register request retention task: %v\n", err)
+			return err
+		}
+	}
+
+	{
+		task, err := tasks.WebhookAPIKeyExpiry(e.deps)
+		if err != nil {
+			log.Printf("[ERROR] Failed to create webhook api key expiry task: %v\n", err)
+			return err
+		}
+
+		err = registry.WebhookAPIKeyExpiry(e.engine, task)
+		if err != nil {
+			log.Printf("[ERROR] Failed to register webhook api key expiry task: %v\n", err)
+			return err
+		}
+	}
+
+	{
+		task, err := tasks.WebhookDispatch(e.deps)
+		if err != nil {
+			log.Printf("[ERROR] Failed to create webhook dispatch task: %v\n", err)
+			return err
+		}
+
+		err = registry.WebhookDispatch(e.engine, task)
+		if err != nil {
+			log.Printf("[ERROR] Failed to register webhook dispatch task: %v\n", err)
+			return err
+		}
+	}
+
 	log.Printf("[INFO] Task Engine is starting...")
 	if err := e.engine.Run(); err != nil {
 		log.Printf("[ERROR] Failed to start server: %v\n", err)
@@ -49,7 +162,17 @@ func NewEngine(connString string, deps *bootstrap.Dependencies) (*Engine, error)
 		return nil, err
 	}
 
-	engine, err := taskengine.New(postgresql.NewStore(db))
+	return newEngine(postgresql.NewStore(db), deps)
+}
+
+// NewMemoryEngine creates an engine keeping its tasks in process memory,
+// for the memory persistence driver.
+func NewMemoryEngine(deps *bootstrap.Dependencies) (*Engine, error) {
+	return newEngine(new(memoryStore), deps)
+}
+
+func newEngine(store store.Store, deps *bootstrap.Dependencies) (*Engine, error) {
+	engine, err := taskengine.New(store)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,38 @@
+package jobs
+
+import (
+	"github.com/MAD-py/go-taskengine/taskengine"
+	"github.com/MAD-py/pandora-core/internal/app/analytics"
+)
+
+func UsageRollup(useCase analytics.RollupUsageUseCase) taskengine.Job {
+	return func(ctx *taskengine.Context) error {
+		ctx.Logger().Infof(
+			"Starting UsageRollup job - Tick: %d", ctx.CurrentTick(),
+		)
+
+		refreshed, err := useCase.Execute(ctx)
+		if err != nil {
+			ctx.Logger().Errorf(
+				"Error executing UsageRollup - Tick: %d - Error: %s",
+				ctx.CurrentTick(), err.Error(),
+			)
+			return err
+		}
+
+		if refreshed > 0 {
+			ctx.Logger().Infof(
+				"Usage rollups refreshed - Hourly buckets refreshed: %d",
+				refreshed,
+			)
+		} else {
+			ctx.Logger().Info("No new requests to roll up")
+		}
+
+		ctx.Logger().Infof(
+			"UsageRollup job completed - Tick: %d", ctx.CurrentTick(),
+		)
+
+		return nil
+	}
+}
@@ -0,0 +1,70 @@
+package jobs
+
+import (
+	"github.com/MAD-py/go-taskengine/taskengine"
+	apikey "github.com/MAD-py/pandora-core/internal/app/api_key"
+)
+
+func APIKeyLegacyProtection(useCase apikey.ProtectLegacyKeysUseCase) taskengine.Job {
+	return func(ctx *taskengine.Context) error {
+		ctx.Logger().Infof(
+			"Starting APIKeyLegacyProtection job - Tick: %d", ctx.CurrentTick(),
+		)
+
+		protected, err := useCase.Execute(ctx)
+		if err != nil {
+			ctx.Logger().Errorf(
+				"Error executing APIKeyLegacyProtection - Tick: %d - Keys protected before failure: %d - Error: %s",
+				ctx.CurrentTick(), protected, err.Error(),
+			)
+			return err
+		}
+
+		if protected > 0 {
+			ctx.Logger().Infof(
+				"Plaintext API keys replaced by hashes - Keys protected: %d",
+				protected,
+			)
+		} else {
+			ctx.Logger().Info("No plaintext API keys found")
+		}
+
+		ctx.Logger().Infof(
+			"APIKeyLegacyProtection job completed - Tick: %d", ctx.CurrentTick(),
+		)
+
+		return nil
+	}
+}
+
+func APIKeyRotationDisable(useCase apikey.DisableRotatedUseCase) taskengine.Job {
+	return func(ctx *taskengine.Context) error {
+		ctx.Logger().Infof(
+			"Starting APIKeyRotationDisable job - Tick: %d", ctx.CurrentTick(),
+		)
+
+		disabled, err := useCase.Execute(ctx)
+		if err != nil {
+			ctx.Logger().Errorf(
+				"Error executing APIKeyRotationDisable - Tick: %d - Error: %s",
+				ctx.CurrentTick(), err.Error(),
+			)
+			return err
+		}
+
+		if disabled > 0 {
+			ctx.Logger().Infof(
+				"Rotated API keys disabled after their grace period - Keys disabled: %d",
+				disabled,
+			)
+		} else {
+			ctx.Logger().Info("No rotated API keys reached the end of their grace period")
+		}
+
+		ctx.Logger().Infof(
+			"APIKeyRotationDisable job completed - Tick: %d", ctx.CurrentTick(),
+		)
+
+		return nil
+	}
+}
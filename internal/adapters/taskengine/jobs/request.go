@@ -0,0 +1,71 @@
+package jobs
+
+import (
+	"github.com/MAD-py/go-taskengine/taskengine"
+	"github.com/MAD-py/pandora-core/internal/app/request"
+)
+
+func RequestRetention(useCase request.EnforceRetentionUseCase) taskengine.Job {
+	return func(ctx *taskengine.Context) error {
+		ctx.Logger().Infof(
+			"Starting RequestRetention job - Tick: %d", ctx.CurrentTick(),
+		)
+
+		result, err := useCase.Execute(ctx)
+		if err != nil {
+			ctx.Logger().Errorf(
+				"Error executing RequestRetention - Tick: %d - Error: %s",
+				ctx.CurrentTick(), err.Error(),
+			)
+			return err
+		}
+
+		if result.DeletedRequests > 0 || result.DroppedPartitions > 0 {
+			ctx.Logger().Infof(
+				"Expired requests deleted - Partitions dropped: %d - Requests deleted: %d",
+				result.DroppedPartitions, result.DeletedRequests,
+			)
+		} else {
+			ctx.Logger().Info("No expired requests found")
+		}
+
+		ctx.Logger().Infof(
+			"RequestRetention job completed - Tick: %d", ctx.CurrentTick(),
+		)
+
+		return nil
+	}
+}
+
+func RequestPartitionCreation(
+	useCase request.CreatePartitionsUseCase,
+) taskengine.Job {
+	return func(ctx *taskengine.Context) error {
+		ctx.Logger().Infof(
+			"Starting RequestPartitionCreation job - Tick: %d", ctx.CurrentTick(),
+		)
+
+		created, err := useCase.Execute(ctx)
+		if err != nil {
+			ctx.Logger().Errorf(
+				"Error executing RequestPartitionCreation - Tick: %d - Error: %s",
+				ctx.CurrentTick(), err.Error(),
+			)
+			return err
+		}
+
+		if created > 0 {
+			ctx.Logger().Infof(
+				"Request partitions created - Partitions created: %d", created,
+			)
+		} else {
+			ctx.Logger().Info("No request partitions to create")
+		}
+
+		ctx.Logger().Infof(
+			"RequestPartitionCreation job completed - Tick: %d", ctx.CurrentTick(),
+		)
+
+		return nil
+	}
+}
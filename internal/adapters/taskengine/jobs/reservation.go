@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"github.com/MAD-py/go-taskengine/taskengine"
+	"github.com/MAD-py/pandora-core/internal/app/reservation"
+)
+
+func ReservationExpiredRelease(useCase reservation.ReleaseExpiredUseCase) taskengine.Job {
+	return func(ctx *taskengine.Context) error {
+		ctx.Logger().Infof(
+			"Starting ReservationExpiredRelease job - Tick: %d", ctx.CurrentTick(),
+		)
+
+		released, err := useCase.Execute(ctx)
+
+		if len(released) > 0 {
+			ctx.Logger().Infof(
+				"Expired reservations released - Reservations processed: %d",
+				len(released),
+			)
+
+			for _, reservation := range released {
+				ctx.Logger().Infof(
+					"  - Reservation ID: %s, Environment ID: %d, Service ID: %d, Start Request ID: %s, Expired at: %s",
+					reservation.ID,
+					reservation.EnvironmentID,
+					reservation.ServiceID,
+					reservation.StartRequestID,
+					reservation.ExpiresAt,
+				)
+			}
+		} else if err == nil {
+			ctx.Logger().Info("No expired reservations found")
+		}
+
+		if err != nil {
+			ctx.Logger().Errorf(
+				"Error executing ReservationExpiredRelease - Tick: %d - Error: %s",
+				ctx.CurrentTick(), err.Error(),
+			)
+			return err
+		}
+
+		ctx.Logger().Infof(
+			"ReservationExpiredRelease job completed - Tick: %d - Summary: %d reservations released",
+			ctx.CurrentTick(),
+			len(released),
+		)
+
+		return nil
+	}
+}
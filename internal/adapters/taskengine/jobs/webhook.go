@@ -0,0 +1,69 @@
+package jobs
+
+import (
+	"github.com/MAD-py/go-taskengine/taskengine"
+	"github.com/MAD-py/pandora-core/internal/app/webhook"
+)
+
+func WebhookDispatch(useCase webhook.DispatchUseCase) taskengine.Job {
+	return func(ctx *taskengine.Context) error {
+		ctx.Logger().Infof(
+			"Starting WebhookDispatch job - Tick: %d", ctx.CurrentTick(),
+		)
+
+		result, err := useCase.Execute(ctx)
+		if err != nil {
+			ctx.Logger().Errorf(
+				"Error executing WebhookDispatch - Tick: %d - Error: %s",
+				ctx.CurrentTick(), err.Error(),
+			)
+			return err
+		}
+
+		if result.Delivered > 0 || result.Retrying > 0 || result.Failed > 0 {
+			ctx.Logger().Infof(
+				"Webhook deliveries sent - Delivered: %d - Retrying: %d - Failed: %d",
+				result.Delivered, result.Retrying, result.Failed,
+			)
+		} else {
+			ctx.Logger().Info("No webhook deliveries due")
+		}
+
+		ctx.Logger().Infof(
+			"WebhookDispatch job completed - Tick: %d", ctx.CurrentTick(),
+		)
+
+		return nil
+	}
+}
+
+func WebhookAPIKeyExpiry(useCase webhook.NotifyExpiredKeysUseCase) taskengine.Job {
+	return func(ctx *taskengine.Context) error {
+		ctx.Logger().Infof(
+			"Starting WebhookAPIKeyExpiry job - Tick: %d", ctx.CurrentTick(),
+		)
+
+		queued, err := useCase.Execute(ctx)
+		if err != nil {
+			ctx.Logger().Errorf(
+				"Error executing WebhookAPIKeyExpiry - Tick: %d - Error: %s",
+				ctx.CurrentTick(), err.Error(),
+			)
+			return err
+		}
+
+		if queued > 0 {
+			ctx.Logger().Infof(
+				"Expired API key webhooks queued - Deliveries queued: %d", queued,
+			)
+		} else {
+			ctx.Logger().Info("No expired API keys found")
+		}
+
+		ctx.Logger().Infof(
+			"WebhookAPIKeyExpiry job completed - Tick: %d", ctx.CurrentTick(),
+		)
+
+		return nil
+	}
+}
@@ -0,0 +1,133 @@
+package taskengine
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/MAD-py/go-taskengine/taskengine/store"
+)
+
+var _ store.Store = (*memoryStore)(nil)
+
+type memoryTask struct {
+	settings  store.TaskSettings
+	status    store.TaskStatus
+	iteration int
+	lastTick  time.Time
+}
+
+// memoryStore keeps the tasks of the engine and the tick of their last
+// execution in process memory, for the memory persistence driver. Tasks
+// start over whenever the process does.
+type memoryStore struct {
+	mu    sync.Mutex
+	tasks map[string]*memoryTask
+}
+
+func (s *memoryStore) CreateStores() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.tasks == nil {
+		s.tasks = make(map[string]*memoryTask)
+	}
+	return nil
+}
+
+func (s *memoryStore) DeleteStores() error {
+	return s.ClearStores()
+}
+
+func (s *memoryStore) ClearStores() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tasks = make(map[string]*memoryTask)
+	return nil
+}
+
+func (s *memoryStore) SaveTask(name string, settings *store.TaskSettings) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.tasks[name]; exists {
+		return fmt.Errorf("task %s already exists", name)
+	}
+
+	s.tasks[name] = &memoryTask{
+		settings: *settings,
+		status:   store.TaskStatusIdle,
+	}
+	return nil
+}
+
+func (s *memoryStore) TaskExists(name string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, exists := s.tasks[name]
+	return exists, nil
+}
+
+func (s *memoryStore) SaveExecution(name string, info *store.ExecutionInfo) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, err := s.task(name)
+	if err != nil {
+		return err
+	}
+
+	task.iteration++
+	task.lastTick = info.Tick
+	return nil
+}
+
+func (s *memoryStore) GetTaskSettings(name string) (*store.TaskSettings, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, err := s.task(name)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := task.settings
+	return &settings, nil
+}
+
+func (s *memoryStore) UpdateTaskStatus(name string, status store.TaskStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, err := s.task(name)
+	if err != nil {
+		return err
+	}
+
+	task.status = status
+	return nil
+}
+
+// GetLastTick returns the tick of the last execution of the task, zero if
+// it never ran.
+func (s *memoryStore) GetLastTick(name string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, err := s.task(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return task.lastTick, nil
+}
+
+func (s *memoryStore) task(name string) (*memoryTask, error) {
+	task, exists := s.tasks[name]
+	if !exists {
+		return nil, fmt.Errorf("task %s not found", name)
+	}
+	return task, nil
+}
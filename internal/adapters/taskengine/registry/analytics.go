@@ -0,0 +1,22 @@
+package registry
+
+import (
+	"time"
+
+	"github.com/MAD-py/go-taskengine/taskengine"
+)
+
+func UsageRollup(e *taskengine.Engine, task *taskengine.Task) error {
+	trigger, err := taskengine.NewIntervalTrigger(5*time.Minute, true)
+	if err != nil {
+		return err
+	}
+
+	return e.RegisterTask(
+		task,
+		taskengine.WorkerPolicySerial,
+		trigger,
+		true,
+		0,
+	)
+}
@@ -0,0 +1,37 @@
+package registry
+
+import (
+	"time"
+
+	"github.com/MAD-py/go-taskengine/taskengine"
+)
+
+func APIKeyLegacyProtection(e *taskengine.Engine, task *taskengine.Task) error {
+	trigger, err := taskengine.NewIntervalTrigger(time.Hour, true)
+	if err != nil {
+		return err
+	}
+
+	return e.RegisterTask(
+		task,
+		taskengine.WorkerPolicySerial,
+		trigger,
+		true,
+		0,
+	)
+}
+
+func APIKeyRotationDisable(e *taskengine.Engine, task *taskengine.Task) error {
+	trigger, err := taskengine.NewIntervalTrigger(time.Minute, true)
+	if err != nil {
+		return err
+	}
+
+	return e.RegisterTask(
+		task,
+		taskengine.WorkerPolicySerial,
+		trigger,
+		true,
+		0,
+	)
+}
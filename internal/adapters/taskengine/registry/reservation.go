@@ -0,0 +1,22 @@
+package registry
+
+import (
+	"time"
+
+	"github.com/MAD-py/go-taskengine/taskengine"
+)
+
+func ReservationExpiredRelease(e *taskengine.Engine, task *taskengine.Task) error {
+	trigger, err := taskengine.NewIntervalTrigger(time.Minute, true)
+	if err != nil {
+		return err
+	}
+
+	return e.RegisterTask(
+		task,
+		taskengine.WorkerPolicySerial,
+		trigger,
+		true,
+		0,
+	)
+}
@@ -0,0 +1,37 @@
+package registry
+
+import (
+	"time"
+
+	"github.com/MAD-py/go-taskengine/taskengine"
+)
+
+func WebhookDispatch(e *taskengine.Engine, task *taskengine.Task) error {
+	trigger, err := taskengine.NewIntervalTrigger(10*time.Second, true)
+	if err != nil {
+		return err
+	}
+
+	return e.RegisterTask(
+		task,
+		taskengine.WorkerPolicySerial,
+		trigger,
+		true,
+		0,
+	)
+}
+
+func WebhookAPIKeyExpiry(e *taskengine.Engine, task *taskengine.Task) error {
+	trigger, err := taskengine.NewIntervalTrigger(time.Minute, true)
+	if err != nil {
+		return err
+	}
+
+	return e.RegisterTask(
+		task,
+		taskengine.WorkerPolicySerial,
+		trigger,
+		true,
+		0,
+	)
+}
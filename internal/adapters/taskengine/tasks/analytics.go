@@ -0,0 +1,15 @@
+package tasks
+
+import (
+	"github.com/MAD-py/go-taskengine/taskengine"
+	"github.com/MAD-py/pandora-core/internal/adapters/taskengine/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/taskengine/jobs"
+	"github.com/MAD-py/pandora-core/internal/app/analytics"
+)
+
+func UsageRollup(deps *bootstrap.Dependencies) (*taskengine.Task, error) {
+	rollupUsageUseCase := analytics.NewRollupUsageUseCase(
+		deps.Repositories.Usage(),
+	)
+	return newTask("usage-rollup", jobs.UsageRollup(rollupUsageUseCase))
+}
@@ -0,0 +1,28 @@
+package tasks
+
+import (
+	"github.com/MAD-py/go-taskengine/taskengine"
+	"github.com/MAD-py/pandora-core/internal/adapters/taskengine/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/taskengine/jobs"
+	apikey "github.com/MAD-py/pandora-core/internal/app/api_key"
+)
+
+func APIKeyLegacyProtection(deps *bootstrap.Dependencies) (*taskengine.Task, error) {
+	protectLegacyKeysUseCase := apikey.NewProtectLegacyKeysUseCase(
+		deps.Repositories.APIKey(),
+	)
+	return newTask(
+		"api-key-legacy-protection",
+		jobs.APIKeyLegacyProtection(protectLegacyKeysUseCase),
+	)
+}
+
+func APIKeyRotationDisable(deps *bootstrap.Dependencies) (*taskengine.Task, error) {
+	disableRotatedUseCase := apikey.NewDisableRotatedUseCase(
+		deps.Repositories.APIKey(), deps.Repositories.Webhook(),
+	)
+	return newTask(
+		"api-key-rotation-disable",
+		jobs.APIKeyRotationDisable(disableRotatedUseCase),
+	)
+}
@@ -8,8 +8,10 @@ import (
 )
 
 func ProjectQuotaReset(deps *bootstrap.Dependencies) (*taskengine.Task, error) {
-	resetDueRequestsUseCase := project.NewResetDueRequestsUseCase(deps.Repositories.Project())
-	return taskengine.NewTask(
+	resetDueRequestsUseCase := project.NewResetDueRequestsUseCase(
+		deps.Repositories.Project(), deps.Repositories.Webhook(),
+	)
+	return newTask(
 		"project-quota-reset",
 		jobs.ProjectQuotaReset(resetDueRequestsUseCase),
 	)
@@ -0,0 +1,29 @@
+package tasks
+
+import (
+	"github.com/MAD-py/go-taskengine/taskengine"
+	"github.com/MAD-py/pandora-core/internal/adapters/taskengine/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/taskengine/jobs"
+	"github.com/MAD-py/pandora-core/internal/app/request"
+)
+
+func RequestRetention(deps *bootstrap.Dependencies) (*taskengine.Task, error) {
+	enforceRetentionUseCase := request.NewEnforceRetentionUseCase(
+		deps.Repositories.Request(),
+		deps.Repositories.Service(),
+		deps.Repositories.Usage(),
+		deps.RequestArchiver,
+		deps.RequestRetentionDays,
+	)
+	return newTask("request-retention", jobs.RequestRetention(enforceRetentionUseCase))
+}
+
+func RequestPartitionCreation(deps *bootstrap.Dependencies) (*taskengine.Task, error) {
+	createPartitionsUseCase := request.NewCreatePartitionsUseCase(
+		deps.Repositories.Request(),
+	)
+	return newTask(
+		"request-partition-creation",
+		jobs.RequestPartitionCreation(createPartitionsUseCase),
+	)
+}
@@ -0,0 +1,18 @@
+package tasks
+
+import (
+	"github.com/MAD-py/go-taskengine/taskengine"
+	"github.com/MAD-py/pandora-core/internal/adapters/taskengine/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/taskengine/jobs"
+	"github.com/MAD-py/pandora-core/internal/app/reservation"
+)
+
+func ReservationExpiredRelease(deps *bootstrap.Dependencies) (*taskengine.Task, error) {
+	releaseExpiredUseCase := reservation.NewReleaseExpiredUseCase(
+		deps.Repositories.Reservation(),
+	)
+	return newTask(
+		"reservation-expired-release",
+		jobs.ReservationExpiredRelease(releaseExpiredUseCase),
+	)
+}
@@ -0,0 +1,23 @@
+package tasks
+
+import (
+	"time"
+
+	"github.com/MAD-py/go-taskengine/taskengine"
+	"github.com/MAD-py/pandora-core/internal/adapters/metrics"
+)
+
+// newTask creates a task whose job runs are recorded in the task engine
+// metrics under the task name.
+func newTask(name string, job taskengine.Job) (*taskengine.Task, error) {
+	return taskengine.NewTask(
+		name,
+		func(ctx *taskengine.Context) error {
+			start := time.Now()
+			err := job(ctx)
+
+			metrics.ObserveJob(name, time.Since(start), err)
+			return err
+		},
+	)
+}
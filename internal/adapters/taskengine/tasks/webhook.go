@@ -0,0 +1,25 @@
+package tasks
+
+import (
+	"github.com/MAD-py/go-taskengine/taskengine"
+	"github.com/MAD-py/pandora-core/internal/adapters/taskengine/bootstrap"
+	"github.com/MAD-py/pandora-core/internal/adapters/taskengine/jobs"
+	"github.com/MAD-py/pandora-core/internal/app/webhook"
+)
+
+func WebhookDispatch(deps *bootstrap.Dependencies) (*taskengine.Task, error) {
+	dispatchUseCase := webhook.NewDispatchUseCase(
+		deps.Repositories.Webhook(), deps.WebhookSender,
+	)
+	return newTask("webhook-dispatch", jobs.WebhookDispatch(dispatchUseCase))
+}
+
+func WebhookAPIKeyExpiry(deps *bootstrap.Dependencies) (*taskengine.Task, error) {
+	notifyExpiredKeysUseCase := webhook.NewNotifyExpiredKeysUseCase(
+		deps.Repositories.APIKey(), deps.Repositories.Webhook(),
+	)
+	return newTask(
+		"webhook-api-key-expiry",
+		jobs.WebhookAPIKeyExpiry(notifyExpiredKeysUseCase),
+	)
+}
@@ -0,0 +1,118 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/MAD-py/pandora-core/internal/version"
+)
+
+const ServiceName = "pandora-core"
+
+type ExporterType string
+
+const (
+	ExporterNone   ExporterType = "none"
+	ExporterOTLP   ExporterType = "otlp"
+	ExporterStdout ExporterType = "stdout"
+	ExporterFile   ExporterType = "file"
+)
+
+type Provider struct {
+	provider *sdktrace.TracerProvider
+
+	file *os.File
+}
+
+// Shutdown flushes the spans that have not been exported yet.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	if p.provider == nil {
+		return nil
+	}
+
+	err := p.provider.Shutdown(ctx)
+	if p.file != nil {
+		if closeErr := p.file.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// NewProvider installs the global tracer provider and the W3C trace context
+// propagator. With ExporterNone no span is recorded, but incoming trace
+// context is still honoured. The OTLP exporter is configured through the
+// standard OTEL_EXPORTER_OTLP_* environment variables.
+func NewProvider(
+	ctx context.Context, exporterType ExporterType, filePath string,
+) (*Provider, error) {
+	otel.SetTextMapPropagator(
+		propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{},
+			propagation.Baggage{},
+		),
+	)
+
+	p := new(Provider)
+
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch exporterType {
+	case ExporterNone:
+		return p, nil
+	case ExporterOTLP:
+		exporter, err = otlptracegrpc.New(ctx)
+	case ExporterStdout:
+		exporter, err = newWriterExporter(os.Stdout)
+	case ExporterFile:
+		p.file, err = os.OpenFile(
+			filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644,
+		)
+		if err != nil {
+			return nil, err
+		}
+		exporter, err = newWriterExporter(p.file)
+	default:
+		return nil, fmt.Errorf("unsupported tracing exporter %q", exporterType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(
+		ctx,
+		resource.WithAttributes(
+			semconv.ServiceName(ServiceName),
+			semconv.ServiceVersion(version.Version),
+		),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(p.provider)
+	return p, nil
+}
+
+func newWriterExporter(w io.Writer) (sdktrace.SpanExporter, error) {
+	return stdouttrace.New(stdouttrace.WithWriter(w))
+}
@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/ports"
+)
+
+const (
+	HeaderEvent     = "X-Pandora-Event"
+	HeaderDelivery  = "X-Pandora-Delivery"
+	HeaderTimestamp = "X-Pandora-Timestamp"
+	HeaderSignature = "X-Pandora-Signature"
+
+	signaturePrefix = "sha256="
+	sendTimeout     = 10 * time.Second
+)
+
+type HTTPSender struct {
+	client *http.Client
+}
+
+func (s *HTTPSender) Send(
+	ctx context.Context, delivery *entities.WebhookDelivery,
+) (int, errors.Error) {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload),
+	)
+	if err != nil {
+		return 0, errors.NewInternal("failed to build webhook request", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Pandora-Webhook")
+	req.Header.Set(HeaderEvent, string(delivery.Event))
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(
+		HeaderSignature, Sign(delivery.Secret, timestamp, delivery.Payload),
+	)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, errors.NewInternal("failed to send webhook", err)
+	}
+	defer resp.Body.Close()
+
+	// The body is drained so the connection can be reused.
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature sent in the X-Pandora-Signature header: the
+// hex HMAC-SHA256, keyed with the webhook secret, of the timestamp and the
+// payload joined by a dot.
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewHTTPSender returns a sender that does not follow redirects, so a
+// delivery is only considered delivered by the URL it was sent to.
+func NewHTTPSender() ports.WebhookSender {
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout: sendTimeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type SenderSuite struct {
+	suite.Suite
+
+	sender *HTTPSender
+
+	ctx context.Context
+}
+
+func (s *SenderSuite) SetupTest() {
+	s.sender = NewHTTPSender().(*HTTPSender)
+	s.ctx = context.Background()
+}
+
+func (s *SenderSuite) newDelivery(url string) *entities.WebhookDelivery {
+	return &entities.WebhookDelivery{
+		ID:      "6f1c1f4e-8a43-4a8e-9d5e-3c4b1b0b7c11",
+		Event:   enums.WebhookEventTypeQuotaExhausted,
+		Payload: []byte(`{"type":"quota.exhausted"}`),
+		URL:     url,
+		Secret:  "whsec_test",
+	}
+}
+
+func (s *SenderSuite) TestSignedDelivery() {
+	delivery := s.newDelivery("")
+
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		s.Require().NoError(err)
+
+		s.Equal(http.MethodPost, r.Method)
+		s.Equal("application/json", r.Header.Get("Content-Type"))
+		s.Equal(string(delivery.Event), r.Header.Get(HeaderEvent))
+		s.Equal(delivery.ID, r.Header.Get(HeaderDelivery))
+		s.Equal(delivery.Payload, body)
+
+		timestamp := r.Header.Get(HeaderTimestamp)
+		s.Require().NotEmpty(timestamp)
+		s.Equal(
+			Sign(delivery.Secret, timestamp, body),
+			r.Header.Get(HeaderSignature),
+		)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer stub.Close()
+
+	delivery.URL = stub.URL
+	statusCode, err := s.sender.Send(s.ctx, delivery)
+
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, statusCode)
+}
+
+func (s *SenderSuite) TestErrorStatusCode() {
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer stub.Close()
+
+	statusCode, err := s.sender.Send(s.ctx, s.newDelivery(stub.URL))
+
+	s.Require().NoError(err)
+	s.Equal(http.StatusServiceUnavailable, statusCode)
+}
+
+func (s *SenderSuite) TestRedirectIsNotFollowed() {
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/moved" {
+			s.Fail("redirect was followed")
+			return
+		}
+		http.Redirect(w, r, "/moved", http.StatusTemporaryRedirect)
+	}))
+	defer stub.Close()
+
+	statusCode, err := s.sender.Send(s.ctx, s.newDelivery(stub.URL))
+
+	s.Require().NoError(err)
+	s.Equal(http.StatusTemporaryRedirect, statusCode)
+}
+
+func (s *SenderSuite) TestUnreachable() {
+	stub := httptest.NewServer(http.NotFoundHandler())
+	stub.Close()
+
+	statusCode, err := s.sender.Send(s.ctx, s.newDelivery(stub.URL))
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeInternal, err.Code())
+	s.Zero(statusCode)
+}
+
+func (s *SenderSuite) TestSign() {
+	// Known answer so receivers in other languages can check their
+	// implementation against it.
+	s.Equal(
+		"sha256=35495024f4ef3f94e5a93e22221544c4b75e9a42300cd965ab81cb85cd994e91",
+		Sign("whsec_test", "1700000000", []byte(`{}`)),
+	)
+}
+
+func TestSenderSuite(t *testing.T) {
+	suite.Run(t, new(SenderSuite))
+}
@@ -0,0 +1,17 @@
+package create
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type CredentialsRepository interface {
+	Create(ctx context.Context, credentials *entities.Credentials) errors.Error
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
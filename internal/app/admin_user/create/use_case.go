@@ -0,0 +1,97 @@
+package create
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, req *dto.AdminUserCreate) (*dto.AdminUserResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	credentialsRepo CredentialsRepository
+	auditRepo       AuditRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, req *dto.AdminUserCreate,
+) (*dto.AdminUserResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "admin_user.create")
+	defer span.End()
+
+	if err := uc.validateReq(req); err != nil {
+		return nil, err
+	}
+
+	// The password is chosen by whoever creates the user, so the user has
+	// to replace it on the first login.
+	credentials := entities.Credentials{
+		Username:           req.Username,
+		Role:               req.Role,
+		Status:             enums.CredentialsStatusEnabled,
+		ForcePasswordReset: true,
+	}
+
+	if err := credentials.CalculatePasswordHash(req.Password); err != nil {
+		return nil, err
+	}
+
+	if err := uc.credentialsRepo.Create(ctx, &credentials); err != nil {
+		return nil, err
+	}
+
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionCreate,
+		enums.AuditEntityCredentials,
+		credentials.ID,
+		nil,
+		&credentials,
+	)
+
+	return &dto.AdminUserResponse{
+		ID:                 credentials.ID,
+		Username:           credentials.Username,
+		Role:               credentials.Role,
+		Status:             credentials.Status,
+		ForcePasswordReset: credentials.ForcePasswordReset,
+		CreatedAt:          credentials.CreatedAt,
+	}, nil
+}
+
+func (uc *useCase) validateReq(req *dto.AdminUserCreate) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"username.required": "username is required",
+			"username.max":      "username must be at most 255 characters long",
+			"password.required": "password is required",
+			"password.min":      "password must be at least 12 characters long",
+			"role.required":     "role is required",
+			"role.enums":        "role must be one of the following: owner, operator, viewer, billing",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	credentialsRepo CredentialsRepository,
+	auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		credentialsRepo: credentialsRepo,
+		auditRepo:       auditRepo,
+	}
+}
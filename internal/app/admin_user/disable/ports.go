@@ -0,0 +1,21 @@
+package disable
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type CredentialsRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Credentials, errors.Error)
+	Count(ctx context.Context, filter *dto.AdminUserFilter) (int, errors.Error)
+	UpdateStatus(ctx context.Context, id int, status enums.CredentialsStatus) errors.Error
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
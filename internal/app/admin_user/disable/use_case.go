@@ -0,0 +1,117 @@
+package disable
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id int) errors.Error
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	credentialsRepo CredentialsRepository
+	auditRepo       AuditRepository
+}
+
+func (uc *useCase) Execute(ctx context.Context, id int) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "admin_user.disable")
+	defer span.End()
+
+	if err := uc.validateID(id); err != nil {
+		return err
+	}
+
+	credentials, err := uc.credentialsRepo.GetByID(ctx, id)
+	if err != nil {
+		if err.Code() == errors.CodeNotFound {
+			return errors.NewEntityNotFound(
+				"Credentials",
+				"admin user not found",
+				map[string]any{"id": id},
+				err,
+			)
+		}
+		return err
+	}
+
+	if !credentials.IsEnabled() {
+		return nil
+	}
+
+	// Without an enabled owner nobody could manage the admin users again.
+	if credentials.IsOwner() {
+		owners, err := uc.credentialsRepo.Count(
+			ctx,
+			&dto.AdminUserFilter{
+				Role:   enums.AdminRoleOwner,
+				Status: enums.CredentialsStatusEnabled,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		if owners <= 1 {
+			return errors.NewEntityValidationFailed(
+				"Credentials",
+				"the last enabled owner cannot be disabled",
+				map[string]any{"id": id},
+				nil,
+			)
+		}
+	}
+
+	err = uc.credentialsRepo.UpdateStatus(
+		ctx, id, enums.CredentialsStatusDisabled,
+	)
+	if err != nil {
+		return err
+	}
+
+	after := *credentials
+	after.Status = enums.CredentialsStatusDisabled
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionDisable,
+		enums.AuditEntityCredentials,
+		id,
+		credentials,
+		&after,
+	)
+
+	return nil
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"required": "id is required",
+			"gt":       "id must be greater than 0",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	credentialsRepo CredentialsRepository,
+	auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		credentialsRepo: credentialsRepo,
+		auditRepo:       auditRepo,
+	}
+}
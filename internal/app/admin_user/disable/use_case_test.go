@@ -0,0 +1,261 @@
+package disable
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/admin_user/disable/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type Suite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator       *mockvalidator.MockValidator
+	credentialsRepo *mock.MockCredentialsRepository
+	auditRepo       *mock.MockAuditRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *Suite) SetupTest() {
+	time.Local = time.UTC
+
+	s.ctrl = gomock.NewController(s.T())
+
+	s.credentialsRepo = mock.NewMockCredentialsRepository(s.ctrl)
+	s.auditRepo = mock.NewMockAuditRepository(s.ctrl)
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+
+	s.useCase = NewUseCase(s.validator, s.credentialsRepo, s.auditRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *Suite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *Suite) expectValidID(id int) {
+	s.validator.EXPECT().
+		ValidateVariable(
+			id,
+			"id",
+			"required,gt=0",
+			gomock.Any(),
+		).
+		Return(nil).
+		Times(1)
+}
+
+func (s *Suite) TestSuccess() {
+	id := 42
+
+	s.expectValidID(id)
+
+	s.credentialsRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(
+			&entities.Credentials{
+				ID:       id,
+				Username: "operator",
+				Role:     enums.AdminRoleOperator,
+				Status:   enums.CredentialsStatusEnabled,
+			},
+			nil,
+		).
+		Times(1)
+
+	s.credentialsRepo.EXPECT().
+		Count(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.credentialsRepo.EXPECT().
+		UpdateStatus(s.ctx, id, enums.CredentialsStatusDisabled).
+		Return(nil).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *entities.AuditEntry) errors.Error {
+			s.Equal(enums.AuditActionDisable, entry.Action)
+			s.Equal(enums.AuditEntityCredentials, entry.Entity)
+			s.Equal(id, entry.EntityID)
+			s.Equal(map[string]any{"status": "enabled"}, entry.Before)
+			s.Equal(map[string]any{"status": "disabled"}, entry.After)
+			return nil
+		}).
+		Times(1)
+
+	err := s.useCase.Execute(s.ctx, id)
+
+	s.Require().NoError(err)
+}
+
+func (s *Suite) TestOwnerWithOtherOwners() {
+	id := 42
+
+	s.expectValidID(id)
+
+	s.credentialsRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(
+			&entities.Credentials{
+				ID:     id,
+				Role:   enums.AdminRoleOwner,
+				Status: enums.CredentialsStatusEnabled,
+			},
+			nil,
+		).
+		Times(1)
+
+	s.credentialsRepo.EXPECT().
+		Count(
+			s.ctx,
+			&dto.AdminUserFilter{
+				Role:   enums.AdminRoleOwner,
+				Status: enums.CredentialsStatusEnabled,
+			},
+		).
+		Return(2, nil).
+		Times(1)
+
+	s.credentialsRepo.EXPECT().
+		UpdateStatus(s.ctx, id, enums.CredentialsStatusDisabled).
+		Return(nil).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	err := s.useCase.Execute(s.ctx, id)
+
+	s.Require().NoError(err)
+}
+
+func (s *Suite) TestLastOwner() {
+	id := 42
+
+	s.expectValidID(id)
+
+	s.credentialsRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(
+			&entities.Credentials{
+				ID:     id,
+				Role:   enums.AdminRoleOwner,
+				Status: enums.CredentialsStatusEnabled,
+			},
+			nil,
+		).
+		Times(1)
+
+	s.credentialsRepo.EXPECT().
+		Count(s.ctx, gomock.Any()).
+		Return(1, nil).
+		Times(1)
+
+	s.credentialsRepo.EXPECT().
+		UpdateStatus(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := s.useCase.Execute(s.ctx, id)
+
+	s.Require().Error(err)
+
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func (s *Suite) TestAlreadyDisabled() {
+	id := 42
+
+	s.expectValidID(id)
+
+	s.credentialsRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(
+			&entities.Credentials{
+				ID:     id,
+				Role:   enums.AdminRoleViewer,
+				Status: enums.CredentialsStatusDisabled,
+			},
+			nil,
+		).
+		Times(1)
+
+	s.credentialsRepo.EXPECT().
+		UpdateStatus(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := s.useCase.Execute(s.ctx, id)
+
+	s.Require().NoError(err)
+}
+
+func (s *Suite) TestNotFound() {
+	id := 42
+
+	s.expectValidID(id)
+
+	s.credentialsRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(nil, errors.NewNotFound("not found", nil)).
+		Times(1)
+
+	err := s.useCase.Execute(s.ctx, id)
+
+	s.Require().Error(err)
+
+	s.Equal(errors.CodeNotFound, err.Code())
+}
+
+func (s *Suite) TestValidationError() {
+	id := 0
+
+	expectedErr := errors.NewValidationFailed("id is required", nil)
+	s.validator.EXPECT().
+		ValidateVariable(
+			id,
+			"id",
+			"required,gt=0",
+			gomock.Any(),
+		).
+		Return(expectedErr).
+		Times(1)
+
+	s.credentialsRepo.EXPECT().
+		GetByID(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := s.useCase.Execute(s.ctx, id)
+
+	s.Require().Error(err)
+
+	s.Equal(expectedErr, err)
+}
+
+func TestAdminUserDisableSuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/admin_user/list/ports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/admin_user/list/ports.go -destination=internal/app/admin_user/list/mock/ports.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCredentialsRepository is a mock of CredentialsRepository interface.
+type MockCredentialsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCredentialsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCredentialsRepositoryMockRecorder is the mock recorder for MockCredentialsRepository.
+type MockCredentialsRepositoryMockRecorder struct {
+	mock *MockCredentialsRepository
+}
+
+// NewMockCredentialsRepository creates a new mock instance.
+func NewMockCredentialsRepository(ctrl *gomock.Controller) *MockCredentialsRepository {
+	mock := &MockCredentialsRepository{ctrl: ctrl}
+	mock.recorder = &MockCredentialsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCredentialsRepository) EXPECT() *MockCredentialsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockCredentialsRepository) Count(ctx context.Context, filter *dto.AdminUserFilter) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockCredentialsRepositoryMockRecorder) Count(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockCredentialsRepository)(nil).Count), ctx, filter)
+}
+
+// List mocks base method.
+func (m *MockCredentialsRepository) List(ctx context.Context, filter *dto.AdminUserFilter, page *dto.Pagination) ([]*entities.Credentials, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter, page)
+	ret0, _ := ret[0].([]*entities.Credentials)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCredentialsRepositoryMockRecorder) List(ctx, filter, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCredentialsRepository)(nil).List), ctx, filter, page)
+}
@@ -0,0 +1,14 @@
+package list
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type CredentialsRepository interface {
+	List(ctx context.Context, filter *dto.AdminUserFilter, page *dto.Pagination) ([]*entities.Credentials, errors.Error)
+	Count(ctx context.Context, filter *dto.AdminUserFilter) (int, errors.Error)
+}
@@ -0,0 +1,122 @@
+package list
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, req *dto.AdminUserFilter, page *dto.Pagination) (*dto.Page[*dto.AdminUserResponse], errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	credentialsRepo CredentialsRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, req *dto.AdminUserFilter, page *dto.Pagination,
+) (*dto.Page[*dto.AdminUserResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "admin_user.list")
+	defer span.End()
+
+	if err := uc.validateInput(req, page); err != nil {
+		return nil, err
+	}
+
+	users, err := uc.credentialsRepo.List(ctx, req, page)
+	if err != nil {
+		return nil, err
+	}
+
+	users, nextCursor := dto.TrimPage(
+		users,
+		page,
+		func(user *entities.Credentials) *dto.Cursor {
+			return &dto.Cursor{
+				Time: user.CreatedAt,
+				ID:   strconv.Itoa(user.ID),
+			}
+		},
+	)
+
+	userResponses := make([]*dto.AdminUserResponse, len(users))
+	for i, user := range users {
+		userResponses[i] = &dto.AdminUserResponse{
+			ID:                 user.ID,
+			Username:           user.Username,
+			Role:               user.Role,
+			Status:             user.Status,
+			ForcePasswordReset: user.ForcePasswordReset,
+			CreatedAt:          user.CreatedAt,
+		}
+	}
+
+	resp := &dto.Page[*dto.AdminUserResponse]{
+		Items:      userResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.credentialsRepo.Count(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validateInput(
+	req *dto.AdminUserFilter, page *dto.Pagination,
+) errors.Error {
+	var err errors.Error
+
+	if errReq := uc.validateReq(req); errReq != nil {
+		err = errors.Aggregate(err, errReq)
+	}
+
+	if errPage := uc.validatePage(page); errPage != nil {
+		err = errors.Aggregate(err, errPage)
+	}
+
+	return err
+}
+
+func (uc *useCase) validateReq(req *dto.AdminUserFilter) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"role.enums":   "role must be one of the following: owner, operator, viewer, billing",
+			"status.enums": "status must be one of the following: enabled, disabled",
+		},
+	)
+}
+
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator, credentialsRepo CredentialsRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		credentialsRepo: credentialsRepo,
+	}
+}
@@ -0,0 +1,27 @@
+package adminuser
+
+import (
+	"github.com/MAD-py/pandora-core/internal/app/admin_user/create"
+	"github.com/MAD-py/pandora-core/internal/app/admin_user/disable"
+	"github.com/MAD-py/pandora-core/internal/app/admin_user/list"
+	seedowner "github.com/MAD-py/pandora-core/internal/app/admin_user/seed_owner"
+)
+
+// ... Create Use Case ...
+
+type CredentialsCreateRepository = create.CredentialsRepository
+type AuditCreateRepository = create.AuditRepository
+
+// ... Disable Use Case ...
+
+type CredentialsDisableRepository = disable.CredentialsRepository
+type AuditDisableRepository = disable.AuditRepository
+
+// ... List Use Case ...
+
+type CredentialsListRepository = list.CredentialsRepository
+
+// ... Seed Owner Use Case ...
+
+type CredentialsSeedOwnerRepository = seedowner.CredentialsRepository
+type LegacyCredentialsLoader = seedowner.LegacyCredentialsLoader
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/admin_user/seed_owner/ports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/admin_user/seed_owner/ports.go -destination=internal/app/admin_user/seed_owner/mock/ports.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCredentialsRepository is a mock of CredentialsRepository interface.
+type MockCredentialsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCredentialsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCredentialsRepositoryMockRecorder is the mock recorder for MockCredentialsRepository.
+type MockCredentialsRepositoryMockRecorder struct {
+	mock *MockCredentialsRepository
+}
+
+// NewMockCredentialsRepository creates a new mock instance.
+func NewMockCredentialsRepository(ctrl *gomock.Controller) *MockCredentialsRepository {
+	mock := &MockCredentialsRepository{ctrl: ctrl}
+	mock.recorder = &MockCredentialsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCredentialsRepository) EXPECT() *MockCredentialsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockCredentialsRepository) Count(ctx context.Context, filter *dto.AdminUserFilter) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockCredentialsRepositoryMockRecorder) Count(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockCredentialsRepository)(nil).Count), ctx, filter)
+}
+
+// Create mocks base method.
+func (m *MockCredentialsRepository) Create(ctx context.Context, credentials *entities.Credentials) errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, credentials)
+	ret0, _ := ret[0].(errors.Error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCredentialsRepositoryMockRecorder) Create(ctx, credentials any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCredentialsRepository)(nil).Create), ctx, credentials)
+}
+
+// MockLegacyCredentialsLoader is a mock of LegacyCredentialsLoader interface.
+type MockLegacyCredentialsLoader struct {
+	ctrl     *gomock.Controller
+	recorder *MockLegacyCredentialsLoaderMockRecorder
+	isgomock struct{}
+}
+
+// MockLegacyCredentialsLoaderMockRecorder is the mock recorder for MockLegacyCredentialsLoader.
+type MockLegacyCredentialsLoaderMockRecorder struct {
+	mock *MockLegacyCredentialsLoader
+}
+
+// NewMockLegacyCredentialsLoader creates a new mock instance.
+func NewMockLegacyCredentialsLoader(ctrl *gomock.Controller) *MockLegacyCredentialsLoader {
+	mock := &MockLegacyCredentialsLoader{ctrl: ctrl}
+	mock.recorder = &MockLegacyCredentialsLoaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLegacyCredentialsLoader) EXPECT() *MockLegacyCredentialsLoaderMockRecorder {
+	return m.recorder
+}
+
+// Load mocks base method.
+func (m *MockLegacyCredentialsLoader) Load() (*entities.Credentials, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load")
+	ret0, _ := ret[0].(*entities.Credentials)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockLegacyCredentialsLoaderMockRecorder) Load() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockLegacyCredentialsLoader)(nil).Load))
+}
@@ -0,0 +1,18 @@
+package seedowner
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type CredentialsRepository interface {
+	Count(ctx context.Context, filter *dto.AdminUserFilter) (int, errors.Error)
+	Create(ctx context.Context, credentials *entities.Credentials) errors.Error
+}
+
+type LegacyCredentialsLoader interface {
+	Load() (*entities.Credentials, errors.Error)
+}
@@ -0,0 +1,90 @@
+package seedowner
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+const defaultOwnerUsername = "Admin"
+
+type UseCase interface {
+	Execute(ctx context.Context) (*dto.AdminUserSeeded, errors.Error)
+}
+
+type useCase struct {
+	credentialsRepo CredentialsRepository
+	legacyLoader    LegacyCredentialsLoader
+}
+
+// Execute creates the first owner when there are no admin users yet,
+// importing the admin user of the legacy credentials file if there is one
+// or generating a password for the default one otherwise. It returns nil
+// when there already are admin users.
+func (uc *useCase) Execute(ctx context.Context) (*dto.AdminUserSeeded, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "admin_user.seed_owner")
+	defer span.End()
+
+	total, err := uc.credentialsRepo.Count(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if total > 0 {
+		return nil, nil
+	}
+
+	legacy, err := uc.legacyLoader.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	if legacy != nil {
+		legacy.Role = enums.AdminRoleOwner
+		legacy.Status = enums.CredentialsStatusEnabled
+
+		if err := uc.credentialsRepo.Create(ctx, legacy); err != nil {
+			return nil, err
+		}
+
+		return &dto.AdminUserSeeded{
+			Username: legacy.Username,
+			Imported: true,
+		}, nil
+	}
+
+	credentials := &entities.Credentials{
+		Username:           defaultOwnerUsername,
+		Role:               enums.AdminRoleOwner,
+		Status:             enums.CredentialsStatusEnabled,
+		ForcePasswordReset: true,
+	}
+
+	password, err := credentials.GeneratePassword()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.credentialsRepo.Create(ctx, credentials); err != nil {
+		return nil, err
+	}
+
+	return &dto.AdminUserSeeded{
+		Username: credentials.Username,
+		Password: password,
+	}, nil
+}
+
+func NewUseCase(
+	credentialsRepo CredentialsRepository,
+	legacyLoader LegacyCredentialsLoader,
+) UseCase {
+	return &useCase{
+		credentialsRepo: credentialsRepo,
+		legacyLoader:    legacyLoader,
+	}
+}
@@ -0,0 +1,157 @@
+package seedowner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/admin_user/seed_owner/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type Suite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	credentialsRepo *mock.MockCredentialsRepository
+	legacyLoader    *mock.MockLegacyCredentialsLoader
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *Suite) SetupTest() {
+	time.Local = time.UTC
+
+	s.ctrl = gomock.NewController(s.T())
+
+	s.credentialsRepo = mock.NewMockCredentialsRepository(s.ctrl)
+	s.legacyLoader = mock.NewMockLegacyCredentialsLoader(s.ctrl)
+
+	s.useCase = NewUseCase(s.credentialsRepo, s.legacyLoader)
+
+	s.ctx = context.Background()
+}
+
+func (s *Suite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *Suite) TestExistingUsers() {
+	s.credentialsRepo.EXPECT().
+		Count(s.ctx, nil).
+		Return(3, nil).
+		Times(1)
+
+	s.legacyLoader.EXPECT().
+		Load().
+		Times(0)
+
+	s.credentialsRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	seeded, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Nil(seeded)
+}
+
+func (s *Suite) TestImportLegacy() {
+	s.credentialsRepo.EXPECT().
+		Count(s.ctx, nil).
+		Return(0, nil).
+		Times(1)
+
+	s.legacyLoader.EXPECT().
+		Load().
+		Return(
+			&entities.Credentials{
+				Username:       "team",
+				HashedPassword: "hashed",
+			},
+			nil,
+		).
+		Times(1)
+
+	s.credentialsRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, credentials *entities.Credentials) errors.Error {
+			s.Equal("team", credentials.Username)
+			s.Equal("hashed", credentials.HashedPassword)
+			s.Equal(enums.AdminRoleOwner, credentials.Role)
+			s.Equal(enums.CredentialsStatusEnabled, credentials.Status)
+			return nil
+		}).
+		Times(1)
+
+	seeded, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(seeded)
+
+	s.Equal("team", seeded.Username)
+	s.True(seeded.Imported)
+	s.Empty(seeded.Password)
+}
+
+func (s *Suite) TestGenerateDefault() {
+	s.credentialsRepo.EXPECT().
+		Count(s.ctx, nil).
+		Return(0, nil).
+		Times(1)
+
+	s.legacyLoader.EXPECT().
+		Load().
+		Return(nil, nil).
+		Times(1)
+
+	var created *entities.Credentials
+	s.credentialsRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, credentials *entities.Credentials) errors.Error {
+			created = credentials
+			return nil
+		}).
+		Times(1)
+
+	seeded, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(seeded)
+	s.Require().NotNil(created)
+
+	s.Equal(defaultOwnerUsername, seeded.Username)
+	s.False(seeded.Imported)
+	s.NotEmpty(seeded.Password)
+
+	s.Equal(enums.AdminRoleOwner, created.Role)
+	s.Equal(enums.CredentialsStatusEnabled, created.Status)
+	s.True(created.ForcePasswordReset)
+	s.NotEqual(seeded.Password, created.HashedPassword)
+}
+
+func (s *Suite) TestCountError() {
+	expectedErr := errors.NewInternal("Database error", nil)
+	s.credentialsRepo.EXPECT().
+		Count(s.ctx, nil).
+		Return(0, expectedErr).
+		Times(1)
+
+	seeded, err := s.useCase.Execute(s.ctx)
+
+	s.Require().Error(err)
+	s.Nil(seeded)
+	s.Equal(expectedErr, err)
+}
+
+func TestAdminUserSeedOwnerSuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
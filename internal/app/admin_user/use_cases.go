@@ -0,0 +1,54 @@
+package adminuser
+
+import (
+	"github.com/MAD-py/pandora-core/internal/app/admin_user/create"
+	"github.com/MAD-py/pandora-core/internal/app/admin_user/disable"
+	"github.com/MAD-py/pandora-core/internal/app/admin_user/list"
+	seedowner "github.com/MAD-py/pandora-core/internal/app/admin_user/seed_owner"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+// ... Create Use Case ...
+
+type CreateUseCase = create.UseCase
+
+func NewCreateUseCase(
+	validator validator.Validator,
+	credentialsRepo CredentialsCreateRepository,
+	auditRepo AuditCreateRepository,
+) CreateUseCase {
+	return create.NewUseCase(validator, credentialsRepo, auditRepo)
+}
+
+// ... Disable Use Case ...
+
+type DisableUseCase = disable.UseCase
+
+func NewDisableUseCase(
+	validator validator.Validator,
+	credentialsRepo CredentialsDisableRepository,
+	auditRepo AuditDisableRepository,
+) DisableUseCase {
+	return disable.NewUseCase(validator, credentialsRepo, auditRepo)
+}
+
+// ... List Use Case ...
+
+type ListUseCase = list.UseCase
+
+func NewListUseCase(
+	validator validator.Validator, credentialsRepo CredentialsListRepository,
+) ListUseCase {
+	return list.NewUseCase(validator, credentialsRepo)
+}
+
+// ... Seed Owner Use Case ...
+
+type SeedOwnerUseCase = seedowner.UseCase
+
+func NewSeedOwnerUseCase(
+	credentialsRepo CredentialsSeedOwnerRepository,
+	legacyLoader LegacyCredentialsLoader,
+) SeedOwnerUseCase {
+	return seedowner.NewUseCase(credentialsRepo, legacyLoader)
+}
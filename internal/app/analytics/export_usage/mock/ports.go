@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/analytics/export_usage/ports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/analytics/export_usage/ports.go -destination=internal/app/analytics/export_usage/mock/ports.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsageRepository is a mock of UsageRepository interface.
+type MockUsageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsageRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUsageRepositoryMockRecorder is the mock recorder for MockUsageRepository.
+type MockUsageRepositoryMockRecorder struct {
+	mock *MockUsageRepository
+}
+
+// NewMockUsageRepository creates a new mock instance.
+func NewMockUsageRepository(ctrl *gomock.Controller) *MockUsageRepository {
+	mock := &MockUsageRepository{ctrl: ctrl}
+	mock.recorder = &MockUsageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsageRepository) EXPECT() *MockUsageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// StreamList mocks base method.
+func (m *MockUsageRepository) StreamList(ctx context.Context, filter *dto.UsageFilter, yield func(*entities.UsageBucket) errors.Error) errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamList", ctx, filter, yield)
+	ret0, _ := ret[0].(errors.Error)
+	return ret0
+}
+
+// StreamList indicates an expected call of StreamList.
+func (mr *MockUsageRepositoryMockRecorder) StreamList(ctx, filter, yield any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamList", reflect.TypeOf((*MockUsageRepository)(nil).StreamList), ctx, filter, yield)
+}
@@ -0,0 +1,13 @@
+package exportusage
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type UsageRepository interface {
+	StreamList(ctx context.Context, filter *dto.UsageFilter, yield func(*entities.UsageBucket) errors.Error) errors.Error
+}
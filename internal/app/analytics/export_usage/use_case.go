@@ -0,0 +1,96 @@
+package exportusage
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, req *dto.UsageFilter, yield func(*dto.UsageBucketResponse) errors.Error) errors.Error
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	usageRepo UsageRepository
+}
+
+// Execute hands every usage bucket of the filter to yield as it is read
+// from the rollups. Unlike the usage report, the range may span any number
+// of buckets. It stops at the first error yield returns.
+func (uc *useCase) Execute(
+	ctx context.Context,
+	req *dto.UsageFilter,
+	yield func(*dto.UsageBucketResponse) errors.Error,
+) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "analytics.export_usage")
+	defer span.End()
+
+	if err := uc.validateReq(req); err != nil {
+		return err
+	}
+
+	// Buckets are whole, so the range is widened to the buckets its ends
+	// fall in.
+	filter := *req
+	filter.From = req.Granularity.Truncate(req.From)
+	filter.To = req.Granularity.Truncate(req.To)
+	if filter.To.Before(req.To) {
+		filter.To = req.Granularity.Next(filter.To)
+	}
+
+	return uc.usageRepo.StreamList(
+		ctx,
+		&filter,
+		func(bucket *entities.UsageBucket) errors.Error {
+			return yield(
+				&dto.UsageBucketResponse{
+					Bucket:             bucket.Bucket,
+					ClientID:           bucket.ClientID,
+					ProjectID:          bucket.ProjectID,
+					EnvironmentID:      bucket.EnvironmentID,
+					ServiceID:          bucket.ServiceID,
+					APIKeyID:           bucket.APIKeyID,
+					ExecutionStatus:    bucket.ExecutionStatus,
+					UnauthorizedReason: bucket.UnauthorizedReason,
+					Requests:           bucket.Requests,
+					Units:              bucket.Units,
+				},
+			)
+		},
+	)
+}
+
+func (uc *useCase) validateReq(req *dto.UsageFilter) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"granularity.required": "granularity is required",
+			"granularity.enums":    "granularity must be one of the following: hour, day, month",
+			"from.required":        "from is required",
+			"to.required":          "to is required",
+			"to.gtfield":           "to must be greater than from",
+			"group_by.unique":      "group_by must not repeat a dimension",
+			"group_by[].enums":     "group_by must be one of the following: client, project, environment, service, api_key, execution_status, unauthorized_reason",
+			"client_id.gt":         "client_id must be greater than 0",
+			"project_id.gt":        "project_id must be greater than 0",
+			"environment_id.gt":    "environment_id must be greater than 0",
+			"service_id.gt":        "service_id must be greater than 0",
+			"api_key_id.gt":        "api_key_id must be greater than 0",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator, usageRepo UsageRepository,
+) UseCase {
+	return &useCase{
+		validator: validator,
+		usageRepo: usageRepo,
+	}
+}
@@ -0,0 +1,127 @@
+package exportusage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/analytics/export_usage/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator *mockvalidator.MockValidator
+	usageRepo *mock.MockUsageRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	time.Local = time.UTC
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.usageRepo = mock.NewMockUsageRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.validator, s.usageRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) TestExportsRangeOfAnySpan() {
+	req := &dto.UsageFilter{
+		Granularity: enums.UsageGranularityHour,
+		From:        time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC),
+		To:          time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		GroupBy:     []enums.UsageGroupBy{enums.UsageGroupByClient},
+	}
+
+	s.validator.EXPECT().
+		ValidateStruct(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.usageRepo.EXPECT().
+		StreamList(s.ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(
+				_ context.Context,
+				filter *dto.UsageFilter,
+				yield func(*entities.UsageBucket) errors.Error,
+			) errors.Error {
+				s.Equal(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), filter.From)
+				s.Equal(req.To, filter.To)
+
+				return yield(
+					&entities.UsageBucket{
+						Bucket:   filter.From,
+						ClientID: 7,
+						Requests: 12,
+						Units:    30,
+					},
+				)
+			},
+		).
+		Times(1)
+
+	var exported []*dto.UsageBucketResponse
+	err := s.useCase.Execute(
+		s.ctx,
+		req,
+		func(b *dto.UsageBucketResponse) errors.Error {
+			exported = append(exported, b)
+			return nil
+		},
+	)
+
+	s.Require().NoError(err)
+	s.Require().Len(exported, 1)
+	s.Equal(7, exported[0].ClientID)
+	s.Equal(12, exported[0].Requests)
+	s.Equal(30, exported[0].Units)
+}
+
+func (s *UseCaseSuite) TestInvalidFilter() {
+	s.validator.EXPECT().
+		ValidateStruct(gomock.Any(), gomock.Any()).
+		Return(
+			errors.NewAttributeValidationFailed(
+				"UsageFilter", "granularity", "granularity is required", nil,
+			),
+		).
+		Times(1)
+
+	s.usageRepo.EXPECT().
+		StreamList(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := s.useCase.Execute(
+		s.ctx,
+		&dto.UsageFilter{},
+		func(*dto.UsageBucketResponse) errors.Error { return nil },
+	)
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
@@ -0,0 +1,19 @@
+package analytics
+
+import (
+	exportusage "github.com/MAD-py/pandora-core/internal/app/analytics/export_usage"
+	rollupusage "github.com/MAD-py/pandora-core/internal/app/analytics/rollup_usage"
+	"github.com/MAD-py/pandora-core/internal/app/analytics/usage"
+)
+
+// ... Export Usage Use Case ...
+
+type UsageExportRepository = exportusage.UsageRepository
+
+// ... Rollup Usage Use Case ...
+
+type UsageRollupRepository = rollupusage.UsageRepository
+
+// ... Usage Use Case ...
+
+type UsageRepository = usage.UsageRepository
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rollup_usage/ports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=rollup_usage/ports.go -destination=rollup_usage/mock/ports.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsageRepository is a mock of UsageRepository interface.
+type MockUsageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsageRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUsageRepositoryMockRecorder is the mock recorder for MockUsageRepository.
+type MockUsageRepositoryMockRecorder struct {
+	mock *MockUsageRepository
+}
+
+// NewMockUsageRepository creates a new mock instance.
+func NewMockUsageRepository(ctrl *gomock.Controller) *MockUsageRepository {
+	mock := &MockUsageRepository{ctrl: ctrl}
+	mock.recorder = &MockUsageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsageRepository) EXPECT() *MockUsageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetRollupWatermark mocks base method.
+func (m *MockUsageRepository) GetRollupWatermark(ctx context.Context) (time.Time, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRollupWatermark", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// GetRollupWatermark indicates an expected call of GetRollupWatermark.
+func (mr *MockUsageRepositoryMockRecorder) GetRollupWatermark(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRollupWatermark", reflect.TypeOf((*MockUsageRepository)(nil).GetRollupWatermark), ctx)
+}
+
+// RefreshRollups mocks base method.
+func (m *MockUsageRepository) RefreshRollups(ctx context.Context, from, to time.Time) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshRollups", ctx, from, to)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// RefreshRollups indicates an expected call of RefreshRollups.
+func (mr *MockUsageRepositoryMockRecorder) RefreshRollups(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshRollups", reflect.TypeOf((*MockUsageRepository)(nil).RefreshRollups), ctx, from, to)
+}
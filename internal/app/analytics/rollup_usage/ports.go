@@ -0,0 +1,13 @@
+package rollupusage
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type UsageRepository interface {
+	GetRollupWatermark(ctx context.Context) (time.Time, errors.Error)
+	RefreshRollups(ctx context.Context, from, to time.Time) (int, errors.Error)
+}
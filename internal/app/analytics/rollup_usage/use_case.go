@@ -0,0 +1,45 @@
+package rollupusage
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// settleWindow is how far before the watermark each run looks back, so the
+// buckets of requests whose status or units were updated shortly after being
+// logged, or that committed late, are refreshed again.
+const settleWindow = time.Hour
+
+type UseCase interface {
+	Execute(ctx context.Context) (int, errors.Error)
+}
+
+type useCase struct {
+	usageRepo UsageRepository
+}
+
+func (uc *useCase) Execute(ctx context.Context) (int, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "analytics.rollup_usage")
+	defer span.End()
+
+	watermark, err := uc.usageRepo.GetRollupWatermark(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var from time.Time
+	if !watermark.IsZero() {
+		from = watermark.Add(-settleWindow)
+	}
+
+	return uc.usageRepo.RefreshRollups(ctx, from, time.Now())
+}
+
+func NewUseCase(usageRepo UsageRepository) UseCase {
+	return &useCase{
+		usageRepo: usageRepo,
+	}
+}
@@ -0,0 +1,101 @@
+package rollupusage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/analytics/rollup_usage/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	usageRepo *mock.MockUsageRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	time.Local = time.UTC
+	s.ctrl = gomock.NewController(s.T())
+
+	s.usageRepo = mock.NewMockUsageRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.usageRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) TestFirstRunRollsUpEverything() {
+	s.usageRepo.EXPECT().
+		GetRollupWatermark(s.ctx).
+		Return(time.Time{}, nil).
+		Times(1)
+
+	s.usageRepo.EXPECT().
+		RefreshRollups(s.ctx, time.Time{}, gomock.Any()).
+		Return(24, nil).
+		Times(1)
+
+	refreshed, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(24, refreshed)
+}
+
+func (s *UseCaseSuite) TestLooksBackFromWatermark() {
+	watermark := time.Now().Add(-5 * time.Minute)
+
+	s.usageRepo.EXPECT().
+		GetRollupWatermark(s.ctx).
+		Return(watermark, nil).
+		Times(1)
+
+	s.usageRepo.EXPECT().
+		RefreshRollups(s.ctx, watermark.Add(-settleWindow), gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, _, to time.Time) (int, errors.Error) {
+				s.True(to.After(watermark))
+				return 2, nil
+			},
+		).
+		Times(1)
+
+	refreshed, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(2, refreshed)
+}
+
+func (s *UseCaseSuite) TestWatermarkError() {
+	s.usageRepo.EXPECT().
+		GetRollupWatermark(s.ctx).
+		Return(time.Time{}, errors.NewInternal("failed to read watermark", nil)).
+		Times(1)
+
+	s.usageRepo.EXPECT().
+		RefreshRollups(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	refreshed, err := s.useCase.Execute(s.ctx)
+
+	s.Require().Error(err)
+	s.Zero(refreshed)
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
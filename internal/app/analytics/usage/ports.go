@@ -0,0 +1,13 @@
+package usage
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type UsageRepository interface {
+	List(ctx context.Context, filter *dto.UsageFilter) ([]*entities.UsageBucket, errors.Error)
+}
@@ -0,0 +1,145 @@
+package usage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/analytics/usage/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator *mockvalidator.MockValidator
+	usageRepo *mock.MockUsageRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	time.Local = time.UTC
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.usageRepo = mock.NewMockUsageRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.validator, s.usageRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) expectValidInput() {
+	s.validator.EXPECT().
+		ValidateStruct(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+}
+
+func (s *UseCaseSuite) TestRangeWidenedToWholeBuckets() {
+	req := &dto.UsageFilter{
+		Granularity: enums.UsageGranularityDay,
+		From:        time.Date(2025, 6, 1, 10, 30, 0, 0, time.UTC),
+		To:          time.Date(2025, 6, 3, 8, 0, 0, 0, time.UTC),
+		GroupBy:     []enums.UsageGroupBy{enums.UsageGroupByProject},
+	}
+
+	wantFrom := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	wantTo := time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC)
+
+	s.expectValidInput()
+
+	s.usageRepo.EXPECT().
+		List(s.ctx, gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, filter *dto.UsageFilter) ([]*entities.UsageBucket, errors.Error) {
+				s.True(filter.From.Equal(wantFrom))
+				s.True(filter.To.Equal(wantTo))
+				s.Equal(req.GroupBy, filter.GroupBy)
+
+				return []*entities.UsageBucket{
+					{Bucket: wantFrom, ProjectID: 1, Requests: 10, Units: 12},
+					{Bucket: wantFrom, ProjectID: 2, Requests: 3, Units: 3},
+				}, nil
+			},
+		).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Equal(enums.UsageGranularityDay, resp.Granularity)
+	s.True(resp.From.Equal(wantFrom))
+	s.True(resp.To.Equal(wantTo))
+	s.Require().Len(resp.Buckets, 2)
+	s.Equal(1, resp.Buckets[0].ProjectID)
+	s.Equal(10, resp.Buckets[0].Requests)
+	s.Equal(12, resp.Buckets[0].Units)
+}
+
+func (s *UseCaseSuite) TestAlignedRangeKept() {
+	req := &dto.UsageFilter{
+		Granularity: enums.UsageGranularityMonth,
+		From:        time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:          time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	s.expectValidInput()
+
+	s.usageRepo.EXPECT().
+		List(s.ctx, gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, filter *dto.UsageFilter) ([]*entities.UsageBucket, errors.Error) {
+				s.True(filter.From.Equal(req.From))
+				s.True(filter.To.Equal(req.To))
+				return nil, nil
+			},
+		).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Empty(resp.Buckets)
+}
+
+func (s *UseCaseSuite) TestSpanTooLarge() {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	req := &dto.UsageFilter{
+		Granularity: enums.UsageGranularityHour,
+		From:        from,
+		To:          from.Add((dto.MaxUsageBuckets + 1) * time.Hour),
+	}
+
+	s.expectValidInput()
+
+	s.usageRepo.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
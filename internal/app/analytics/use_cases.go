@@ -0,0 +1,36 @@
+package analytics
+
+import (
+	exportusage "github.com/MAD-py/pandora-core/internal/app/analytics/export_usage"
+	rollupusage "github.com/MAD-py/pandora-core/internal/app/analytics/rollup_usage"
+	"github.com/MAD-py/pandora-core/internal/app/analytics/usage"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+// ... Export Usage Use Case ...
+
+type ExportUsageUseCase = exportusage.UseCase
+
+func NewExportUsageUseCase(
+	validator validator.Validator, usageRepo UsageExportRepository,
+) ExportUsageUseCase {
+	return exportusage.NewUseCase(validator, usageRepo)
+}
+
+// ... Rollup Usage Use Case ...
+
+type RollupUsageUseCase = rollupusage.UseCase
+
+func NewRollupUsageUseCase(usageRepo UsageRollupRepository) RollupUsageUseCase {
+	return rollupusage.NewUseCase(usageRepo)
+}
+
+// ... Usage Use Case ...
+
+type UsageUseCase = usage.UseCase
+
+func NewUsageUseCase(
+	validator validator.Validator, usageRepo UsageRepository,
+) UsageUseCase {
+	return usage.NewUseCase(validator, usageRepo)
+}
@@ -3,6 +3,7 @@ package create
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
@@ -11,3 +12,11 @@ type APIKeyRepository interface {
 	Exists(ctx context.Context, key string) (bool, errors.Error)
 	Create(ctx context.Context, apiKey *entities.APIKey) errors.Error
 }
+
+type EnvironmentRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Environment, errors.Error)
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
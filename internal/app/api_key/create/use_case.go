@@ -3,6 +3,8 @@ package create
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
@@ -17,24 +19,42 @@ type UseCase interface {
 type useCase struct {
 	validator validator.Validator
 
-	apiKeyRepo APIKeyRepository
+	keyPrefix string
+
+	apiKeyRepo      APIKeyRepository
+	environmentRepo EnvironmentRepository
+	auditRepo       AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, req *dto.APIKeyCreate,
 ) (*dto.APIKeyResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "api_key.create")
+	defer span.End()
+
 	if err := uc.validateReq(req); err != nil {
 		return nil, err
 	}
 
+	environment, err := uc.environmentRepo.GetByID(ctx, req.EnvironmentID)
+	if err != nil {
+		return nil, err
+	}
+
 	apiKey := &entities.APIKey{
 		Status:        enums.APIKeyStatusEnabled,
 		ExpiresAt:     req.ExpiresAt,
 		EnvironmentID: req.EnvironmentID,
 	}
 
+	if req.RateLimit != nil {
+		apiKey.RateLimit = entities.NewAPIKeyRateLimit(
+			req.RateLimit.Requests, req.RateLimit.Period, req.RateLimit.Burst,
+		)
+	}
+
 	for {
-		err := apiKey.GenerateKey()
+		err := apiKey.GenerateKey(uc.keyPrefix, environment.Type)
 		if err != nil {
 			return nil, err
 		}
@@ -53,6 +73,25 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionCreate,
+		enums.AuditEntityAPIKey,
+		apiKey.ID,
+		nil,
+		apiKey,
+	)
+
+	var rateLimit *dto.APIKeyRateLimit
+	if apiKey.RateLimit != nil {
+		rateLimit = &dto.APIKeyRateLimit{
+			Requests: apiKey.RateLimit.Requests,
+			Period:   apiKey.RateLimit.Period,
+			Burst:    apiKey.RateLimit.Burst,
+		}
+	}
+
 	return &dto.APIKeyResponse{
 		ID:            apiKey.ID,
 		Key:           apiKey.KeySummary(),
@@ -60,6 +99,7 @@ func (uc *useCase) Execute(
 		LastUsed:      apiKey.LastUsed,
 		ExpiresAt:     apiKey.ExpiresAt,
 		EnvironmentID: apiKey.EnvironmentID,
+		RateLimit:     rateLimit,
 		CreatedAt:     apiKey.CreatedAt,
 	}, nil
 }
@@ -71,15 +111,25 @@ func (uc *useCase) validateReq(req *dto.APIKeyCreate) errors.Error {
 			"expires_at.utc":          "expires_at must be in UTC format",
 			"environment_id.gt":       "environment_id must be greater than 0",
 			"environment_id.required": "environment_id is required",
+			"rate_limit.burst.gte":    "rate_limit.burst must be greater than or equal to 0",
+			"rate_limit.period.enums": "rate_limit.period must be one of the following: second, minute",
+			"rate_limit.requests.gte": "rate_limit.requests must be greater than or equal to 0",
 		},
 	)
 }
 
 func NewUseCase(
-	validator validator.Validator, apiKeyRepo APIKeyRepository,
+	validator validator.Validator,
+	keyPrefix string,
+	apiKeyRepo APIKeyRepository,
+	environmentRepo EnvironmentRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
-		validator:  validator,
-		apiKeyRepo: apiKeyRepo,
+		validator:       validator,
+		keyPrefix:       keyPrefix,
+		apiKeyRepo:      apiKeyRepo,
+		environmentRepo: environmentRepo,
+		auditRepo:       auditRepo,
 	}
 }
@@ -3,9 +3,16 @@ package delete
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
 type APIKeyRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.APIKey, errors.Error)
 	Delete(ctx context.Context, id int) errors.Error
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -3,6 +3,7 @@ package disable
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
@@ -12,3 +13,11 @@ type APIKeyRepository interface {
 	GetByID(ctx context.Context, id int) (*entities.APIKey, errors.Error)
 	UpdateStatus(ctx context.Context, id int, status enums.APIKeyStatus) errors.Error
 }
+
+type WebhookRepository interface {
+	CreateDeliveries(ctx context.Context, events []*entities.WebhookEvent) (int, errors.Error)
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -2,7 +2,12 @@ package disable
 
 import (
 	"context"
+	"log"
+	"time"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
@@ -15,10 +20,15 @@ type UseCase interface {
 type useCase struct {
 	validator validator.Validator
 
-	apikeyRepo APIKeyRepository
+	apikeyRepo  APIKeyRepository
+	webhookRepo WebhookRepository
+	auditRepo   AuditRepository
 }
 
 func (uc *useCase) Execute(ctx context.Context, id int) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "api_key.disable")
+	defer span.End()
+
 	if err := uc.validateID(id); err != nil {
 		return err
 	}
@@ -41,7 +51,41 @@ func (uc *useCase) Execute(ctx context.Context, id int) errors.Error {
 		)
 	}
 
-	return uc.apikeyRepo.UpdateStatus(ctx, id, enums.APIKeyStatusDisabled)
+	err = uc.apikeyRepo.UpdateStatus(ctx, id, enums.APIKeyStatusDisabled)
+	if err != nil {
+		return err
+	}
+
+	after := *apiKey
+	after.Status = enums.APIKeyStatusDisabled
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionDisable,
+		enums.AuditEntityAPIKey,
+		id,
+		apiKey,
+		&after,
+	)
+
+	event := entities.WebhookEvent{
+		Type:          enums.WebhookEventTypeAPIKeyDisabled,
+		EnvironmentID: apiKey.EnvironmentID,
+		APIKeyID:      id,
+		OccurredAt:    time.Now(),
+	}
+
+	_, err = uc.webhookRepo.CreateDeliveries(
+		ctx, []*entities.WebhookEvent{&event},
+	)
+	if err != nil {
+		log.Printf(
+			"[WARN] Failed to queue webhooks for disabled API Key %v: %v",
+			id, err,
+		)
+	}
+
+	return nil
 }
 
 func (uc *useCase) validateID(id int) errors.Error {
@@ -59,9 +103,13 @@ func (uc *useCase) validateID(id int) errors.Error {
 func NewUseCase(
 	validator validator.Validator,
 	apikeyRepo APIKeyRepository,
+	webhookRepo WebhookRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
-		validator:  validator,
-		apikeyRepo: apikeyRepo,
+		validator:   validator,
+		apikeyRepo:  apikeyRepo,
+		webhookRepo: webhookRepo,
+		auditRepo:   auditRepo,
 	}
 }
@@ -20,8 +20,10 @@ type Suite struct {
 
 	ctrl *gomock.Controller
 
-	validator  *mockvalidator.MockValidator
-	apiKeyRepo *mock.MockAPIKeyRepository
+	validator   *mockvalidator.MockValidator
+	apiKeyRepo  *mock.MockAPIKeyRepository
+	webhookRepo *mock.MockWebhookRepository
+	auditRepo   *mock.MockAuditRepository
 
 	useCase UseCase
 
@@ -34,9 +36,13 @@ func (s *Suite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 
 	s.apiKeyRepo = mock.NewMockAPIKeyRepository(s.ctrl)
+	s.webhookRepo = mock.NewMockWebhookRepository(s.ctrl)
+	s.auditRepo = mock.NewMockAuditRepository(s.ctrl)
 	s.validator = mockvalidator.NewMockValidator(s.ctrl)
 
-	s.useCase = NewUseCase(s.validator, s.apiKeyRepo)
+	s.useCase = NewUseCase(
+		s.validator, s.apiKeyRepo, s.webhookRepo, s.auditRepo,
+	)
 
 	s.ctx = context.Background()
 }
@@ -48,6 +54,66 @@ func (s *Suite) TearDownTest() {
 func (s *Suite) TestSuccess() {
 	id := 42
 
+	s.validator.EXPECT().
+		ValidateVariable(
+			id,
+			"id",
+			"required,gt=0",
+			gomock.Any(),
+		).
+		Return(nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(
+			&entities.APIKey{
+				ID:            id,
+				EnvironmentID: 7,
+				Status:        enums.APIKeyStatusEnabled,
+				ExpiresAt:     time.Now().Add(24 * time.Hour),
+			},
+			nil,
+		).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		UpdateStatus(s.ctx, id, enums.APIKeyStatusDisabled).
+		Return(nil).
+		Times(1)
+
+	s.webhookRepo.EXPECT().
+		CreateDeliveries(s.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, events []*entities.WebhookEvent) (int, errors.Error) {
+			s.Require().Len(events, 1)
+			s.Equal(enums.WebhookEventTypeAPIKeyDisabled, events[0].Type)
+			s.Equal(7, events[0].EnvironmentID)
+			s.Equal(id, events[0].APIKeyID)
+			s.Nil(events[0].Quota)
+			return 1, nil
+		}).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *entities.AuditEntry) errors.Error {
+			s.Equal(enums.AuditActionDisable, entry.Action)
+			s.Equal(enums.AuditEntityAPIKey, entry.Entity)
+			s.Equal(id, entry.EntityID)
+			s.Equal(map[string]any{"status": "enabled"}, entry.Before)
+			s.Equal(map[string]any{"status": "disabled"}, entry.After)
+			return nil
+		}).
+		Times(1)
+
+	err := s.useCase.Execute(s.ctx, id)
+
+	s.Require().NoError(err)
+}
+
+func (s *Suite) TestWebhookErrorIsIgnored() {
+	id := 42
+
 	s.validator.EXPECT().
 		ValidateVariable(
 			id,
@@ -75,6 +141,16 @@ func (s *Suite) TestSuccess() {
 		Return(nil).
 		Times(1)
 
+	s.webhookRepo.EXPECT().
+		CreateDeliveries(s.ctx, gomock.Any()).
+		Return(0, errors.NewInternal("Database error", nil)).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		Return(nil).
+		Times(1)
+
 	err := s.useCase.Execute(s.ctx, id)
 
 	s.Require().NoError(err)
@@ -248,6 +324,14 @@ func (s *Suite) TestRepositoryUpdateStatusError() {
 		Return(expectedErr).
 		Times(1)
 
+	s.webhookRepo.EXPECT().
+		CreateDeliveries(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
 	err := s.useCase.Execute(s.ctx, id)
 
 	s.Require().Error(err)
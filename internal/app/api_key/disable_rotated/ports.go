@@ -0,0 +1,17 @@
+package disablerotated
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type APIKeyRepository interface {
+	DisableRotated(ctx context.Context, now time.Time) ([]*entities.APIKey, errors.Error)
+}
+
+type WebhookRepository interface {
+	CreateDeliveries(ctx context.Context, events []*entities.WebhookEvent) (int, errors.Error)
+}
@@ -0,0 +1,63 @@
+package disablerotated
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context) (int, errors.Error)
+}
+
+type useCase struct {
+	apiKeyRepo  APIKeyRepository
+	webhookRepo WebhookRepository
+}
+
+func (uc *useCase) Execute(ctx context.Context) (int, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "api_key.disable_rotated")
+	defer span.End()
+
+	apiKeys, err := uc.apiKeyRepo.DisableRotated(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	if len(apiKeys) == 0 {
+		return 0, nil
+	}
+
+	events := make([]*entities.WebhookEvent, len(apiKeys))
+	for i, apiKey := range apiKeys {
+		events[i] = &entities.WebhookEvent{
+			Type:          enums.WebhookEventTypeAPIKeyDisabled,
+			EnvironmentID: apiKey.EnvironmentID,
+			APIKeyID:      apiKey.ID,
+			OccurredAt:    apiKey.GraceEndsAt,
+		}
+	}
+
+	if _, err := uc.webhookRepo.CreateDeliveries(ctx, events); err != nil {
+		log.Printf(
+			"[WARN] Failed to queue webhooks for %d rotated API Keys: %v",
+			len(apiKeys), err,
+		)
+	}
+
+	return len(apiKeys), nil
+}
+
+func NewUseCase(
+	apiKeyRepo APIKeyRepository, webhookRepo WebhookRepository,
+) UseCase {
+	return &useCase{
+		apiKeyRepo:  apiKeyRepo,
+		webhookRepo: webhookRepo,
+	}
+}
@@ -3,6 +3,7 @@ package enable
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
@@ -16,3 +17,7 @@ type APIKeyRepository interface {
 type EnvironmentRepository interface {
 	GetByID(ctx context.Context, id int) (*entities.Environment, errors.Error)
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -3,6 +3,8 @@ package enable
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
@@ -17,9 +19,13 @@ type useCase struct {
 
 	apiKeyRepo      APIKeyRepository
 	environmentRepo EnvironmentRepository
+	auditRepo       AuditRepository
 }
 
 func (uc *useCase) Execute(ctx context.Context, id int) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "api_key.enable")
+	defer span.End()
+
 	if err := uc.validateID(id); err != nil {
 		return err
 	}
@@ -42,6 +48,15 @@ func (uc *useCase) Execute(ctx context.Context, id int) errors.Error {
 		)
 	}
 
+	if apiKey.IsGracePeriodOver() {
+		return errors.NewEntityValidationFailed(
+			"APIKey",
+			"API key was rotated and cannot be enabled",
+			map[string]any{"id": id},
+			nil,
+		)
+	}
+
 	environment, err := uc.environmentRepo.GetByID(ctx, apiKey.EnvironmentID)
 	if err != nil {
 		return err
@@ -56,7 +71,24 @@ func (uc *useCase) Execute(ctx context.Context, id int) errors.Error {
 		)
 	}
 
-	return uc.apiKeyRepo.UpdateStatus(ctx, id, enums.APIKeyStatusEnabled)
+	err = uc.apiKeyRepo.UpdateStatus(ctx, id, enums.APIKeyStatusEnabled)
+	if err != nil {
+		return err
+	}
+
+	after := *apiKey
+	after.Status = enums.APIKeyStatusEnabled
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionEnable,
+		enums.AuditEntityAPIKey,
+		id,
+		apiKey,
+		&after,
+	)
+
+	return nil
 }
 
 func (uc *useCase) validateID(id int) errors.Error {
@@ -75,10 +107,12 @@ func NewUseCase(
 	validator validator.Validator,
 	apiKeyRepo APIKeyRepository,
 	environmentRepo EnvironmentRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:       validator,
 		apiKeyRepo:      apiKeyRepo,
 		environmentRepo: environmentRepo,
+		auditRepo:       auditRepo,
 	}
 }
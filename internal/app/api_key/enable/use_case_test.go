@@ -23,6 +23,7 @@ type Suite struct {
 	validator       *mockvalidator.MockValidator
 	apiKeyRepo      *mock.MockAPIKeyRepository
 	environmentRepo *mock.MockEnvironmentRepository
+	auditRepo       *mock.MockAuditRepository
 
 	useCase UseCase
 
@@ -36,9 +37,12 @@ func (s *Suite) SetupTest() {
 
 	s.apiKeyRepo = mock.NewMockAPIKeyRepository(s.ctrl)
 	s.environmentRepo = mock.NewMockEnvironmentRepository(s.ctrl)
+	s.auditRepo = mock.NewMockAuditRepository(s.ctrl)
 	s.validator = mockvalidator.NewMockValidator(s.ctrl)
 
-	s.useCase = NewUseCase(s.validator, s.apiKeyRepo, s.environmentRepo)
+	s.useCase = NewUseCase(
+		s.validator, s.apiKeyRepo, s.environmentRepo, s.auditRepo,
+	)
 
 	s.ctx = context.Background()
 }
@@ -93,6 +97,18 @@ func (s *Suite) TestSuccess() {
 		Return(nil).
 		Times(1)
 
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *entities.AuditEntry) errors.Error {
+			s.Equal(enums.AuditActionEnable, entry.Action)
+			s.Equal(enums.AuditEntityAPIKey, entry.Entity)
+			s.Equal(id, entry.EntityID)
+			s.Equal(map[string]any{"status": "disabled"}, entry.Before)
+			s.Equal(map[string]any{"status": "enabled"}, entry.After)
+			return nil
+		}).
+		Times(1)
+
 	err := s.useCase.Execute(s.ctx, id)
 
 	s.Require().NoError(err)
@@ -185,6 +201,53 @@ func (s *Suite) TestExpired() {
 	s.Equal(map[string]any{"id": id}, e.Identifiers())
 }
 
+func (s *Suite) TestRotated() {
+	id := 42
+
+	s.validator.EXPECT().
+		ValidateVariable(
+			id,
+			"id",
+			"required,gt=0",
+			gomock.Any(),
+		).
+		Return(nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(
+			&entities.APIKey{
+				ID:          id,
+				Status:      enums.APIKeyStatusDisabled,
+				GraceEndsAt: time.Now().Add(-time.Hour),
+			},
+			nil,
+		).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		GetByID(s.ctx, gomock.Any()).
+		Times(0)
+
+	s.apiKeyRepo.EXPECT().
+		UpdateStatus(s.ctx, id, gomock.Any()).
+		Times(0)
+
+	err := s.useCase.Execute(s.ctx, id)
+
+	s.Require().Error(err)
+
+	s.Equal(errors.CodeValidationFailed, err.Code())
+
+	var e *errors.EntityError
+	s.Require().ErrorAs(err, &e)
+
+	s.Equal("APIKey", e.Entity())
+	s.Equal("API key was rotated and cannot be enabled", e.Message())
+	s.Equal(map[string]any{"id": id}, e.Identifiers())
+}
+
 func (s *Suite) TestEnvironmentDisabled() {
 	id := 42
 	environmentID := 42
@@ -396,6 +459,10 @@ func (s *Suite) TestRepositoryUpdateStatusError() {
 		Return(expectedErr).
 		Times(1)
 
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
 	err := s.useCase.Execute(s.ctx, id)
 
 	s.Require().Error(err)
@@ -4,8 +4,11 @@ import (
 	"github.com/MAD-py/pandora-core/internal/app/api_key/create"
 	"github.com/MAD-py/pandora-core/internal/app/api_key/delete"
 	"github.com/MAD-py/pandora-core/internal/app/api_key/disable"
+	disablerotated "github.com/MAD-py/pandora-core/internal/app/api_key/disable_rotated"
 	"github.com/MAD-py/pandora-core/internal/app/api_key/enable"
+	protectlegacykeys "github.com/MAD-py/pandora-core/internal/app/api_key/protect_legacy_keys"
 	revealkey "github.com/MAD-py/pandora-core/internal/app/api_key/reveal_key"
+	"github.com/MAD-py/pandora-core/internal/app/api_key/rotate"
 	"github.com/MAD-py/pandora-core/internal/app/api_key/update"
 	validateconsume "github.com/MAD-py/pandora-core/internal/app/api_key/validate_consume"
 	validateonly "github.com/MAD-py/pandora-core/internal/app/api_key/validate_only"
@@ -14,14 +17,18 @@ import (
 // ... Create Use Case ...
 
 type APIKeyCreateRepository = create.APIKeyRepository
+type EnvironmentCreateRepository = create.EnvironmentRepository
+type AuditCreateRepository = create.AuditRepository
 
 // ... Delete Use Case ...
 
 type APIKeyDeleteRepository = delete.APIKeyRepository
+type AuditDeleteRepository = delete.AuditRepository
 
 // ... Update Use Case ...
 
 type APIKeyUpdateRepository = update.APIKeyRepository
+type AuditUpdateRepository = update.AuditRepository
 
 // ... Validate Use Case ...
 
@@ -33,21 +40,40 @@ type EnvironmentValidateRepository = validateonly.EnvironmentRepository
 
 // ... Validate And Consume Use Case ...
 
-type APIKeyValidateConsumeRepository = validateconsume.APIKeyRepository
+type ValidationValidateConsumeRepository = validateconsume.ValidationRepository
 type RequestValidateConsumeRepository = validateconsume.RequestRepository
-type ServiceValidateConsumeRepository = validateconsume.ServiceRepository
-type ProjectValidateConsumeRepository = validateconsume.ProjectRepository
-type EnvironmentValidateConsumeRepository = validateconsume.EnvironmentRepository
+type QuotaAlertValidateConsumeRepository = validateconsume.QuotaAlertRepository
+type WebhookValidateConsumeRepository = validateconsume.WebhookRepository
+type RateLimiterValidateConsume = validateconsume.RateLimiter
 
 // ... Disable Use Case ...
 
 type APIKeyDisableRepository = disable.APIKeyRepository
+type WebhookDisableRepository = disable.WebhookRepository
+type AuditDisableRepository = disable.AuditRepository
 
 /// ... Enable Use Case ...
 
 type APIKeyEnableRepository = enable.APIKeyRepository
 type EnvironmentEnableRepository = enable.EnvironmentRepository
+type AuditEnableRepository = enable.AuditRepository
 
 // ... Reveal Key Use Case ...
 
 type APIKeyRevealKeyRepository = revealkey.APIKeyRepository
+type AuditRevealKeyRepository = revealkey.AuditRepository
+
+// ... Protect Legacy Keys Use Case ...
+
+type APIKeyProtectLegacyKeysRepository = protectlegacykeys.APIKeyRepository
+
+// ... Rotate Use Case ...
+
+type APIKeyRotateRepository = rotate.APIKeyRepository
+type EnvironmentRotateRepository = rotate.EnvironmentRepository
+type AuditRotateRepository = rotate.AuditRepository
+
+// ... Disable Rotated Use Case ...
+
+type APIKeyDisableRotatedRepository = disablerotated.APIKeyRepository
+type WebhookDisableRotatedRepository = disablerotated.WebhookRepository
@@ -0,0 +1,11 @@
+package protectlegacykeys
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type APIKeyRepository interface {
+	ProtectLegacyKeys(ctx context.Context) (int, errors.Error)
+}
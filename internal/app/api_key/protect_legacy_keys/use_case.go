@@ -0,0 +1,29 @@
+package protectlegacykeys
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context) (int, errors.Error)
+}
+
+type useCase struct {
+	apiKeyRepo APIKeyRepository
+}
+
+func (uc *useCase) Execute(ctx context.Context) (int, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "api_key.protect_legacy_keys")
+	defer span.End()
+
+	return uc.apiKeyRepo.ProtectLegacyKeys(ctx)
+}
+
+func NewUseCase(apiKeyRepo APIKeyRepository) UseCase {
+	return &useCase{
+		apiKeyRepo: apiKeyRepo,
+	}
+}
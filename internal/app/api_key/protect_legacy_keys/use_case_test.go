@@ -0,0 +1,81 @@
+package protectlegacykeys
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/api_key/protect_legacy_keys/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	apiKeyRepo *mock.MockAPIKeyRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+
+	s.apiKeyRepo = mock.NewMockAPIKeyRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.apiKeyRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) TestReturnsProtectedKeys() {
+	s.apiKeyRepo.EXPECT().
+		ProtectLegacyKeys(s.ctx).
+		Return(3, nil).
+		Times(1)
+
+	protected, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(3, protected)
+}
+
+func (s *UseCaseSuite) TestRerunProtectsNothing() {
+	gomock.InOrder(
+		s.apiKeyRepo.EXPECT().ProtectLegacyKeys(s.ctx).Return(2, nil),
+		s.apiKeyRepo.EXPECT().ProtectLegacyKeys(s.ctx).Return(0, nil),
+	)
+
+	protected, err := s.useCase.Execute(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(2, protected)
+
+	protected, err = s.useCase.Execute(s.ctx)
+	s.Require().NoError(err)
+	s.Zero(protected)
+}
+
+func (s *UseCaseSuite) TestReturnsKeysProtectedBeforeError() {
+	s.apiKeyRepo.EXPECT().
+		ProtectLegacyKeys(s.ctx).
+		Return(1, errors.NewInternal("failed", nil)).
+		Times(1)
+
+	protected, err := s.useCase.Execute(s.ctx)
+
+	s.Require().Error(err)
+	s.Equal(1, protected)
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
@@ -3,10 +3,14 @@ package revealkey
 import (
 	"context"
 
-	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
 type APIKeyRepository interface {
-	GetByID(ctx context.Context, id int) (*entities.APIKey, errors.Error)
+	GetKeyByID(ctx context.Context, id int) (string, errors.Error)
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
 }
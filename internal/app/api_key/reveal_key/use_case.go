@@ -3,7 +3,10 @@ package revealkey
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -16,20 +19,34 @@ type useCase struct {
 	validator validator.Validator
 
 	apiKeyRepo APIKeyRepository
+	auditRepo  AuditRepository
 }
 
 func (uc *useCase) Execute(ctx context.Context, id int) (*dto.APIKeyRevealKeyResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "api_key.reveal_key")
+	defer span.End()
+
 	if err := uc.validateID(id); err != nil {
 		return nil, err
 	}
 
-	apiKey, err := uc.apiKeyRepo.GetByID(ctx, id)
+	key, err := uc.apiKeyRepo.GetKeyByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionRevealKey,
+		enums.AuditEntityAPIKey,
+		id,
+		nil,
+		nil,
+	)
+
 	return &dto.APIKeyRevealKeyResponse{
-		Key: apiKey.Key,
+		Key: key,
 	}, nil
 }
 
@@ -46,10 +63,13 @@ func (uc *useCase) validateID(id int) errors.Error {
 }
 
 func NewUseCase(
-	validator validator.Validator, apiKeyRepo APIKeyRepository,
+	validator validator.Validator,
+	apiKeyRepo APIKeyRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:  validator,
 		apiKeyRepo: apiKeyRepo,
+		auditRepo:  auditRepo,
 	}
 }
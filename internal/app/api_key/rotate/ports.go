@@ -0,0 +1,24 @@
+package rotate
+
+import (
+	"context"
+	"time"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type APIKeyRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.APIKey, errors.Error)
+	Exists(ctx context.Context, key string) (bool, errors.Error)
+	Rotate(ctx context.Context, id int, graceEndsAt time.Time, successor *entities.APIKey, limit int) errors.Error
+}
+
+type EnvironmentRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Environment, errors.Error)
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
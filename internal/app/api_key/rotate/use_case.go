@@ -0,0 +1,77 @@
+package rotate
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/app/api_key/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id int, req *dto.APIKeyRotate) (*dto.APIKeyRotateResponse, errors.Error)
+}
+
+type useCase struct {
+	rotation *shared.RotateDependencies
+
+	apiKeyRepo      APIKeyRepository
+	environmentRepo EnvironmentRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, id int, req *dto.APIKeyRotate,
+) (*dto.APIKeyRotateResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "api_key.rotate")
+	defer span.End()
+
+	if err := shared.ValidateRotation(uc.rotation, id, req); err != nil {
+		return nil, err
+	}
+
+	apiKey, err := uc.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := shared.CheckRotatable(apiKey); err != nil {
+		return nil, err
+	}
+
+	environment, err := uc.environmentRepo.GetByID(ctx, apiKey.EnvironmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	successor, err := shared.RotateAPIKey(ctx, uc.rotation, apiKey, environment, req, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.APIKeyRotateResponse{
+		APIKey:        shared.APIKeyResponse(successor),
+		RotatedAPIKey: shared.APIKeyResponse(apiKey),
+	}, nil
+}
+
+// NewUseCase returns the use case rotating API keys, whose grace period
+// can last up to maxGracePeriod.
+func NewUseCase(
+	validator validator.Validator,
+	keyPrefix string,
+	maxGracePeriod time.Duration,
+	apiKeyRepo APIKeyRepository,
+	environmentRepo EnvironmentRepository,
+	auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		rotation: shared.NewRotationDependencies(
+			validator, keyPrefix, maxGracePeriod, apiKeyRepo, auditRepo,
+		),
+		apiKeyRepo:      apiKeyRepo,
+		environmentRepo: environmentRepo,
+	}
+}
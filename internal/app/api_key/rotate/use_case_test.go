@@ -0,0 +1,302 @@
+package rotate
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/api_key/rotate/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type Suite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator       *mockvalidator.MockValidator
+	apiKeyRepo      *mock.MockAPIKeyRepository
+	environmentRepo *mock.MockEnvironmentRepository
+	auditRepo       *mock.MockAuditRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *Suite) SetupTest() {
+	time.Local = time.UTC
+
+	s.ctrl = gomock.NewController(s.T())
+
+	s.apiKeyRepo = mock.NewMockAPIKeyRepository(s.ctrl)
+	s.environmentRepo = mock.NewMockEnvironmentRepository(s.ctrl)
+	s.auditRepo = mock.NewMockAuditRepository(s.ctrl)
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+
+	s.useCase = NewUseCase(
+		s.validator,
+		"pdr",
+		7*24*time.Hour,
+		s.apiKeyRepo,
+		s.environmentRepo,
+		s.auditRepo,
+	)
+
+	s.ctx = context.Background()
+}
+
+func (s *Suite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *Suite) expectValidation(id int, req *dto.APIKeyRotate) {
+	s.validator.EXPECT().
+		ValidateVariable(id, "id", "required,gt=0", gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.validator.EXPECT().
+		ValidateStruct(req, gomock.Any()).
+		Return(nil).
+		Times(1)
+}
+
+func (s *Suite) TestSuccess() {
+	id := 42
+	environmentID := 7
+	req := &dto.APIKeyRotate{GraceEndsAt: time.Now().Add(24 * time.Hour)}
+
+	s.expectValidation(id, req)
+
+	s.apiKeyRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(
+			&entities.APIKey{
+				ID:            id,
+				KeyPrefix:     "pdr_test_abcd",
+				Status:        enums.APIKeyStatusEnabled,
+				EnvironmentID: environmentID,
+			},
+			nil,
+		).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		GetByID(s.ctx, environmentID).
+		Return(
+			&entities.Environment{
+				ID:     environmentID,
+				Status: enums.EnvironmentStatusEnabled,
+				Type:   enums.EnvironmentTypeTest,
+			},
+			nil,
+		).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		Exists(s.ctx, gomock.Any()).
+		Return(false, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		Rotate(s.ctx, id, req.GraceEndsAt, gomock.AssignableToTypeOf(&entities.APIKey{}), 0).
+		DoAndReturn(
+			func(_ context.Context, id int, _ time.Time, successor *entities.APIKey, _ int) errors.Error {
+				s.Require().True(strings.HasPrefix(successor.Key, "pdr_test_"))
+				s.Require().Equal(environmentID, successor.EnvironmentID)
+				s.Require().Equal(enums.APIKeyStatusEnabled, successor.Status)
+				successor.ID = 43
+				successor.RotatedFromID = id
+				return nil
+			},
+		).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *entities.AuditEntry) errors.Error {
+			s.Equal(enums.AuditActionRotate, entry.Action)
+			s.Equal(enums.AuditEntityAPIKey, entry.Entity)
+			s.Equal(id, entry.EntityID)
+			s.Equal(map[string]any{"grace_ends_at": nil}, entry.Before)
+			s.Equal(map[string]any{"grace_ends_at": req.GraceEndsAt.UTC()}, entry.After)
+			return nil
+		}).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, id, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.Equal(43, resp.APIKey.ID)
+	s.Equal(id, resp.APIKey.RotatedFromID)
+	s.True(resp.APIKey.GraceEndsAt.IsZero())
+	s.True(strings.HasPrefix(resp.APIKey.Key, "pdr_test_"))
+
+	s.Equal(id, resp.RotatedAPIKey.ID)
+	s.Equal(req.GraceEndsAt, resp.RotatedAPIKey.GraceEndsAt)
+	s.Equal(enums.APIKeyStatusEnabled, resp.RotatedAPIKey.Status)
+}
+
+func (s *Suite) TestAlreadyRotated() {
+	id := 42
+	req := &dto.APIKeyRotate{GraceEndsAt: time.Now().Add(24 * time.Hour)}
+
+	s.expectValidation(id, req)
+
+	s.apiKeyRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(
+			&entities.APIKey{
+				ID:          id,
+				Status:      enums.APIKeyStatusEnabled,
+				GraceEndsAt: time.Now().Add(time.Hour),
+			},
+			nil,
+		).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		Rotate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, id, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+
+	s.Equal(errors.CodeValidationFailed, err.Code())
+
+	var e *errors.EntityError
+	s.Require().ErrorAs(err, &e)
+
+	s.Equal("APIKey", e.Entity())
+	s.Equal("API key has already been rotated", e.Message())
+	s.Equal(map[string]any{"id": id}, e.Identifiers())
+}
+
+func (s *Suite) TestDisabled() {
+	id := 42
+	req := &dto.APIKeyRotate{GraceEndsAt: time.Now().Add(24 * time.Hour)}
+
+	s.expectValidation(id, req)
+
+	s.apiKeyRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(
+			&entities.APIKey{ID: id, Status: enums.APIKeyStatusDisabled},
+			nil,
+		).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		Rotate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, id, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+
+	var e *errors.EntityError
+	s.Require().ErrorAs(err, &e)
+
+	s.Equal("API key is disabled and cannot be rotated", e.Message())
+}
+
+func (s *Suite) TestGraceEndsAtInThePast() {
+	id := 42
+	req := &dto.APIKeyRotate{GraceEndsAt: time.Now().Add(-time.Hour)}
+
+	s.expectValidation(id, req)
+
+	s.apiKeyRepo.EXPECT().
+		GetByID(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, id, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func (s *Suite) TestGraceEndsAtBeyondMaximum() {
+	id := 42
+	req := &dto.APIKeyRotate{GraceEndsAt: time.Now().Add(8 * 24 * time.Hour)}
+
+	s.expectValidation(id, req)
+
+	s.apiKeyRepo.EXPECT().
+		GetByID(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, id, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+
+	s.Equal(errors.CodeValidationFailed, err.Code())
+	s.Contains(err.Error(), "grace_ends_at must be within 168h0m0s from now")
+}
+
+func (s *Suite) TestRepositoryRotateError() {
+	id := 42
+	environmentID := 7
+	req := &dto.APIKeyRotate{GraceEndsAt: time.Now().Add(24 * time.Hour)}
+
+	s.expectValidation(id, req)
+
+	s.apiKeyRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(
+			&entities.APIKey{
+				ID:            id,
+				Status:        enums.APIKeyStatusEnabled,
+				EnvironmentID: environmentID,
+			},
+			nil,
+		).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		GetByID(s.ctx, environmentID).
+		Return(&entities.Environment{ID: environmentID, Type: enums.EnvironmentTypeLive}, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		Exists(s.ctx, gomock.Any()).
+		Return(false, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		Rotate(s.ctx, id, req.GraceEndsAt, gomock.Any(), 0).
+		Return(errors.NewInternal("database error", nil)).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, id, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+
+	s.Equal(errors.CodeInternal, err.Code())
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
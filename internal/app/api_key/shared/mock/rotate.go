@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api_key/shared/rotate.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/api_key/shared/rotate.go -destination=internal/app/api_key/shared/mock/rotate.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRotateAPIKeyRepository is a mock of RotateAPIKeyRepository interface.
+type MockRotateAPIKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRotateAPIKeyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRotateAPIKeyRepositoryMockRecorder is the mock recorder for MockRotateAPIKeyRepository.
+type MockRotateAPIKeyRepositoryMockRecorder struct {
+	mock *MockRotateAPIKeyRepository
+}
+
+// NewMockRotateAPIKeyRepository creates a new mock instance.
+func NewMockRotateAPIKeyRepository(ctrl *gomock.Controller) *MockRotateAPIKeyRepository {
+	mock := &MockRotateAPIKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockRotateAPIKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRotateAPIKeyRepository) EXPECT() *MockRotateAPIKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockRotateAPIKeyRepository) Exists(ctx context.Context, key string) (bool, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockRotateAPIKeyRepositoryMockRecorder) Exists(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockRotateAPIKeyRepository)(nil).Exists), ctx, key)
+}
+
+// Rotate mocks base method.
+func (m *MockRotateAPIKeyRepository) Rotate(ctx context.Context, id int, graceEndsAt time.Time, successor *entities.APIKey) errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, id, graceEndsAt, successor)
+	ret0, _ := ret[0].(errors.Error)
+	return ret0
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockRotateAPIKeyRepositoryMockRecorder) Rotate(ctx, id, graceEndsAt, successor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockRotateAPIKeyRepository)(nil).Rotate), ctx, id, graceEndsAt, successor)
+}
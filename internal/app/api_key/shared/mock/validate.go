@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
 	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
@@ -174,3 +175,42 @@ func (mr *MockValidateProjectRepositoryMockRecorder) GetProjectClientInfoByID(ct
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectClientInfoByID", reflect.TypeOf((*MockValidateProjectRepository)(nil).GetProjectClientInfoByID), ctx, id)
 }
+
+// MockValidateRateLimiter is a mock of ValidateRateLimiter interface.
+type MockValidateRateLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidateRateLimiterMockRecorder
+	isgomock struct{}
+}
+
+// MockValidateRateLimiterMockRecorder is the mock recorder for MockValidateRateLimiter.
+type MockValidateRateLimiterMockRecorder struct {
+	mock *MockValidateRateLimiter
+}
+
+// NewMockValidateRateLimiter creates a new mock instance.
+func NewMockValidateRateLimiter(ctrl *gomock.Controller) *MockValidateRateLimiter {
+	mock := &MockValidateRateLimiter{ctrl: ctrl}
+	mock.recorder = &MockValidateRateLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidateRateLimiter) EXPECT() *MockValidateRateLimiterMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockValidateRateLimiter) Allow(ctx context.Context, key string, limit *entities.APIKeyRateLimit) (time.Duration, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", ctx, key, limit)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockValidateRateLimiterMockRecorder) Allow(ctx, key, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockValidateRateLimiter)(nil).Allow), ctx, key, limit)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api_key/shared/webhook.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/api_key/shared/webhook.go -destination=internal/app/api_key/shared/mock/webhook.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotifyWebhookRepository is a mock of NotifyWebhookRepository interface.
+type MockNotifyWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifyWebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotifyWebhookRepositoryMockRecorder is the mock recorder for MockNotifyWebhookRepository.
+type MockNotifyWebhookRepositoryMockRecorder struct {
+	mock *MockNotifyWebhookRepository
+}
+
+// NewMockNotifyWebhookRepository creates a new mock instance.
+func NewMockNotifyWebhookRepository(ctrl *gomock.Controller) *MockNotifyWebhookRepository {
+	mock := &MockNotifyWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockNotifyWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifyWebhookRepository) EXPECT() *MockNotifyWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateDeliveries mocks base method.
+func (m *MockNotifyWebhookRepository) CreateDeliveries(ctx context.Context, events []*entities.WebhookEvent) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeliveries", ctx, events)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// CreateDeliveries indicates an expected call of CreateDeliveries.
+func (mr *MockNotifyWebhookRepositoryMockRecorder) CreateDeliveries(ctx, events any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeliveries", reflect.TypeOf((*MockNotifyWebhookRepository)(nil).CreateDeliveries), ctx, events)
+}
+
+// MockNotifyQuotaAlertRepository is a mock of NotifyQuotaAlertRepository interface.
+type MockNotifyQuotaAlertRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifyQuotaAlertRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotifyQuotaAlertRepositoryMockRecorder is the mock recorder for MockNotifyQuotaAlertRepository.
+type MockNotifyQuotaAlertRepositoryMockRecorder struct {
+	mock *MockNotifyQuotaAlertRepository
+}
+
+// NewMockNotifyQuotaAlertRepository creates a new mock instance.
+func NewMockNotifyQuotaAlertRepository(ctrl *gomock.Controller) *MockNotifyQuotaAlertRepository {
+	mock := &MockNotifyQuotaAlertRepository{ctrl: ctrl}
+	mock.recorder = &MockNotifyQuotaAlertRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifyQuotaAlertRepository) EXPECT() *MockNotifyQuotaAlertRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Trigger mocks base method.
+func (m *MockNotifyQuotaAlertRepository) Trigger(ctx context.Context, environmentID, serviceID, environmentPercent, projectPercent int) ([]*entities.QuotaAlert, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trigger", ctx, environmentID, serviceID, environmentPercent, projectPercent)
+	ret0, _ := ret[0].([]*entities.QuotaAlert)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// Trigger indicates an expected call of Trigger.
+func (mr *MockNotifyQuotaAlertRepositoryMockRecorder) Trigger(ctx, environmentID, serviceID, environmentPercent, projectPercent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trigger", reflect.TypeOf((*MockNotifyQuotaAlertRepository)(nil).Trigger), ctx, environmentID, serviceID, environmentPercent, projectPercent)
+}
@@ -0,0 +1,218 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type RotateAPIKeyRepository interface {
+	Exists(ctx context.Context, key string) (bool, errors.Error)
+	Rotate(ctx context.Context, id int, graceEndsAt time.Time, successor *entities.APIKey, limit int) errors.Error
+}
+
+type RotateDependencies struct {
+	validator validator.Validator
+
+	keyPrefix      string
+	maxGracePeriod time.Duration
+
+	apiKeyRepo RotateAPIKeyRepository
+	auditRepo  auditshared.RecordAuditRepository
+}
+
+// NewRotationDependencies builds the dependencies of RotateAPIKey, which
+// generates keys with keyPrefix and accepts grace periods of up to
+// maxGracePeriod.
+func NewRotationDependencies(
+	validator validator.Validator,
+	keyPrefix string,
+	maxGracePeriod time.Duration,
+	apiKeyRepo RotateAPIKeyRepository,
+	auditRepo auditshared.RecordAuditRepository,
+) *RotateDependencies {
+	return &RotateDependencies{
+		validator:      validator,
+		keyPrefix:      keyPrefix,
+		maxGracePeriod: maxGracePeriod,
+		apiKeyRepo:     apiKeyRepo,
+		auditRepo:      auditRepo,
+	}
+}
+
+// ValidateRotation validates the id of the API key to rotate and the
+// rotation, whose grace period must end in the future and within the
+// maximum grace period.
+func ValidateRotation(
+	deps *RotateDependencies, id int, req *dto.APIKeyRotate,
+) errors.Error {
+	var err errors.Error
+
+	errID := deps.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "id must be greater than 0",
+			"required": "id is required",
+		},
+	)
+	if errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	validationErr := deps.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"expires_at.utc":         "expires_at must be in UTC format",
+			"grace_ends_at.utc":      "grace_ends_at must be in UTC format",
+			"grace_ends_at.required": "grace_ends_at is required",
+		},
+	)
+	if validationErr != nil {
+		err = errors.Aggregate(err, validationErr)
+	}
+
+	if req.GraceEndsAt.IsZero() {
+		return err
+	}
+
+	now := time.Now()
+	if !req.GraceEndsAt.After(now) {
+		err = errors.Aggregate(
+			err,
+			errors.NewAttributeValidationFailed(
+				"APIKeyRotate",
+				"grace_ends_at",
+				"grace_ends_at must be in the future",
+				nil,
+			),
+		)
+	} else if req.GraceEndsAt.After(now.Add(deps.maxGracePeriod)) {
+		err = errors.Aggregate(
+			err,
+			errors.NewAttributeValidationFailed(
+				"APIKeyRotate",
+				"grace_ends_at",
+				fmt.Sprintf(
+					"grace_ends_at must be within %s from now", deps.maxGracePeriod,
+				),
+				nil,
+			),
+		)
+	}
+
+	return err
+}
+
+// CheckRotatable fails unless the API key is enabled, not expired and not
+// rotated already.
+func CheckRotatable(apiKey *entities.APIKey) errors.Error {
+	var message string
+	switch {
+	case !apiKey.IsEnabled():
+		message = "API key is disabled and cannot be rotated"
+	case apiKey.IsExpired():
+		message = "API key is expired and cannot be rotated"
+	case apiKey.IsRotated():
+		message = "API key has already been rotated"
+	default:
+		return nil
+	}
+
+	return errors.NewEntityValidationFailed(
+		"APIKey", message, map[string]any{"id": apiKey.ID}, nil,
+	)
+}
+
+// RotateAPIKey replaces the API key, which CheckRotatable must have passed,
+// by a new key of its environment, and records the rotation in the audit
+// log. The API key keeps working until the grace period of the rotation
+// ends, which is set on it. With a limit above 0, the environment must have
+// fewer than limit usable keys, the API key included, when the new key is
+// created. It returns the new key.
+func RotateAPIKey(
+	ctx context.Context,
+	deps *RotateDependencies,
+	apiKey *entities.APIKey,
+	environment *entities.Environment,
+	req *dto.APIKeyRotate,
+	limit int,
+) (*entities.APIKey, errors.Error) {
+	successor := &entities.APIKey{
+		Status:        enums.APIKeyStatusEnabled,
+		ExpiresAt:     req.ExpiresAt,
+		RateLimit:     apiKey.RateLimit,
+		EnvironmentID: apiKey.EnvironmentID,
+	}
+
+	for {
+		err := successor.GenerateKey(deps.keyPrefix, environment.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		exists, err := deps.apiKeyRepo.Exists(ctx, successor.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			break
+		}
+	}
+
+	err := deps.apiKeyRepo.Rotate(
+		ctx, apiKey.ID, req.GraceEndsAt, successor, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *apiKey
+	apiKey.GraceEndsAt = req.GraceEndsAt
+
+	auditshared.Record(
+		ctx,
+		deps.auditRepo,
+		enums.AuditActionRotate,
+		enums.AuditEntityAPIKey,
+		apiKey.ID,
+		&before,
+		apiKey,
+	)
+
+	return successor, nil
+}
+
+// APIKeyResponse returns the API key with a summary of its key.
+func APIKeyResponse(apiKey *entities.APIKey) *dto.APIKeyResponse {
+	var rateLimit *dto.APIKeyRateLimit
+	if apiKey.RateLimit != nil {
+		rateLimit = &dto.APIKeyRateLimit{
+			Requests: apiKey.RateLimit.Requests,
+			Period:   apiKey.RateLimit.Period,
+			Burst:    apiKey.RateLimit.Burst,
+		}
+	}
+
+	return &dto.APIKeyResponse{
+		ID:            apiKey.ID,
+		Key:           apiKey.KeySummary(),
+		Status:        apiKey.Status,
+		LastUsed:      apiKey.LastUsed,
+		ExpiresAt:     apiKey.ExpiresAt,
+		EnvironmentID: apiKey.EnvironmentID,
+		RotatedFromID: apiKey.RotatedFromID,
+		GraceEndsAt:   apiKey.GraceEndsAt,
+		RateLimit:     rateLimit,
+		CreatedAt:     apiKey.CreatedAt,
+	}
+}
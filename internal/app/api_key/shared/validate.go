@@ -2,7 +2,9 @@ package shared
 
 import (
 	"context"
+	"fmt"
 	"slices"
+	"time"
 
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
@@ -26,24 +28,39 @@ type ValidateProjectRepository interface {
 	GetProjectClientInfoByID(ctx context.Context, id int) (*dto.ProjectClientInfoResponse, errors.Error)
 }
 
+type ValidateRateLimiter interface {
+	Allow(ctx context.Context, key string, limit *entities.APIKeyRateLimit) (time.Duration, errors.Error)
+}
+
+type ValidateLookupRepository interface {
+	Lookup(ctx context.Context, key, serviceName, serviceVersion string) (*dto.APIKeyValidationLookup, errors.Error)
+}
+
 type ValidateDependencies struct {
 	apiKeyRepo      ValidateAPIKeyRepository
 	serviceRepo     ValidateServiceRepository
 	projectRepo     ValidateProjectRepository
 	environmentRepo ValidateEnvironmentRepository
+
+	rateLimiter ValidateRateLimiter
 }
 
+// NewValidationDependencies builds the dependencies of ValidateAPIKey. A
+// nil rateLimiter skips rate limiting, as validation alone does not use
+// the API key.
 func NewValidationDependencies(
 	apiKeyRepo ValidateAPIKeyRepository,
 	serviceRepo ValidateServiceRepository,
 	projectRepo ValidateProjectRepository,
 	environmentRepo ValidateEnvironmentRepository,
+	rateLimiter ValidateRateLimiter,
 ) *ValidateDependencies {
 	return &ValidateDependencies{
 		apiKeyRepo:      apiKeyRepo,
 		serviceRepo:     serviceRepo,
 		projectRepo:     projectRepo,
 		environmentRepo: environmentRepo,
+		rateLimiter:     rateLimiter,
 	}
 }
 
@@ -54,7 +71,42 @@ func ValidateAPIKey(
 	request *entities.Request,
 	validateResponse *dto.APIKeyValidateResponse,
 ) errors.Error {
-	service, err := deps.serviceRepo.GetByNameAndVersion(
+	return validate(ctx, deps, deps.rateLimiter, req, request, validateResponse)
+}
+
+// ValidateAPIKeyWithLookup validates like ValidateAPIKey, reading every row
+// it checks with a single lookup. A nil rateLimiter skips rate limiting.
+func ValidateAPIKeyWithLookup(
+	ctx context.Context,
+	lookupRepo ValidateLookupRepository,
+	rateLimiter ValidateRateLimiter,
+	req *dto.APIKeyValidate,
+	request *entities.Request,
+	validateResponse *dto.APIKeyValidateResponse,
+) errors.Error {
+	source := &lookupSource{repo: lookupRepo, req: req}
+	return validate(ctx, source, rateLimiter, req, request, validateResponse)
+}
+
+func validate(
+	ctx context.Context,
+	source validationSource,
+	rateLimiter ValidateRateLimiter,
+	req *dto.APIKeyValidate,
+	request *entities.Request,
+	validateResponse *dto.APIKeyValidateResponse,
+) errors.Error {
+	// Malformed keys and keys with a bad checksum can never match a stored
+	// key, so they are rejected without any lookups.
+	if !(&entities.APIKey{Key: req.APIKey}).HasValidFormat() {
+		setFailureWithPriority(
+			validateResponse,
+			enums.APIKeyValidationFailureCodeAPIKeyInvalid,
+		)
+		return nil
+	}
+
+	service, err := source.service(
 		ctx, req.ServiceName, req.ServiceVersion,
 	)
 	if err != nil {
@@ -83,7 +135,7 @@ func ValidateAPIKey(
 		}
 	}
 
-	apiKey, err := deps.apiKeyRepo.GetByKey(ctx, req.APIKey)
+	apiKey, err := source.apiKey(ctx, req.APIKey)
 	if err != nil {
 		if err.Code() == errors.CodeNotFound {
 			setFailureWithPriority(
@@ -97,7 +149,9 @@ func ValidateAPIKey(
 
 	request.APIKey.ID = apiKey.ID
 
-	if !apiKey.IsEnabled() {
+	// A rotated key is disabled by the TaskEngine once its grace period
+	// ends; until then it is treated as disabled here.
+	if !apiKey.IsEnabled() || apiKey.IsGracePeriodOver() {
 		setFailureWithPriority(
 			validateResponse,
 			enums.APIKeyValidationFailureCodeAPIKeyDisabled,
@@ -111,7 +165,7 @@ func ValidateAPIKey(
 		)
 	}
 
-	environment, err := deps.environmentRepo.GetByID(ctx, apiKey.EnvironmentID)
+	environment, err := source.environment(ctx, apiKey.EnvironmentID)
 	if err != nil {
 		return err
 	}
@@ -131,7 +185,7 @@ func ValidateAPIKey(
 		)
 	}
 
-	projectClient, err := deps.projectRepo.GetProjectClientInfoByID(
+	projectClient, err := source.projectClient(
 		ctx, environment.ProjectID,
 	)
 	if err != nil {
@@ -151,17 +205,30 @@ func ValidateAPIKey(
 		Name: projectClient.ClientName,
 	}
 
-	if service != nil {
-		index := slices.IndexFunc(
-			environment.Services,
-			func(s *entities.EnvironmentService) bool { return s.ID == service.ID },
+	if service != nil && !source.serviceAssigned(environment, service.ID) {
+		setFailureWithPriority(
+			validateResponse,
+			enums.APIKeyValidationFailureCodeServiceNotAssigned,
+		)
+	}
+
+	// Only requests that would otherwise succeed take from the bucket, so
+	// rejected requests never delay valid ones.
+	if validateResponse.FailureCode == "" &&
+		rateLimiter != nil && apiKey.RateLimit != nil {
+		retryAfter, err := rateLimiter.Allow(
+			ctx, fmt.Sprintf("api_key:%d", apiKey.ID), apiKey.RateLimit,
 		)
+		if err != nil {
+			return err
+		}
 
-		if index == -1 {
+		if retryAfter > 0 {
 			setFailureWithPriority(
 				validateResponse,
-				enums.APIKeyValidationFailureCodeServiceNotAssigned,
+				enums.APIKeyValidationFailureCodeRateLimited,
 			)
+			validateResponse.RetryAfter = retryAfter
 		}
 	}
 
@@ -169,6 +236,146 @@ func ValidateAPIKey(
 	return nil
 }
 
+// validationSource reads the rows a validation checks, reporting the
+// missing ones as not found.
+type validationSource interface {
+	service(ctx context.Context, name, version string) (*entities.Service, errors.Error)
+	apiKey(ctx context.Context, key string) (*entities.APIKey, errors.Error)
+	environment(ctx context.Context, id int) (*entities.Environment, errors.Error)
+	projectClient(ctx context.Context, id int) (*dto.ProjectClientInfoResponse, errors.Error)
+	serviceAssigned(environment *entities.Environment, serviceID int) bool
+}
+
+func (d *ValidateDependencies) service(
+	ctx context.Context, name, version string,
+) (*entities.Service, errors.Error) {
+	return d.serviceRepo.GetByNameAndVersion(ctx, name, version)
+}
+
+func (d *ValidateDependencies) apiKey(
+	ctx context.Context, key string,
+) (*entities.APIKey, errors.Error) {
+	return d.apiKeyRepo.GetByKey(ctx, key)
+}
+
+func (d *ValidateDependencies) environment(
+	ctx context.Context, id int,
+) (*entities.Environment, errors.Error) {
+	return d.environmentRepo.GetByID(ctx, id)
+}
+
+func (d *ValidateDependencies) projectClient(
+	ctx context.Context, id int,
+) (*dto.ProjectClientInfoResponse, errors.Error) {
+	return d.projectRepo.GetProjectClientInfoByID(ctx, id)
+}
+
+func (d *ValidateDependencies) serviceAssigned(
+	environment *entities.Environment, serviceID int,
+) bool {
+	return slices.ContainsFunc(
+		environment.Services,
+		func(s *entities.EnvironmentService) bool { return s.ID == serviceID },
+	)
+}
+
+// lookupSource serves a validation from a single lookup of its request,
+// made by the first read and shared by the rest.
+type lookupSource struct {
+	repo ValidateLookupRepository
+	req  *dto.APIKeyValidate
+
+	lookup *dto.APIKeyValidationLookup
+}
+
+func (s *lookupSource) get(
+	ctx context.Context,
+) (*dto.APIKeyValidationLookup, errors.Error) {
+	if s.lookup == nil {
+		lookup, err := s.repo.Lookup(
+			ctx, s.req.APIKey, s.req.ServiceName, s.req.ServiceVersion,
+		)
+		if err != nil {
+			return nil, err
+		}
+		s.lookup = lookup
+	}
+	return s.lookup, nil
+}
+
+func (s *lookupSource) service(
+	ctx context.Context, name, version string,
+) (*entities.Service, errors.Error) {
+	lookup, err := s.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if lookup.Service == nil {
+		return nil, errors.NewNotFound("Service not found", nil)
+	}
+	return lookup.Service, nil
+}
+
+func (s *lookupSource) apiKey(
+	ctx context.Context, key string,
+) (*entities.APIKey, errors.Error) {
+	lookup, err := s.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if lookup.APIKey == nil {
+		return nil, errors.NewNotFound("API key not found", nil)
+	}
+	return lookup.APIKey, nil
+}
+
+func (s *lookupSource) environment(
+	ctx context.Context, id int,
+) (*entities.Environment, errors.Error) {
+	lookup, err := s.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lookup.Environment, nil
+}
+
+func (s *lookupSource) projectClient(
+	ctx context.Context, id int,
+) (*dto.ProjectClientInfoResponse, errors.Error) {
+	lookup, err := s.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lookup.ProjectClient, nil
+}
+
+func (s *lookupSource) serviceAssigned(
+	environment *entities.Environment, serviceID int,
+) bool {
+	return s.lookup.ServiceAssigned
+}
+
+// RequestedUnits returns the units a request asks to consume, one unless
+// the caller weighs it otherwise.
+func RequestedUnits(req *dto.APIKeyValidate) int {
+	if req.Units > 0 {
+		return req.Units
+	}
+	return 1
+}
+
+// SetQuotaExceeded fails a validation that passed every check but found the
+// quota of the given level exhausted when consuming it.
+func SetQuotaExceeded(
+	validateResponse *dto.APIKeyValidateResponse, level enums.QuotaLevel,
+) {
+	validateResponse.Valid = false
+	validateResponse.QuotaLevel = level
+	validateResponse.FailureCode = enums.APIKeyValidationFailureCodeQuotaExceeded
+}
+
 func setFailureWithPriority(
 	validateResponse *dto.APIKeyValidateResponse,
 	failureCode enums.APIKeyValidationFailureCode,
@@ -24,6 +24,7 @@ type UseCaseSuite struct {
 	projectRepo     *mock.MockValidateProjectRepository
 	serviceRepo     *mock.MockValidateServiceRepository
 	environmentRepo *mock.MockValidateEnvironmentRepository
+	rateLimiter     *mock.MockValidateRateLimiter
 
 	deps *ValidateDependencies
 
@@ -38,12 +39,14 @@ func (s *UseCaseSuite) SetupTest() {
 	s.projectRepo = mock.NewMockValidateProjectRepository(s.ctrl)
 	s.serviceRepo = mock.NewMockValidateServiceRepository(s.ctrl)
 	s.environmentRepo = mock.NewMockValidateEnvironmentRepository(s.ctrl)
+	s.rateLimiter = mock.NewMockValidateRateLimiter(s.ctrl)
 
 	s.deps = NewValidationDependencies(
 		s.apiKeyRepo,
 		s.serviceRepo,
 		s.projectRepo,
 		s.environmentRepo,
+		s.rateLimiter,
 	)
 
 	s.ctx = context.Background()
@@ -56,7 +59,7 @@ func (s *UseCaseSuite) TearDownTest() {
 func (s *UseCaseSuite) TestSuccess() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -161,7 +164,7 @@ func (s *UseCaseSuite) TestSuccess() {
 func (s *UseCaseSuite) TestAPIKeyNotFound() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "invalid-api-key",
+		APIKey:         "pdr_test_invalid00000000000000000000000000000000Test_f428e6bb",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -235,10 +238,59 @@ func (s *UseCaseSuite) TestAPIKeyNotFound() {
 	s.Empty(request.Project.Name)
 }
 
+func (s *UseCaseSuite) TestAPIKeyMalformed() {
+	keys := []string{
+		"invalid-api-key",
+		"pdr_test_valid0000000000000000000000000000000000Test_a9715934",
+		"pdr_prod_valid0000000000000000000000000000000000Test_a9715933",
+		"pdr_test_valid000000000000000000000000000000000Test_a9715933",
+	}
+
+	for _, key := range keys {
+		req := &dto.APIKeyValidate{
+			APIKey:         key,
+			ServiceName:    "TestService",
+			ServiceVersion: "1.0.0",
+			Request: &dto.RequestIncoming{
+				Path:        "/test",
+				Method:      "GET",
+				IPAddress:   "127.0.0.1",
+				RequestTime: time.Now(),
+			},
+		}
+
+		s.serviceRepo.EXPECT().
+			GetByNameAndVersion(s.ctx, gomock.Any(), gomock.Any()).
+			Times(0)
+
+		s.apiKeyRepo.EXPECT().
+			GetByKey(s.ctx, gomock.Any()).
+			Times(0)
+
+		validateResponse := dto.APIKeyValidateResponse{}
+
+		request := entities.Request{
+			APIKey:      &entities.RequestAPIKey{Key: req.APIKey},
+			Service:     &entities.RequestService{},
+			Environment: &entities.RequestEnvironment{},
+			Project:     &entities.RequestProject{},
+		}
+
+		err := ValidateAPIKey(s.ctx, s.deps, req, &request, &validateResponse)
+
+		s.Require().NoError(err)
+
+		s.False(validateResponse.Valid, key)
+		s.Equal(enums.APIKeyValidationFailureCodeAPIKeyInvalid, validateResponse.FailureCode, key)
+		s.Zero(request.Service.ID)
+		s.Zero(request.APIKey.ID)
+	}
+}
+
 func (s *UseCaseSuite) TestServiceMismatch() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "NonExistentService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -335,7 +387,7 @@ func (s *UseCaseSuite) TestServiceMismatch() {
 func (s *UseCaseSuite) TestServiceRepoInternalError() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -401,7 +453,7 @@ func (s *UseCaseSuite) TestServiceRepoInternalError() {
 func (s *UseCaseSuite) TestAPIKeyRepoInternalError() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -474,7 +526,7 @@ func (s *UseCaseSuite) TestAPIKeyRepoInternalError() {
 func (s *UseCaseSuite) TestEnvironmentRepoGetByIDInternalError() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -554,7 +606,7 @@ func (s *UseCaseSuite) TestEnvironmentRepoGetByIDInternalError() {
 func (s *UseCaseSuite) TestProjectRepoInternalError() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -652,7 +704,7 @@ func (s *UseCaseSuite) TestProjectRepoInternalError() {
 func (s *UseCaseSuite) TestEnvironmentDisabled() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -757,7 +809,7 @@ func (s *UseCaseSuite) TestEnvironmentDisabled() {
 func (s *UseCaseSuite) TestServiceNotAssignedToEnvironment() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -862,7 +914,7 @@ func (s *UseCaseSuite) TestServiceNotAssignedToEnvironment() {
 func (s *UseCaseSuite) TestServiceDisabled() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "DisabledService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -967,7 +1019,7 @@ func (s *UseCaseSuite) TestServiceDisabled() {
 func (s *UseCaseSuite) TestServiceDeprecated() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "DeprecatedService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -1073,7 +1125,7 @@ func (s *UseCaseSuite) TestAPIKeyExpired() {
 	reqTime := time.Now()
 	pastTime := time.Now().Add(-24 * time.Hour)
 	req := &dto.APIKeyValidate{
-		APIKey:         "expired-api-key",
+		APIKey:         "pdr_test_expired00000000000000000000000000000000Test_d831d188",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -1179,7 +1231,7 @@ func (s *UseCaseSuite) TestAPIKeyExpired() {
 func (s *UseCaseSuite) TestAPIKeyDisabled() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "disabled-api-key",
+		APIKey:         "pdr_test_disabled0000000000000000000000000000000Test_d74efcea",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -1281,10 +1333,340 @@ func (s *UseCaseSuite) TestAPIKeyDisabled() {
 	s.Equal(projectClient.ProjectName, request.Project.Name)
 }
 
+func (s *UseCaseSuite) TestAPIKeyRotationGraceOver() {
+	reqTime := time.Now()
+	req := &dto.APIKeyValidate{
+		APIKey:         "pdr_test_disabled0000000000000000000000000000000Test_d74efcea",
+		ServiceName:    "TestService",
+		ServiceVersion: "1.0.0",
+		Request: &dto.RequestIncoming{
+			Path:        "/test",
+			Method:      "GET",
+			IPAddress:   "127.0.0.1",
+			RequestTime: reqTime,
+		},
+	}
+
+	service := &entities.Service{
+		ID:      1,
+		Name:    req.ServiceName,
+		Version: req.ServiceVersion,
+		Status:  enums.ServiceStatusEnabled,
+	}
+	apiKey := &entities.APIKey{
+		ID:            10,
+		Key:           req.APIKey,
+		Status:        enums.APIKeyStatusEnabled,
+		EnvironmentID: 100,
+		GraceEndsAt:   reqTime.Add(-time.Minute),
+	}
+	environment := &entities.Environment{
+		ID:        100,
+		Name:      "production",
+		Status:    enums.EnvironmentStatusEnabled,
+		ProjectID: 1000,
+		Services: []*entities.EnvironmentService{
+			{
+				ID:               service.ID,
+				Name:             service.Name,
+				Version:          service.Version,
+				MaxRequests:      -1,
+				AvailableRequest: -1,
+				AssignedAt:       reqTime,
+			},
+		},
+	}
+	projectClient := &dto.ProjectClientInfoResponse{
+		ProjectID:   1000,
+		ProjectName: "TestProject",
+		ClientID:    2000,
+		ClientName:  "TestClient",
+	}
+
+	s.serviceRepo.EXPECT().
+		GetByNameAndVersion(s.ctx, req.ServiceName, req.ServiceVersion).
+		Return(service, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		GetByKey(s.ctx, req.APIKey).
+		Return(apiKey, nil).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		GetByID(s.ctx, apiKey.EnvironmentID).
+		Return(environment, nil).
+		Times(1)
+
+	s.projectRepo.EXPECT().
+		GetProjectClientInfoByID(s.ctx, environment.ProjectID).
+		Return(projectClient, nil).
+		Times(1)
+
+	validateResponse := dto.APIKeyValidateResponse{}
+
+	request := entities.Request{
+		Path:        req.Request.Path,
+		Method:      req.Request.Method,
+		IPAddress:   req.Request.IPAddress,
+		RequestTime: req.Request.RequestTime,
+		APIKey:      &entities.RequestAPIKey{Key: req.APIKey},
+		Service: &entities.RequestService{
+			Name:    req.ServiceName,
+			Version: req.ServiceVersion,
+		},
+		Environment: &entities.RequestEnvironment{},
+		Project:     &entities.RequestProject{},
+	}
+
+	err := ValidateAPIKey(s.ctx, s.deps, req, &request, &validateResponse)
+
+	s.Require().NoError(err)
+
+	s.False(validateResponse.Valid)
+	s.Equal(enums.APIKeyValidationFailureCodeAPIKeyDisabled, validateResponse.FailureCode)
+	s.Equal(projectClient.ProjectID, validateResponse.Project.ID)
+	s.Equal(projectClient.ProjectName, validateResponse.Project.Name)
+	s.Equal(projectClient.ClientID, validateResponse.Client.ID)
+	s.Equal(projectClient.ClientName, validateResponse.Client.Name)
+	s.Equal(environment.ID, validateResponse.Environment.ID)
+	s.Equal(environment.Name, validateResponse.Environment.Name)
+
+	s.Equal(service.ID, request.Service.ID)
+	s.Equal(apiKey.ID, request.APIKey.ID)
+	s.Equal(environment.ID, request.Environment.ID)
+	s.Equal(projectClient.ProjectID, request.Project.ID)
+	s.Equal(projectClient.ProjectName, request.Project.Name)
+}
+
+func (s *UseCaseSuite) TestRateLimitAllowed() {
+	reqTime := time.Now()
+	req := &dto.APIKeyValidate{
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
+		ServiceName:    "TestService",
+		ServiceVersion: "1.0.0",
+		Request: &dto.RequestIncoming{
+			Path:        "/test",
+			Method:      "GET",
+			IPAddress:   "127.0.0.1",
+			RequestTime: reqTime,
+		},
+	}
+
+	service := &entities.Service{
+		ID:      1,
+		Name:    req.ServiceName,
+		Version: req.ServiceVersion,
+		Status:  enums.ServiceStatusEnabled,
+	}
+	apiKey := &entities.APIKey{
+		ID:            10,
+		Key:           req.APIKey,
+		Status:        enums.APIKeyStatusEnabled,
+		EnvironmentID: 100,
+		RateLimit:     entities.NewAPIKeyRateLimit(10, enums.APIKeyRateLimitPeriodSecond, 0),
+	}
+	environment := &entities.Environment{
+		ID:        100,
+		Name:      "production",
+		Status:    enums.EnvironmentStatusEnabled,
+		ProjectID: 1000,
+		Services: []*entities.EnvironmentService{
+			{
+				ID:               service.ID,
+				Name:             service.Name,
+				Version:          service.Version,
+				MaxRequests:      -1,
+				AvailableRequest: -1,
+				AssignedAt:       reqTime,
+			},
+		},
+	}
+	projectClient := &dto.ProjectClientInfoResponse{
+		ProjectID:   1000,
+		ProjectName: "TestProject",
+		ClientID:    2000,
+		ClientName:  "TestClient",
+	}
+
+	s.serviceRepo.EXPECT().
+		GetByNameAndVersion(s.ctx, req.ServiceName, req.ServiceVersion).
+		Return(service, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		GetByKey(s.ctx, req.APIKey).
+		Return(apiKey, nil).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		GetByID(s.ctx, apiKey.EnvironmentID).
+		Return(environment, nil).
+		Times(1)
+
+	s.projectRepo.EXPECT().
+		GetProjectClientInfoByID(s.ctx, environment.ProjectID).
+		Return(projectClient, nil).
+		Times(1)
+
+	s.rateLimiter.EXPECT().
+		Allow(s.ctx, "api_key:10", apiKey.RateLimit).
+		Return(time.Duration(0), nil).
+		Times(1)
+
+	validateResponse := dto.APIKeyValidateResponse{}
+
+	request := entities.Request{
+		Path:        req.Request.Path,
+		Method:      req.Request.Method,
+		IPAddress:   req.Request.IPAddress,
+		RequestTime: req.Request.RequestTime,
+		APIKey:      &entities.RequestAPIKey{Key: req.APIKey},
+		Service: &entities.RequestService{
+			Name:    req.ServiceName,
+			Version: req.ServiceVersion,
+		},
+		Environment: &entities.RequestEnvironment{},
+		Project:     &entities.RequestProject{},
+	}
+
+	err := ValidateAPIKey(s.ctx, s.deps, req, &request, &validateResponse)
+
+	s.Require().NoError(err)
+
+	s.True(validateResponse.Valid)
+	s.Empty(validateResponse.FailureCode)
+	s.Zero(validateResponse.RetryAfter)
+	s.Equal(projectClient.ProjectID, validateResponse.Project.ID)
+	s.Equal(projectClient.ProjectName, validateResponse.Project.Name)
+	s.Equal(projectClient.ClientID, validateResponse.Client.ID)
+	s.Equal(projectClient.ClientName, validateResponse.Client.Name)
+	s.Equal(environment.ID, validateResponse.Environment.ID)
+	s.Equal(environment.Name, validateResponse.Environment.Name)
+
+	s.Equal(service.ID, request.Service.ID)
+	s.Equal(apiKey.ID, request.APIKey.ID)
+	s.Equal(environment.ID, request.Environment.ID)
+	s.Equal(projectClient.ProjectID, request.Project.ID)
+	s.Equal(projectClient.ProjectName, request.Project.Name)
+}
+
+func (s *UseCaseSuite) TestRateLimited() {
+	reqTime := time.Now()
+	req := &dto.APIKeyValidate{
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
+		ServiceName:    "TestService",
+		ServiceVersion: "1.0.0",
+		Request: &dto.RequestIncoming{
+			Path:        "/test",
+			Method:      "GET",
+			IPAddress:   "127.0.0.1",
+			RequestTime: reqTime,
+		},
+	}
+
+	service := &entities.Service{
+		ID:      1,
+		Name:    req.ServiceName,
+		Version: req.ServiceVersion,
+		Status:  enums.ServiceStatusEnabled,
+	}
+	apiKey := &entities.APIKey{
+		ID:            10,
+		Key:           req.APIKey,
+		Status:        enums.APIKeyStatusEnabled,
+		EnvironmentID: 100,
+		RateLimit:     entities.NewAPIKeyRateLimit(10, enums.APIKeyRateLimitPeriodSecond, 0),
+	}
+	environment := &entities.Environment{
+		ID:        100,
+		Name:      "production",
+		Status:    enums.EnvironmentStatusEnabled,
+		ProjectID: 1000,
+		Services: []*entities.EnvironmentService{
+			{
+				ID:               service.ID,
+				Name:             service.Name,
+				Version:          service.Version,
+				MaxRequests:      -1,
+				AvailableRequest: -1,
+				AssignedAt:       reqTime,
+			},
+		},
+	}
+	projectClient := &dto.ProjectClientInfoResponse{
+		ProjectID:   1000,
+		ProjectName: "TestProject",
+		ClientID:    2000,
+		ClientName:  "TestClient",
+	}
+
+	s.serviceRepo.EXPECT().
+		GetByNameAndVersion(s.ctx, req.ServiceName, req.ServiceVersion).
+		Return(service, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		GetByKey(s.ctx, req.APIKey).
+		Return(apiKey, nil).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		GetByID(s.ctx, apiKey.EnvironmentID).
+		Return(environment, nil).
+		Times(1)
+
+	s.projectRepo.EXPECT().
+		GetProjectClientInfoByID(s.ctx, environment.ProjectID).
+		Return(projectClient, nil).
+		Times(1)
+
+	s.rateLimiter.EXPECT().
+		Allow(s.ctx, "api_key:10", apiKey.RateLimit).
+		Return(250*time.Millisecond, nil).
+		Times(1)
+
+	validateResponse := dto.APIKeyValidateResponse{}
+
+	request := entities.Request{
+		Path:        req.Request.Path,
+		Method:      req.Request.Method,
+		IPAddress:   req.Request.IPAddress,
+		RequestTime: req.Request.RequestTime,
+		APIKey:      &entities.RequestAPIKey{Key: req.APIKey},
+		Service: &entities.RequestService{
+			Name:    req.ServiceName,
+			Version: req.ServiceVersion,
+		},
+		Environment: &entities.RequestEnvironment{},
+		Project:     &entities.RequestProject{},
+	}
+
+	err := ValidateAPIKey(s.ctx, s.deps, req, &request, &validateResponse)
+
+	s.Require().NoError(err)
+
+	s.False(validateResponse.Valid)
+	s.Equal(enums.APIKeyValidationFailureCodeRateLimited, validateResponse.FailureCode)
+	s.Equal(250*time.Millisecond, validateResponse.RetryAfter)
+	s.Equal(projectClient.ProjectID, validateResponse.Project.ID)
+	s.Equal(projectClient.ProjectName, validateResponse.Project.Name)
+	s.Equal(projectClient.ClientID, validateResponse.Client.ID)
+	s.Equal(projectClient.ClientName, validateResponse.Client.Name)
+	s.Equal(environment.ID, validateResponse.Environment.ID)
+	s.Equal(environment.Name, validateResponse.Environment.Name)
+
+	s.Equal(service.ID, request.Service.ID)
+	s.Equal(apiKey.ID, request.APIKey.ID)
+	s.Equal(environment.ID, request.Environment.ID)
+	s.Equal(projectClient.ProjectID, request.Project.ID)
+	s.Equal(projectClient.ProjectName, request.Project.Name)
+}
+
 func (s *UseCaseSuite) TestFailureCodePriority() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "invalid-api-key",
+		APIKey:         "pdr_test_invalid00000000000000000000000000000000Test_f428e6bb",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
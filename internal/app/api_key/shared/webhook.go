@@ -0,0 +1,124 @@
+package shared
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// QuotaWarningPercent is the share of a quota whose consumption triggers a
+// quota.threshold_reached event.
+const QuotaWarningPercent = 80
+
+type NotifyWebhookRepository interface {
+	CreateDeliveries(ctx context.Context, events []*entities.WebhookEvent) (int, errors.Error)
+}
+
+type NotifyQuotaAlertRepository interface {
+	Trigger(ctx context.Context, environmentID, serviceID, environmentPercent, projectPercent int) ([]*entities.QuotaAlert, errors.Error)
+}
+
+// NotifyQuota queues the webhook events of the quota pools that consuming
+// the request units took to QuotaWarningPercent or exhausted, and of the
+// quota alerts whose threshold they reached. The request has already
+// consumed its units, so failing to queue them is only logged.
+func NotifyQuota(
+	ctx context.Context,
+	alertRepo NotifyQuotaAlertRepository,
+	webhookRepo NotifyWebhookRepository,
+	request *entities.Request,
+	decrement *dto.DecrementAvailableRequest,
+) {
+	environmentPercent := decrement.Environment.CrossedPercent(request.Units)
+	projectPercent := decrement.Project.CrossedPercent(request.Units)
+	if environmentPercent == 0 && projectPercent == 0 {
+		return
+	}
+
+	balances := map[enums.QuotaLevel]*dto.QuotaBalance{
+		enums.QuotaLevelEnvironment: &decrement.Environment,
+		enums.QuotaLevelProject:     &decrement.Project,
+	}
+
+	newEvent := func(
+		eventType enums.WebhookEventType, quota *entities.WebhookEventQuota,
+	) *entities.WebhookEvent {
+		balance := balances[quota.Level]
+		quota.MaxRequests = balance.MaxRequests
+		quota.AvailableRequest = balance.AvailableRequest
+
+		return &entities.WebhookEvent{
+			Type:          eventType,
+			ProjectID:     request.Project.ID,
+			EnvironmentID: request.Environment.ID,
+			ServiceID:     request.Service.ID,
+			Quota:         quota,
+			OccurredAt:    time.Now(),
+		}
+	}
+
+	var events []*entities.WebhookEvent
+	for _, level := range []enums.QuotaLevel{
+		enums.QuotaLevelEnvironment, enums.QuotaLevelProject,
+	} {
+		for _, threshold := range []struct {
+			eventType enums.WebhookEventType
+			percent   int
+		}{
+			{enums.WebhookEventTypeQuotaThresholdReached, QuotaWarningPercent},
+			{enums.WebhookEventTypeQuotaExhausted, 100},
+		} {
+			if !balances[level].Crossed(request.Units, threshold.percent) {
+				continue
+			}
+
+			events = append(events, newEvent(
+				threshold.eventType,
+				&entities.WebhookEventQuota{
+					Level: level, Threshold: threshold.percent,
+				},
+			))
+		}
+	}
+
+	alerts, err := alertRepo.Trigger(
+		ctx,
+		request.Environment.ID,
+		request.Service.ID,
+		environmentPercent,
+		projectPercent,
+	)
+	if err != nil {
+		log.Printf(
+			"[WARN] Failed to trigger quota alerts for environment %d and service %d: %v",
+			request.Environment.ID, request.Service.ID, err,
+		)
+	}
+
+	for _, alert := range alerts {
+		events = append(events, newEvent(
+			enums.WebhookEventTypeQuotaAlertTriggered,
+			&entities.WebhookEventQuota{
+				Level:     alert.Level,
+				AlertID:   alert.ID,
+				Threshold: alert.Threshold,
+			},
+		))
+	}
+
+	if len(events) == 0 {
+		return
+	}
+
+	if _, err := webhookRepo.CreateDeliveries(ctx, events); err != nil {
+		log.Printf(
+			"[WARN] Failed to queue quota webhooks for environment %d and service %d: %v",
+			request.Environment.ID, request.Service.ID, err,
+		)
+	}
+}
@@ -3,11 +3,17 @@ package update
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
 type APIKeyRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.APIKey, errors.Error)
 	Update(ctx context.Context, id int, update *dto.APIKeyUpdate) (*entities.APIKey, errors.Error)
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
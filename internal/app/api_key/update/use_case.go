@@ -4,7 +4,10 @@ import (
 	"context"
 	"time"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -17,15 +20,32 @@ type useCase struct {
 	validator validator.Validator
 
 	apiKeyRepo APIKeyRepository
+	auditRepo  AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, id int, req *dto.APIKeyUpdate,
 ) (*dto.APIKeyResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "api_key.update")
+	defer span.End()
+
 	if err := uc.validateInput(id, req); err != nil {
 		return nil, err
 	}
 
+	before, err := uc.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		if err.Code() == errors.CodeNotFound {
+			return nil, errors.NewEntityNotFound(
+				"APIKey",
+				"api key not found",
+				map[string]any{"id": id},
+				err,
+			)
+		}
+		return nil, err
+	}
+
 	apiKey, err := uc.apiKeyRepo.Update(ctx, id, req)
 	if err != nil {
 		if err.Code() == errors.CodeNotFound {
@@ -39,6 +59,25 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionUpdate,
+		enums.AuditEntityAPIKey,
+		apiKey.ID,
+		before,
+		apiKey,
+	)
+
+	var rateLimit *dto.APIKeyRateLimit
+	if apiKey.RateLimit != nil {
+		rateLimit = &dto.APIKeyRateLimit{
+			Requests: apiKey.RateLimit.Requests,
+			Period:   apiKey.RateLimit.Period,
+			Burst:    apiKey.RateLimit.Burst,
+		}
+	}
+
 	return &dto.APIKeyResponse{
 		ID:            apiKey.ID,
 		Key:           apiKey.KeySummary(),
@@ -46,6 +85,9 @@ func (uc *useCase) Execute(
 		LastUsed:      apiKey.LastUsed,
 		ExpiresAt:     apiKey.ExpiresAt,
 		EnvironmentID: apiKey.EnvironmentID,
+		RotatedFromID: apiKey.RotatedFromID,
+		GraceEndsAt:   apiKey.GraceEndsAt,
+		RateLimit:     rateLimit,
 		CreatedAt:     apiKey.CreatedAt,
 	}, nil
 }
@@ -82,7 +124,10 @@ func (uc *useCase) validateReq(req *dto.APIKeyUpdate) errors.Error {
 	validationErr := uc.validator.ValidateStruct(
 		req,
 		map[string]string{
-			"expires_at.utc": "expires_at must be in UTC format",
+			"expires_at.utc":          "expires_at must be in UTC format",
+			"rate_limit.burst.gte":    "rate_limit.burst must be greater than or equal to 0",
+			"rate_limit.period.enums": "rate_limit.period must be one of the following: second, minute",
+			"rate_limit.requests.gte": "rate_limit.requests must be greater than or equal to 0",
 		},
 	)
 
@@ -106,10 +151,13 @@ func (uc *useCase) validateReq(req *dto.APIKeyUpdate) errors.Error {
 }
 
 func NewUseCase(
-	validator validator.Validator, apiKeyRepo APIKeyRepository,
+	validator validator.Validator,
+	apiKeyRepo APIKeyRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		apiKeyRepo: apiKeyRepo,
 		validator:  validator,
+		auditRepo:  auditRepo,
 	}
 }
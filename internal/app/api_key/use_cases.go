@@ -1,11 +1,16 @@
 package apikey
 
 import (
+	"time"
+
 	"github.com/MAD-py/pandora-core/internal/app/api_key/create"
 	"github.com/MAD-py/pandora-core/internal/app/api_key/delete"
 	"github.com/MAD-py/pandora-core/internal/app/api_key/disable"
+	disablerotated "github.com/MAD-py/pandora-core/internal/app/api_key/disable_rotated"
 	"github.com/MAD-py/pandora-core/internal/app/api_key/enable"
+	protectlegacykeys "github.com/MAD-py/pandora-core/internal/app/api_key/protect_legacy_keys"
 	revealkey "github.com/MAD-py/pandora-core/internal/app/api_key/reveal_key"
+	"github.com/MAD-py/pandora-core/internal/app/api_key/rotate"
 	"github.com/MAD-py/pandora-core/internal/app/api_key/update"
 	validateconsume "github.com/MAD-py/pandora-core/internal/app/api_key/validate_consume"
 	validateonly "github.com/MAD-py/pandora-core/internal/app/api_key/validate_only"
@@ -17,9 +22,19 @@ import (
 type CreateUseCase = create.UseCase
 
 func NewCreateUseCase(
-	validator validator.Validator, repo APIKeyCreateRepository,
+	validator validator.Validator,
+	keyPrefix string,
+	apiKeyRepo APIKeyCreateRepository,
+	environmentRepo EnvironmentCreateRepository,
+	auditRepo AuditCreateRepository,
 ) CreateUseCase {
-	return create.NewUseCase(validator, repo)
+	return create.NewUseCase(
+		validator,
+		keyPrefix,
+		apiKeyRepo,
+		environmentRepo,
+		auditRepo,
+	)
 }
 
 // ... Delete Use Case ...
@@ -27,9 +42,11 @@ func NewCreateUseCase(
 type DeleteUseCase = delete.UseCase
 
 func NewDeleteUseCase(
-	validator validator.Validator, repo APIKeyDeleteRepository,
+	validator validator.Validator,
+	repo APIKeyDeleteRepository,
+	auditRepo AuditDeleteRepository,
 ) DeleteUseCase {
-	return delete.NewUseCase(validator, repo)
+	return delete.NewUseCase(validator, repo, auditRepo)
 }
 
 // ... Update Use Case ...
@@ -37,9 +54,11 @@ func NewDeleteUseCase(
 type UpdateUseCase = update.UseCase
 
 func NewUpdateUseCase(
-	validator validator.Validator, repo APIKeyUpdateRepository,
+	validator validator.Validator,
+	repo APIKeyUpdateRepository,
+	auditRepo AuditUpdateRepository,
 ) UpdateUseCase {
-	return update.NewUseCase(validator, repo)
+	return update.NewUseCase(validator, repo, auditRepo)
 }
 
 // ... Validate Use Case ...
@@ -70,19 +89,19 @@ type ValidateConsumeUseCase = validateconsume.UseCase
 
 func NewValidateConsumeUseCase(
 	validator validator.Validator,
-	apiKeyRepo APIKeyValidateConsumeRepository,
-	projectRepo ProjectValidateConsumeRepository,
+	validationRepo ValidationValidateConsumeRepository,
 	requestRepo RequestValidateConsumeRepository,
-	serviceRepo ServiceValidateConsumeRepository,
-	environmentRepo EnvironmentValidateConsumeRepository,
+	quotaAlertRepo QuotaAlertValidateConsumeRepository,
+	webhookRepo WebhookValidateConsumeRepository,
+	rateLimiter RateLimiterValidateConsume,
 ) ValidateConsumeUseCase {
 	return validateconsume.NewUseCase(
 		validator,
-		apiKeyRepo,
-		projectRepo,
-		serviceRepo,
+		validationRepo,
 		requestRepo,
-		environmentRepo,
+		quotaAlertRepo,
+		webhookRepo,
+		rateLimiter,
 	)
 }
 
@@ -91,9 +110,12 @@ func NewValidateConsumeUseCase(
 type DisableUseCase = disable.UseCase
 
 func NewDisableUseCase(
-	validator validator.Validator, repo APIKeyDisableRepository,
+	validator validator.Validator,
+	repo APIKeyDisableRepository,
+	webhookRepo WebhookDisableRepository,
+	auditRepo AuditDisableRepository,
 ) DisableUseCase {
-	return disable.NewUseCase(validator, repo)
+	return disable.NewUseCase(validator, repo, webhookRepo, auditRepo)
 }
 
 // ... Enable Use Case ...
@@ -104,8 +126,9 @@ func NewEnableUseCase(
 	validator validator.Validator,
 	apiKeyRepo APIKeyEnableRepository,
 	environmentRepo EnvironmentEnableRepository,
+	auditRepo AuditEnableRepository,
 ) EnableUseCase {
-	return enable.NewUseCase(validator, apiKeyRepo, environmentRepo)
+	return enable.NewUseCase(validator, apiKeyRepo, environmentRepo, auditRepo)
 }
 
 // ... Reveal Key Use Case ...
@@ -113,7 +136,52 @@ func NewEnableUseCase(
 type RevealKeyUseCase = revealkey.UseCase
 
 func NewRevealKeyUseCase(
-	validator validator.Validator, repo APIKeyRevealKeyRepository,
+	validator validator.Validator,
+	repo APIKeyRevealKeyRepository,
+	auditRepo AuditRevealKeyRepository,
 ) RevealKeyUseCase {
-	return revealkey.NewUseCase(validator, repo)
+	return revealkey.NewUseCase(validator, repo, auditRepo)
+}
+
+// ... Protect Legacy Keys Use Case ...
+
+type ProtectLegacyKeysUseCase = protectlegacykeys.UseCase
+
+func NewProtectLegacyKeysUseCase(
+	repo APIKeyProtectLegacyKeysRepository,
+) ProtectLegacyKeysUseCase {
+	return protectlegacykeys.NewUseCase(repo)
+}
+
+// ... Rotate Use Case ...
+
+type RotateUseCase = rotate.UseCase
+
+func NewRotateUseCase(
+	validator validator.Validator,
+	keyPrefix string,
+	maxGracePeriod time.Duration,
+	apiKeyRepo APIKeyRotateRepository,
+	environmentRepo EnvironmentRotateRepository,
+	auditRepo AuditRotateRepository,
+) RotateUseCase {
+	return rotate.NewUseCase(
+		validator,
+		keyPrefix,
+		maxGracePeriod,
+		apiKeyRepo,
+		environmentRepo,
+		auditRepo,
+	)
+}
+
+// ... Disable Rotated Use Case ...
+
+type DisableRotatedUseCase = disablerotated.UseCase
+
+func NewDisableRotatedUseCase(
+	repo APIKeyDisableRotatedRepository,
+	webhookRepo WebhookDisableRotatedRepository,
+) DisableRotatedUseCase {
+	return disablerotated.NewUseCase(repo, webhookRepo)
 }
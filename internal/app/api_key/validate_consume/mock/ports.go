@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
 	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
@@ -19,225 +20,211 @@ import (
 	gomock "go.uber.org/mock/gomock"
 )
 
-// MockAPIKeyRepository is a mock of APIKeyRepository interface.
-type MockAPIKeyRepository struct {
+// MockValidationRepository is a mock of ValidationRepository interface.
+type MockValidationRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockAPIKeyRepositoryMockRecorder
+	recorder *MockValidationRepositoryMockRecorder
 	isgomock struct{}
 }
 
-// MockAPIKeyRepositoryMockRecorder is the mock recorder for MockAPIKeyRepository.
-type MockAPIKeyRepositoryMockRecorder struct {
-	mock *MockAPIKeyRepository
+// MockValidationRepositoryMockRecorder is the mock recorder for MockValidationRepository.
+type MockValidationRepositoryMockRecorder struct {
+	mock *MockValidationRepository
 }
 
-// NewMockAPIKeyRepository creates a new mock instance.
-func NewMockAPIKeyRepository(ctrl *gomock.Controller) *MockAPIKeyRepository {
-	mock := &MockAPIKeyRepository{ctrl: ctrl}
-	mock.recorder = &MockAPIKeyRepositoryMockRecorder{mock}
+// NewMockValidationRepository creates a new mock instance.
+func NewMockValidationRepository(ctrl *gomock.Controller) *MockValidationRepository {
+	mock := &MockValidationRepository{ctrl: ctrl}
+	mock.recorder = &MockValidationRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepositoryMockRecorder {
+func (m *MockValidationRepository) EXPECT() *MockValidationRepositoryMockRecorder {
 	return m.recorder
 }
 
-// GetByKey mocks base method.
-func (m *MockAPIKeyRepository) GetByKey(ctx context.Context, key string) (*entities.APIKey, errors.Error) {
+// Consume mocks base method.
+func (m *MockValidationRepository) Consume(ctx context.Context, request *entities.Request) (*dto.DecrementAvailableRequest, errors.Error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByKey", ctx, key)
-	ret0, _ := ret[0].(*entities.APIKey)
+	ret := m.ctrl.Call(m, "Consume", ctx, request)
+	ret0, _ := ret[0].(*dto.DecrementAvailableRequest)
 	ret1, _ := ret[1].(errors.Error)
 	return ret0, ret1
 }
 
-// GetByKey indicates an expected call of GetByKey.
-func (mr *MockAPIKeyRepositoryMockRecorder) GetByKey(ctx, key any) *gomock.Call {
+// Consume indicates an expected call of Consume.
+func (mr *MockValidationRepositoryMockRecorder) Consume(ctx, request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).GetByKey), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockValidationRepository)(nil).Consume), ctx, request)
 }
 
-// UpdateLastUsed mocks base method.
-func (m *MockAPIKeyRepository) UpdateLastUsed(ctx context.Context, key string) errors.Error {
+// Lookup mocks base method.
+func (m *MockValidationRepository) Lookup(ctx context.Context, key, serviceName, serviceVersion string) (*dto.APIKeyValidationLookup, errors.Error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateLastUsed", ctx, key)
-	ret0, _ := ret[0].(errors.Error)
-	return ret0
+	ret := m.ctrl.Call(m, "Lookup", ctx, key, serviceName, serviceVersion)
+	ret0, _ := ret[0].(*dto.APIKeyValidationLookup)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
 }
 
-// UpdateLastUsed indicates an expected call of UpdateLastUsed.
-func (mr *MockAPIKeyRepositoryMockRecorder) UpdateLastUsed(ctx, key any) *gomock.Call {
+// Lookup indicates an expected call of Lookup.
+func (mr *MockValidationRepositoryMockRecorder) Lookup(ctx, key, serviceName, serviceVersion any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastUsed", reflect.TypeOf((*MockAPIKeyRepository)(nil).UpdateLastUsed), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lookup", reflect.TypeOf((*MockValidationRepository)(nil).Lookup), ctx, key, serviceName, serviceVersion)
 }
 
-// MockEnvironmentRepository is a mock of EnvironmentRepository interface.
-type MockEnvironmentRepository struct {
+// MockRequestRepository is a mock of RequestRepository interface.
+type MockRequestRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockEnvironmentRepositoryMockRecorder
+	recorder *MockRequestRepositoryMockRecorder
 	isgomock struct{}
 }
 
-// MockEnvironmentRepositoryMockRecorder is the mock recorder for MockEnvironmentRepository.
-type MockEnvironmentRepositoryMockRecorder struct {
-	mock *MockEnvironmentRepository
+// MockRequestRepositoryMockRecorder is the mock recorder for MockRequestRepository.
+type MockRequestRepositoryMockRecorder struct {
+	mock *MockRequestRepository
 }
 
-// NewMockEnvironmentRepository creates a new mock instance.
-func NewMockEnvironmentRepository(ctrl *gomock.Controller) *MockEnvironmentRepository {
-	mock := &MockEnvironmentRepository{ctrl: ctrl}
-	mock.recorder = &MockEnvironmentRepositoryMockRecorder{mock}
+// NewMockRequestRepository creates a new mock instance.
+func NewMockRequestRepository(ctrl *gomock.Controller) *MockRequestRepository {
+	mock := &MockRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockRequestRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockEnvironmentRepository) EXPECT() *MockEnvironmentRepositoryMockRecorder {
+func (m *MockRequestRepository) EXPECT() *MockRequestRepositoryMockRecorder {
 	return m.recorder
 }
 
-// DecrementAvailableRequest mocks base method.
-func (m *MockEnvironmentRepository) DecrementAvailableRequest(ctx context.Context, id, serviceID int) (*dto.DecrementAvailableRequest, errors.Error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DecrementAvailableRequest", ctx, id, serviceID)
-	ret0, _ := ret[0].(*dto.DecrementAvailableRequest)
-	ret1, _ := ret[1].(errors.Error)
-	return ret0, ret1
-}
-
-// DecrementAvailableRequest indicates an expected call of DecrementAvailableRequest.
-func (mr *MockEnvironmentRepositoryMockRecorder) DecrementAvailableRequest(ctx, id, serviceID any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrementAvailableRequest", reflect.TypeOf((*MockEnvironmentRepository)(nil).DecrementAvailableRequest), ctx, id, serviceID)
-}
-
-// GetByID mocks base method.
-func (m *MockEnvironmentRepository) GetByID(ctx context.Context, id int) (*entities.Environment, errors.Error) {
+// Create mocks base method.
+func (m *MockRequestRepository) Create(ctx context.Context, request *entities.Request) errors.Error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", ctx, id)
-	ret0, _ := ret[0].(*entities.Environment)
-	ret1, _ := ret[1].(errors.Error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(errors.Error)
+	return ret0
 }
 
-// GetByID indicates an expected call of GetByID.
-func (mr *MockEnvironmentRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockRequestRepositoryMockRecorder) Create(ctx, request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockEnvironmentRepository)(nil).GetByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRequestRepository)(nil).Create), ctx, request)
 }
 
-// MockProjectRepository is a mock of ProjectRepository interface.
-type MockProjectRepository struct {
+// MockQuotaAlertRepository is a mock of QuotaAlertRepository interface.
+type MockQuotaAlertRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockProjectRepositoryMockRecorder
+	recorder *MockQuotaAlertRepositoryMockRecorder
 	isgomock struct{}
 }
 
-// MockProjectRepositoryMockRecorder is the mock recorder for MockProjectRepository.
-type MockProjectRepositoryMockRecorder struct {
-	mock *MockProjectRepository
+// MockQuotaAlertRepositoryMockRecorder is the mock recorder for MockQuotaAlertRepository.
+type MockQuotaAlertRepositoryMockRecorder struct {
+	mock *MockQuotaAlertRepository
 }
 
-// NewMockProjectRepository creates a new mock instance.
-func NewMockProjectRepository(ctrl *gomock.Controller) *MockProjectRepository {
-	mock := &MockProjectRepository{ctrl: ctrl}
-	mock.recorder = &MockProjectRepositoryMockRecorder{mock}
+// NewMockQuotaAlertRepository creates a new mock instance.
+func NewMockQuotaAlertRepository(ctrl *gomock.Controller) *MockQuotaAlertRepository {
+	mock := &MockQuotaAlertRepository{ctrl: ctrl}
+	mock.recorder = &MockQuotaAlertRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockProjectRepository) EXPECT() *MockProjectRepositoryMockRecorder {
+func (m *MockQuotaAlertRepository) EXPECT() *MockQuotaAlertRepositoryMockRecorder {
 	return m.recorder
 }
 
-// GetProjectClientInfoByID mocks base method.
-func (m *MockProjectRepository) GetProjectClientInfoByID(ctx context.Context, id int) (*dto.ProjectClientInfoResponse, errors.Error) {
+// Trigger mocks base method.
+func (m *MockQuotaAlertRepository) Trigger(ctx context.Context, environmentID, serviceID, environmentPercent, projectPercent int) ([]*entities.QuotaAlert, errors.Error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProjectClientInfoByID", ctx, id)
-	ret0, _ := ret[0].(*dto.ProjectClientInfoResponse)
+	ret := m.ctrl.Call(m, "Trigger", ctx, environmentID, serviceID, environmentPercent, projectPercent)
+	ret0, _ := ret[0].([]*entities.QuotaAlert)
 	ret1, _ := ret[1].(errors.Error)
 	return ret0, ret1
 }
 
-// GetProjectClientInfoByID indicates an expected call of GetProjectClientInfoByID.
-func (mr *MockProjectRepositoryMockRecorder) GetProjectClientInfoByID(ctx, id any) *gomock.Call {
+// Trigger indicates an expected call of Trigger.
+func (mr *MockQuotaAlertRepositoryMockRecorder) Trigger(ctx, environmentID, serviceID, environmentPercent, projectPercent any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectClientInfoByID", reflect.TypeOf((*MockProjectRepository)(nil).GetProjectClientInfoByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trigger", reflect.TypeOf((*MockQuotaAlertRepository)(nil).Trigger), ctx, environmentID, serviceID, environmentPercent, projectPercent)
 }
 
-// MockServiceRepository is a mock of ServiceRepository interface.
-type MockServiceRepository struct {
+// MockWebhookRepository is a mock of WebhookRepository interface.
+type MockWebhookRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockServiceRepositoryMockRecorder
+	recorder *MockWebhookRepositoryMockRecorder
 	isgomock struct{}
 }
 
-// MockServiceRepositoryMockRecorder is the mock recorder for MockServiceRepository.
-type MockServiceRepositoryMockRecorder struct {
-	mock *MockServiceRepository
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
 }
 
-// NewMockServiceRepository creates a new mock instance.
-func NewMockServiceRepository(ctrl *gomock.Controller) *MockServiceRepository {
-	mock := &MockServiceRepository{ctrl: ctrl}
-	mock.recorder = &MockServiceRepositoryMockRecorder{mock}
+// NewMockWebhookRepository creates a new mock instance.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockServiceRepository) EXPECT() *MockServiceRepositoryMockRecorder {
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
 	return m.recorder
 }
 
-// GetByNameAndVersion mocks base method.
-func (m *MockServiceRepository) GetByNameAndVersion(ctx context.Context, name, version string) (*entities.Service, errors.Error) {
+// CreateDeliveries mocks base method.
+func (m *MockWebhookRepository) CreateDeliveries(ctx context.Context, events []*entities.WebhookEvent) (int, errors.Error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByNameAndVersion", ctx, name, version)
-	ret0, _ := ret[0].(*entities.Service)
+	ret := m.ctrl.Call(m, "CreateDeliveries", ctx, events)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(errors.Error)
 	return ret0, ret1
 }
 
-// GetByNameAndVersion indicates an expected call of GetByNameAndVersion.
-func (mr *MockServiceRepositoryMockRecorder) GetByNameAndVersion(ctx, name, version any) *gomock.Call {
+// CreateDeliveries indicates an expected call of CreateDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) CreateDeliveries(ctx, events any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByNameAndVersion", reflect.TypeOf((*MockServiceRepository)(nil).GetByNameAndVersion), ctx, name, version)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).CreateDeliveries), ctx, events)
 }
 
-// MockRequestRepository is a mock of RequestRepository interface.
-type MockRequestRepository struct {
+// MockRateLimiter is a mock of RateLimiter interface.
+type MockRateLimiter struct {
 	ctrl     *gomock.Controller
-	recorder *MockRequestRepositoryMockRecorder
+	recorder *MockRateLimiterMockRecorder
 	isgomock struct{}
 }
 
-// MockRequestRepositoryMockRecorder is the mock recorder for MockRequestRepository.
-type MockRequestRepositoryMockRecorder struct {
-	mock *MockRequestRepository
+// MockRateLimiterMockRecorder is the mock recorder for MockRateLimiter.
+type MockRateLimiterMockRecorder struct {
+	mock *MockRateLimiter
 }
 
-// NewMockRequestRepository creates a new mock instance.
-func NewMockRequestRepository(ctrl *gomock.Controller) *MockRequestRepository {
-	mock := &MockRequestRepository{ctrl: ctrl}
-	mock.recorder = &MockRequestRepositoryMockRecorder{mock}
+// NewMockRateLimiter creates a new mock instance.
+func NewMockRateLimiter(ctrl *gomock.Controller) *MockRateLimiter {
+	mock := &MockRateLimiter{ctrl: ctrl}
+	mock.recorder = &MockRateLimiterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRequestRepository) EXPECT() *MockRequestRepositoryMockRecorder {
+func (m *MockRateLimiter) EXPECT() *MockRateLimiterMockRecorder {
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *MockRequestRepository) Create(ctx context.Context, request *entities.Request) errors.Error {
+// Allow mocks base method.
+func (m *MockRateLimiter) Allow(ctx context.Context, key string, limit *entities.APIKeyRateLimit) (time.Duration, errors.Error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, request)
-	ret0, _ := ret[0].(errors.Error)
-	return ret0
+	ret := m.ctrl.Call(m, "Allow", ctx, key, limit)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockRequestRepositoryMockRecorder) Create(ctx, request any) *gomock.Call {
+// Allow indicates an expected call of Allow.
+func (mr *MockRateLimiterMockRecorder) Allow(ctx, key, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRequestRepository)(nil).Create), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockRateLimiter)(nil).Allow), ctx, key, limit)
 }
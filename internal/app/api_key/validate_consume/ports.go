@@ -9,24 +9,23 @@ import (
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
-type APIKeyRepository interface {
-	shared.ValidateAPIKeyRepository
-	UpdateLastUsed(ctx context.Context, key string) errors.Error
+type ValidationRepository interface {
+	shared.ValidateLookupRepository
+	Consume(ctx context.Context, request *entities.Request) (*dto.DecrementAvailableRequest, errors.Error)
 }
 
-type EnvironmentRepository interface {
-	shared.ValidateEnvironmentRepository
-	DecrementAvailableRequest(ctx context.Context, id, serviceID int) (*dto.DecrementAvailableRequest, errors.Error)
+type RequestRepository interface {
+	Create(ctx context.Context, request *entities.Request) errors.Error
 }
 
-type ProjectRepository interface {
-	shared.ValidateProjectRepository
+type QuotaAlertRepository interface {
+	shared.NotifyQuotaAlertRepository
 }
 
-type ServiceRepository interface {
-	shared.ValidateServiceRepository
+type WebhookRepository interface {
+	shared.NotifyWebhookRepository
 }
 
-type RequestRepository interface {
-	Create(ctx context.Context, request *entities.Request) errors.Error
+type RateLimiter interface {
+	shared.ValidateRateLimiter
 }
@@ -2,10 +2,9 @@ package validateconsume
 
 import (
 	"context"
-	"log"
-	"net/http"
 
 	"github.com/MAD-py/pandora-core/internal/app/api_key/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
@@ -20,18 +19,20 @@ type UseCase interface {
 type useCase struct {
 	validator validator.Validator
 
-	apiKeyRepo      APIKeyRepository
-	projectRepo     ProjectRepository
-	serviceRepo     ServiceRepository
-	requestRepo     RequestRepository
-	environmentRepo EnvironmentRepository
+	validationRepo ValidationRepository
+	requestRepo    RequestRepository
+	quotaAlertRepo QuotaAlertRepository
+	webhookRepo    WebhookRepository
 
-	validateDeps *shared.ValidateDependencies
+	rateLimiter RateLimiter
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, req *dto.APIKeyValidate,
 ) (*dto.APIKeyValidateConsumeResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "api_key.validate_consume")
+	defer span.End()
+
 	if err := uc.validateReq(req); err != nil {
 		return nil, err
 	}
@@ -66,50 +67,50 @@ func (uc *useCase) Execute(
 		Project:     &entities.RequestProject{},
 	}
 
-	err := shared.ValidateAPIKey(
-		ctx, uc.validateDeps, req, &request, &validateResponse,
+	err := shared.ValidateAPIKeyWithLookup(
+		ctx, uc.validationRepo, uc.rateLimiter, req, &request, &validateResponse,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// Quota is only consumed by requests that passed every other check,
+	// including the rate limit. Consuming it logs the request and marks the
+	// API key as used along with it, so none of them happens without the
+	// others.
+	var availableRequest int
 	if validateResponse.Valid {
 		request.ExecutionStatus = enums.RequestExecutionStatusForwarded
-	} else {
-		request.ExecutionStatus = enums.RequestExecutionStatusUnauthorized
-
-		request.StatusCode = http.StatusUnauthorized
-		request.UnauthorizedReason = validateResponse.FailureCode
-	}
-
-	availableRequest, err := uc.environmentRepo.DecrementAvailableRequest(
-		ctx, request.Environment.ID, request.Service.ID,
-	)
+		request.Units = shared.RequestedUnits(req)
 
-	if err != nil {
-		return nil, err
-	}
+		decrement, err := uc.validationRepo.Consume(ctx, &request)
+		if err != nil {
+			return nil, err
+		}
 
-	response := dto.APIKeyValidateConsumeResponse{
-		AvailableRequest:       availableRequest.AvailableRequest,
-		APIKeyValidateResponse: validateResponse,
-	}
+		if decrement.ExceededLevel != enums.QuotaLevelNull {
+			shared.SetQuotaExceeded(&validateResponse, decrement.ExceededLevel)
+		} else {
+			availableRequest = decrement.AvailableRequest
 
-	if err := uc.requestRepo.Create(ctx, &request); err != nil {
-		return nil, err
+			shared.NotifyQuota(
+				ctx, uc.quotaAlertRepo, uc.webhookRepo, &request, decrement,
+			)
+		}
+	} else {
+		request.Reject(validateResponse.FailureCode)
+		if err := uc.requestRepo.Create(ctx, &request); err != nil {
+			return nil, err
+		}
 	}
 
 	validateResponse.RequestID = request.ID
+	validateResponse.RequestDropped = request.Dropped
 
-	if validateResponse.Valid {
-		if err := uc.apiKeyRepo.UpdateLastUsed(ctx, req.APIKey); err != nil {
-			log.Printf(
-				"[WARN] Failed to update last_used for API Key %v: %v",
-				request.APIKey.ID, err,
-			)
-		}
-	}
-	return &response, nil
+	return &dto.APIKeyValidateConsumeResponse{
+		AvailableRequest:       availableRequest,
+		APIKeyValidateResponse: validateResponse,
+	}, nil
 }
 
 func (uc *useCase) validateReq(req *dto.APIKeyValidate) errors.Error {
@@ -129,31 +130,26 @@ func (uc *useCase) validateReq(req *dto.APIKeyValidate) errors.Error {
 			"request.request_time.required": "request.request_time is required",
 
 			"request.metadata.body_content_type.enums": "request.metadata.body_content_type must be one of the following: application/xml, application/json, text/plain, text/html, multipart/form-data, application/x-www-form-urlencoded, application/octet-stream",
+
+			"units.gt": "units must be greater than 0",
 		},
 	)
 }
 
 func NewUseCase(
 	validator validator.Validator,
-	apiKeyRepo APIKeyRepository,
-	projectRepo ProjectRepository,
-	serviceRepo ServiceRepository,
+	validationRepo ValidationRepository,
 	requestRepo RequestRepository,
-	environmentRepo EnvironmentRepository,
+	quotaAlertRepo QuotaAlertRepository,
+	webhookRepo WebhookRepository,
+	rateLimiter RateLimiter,
 ) UseCase {
 	return &useCase{
-		validator:       validator,
-		apiKeyRepo:      apiKeyRepo,
-		projectRepo:     projectRepo,
-		serviceRepo:     serviceRepo,
-		requestRepo:     requestRepo,
-		environmentRepo: environmentRepo,
-
-		validateDeps: shared.NewValidationDependencies(
-			apiKeyRepo,
-			serviceRepo,
-			projectRepo,
-			environmentRepo,
-		),
+		validator:      validator,
+		validationRepo: validationRepo,
+		requestRepo:    requestRepo,
+		quotaAlertRepo: quotaAlertRepo,
+		webhookRepo:    webhookRepo,
+		rateLimiter:    rateLimiter,
 	}
 }
@@ -1 +1,406 @@
 package validateconsume
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/api_key/validate_consume/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator      *mockvalidator.MockValidator
+	validationRepo *mock.MockValidationRepository
+	requestRepo    *mock.MockRequestRepository
+	quotaAlertRepo *mock.MockQuotaAlertRepository
+	webhookRepo    *mock.MockWebhookRepository
+	rateLimiter    *mock.MockRateLimiter
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	time.Local = time.UTC
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.validationRepo = mock.NewMockValidationRepository(s.ctrl)
+	s.requestRepo = mock.NewMockRequestRepository(s.ctrl)
+	s.quotaAlertRepo = mock.NewMockQuotaAlertRepository(s.ctrl)
+	s.webhookRepo = mock.NewMockWebhookRepository(s.ctrl)
+	s.rateLimiter = mock.NewMockRateLimiter(s.ctrl)
+
+	s.useCase = NewUseCase(
+		s.validator,
+		s.validationRepo,
+		s.requestRepo,
+		s.quotaAlertRepo,
+		s.webhookRepo,
+		s.rateLimiter,
+	)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) newRequest() *dto.APIKeyValidate {
+	return &dto.APIKeyValidate{
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
+		ServiceName:    "TestService",
+		ServiceVersion: "1.0.0",
+		Request: &dto.RequestIncoming{
+			Path:        "/test",
+			Method:      "GET",
+			IPAddress:   "127.0.0.1",
+			RequestTime: time.Now(),
+		},
+	}
+}
+
+func (s *UseCaseSuite) expectValidation(
+	req *dto.APIKeyValidate, rateLimit *entities.APIKeyRateLimit,
+) (*entities.Service, *entities.Environment) {
+	service := &entities.Service{
+		ID:      1,
+		Name:    req.ServiceName,
+		Version: req.ServiceVersion,
+		Status:  enums.ServiceStatusEnabled,
+	}
+	apiKey := &entities.APIKey{
+		ID:            10,
+		Key:           req.APIKey,
+		Status:        enums.APIKeyStatusEnabled,
+		EnvironmentID: 100,
+		RateLimit:     rateLimit,
+	}
+	environment := &entities.Environment{
+		ID:        100,
+		Name:      "production",
+		Status:    enums.EnvironmentStatusEnabled,
+		ProjectID: 1000,
+	}
+
+	s.validator.EXPECT().
+		ValidateStruct(req, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.validationRepo.EXPECT().
+		Lookup(s.ctx, req.APIKey, req.ServiceName, req.ServiceVersion).
+		Return(
+			&dto.APIKeyValidationLookup{
+				Service:     service,
+				APIKey:      apiKey,
+				Environment: environment,
+				ProjectClient: &dto.ProjectClientInfoResponse{
+					ProjectID:   1000,
+					ProjectName: "TestProject",
+					ClientID:    2000,
+					ClientName:  "TestClient",
+				},
+				ServiceAssigned: true,
+			},
+			nil,
+		).
+		Times(1)
+
+	return service, environment
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	req := s.newRequest()
+	rateLimit := entities.NewAPIKeyRateLimit(10, enums.APIKeyRateLimitPeriodSecond, 0)
+	service, environment := s.expectValidation(req, rateLimit)
+
+	s.rateLimiter.EXPECT().
+		Allow(s.ctx, "api_key:10", rateLimit).
+		Return(time.Duration(0), nil).
+		Times(1)
+
+	s.validationRepo.EXPECT().
+		Consume(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		DoAndReturn(func(_ context.Context, r *entities.Request) (*dto.DecrementAvailableRequest, errors.Error) {
+			s.Require().Equal(enums.RequestExecutionStatusForwarded, r.ExecutionStatus)
+			s.Require().Equal(1, r.Units)
+			s.Require().Equal(10, r.APIKey.ID)
+			s.Require().Equal(environment.ID, r.Environment.ID)
+			s.Require().Equal(service.ID, r.Service.ID)
+			s.Require().Equal(1000, r.Project.ID)
+			r.ID = "request-id"
+			return &dto.DecrementAvailableRequest{MaxRequests: 10, AvailableRequest: 4}, nil
+		}).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.True(resp.Valid)
+	s.Equal("request-id", resp.RequestID)
+	s.Equal(4, resp.AvailableRequest)
+}
+
+func (s *UseCaseSuite) TestWeightedUnits() {
+	req := s.newRequest()
+	req.Units = 250
+	s.expectValidation(req, nil)
+
+	s.validationRepo.EXPECT().
+		Consume(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		DoAndReturn(func(_ context.Context, r *entities.Request) (*dto.DecrementAvailableRequest, errors.Error) {
+			s.Require().Equal(250, r.Units)
+			r.ID = "request-id"
+			return &dto.DecrementAvailableRequest{MaxRequests: 1000, AvailableRequest: 750}, nil
+		}).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.True(resp.Valid)
+	s.Equal(750, resp.AvailableRequest)
+}
+
+func (s *UseCaseSuite) TestQuotaThresholdNotifiesWebhooks() {
+	req := s.newRequest()
+	service, environment := s.expectValidation(req, nil)
+
+	s.validationRepo.EXPECT().
+		Consume(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		Return(
+			&dto.DecrementAvailableRequest{
+				MaxRequests:      10,
+				AvailableRequest: 2,
+				Environment:      dto.QuotaBalance{MaxRequests: 10, AvailableRequest: 2},
+				Project:          dto.QuotaBalance{MaxRequests: 100, AvailableRequest: 50},
+			},
+			nil,
+		).
+		Times(1)
+
+	s.quotaAlertRepo.EXPECT().
+		Trigger(s.ctx, environment.ID, service.ID, 80, 50).
+		Return(
+			[]*entities.QuotaAlert{
+				{
+					ID:            7,
+					EnvironmentID: environment.ID,
+					ServiceID:     service.ID,
+					Level:         enums.QuotaLevelProject,
+					Threshold:     50,
+				},
+			},
+			nil,
+		).
+		Times(1)
+
+	s.webhookRepo.EXPECT().
+		CreateDeliveries(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, events []*entities.WebhookEvent) (int, errors.Error) {
+			s.Require().Len(events, 2)
+			s.Equal(enums.WebhookEventTypeQuotaThresholdReached, events[0].Type)
+			s.Equal(environment.ID, events[0].EnvironmentID)
+			s.Equal(service.ID, events[0].ServiceID)
+			s.Require().NotNil(events[0].Quota)
+			s.Equal(enums.QuotaLevelEnvironment, events[0].Quota.Level)
+			s.Equal(80, events[0].Quota.Threshold)
+			s.Equal(2, events[0].Quota.AvailableRequest)
+
+			s.Equal(enums.WebhookEventTypeQuotaAlertTriggered, events[1].Type)
+			s.Require().NotNil(events[1].Quota)
+			s.Equal(enums.QuotaLevelProject, events[1].Quota.Level)
+			s.Equal(7, events[1].Quota.AlertID)
+			s.Equal(50, events[1].Quota.Threshold)
+			s.Equal(50, events[1].Quota.AvailableRequest)
+			return 2, nil
+		}).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.True(resp.Valid)
+	s.Equal(2, resp.AvailableRequest)
+}
+
+func (s *UseCaseSuite) TestRateLimitedDoesNotConsume() {
+	req := s.newRequest()
+	rateLimit := entities.NewAPIKeyRateLimit(1, enums.APIKeyRateLimitPeriodSecond, 0)
+	s.expectValidation(req, rateLimit)
+
+	s.rateLimiter.EXPECT().
+		Allow(s.ctx, "api_key:10", rateLimit).
+		Return(500*time.Millisecond, nil).
+		Times(1)
+
+	s.validationRepo.EXPECT().
+		Consume(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.requestRepo.EXPECT().
+		Create(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		DoAndReturn(func(_ context.Context, r *entities.Request) errors.Error {
+			s.Require().Equal(enums.RequestExecutionStatusUnauthorized, r.ExecutionStatus)
+			s.Require().Equal(enums.APIKeyValidationFailureCodeRateLimited, r.UnauthorizedReason)
+			s.Require().Equal(http.StatusTooManyRequests, r.StatusCode)
+			r.ID = "request-id"
+			return nil
+		}).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.False(resp.Valid)
+	s.Equal(enums.APIKeyValidationFailureCodeRateLimited, resp.FailureCode)
+	s.Equal(500*time.Millisecond, resp.RetryAfter)
+	s.Equal("request-id", resp.RequestID)
+}
+
+func (s *UseCaseSuite) TestServiceNotAssigned() {
+	req := s.newRequest()
+
+	s.validator.EXPECT().
+		ValidateStruct(req, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.validationRepo.EXPECT().
+		Lookup(s.ctx, req.APIKey, req.ServiceName, req.ServiceVersion).
+		Return(
+			&dto.APIKeyValidationLookup{
+				Service: &entities.Service{ID: 1, Status: enums.ServiceStatusEnabled},
+				APIKey: &entities.APIKey{
+					ID: 10, Status: enums.APIKeyStatusEnabled, EnvironmentID: 100,
+				},
+				Environment: &entities.Environment{
+					ID: 100, Status: enums.EnvironmentStatusEnabled, ProjectID: 1000,
+				},
+				ProjectClient: &dto.ProjectClientInfoResponse{ProjectID: 1000},
+			},
+			nil,
+		).
+		Times(1)
+
+	s.validationRepo.EXPECT().
+		Consume(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.requestRepo.EXPECT().
+		Create(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		DoAndReturn(func(_ context.Context, r *entities.Request) errors.Error {
+			s.Require().Equal(enums.APIKeyValidationFailureCodeServiceNotAssigned, r.UnauthorizedReason)
+			s.Require().Equal(http.StatusUnauthorized, r.StatusCode)
+			s.Require().Zero(r.Units)
+			r.ID = "request-id"
+			return nil
+		}).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.False(resp.Valid)
+	s.Equal(enums.APIKeyValidationFailureCodeServiceNotAssigned, resp.FailureCode)
+	s.Equal("request-id", resp.RequestID)
+}
+
+func (s *UseCaseSuite) TestQuotaExceeded() {
+	req := s.newRequest()
+	s.expectValidation(req, nil)
+
+	s.validationRepo.EXPECT().
+		Consume(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		DoAndReturn(func(_ context.Context, r *entities.Request) (*dto.DecrementAvailableRequest, errors.Error) {
+			r.Reject(enums.APIKeyValidationFailureCodeQuotaExceeded)
+			r.ID = "request-id"
+			return &dto.DecrementAvailableRequest{ExceededLevel: enums.QuotaLevelEnvironment}, nil
+		}).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.False(resp.Valid)
+	s.Equal(enums.APIKeyValidationFailureCodeQuotaExceeded, resp.FailureCode)
+	s.Equal(enums.QuotaLevelEnvironment, resp.QuotaLevel)
+	s.Equal("request-id", resp.RequestID)
+	s.Zero(resp.AvailableRequest)
+}
+
+func (s *UseCaseSuite) TestProjectQuotaExceeded() {
+	req := s.newRequest()
+	s.expectValidation(req, nil)
+
+	s.validationRepo.EXPECT().
+		Consume(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		Return(
+			&dto.DecrementAvailableRequest{ExceededLevel: enums.QuotaLevelProject},
+			nil,
+		).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.False(resp.Valid)
+	s.Equal(enums.APIKeyValidationFailureCodeQuotaExceeded, resp.FailureCode)
+	s.Equal(enums.QuotaLevelProject, resp.QuotaLevel)
+}
+
+func (s *UseCaseSuite) TestConsumeError() {
+	req := s.newRequest()
+	s.expectValidation(req, nil)
+
+	internalErr := errors.NewInternal("validation repo error", nil)
+	s.validationRepo.EXPECT().
+		Consume(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		Return(nil, internalErr).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().ErrorIs(err, internalErr)
+	s.Nil(resp)
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
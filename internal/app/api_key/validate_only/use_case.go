@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/MAD-py/pandora-core/internal/app/api_key/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
@@ -32,6 +33,9 @@ type useCase struct {
 func (uc *useCase) Execute(
 	ctx context.Context, req *dto.APIKeyValidate,
 ) (*dto.APIKeyValidateResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "api_key.validate_only")
+	defer span.End()
+
 	if err := uc.validateReq(req); err != nil {
 		return nil, err
 	}
@@ -87,6 +91,7 @@ func (uc *useCase) Execute(
 	}
 
 	validateResponse.RequestID = request.ID
+	validateResponse.RequestDropped = request.Dropped
 
 	if validateResponse.Valid {
 		if err := uc.apiKeyRepo.UpdateLastUsed(ctx, req.APIKey); err != nil {
@@ -141,6 +146,7 @@ func NewUseCase(
 			serviceRepo,
 			projectRepo,
 			environmentRepo,
+			nil,
 		),
 	}
 }
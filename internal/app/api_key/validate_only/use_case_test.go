@@ -63,7 +63,7 @@ func (s *UseCaseSuite) TearDownTest() {
 func (s *UseCaseSuite) TestSuccess() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -180,7 +180,7 @@ func (s *UseCaseSuite) TestSuccess() {
 func (s *UseCaseSuite) TestSuccessUnauthorized() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "disabled-api-key",
+		APIKey:         "pdr_test_disabled0000000000000000000000000000000Test_d74efcea",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -290,7 +290,7 @@ func (s *UseCaseSuite) TestSuccessUnauthorized() {
 func (s *UseCaseSuite) TestValidateInternalError() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -342,7 +342,7 @@ func (s *UseCaseSuite) TestValidateInternalError() {
 func (s *UseCaseSuite) TestSuccessWithAPIKeyLastUsedErr() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -471,7 +471,7 @@ func (s *UseCaseSuite) TestValidationError() {
 func (s *UseCaseSuite) TestRequestCreationError() {
 	reqTime := time.Now()
 	req := &dto.APIKeyValidate{
-		APIKey:         "valid-api-key",
+		APIKey:         "pdr_test_valid0000000000000000000000000000000000Test_a9715933",
 		ServiceName:    "TestService",
 		ServiceVersion: "1.0.0",
 		Request: &dto.RequestIncoming{
@@ -0,0 +1,14 @@
+package list
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type AuditRepository interface {
+	List(ctx context.Context, filter *dto.AuditFilter, page *dto.Pagination) ([]*entities.AuditEntry, errors.Error)
+	Count(ctx context.Context, filter *dto.AuditFilter) (int, errors.Error)
+}
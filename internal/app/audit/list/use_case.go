@@ -0,0 +1,128 @@
+package list
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, req *dto.AuditFilter, page *dto.Pagination) (*dto.Page[*dto.AuditEntryResponse], errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	auditRepo AuditRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, req *dto.AuditFilter, page *dto.Pagination,
+) (*dto.Page[*dto.AuditEntryResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "audit.list")
+	defer span.End()
+
+	if err := uc.validateInput(req, page); err != nil {
+		return nil, err
+	}
+
+	entries, err := uc.auditRepo.List(ctx, req, page)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, nextCursor := dto.TrimPage(
+		entries,
+		page,
+		func(entry *entities.AuditEntry) *dto.Cursor {
+			return &dto.Cursor{
+				Time: entry.CreatedAt,
+				ID:   strconv.FormatInt(entry.ID, 10),
+			}
+		},
+	)
+
+	entryResponses := make([]*dto.AuditEntryResponse, len(entries))
+	for i, entry := range entries {
+		entryResponses[i] = &dto.AuditEntryResponse{
+			ID:        entry.ID,
+			Actor:     entry.Actor,
+			Action:    entry.Action,
+			Entity:    entry.Entity,
+			EntityID:  entry.EntityID,
+			Before:    entry.Before,
+			After:     entry.After,
+			IP:        entry.IP,
+			CreatedAt: entry.CreatedAt,
+		}
+	}
+
+	resp := &dto.Page[*dto.AuditEntryResponse]{
+		Items:      entryResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.auditRepo.Count(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validateInput(
+	req *dto.AuditFilter, page *dto.Pagination,
+) errors.Error {
+	var err errors.Error
+
+	if errReq := uc.validateReq(req); errReq != nil {
+		err = errors.Aggregate(err, errReq)
+	}
+
+	if errPage := uc.validatePage(page); errPage != nil {
+		err = errors.Aggregate(err, errPage)
+	}
+
+	return err
+}
+
+func (uc *useCase) validateReq(req *dto.AuditFilter) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"actor.max":           "actor must be at most 255 characters long",
+			"action.enums":        "action must be one of the following: create, update, delete, assign_service, update_service, remove_service, reset_requests, update_status, update_retention, enable, disable, rotate, reveal_key, change_password",
+			"entity.enums":        "entity must be one of the following: client, project, environment, service, api_key, webhook, quota_alert, credentials, client_token",
+			"entity_id.gt":        "entity_id must be greater than 0",
+			"created_to.gtefield": "created_to must be greater than or equal to created_from",
+		},
+	)
+}
+
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator, auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		validator: validator,
+		auditRepo: auditRepo,
+	}
+}
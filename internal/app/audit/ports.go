@@ -0,0 +1,9 @@
+package audit
+
+import (
+	"github.com/MAD-py/pandora-core/internal/app/audit/list"
+)
+
+// ... List Use Case ...
+
+type AuditListRepository = list.AuditRepository
@@ -0,0 +1,24 @@
+package shared
+
+import "context"
+
+type actorKey struct{}
+
+// Actor is the admin a change is made by and the address of the request
+// that made it.
+type Actor struct {
+	Username string
+	IP       string
+}
+
+// WithActor returns a copy of ctx carrying actor, for Record to attribute
+// the changes made with it.
+func WithActor(ctx context.Context, actor *Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor set by WithActor, or nil.
+func ActorFromContext(ctx context.Context) *Actor {
+	actor, _ := ctx.Value(actorKey{}).(*Actor)
+	return actor
+}
@@ -0,0 +1,148 @@
+package shared
+
+import (
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Diff returns the fields of before and after whose values differ, keyed
+// by their snake_case names. Either may be nil, in which case every field
+// of the other is returned. Struct fields tagged `audit:"-"` are left out.
+func Diff(before, after any) (map[string]any, map[string]any) {
+	beforeFields := auditFields(before)
+	afterFields := auditFields(after)
+
+	if beforeFields == nil || afterFields == nil {
+		return beforeFields, afterFields
+	}
+
+	beforeDiff := make(map[string]any)
+	afterDiff := make(map[string]any)
+
+	for name, value := range beforeFields {
+		afterValue, ok := afterFields[name]
+		if ok && reflect.DeepEqual(value, afterValue) {
+			continue
+		}
+
+		beforeDiff[name] = value
+		if ok {
+			afterDiff[name] = afterValue
+		}
+	}
+
+	for name, value := range afterFields {
+		if _, ok := beforeFields[name]; !ok {
+			afterDiff[name] = value
+		}
+	}
+
+	return beforeDiff, afterDiff
+}
+
+// auditFields returns the fields of v, a struct, a pointer to one or a
+// map, as auditValue normalizes them. It returns nil when v is nil.
+func auditFields(v any) map[string]any {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		fields, _ := auditValue(value).(map[string]any)
+		return fields
+	case reflect.Map:
+		fields := make(map[string]any, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			fields[iter.Key().String()] = auditValue(iter.Value())
+		}
+		return fields
+	default:
+		return nil
+	}
+}
+
+// auditValue converts value into maps, slices and plain values, so it can
+// be compared and stored as JSON with snake_case keys. Zero times become
+// nil and the rest UTC.
+func auditValue(value reflect.Value) any {
+	switch value.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return auditValue(value.Elem())
+	case reflect.Struct:
+		if t, ok := value.Interface().(time.Time); ok {
+			if t.IsZero() {
+				return nil
+			}
+			return t.UTC()
+		}
+
+		fields := make(map[string]any)
+		for i := range value.NumField() {
+			field := value.Type().Field(i)
+			if !field.IsExported() || field.Tag.Get("audit") == "-" {
+				continue
+			}
+
+			fields[snakeCase(field.Name)] = auditValue(value.Field(i))
+		}
+		return fields
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return nil
+		}
+
+		items := make([]any, value.Len())
+		for i := range items {
+			items[i] = auditValue(value.Index(i))
+		}
+		return items
+	case reflect.Map:
+		if value.IsNil() {
+			return nil
+		}
+
+		items := make(map[string]any, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			items[iter.Key().String()] = auditValue(iter.Value())
+		}
+		return items
+	case reflect.String:
+		return value.String()
+	default:
+		return value.Interface()
+	}
+}
+
+// snakeCase converts a Go field name, such as APIKeyID, into api_key_id.
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				(unicode.IsUpper(prev) && nextIsLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/audit/shared/record.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/audit/shared/record.go -destination=internal/app/audit/shared/mock/record.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecordAuditRepository is a mock of RecordAuditRepository interface.
+type MockRecordAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecordAuditRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRecordAuditRepositoryMockRecorder is the mock recorder for MockRecordAuditRepository.
+type MockRecordAuditRepositoryMockRecorder struct {
+	mock *MockRecordAuditRepository
+}
+
+// NewMockRecordAuditRepository creates a new mock instance.
+func NewMockRecordAuditRepository(ctrl *gomock.Controller) *MockRecordAuditRepository {
+	mock := &MockRecordAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockRecordAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecordAuditRepository) EXPECT() *MockRecordAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRecordAuditRepository) Create(ctx context.Context, entry *entities.AuditEntry) errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entry)
+	ret0, _ := ret[0].(errors.Error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRecordAuditRepositoryMockRecorder) Create(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecordAuditRepository)(nil).Create), ctx, entry)
+}
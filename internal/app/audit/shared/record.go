@@ -0,0 +1,48 @@
+package shared
+
+import (
+	"context"
+	"log"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type RecordAuditRepository interface {
+	Create(ctx context.Context, entry *entities.AuditEntry) errors.Error
+}
+
+// Record appends to the audit log the action taken on the entity by the
+// actor of ctx. before and after are the entity before and after it, nil
+// when it did not exist, and only their differing fields are kept. The
+// change has already been made when Record is called, so a failure to
+// record it is logged rather than returned.
+func Record(
+	ctx context.Context,
+	auditRepo RecordAuditRepository,
+	action enums.AuditAction,
+	entity enums.AuditEntity,
+	entityID int,
+	before, after any,
+) {
+	entry := &entities.AuditEntry{
+		Action:   action,
+		Entity:   entity,
+		EntityID: entityID,
+	}
+
+	if actor := ActorFromContext(ctx); actor != nil {
+		entry.Actor = actor.Username
+		entry.IP = actor.IP
+	}
+
+	entry.Before, entry.After = Diff(before, after)
+
+	if err := auditRepo.Create(ctx, entry); err != nil {
+		log.Printf(
+			"[WARN] Failed to record %s of %s %d by %q in the audit log: %v",
+			action, entity, entityID, entry.Actor, err,
+		)
+	}
+}
@@ -0,0 +1,162 @@
+package shared
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/audit/shared/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type RecordSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	auditRepo *mock.MockRecordAuditRepository
+
+	ctx context.Context
+}
+
+func (s *RecordSuite) SetupTest() {
+	time.Local = time.UTC
+
+	s.ctrl = gomock.NewController(s.T())
+
+	s.auditRepo = mock.NewMockRecordAuditRepository(s.ctrl)
+
+	s.ctx = WithActor(
+		context.Background(), &Actor{Username: "admin", IP: "10.0.0.1"},
+	)
+}
+
+func (s *RecordSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *RecordSuite) TestCreate() {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	client := &entities.Client{
+		ID:        7,
+		Type:      enums.ClientTypeDeveloper,
+		Name:      "Acme",
+		Email:     "dev@acme.com",
+		CreatedAt: createdAt,
+	}
+
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *entities.AuditEntry) errors.Error {
+			s.Equal("admin", entry.Actor)
+			s.Equal("10.0.0.1", entry.IP)
+			s.Equal(enums.AuditActionCreate, entry.Action)
+			s.Equal(enums.AuditEntityClient, entry.Entity)
+			s.Equal(7, entry.EntityID)
+			s.Nil(entry.Before)
+			s.Equal(
+				map[string]any{
+					"id":            7,
+					"type":          string(enums.ClientTypeDeveloper),
+					"name":          "Acme",
+					"email":         "dev@acme.com",
+					"api_key_limit": 0,
+					"created_at":    createdAt,
+				},
+				entry.After,
+			)
+			return nil
+		}).
+		Times(1)
+
+	Record(
+		s.ctx, s.auditRepo,
+		enums.AuditActionCreate, enums.AuditEntityClient, client.ID,
+		nil, client,
+	)
+}
+
+func (s *RecordSuite) TestUpdateKeepsChangedFields() {
+	before := &entities.Project{ID: 3, Name: "Old", Status: enums.ProjectStatusEnabled}
+	after := &entities.Project{ID: 3, Name: "New", Status: enums.ProjectStatusEnabled}
+
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *entities.AuditEntry) errors.Error {
+			s.Equal(map[string]any{"name": "Old"}, entry.Before)
+			s.Equal(map[string]any{"name": "New"}, entry.After)
+			return nil
+		}).
+		Times(1)
+
+	Record(
+		s.ctx, s.auditRepo,
+		enums.AuditActionUpdate, enums.AuditEntityProject, 3,
+		before, after,
+	)
+}
+
+func (s *RecordSuite) TestSecretsAreLeftOut() {
+	apiKey := &entities.APIKey{ID: 4, Key: "pdr_secret", KeyPrefix: "pdr_secr"}
+
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *entities.AuditEntry) errors.Error {
+			s.NotContains(entry.After, "key")
+			s.Equal("pdr_secr", entry.After["key_prefix"])
+			return nil
+		}).
+		Times(1)
+
+	Record(
+		s.ctx, s.auditRepo,
+		enums.AuditActionCreate, enums.AuditEntityAPIKey, apiKey.ID,
+		nil, apiKey,
+	)
+}
+
+func (s *RecordSuite) TestRepositoryErrorIsNotReturned() {
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		Return(errors.NewInternal("Repository Error", nil)).
+		Times(1)
+
+	s.NotPanics(func() {
+		Record(
+			s.ctx, s.auditRepo,
+			enums.AuditActionDelete, enums.AuditEntityWebhook, 9,
+			nil, nil,
+		)
+	})
+}
+
+func TestRecordSuite(t *testing.T) {
+	suite.Run(t, new(RecordSuite))
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"ID", "id"},
+		{"Name", "name"},
+		{"MaxRequests", "max_requests"},
+		{"APIKeyID", "api_key_id"},
+		{"RequestRetentionDays", "request_retention_days"},
+		{"URL", "url"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := snakeCase(test.name); got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
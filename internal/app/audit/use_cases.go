@@ -0,0 +1,17 @@
+package audit
+
+import (
+	"github.com/MAD-py/pandora-core/internal/app/audit/list"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+// ... List Use Case ...
+
+type ListUseCase = list.UseCase
+
+func NewListUseCase(
+	validator validator.Validator,
+	auditRepo AuditListRepository,
+) ListUseCase {
+	return list.NewUseCase(validator, auditRepo)
+}
@@ -3,6 +3,7 @@ package accesstokenvalidation
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -20,6 +21,9 @@ type useCase struct {
 func (uc *useCase) Execute(
 	ctx context.Context, token string,
 ) (string, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "auth.access_token_validation")
+	defer span.End()
+
 	if err := uc.validateAccessToken(token); err != nil {
 		return "", err
 	}
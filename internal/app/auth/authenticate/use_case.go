@@ -3,6 +3,7 @@ package authenticate
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
@@ -22,6 +23,9 @@ type useCase struct {
 func (uc *useCase) Execute(
 	ctx context.Context, req *dto.Authenticate,
 ) (*dto.AuthenticateResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "auth.authenticate")
+	defer span.End()
+
 	if err := uc.validateReq(req); err != nil {
 		return nil, err
 	}
@@ -41,6 +45,10 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	if !credentials.IsEnabled() {
+		return nil, errors.NewUnauthorized("Admin user is disabled", nil)
+	}
+
 	token, err := uc.tokenProvider.GenerateAccessToken(ctx, req.Username)
 	if err != nil {
 		return nil, err
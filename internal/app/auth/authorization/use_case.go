@@ -0,0 +1,72 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, username string) (enums.AdminRole, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	credentialsRepo CredentialsRepository
+}
+
+// Execute returns the role of the admin user. The user is looked up on
+// every request, so disabling it or changing its role takes effect
+// without waiting for its tokens to expire.
+func (uc *useCase) Execute(
+	ctx context.Context, username string,
+) (enums.AdminRole, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "auth.authorization")
+	defer span.End()
+
+	if err := uc.validateUsername(username); err != nil {
+		return enums.AdminRoleNull, err
+	}
+
+	credentials, err := uc.credentialsRepo.GetByUsername(ctx, username)
+	if err != nil {
+		if err.Code() == errors.CodeNotFound {
+			return enums.AdminRoleNull, errors.NewUnauthorized(
+				"Admin user not found", err,
+			)
+		}
+		return enums.AdminRoleNull, err
+	}
+
+	if !credentials.IsEnabled() {
+		return enums.AdminRoleNull, errors.NewUnauthorized(
+			"Admin user is disabled", nil,
+		)
+	}
+
+	return credentials.Role, nil
+}
+
+func (uc *useCase) validateUsername(username string) errors.Error {
+	return uc.validator.ValidateVariable(
+		username,
+		"username",
+		"required",
+		map[string]string{
+			"required": "username is required",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator, credentialsRepo CredentialsRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		credentialsRepo: credentialsRepo,
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/auth/client_token_validation/ports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/auth/client_token_validation/ports.go -destination=internal/app/auth/client_token_validation/mock/ports.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClientTokenRepository is a mock of ClientTokenRepository interface.
+type MockClientTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockClientTokenRepositoryMockRecorder is the mock recorder for MockClientTokenRepository.
+type MockClientTokenRepositoryMockRecorder struct {
+	mock *MockClientTokenRepository
+}
+
+// NewMockClientTokenRepository creates a new mock instance.
+func NewMockClientTokenRepository(ctrl *gomock.Controller) *MockClientTokenRepository {
+	mock := &MockClientTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockClientTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientTokenRepository) EXPECT() *MockClientTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByToken mocks base method.
+func (m *MockClientTokenRepository) GetByToken(ctx context.Context, token string) (*entities.ClientToken, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByToken", ctx, token)
+	ret0, _ := ret[0].(*entities.ClientToken)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// GetByToken indicates an expected call of GetByToken.
+func (mr *MockClientTokenRepositoryMockRecorder) GetByToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByToken", reflect.TypeOf((*MockClientTokenRepository)(nil).GetByToken), ctx, token)
+}
+
+// UpdateLastUsed mocks base method.
+func (m *MockClientTokenRepository) UpdateLastUsed(ctx context.Context, id int) errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastUsed", ctx, id)
+	ret0, _ := ret[0].(errors.Error)
+	return ret0
+}
+
+// UpdateLastUsed indicates an expected call of UpdateLastUsed.
+func (mr *MockClientTokenRepositoryMockRecorder) UpdateLastUsed(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastUsed", reflect.TypeOf((*MockClientTokenRepository)(nil).UpdateLastUsed), ctx, id)
+}
@@ -0,0 +1,13 @@
+package clienttokenvalidation
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ClientTokenRepository interface {
+	GetByToken(ctx context.Context, token string) (*entities.ClientToken, errors.Error)
+	UpdateLastUsed(ctx context.Context, id int) errors.Error
+}
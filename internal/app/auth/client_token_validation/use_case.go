@@ -0,0 +1,78 @@
+package clienttokenvalidation
+
+import (
+	"context"
+	"log"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, token string) (int, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	clientTokenRepo ClientTokenRepository
+}
+
+// Execute returns the ID of the client the portal token was issued to.
+func (uc *useCase) Execute(
+	ctx context.Context, token string,
+) (int, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "auth.client_token_validation")
+	defer span.End()
+
+	if err := uc.validateClientToken(token); err != nil {
+		return 0, err
+	}
+
+	if !(&entities.ClientToken{Token: token}).HasValidFormat() {
+		return 0, errors.NewUnauthorized("Invalid client token", nil)
+	}
+
+	clientToken, err := uc.clientTokenRepo.GetByToken(ctx, token)
+	if err != nil {
+		if err.Code() == errors.CodeNotFound {
+			return 0, errors.NewUnauthorized("Invalid client token", err)
+		}
+		return 0, err
+	}
+
+	if clientToken.IsExpired() {
+		return 0, errors.NewUnauthorized("Client token has expired", nil)
+	}
+
+	if err := uc.clientTokenRepo.UpdateLastUsed(ctx, clientToken.ID); err != nil {
+		log.Printf(
+			"[WARN] Failed to update last_used for client token %v: %v",
+			clientToken.ID, err,
+		)
+	}
+
+	return clientToken.ClientID, nil
+}
+
+func (uc *useCase) validateClientToken(token string) errors.Error {
+	return uc.validator.ValidateVariable(
+		token,
+		"client_token",
+		"required",
+		map[string]string{
+			"required": "client_token is required",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator, clientTokenRepo ClientTokenRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		clientTokenRepo: clientTokenRepo,
+	}
+}
@@ -0,0 +1,138 @@
+package clienttokenvalidation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/auth/client_token_validation/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type Suite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator       *mockvalidator.MockValidator
+	clientTokenRepo *mock.MockClientTokenRepository
+
+	useCase UseCase
+
+	ctx context.Context
+
+	token string
+}
+
+func (s *Suite) SetupTest() {
+	time.Local = time.UTC
+
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.clientTokenRepo = mock.NewMockClientTokenRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.validator, s.clientTokenRepo)
+
+	s.ctx = context.Background()
+
+	clientToken := &entities.ClientToken{}
+	s.Require().NoError(clientToken.GenerateToken())
+	s.token = clientToken.Token
+}
+
+func (s *Suite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *Suite) expectValidToken(token string) {
+	s.validator.EXPECT().
+		ValidateVariable(token, "client_token", "required", gomock.Any()).
+		Return(nil).
+		Times(1)
+}
+
+func (s *Suite) TestSuccess() {
+	s.expectValidToken(s.token)
+
+	s.clientTokenRepo.EXPECT().
+		GetByToken(s.ctx, s.token).
+		Return(&entities.ClientToken{ID: 4, ClientID: 7}, nil).
+		Times(1)
+
+	s.clientTokenRepo.EXPECT().
+		UpdateLastUsed(s.ctx, 4).
+		Return(nil).
+		Times(1)
+
+	clientID, err := s.useCase.Execute(s.ctx, s.token)
+
+	s.Require().NoError(err)
+	s.Equal(7, clientID)
+}
+
+func (s *Suite) TestInvalidFormat() {
+	token := "not-a-client-token"
+
+	s.expectValidToken(token)
+
+	s.clientTokenRepo.EXPECT().
+		GetByToken(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	clientID, err := s.useCase.Execute(s.ctx, token)
+
+	s.Require().Error(err)
+	s.Zero(clientID)
+	s.Equal(errors.CodeUnauthorized, err.Code())
+}
+
+func (s *Suite) TestUnknownToken() {
+	s.expectValidToken(s.token)
+
+	s.clientTokenRepo.EXPECT().
+		GetByToken(s.ctx, s.token).
+		Return(nil, errors.NewNotFound("client token not found", nil)).
+		Times(1)
+
+	clientID, err := s.useCase.Execute(s.ctx, s.token)
+
+	s.Require().Error(err)
+	s.Zero(clientID)
+	s.Equal(errors.CodeUnauthorized, err.Code())
+}
+
+func (s *Suite) TestExpiredToken() {
+	s.expectValidToken(s.token)
+
+	s.clientTokenRepo.EXPECT().
+		GetByToken(s.ctx, s.token).
+		Return(
+			&entities.ClientToken{
+				ID:        4,
+				ClientID:  7,
+				ExpiresAt: time.Now().Add(-time.Hour),
+			},
+			nil,
+		).
+		Times(1)
+
+	s.clientTokenRepo.EXPECT().
+		UpdateLastUsed(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	clientID, err := s.useCase.Execute(s.ctx, s.token)
+
+	s.Require().Error(err)
+	s.Zero(clientID)
+	s.Equal(errors.CodeUnauthorized, err.Code())
+}
+
+func TestClientTokenValidationSuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
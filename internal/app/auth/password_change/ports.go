@@ -3,6 +3,7 @@ package passwordchange
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
@@ -11,3 +12,7 @@ type CredentialsRepository interface {
 	GetByUsername(ctx context.Context, username string) (*entities.Credentials, errors.Error)
 	ChangePassword(ctx context.Context, credentials *entities.Credentials) errors.Error
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
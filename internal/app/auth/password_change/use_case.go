@@ -3,8 +3,11 @@ package passwordchange
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -17,11 +20,15 @@ type useCase struct {
 	validator validator.Validator
 
 	credentialsRepo CredentialsRepository
+	auditRepo       AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, req *dto.ChangePassword,
 ) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "auth.password_change")
+	defer span.End()
+
 	if err := uc.validateReq(req); err != nil {
 		return err
 	}
@@ -54,6 +61,16 @@ func (uc *useCase) Execute(
 		}
 		return err
 	}
+
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionChangePassword,
+		enums.AuditEntityCredentials,
+		currentCredentials.ID,
+		nil,
+		nil,
+	)
 	return nil
 }
 
@@ -71,10 +88,13 @@ func (uc *useCase) validateReq(req *dto.ChangePassword) errors.Error {
 }
 
 func NewUseCase(
-	validator validator.Validator, credentialsRepo CredentialsRepository,
+	validator validator.Validator,
+	credentialsRepo CredentialsRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:       validator,
 		credentialsRepo: credentialsRepo,
+		auditRepo:       auditRepo,
 	}
 }
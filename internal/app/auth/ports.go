@@ -3,6 +3,8 @@ package auth
 import (
 	accesstokenvalidation "github.com/MAD-py/pandora-core/internal/app/auth/access_token_validation"
 	"github.com/MAD-py/pandora-core/internal/app/auth/authenticate"
+	"github.com/MAD-py/pandora-core/internal/app/auth/authorization"
+	clienttokenvalidation "github.com/MAD-py/pandora-core/internal/app/auth/client_token_validation"
 	passwordchange "github.com/MAD-py/pandora-core/internal/app/auth/password_change"
 	"github.com/MAD-py/pandora-core/internal/app/auth/reauthenticate"
 	resetcheck "github.com/MAD-py/pandora-core/internal/app/auth/reset_check"
@@ -14,9 +16,18 @@ import (
 type CredentialsGetRepository = authenticate.CredentialsRepository
 type TokenGenerateProvider = authenticate.TokenProvider
 
+// ... Authorization Use Case ...
+
+type CredentialsAuthorizationRepository = authorization.CredentialsRepository
+
+// ... Client Token Validation Use Case ...
+
+type ClientTokenValidationRepository = clienttokenvalidation.ClientTokenRepository
+
 // ... Password Change Use Case ...
 
 type CredentialsPasswordChangeRepository = passwordchange.CredentialsRepository
+type AuditPasswordChangeRepository = passwordchange.AuditRepository
 
 // ... Reset Password Use Case ...
 
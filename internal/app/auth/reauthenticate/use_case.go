@@ -3,6 +3,7 @@ package reauthenticate
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
@@ -23,6 +24,9 @@ type useCase struct {
 func (uc *useCase) Execute(
 	ctx context.Context, req *dto.Reauthenticate,
 ) (*dto.ReauthenticateResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "auth.reauthenticate")
+	defer span.End()
+
 	if err := uc.validateReq(req); err != nil {
 		return nil, err
 	}
@@ -39,6 +43,10 @@ func (uc *useCase) Execute(
 		return nil, errors.NewUnauthorized("Invalid password", err.Unwrap())
 	}
 
+	if !credentials.IsEnabled() {
+		return nil, errors.NewUnauthorized("Admin user is disabled", nil)
+	}
+
 	scope, err := uc.actionToScope(req.Action)
 	if err != nil {
 		return nil, err
@@ -3,6 +3,7 @@ package resetcheck
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -20,6 +21,9 @@ type useCase struct {
 func (uc *useCase) Execute(
 	ctx context.Context, username string,
 ) (bool, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "auth.reset_check")
+	defer span.End()
+
 	if err := uc.validateUsername(username); err != nil {
 		return false, err
 	}
@@ -3,6 +3,7 @@ package scopedtokenvalidation
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
@@ -21,6 +22,9 @@ type useCase struct {
 func (uc *useCase) Execute(
 	ctx context.Context, token string, expectedScope enums.Scope,
 ) (string, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "auth.scoped_token_validation")
+	defer span.End()
+
 	if err := uc.validateInput(token, expectedScope); err != nil {
 		return "", err
 	}
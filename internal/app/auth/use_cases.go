@@ -3,6 +3,8 @@ package auth
 import (
 	accesstokenvalidation "github.com/MAD-py/pandora-core/internal/app/auth/access_token_validation"
 	"github.com/MAD-py/pandora-core/internal/app/auth/authenticate"
+	"github.com/MAD-py/pandora-core/internal/app/auth/authorization"
+	clienttokenvalidation "github.com/MAD-py/pandora-core/internal/app/auth/client_token_validation"
 	passwordchange "github.com/MAD-py/pandora-core/internal/app/auth/password_change"
 	"github.com/MAD-py/pandora-core/internal/app/auth/reauthenticate"
 	resetcheck "github.com/MAD-py/pandora-core/internal/app/auth/reset_check"
@@ -22,6 +24,28 @@ func NewAutenticateUseCase(
 	return authenticate.NewUseCase(validator, tokenProvider, credentialsRepo)
 }
 
+// ... Authorization Use Case ...
+
+type AuthorizationUseCase = authorization.UseCase
+
+func NewAuthorizationUseCase(
+	validator validator.Validator,
+	credentialsRepo CredentialsAuthorizationRepository,
+) AuthorizationUseCase {
+	return authorization.NewUseCase(validator, credentialsRepo)
+}
+
+// ... Client Token Validation Use Case ...
+
+type ClientTokenValidationUseCase = clienttokenvalidation.UseCase
+
+func NewClientTokenValidationUseCase(
+	validator validator.Validator,
+	clientTokenRepo ClientTokenValidationRepository,
+) ClientTokenValidationUseCase {
+	return clienttokenvalidation.NewUseCase(validator, clientTokenRepo)
+}
+
 // ... Password Change Use Case ...
 
 type PasswordChangeUseCase = passwordchange.UseCase
@@ -29,8 +53,9 @@ type PasswordChangeUseCase = passwordchange.UseCase
 func NewPasswordChangeUseCase(
 	validator validator.Validator,
 	credentialsRepo CredentialsPasswordChangeRepository,
+	auditRepo AuditPasswordChangeRepository,
 ) PasswordChangeUseCase {
-	return passwordchange.NewUseCase(validator, credentialsRepo)
+	return passwordchange.NewUseCase(validator, credentialsRepo, auditRepo)
 }
 
 // ... Reset Password Use Case ...
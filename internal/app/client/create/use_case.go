@@ -3,8 +3,11 @@ package create
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -17,31 +20,47 @@ type useCase struct {
 	validator validator.Validator
 
 	clientRepo ClientRepository
+	auditRepo  AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, req *dto.ClientCreate,
 ) (*dto.ClientResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "client.create")
+	defer span.End()
+
 	if err := uc.validateReq(req); err != nil {
 		return nil, err
 	}
 
 	client := entities.Client{
-		Type:  req.Type,
-		Name:  req.Name,
-		Email: req.Email,
+		Type:        req.Type,
+		Name:        req.Name,
+		Email:       req.Email,
+		APIKeyLimit: req.APIKeyLimit,
 	}
 
 	if err := uc.clientRepo.Create(ctx, &client); err != nil {
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionCreate,
+		enums.AuditEntityClient,
+		client.ID,
+		nil,
+		&client,
+	)
+
 	return &dto.ClientResponse{
-		ID:        client.ID,
-		Type:      client.Type,
-		Name:      client.Name,
-		Email:     client.Email,
-		CreatedAt: client.CreatedAt,
+		ID:          client.ID,
+		Type:        client.Type,
+		Name:        client.Name,
+		Email:       client.Email,
+		APIKeyLimit: client.APIKeyLimit,
+		CreatedAt:   client.CreatedAt,
 	}, nil
 }
 
@@ -49,20 +68,24 @@ func (uc *useCase) validateReq(req *dto.ClientCreate) errors.Error {
 	return uc.validator.ValidateStruct(
 		req,
 		map[string]string{
-			"type.enums":     "type must be one of the following: developer, organization",
-			"email.email":    "email must be a valid email address",
-			"type.required":  "type is required",
-			"name.required":  "name is required",
-			"email.required": "email is required",
+			"type.enums":        "type must be one of the following: developer, organization",
+			"email.email":       "email must be a valid email address",
+			"type.required":     "type is required",
+			"name.required":     "name is required",
+			"email.required":    "email is required",
+			"api_key_limit.gte": "api_key_limit must be greater than or equal to 0",
 		},
 	)
 }
 
 func NewUseCase(
-	validator validator.Validator, clientRepo ClientRepository,
+	validator validator.Validator,
+	clientRepo ClientRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:  validator,
 		clientRepo: clientRepo,
+		auditRepo:  auditRepo,
 	}
 }
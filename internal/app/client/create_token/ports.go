@@ -0,0 +1,21 @@
+package createtoken
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ClientRepository interface {
+	Exists(ctx context.Context, id int) (bool, errors.Error)
+}
+
+type ClientTokenRepository interface {
+	Create(ctx context.Context, token *entities.ClientToken) errors.Error
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -0,0 +1,159 @@
+package createtoken
+
+import (
+	"context"
+	"time"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id int, req *dto.ClientTokenCreate) (*dto.ClientTokenResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	clientRepo      ClientRepository
+	clientTokenRepo ClientTokenRepository
+	auditRepo       AuditRepository
+}
+
+// Execute issues a portal token to the client. The token itself is only
+// returned here, as just its hash is stored.
+func (uc *useCase) Execute(
+	ctx context.Context, id int, req *dto.ClientTokenCreate,
+) (*dto.ClientTokenResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "client.create_token")
+	defer span.End()
+
+	if err := uc.validateInput(id, req); err != nil {
+		return nil, err
+	}
+
+	exists, err := uc.clientRepo.Exists(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.NewEntityNotFound(
+			"Client",
+			"client not found",
+			map[string]any{"id": id},
+			nil,
+		)
+	}
+
+	clientToken := &entities.ClientToken{
+		ClientID:  id,
+		Name:      req.Name,
+		ExpiresAt: req.ExpiresAt,
+	}
+
+	if err := clientToken.GenerateToken(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.clientTokenRepo.Create(ctx, clientToken); err != nil {
+		return nil, err
+	}
+
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionCreate,
+		enums.AuditEntityClientToken,
+		clientToken.ID,
+		nil,
+		clientToken,
+	)
+
+	return &dto.ClientTokenResponse{
+		ID:        clientToken.ID,
+		ClientID:  clientToken.ClientID,
+		Name:      clientToken.Name,
+		Token:     clientToken.Token,
+		ExpiresAt: clientToken.ExpiresAt,
+		CreatedAt: clientToken.CreatedAt,
+	}, nil
+}
+
+func (uc *useCase) validateInput(
+	id int, req *dto.ClientTokenCreate,
+) errors.Error {
+	var err errors.Error
+
+	if errID := uc.validateID(id); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errReq := uc.validateReq(req); errReq != nil {
+		err = errors.Aggregate(err, errReq)
+	}
+
+	return err
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "id must be greater than 0",
+			"required": "id is required",
+		},
+	)
+}
+
+func (uc *useCase) validateReq(req *dto.ClientTokenCreate) errors.Error {
+	var err errors.Error
+
+	validationErr := uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"name.max":       "name must be at most 255 characters long",
+			"name.required":  "name is required",
+			"expires_at.utc": "expires_at must be in UTC format",
+		},
+	)
+
+	if validationErr != nil {
+		err = errors.Aggregate(err, validationErr)
+	}
+
+	if !req.ExpiresAt.IsZero() && !req.ExpiresAt.After(time.Now()) {
+		err = errors.Aggregate(
+			err,
+			errors.NewAttributeValidationFailed(
+				"ClientTokenCreate",
+				"expires_at",
+				"expires_at must be in the future",
+				nil,
+			),
+		)
+	}
+
+	return err
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	clientRepo ClientRepository,
+	clientTokenRepo ClientTokenRepository,
+	auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		clientRepo:      clientRepo,
+		clientTokenRepo: clientTokenRepo,
+		auditRepo:       auditRepo,
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/MAD-py/pandora-core/internal/app/client/delete/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
 )
@@ -20,6 +22,7 @@ type Suite struct {
 
 	validator  *mockvalidator.MockValidator
 	clientRepo *mock.MockClientRepository
+	auditRepo  *mock.MockAuditRepository
 
 	useCase UseCase
 
@@ -32,9 +35,10 @@ func (s *Suite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 
 	s.clientRepo = mock.NewMockClientRepository(s.ctrl)
+	s.auditRepo = mock.NewMockAuditRepository(s.ctrl)
 	s.validator = mockvalidator.NewMockValidator(s.ctrl)
 
-	s.useCase = NewUseCase(s.validator, s.clientRepo)
+	s.useCase = NewUseCase(s.validator, s.clientRepo, s.auditRepo)
 
 	s.ctx = context.Background()
 }
@@ -51,11 +55,28 @@ func (s *Suite) TestSuccess() {
 		Return(nil).
 		Times(1)
 
+	s.clientRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(&entities.Client{ID: id}, nil).
+		Times(1)
+
 	s.clientRepo.EXPECT().
 		Delete(s.ctx, id).
 		Return(nil).
 		Times(1)
 
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *entities.AuditEntry) errors.Error {
+			s.Equal(enums.AuditActionDelete, entry.Action)
+			s.Equal(enums.AuditEntityClient, entry.Entity)
+			s.Equal(id, entry.EntityID)
+			s.Equal(id, entry.Before["id"])
+			s.Nil(entry.After)
+			return nil
+		}).
+		Times(1)
+
 	err := s.useCase.Execute(s.ctx, id)
 
 	s.Require().NoError(err)
@@ -90,12 +111,21 @@ func (s *Suite) TestRepositoryError() {
 		Return(nil).
 		Times(1)
 
+	s.clientRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(&entities.Client{ID: id}, nil).
+		Times(1)
+
 	repositoryErr := errors.NewInternal("Repository Error", nil)
 	s.clientRepo.EXPECT().
 		Delete(s.ctx, id).
 		Return(repositoryErr).
 		Times(1)
 
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
 	err := s.useCase.Execute(s.ctx, id)
 
 	s.Require().Error(err)
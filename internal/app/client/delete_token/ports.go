@@ -0,0 +1,18 @@
+package deletetoken
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ClientTokenRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.ClientToken, errors.Error)
+	Delete(ctx context.Context, id int) errors.Error
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
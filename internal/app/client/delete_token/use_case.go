@@ -0,0 +1,113 @@
+package deletetoken
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id, tokenID int) errors.Error
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	clientTokenRepo ClientTokenRepository
+	auditRepo       AuditRepository
+}
+
+// Execute revokes a portal token of the client. Tokens of other clients
+// are reported as not found.
+func (uc *useCase) Execute(ctx context.Context, id, tokenID int) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "client.delete_token")
+	defer span.End()
+
+	if err := uc.validateInput(id, tokenID); err != nil {
+		return err
+	}
+
+	clientToken, err := uc.clientTokenRepo.GetByID(ctx, tokenID)
+	if err != nil && err.Code() != errors.CodeNotFound {
+		return err
+	}
+
+	if clientToken == nil || clientToken.ClientID != id {
+		return errors.NewEntityNotFound(
+			"ClientToken",
+			"client token not found",
+			map[string]any{"id": tokenID, "client_id": id},
+			err,
+		)
+	}
+
+	if err := uc.clientTokenRepo.Delete(ctx, tokenID); err != nil {
+		return err
+	}
+
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionDelete,
+		enums.AuditEntityClientToken,
+		tokenID,
+		clientToken,
+		nil,
+	)
+
+	return nil
+}
+
+func (uc *useCase) validateInput(id, tokenID int) errors.Error {
+	var err errors.Error
+
+	if errID := uc.validateID(id); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errID := uc.validateTokenID(tokenID); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	return err
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "id must be greater than 0",
+			"required": "id is required",
+		},
+	)
+}
+
+func (uc *useCase) validateTokenID(tokenID int) errors.Error {
+	return uc.validator.ValidateVariable(
+		tokenID,
+		"token_id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "token_id must be greater than 0",
+			"required": "token_id is required",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	clientTokenRepo ClientTokenRepository,
+	auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		clientTokenRepo: clientTokenRepo,
+		auditRepo:       auditRepo,
+	}
+}
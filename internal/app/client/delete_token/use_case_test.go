@@ -0,0 +1,131 @@
+package deletetoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/client/delete_token/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type Suite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator       *mockvalidator.MockValidator
+	clientTokenRepo *mock.MockClientTokenRepository
+	auditRepo       *mock.MockAuditRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *Suite) SetupTest() {
+	time.Local = time.UTC
+
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.clientTokenRepo = mock.NewMockClientTokenRepository(s.ctrl)
+	s.auditRepo = mock.NewMockAuditRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.validator, s.clientTokenRepo, s.auditRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *Suite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *Suite) expectValidIDs() {
+	s.validator.EXPECT().
+		ValidateVariable(gomock.Any(), gomock.Any(), "required,gt=0", gomock.Any()).
+		Return(nil).
+		Times(2)
+}
+
+func (s *Suite) TestSuccess() {
+	s.expectValidIDs()
+
+	s.clientTokenRepo.EXPECT().
+		GetByID(s.ctx, 4).
+		Return(
+			&entities.ClientToken{ID: 4, ClientID: 7, Name: "CI", TokenPrefix: "pct_abcdefgh"},
+			nil,
+		).
+		Times(1)
+
+	s.clientTokenRepo.EXPECT().
+		Delete(s.ctx, 4).
+		Return(nil).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *entities.AuditEntry) errors.Error {
+			s.Equal(enums.AuditActionDelete, entry.Action)
+			s.Equal(enums.AuditEntityClientToken, entry.Entity)
+			s.Equal(4, entry.EntityID)
+			s.NotContains(entry.Before, "token")
+			return nil
+		}).
+		Times(1)
+
+	err := s.useCase.Execute(s.ctx, 7, 4)
+
+	s.Require().NoError(err)
+}
+
+func (s *Suite) TestTokenOfOtherClient() {
+	s.expectValidIDs()
+
+	s.clientTokenRepo.EXPECT().
+		GetByID(s.ctx, 4).
+		Return(&entities.ClientToken{ID: 4, ClientID: 8}, nil).
+		Times(1)
+
+	s.clientTokenRepo.EXPECT().
+		Delete(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := s.useCase.Execute(s.ctx, 7, 4)
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeNotFound, err.Code())
+}
+
+func (s *Suite) TestNotFound() {
+	s.expectValidIDs()
+
+	s.clientTokenRepo.EXPECT().
+		GetByID(s.ctx, 4).
+		Return(nil, errors.NewNotFound("client token not found", nil)).
+		Times(1)
+
+	s.clientTokenRepo.EXPECT().
+		Delete(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := s.useCase.Execute(s.ctx, 7, 4)
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeNotFound, err.Code())
+}
+
+func TestDeleteTokenSuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
@@ -3,6 +3,7 @@ package get
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
@@ -21,6 +22,9 @@ type useCase struct {
 func (uc *useCase) Execute(
 	ctx context.Context, id int,
 ) (*dto.ClientResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "client.get")
+	defer span.End()
+
 	if err := uc.validateID(id); err != nil {
 		return nil, err
 	}
@@ -39,11 +43,12 @@ func (uc *useCase) Execute(
 	}
 
 	return &dto.ClientResponse{
-		ID:        client.ID,
-		Type:      client.Type,
-		Name:      client.Name,
-		Email:     client.Email,
-		CreatedAt: client.CreatedAt,
+		ID:          client.ID,
+		Type:        client.Type,
+		Name:        client.Name,
+		Email:       client.Email,
+		APIKeyLimit: client.APIKeyLimit,
+		CreatedAt:   client.CreatedAt,
 	}, nil
 }
 
@@ -43,17 +43,32 @@ func (m *MockClientRepository) EXPECT() *MockClientRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockClientRepository) Count(ctx context.Context, filter *dto.ClientFilter) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockClientRepositoryMockRecorder) Count(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockClientRepository)(nil).Count), ctx, filter)
+}
+
 // List mocks base method.
-func (m *MockClientRepository) List(ctx context.Context, filter *dto.ClientFilter) ([]*entities.Client, errors.Error) {
+func (m *MockClientRepository) List(ctx context.Context, filter *dto.ClientFilter, page *dto.Pagination) ([]*entities.Client, errors.Error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret := m.ctrl.Call(m, "List", ctx, filter, page)
 	ret0, _ := ret[0].([]*entities.Client)
 	ret1, _ := ret[1].(errors.Error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockClientRepositoryMockRecorder) List(ctx, filter any) *gomock.Call {
+func (mr *MockClientRepositoryMockRecorder) List(ctx, filter, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClientRepository)(nil).List), ctx, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClientRepository)(nil).List), ctx, filter, page)
 }
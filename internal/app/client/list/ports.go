@@ -9,5 +9,6 @@ import (
 )
 
 type ClientRepository interface {
-	List(ctx context.Context, filter *dto.ClientFilter) ([]*entities.Client, errors.Error)
+	List(ctx context.Context, filter *dto.ClientFilter, page *dto.Pagination) ([]*entities.Client, errors.Error)
+	Count(ctx context.Context, filter *dto.ClientFilter) (int, errors.Error)
 }
@@ -2,14 +2,17 @@ package list
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
 
 type UseCase interface {
-	Execute(ctx context.Context, req *dto.ClientFilter) ([]*dto.ClientResponse, errors.Error)
+	Execute(ctx context.Context, req *dto.ClientFilter, page *dto.Pagination) (*dto.Page[*dto.ClientResponse], errors.Error)
 }
 
 type useCase struct {
@@ -19,29 +22,73 @@ type useCase struct {
 }
 
 func (uc *useCase) Execute(
-	ctx context.Context, req *dto.ClientFilter,
-) ([]*dto.ClientResponse, errors.Error) {
-	if err := uc.validateReq(req); err != nil {
+	ctx context.Context, req *dto.ClientFilter, page *dto.Pagination,
+) (*dto.Page[*dto.ClientResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "client.list")
+	defer span.End()
+
+	if err := uc.validateInput(req, page); err != nil {
 		return nil, err
 	}
 
-	clients, err := uc.clientRepo.List(ctx, req)
+	clients, err := uc.clientRepo.List(ctx, req, page)
 	if err != nil {
 		return nil, err
 	}
 
+	clients, nextCursor := dto.TrimPage(
+		clients,
+		page,
+		func(client *entities.Client) *dto.Cursor {
+			return &dto.Cursor{
+				Time: client.CreatedAt,
+				ID:   strconv.Itoa(client.ID),
+			}
+		},
+	)
+
 	clientResponses := make([]*dto.ClientResponse, len(clients))
 	for i, client := range clients {
 		clientResponses[i] = &dto.ClientResponse{
-			ID:        client.ID,
-			Type:      client.Type,
-			Name:      client.Name,
-			Email:     client.Email,
-			CreatedAt: client.CreatedAt,
+			ID:          client.ID,
+			Type:        client.Type,
+			Name:        client.Name,
+			Email:       client.Email,
+			APIKeyLimit: client.APIKeyLimit,
+			CreatedAt:   client.CreatedAt,
 		}
 	}
 
-	return clientResponses, nil
+	resp := &dto.Page[*dto.ClientResponse]{
+		Items:      clientResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.clientRepo.Count(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validateInput(
+	req *dto.ClientFilter, page *dto.Pagination,
+) errors.Error {
+	var err errors.Error
+
+	if errReq := uc.validateReq(req); errReq != nil {
+		err = errors.Aggregate(err, errReq)
+	}
+
+	if errPage := uc.validatePage(page); errPage != nil {
+		err = errors.Aggregate(err, errPage)
+	}
+
+	return err
 }
 
 func (uc *useCase) validateReq(req *dto.ClientFilter) errors.Error {
@@ -53,6 +100,17 @@ func (uc *useCase) validateReq(req *dto.ClientFilter) errors.Error {
 	)
 }
 
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
 func NewUseCase(
 	validator validator.Validator, clientRepo ClientRepository,
 ) UseCase {
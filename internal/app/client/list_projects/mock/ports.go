@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
 	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
 	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
 	gomock "go.uber.org/mock/gomock"
@@ -81,17 +82,32 @@ func (m *MockProjectRepository) EXPECT() *MockProjectRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountByClient mocks base method.
+func (m *MockProjectRepository) CountByClient(ctx context.Context, id int) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByClient", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// CountByClient indicates an expected call of CountByClient.
+func (mr *MockProjectRepositoryMockRecorder) CountByClient(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByClient", reflect.TypeOf((*MockProjectRepository)(nil).CountByClient), ctx, id)
+}
+
 // ListByClient mocks base method.
-func (m *MockProjectRepository) ListByClient(ctx context.Context, id int) ([]*entities.Project, errors.Error) {
+func (m *MockProjectRepository) ListByClient(ctx context.Context, id int, page *dto.Pagination) ([]*entities.Project, errors.Error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByClient", ctx, id)
+	ret := m.ctrl.Call(m, "ListByClient", ctx, id, page)
 	ret0, _ := ret[0].([]*entities.Project)
 	ret1, _ := ret[1].(errors.Error)
 	return ret0, ret1
 }
 
 // ListByClient indicates an expected call of ListByClient.
-func (mr *MockProjectRepositoryMockRecorder) ListByClient(ctx, id any) *gomock.Call {
+func (mr *MockProjectRepositoryMockRecorder) ListByClient(ctx, id, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByClient", reflect.TypeOf((*MockProjectRepository)(nil).ListByClient), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByClient", reflect.TypeOf((*MockProjectRepository)(nil).ListByClient), ctx, id, page)
 }
@@ -3,6 +3,7 @@ package listprojects
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
@@ -12,5 +13,6 @@ type ClientRepository interface {
 }
 
 type ProjectRepository interface {
-	ListByClient(ctx context.Context, id int) ([]*entities.Project, errors.Error)
+	ListByClient(ctx context.Context, id int, page *dto.Pagination) ([]*entities.Project, errors.Error)
+	CountByClient(ctx context.Context, id int) (int, errors.Error)
 }
@@ -2,14 +2,17 @@ package listprojects
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
 
 type UseCase interface {
-	Execute(ctx context.Context, id int) ([]*dto.ProjectResponse, errors.Error)
+	Execute(ctx context.Context, id int, page *dto.Pagination) (*dto.Page[*dto.ProjectResponse], errors.Error)
 }
 
 type useCase struct {
@@ -20,9 +23,12 @@ type useCase struct {
 }
 
 func (uc *useCase) Execute(
-	ctx context.Context, id int,
-) ([]*dto.ProjectResponse, errors.Error) {
-	if err := uc.validateID(id); err != nil {
+	ctx context.Context, id int, page *dto.Pagination,
+) (*dto.Page[*dto.ProjectResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "client.list_projects")
+	defer span.End()
+
+	if err := uc.validateInput(id, page); err != nil {
 		return nil, err
 	}
 
@@ -40,11 +46,22 @@ func (uc *useCase) Execute(
 		)
 	}
 
-	projects, err := uc.projectRepo.ListByClient(ctx, id)
+	projects, err := uc.projectRepo.ListByClient(ctx, id, page)
 	if err != nil {
 		return nil, err
 	}
 
+	projects, nextCursor := dto.TrimPage(
+		projects,
+		page,
+		func(project *entities.Project) *dto.Cursor {
+			return &dto.Cursor{
+				Time: project.CreatedAt,
+				ID:   strconv.Itoa(project.ID),
+			}
+		},
+	)
+
 	projectResponses := make([]*dto.ProjectResponse, len(projects))
 	for i, project := range projects {
 		serviceResp := make(
@@ -52,13 +69,14 @@ func (uc *useCase) Execute(
 		)
 		for i, service := range project.Services {
 			serviceResp[i] = &dto.ProjectServiceResponse{
-				ID:             service.ID,
-				Name:           service.Name,
-				Version:        service.Version,
-				NextReset:      service.NextReset,
-				MaxRequests:    service.MaxRequests,
-				ResetFrequency: service.ResetFrequency,
-				AssignedAt:     service.AssignedAt,
+				ID:               service.ID,
+				Name:             service.Name,
+				Version:          service.Version,
+				NextReset:        service.NextReset,
+				MaxRequests:      service.MaxRequests,
+				ResetFrequency:   service.ResetFrequency,
+				AvailableRequest: service.AvailableRequest,
+				AssignedAt:       service.AssignedAt,
 			}
 		}
 
@@ -72,7 +90,34 @@ func (uc *useCase) Execute(
 		}
 	}
 
-	return projectResponses, nil
+	resp := &dto.Page[*dto.ProjectResponse]{
+		Items:      projectResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.projectRepo.CountByClient(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validateInput(id int, page *dto.Pagination) errors.Error {
+	var err errors.Error
+
+	if errID := uc.validateID(id); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errPage := uc.validatePage(page); errPage != nil {
+		err = errors.Aggregate(err, errPage)
+	}
+
+	return err
 }
 
 func (uc *useCase) validateID(id int) errors.Error {
@@ -87,6 +132,17 @@ func (uc *useCase) validateID(id int) errors.Error {
 	)
 }
 
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
 func NewUseCase(
 	validator validator.Validator,
 	clientRepo ClientRepository,
@@ -0,0 +1,16 @@
+package listtokens
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ClientRepository interface {
+	Exists(ctx context.Context, id int) (bool, errors.Error)
+}
+
+type ClientTokenRepository interface {
+	ListByClient(ctx context.Context, clientID int) ([]*entities.ClientToken, errors.Error)
+}
@@ -0,0 +1,90 @@
+package listtokens
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id int) ([]*dto.ClientTokenResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	clientRepo      ClientRepository
+	clientTokenRepo ClientTokenRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, id int,
+) ([]*dto.ClientTokenResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "client.list_tokens")
+	defer span.End()
+
+	if err := uc.validateID(id); err != nil {
+		return nil, err
+	}
+
+	exists, err := uc.clientRepo.Exists(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.NewEntityNotFound(
+			"Client",
+			"client not found",
+			map[string]any{"id": id},
+			nil,
+		)
+	}
+
+	clientTokens, err := uc.clientTokenRepo.ListByClient(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	clientTokenResponses := make([]*dto.ClientTokenResponse, len(clientTokens))
+	for i, clientToken := range clientTokens {
+		clientTokenResponses[i] = &dto.ClientTokenResponse{
+			ID:        clientToken.ID,
+			ClientID:  clientToken.ClientID,
+			Name:      clientToken.Name,
+			Token:     clientToken.TokenSummary(),
+			ExpiresAt: clientToken.ExpiresAt,
+			LastUsed:  clientToken.LastUsed,
+			CreatedAt: clientToken.CreatedAt,
+		}
+	}
+
+	return clientTokenResponses, nil
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "id must be greater than 0",
+			"required": "id is required",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	clientRepo ClientRepository,
+	clientTokenRepo ClientTokenRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		clientRepo:      clientRepo,
+		clientTokenRepo: clientTokenRepo,
+	}
+}
@@ -2,20 +2,36 @@ package client
 
 import (
 	"github.com/MAD-py/pandora-core/internal/app/client/create"
+	createtoken "github.com/MAD-py/pandora-core/internal/app/client/create_token"
 	"github.com/MAD-py/pandora-core/internal/app/client/delete"
+	deletetoken "github.com/MAD-py/pandora-core/internal/app/client/delete_token"
 	"github.com/MAD-py/pandora-core/internal/app/client/get"
 	"github.com/MAD-py/pandora-core/internal/app/client/list"
 	listprojects "github.com/MAD-py/pandora-core/internal/app/client/list_projects"
+	listtokens "github.com/MAD-py/pandora-core/internal/app/client/list_tokens"
 	"github.com/MAD-py/pandora-core/internal/app/client/update"
 )
 
 // ... Create Use Case ...
 
 type ClientCreateRepository = create.ClientRepository
+type AuditCreateRepository = create.AuditRepository
+
+// ... Create Token Use Case ...
+
+type ClientCreateTokenRepository = createtoken.ClientRepository
+type ClientTokenCreateRepository = createtoken.ClientTokenRepository
+type AuditCreateTokenRepository = createtoken.AuditRepository
 
 // ... Delete Use Case ...
 
 type ClientDeleteRepository = delete.ClientRepository
+type AuditDeleteRepository = delete.AuditRepository
+
+// ... Delete Token Use Case ...
+
+type ClientTokenDeleteRepository = deletetoken.ClientTokenRepository
+type AuditDeleteTokenRepository = deletetoken.AuditRepository
 
 // ... Get Use Case ...
 
@@ -30,5 +46,11 @@ type ClientListRepository = list.ClientRepository
 type ClientListProjectsRepository = listprojects.ClientRepository
 type ProjectListByClientRepository = listprojects.ProjectRepository
 
+// ... List Tokens Use Case ...
+
+type ClientListTokensRepository = listtokens.ClientRepository
+type ClientTokenListRepository = listtokens.ClientTokenRepository
+
 // ... Update Use Case ...
 type ClientUpdateRepository = update.ClientRepository
+type AuditUpdateRepository = update.AuditRepository
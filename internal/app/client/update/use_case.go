@@ -3,7 +3,10 @@ package update
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -16,24 +19,44 @@ type useCase struct {
 	validator validator.Validator
 
 	clientRepo ClientRepository
+	auditRepo  AuditRepository
 }
 
 func (uc *useCase) Execute(ctx context.Context, id int, req *dto.ClientUpdate) (*dto.ClientResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "client.update")
+	defer span.End()
+
 	if err := uc.validateReq(req); err != nil {
 		return nil, err
 	}
 
+	before, err := uc.clientRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
 	client, err := uc.clientRepo.Update(ctx, id, req)
 	if err != nil {
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionUpdate,
+		enums.AuditEntityClient,
+		client.ID,
+		before,
+		client,
+	)
+
 	return &dto.ClientResponse{
-		ID:        client.ID,
-		Type:      client.Type,
-		Name:      client.Name,
-		Email:     client.Email,
-		CreatedAt: client.CreatedAt,
+		ID:          client.ID,
+		Type:        client.Type,
+		Name:        client.Name,
+		Email:       client.Email,
+		APIKeyLimit: client.APIKeyLimit,
+		CreatedAt:   client.CreatedAt,
 	}, nil
 }
 
@@ -41,17 +64,21 @@ func (uc *useCase) validateReq(req *dto.ClientUpdate) errors.Error {
 	return uc.validator.ValidateStruct(
 		req,
 		map[string]string{
-			"type.enums":  "type must be one of the following: developer, organization",
-			"email.email": "email must be a valid email address",
+			"type.enums":        "type must be one of the following: developer, organization",
+			"email.email":       "email must be a valid email address",
+			"api_key_limit.gte": "api_key_limit must be greater than or equal to 0",
 		},
 	)
 }
 
 func NewUseCase(
-	validator validator.Validator, clientRepo ClientRepository,
+	validator validator.Validator,
+	clientRepo ClientRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:  validator,
 		clientRepo: clientRepo,
+		auditRepo:  auditRepo,
 	}
 }
@@ -2,10 +2,13 @@ package client
 
 import (
 	"github.com/MAD-py/pandora-core/internal/app/client/create"
+	createtoken "github.com/MAD-py/pandora-core/internal/app/client/create_token"
 	"github.com/MAD-py/pandora-core/internal/app/client/delete"
+	deletetoken "github.com/MAD-py/pandora-core/internal/app/client/delete_token"
 	"github.com/MAD-py/pandora-core/internal/app/client/get"
 	"github.com/MAD-py/pandora-core/internal/app/client/list"
 	listprojects "github.com/MAD-py/pandora-core/internal/app/client/list_projects"
+	listtokens "github.com/MAD-py/pandora-core/internal/app/client/list_tokens"
 	"github.com/MAD-py/pandora-core/internal/app/client/update"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -15,9 +18,26 @@ import (
 type CreateUseCase = create.UseCase
 
 func NewCreateUseCase(
-	validator validator.Validator, clientRepo ClientCreateRepository,
+	validator validator.Validator,
+	clientRepo ClientCreateRepository,
+	auditRepo AuditCreateRepository,
 ) CreateUseCase {
-	return create.NewUseCase(validator, clientRepo)
+	return create.NewUseCase(validator, clientRepo, auditRepo)
+}
+
+// ... Create Token Use Case ...
+
+type CreateTokenUseCase = createtoken.UseCase
+
+func NewCreateTokenUseCase(
+	validator validator.Validator,
+	clientRepo ClientCreateTokenRepository,
+	clientTokenRepo ClientTokenCreateRepository,
+	auditRepo AuditCreateTokenRepository,
+) CreateTokenUseCase {
+	return createtoken.NewUseCase(
+		validator, clientRepo, clientTokenRepo, auditRepo,
+	)
 }
 
 // ... Delete Use Case ...
@@ -25,9 +45,23 @@ func NewCreateUseCase(
 type DeleteUseCase = delete.UseCase
 
 func NewDeleteUseCase(
-	validator validator.Validator, clientRepo ClientDeleteRepository,
+	validator validator.Validator,
+	clientRepo ClientDeleteRepository,
+	auditRepo AuditDeleteRepository,
 ) DeleteUseCase {
-	return delete.NewUseCase(validator, clientRepo)
+	return delete.NewUseCase(validator, clientRepo, auditRepo)
+}
+
+// ... Delete Token Use Case ...
+
+type DeleteTokenUseCase = deletetoken.UseCase
+
+func NewDeleteTokenUseCase(
+	validator validator.Validator,
+	clientTokenRepo ClientTokenDeleteRepository,
+	auditRepo AuditDeleteTokenRepository,
+) DeleteTokenUseCase {
+	return deletetoken.NewUseCase(validator, clientTokenRepo, auditRepo)
 }
 
 // ... Get Use Case ...
@@ -62,12 +96,26 @@ func NewListProjectsUseCase(
 	return listprojects.NewUseCase(validator, clientRepo, projectRepo)
 }
 
+// ... List Tokens Use Case ...
+
+type ListTokensUseCase = listtokens.UseCase
+
+func NewListTokensUseCase(
+	validator validator.Validator,
+	clientRepo ClientListTokensRepository,
+	clientTokenRepo ClientTokenListRepository,
+) ListTokensUseCase {
+	return listtokens.NewUseCase(validator, clientRepo, clientTokenRepo)
+}
+
 // ... Update Use Case ...
 
 type UpdateUseCase = update.UseCase
 
 func NewUpdateUseCase(
-	validator validator.Validator, clientRepo ClientUpdateRepository,
+	validator validator.Validator,
+	clientRepo ClientUpdateRepository,
+	auditRepo AuditUpdateRepository,
 ) UpdateUseCase {
-	return update.NewUseCase(validator, clientRepo)
+	return update.NewUseCase(validator, clientRepo, auditRepo)
 }
@@ -3,6 +3,7 @@ package assignservice
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
@@ -14,3 +15,7 @@ type EnvironmentRepository interface {
 	ExistsServiceIn(ctx context.Context, id, serviceID int) (bool, errors.Error)
 	GetProjectServiceQuotaUsage(ctx context.Context, id, serviceID int) (*dto.QuotaUsage, errors.Error)
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -3,8 +3,11 @@ package assignservice
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -17,11 +20,15 @@ type useCase struct {
 	validator validator.Validator
 
 	environmentRepo EnvironmentRepository
+	auditRepo       AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, id int, req *dto.EnvironmentService,
 ) (*dto.EnvironmentServiceResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "environment.assign_service")
+	defer span.End()
+
 	if err := uc.validateInput(id, req); err != nil {
 		return nil, err
 	}
@@ -75,16 +82,8 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
-	if quota.MaxAllowed > -1 {
-		if service.MaxRequests == -1 {
-			return nil, errors.NewAttributeValidationFailed(
-				"EnvironmentService",
-				"max_requests",
-				"max_requests cannot be -1 (unlimited) if the project has a defined limit",
-				nil,
-			)
-		}
-
+	// Unlimited environment services only draw from the project pool.
+	if quota.MaxAllowed > -1 && service.MaxRequests != -1 {
 		if quota.CurrentAllocated+service.MaxRequests > quota.MaxAllowed {
 			return nil, errors.NewAttributeValidationFailed(
 				"EnvironmentService",
@@ -99,6 +98,16 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionAssignService,
+		enums.AuditEntityEnvironment,
+		id,
+		nil,
+		&service,
+	)
+
 	return &dto.EnvironmentServiceResponse{
 		ID:               service.ID,
 		Name:             service.Name,
@@ -147,10 +156,13 @@ func (uc *useCase) validateReq(req *dto.EnvironmentService) errors.Error {
 }
 
 func NewUseCase(
-	validator validator.Validator, environmentRepo EnvironmentRepository,
+	validator validator.Validator,
+	environmentRepo EnvironmentRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:       validator,
 		environmentRepo: environmentRepo,
+		auditRepo:       auditRepo,
 	}
 }
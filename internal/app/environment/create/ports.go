@@ -3,6 +3,7 @@ package create
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
@@ -16,3 +17,7 @@ type ProjectRepository interface {
 	Exists(ctx context.Context, id int) (bool, errors.Error)
 	GetProjectServiceQuotaUsage(ctx context.Context, id, serviceID int) (*dto.QuotaUsage, errors.Error)
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
@@ -20,11 +22,15 @@ type useCase struct {
 
 	projectRepo     ProjectRepository
 	environmentRepo EnvironmentRepository
+	auditRepo       AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, req *dto.EnvironmentCreate,
 ) (*dto.EnvironmentResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "environment.create")
+	defer span.End()
+
 	if err := uc.validateReq(req); err != nil {
 		return nil, err
 	}
@@ -62,20 +68,8 @@ func (uc *useCase) Execute(
 			continue
 		}
 
-		if quota.MaxAllowed > -1 {
-			if service.MaxRequests == -1 {
-				errs = errors.Aggregate(
-					errs,
-					errors.NewAttributeValidationFailed(
-						"EnvironmentCreate",
-						fmt.Sprintf("services[%d].max_requests", i),
-						"max_requests cannot be -1 (unlimited) if the project has a defined limit",
-						nil,
-					),
-				)
-				continue
-			}
-
+		// Unlimited environment services only draw from the project pool.
+		if quota.MaxAllowed > -1 && service.MaxRequests != -1 {
 			if quota.CurrentAllocated+service.MaxRequests > quota.MaxAllowed {
 				errs = errors.Aggregate(
 					errs,
@@ -101,8 +95,14 @@ func (uc *useCase) Execute(
 		return nil, errs
 	}
 
+	environmentType := req.Type
+	if environmentType == enums.EnvironmentTypeNull {
+		environmentType = enums.EnvironmentTypeLive
+	}
+
 	environment := entities.Environment{
 		Name:      req.Name,
+		Type:      environmentType,
 		Status:    enums.EnvironmentStatusEnabled,
 		ProjectID: req.ProjectID,
 		Services:  services,
@@ -112,6 +112,16 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionCreate,
+		enums.AuditEntityEnvironment,
+		environment.ID,
+		nil,
+		&environment,
+	)
+
 	serviceResp := make(
 		[]*dto.EnvironmentServiceResponse, len(environment.Services),
 	)
@@ -129,6 +139,7 @@ func (uc *useCase) Execute(
 	return &dto.EnvironmentResponse{
 		ID:        environment.ID,
 		Name:      environment.Name,
+		Type:      environment.Type,
 		Status:    environment.Status,
 		ProjectID: environment.ProjectID,
 		CreatedAt: environment.CreatedAt,
@@ -141,6 +152,7 @@ func (uc *useCase) validateReq(req *dto.EnvironmentCreate) errors.Error {
 		req,
 		map[string]string{
 			"name.required":               "name is required",
+			"type.enums":                  "type must be one of the following: live, test",
 			"project_id.gt":               "project_id must be greater than 0",
 			"services[].id.gt":            "id must be greater than 0",
 			"project_id.required":         "project_id is required",
@@ -154,10 +166,12 @@ func NewUseCase(
 	validator validator.Validator,
 	projectRepo ProjectRepository,
 	environmentRepo EnvironmentRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:       validator,
 		projectRepo:     projectRepo,
 		environmentRepo: environmentRepo,
+		auditRepo:       auditRepo,
 	}
 }
@@ -0,0 +1,149 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/environment/create_alert/ports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/environment/create_alert/ports.go -destination=internal/app/environment/create_alert/mock/ports.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEnvironmentRepository is a mock of EnvironmentRepository interface.
+type MockEnvironmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnvironmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEnvironmentRepositoryMockRecorder is the mock recorder for MockEnvironmentRepository.
+type MockEnvironmentRepositoryMockRecorder struct {
+	mock *MockEnvironmentRepository
+}
+
+// NewMockEnvironmentRepository creates a new mock instance.
+func NewMockEnvironmentRepository(ctrl *gomock.Controller) *MockEnvironmentRepository {
+	mock := &MockEnvironmentRepository{ctrl: ctrl}
+	mock.recorder = &MockEnvironmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnvironmentRepository) EXPECT() *MockEnvironmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockEnvironmentRepository) Exists(ctx context.Context, id int) (bool, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockEnvironmentRepositoryMockRecorder) Exists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockEnvironmentRepository)(nil).Exists), ctx, id)
+}
+
+// ExistsServiceIn mocks base method.
+func (m *MockEnvironmentRepository) ExistsServiceIn(ctx context.Context, id, serviceID int) (bool, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsServiceIn", ctx, id, serviceID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// ExistsServiceIn indicates an expected call of ExistsServiceIn.
+func (mr *MockEnvironmentRepositoryMockRecorder) ExistsServiceIn(ctx, id, serviceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsServiceIn", reflect.TypeOf((*MockEnvironmentRepository)(nil).ExistsServiceIn), ctx, id, serviceID)
+}
+
+// MockQuotaAlertRepository is a mock of QuotaAlertRepository interface.
+type MockQuotaAlertRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuotaAlertRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockQuotaAlertRepositoryMockRecorder is the mock recorder for MockQuotaAlertRepository.
+type MockQuotaAlertRepositoryMockRecorder struct {
+	mock *MockQuotaAlertRepository
+}
+
+// NewMockQuotaAlertRepository creates a new mock instance.
+func NewMockQuotaAlertRepository(ctrl *gomock.Controller) *MockQuotaAlertRepository {
+	mock := &MockQuotaAlertRepository{ctrl: ctrl}
+	mock.recorder = &MockQuotaAlertRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuotaAlertRepository) EXPECT() *MockQuotaAlertRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockQuotaAlertRepository) Create(ctx context.Context, alert *entities.QuotaAlert) errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, alert)
+	ret0, _ := ret[0].(errors.Error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockQuotaAlertRepositoryMockRecorder) Create(ctx, alert any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockQuotaAlertRepository)(nil).Create), ctx, alert)
+}
+
+// MockAuditRepository is a mock of AuditRepository interface.
+type MockAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditRepositoryMockRecorder is the mock recorder for MockAuditRepository.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
+}
+
+// NewMockAuditRepository creates a new mock instance.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAuditRepository) Create(ctx context.Context, entry *entities.AuditEntry) errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entry)
+	ret0, _ := ret[0].(errors.Error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuditRepositoryMockRecorder) Create(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditRepository)(nil).Create), ctx, entry)
+}
@@ -0,0 +1,22 @@
+package createalert
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type EnvironmentRepository interface {
+	Exists(ctx context.Context, id int) (bool, errors.Error)
+	ExistsServiceIn(ctx context.Context, id, serviceID int) (bool, errors.Error)
+}
+
+type QuotaAlertRepository interface {
+	Create(ctx context.Context, alert *entities.QuotaAlert) errors.Error
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -0,0 +1,166 @@
+package createalert
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id, serviceID int, req *dto.QuotaAlertCreate) (*dto.QuotaAlertResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	environmentRepo EnvironmentRepository
+	quotaAlertRepo  QuotaAlertRepository
+	auditRepo       AuditRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, id, serviceID int, req *dto.QuotaAlertCreate,
+) (*dto.QuotaAlertResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "environment.create_alert")
+	defer span.End()
+
+	if err := uc.validateInput(id, serviceID, req); err != nil {
+		return nil, err
+	}
+
+	exists, err := uc.environmentRepo.Exists(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.NewEntityNotFound(
+			"Environment",
+			"environment not found",
+			map[string]any{"id": id},
+			nil,
+		)
+	}
+
+	exists, err = uc.environmentRepo.ExistsServiceIn(ctx, id, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.NewEntityNotFound(
+			"Service",
+			"service not assigned to environment",
+			map[string]any{"id": serviceID},
+			nil,
+		)
+	}
+
+	alert := entities.QuotaAlert{
+		EnvironmentID: id,
+		ServiceID:     serviceID,
+		Level:         req.Level,
+		Threshold:     req.Threshold,
+	}
+
+	if err := uc.quotaAlertRepo.Create(ctx, &alert); err != nil {
+		return nil, err
+	}
+
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionCreate,
+		enums.AuditEntityQuotaAlert,
+		alert.ID,
+		nil,
+		&alert,
+	)
+
+	return &dto.QuotaAlertResponse{
+		ID:            alert.ID,
+		EnvironmentID: alert.EnvironmentID,
+		ServiceID:     alert.ServiceID,
+		Level:         alert.Level,
+		Threshold:     alert.Threshold,
+		TriggeredAt:   alert.TriggeredAt,
+		CreatedAt:     alert.CreatedAt,
+	}, nil
+}
+
+func (uc *useCase) validateInput(
+	id, serviceID int, req *dto.QuotaAlertCreate,
+) errors.Error {
+	var err errors.Error
+
+	if errID := uc.validateID(id); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errID := uc.validateServiceID(serviceID); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errReq := uc.validateReq(req); errReq != nil {
+		err = errors.Aggregate(err, errReq)
+	}
+
+	return err
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "id must be greater than 0",
+			"required": "id is required",
+		},
+	)
+}
+
+func (uc *useCase) validateServiceID(serviceID int) errors.Error {
+	return uc.validator.ValidateVariable(
+		serviceID,
+		"service_id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "service_id must be greater than 0",
+			"required": "service_id is required",
+		},
+	)
+}
+
+func (uc *useCase) validateReq(req *dto.QuotaAlertCreate) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"level.required":     "level is required",
+			"level.enums":        "level must be one of the following: environment, project",
+			"threshold.required": "threshold is required",
+			"threshold.min":      "threshold must be at least 1",
+			"threshold.max":      "threshold must be at most 100",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	environmentRepo EnvironmentRepository,
+	quotaAlertRepo QuotaAlertRepository,
+	auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		environmentRepo: environmentRepo,
+		quotaAlertRepo:  quotaAlertRepo,
+		auditRepo:       auditRepo,
+	}
+}
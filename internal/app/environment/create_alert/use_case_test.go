@@ -0,0 +1,256 @@
+package createalert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/environment/create_alert/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type Suite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator       *mockvalidator.MockValidator
+	environmentRepo *mock.MockEnvironmentRepository
+	quotaAlertRepo  *mock.MockQuotaAlertRepository
+	auditRepo       *mock.MockAuditRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *Suite) SetupTest() {
+	time.Local = time.UTC
+
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.environmentRepo = mock.NewMockEnvironmentRepository(s.ctrl)
+	s.quotaAlertRepo = mock.NewMockQuotaAlertRepository(s.ctrl)
+	s.auditRepo = mock.NewMockAuditRepository(s.ctrl)
+
+	s.useCase = NewUseCase(
+		s.validator, s.environmentRepo, s.quotaAlertRepo, s.auditRepo,
+	)
+
+	s.ctx = context.Background()
+}
+
+func (s *Suite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *Suite) expectValidation(id, serviceID int, req *dto.QuotaAlertCreate) {
+	s.validator.EXPECT().
+		ValidateVariable(id, "id", gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.validator.EXPECT().
+		ValidateVariable(serviceID, "service_id", gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.validator.EXPECT().
+		ValidateStruct(req, gomock.Any()).
+		Return(nil).
+		Times(1)
+}
+
+func (s *Suite) TestSuccess() {
+	id, serviceID := 10, 3
+	req := &dto.QuotaAlertCreate{Level: enums.QuotaLevelEnvironment, Threshold: 75}
+	createdAt := time.Now()
+
+	s.expectValidation(id, serviceID, req)
+
+	s.environmentRepo.EXPECT().
+		Exists(s.ctx, id).
+		Return(true, nil).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		ExistsServiceIn(s.ctx, id, serviceID).
+		Return(true, nil).
+		Times(1)
+
+	s.quotaAlertRepo.EXPECT().
+		Create(s.ctx, gomock.AssignableToTypeOf(&entities.QuotaAlert{})).
+		DoAndReturn(func(_ context.Context, alert *entities.QuotaAlert) errors.Error {
+			s.Equal(id, alert.EnvironmentID)
+			s.Equal(serviceID, alert.ServiceID)
+			s.Equal(enums.QuotaLevelEnvironment, alert.Level)
+			s.Equal(75, alert.Threshold)
+
+			alert.ID = 1
+			alert.CreatedAt = createdAt
+			return nil
+		}).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *entities.AuditEntry) errors.Error {
+			s.Equal(enums.AuditActionCreate, entry.Action)
+			s.Equal(enums.AuditEntityQuotaAlert, entry.Entity)
+			s.Equal(1, entry.EntityID)
+			s.Nil(entry.Before)
+			s.Equal(75, entry.After["threshold"])
+			return nil
+		}).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, id, serviceID, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.Equal(1, resp.ID)
+	s.Equal(id, resp.EnvironmentID)
+	s.Equal(serviceID, resp.ServiceID)
+	s.Equal(enums.QuotaLevelEnvironment, resp.Level)
+	s.Equal(75, resp.Threshold)
+	s.True(resp.TriggeredAt.IsZero())
+	s.Equal(createdAt, resp.CreatedAt)
+}
+
+func (s *Suite) TestValidationError() {
+	id, serviceID := 10, 3
+	req := &dto.QuotaAlertCreate{Level: enums.QuotaLevelProject, Threshold: 101}
+
+	s.validator.EXPECT().
+		ValidateVariable(id, "id", gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.validator.EXPECT().
+		ValidateVariable(serviceID, "service_id", gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	validationErr := errors.NewAttributeValidationFailed(
+		"QuotaAlertCreate", "threshold", "threshold must be at most 100", nil,
+	)
+	s.validator.EXPECT().
+		ValidateStruct(req, gomock.Any()).
+		Return(validationErr).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		Exists(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.quotaAlertRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, id, serviceID, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func (s *Suite) TestEnvironmentNotFound() {
+	id, serviceID := 10, 3
+	req := &dto.QuotaAlertCreate{Level: enums.QuotaLevelEnvironment, Threshold: 90}
+
+	s.expectValidation(id, serviceID, req)
+
+	s.environmentRepo.EXPECT().
+		Exists(s.ctx, id).
+		Return(false, nil).
+		Times(1)
+
+	s.quotaAlertRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, id, serviceID, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+
+	s.Equal(errors.CodeNotFound, err.Code())
+}
+
+func (s *Suite) TestServiceNotAssigned() {
+	id, serviceID := 10, 3
+	req := &dto.QuotaAlertCreate{Level: enums.QuotaLevelProject, Threshold: 90}
+
+	s.expectValidation(id, serviceID, req)
+
+	s.environmentRepo.EXPECT().
+		Exists(s.ctx, id).
+		Return(true, nil).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		ExistsServiceIn(s.ctx, id, serviceID).
+		Return(false, nil).
+		Times(1)
+
+	s.quotaAlertRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, id, serviceID, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+
+	s.Equal(errors.CodeNotFound, err.Code())
+}
+
+func (s *Suite) TestAlreadyExists() {
+	id, serviceID := 10, 3
+	req := &dto.QuotaAlertCreate{Level: enums.QuotaLevelEnvironment, Threshold: 90}
+
+	s.expectValidation(id, serviceID, req)
+
+	s.environmentRepo.EXPECT().
+		Exists(s.ctx, id).
+		Return(true, nil).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		ExistsServiceIn(s.ctx, id, serviceID).
+		Return(true, nil).
+		Times(1)
+
+	repositoryErr := errors.NewEntityAlreadyExists(
+		"QuotaAlert", "quota alert already exists", nil, nil,
+	)
+	s.quotaAlertRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		Return(repositoryErr).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, id, serviceID, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+
+	s.Equal(repositoryErr, err)
+}
+
+func TestCreateAlertSuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
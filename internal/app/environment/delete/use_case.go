@@ -3,6 +3,9 @@ package delete
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -15,17 +18,36 @@ type useCase struct {
 	validator validator.Validator
 
 	environmentRepo EnvironmentRepository
+	auditRepo       AuditRepository
 }
 
 func (u *useCase) Execute(ctx context.Context, id int) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "environment.delete")
+	defer span.End()
+
 	if err := u.validateID(id); err != nil {
 		return err
 	}
 
+	before, err := u.environmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	if err := u.environmentRepo.Delete(ctx, id); err != nil {
 		return err
 	}
 
+	auditshared.Record(
+		ctx,
+		u.auditRepo,
+		enums.AuditActionDelete,
+		enums.AuditEntityEnvironment,
+		id,
+		before,
+		nil,
+	)
+
 	return nil
 }
 
@@ -43,9 +65,11 @@ func (uc *useCase) validateID(id int) errors.Error {
 func NewUseCase(
 	validator validator.Validator,
 	environmentRepo EnvironmentRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:       validator,
 		environmentRepo: environmentRepo,
+		auditRepo:       auditRepo,
 	}
 }
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/environment/delete_alert/ports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/environment/delete_alert/ports.go -destination=internal/app/environment/delete_alert/mock/ports.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQuotaAlertRepository is a mock of QuotaAlertRepository interface.
+type MockQuotaAlertRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuotaAlertRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockQuotaAlertRepositoryMockRecorder is the mock recorder for MockQuotaAlertRepository.
+type MockQuotaAlertRepositoryMockRecorder struct {
+	mock *MockQuotaAlertRepository
+}
+
+// NewMockQuotaAlertRepository creates a new mock instance.
+func NewMockQuotaAlertRepository(ctrl *gomock.Controller) *MockQuotaAlertRepository {
+	mock := &MockQuotaAlertRepository{ctrl: ctrl}
+	mock.recorder = &MockQuotaAlertRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuotaAlertRepository) EXPECT() *MockQuotaAlertRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockQuotaAlertRepository) Delete(ctx context.Context, environmentID, serviceID, id int) errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, environmentID, serviceID, id)
+	ret0, _ := ret[0].(errors.Error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockQuotaAlertRepositoryMockRecorder) Delete(ctx, environmentID, serviceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockQuotaAlertRepository)(nil).Delete), ctx, environmentID, serviceID, id)
+}
+
+// MockAuditRepository is a mock of AuditRepository interface.
+type MockAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditRepositoryMockRecorder is the mock recorder for MockAuditRepository.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
+}
+
+// NewMockAuditRepository creates a new mock instance.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAuditRepository) Create(ctx context.Context, entry *entities.AuditEntry) errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entry)
+	ret0, _ := ret[0].(errors.Error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuditRepositoryMockRecorder) Create(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditRepository)(nil).Create), ctx, entry)
+}
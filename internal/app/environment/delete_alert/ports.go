@@ -0,0 +1,16 @@
+package deletealert
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type QuotaAlertRepository interface {
+	Delete(ctx context.Context, environmentID, serviceID, id int) errors.Error
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -0,0 +1,131 @@
+package deletealert
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id, serviceID, alertID int) errors.Error
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	quotaAlertRepo QuotaAlertRepository
+	auditRepo      AuditRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, id, serviceID, alertID int,
+) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "environment.delete_alert")
+	defer span.End()
+
+	if err := uc.validateInput(id, serviceID, alertID); err != nil {
+		return err
+	}
+
+	err := uc.quotaAlertRepo.Delete(ctx, id, serviceID, alertID)
+	if err != nil {
+		if err.Code() == errors.CodeNotFound {
+			return errors.NewEntityNotFound(
+				"QuotaAlert",
+				"quota alert not found",
+				map[string]any{
+					"id":             alertID,
+					"environment_id": id,
+					"service_id":     serviceID,
+				},
+				err,
+			)
+		}
+		return err
+	}
+
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionDelete,
+		enums.AuditEntityQuotaAlert,
+		alertID,
+		map[string]any{
+			"environment_id": id,
+			"service_id":     serviceID,
+		},
+		nil,
+	)
+
+	return nil
+}
+
+func (uc *useCase) validateInput(id, serviceID, alertID int) errors.Error {
+	var err errors.Error
+
+	if errID := uc.validateID(id); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errID := uc.validateServiceID(serviceID); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errID := uc.validateAlertID(alertID); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	return err
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "id must be greater than 0",
+			"required": "id is required",
+		},
+	)
+}
+
+func (uc *useCase) validateServiceID(serviceID int) errors.Error {
+	return uc.validator.ValidateVariable(
+		serviceID,
+		"service_id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "service_id must be greater than 0",
+			"required": "service_id is required",
+		},
+	)
+}
+
+func (uc *useCase) validateAlertID(alertID int) errors.Error {
+	return uc.validator.ValidateVariable(
+		alertID,
+		"alert_id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "alert_id must be greater than 0",
+			"required": "alert_id is required",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	quotaAlertRepo QuotaAlertRepository,
+	auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		validator:      validator,
+		quotaAlertRepo: quotaAlertRepo,
+		auditRepo:      auditRepo,
+	}
+}
@@ -3,6 +3,7 @@ package get
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
@@ -19,6 +20,9 @@ type useCase struct {
 }
 
 func (uc *useCase) Execute(ctx context.Context, id int) (*dto.EnvironmentResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "environment.get")
+	defer span.End()
+
 	if err := uc.validateID(id); err != nil {
 		return nil, err
 	}
@@ -53,6 +57,7 @@ func (uc *useCase) Execute(ctx context.Context, id int) (*dto.EnvironmentRespons
 	return &dto.EnvironmentResponse{
 		ID:        environment.ID,
 		Name:      environment.Name,
+		Type:      environment.Type,
 		Status:    environment.Status,
 		ProjectID: environment.ProjectID,
 		CreatedAt: environment.CreatedAt,
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/environment/list_alerts/ports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/environment/list_alerts/ports.go -destination=internal/app/environment/list_alerts/mock/ports.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEnvironmentRepository is a mock of EnvironmentRepository interface.
+type MockEnvironmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnvironmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEnvironmentRepositoryMockRecorder is the mock recorder for MockEnvironmentRepository.
+type MockEnvironmentRepositoryMockRecorder struct {
+	mock *MockEnvironmentRepository
+}
+
+// NewMockEnvironmentRepository creates a new mock instance.
+func NewMockEnvironmentRepository(ctrl *gomock.Controller) *MockEnvironmentRepository {
+	mock := &MockEnvironmentRepository{ctrl: ctrl}
+	mock.recorder = &MockEnvironmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnvironmentRepository) EXPECT() *MockEnvironmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockEnvironmentRepository) Exists(ctx context.Context, id int) (bool, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockEnvironmentRepositoryMockRecorder) Exists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockEnvironmentRepository)(nil).Exists), ctx, id)
+}
+
+// ExistsServiceIn mocks base method.
+func (m *MockEnvironmentRepository) ExistsServiceIn(ctx context.Context, id, serviceID int) (bool, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsServiceIn", ctx, id, serviceID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// ExistsServiceIn indicates an expected call of ExistsServiceIn.
+func (mr *MockEnvironmentRepositoryMockRecorder) ExistsServiceIn(ctx, id, serviceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsServiceIn", reflect.TypeOf((*MockEnvironmentRepository)(nil).ExistsServiceIn), ctx, id, serviceID)
+}
+
+// MockQuotaAlertRepository is a mock of QuotaAlertRepository interface.
+type MockQuotaAlertRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuotaAlertRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockQuotaAlertRepositoryMockRecorder is the mock recorder for MockQuotaAlertRepository.
+type MockQuotaAlertRepositoryMockRecorder struct {
+	mock *MockQuotaAlertRepository
+}
+
+// NewMockQuotaAlertRepository creates a new mock instance.
+func NewMockQuotaAlertRepository(ctrl *gomock.Controller) *MockQuotaAlertRepository {
+	mock := &MockQuotaAlertRepository{ctrl: ctrl}
+	mock.recorder = &MockQuotaAlertRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuotaAlertRepository) EXPECT() *MockQuotaAlertRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByEnvironmentService mocks base method.
+func (m *MockQuotaAlertRepository) ListByEnvironmentService(ctx context.Context, environmentID, serviceID int) ([]*entities.QuotaAlert, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEnvironmentService", ctx, environmentID, serviceID)
+	ret0, _ := ret[0].([]*entities.QuotaAlert)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// ListByEnvironmentService indicates an expected call of ListByEnvironmentService.
+func (mr *MockQuotaAlertRepositoryMockRecorder) ListByEnvironmentService(ctx, environmentID, serviceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEnvironmentService", reflect.TypeOf((*MockQuotaAlertRepository)(nil).ListByEnvironmentService), ctx, environmentID, serviceID)
+}
@@ -0,0 +1,17 @@
+package listalerts
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type EnvironmentRepository interface {
+	Exists(ctx context.Context, id int) (bool, errors.Error)
+	ExistsServiceIn(ctx context.Context, id, serviceID int) (bool, errors.Error)
+}
+
+type QuotaAlertRepository interface {
+	ListByEnvironmentService(ctx context.Context, environmentID, serviceID int) ([]*entities.QuotaAlert, errors.Error)
+}
@@ -0,0 +1,130 @@
+package listalerts
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id, serviceID int) ([]*dto.QuotaAlertResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	environmentRepo EnvironmentRepository
+	quotaAlertRepo  QuotaAlertRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, id, serviceID int,
+) ([]*dto.QuotaAlertResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "environment.list_alerts")
+	defer span.End()
+
+	if err := uc.validateInput(id, serviceID); err != nil {
+		return nil, err
+	}
+
+	exists, err := uc.environmentRepo.Exists(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.NewEntityNotFound(
+			"Environment",
+			"environment not found",
+			map[string]any{"id": id},
+			nil,
+		)
+	}
+
+	exists, err = uc.environmentRepo.ExistsServiceIn(ctx, id, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.NewEntityNotFound(
+			"Service",
+			"service not assigned to environment",
+			map[string]any{"id": serviceID},
+			nil,
+		)
+	}
+
+	alerts, err := uc.quotaAlertRepo.ListByEnvironmentService(ctx, id, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	alertResponses := make([]*dto.QuotaAlertResponse, len(alerts))
+	for i, alert := range alerts {
+		alertResponses[i] = &dto.QuotaAlertResponse{
+			ID:            alert.ID,
+			EnvironmentID: alert.EnvironmentID,
+			ServiceID:     alert.ServiceID,
+			Level:         alert.Level,
+			Threshold:     alert.Threshold,
+			TriggeredAt:   alert.TriggeredAt,
+			CreatedAt:     alert.CreatedAt,
+		}
+	}
+
+	return alertResponses, nil
+}
+
+func (uc *useCase) validateInput(id, serviceID int) errors.Error {
+	var err errors.Error
+
+	if errID := uc.validateID(id); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errID := uc.validateServiceID(serviceID); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	return err
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "id must be greater than 0",
+			"required": "id is required",
+		},
+	)
+}
+
+func (uc *useCase) validateServiceID(serviceID int) errors.Error {
+	return uc.validator.ValidateVariable(
+		serviceID,
+		"service_id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "service_id must be greater than 0",
+			"required": "service_id is required",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	environmentRepo EnvironmentRepository,
+	quotaAlertRepo QuotaAlertRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		environmentRepo: environmentRepo,
+		quotaAlertRepo:  quotaAlertRepo,
+	}
+}
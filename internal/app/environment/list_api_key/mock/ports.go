@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
 	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
 	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
 	gomock "go.uber.org/mock/gomock"
@@ -81,17 +82,32 @@ func (m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountByEnvironment mocks base method.
+func (m *MockAPIKeyRepository) CountByEnvironment(ctx context.Context, environmentID int) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByEnvironment", ctx, environmentID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// CountByEnvironment indicates an expected call of CountByEnvironment.
+func (mr *MockAPIKeyRepositoryMockRecorder) CountByEnvironment(ctx, environmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByEnvironment", reflect.TypeOf((*MockAPIKeyRepository)(nil).CountByEnvironment), ctx, environmentID)
+}
+
 // ListByEnvironment mocks base method.
-func (m *MockAPIKeyRepository) ListByEnvironment(ctx context.Context, environmentID int) ([]*entities.APIKey, errors.Error) {
+func (m *MockAPIKeyRepository) ListByEnvironment(ctx context.Context, environmentID int, page *dto.Pagination) ([]*entities.APIKey, errors.Error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByEnvironment", ctx, environmentID)
+	ret := m.ctrl.Call(m, "ListByEnvironment", ctx, environmentID, page)
 	ret0, _ := ret[0].([]*entities.APIKey)
 	ret1, _ := ret[1].(errors.Error)
 	return ret0, ret1
 }
 
 // ListByEnvironment indicates an expected call of ListByEnvironment.
-func (mr *MockAPIKeyRepositoryMockRecorder) ListByEnvironment(ctx, environmentID any) *gomock.Call {
+func (mr *MockAPIKeyRepositoryMockRecorder) ListByEnvironment(ctx, environmentID, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEnvironment", reflect.TypeOf((*MockAPIKeyRepository)(nil).ListByEnvironment), ctx, environmentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEnvironment", reflect.TypeOf((*MockAPIKeyRepository)(nil).ListByEnvironment), ctx, environmentID, page)
 }
@@ -3,6 +3,7 @@ package listapikey
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
@@ -12,5 +13,6 @@ type EnvironmentRepository interface {
 }
 
 type APIKeyRepository interface {
-	ListByEnvironment(ctx context.Context, environmentID int) ([]*entities.APIKey, errors.Error)
+	ListByEnvironment(ctx context.Context, environmentID int, page *dto.Pagination) ([]*entities.APIKey, errors.Error)
+	CountByEnvironment(ctx context.Context, environmentID int) (int, errors.Error)
 }
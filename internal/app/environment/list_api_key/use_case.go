@@ -2,14 +2,17 @@ package listapikey
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
 
 type UseCase interface {
-	Execute(ctx context.Context, id int) ([]*dto.APIKeyResponse, errors.Error)
+	Execute(ctx context.Context, id int, page *dto.Pagination) (*dto.Page[*dto.APIKeyResponse], errors.Error)
 }
 
 type useCase struct {
@@ -20,9 +23,12 @@ type useCase struct {
 }
 
 func (uc *useCase) Execute(
-	ctx context.Context, id int,
-) ([]*dto.APIKeyResponse, errors.Error) {
-	if err := uc.validateID(id); err != nil {
+	ctx context.Context, id int, page *dto.Pagination,
+) (*dto.Page[*dto.APIKeyResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "environment.list_api_key")
+	defer span.End()
+
+	if err := uc.validateInput(id, page); err != nil {
 		return nil, err
 	}
 
@@ -40,13 +46,33 @@ func (uc *useCase) Execute(
 		)
 	}
 
-	apiKeys, err := uc.apiKeyRepo.ListByEnvironment(ctx, id)
+	apiKeys, err := uc.apiKeyRepo.ListByEnvironment(ctx, id, page)
 	if err != nil {
 		return nil, err
 	}
 
+	apiKeys, nextCursor := dto.TrimPage(
+		apiKeys,
+		page,
+		func(apiKey *entities.APIKey) *dto.Cursor {
+			return &dto.Cursor{
+				Time: apiKey.CreatedAt,
+				ID:   strconv.Itoa(apiKey.ID),
+			}
+		},
+	)
+
 	apiKeysResponses := make([]*dto.APIKeyResponse, len(apiKeys))
 	for i, apiKey := range apiKeys {
+		var rateLimit *dto.APIKeyRateLimit
+		if apiKey.RateLimit != nil {
+			rateLimit = &dto.APIKeyRateLimit{
+				Requests: apiKey.RateLimit.Requests,
+				Period:   apiKey.RateLimit.Period,
+				Burst:    apiKey.RateLimit.Burst,
+			}
+		}
+
 		apiKeysResponses[i] = &dto.APIKeyResponse{
 			ID:            apiKey.ID,
 			Key:           apiKey.KeySummary(),
@@ -54,11 +80,41 @@ func (uc *useCase) Execute(
 			LastUsed:      apiKey.LastUsed,
 			ExpiresAt:     apiKey.ExpiresAt,
 			EnvironmentID: apiKey.EnvironmentID,
+			RotatedFromID: apiKey.RotatedFromID,
+			GraceEndsAt:   apiKey.GraceEndsAt,
+			RateLimit:     rateLimit,
 			CreatedAt:     apiKey.CreatedAt,
 		}
 	}
 
-	return apiKeysResponses, nil
+	resp := &dto.Page[*dto.APIKeyResponse]{
+		Items:      apiKeysResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.apiKeyRepo.CountByEnvironment(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validateInput(id int, page *dto.Pagination) errors.Error {
+	var err errors.Error
+
+	if errID := uc.validateID(id); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errPage := uc.validatePage(page); errPage != nil {
+		err = errors.Aggregate(err, errPage)
+	}
+
+	return err
 }
 
 func (uc *useCase) validateID(id int) errors.Error {
@@ -73,6 +129,17 @@ func (uc *useCase) validateID(id int) errors.Error {
 	)
 }
 
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
 func NewUseCase(
 	validator validator.Validator,
 	apiKeyRepo APIKeyRepository,
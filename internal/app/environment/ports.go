@@ -3,8 +3,11 @@ package environment
 import (
 	assignservice "github.com/MAD-py/pandora-core/internal/app/environment/assign_service"
 	"github.com/MAD-py/pandora-core/internal/app/environment/create"
+	createalert "github.com/MAD-py/pandora-core/internal/app/environment/create_alert"
 	"github.com/MAD-py/pandora-core/internal/app/environment/delete"
+	deletealert "github.com/MAD-py/pandora-core/internal/app/environment/delete_alert"
 	"github.com/MAD-py/pandora-core/internal/app/environment/get"
+	listalerts "github.com/MAD-py/pandora-core/internal/app/environment/list_alerts"
 	listapikey "github.com/MAD-py/pandora-core/internal/app/environment/list_api_key"
 	removeservice "github.com/MAD-py/pandora-core/internal/app/environment/remove_service"
 	resetrequests "github.com/MAD-py/pandora-core/internal/app/environment/reset_requests"
@@ -15,19 +18,38 @@ import (
 // ... Assign Service Use Case ...
 
 type EnvironmentAssignServiceRepository = assignservice.EnvironmentRepository
+type AuditAssignServiceRepository = assignservice.AuditRepository
 
 // ... Create Use Case ...
 
 type EnvironmentCreateRepository = create.EnvironmentRepository
 type ProjectQuotaRepository = create.ProjectRepository
+type AuditCreateRepository = create.AuditRepository
+
+// ... Create Alert Use Case ...
+
+type EnvironmentCreateAlertRepository = createalert.EnvironmentRepository
+type QuotaAlertCreateRepository = createalert.QuotaAlertRepository
+type AuditCreateAlertRepository = createalert.AuditRepository
 
 // ... Delete Use Case ...
 type EnvironmentDeleteRepository = delete.EnvironmentRepository
+type AuditDeleteRepository = delete.AuditRepository
+
+// ... Delete Alert Use Case ...
+
+type QuotaAlertDeleteRepository = deletealert.QuotaAlertRepository
+type AuditDeleteAlertRepository = deletealert.AuditRepository
 
 // ... Get Use Case ...
 
 type EnvironmentGetRepository = get.EnvironmentRepository
 
+// ... List Alerts Use Case ...
+
+type EnvironmentListAlertsRepository = listalerts.EnvironmentRepository
+type QuotaAlertListRepository = listalerts.QuotaAlertRepository
+
 // ... List API Key Use Case ...
 
 type EnvironmentListAPIKeyRepository = listapikey.EnvironmentRepository
@@ -36,15 +58,19 @@ type APIKeyListByEnvironmentRepository = listapikey.APIKeyRepository
 // ... Remove Service Use Case ...
 
 type EnvironmentRemoveServiceRepository = removeservice.EnvironmentRepository
+type AuditRemoveServiceRepository = removeservice.AuditRepository
 
 // ... Reset Request Use Case ...
 
 type EnvironmentResetRequestRepository = resetrequests.EnvironmentRepository
+type AuditResetRequestRepository = resetrequests.AuditRepository
 
 // ... Update Use Case ...
 
 type EnvironmentUpdateRepository = update.EnvironmentRepository
+type AuditUpdateRepository = update.AuditRepository
 
 // ... Update Service Use Case ...
 
 type EnvironmentUpdateServiceRepository = updateservice.EnvironmentRepository
+type AuditUpdateServiceRepository = updateservice.AuditRepository
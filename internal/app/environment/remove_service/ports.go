@@ -3,6 +3,7 @@ package removeservice
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
@@ -10,3 +11,7 @@ type EnvironmentRepository interface {
 	Exists(ctx context.Context, id int) (bool, errors.Error)
 	RemoveService(ctx context.Context, id, serviceID int) (int64, errors.Error)
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -3,6 +3,9 @@ package removeservice
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -15,9 +18,13 @@ type useCase struct {
 	validator validator.Validator
 
 	environmentRepo EnvironmentRepository
+	auditRepo       AuditRepository
 }
 
 func (uc *useCase) Execute(ctx context.Context, id, serviceID int) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "environment.remove_service")
+	defer span.End()
+
 	if err := uc.validateInput(id, serviceID); err != nil {
 		return err
 	}
@@ -50,6 +57,16 @@ func (uc *useCase) Execute(ctx context.Context, id, serviceID int) errors.Error
 		)
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionRemoveService,
+		enums.AuditEntityEnvironment,
+		id,
+		map[string]any{"id": serviceID},
+		nil,
+	)
+
 	return nil
 }
 
@@ -94,9 +111,11 @@ func (uc *useCase) validateServiceID(serviceID int) errors.Error {
 func NewUseCase(
 	validator validator.Validator,
 	environmentRepo EnvironmentRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:       validator,
 		environmentRepo: environmentRepo,
+		auditRepo:       auditRepo,
 	}
 }
@@ -3,6 +3,7 @@ package resetrequests
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
@@ -11,3 +12,7 @@ type EnvironmentRepository interface {
 	Exists(ctx context.Context, id int) (bool, errors.Error)
 	ResetAvailableRequests(ctx context.Context, id, serviceID int) (*entities.EnvironmentService, errors.Error)
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
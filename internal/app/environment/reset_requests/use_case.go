@@ -3,7 +3,10 @@ package resetrequests
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -16,11 +19,15 @@ type useCase struct {
 	validator validator.Validator
 
 	environmentRepo EnvironmentRepository
+	auditRepo       AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, id, serviceID int,
 ) (*dto.EnvironmentServiceResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "environment.reset_requests")
+	defer span.End()
+
 	if err := uc.validateInput(id, serviceID); err != nil {
 		return nil, err
 	}
@@ -52,6 +59,16 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionResetRequests,
+		enums.AuditEntityEnvironment,
+		id,
+		nil,
+		service,
+	)
+
 	return &dto.EnvironmentServiceResponse{
 		ID:               service.ID,
 		Name:             service.Name,
@@ -101,10 +118,13 @@ func (uc *useCase) validateServiceID(serviceID int) errors.Error {
 }
 
 func NewUseCase(
-	validator validator.Validator, environmentRepo EnvironmentRepository,
+	validator validator.Validator,
+	environmentRepo EnvironmentRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:       validator,
 		environmentRepo: environmentRepo,
+		auditRepo:       auditRepo,
 	}
 }
@@ -3,7 +3,10 @@ package update
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -16,20 +19,39 @@ type useCase struct {
 	validator validator.Validator
 
 	environmentRepo EnvironmentRepository
+	auditRepo       AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, id int, req *dto.EnvironmentUpdate,
 ) (*dto.EnvironmentResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "environment.update")
+	defer span.End()
+
 	if err := uc.validatorInput(id, req); err != nil {
 		return nil, err
 	}
 
+	before, err := uc.environmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
 	environment, err := uc.environmentRepo.Update(ctx, id, req)
 	if err != nil {
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionUpdate,
+		enums.AuditEntityEnvironment,
+		environment.ID,
+		before,
+		environment,
+	)
+
 	serviceResp := make(
 		[]*dto.EnvironmentServiceResponse, len(environment.Services),
 	)
@@ -47,6 +69,7 @@ func (uc *useCase) Execute(
 	return &dto.EnvironmentResponse{
 		ID:        environment.ID,
 		Name:      environment.Name,
+		Type:      environment.Type,
 		Status:    environment.Status,
 		ProjectID: environment.ProjectID,
 		CreatedAt: environment.CreatedAt,
@@ -85,10 +108,13 @@ func (uc *useCase) validateReq(req *dto.EnvironmentUpdate) errors.Error {
 }
 
 func NewUseCase(
-	validator validator.Validator, environmentRepo EnvironmentRepository,
+	validator validator.Validator,
+	environmentRepo EnvironmentRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:       validator,
 		environmentRepo: environmentRepo,
+		auditRepo:       auditRepo,
 	}
 }
@@ -3,6 +3,7 @@ package updateservice
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
@@ -14,3 +15,7 @@ type EnvironmentRepository interface {
 	GetServiceByID(ctx context.Context, id, serviceID int) (*entities.EnvironmentService, errors.Error)
 	GetProjectServiceQuotaUsage(ctx context.Context, id, serviceID int) (*dto.QuotaUsage, errors.Error)
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -3,7 +3,10 @@ package updateservice
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -16,11 +19,15 @@ type useCase struct {
 	validator validator.Validator
 
 	environmentRepo EnvironmentRepository
+	auditRepo       AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, id, serviceID int, req *dto.EnvironmentServiceUpdateInput,
 ) (*dto.EnvironmentServiceResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "environment.update_service")
+	defer span.End()
+
 	if err := uc.validateInput(id, serviceID, req); err != nil {
 		return nil, err
 	}
@@ -69,17 +76,15 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
-	if quota.MaxAllowed != -1 {
-		if req.MaxRequests == -1 {
-			return nil, errors.NewAttributeValidationFailed(
-				"EnvironmentServiceUpdateInput",
-				"max_requests",
-				"max_requests cannot be -1 (unlimited) if the project has a defined limit",
-				nil,
-			)
+	// Unlimited environment services only draw from the project pool, so
+	// they do not count towards the requests allocated in the project.
+	if quota.MaxAllowed != -1 && req.MaxRequests != -1 {
+		allocated := quota.CurrentAllocated + req.MaxRequests
+		if service.MaxRequests != -1 {
+			allocated -= service.MaxRequests
 		}
 
-		if quota.CurrentAllocated-service.MaxRequests+req.MaxRequests > quota.MaxAllowed {
+		if allocated > quota.MaxAllowed {
 			return nil, errors.NewAttributeValidationFailed(
 				"EnvironmentCreate",
 				"max_requests",
@@ -96,6 +101,7 @@ func (uc *useCase) Execute(
 		update.AvailableRequest = service.AvailableRequest
 	}
 
+	before := service
 	service, err = uc.environmentRepo.UpdateService(
 		ctx, id, serviceID, update,
 	)
@@ -103,6 +109,16 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionUpdateService,
+		enums.AuditEntityEnvironment,
+		id,
+		before,
+		service,
+	)
+
 	return &dto.EnvironmentServiceResponse{
 		ID:               service.ID,
 		Name:             service.Name,
@@ -167,10 +183,13 @@ func (uc *useCase) validateReq(req *dto.EnvironmentServiceUpdateInput) errors.Er
 }
 
 func NewUseCase(
-	validator validator.Validator, environmentRepo EnvironmentRepository,
+	validator validator.Validator,
+	environmentRepo EnvironmentRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:       validator,
 		environmentRepo: environmentRepo,
+		auditRepo:       auditRepo,
 	}
 }
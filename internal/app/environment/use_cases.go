@@ -3,8 +3,11 @@ package environment
 import (
 	assignservice "github.com/MAD-py/pandora-core/internal/app/environment/assign_service"
 	"github.com/MAD-py/pandora-core/internal/app/environment/create"
+	createalert "github.com/MAD-py/pandora-core/internal/app/environment/create_alert"
 	"github.com/MAD-py/pandora-core/internal/app/environment/delete"
+	deletealert "github.com/MAD-py/pandora-core/internal/app/environment/delete_alert"
 	"github.com/MAD-py/pandora-core/internal/app/environment/get"
+	listalerts "github.com/MAD-py/pandora-core/internal/app/environment/list_alerts"
 	listapikey "github.com/MAD-py/pandora-core/internal/app/environment/list_api_key"
 	removeservice "github.com/MAD-py/pandora-core/internal/app/environment/remove_service"
 	resetrequests "github.com/MAD-py/pandora-core/internal/app/environment/reset_requests"
@@ -20,8 +23,9 @@ type AssignServiceUseCase = assignservice.UseCase
 func NewAssignServiceUseCase(
 	validator validator.Validator,
 	environmentRepo assignservice.EnvironmentRepository,
+	auditRepo AuditAssignServiceRepository,
 ) AssignServiceUseCase {
-	return assignservice.NewUseCase(validator, environmentRepo)
+	return assignservice.NewUseCase(validator, environmentRepo, auditRepo)
 }
 
 // ... Create Use Case ...
@@ -32,8 +36,27 @@ func NewCreateUseCase(
 	validator validator.Validator,
 	projectRepo ProjectQuotaRepository,
 	environmentRepo EnvironmentCreateRepository,
+	auditRepo AuditCreateRepository,
 ) CreateUseCase {
-	return create.NewUseCase(validator, projectRepo, environmentRepo)
+	return create.NewUseCase(validator, projectRepo, environmentRepo, auditRepo)
+}
+
+// ... Create Alert Use Case ...
+
+type CreateAlertUseCase = createalert.UseCase
+
+func NewCreateAlertUseCase(
+	validator validator.Validator,
+	environmentRepo EnvironmentCreateAlertRepository,
+	quotaAlertRepo QuotaAlertCreateRepository,
+	auditRepo AuditCreateAlertRepository,
+) CreateAlertUseCase {
+	return createalert.NewUseCase(
+		validator,
+		environmentRepo,
+		quotaAlertRepo,
+		auditRepo,
+	)
 }
 
 // ... Delete Use Case ...
@@ -43,8 +66,21 @@ type DeleteUseCase = delete.UseCase
 func NewDeleteUseCase(
 	validator validator.Validator,
 	environmentRepo EnvironmentDeleteRepository,
+	auditRepo AuditDeleteRepository,
 ) DeleteUseCase {
-	return delete.NewUseCase(validator, environmentRepo)
+	return delete.NewUseCase(validator, environmentRepo, auditRepo)
+}
+
+// ... Delete Alert Use Case ...
+
+type DeleteAlertUseCase = deletealert.UseCase
+
+func NewDeleteAlertUseCase(
+	validator validator.Validator,
+	quotaAlertRepo QuotaAlertDeleteRepository,
+	auditRepo AuditDeleteAlertRepository,
+) DeleteAlertUseCase {
+	return deletealert.NewUseCase(validator, quotaAlertRepo, auditRepo)
 }
 
 // ... Get Use Case ...
@@ -58,6 +94,18 @@ func NewGetUseCase(
 	return get.NewUseCase(validator, environmentRepo)
 }
 
+// ... List Alerts Use Case ...
+
+type ListAlertsUseCase = listalerts.UseCase
+
+func NewListAlertsUseCase(
+	validator validator.Validator,
+	environmentRepo EnvironmentListAlertsRepository,
+	quotaAlertRepo QuotaAlertListRepository,
+) ListAlertsUseCase {
+	return listalerts.NewUseCase(validator, environmentRepo, quotaAlertRepo)
+}
+
 // ... List API Key Use Case ...
 
 type ListAPIKeyUseCase = listapikey.UseCase
@@ -77,8 +125,9 @@ type RemoveServiceUseCase = removeservice.UseCase
 func NewRemoveServiceUseCase(
 	validator validator.Validator,
 	environmentRepo EnvironmentRemoveServiceRepository,
+	auditRepo AuditRemoveServiceRepository,
 ) RemoveServiceUseCase {
-	return removeservice.NewUseCase(validator, environmentRepo)
+	return removeservice.NewUseCase(validator, environmentRepo, auditRepo)
 }
 
 // ... Reset Request Use Case ...
@@ -88,8 +137,9 @@ type ResetRequestUseCase = resetrequests.UseCase
 func NewResetRequestUseCase(
 	validator validator.Validator,
 	environmentRepo EnvironmentResetRequestRepository,
+	auditRepo AuditResetRequestRepository,
 ) ResetRequestUseCase {
-	return resetrequests.NewUseCase(validator, environmentRepo)
+	return resetrequests.NewUseCase(validator, environmentRepo, auditRepo)
 }
 
 // ... Update Use Case ...
@@ -99,8 +149,9 @@ type UpdateUseCase = update.UseCase
 func NewUpdateUseCase(
 	validator validator.Validator,
 	environmentRepo EnvironmentUpdateRepository,
+	auditRepo AuditUpdateRepository,
 ) UpdateUseCase {
-	return update.NewUseCase(validator, environmentRepo)
+	return update.NewUseCase(validator, environmentRepo, auditRepo)
 }
 
 // ... Update Service Use Case ...
@@ -110,6 +161,7 @@ type UpdateServiceUseCase = updateservice.UseCase
 func NewUpdateServiceUseCase(
 	validator validator.Validator,
 	environmentRepo EnvironmentUpdateServiceRepository,
+	auditRepo AuditUpdateServiceRepository,
 ) UpdateServiceUseCase {
-	return updateservice.NewUseCase(validator, environmentRepo)
+	return updateservice.NewUseCase(validator, environmentRepo, auditRepo)
 }
@@ -0,0 +1,31 @@
+package createapikey
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/portal/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ClientRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Client, errors.Error)
+}
+
+type ProjectRepository interface {
+	shared.TenantProjectRepository
+}
+
+type EnvironmentRepository interface {
+	shared.TenantEnvironmentRepository
+}
+
+type APIKeyRepository interface {
+	Exists(ctx context.Context, key string) (bool, errors.Error)
+	CreateWithinLimit(ctx context.Context, apiKey *entities.APIKey, limit int) errors.Error
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
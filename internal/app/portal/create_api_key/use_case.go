@@ -0,0 +1,162 @@
+package createapikey
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/portal/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, clientID int, req *dto.APIKeyCreate) (*dto.APIKeyResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	keyPrefix string
+
+	clientRepo      ClientRepository
+	projectRepo     ProjectRepository
+	environmentRepo EnvironmentRepository
+	apiKeyRepo      APIKeyRepository
+	auditRepo       AuditRepository
+}
+
+// Execute creates an API key in an environment of the client, as long as
+// the environment has fewer usable keys than the API key limit of the
+// client. The key is returned in full, as the client cannot reveal it
+// later.
+func (uc *useCase) Execute(
+	ctx context.Context, clientID int, req *dto.APIKeyCreate,
+) (*dto.APIKeyResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "portal.create_api_key")
+	defer span.End()
+
+	if err := uc.validateReq(req); err != nil {
+		return nil, err
+	}
+
+	environment, err := shared.EnvironmentOf(
+		ctx, uc.environmentRepo, uc.projectRepo, clientID, req.EnvironmentID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := uc.clientRepo.GetByID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if client.APIKeyLimit == 0 {
+		return nil, errors.NewForbidden(
+			"Creating API keys is not allowed for this client", nil,
+		)
+	}
+
+	apiKey := &entities.APIKey{
+		Status:        enums.APIKeyStatusEnabled,
+		ExpiresAt:     req.ExpiresAt,
+		EnvironmentID: environment.ID,
+	}
+
+	if req.RateLimit != nil {
+		apiKey.RateLimit = entities.NewAPIKeyRateLimit(
+			req.RateLimit.Requests, req.RateLimit.Period, req.RateLimit.Burst,
+		)
+	}
+
+	for {
+		err := apiKey.GenerateKey(uc.keyPrefix, environment.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		exists, err := uc.apiKeyRepo.Exists(ctx, apiKey.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			break
+		}
+	}
+
+	// The limit is checked with the insert, so concurrent requests cannot
+	// exceed it together.
+	err = uc.apiKeyRepo.CreateWithinLimit(ctx, apiKey, client.APIKeyLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionCreate,
+		enums.AuditEntityAPIKey,
+		apiKey.ID,
+		nil,
+		apiKey,
+	)
+
+	var rateLimit *dto.APIKeyRateLimit
+	if apiKey.RateLimit != nil {
+		rateLimit = &dto.APIKeyRateLimit{
+			Requests: apiKey.RateLimit.Requests,
+			Period:   apiKey.RateLimit.Period,
+			Burst:    apiKey.RateLimit.Burst,
+		}
+	}
+
+	return &dto.APIKeyResponse{
+		ID:            apiKey.ID,
+		Key:           apiKey.Key,
+		Status:        apiKey.Status,
+		LastUsed:      apiKey.LastUsed,
+		ExpiresAt:     apiKey.ExpiresAt,
+		EnvironmentID: apiKey.EnvironmentID,
+		RateLimit:     rateLimit,
+		CreatedAt:     apiKey.CreatedAt,
+	}, nil
+}
+
+func (uc *useCase) validateReq(req *dto.APIKeyCreate) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"expires_at.utc":          "expires_at must be in UTC format",
+			"environment_id.gt":       "environment_id must be greater than 0",
+			"environment_id.required": "environment_id is required",
+			"rate_limit.burst.gte":    "rate_limit.burst must be greater than or equal to 0",
+			"rate_limit.period.enums": "rate_limit.period must be one of the following: second, minute",
+			"rate_limit.requests.gte": "rate_limit.requests must be greater than or equal to 0",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	keyPrefix string,
+	clientRepo ClientRepository,
+	projectRepo ProjectRepository,
+	environmentRepo EnvironmentRepository,
+	apiKeyRepo APIKeyRepository,
+	auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		keyPrefix:       keyPrefix,
+		clientRepo:      clientRepo,
+		projectRepo:     projectRepo,
+		environmentRepo: environmentRepo,
+		apiKeyRepo:      apiKeyRepo,
+		auditRepo:       auditRepo,
+	}
+}
@@ -0,0 +1,228 @@
+package createapikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/portal/create_api_key/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type Suite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator       *mockvalidator.MockValidator
+	clientRepo      *mock.MockClientRepository
+	projectRepo     *mock.MockProjectRepository
+	environmentRepo *mock.MockEnvironmentRepository
+	apiKeyRepo      *mock.MockAPIKeyRepository
+	auditRepo       *mock.MockAuditRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *Suite) SetupTest() {
+	time.Local = time.UTC
+
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.clientRepo = mock.NewMockClientRepository(s.ctrl)
+	s.projectRepo = mock.NewMockProjectRepository(s.ctrl)
+	s.environmentRepo = mock.NewMockEnvironmentRepository(s.ctrl)
+	s.apiKeyRepo = mock.NewMockAPIKeyRepository(s.ctrl)
+	s.auditRepo = mock.NewMockAuditRepository(s.ctrl)
+
+	s.useCase = NewUseCase(
+		s.validator,
+		"pdr",
+		s.clientRepo,
+		s.projectRepo,
+		s.environmentRepo,
+		s.apiKeyRepo,
+		s.auditRepo,
+	)
+
+	s.ctx = context.Background()
+}
+
+func (s *Suite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *Suite) expectOwnEnvironment(req *dto.APIKeyCreate, clientID int) {
+	s.validator.EXPECT().
+		ValidateStruct(req, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		GetByID(s.ctx, req.EnvironmentID).
+		Return(
+			&entities.Environment{
+				ID:        req.EnvironmentID,
+				ProjectID: 3,
+				Type:      enums.EnvironmentTypeLive,
+			},
+			nil,
+		).
+		Times(1)
+
+	s.projectRepo.EXPECT().
+		GetByID(s.ctx, 3).
+		Return(&entities.Project{ID: 3, ClientID: clientID}, nil).
+		Times(1)
+}
+
+func (s *Suite) TestSuccess() {
+	clientID := 7
+	req := dto.APIKeyCreate{EnvironmentID: 5}
+
+	s.expectOwnEnvironment(&req, clientID)
+
+	s.clientRepo.EXPECT().
+		GetByID(s.ctx, clientID).
+		Return(&entities.Client{ID: clientID, APIKeyLimit: 2}, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		Exists(s.ctx, gomock.Any()).
+		Return(false, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		CreateWithinLimit(s.ctx, gomock.Any(), 2).
+		DoAndReturn(func(_ context.Context, apiKey *entities.APIKey, _ int) errors.Error {
+			apiKey.ID = 11
+			return nil
+		}).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, clientID, &req)
+
+	s.Require().NoError(err)
+	s.Equal(11, resp.ID)
+	s.Equal(req.EnvironmentID, resp.EnvironmentID)
+	s.Equal(enums.APIKeyStatusEnabled, resp.Status)
+	s.NotContains(resp.Key, "...")
+	s.True((&entities.APIKey{Key: resp.Key}).HasValidFormat())
+}
+
+func (s *Suite) TestCreationNotAllowed() {
+	clientID := 7
+	req := dto.APIKeyCreate{EnvironmentID: 5}
+
+	s.expectOwnEnvironment(&req, clientID)
+
+	s.clientRepo.EXPECT().
+		GetByID(s.ctx, clientID).
+		Return(&entities.Client{ID: clientID, APIKeyLimit: 0}, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		CreateWithinLimit(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, clientID, &req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+	s.Equal(errors.CodeForbidden, err.Code())
+}
+
+func (s *Suite) TestLimitReached() {
+	clientID := 7
+	req := dto.APIKeyCreate{EnvironmentID: 5}
+
+	s.expectOwnEnvironment(&req, clientID)
+
+	s.clientRepo.EXPECT().
+		GetByID(s.ctx, clientID).
+		Return(&entities.Client{ID: clientID, APIKeyLimit: 2}, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		Exists(s.ctx, gomock.Any()).
+		Return(false, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		CreateWithinLimit(s.ctx, gomock.Any(), 2).
+		Return(errors.NewEntityValidationFailed(
+			"APIKey",
+			"environment already has 2 usable API keys, the limit of the client",
+			map[string]any{"environment_id": req.EnvironmentID},
+			nil,
+		)).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, clientID, &req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func (s *Suite) TestForeignEnvironment() {
+	req := dto.APIKeyCreate{EnvironmentID: 5}
+
+	s.expectOwnEnvironment(&req, 8)
+
+	s.clientRepo.EXPECT().
+		GetByID(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.apiKeyRepo.EXPECT().
+		CreateWithinLimit(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, 7, &req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+	s.Equal(errors.CodeNotFound, err.Code())
+}
+
+func (s *Suite) TestValidationError() {
+	req := dto.APIKeyCreate{}
+
+	s.validator.EXPECT().
+		ValidateStruct(&req, gomock.Any()).
+		Return(errors.NewValidationFailed("Validation Error", nil)).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		GetByID(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, 7, &req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func TestCreateAPIKeySuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
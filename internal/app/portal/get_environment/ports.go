@@ -0,0 +1,13 @@
+package getenvironment
+
+import (
+	"github.com/MAD-py/pandora-core/internal/app/portal/shared"
+)
+
+type ProjectRepository interface {
+	shared.TenantProjectRepository
+}
+
+type EnvironmentRepository interface {
+	shared.TenantEnvironmentRepository
+}
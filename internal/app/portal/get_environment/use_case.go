@@ -0,0 +1,90 @@
+package getenvironment
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/portal/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, clientID, id int) (*dto.EnvironmentResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	projectRepo     ProjectRepository
+	environmentRepo EnvironmentRepository
+}
+
+// Execute returns an environment of the client, with the remaining quota
+// of its services.
+func (uc *useCase) Execute(
+	ctx context.Context, clientID, id int,
+) (*dto.EnvironmentResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "portal.get_environment")
+	defer span.End()
+
+	if err := uc.validateID(id); err != nil {
+		return nil, err
+	}
+
+	environment, err := shared.EnvironmentOf(
+		ctx, uc.environmentRepo, uc.projectRepo, clientID, id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceResp := make(
+		[]*dto.EnvironmentServiceResponse, len(environment.Services),
+	)
+	for i, service := range environment.Services {
+		serviceResp[i] = &dto.EnvironmentServiceResponse{
+			ID:               service.ID,
+			Name:             service.Name,
+			Version:          service.Version,
+			MaxRequests:      service.MaxRequests,
+			AvailableRequest: service.AvailableRequest,
+			AssignedAt:       service.AssignedAt,
+		}
+	}
+
+	return &dto.EnvironmentResponse{
+		ID:        environment.ID,
+		Name:      environment.Name,
+		Type:      environment.Type,
+		Status:    environment.Status,
+		ProjectID: environment.ProjectID,
+		CreatedAt: environment.CreatedAt,
+		Services:  serviceResp,
+	}, nil
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "id must be greater than 0",
+			"required": "id is required",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	projectRepo ProjectRepository,
+	environmentRepo EnvironmentRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		projectRepo:     projectRepo,
+		environmentRepo: environmentRepo,
+	}
+}
@@ -0,0 +1,23 @@
+package listapikeys
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/portal/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ProjectRepository interface {
+	shared.TenantProjectRepository
+}
+
+type EnvironmentRepository interface {
+	shared.TenantEnvironmentRepository
+}
+
+type APIKeyRepository interface {
+	ListByEnvironment(ctx context.Context, environmentID int, page *dto.Pagination) ([]*entities.APIKey, errors.Error)
+	CountByEnvironment(ctx context.Context, environmentID int) (int, errors.Error)
+}
@@ -0,0 +1,151 @@
+package listapikeys
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/MAD-py/pandora-core/internal/app/portal/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, clientID, id int, page *dto.Pagination) (*dto.Page[*dto.APIKeyResponse], errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	apiKeyRepo      APIKeyRepository
+	projectRepo     ProjectRepository
+	environmentRepo EnvironmentRepository
+}
+
+// Execute lists the API keys of an environment of the client.
+func (uc *useCase) Execute(
+	ctx context.Context, clientID, id int, page *dto.Pagination,
+) (*dto.Page[*dto.APIKeyResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "portal.list_api_keys")
+	defer span.End()
+
+	if err := uc.validateInput(id, page); err != nil {
+		return nil, err
+	}
+
+	_, err := shared.EnvironmentOf(
+		ctx, uc.environmentRepo, uc.projectRepo, clientID, id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := uc.apiKeyRepo.ListByEnvironment(ctx, id, page)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, nextCursor := dto.TrimPage(
+		apiKeys,
+		page,
+		func(apiKey *entities.APIKey) *dto.Cursor {
+			return &dto.Cursor{
+				Time: apiKey.CreatedAt,
+				ID:   strconv.Itoa(apiKey.ID),
+			}
+		},
+	)
+
+	apiKeysResponses := make([]*dto.APIKeyResponse, len(apiKeys))
+	for i, apiKey := range apiKeys {
+		var rateLimit *dto.APIKeyRateLimit
+		if apiKey.RateLimit != nil {
+			rateLimit = &dto.APIKeyRateLimit{
+				Requests: apiKey.RateLimit.Requests,
+				Period:   apiKey.RateLimit.Period,
+				Burst:    apiKey.RateLimit.Burst,
+			}
+		}
+
+		apiKeysResponses[i] = &dto.APIKeyResponse{
+			ID:            apiKey.ID,
+			Key:           apiKey.KeySummary(),
+			Status:        apiKey.Status,
+			LastUsed:      apiKey.LastUsed,
+			ExpiresAt:     apiKey.ExpiresAt,
+			EnvironmentID: apiKey.EnvironmentID,
+			RotatedFromID: apiKey.RotatedFromID,
+			GraceEndsAt:   apiKey.GraceEndsAt,
+			RateLimit:     rateLimit,
+			CreatedAt:     apiKey.CreatedAt,
+		}
+	}
+
+	resp := &dto.Page[*dto.APIKeyResponse]{
+		Items:      apiKeysResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.apiKeyRepo.CountByEnvironment(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validateInput(id int, page *dto.Pagination) errors.Error {
+	var err errors.Error
+
+	if errID := uc.validateID(id); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errPage := uc.validatePage(page); errPage != nil {
+		err = errors.Aggregate(err, errPage)
+	}
+
+	return err
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"required": "id is required",
+			"gt":       "id must be greater than 0",
+		},
+	)
+}
+
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	apiKeyRepo APIKeyRepository,
+	projectRepo ProjectRepository,
+	environmentRepo EnvironmentRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		apiKeyRepo:      apiKeyRepo,
+		projectRepo:     projectRepo,
+		environmentRepo: environmentRepo,
+	}
+}
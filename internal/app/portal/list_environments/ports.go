@@ -0,0 +1,19 @@
+package listenvironments
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/portal/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ProjectRepository interface {
+	shared.TenantProjectRepository
+}
+
+type EnvironmentRepository interface {
+	ListByProject(ctx context.Context, projectID int, page *dto.Pagination) ([]*entities.Environment, errors.Error)
+	CountByProject(ctx context.Context, projectID int) (int, errors.Error)
+}
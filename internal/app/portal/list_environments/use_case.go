@@ -0,0 +1,149 @@
+package listenvironments
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/MAD-py/pandora-core/internal/app/portal/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, clientID, id int, page *dto.Pagination) (*dto.Page[*dto.EnvironmentResponse], errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	projectRepo     ProjectRepository
+	environmentRepo EnvironmentRepository
+}
+
+// Execute lists the environments of a project of the client, with the
+// quota of their services.
+func (uc *useCase) Execute(
+	ctx context.Context, clientID, id int, page *dto.Pagination,
+) (*dto.Page[*dto.EnvironmentResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "portal.list_environments")
+	defer span.End()
+
+	if err := uc.validateInput(id, page); err != nil {
+		return nil, err
+	}
+
+	_, err := shared.ProjectOf(ctx, uc.projectRepo, clientID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	environments, err := uc.environmentRepo.ListByProject(ctx, id, page)
+	if err != nil {
+		return nil, err
+	}
+
+	environments, nextCursor := dto.TrimPage(
+		environments,
+		page,
+		func(environment *entities.Environment) *dto.Cursor {
+			return &dto.Cursor{
+				Time: environment.CreatedAt,
+				ID:   strconv.Itoa(environment.ID),
+			}
+		},
+	)
+
+	environmentResponses := make([]*dto.EnvironmentResponse, len(environments))
+	for i, environment := range environments {
+		serviceResp := make(
+			[]*dto.EnvironmentServiceResponse, len(environment.Services),
+		)
+		for i, service := range environment.Services {
+			serviceResp[i] = &dto.EnvironmentServiceResponse{
+				ID:               service.ID,
+				Name:             service.Name,
+				Version:          service.Version,
+				MaxRequests:      service.MaxRequests,
+				AvailableRequest: service.AvailableRequest,
+				AssignedAt:       service.AssignedAt,
+			}
+		}
+
+		environmentResponses[i] = &dto.EnvironmentResponse{
+			ID:        environment.ID,
+			Name:      environment.Name,
+			Type:      environment.Type,
+			Status:    environment.Status,
+			ProjectID: environment.ProjectID,
+			CreatedAt: environment.CreatedAt,
+			Services:  serviceResp,
+		}
+	}
+
+	resp := &dto.Page[*dto.EnvironmentResponse]{
+		Items:      environmentResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.environmentRepo.CountByProject(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validateInput(id int, page *dto.Pagination) errors.Error {
+	var err errors.Error
+
+	if errID := uc.validateID(id); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errPage := uc.validatePage(page); errPage != nil {
+		err = errors.Aggregate(err, errPage)
+	}
+
+	return err
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "id must be greater than 0",
+			"required": "id is required",
+		},
+	)
+}
+
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	projectRepo ProjectRepository,
+	environmentRepo EnvironmentRepository,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		projectRepo:     projectRepo,
+		environmentRepo: environmentRepo,
+	}
+}
@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/portal/list_projects/ports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/portal/list_projects/ports.go -destination=internal/app/portal/list_projects/mock/ports.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectRepository is a mock of ProjectRepository interface.
+type MockProjectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectRepositoryMockRecorder is the mock recorder for MockProjectRepository.
+type MockProjectRepositoryMockRecorder struct {
+	mock *MockProjectRepository
+}
+
+// NewMockProjectRepository creates a new mock instance.
+func NewMockProjectRepository(ctrl *gomock.Controller) *MockProjectRepository {
+	mock := &MockProjectRepository{ctrl: ctrl}
+	mock.recorder = &MockProjectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectRepository) EXPECT() *MockProjectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByClient mocks base method.
+func (m *MockProjectRepository) CountByClient(ctx context.Context, clientID int) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByClient", ctx, clientID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// CountByClient indicates an expected call of CountByClient.
+func (mr *MockProjectRepositoryMockRecorder) CountByClient(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByClient", reflect.TypeOf((*MockProjectRepository)(nil).CountByClient), ctx, clientID)
+}
+
+// ListByClient mocks base method.
+func (m *MockProjectRepository) ListByClient(ctx context.Context, clientID int, page *dto.Pagination) ([]*entities.Project, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByClient", ctx, clientID, page)
+	ret0, _ := ret[0].([]*entities.Project)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// ListByClient indicates an expected call of ListByClient.
+func (mr *MockProjectRepositoryMockRecorder) ListByClient(ctx, clientID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByClient", reflect.TypeOf((*MockProjectRepository)(nil).ListByClient), ctx, clientID, page)
+}
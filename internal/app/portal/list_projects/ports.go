@@ -0,0 +1,14 @@
+package listprojects
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ProjectRepository interface {
+	ListByClient(ctx context.Context, clientID int, page *dto.Pagination) ([]*entities.Project, errors.Error)
+	CountByClient(ctx context.Context, clientID int) (int, errors.Error)
+}
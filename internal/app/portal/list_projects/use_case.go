@@ -0,0 +1,114 @@
+package listprojects
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, clientID int, page *dto.Pagination) (*dto.Page[*dto.ProjectResponse], errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	projectRepo ProjectRepository
+}
+
+// Execute lists the projects of the client, with the quota of their
+// services.
+func (uc *useCase) Execute(
+	ctx context.Context, clientID int, page *dto.Pagination,
+) (*dto.Page[*dto.ProjectResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "portal.list_projects")
+	defer span.End()
+
+	if err := uc.validatePage(page); err != nil {
+		return nil, err
+	}
+
+	projects, err := uc.projectRepo.ListByClient(ctx, clientID, page)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, nextCursor := dto.TrimPage(
+		projects,
+		page,
+		func(project *entities.Project) *dto.Cursor {
+			return &dto.Cursor{
+				Time: project.CreatedAt,
+				ID:   strconv.Itoa(project.ID),
+			}
+		},
+	)
+
+	projectResponses := make([]*dto.ProjectResponse, len(projects))
+	for i, project := range projects {
+		serviceResp := make(
+			[]*dto.ProjectServiceResponse, len(project.Services),
+		)
+		for i, service := range project.Services {
+			serviceResp[i] = &dto.ProjectServiceResponse{
+				ID:               service.ID,
+				Name:             service.Name,
+				Version:          service.Version,
+				NextReset:        service.NextReset,
+				MaxRequests:      service.MaxRequests,
+				ResetFrequency:   service.ResetFrequency,
+				AvailableRequest: service.AvailableRequest,
+				AssignedAt:       service.AssignedAt,
+			}
+		}
+
+		projectResponses[i] = &dto.ProjectResponse{
+			ID:        project.ID,
+			Name:      project.Name,
+			Status:    project.Status,
+			ClientID:  project.ClientID,
+			CreatedAt: project.CreatedAt,
+			Services:  serviceResp,
+		}
+	}
+
+	resp := &dto.Page[*dto.ProjectResponse]{
+		Items:      projectResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.projectRepo.CountByClient(ctx, clientID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator, projectRepo ProjectRepository,
+) UseCase {
+	return &useCase{
+		validator:   validator,
+		projectRepo: projectRepo,
+	}
+}
@@ -0,0 +1,56 @@
+package portal
+
+import (
+	createapikey "github.com/MAD-py/pandora-core/internal/app/portal/create_api_key"
+	getenvironment "github.com/MAD-py/pandora-core/internal/app/portal/get_environment"
+	listapikeys "github.com/MAD-py/pandora-core/internal/app/portal/list_api_keys"
+	listenvironments "github.com/MAD-py/pandora-core/internal/app/portal/list_environments"
+	listprojects "github.com/MAD-py/pandora-core/internal/app/portal/list_projects"
+	rotateapikey "github.com/MAD-py/pandora-core/internal/app/portal/rotate_api_key"
+	searchrequests "github.com/MAD-py/pandora-core/internal/app/portal/search_requests"
+	"github.com/MAD-py/pandora-core/internal/app/portal/usage"
+)
+
+// ... Create API Key Use Case ...
+
+type ClientCreateAPIKeyRepository = createapikey.ClientRepository
+type ProjectCreateAPIKeyRepository = createapikey.ProjectRepository
+type EnvironmentCreateAPIKeyRepository = createapikey.EnvironmentRepository
+type APIKeyCreateRepository = createapikey.APIKeyRepository
+type AuditCreateAPIKeyRepository = createapikey.AuditRepository
+
+// ... Get Environment Use Case ...
+
+type ProjectGetEnvironmentRepository = getenvironment.ProjectRepository
+type EnvironmentGetRepository = getenvironment.EnvironmentRepository
+
+// ... List API Keys Use Case ...
+
+type APIKeyListRepository = listapikeys.APIKeyRepository
+type ProjectListAPIKeysRepository = listapikeys.ProjectRepository
+type EnvironmentListAPIKeysRepository = listapikeys.EnvironmentRepository
+
+// ... List Environments Use Case ...
+
+type ProjectListEnvironmentsRepository = listenvironments.ProjectRepository
+type EnvironmentListRepository = listenvironments.EnvironmentRepository
+
+// ... List Projects Use Case ...
+
+type ProjectListRepository = listprojects.ProjectRepository
+
+// ... Rotate API Key Use Case ...
+
+type APIKeyRotateRepository = rotateapikey.APIKeyRepository
+type ClientRotateAPIKeyRepository = rotateapikey.ClientRepository
+type ProjectRotateAPIKeyRepository = rotateapikey.ProjectRepository
+type EnvironmentRotateAPIKeyRepository = rotateapikey.EnvironmentRepository
+type AuditRotateAPIKeyRepository = rotateapikey.AuditRepository
+
+// ... Search Requests Use Case ...
+
+type RequestSearchRepository = searchrequests.RequestRepository
+
+// ... Usage Use Case ...
+
+type UsageRepository = usage.UsageRepository
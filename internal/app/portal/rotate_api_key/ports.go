@@ -0,0 +1,33 @@
+package rotateapikey
+
+import (
+	"context"
+	"time"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/portal/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ClientRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Client, errors.Error)
+}
+
+type ProjectRepository interface {
+	shared.TenantProjectRepository
+}
+
+type EnvironmentRepository interface {
+	shared.TenantEnvironmentRepository
+}
+
+type APIKeyRepository interface {
+	shared.TenantAPIKeyRepository
+	Exists(ctx context.Context, key string) (bool, errors.Error)
+	Rotate(ctx context.Context, id int, graceEndsAt time.Time, successor *entities.APIKey, limit int) errors.Error
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
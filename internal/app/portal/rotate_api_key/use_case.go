@@ -0,0 +1,103 @@
+package rotateapikey
+
+import (
+	"context"
+	"time"
+
+	apikeyshared "github.com/MAD-py/pandora-core/internal/app/api_key/shared"
+	"github.com/MAD-py/pandora-core/internal/app/portal/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, clientID, id int, req *dto.APIKeyRotate) (*dto.APIKeyRotateResponse, errors.Error)
+}
+
+type useCase struct {
+	rotation *apikeyshared.RotateDependencies
+
+	apiKeyRepo      APIKeyRepository
+	clientRepo      ClientRepository
+	projectRepo     ProjectRepository
+	environmentRepo EnvironmentRepository
+}
+
+// Execute rotates an API key of the client. The key being replaced counts
+// toward the API key limit of the client until its grace period is over,
+// and rotating may only exceed the limit by that key. Like creating, it is
+// not allowed for clients whose limit is 0. The new key is returned in full.
+func (uc *useCase) Execute(
+	ctx context.Context, clientID, id int, req *dto.APIKeyRotate,
+) (*dto.APIKeyRotateResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "portal.rotate_api_key")
+	defer span.End()
+
+	if err := apikeyshared.ValidateRotation(uc.rotation, id, req); err != nil {
+		return nil, err
+	}
+
+	apiKey, environment, err := shared.APIKeyOf(
+		ctx, uc.apiKeyRepo, uc.environmentRepo, uc.projectRepo, clientID, id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := apikeyshared.CheckRotatable(apiKey); err != nil {
+		return nil, err
+	}
+
+	client, err := uc.clientRepo.GetByID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if client.APIKeyLimit == 0 {
+		return nil, errors.NewForbidden(
+			"Rotating API keys is not allowed for this client", nil,
+		)
+	}
+
+	// The key being replaced may take the environment one key over the
+	// limit, which is checked with the insert of the new key.
+	successor, err := apikeyshared.RotateAPIKey(
+		ctx, uc.rotation, apiKey, environment, req, client.APIKeyLimit+1,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	successorResponse := apikeyshared.APIKeyResponse(successor)
+	successorResponse.Key = successor.Key
+
+	return &dto.APIKeyRotateResponse{
+		APIKey:        successorResponse,
+		RotatedAPIKey: apikeyshared.APIKeyResponse(apiKey),
+	}, nil
+}
+
+// NewUseCase returns the use case rotating the API keys of a client, whose
+// grace period can last up to maxGracePeriod.
+func NewUseCase(
+	validator validator.Validator,
+	keyPrefix string,
+	maxGracePeriod time.Duration,
+	apiKeyRepo APIKeyRepository,
+	clientRepo ClientRepository,
+	projectRepo ProjectRepository,
+	environmentRepo EnvironmentRepository,
+	auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		rotation: apikeyshared.NewRotationDependencies(
+			validator, keyPrefix, maxGracePeriod, apiKeyRepo, auditRepo,
+		),
+		apiKeyRepo:      apiKeyRepo,
+		clientRepo:      clientRepo,
+		projectRepo:     projectRepo,
+		environmentRepo: environmentRepo,
+	}
+}
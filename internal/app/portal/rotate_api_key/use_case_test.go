@@ -0,0 +1,243 @@
+package rotateapikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/portal/rotate_api_key/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type Suite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator       *mockvalidator.MockValidator
+	apiKeyRepo      *mock.MockAPIKeyRepository
+	clientRepo      *mock.MockClientRepository
+	projectRepo     *mock.MockProjectRepository
+	environmentRepo *mock.MockEnvironmentRepository
+	auditRepo       *mock.MockAuditRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *Suite) SetupTest() {
+	time.Local = time.UTC
+
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.apiKeyRepo = mock.NewMockAPIKeyRepository(s.ctrl)
+	s.clientRepo = mock.NewMockClientRepository(s.ctrl)
+	s.projectRepo = mock.NewMockProjectRepository(s.ctrl)
+	s.environmentRepo = mock.NewMockEnvironmentRepository(s.ctrl)
+	s.auditRepo = mock.NewMockAuditRepository(s.ctrl)
+
+	s.useCase = NewUseCase(
+		s.validator,
+		"pdr",
+		7*24*time.Hour,
+		s.apiKeyRepo,
+		s.clientRepo,
+		s.projectRepo,
+		s.environmentRepo,
+		s.auditRepo,
+	)
+
+	s.ctx = context.Background()
+}
+
+func (s *Suite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *Suite) expectOwnAPIKey(clientID, id int, req *dto.APIKeyRotate) {
+	s.validator.EXPECT().
+		ValidateVariable(id, "id", "required,gt=0", gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.validator.EXPECT().
+		ValidateStruct(req, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(
+			&entities.APIKey{
+				ID:            id,
+				Status:        enums.APIKeyStatusEnabled,
+				EnvironmentID: 5,
+			},
+			nil,
+		).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		GetByID(s.ctx, 5).
+		Return(
+			&entities.Environment{
+				ID:        5,
+				ProjectID: 3,
+				Type:      enums.EnvironmentTypeLive,
+			},
+			nil,
+		).
+		Times(1)
+
+	s.projectRepo.EXPECT().
+		GetByID(s.ctx, 3).
+		Return(&entities.Project{ID: 3, ClientID: clientID}, nil).
+		Times(1)
+}
+
+func (s *Suite) TestSuccess() {
+	clientID := 7
+	id := 42
+	req := &dto.APIKeyRotate{GraceEndsAt: time.Now().Add(24 * time.Hour)}
+
+	s.expectOwnAPIKey(clientID, id, req)
+
+	s.clientRepo.EXPECT().
+		GetByID(s.ctx, clientID).
+		Return(&entities.Client{ID: clientID, APIKeyLimit: 2}, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		Exists(s.ctx, gomock.Any()).
+		Return(false, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		Rotate(s.ctx, id, req.GraceEndsAt, gomock.Any(), 3).
+		DoAndReturn(
+			func(_ context.Context, id int, _ time.Time, successor *entities.APIKey, _ int) errors.Error {
+				successor.ID = 43
+				successor.RotatedFromID = id
+				return nil
+			},
+		).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, clientID, id, req)
+
+	s.Require().NoError(err)
+	s.Equal(43, resp.APIKey.ID)
+	s.Equal(id, resp.APIKey.RotatedFromID)
+	s.NotContains(resp.APIKey.Key, "...")
+	s.True((&entities.APIKey{Key: resp.APIKey.Key}).HasValidFormat())
+
+	s.Equal(id, resp.RotatedAPIKey.ID)
+	s.Equal(req.GraceEndsAt, resp.RotatedAPIKey.GraceEndsAt)
+}
+
+func (s *Suite) TestRotationNotAllowed() {
+	clientID := 7
+	id := 42
+	req := &dto.APIKeyRotate{GraceEndsAt: time.Now().Add(24 * time.Hour)}
+
+	s.expectOwnAPIKey(clientID, id, req)
+
+	s.clientRepo.EXPECT().
+		GetByID(s.ctx, clientID).
+		Return(&entities.Client{ID: clientID, APIKeyLimit: 0}, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		Rotate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, clientID, id, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+	s.Equal(errors.CodeForbidden, err.Code())
+}
+
+func (s *Suite) TestLimitExceeded() {
+	clientID := 7
+	id := 42
+	req := &dto.APIKeyRotate{GraceEndsAt: time.Now().Add(24 * time.Hour)}
+
+	s.expectOwnAPIKey(clientID, id, req)
+
+	s.clientRepo.EXPECT().
+		GetByID(s.ctx, clientID).
+		Return(&entities.Client{ID: clientID, APIKeyLimit: 2}, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		Exists(s.ctx, gomock.Any()).
+		Return(false, nil).
+		Times(1)
+
+	// The key being rotated may take the environment one over the limit, a
+	// key still in the grace period of an earlier rotation may not.
+	s.apiKeyRepo.EXPECT().
+		Rotate(s.ctx, id, req.GraceEndsAt, gomock.Any(), 3).
+		Return(errors.NewEntityValidationFailed(
+			"APIKey",
+			"environment already has 3 usable API keys, the limit of the client",
+			map[string]any{"environment_id": 5},
+			nil,
+		)).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, clientID, id, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func (s *Suite) TestGraceEndsAtBeyondMaximum() {
+	clientID := 7
+	id := 42
+	req := &dto.APIKeyRotate{GraceEndsAt: time.Now().Add(30 * 24 * time.Hour)}
+
+	s.validator.EXPECT().
+		ValidateVariable(id, "id", "required,gt=0", gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.validator.EXPECT().
+		ValidateStruct(req, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		GetByID(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, clientID, id, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
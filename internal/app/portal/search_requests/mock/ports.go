@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/portal/search_requests/ports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/portal/search_requests/ports.go -destination=internal/app/portal/search_requests/mock/ports.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRequestRepository is a mock of RequestRepository interface.
+type MockRequestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRequestRepositoryMockRecorder is the mock recorder for MockRequestRepository.
+type MockRequestRepositoryMockRecorder struct {
+	mock *MockRequestRepository
+}
+
+// NewMockRequestRepository creates a new mock instance.
+func NewMockRequestRepository(ctrl *gomock.Controller) *MockRequestRepository {
+	mock := &MockRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockRequestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestRepository) EXPECT() *MockRequestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockRequestRepository) Count(ctx context.Context, filter *dto.RequestSearch) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockRequestRepositoryMockRecorder) Count(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRequestRepository)(nil).Count), ctx, filter)
+}
+
+// Search mocks base method.
+func (m *MockRequestRepository) Search(ctx context.Context, filter *dto.RequestSearch, page *dto.Pagination) ([]*entities.Request, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter, page)
+	ret0, _ := ret[0].([]*entities.Request)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockRequestRepositoryMockRecorder) Search(ctx, filter, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRequestRepository)(nil).Search), ctx, filter, page)
+}
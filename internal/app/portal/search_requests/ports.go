@@ -0,0 +1,14 @@
+package searchrequests
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type RequestRepository interface {
+	Search(ctx context.Context, filter *dto.RequestSearch, page *dto.Pagination) ([]*entities.Request, errors.Error)
+	Count(ctx context.Context, filter *dto.RequestSearch) (int, errors.Error)
+}
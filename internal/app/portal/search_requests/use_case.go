@@ -0,0 +1,168 @@
+package searchrequests
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, clientID int, req *dto.RequestSearch, page *dto.Pagination) (*dto.Page[*dto.RequestResponse], errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	requestRepo RequestRepository
+}
+
+// Execute searches the requests made with the API keys of the client. The
+// search is always restricted to the client, whatever client_id is given.
+func (uc *useCase) Execute(
+	ctx context.Context,
+	clientID int,
+	req *dto.RequestSearch,
+	page *dto.Pagination,
+) (*dto.Page[*dto.RequestResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "portal.search_requests")
+	defer span.End()
+
+	if err := uc.validateInput(req, page); err != nil {
+		return nil, err
+	}
+
+	filter := *req
+	filter.ClientID = clientID
+
+	requests, err := uc.requestRepo.Search(ctx, &filter, page)
+	if err != nil {
+		return nil, err
+	}
+
+	requests, nextCursor := dto.TrimPage(
+		requests,
+		page,
+		func(request *entities.Request) *dto.Cursor {
+			if req.SortBy == enums.RequestSortFieldRequestTime {
+				return &dto.Cursor{Time: request.RequestTime, ID: request.ID}
+			}
+			return &dto.Cursor{Time: request.CreatedAt, ID: request.ID}
+		},
+	)
+
+	requestResponses := make([]*dto.RequestResponse, len(requests))
+	for i, request := range requests {
+		requestResponses[i] = &dto.RequestResponse{
+			ID:                 request.ID,
+			StartPoint:         request.StartPoint,
+			Detail:             request.Detail,
+			StatusCode:         request.StatusCode,
+			ExecutionStatus:    request.ExecutionStatus,
+			UnauthorizedReason: request.UnauthorizedReason,
+			Units:              request.Units,
+			RequestTime:        request.RequestTime,
+			Path:               request.Path,
+			Method:             request.Method,
+			IPAddress:          request.IPAddress,
+			CreatedAt:          request.CreatedAt,
+			APIKey: &dto.RequestAPIKeyResponse{
+				ID:  request.APIKey.ID,
+				Key: request.APIKey.KeySummary(),
+			},
+			Project: &dto.RequestProjectResponse{
+				ID:   request.Project.ID,
+				Name: request.Project.Name,
+			},
+			Environment: &dto.RequestEnvironmentResponse{
+				ID:   request.Environment.ID,
+				Name: request.Environment.Name,
+			},
+			Service: &dto.RequestServiceResponse{
+				ID:      request.Service.ID,
+				Name:    request.Service.Name,
+				Version: request.Service.Version,
+			},
+		}
+	}
+
+	resp := &dto.Page[*dto.RequestResponse]{
+		Items:      requestResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.requestRepo.Count(ctx, &filter)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validateInput(
+	req *dto.RequestSearch, page *dto.Pagination,
+) errors.Error {
+	var err errors.Error
+
+	if errReq := uc.validateReq(req); errReq != nil {
+		err = errors.Aggregate(err, errReq)
+	}
+
+	if errPage := uc.validatePage(page); errPage != nil {
+		err = errors.Aggregate(err, errPage)
+	}
+
+	return err
+}
+
+func (uc *useCase) validateReq(req *dto.RequestSearch) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"client_id.gt":              "client_id must be greater than 0",
+			"project_id.gt":             "project_id must be greater than 0",
+			"environment_id.gt":         "environment_id must be greater than 0",
+			"api_key_id.gt":             "api_key_id must be greater than 0",
+			"service_id.gt":             "service_id must be greater than 0",
+			"method.enums":              "method must be one of the following: GET, HEAD, POST, PUT, PATCH, DELETE, CONNECT, OPTIONS, TRACE",
+			"ip_address.ip|cidr":        "ip_address must be a valid IP address or CIDR",
+			"status_code_from.gte":      "status_code_from must be greater than or equal to 100",
+			"status_code_from.lte":      "status_code_from must be less than or equal to 599",
+			"status_code_to.gte":        "status_code_to must be greater than or equal to 100",
+			"status_code_to.lte":        "status_code_to must be less than or equal to 599",
+			"status_code_to.gtefield":   "status_code_to must be greater than or equal to status_code_from",
+			"unauthorized_reason.enums": "unauthorized_reason must be one of the following: API_KEY_INVALID, QUOTA_EXCEEDED, API_KEY_EXPIRED, API_KEY_DISABLED, SERVICE_MISMATCH, SERVICE_DISABLED, SERVICE_DEPRECATED, SERVICE_NOT_ASSIGNED, ENVIRONMENT_DISABLED, RATE_LIMITED",
+			"execution_status.enums":    "execution_status must be one of the following: success, forwarded, client_error, server_error, unauthorized, abandoned",
+			"request_time_to.gtefield":  "request_time_to must be greater than or equal to request_time_from",
+			"sort_by.enums":             "sort_by must be one of the following: created_at, request_time",
+			"sort_order.enums":          "sort_order must be one of the following: asc, desc",
+		},
+	)
+}
+
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator, requestRepo RequestRepository,
+) UseCase {
+	return &useCase{
+		validator:   validator,
+		requestRepo: requestRepo,
+	}
+}
@@ -0,0 +1,90 @@
+package searchrequests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/portal/search_requests/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type Suite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator   *mockvalidator.MockValidator
+	requestRepo *mock.MockRequestRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *Suite) SetupTest() {
+	time.Local = time.UTC
+
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.requestRepo = mock.NewMockRequestRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.validator, s.requestRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *Suite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *Suite) TestClientIsForced() {
+	clientID := 7
+	req := dto.RequestSearch{ClientID: 8, ProjectID: 3}
+	page := dto.Pagination{Limit: 10, IncludeTotal: true}
+
+	s.validator.EXPECT().
+		ValidateStruct(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(2)
+
+	expectedFilter := &dto.RequestSearch{ClientID: clientID, ProjectID: 3}
+
+	s.requestRepo.EXPECT().
+		Search(s.ctx, expectedFilter, &page).
+		Return(
+			[]*entities.Request{
+				{
+					ID:          "request-1",
+					APIKey:      &entities.RequestAPIKey{ID: 1, Key: "pdr_live_xxxxxxxx"},
+					Project:     &entities.RequestProject{ID: 3, Name: "Project"},
+					Environment: &entities.RequestEnvironment{ID: 5, Name: "Production"},
+					Service:     &entities.RequestService{ID: 2, Name: "Service", Version: "1.0.0"},
+				},
+			},
+			nil,
+		).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		Count(s.ctx, expectedFilter).
+		Return(1, nil).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, clientID, &req, &page)
+
+	s.Require().NoError(err)
+	s.Len(resp.Items, 1)
+	s.Equal(1, *resp.Total)
+	s.Equal(8, req.ClientID)
+}
+
+func TestSearchRequestsSuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
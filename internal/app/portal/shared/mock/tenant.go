@@ -0,0 +1,136 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/portal/shared/tenant.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/portal/shared/tenant.go -destination=internal/app/portal/shared/mock/tenant.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTenantProjectRepository is a mock of TenantProjectRepository interface.
+type MockTenantProjectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantProjectRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTenantProjectRepositoryMockRecorder is the mock recorder for MockTenantProjectRepository.
+type MockTenantProjectRepositoryMockRecorder struct {
+	mock *MockTenantProjectRepository
+}
+
+// NewMockTenantProjectRepository creates a new mock instance.
+func NewMockTenantProjectRepository(ctrl *gomock.Controller) *MockTenantProjectRepository {
+	mock := &MockTenantProjectRepository{ctrl: ctrl}
+	mock.recorder = &MockTenantProjectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantProjectRepository) EXPECT() *MockTenantProjectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockTenantProjectRepository) GetByID(ctx context.Context, id int) (*entities.Project, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Project)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTenantProjectRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTenantProjectRepository)(nil).GetByID), ctx, id)
+}
+
+// MockTenantEnvironmentRepository is a mock of TenantEnvironmentRepository interface.
+type MockTenantEnvironmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantEnvironmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTenantEnvironmentRepositoryMockRecorder is the mock recorder for MockTenantEnvironmentRepository.
+type MockTenantEnvironmentRepositoryMockRecorder struct {
+	mock *MockTenantEnvironmentRepository
+}
+
+// NewMockTenantEnvironmentRepository creates a new mock instance.
+func NewMockTenantEnvironmentRepository(ctrl *gomock.Controller) *MockTenantEnvironmentRepository {
+	mock := &MockTenantEnvironmentRepository{ctrl: ctrl}
+	mock.recorder = &MockTenantEnvironmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantEnvironmentRepository) EXPECT() *MockTenantEnvironmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockTenantEnvironmentRepository) GetByID(ctx context.Context, id int) (*entities.Environment, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Environment)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTenantEnvironmentRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTenantEnvironmentRepository)(nil).GetByID), ctx, id)
+}
+
+// MockTenantAPIKeyRepository is a mock of TenantAPIKeyRepository interface.
+type MockTenantAPIKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantAPIKeyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTenantAPIKeyRepositoryMockRecorder is the mock recorder for MockTenantAPIKeyRepository.
+type MockTenantAPIKeyRepositoryMockRecorder struct {
+	mock *MockTenantAPIKeyRepository
+}
+
+// NewMockTenantAPIKeyRepository creates a new mock instance.
+func NewMockTenantAPIKeyRepository(ctrl *gomock.Controller) *MockTenantAPIKeyRepository {
+	mock := &MockTenantAPIKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockTenantAPIKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantAPIKeyRepository) EXPECT() *MockTenantAPIKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockTenantAPIKeyRepository) GetByID(ctx context.Context, id int) (*entities.APIKey, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entities.APIKey)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTenantAPIKeyRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTenantAPIKeyRepository)(nil).GetByID), ctx, id)
+}
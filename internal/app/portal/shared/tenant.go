@@ -0,0 +1,119 @@
+package shared
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// The portal only lets a client reach its own resources. Those of other
+// clients are reported as not found, so their existence is not disclosed.
+
+type TenantProjectRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Project, errors.Error)
+}
+
+type TenantEnvironmentRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Environment, errors.Error)
+}
+
+type TenantAPIKeyRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.APIKey, errors.Error)
+}
+
+// ProjectOf returns the project with the given id if it belongs to the
+// client.
+func ProjectOf(
+	ctx context.Context,
+	projectRepo TenantProjectRepository,
+	clientID, id int,
+) (*entities.Project, errors.Error) {
+	project, err := projectRepo.GetByID(ctx, id)
+	if err != nil && err.Code() != errors.CodeNotFound {
+		return nil, err
+	}
+
+	if project == nil || project.ClientID != clientID {
+		return nil, errors.NewEntityNotFound(
+			"Project",
+			"project not found",
+			map[string]any{"id": id},
+			err,
+		)
+	}
+
+	return project, nil
+}
+
+// EnvironmentOf returns the environment with the given id if its project
+// belongs to the client.
+func EnvironmentOf(
+	ctx context.Context,
+	environmentRepo TenantEnvironmentRepository,
+	projectRepo TenantProjectRepository,
+	clientID, id int,
+) (*entities.Environment, errors.Error) {
+	environment, err := environmentRepo.GetByID(ctx, id)
+	if err != nil && err.Code() != errors.CodeNotFound {
+		return nil, err
+	}
+
+	notFound := errors.NewEntityNotFound(
+		"Environment",
+		"environment not found",
+		map[string]any{"id": id},
+		err,
+	)
+
+	if environment == nil {
+		return nil, notFound
+	}
+
+	if _, err := ProjectOf(ctx, projectRepo, clientID, environment.ProjectID); err != nil {
+		if err.Code() == errors.CodeNotFound {
+			return nil, notFound
+		}
+		return nil, err
+	}
+
+	return environment, nil
+}
+
+// APIKeyOf returns the API key with the given id, and its environment, if
+// the project of the environment belongs to the client.
+func APIKeyOf(
+	ctx context.Context,
+	apiKeyRepo TenantAPIKeyRepository,
+	environmentRepo TenantEnvironmentRepository,
+	projectRepo TenantProjectRepository,
+	clientID, id int,
+) (*entities.APIKey, *entities.Environment, errors.Error) {
+	apiKey, err := apiKeyRepo.GetByID(ctx, id)
+	if err != nil && err.Code() != errors.CodeNotFound {
+		return nil, nil, err
+	}
+
+	notFound := errors.NewEntityNotFound(
+		"APIKey",
+		"api key not found",
+		map[string]any{"id": id},
+		err,
+	)
+
+	if apiKey == nil {
+		return nil, nil, notFound
+	}
+
+	environment, err := EnvironmentOf(
+		ctx, environmentRepo, projectRepo, clientID, apiKey.EnvironmentID,
+	)
+	if err != nil {
+		if err.Code() == errors.CodeNotFound {
+			return nil, nil, notFound
+		}
+		return nil, nil, err
+	}
+
+	return apiKey, environment, nil
+}
@@ -0,0 +1,174 @@
+package shared
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/portal/shared/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type Suite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	projectRepo     *mock.MockTenantProjectRepository
+	environmentRepo *mock.MockTenantEnvironmentRepository
+	apiKeyRepo      *mock.MockTenantAPIKeyRepository
+
+	ctx context.Context
+}
+
+func (s *Suite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+
+	s.projectRepo = mock.NewMockTenantProjectRepository(s.ctrl)
+	s.environmentRepo = mock.NewMockTenantEnvironmentRepository(s.ctrl)
+	s.apiKeyRepo = mock.NewMockTenantAPIKeyRepository(s.ctrl)
+
+	s.ctx = context.Background()
+}
+
+func (s *Suite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *Suite) TestProjectOfOwnProject() {
+	s.projectRepo.EXPECT().
+		GetByID(s.ctx, 3).
+		Return(&entities.Project{ID: 3, ClientID: 7}, nil).
+		Times(1)
+
+	project, err := ProjectOf(s.ctx, s.projectRepo, 7, 3)
+
+	s.Require().NoError(err)
+	s.Equal(3, project.ID)
+}
+
+func (s *Suite) TestProjectOfForeignProject() {
+	s.projectRepo.EXPECT().
+		GetByID(s.ctx, 3).
+		Return(&entities.Project{ID: 3, ClientID: 8}, nil).
+		Times(1)
+
+	project, err := ProjectOf(s.ctx, s.projectRepo, 7, 3)
+
+	s.Require().Error(err)
+	s.Nil(project)
+	s.Equal(errors.CodeNotFound, err.Code())
+}
+
+func (s *Suite) TestProjectOfRepositoryError() {
+	repoErr := errors.NewInternal("database error", nil)
+	s.projectRepo.EXPECT().
+		GetByID(s.ctx, 3).
+		Return(nil, repoErr).
+		Times(1)
+
+	project, err := ProjectOf(s.ctx, s.projectRepo, 7, 3)
+
+	s.Require().Error(err)
+	s.Nil(project)
+	s.Equal(repoErr, err)
+}
+
+func (s *Suite) TestEnvironmentOfForeignProject() {
+	s.environmentRepo.EXPECT().
+		GetByID(s.ctx, 5).
+		Return(&entities.Environment{ID: 5, ProjectID: 3}, nil).
+		Times(1)
+
+	s.projectRepo.EXPECT().
+		GetByID(s.ctx, 3).
+		Return(&entities.Project{ID: 3, ClientID: 8}, nil).
+		Times(1)
+
+	environment, err := EnvironmentOf(
+		s.ctx, s.environmentRepo, s.projectRepo, 7, 5,
+	)
+
+	s.Require().Error(err)
+	s.Nil(environment)
+	s.Equal(errors.CodeNotFound, err.Code())
+	s.Equal("<NOT_FOUND> Environment (id=5): environment not found", err.Error())
+}
+
+func (s *Suite) TestEnvironmentOfMissing() {
+	s.environmentRepo.EXPECT().
+		GetByID(s.ctx, 5).
+		Return(nil, errors.NewNotFound("environment not found", nil)).
+		Times(1)
+
+	s.projectRepo.EXPECT().
+		GetByID(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	environment, err := EnvironmentOf(
+		s.ctx, s.environmentRepo, s.projectRepo, 7, 5,
+	)
+
+	s.Require().Error(err)
+	s.Nil(environment)
+	s.Equal(errors.CodeNotFound, err.Code())
+}
+
+func (s *Suite) TestAPIKeyOfOwnAPIKey() {
+	s.apiKeyRepo.EXPECT().
+		GetByID(s.ctx, 9).
+		Return(&entities.APIKey{ID: 9, EnvironmentID: 5}, nil).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		GetByID(s.ctx, 5).
+		Return(&entities.Environment{ID: 5, ProjectID: 3}, nil).
+		Times(1)
+
+	s.projectRepo.EXPECT().
+		GetByID(s.ctx, 3).
+		Return(&entities.Project{ID: 3, ClientID: 7}, nil).
+		Times(1)
+
+	apiKey, environment, err := APIKeyOf(
+		s.ctx, s.apiKeyRepo, s.environmentRepo, s.projectRepo, 7, 9,
+	)
+
+	s.Require().NoError(err)
+	s.Equal(9, apiKey.ID)
+	s.Equal(5, environment.ID)
+}
+
+func (s *Suite) TestAPIKeyOfForeignAPIKey() {
+	s.apiKeyRepo.EXPECT().
+		GetByID(s.ctx, 9).
+		Return(&entities.APIKey{ID: 9, EnvironmentID: 5}, nil).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		GetByID(s.ctx, 5).
+		Return(&entities.Environment{ID: 5, ProjectID: 3}, nil).
+		Times(1)
+
+	s.projectRepo.EXPECT().
+		GetByID(s.ctx, 3).
+		Return(&entities.Project{ID: 3, ClientID: 8}, nil).
+		Times(1)
+
+	apiKey, environment, err := APIKeyOf(
+		s.ctx, s.apiKeyRepo, s.environmentRepo, s.projectRepo, 7, 9,
+	)
+
+	s.Require().Error(err)
+	s.Nil(apiKey)
+	s.Nil(environment)
+	s.Equal(errors.CodeNotFound, err.Code())
+	s.Equal("<NOT_FOUND> APIKey (id=9): api key not found", err.Error())
+}
+
+func TestTenantSuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
@@ -0,0 +1,125 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, clientID int, req *dto.UsageFilter) (*dto.UsageResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	usageRepo UsageRepository
+}
+
+// Execute returns the usage of the client over time. Like the search of
+// requests, it is always restricted to the client.
+func (uc *useCase) Execute(
+	ctx context.Context, clientID int, req *dto.UsageFilter,
+) (*dto.UsageResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "portal.usage")
+	defer span.End()
+
+	if err := uc.validateReq(req); err != nil {
+		return nil, err
+	}
+
+	// Buckets are whole, so the range is widened to the buckets its ends
+	// fall in.
+	filter := *req
+	filter.ClientID = clientID
+	filter.From = req.Granularity.Truncate(req.From)
+	filter.To = req.Granularity.Truncate(req.To)
+	if filter.To.Before(req.To) {
+		filter.To = req.Granularity.Next(filter.To)
+	}
+
+	if err := uc.validateSpan(&filter); err != nil {
+		return nil, err
+	}
+
+	buckets, err := uc.usageRepo.List(ctx, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketResponses := make([]*dto.UsageBucketResponse, len(buckets))
+	for i, bucket := range buckets {
+		bucketResponses[i] = &dto.UsageBucketResponse{
+			Bucket:             bucket.Bucket,
+			ClientID:           bucket.ClientID,
+			ProjectID:          bucket.ProjectID,
+			EnvironmentID:      bucket.EnvironmentID,
+			ServiceID:          bucket.ServiceID,
+			APIKeyID:           bucket.APIKeyID,
+			ExecutionStatus:    bucket.ExecutionStatus,
+			UnauthorizedReason: bucket.UnauthorizedReason,
+			Requests:           bucket.Requests,
+			Units:              bucket.Units,
+		}
+	}
+
+	return &dto.UsageResponse{
+		Granularity: filter.Granularity,
+		From:        filter.From,
+		To:          filter.To,
+		Buckets:     bucketResponses,
+	}, nil
+}
+
+func (uc *useCase) validateReq(req *dto.UsageFilter) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"granularity.required": "granularity is required",
+			"granularity.enums":    "granularity must be one of the following: hour, day, month",
+			"from.required":        "from is required",
+			"to.required":          "to is required",
+			"to.gtfield":           "to must be greater than from",
+			"group_by.unique":      "group_by must not repeat a dimension",
+			"group_by[].enums":     "group_by must be one of the following: client, project, environment, service, api_key, execution_status, unauthorized_reason",
+			"client_id.gt":         "client_id must be greater than 0",
+			"project_id.gt":        "project_id must be greater than 0",
+			"environment_id.gt":    "environment_id must be greater than 0",
+			"service_id.gt":        "service_id must be greater than 0",
+			"api_key_id.gt":        "api_key_id must be greater than 0",
+		},
+	)
+}
+
+func (uc *useCase) validateSpan(filter *dto.UsageFilter) errors.Error {
+	buckets := 0
+	for t := filter.From; t.Before(filter.To); t = filter.Granularity.Next(t) {
+		buckets++
+		if buckets > dto.MaxUsageBuckets {
+			return errors.NewAttributeValidationFailed(
+				"UsageFilter",
+				"to",
+				fmt.Sprintf(
+					"from and to must not span more than %d buckets of one %s",
+					dto.MaxUsageBuckets, filter.Granularity,
+				),
+				nil,
+			)
+		}
+	}
+
+	return nil
+}
+
+func NewUseCase(
+	validator validator.Validator, usageRepo UsageRepository,
+) UseCase {
+	return &useCase{
+		validator: validator,
+		usageRepo: usageRepo,
+	}
+}
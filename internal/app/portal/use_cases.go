@@ -0,0 +1,134 @@
+package portal
+
+import (
+	"time"
+
+	createapikey "github.com/MAD-py/pandora-core/internal/app/portal/create_api_key"
+	getenvironment "github.com/MAD-py/pandora-core/internal/app/portal/get_environment"
+	listapikeys "github.com/MAD-py/pandora-core/internal/app/portal/list_api_keys"
+	listenvironments "github.com/MAD-py/pandora-core/internal/app/portal/list_environments"
+	listprojects "github.com/MAD-py/pandora-core/internal/app/portal/list_projects"
+	rotateapikey "github.com/MAD-py/pandora-core/internal/app/portal/rotate_api_key"
+	searchrequests "github.com/MAD-py/pandora-core/internal/app/portal/search_requests"
+	"github.com/MAD-py/pandora-core/internal/app/portal/usage"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+// ... Create API Key Use Case ...
+
+type CreateAPIKeyUseCase = createapikey.UseCase
+
+func NewCreateAPIKeyUseCase(
+	validator validator.Validator,
+	keyPrefix string,
+	clientRepo ClientCreateAPIKeyRepository,
+	projectRepo ProjectCreateAPIKeyRepository,
+	environmentRepo EnvironmentCreateAPIKeyRepository,
+	apiKeyRepo APIKeyCreateRepository,
+	auditRepo AuditCreateAPIKeyRepository,
+) CreateAPIKeyUseCase {
+	return createapikey.NewUseCase(
+		validator,
+		keyPrefix,
+		clientRepo,
+		projectRepo,
+		environmentRepo,
+		apiKeyRepo,
+		auditRepo,
+	)
+}
+
+// ... Get Environment Use Case ...
+
+type GetEnvironmentUseCase = getenvironment.UseCase
+
+func NewGetEnvironmentUseCase(
+	validator validator.Validator,
+	projectRepo ProjectGetEnvironmentRepository,
+	environmentRepo EnvironmentGetRepository,
+) GetEnvironmentUseCase {
+	return getenvironment.NewUseCase(validator, projectRepo, environmentRepo)
+}
+
+// ... List API Keys Use Case ...
+
+type ListAPIKeysUseCase = listapikeys.UseCase
+
+func NewListAPIKeysUseCase(
+	validator validator.Validator,
+	apiKeyRepo APIKeyListRepository,
+	projectRepo ProjectListAPIKeysRepository,
+	environmentRepo EnvironmentListAPIKeysRepository,
+) ListAPIKeysUseCase {
+	return listapikeys.NewUseCase(
+		validator, apiKeyRepo, projectRepo, environmentRepo,
+	)
+}
+
+// ... List Environments Use Case ...
+
+type ListEnvironmentsUseCase = listenvironments.UseCase
+
+func NewListEnvironmentsUseCase(
+	validator validator.Validator,
+	projectRepo ProjectListEnvironmentsRepository,
+	environmentRepo EnvironmentListRepository,
+) ListEnvironmentsUseCase {
+	return listenvironments.NewUseCase(validator, projectRepo, environmentRepo)
+}
+
+// ... List Projects Use Case ...
+
+type ListProjectsUseCase = listprojects.UseCase
+
+func NewListProjectsUseCase(
+	validator validator.Validator, projectRepo ProjectListRepository,
+) ListProjectsUseCase {
+	return listprojects.NewUseCase(validator, projectRepo)
+}
+
+// ... Rotate API Key Use Case ...
+
+type RotateAPIKeyUseCase = rotateapikey.UseCase
+
+func NewRotateAPIKeyUseCase(
+	validator validator.Validator,
+	keyPrefix string,
+	maxGracePeriod time.Duration,
+	apiKeyRepo APIKeyRotateRepository,
+	clientRepo ClientRotateAPIKeyRepository,
+	projectRepo ProjectRotateAPIKeyRepository,
+	environmentRepo EnvironmentRotateAPIKeyRepository,
+	auditRepo AuditRotateAPIKeyRepository,
+) RotateAPIKeyUseCase {
+	return rotateapikey.NewUseCase(
+		validator,
+		keyPrefix,
+		maxGracePeriod,
+		apiKeyRepo,
+		clientRepo,
+		projectRepo,
+		environmentRepo,
+		auditRepo,
+	)
+}
+
+// ... Search Requests Use Case ...
+
+type SearchRequestsUseCase = searchrequests.UseCase
+
+func NewSearchRequestsUseCase(
+	validator validator.Validator, requestRepo RequestSearchRepository,
+) SearchRequestsUseCase {
+	return searchrequests.NewUseCase(validator, requestRepo)
+}
+
+// ... Usage Use Case ...
+
+type UsageUseCase = usage.UseCase
+
+func NewUsageUseCase(
+	validator validator.Validator, usageRepo UsageRepository,
+) UsageUseCase {
+	return usage.NewUseCase(validator, usageRepo)
+}
@@ -3,6 +3,7 @@ package assignservice
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
@@ -10,3 +11,7 @@ import (
 type ProjectRepository interface {
 	AddService(ctx context.Context, id int, service *entities.ProjectService) errors.Error
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
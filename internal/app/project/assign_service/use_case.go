@@ -3,8 +3,11 @@ package assignservice
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -17,19 +20,24 @@ type useCase struct {
 	validator validator.Validator
 
 	projectRepo ProjectRepository
+	auditRepo   AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, id int, req *dto.ProjectService,
 ) (*dto.ProjectServiceResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "project.assign_service")
+	defer span.End()
+
 	if err := uc.validateInput(id, req); err != nil {
 		return nil, err
 	}
 
 	service := &entities.ProjectService{
-		ID:             req.ID,
-		MaxRequests:    req.MaxRequests,
-		ResetFrequency: req.ResetFrequency,
+		ID:               req.ID,
+		MaxRequests:      req.MaxRequests,
+		ResetFrequency:   req.ResetFrequency,
+		AvailableRequest: req.MaxRequests,
 	}
 
 	service.CalculateNextReset()
@@ -45,14 +53,25 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionAssignService,
+		enums.AuditEntityProject,
+		id,
+		nil,
+		service,
+	)
+
 	return &dto.ProjectServiceResponse{
-		ID:             service.ID,
-		Name:           service.Name,
-		Version:        service.Version,
-		NextReset:      service.NextReset,
-		MaxRequests:    service.MaxRequests,
-		ResetFrequency: service.ResetFrequency,
-		AssignedAt:     service.AssignedAt,
+		ID:               service.ID,
+		Name:             service.Name,
+		Version:          service.Version,
+		NextReset:        service.NextReset,
+		MaxRequests:      service.MaxRequests,
+		ResetFrequency:   service.ResetFrequency,
+		AvailableRequest: service.AvailableRequest,
+		AssignedAt:       service.AssignedAt,
 	}, nil
 }
 
@@ -104,10 +123,13 @@ func (uc *useCase) validateReq(req *dto.ProjectService) errors.Error {
 }
 
 func NewUseCase(
-	validator validator.Validator, projectRepo ProjectRepository,
+	validator validator.Validator,
+	projectRepo ProjectRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:   validator,
 		projectRepo: projectRepo,
+		auditRepo:   auditRepo,
 	}
 }
@@ -3,6 +3,7 @@ package create
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
@@ -10,3 +11,7 @@ import (
 type ProjectRepository interface {
 	Create(ctx context.Context, project *entities.Project) errors.Error
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -3,6 +3,8 @@ package create
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
@@ -18,11 +20,15 @@ type useCase struct {
 	validator validator.Validator
 
 	projectRepo ProjectRepository
+	auditRepo   AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, req *dto.ProjectCreate,
 ) (*dto.ProjectResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "project.create")
+	defer span.End()
+
 	if err := uc.validateReq(req); err != nil {
 		return nil, err
 	}
@@ -30,9 +36,10 @@ func (uc *useCase) Execute(
 	services := make([]*entities.ProjectService, len(req.Services))
 	for i, service := range req.Services {
 		s := &entities.ProjectService{
-			ID:             service.ID,
-			MaxRequests:    service.MaxRequests,
-			ResetFrequency: service.ResetFrequency,
+			ID:               service.ID,
+			MaxRequests:      service.MaxRequests,
+			ResetFrequency:   service.ResetFrequency,
+			AvailableRequest: service.MaxRequests,
 		}
 		s.CalculateNextReset()
 		services[i] = s
@@ -49,6 +56,16 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionCreate,
+		enums.AuditEntityProject,
+		project.ID,
+		nil,
+		&project,
+	)
+
 	serviceResp := make(
 		[]*dto.ProjectServiceResponse, len(project.Services),
 	)
@@ -92,10 +109,13 @@ func (uc *useCase) validateReq(req *dto.ProjectCreate) errors.Error {
 }
 
 func NewUseCase(
-	validator validator.Validator, projectRepo ProjectRepository,
+	validator validator.Validator,
+	projectRepo ProjectRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:   validator,
 		projectRepo: projectRepo,
+		auditRepo:   auditRepo,
 	}
 }
@@ -3,6 +3,7 @@ package get
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
@@ -21,6 +22,9 @@ type useCase struct {
 func (uc *useCase) Execute(
 	ctx context.Context, id int,
 ) (*dto.ProjectResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "project.get")
+	defer span.End()
+
 	if err := uc.validateID(id); err != nil {
 		return nil, err
 	}
@@ -43,13 +47,14 @@ func (uc *useCase) Execute(
 	)
 	for i, service := range project.Services {
 		serviceResp[i] = &dto.ProjectServiceResponse{
-			ID:             service.ID,
-			Name:           service.Name,
-			Version:        service.Version,
-			NextReset:      service.NextReset,
-			MaxRequests:    service.MaxRequests,
-			ResetFrequency: service.ResetFrequency,
-			AssignedAt:     service.AssignedAt,
+			ID:               service.ID,
+			Name:             service.Name,
+			Version:          service.Version,
+			NextReset:        service.NextReset,
+			MaxRequests:      service.MaxRequests,
+			ResetFrequency:   service.ResetFrequency,
+			AvailableRequest: service.AvailableRequest,
+			AssignedAt:       service.AssignedAt,
 		}
 	}
 
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
 	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
 	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
 	gomock "go.uber.org/mock/gomock"
@@ -42,17 +43,32 @@ func (m *MockProjectRepository) EXPECT() *MockProjectRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockProjectRepository) Count(ctx context.Context) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockProjectRepositoryMockRecorder) Count(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockProjectRepository)(nil).Count), ctx)
+}
+
 // List mocks base method.
-func (m *MockProjectRepository) List(ctx context.Context) ([]*entities.Project, errors.Error) {
+func (m *MockProjectRepository) List(ctx context.Context, page *dto.Pagination) ([]*entities.Project, errors.Error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx)
+	ret := m.ctrl.Call(m, "List", ctx, page)
 	ret0, _ := ret[0].([]*entities.Project)
 	ret1, _ := ret[1].(errors.Error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockProjectRepositoryMockRecorder) List(ctx any) *gomock.Call {
+func (mr *MockProjectRepositoryMockRecorder) List(ctx, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectRepository)(nil).List), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectRepository)(nil).List), ctx, page)
 }
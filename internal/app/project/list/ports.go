@@ -3,10 +3,12 @@ package list
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
 type ProjectRepository interface {
-	List(ctx context.Context) ([]*entities.Project, errors.Error)
+	List(ctx context.Context, page *dto.Pagination) ([]*entities.Project, errors.Error)
+	Count(ctx context.Context) (int, errors.Error)
 }
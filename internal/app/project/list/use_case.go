@@ -2,25 +2,51 @@ package list
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
 )
 
 type UseCase interface {
-	Execute(ctx context.Context) ([]*dto.ProjectResponse, errors.Error)
+	Execute(ctx context.Context, page *dto.Pagination) (*dto.Page[*dto.ProjectResponse], errors.Error)
 }
 
 type useCase struct {
+	validator validator.Validator
+
 	projectRepo ProjectRepository
 }
 
-func (uc *useCase) Execute(ctx context.Context) ([]*dto.ProjectResponse, errors.Error) {
-	projects, err := uc.projectRepo.List(ctx)
+func (uc *useCase) Execute(
+	ctx context.Context, page *dto.Pagination,
+) (*dto.Page[*dto.ProjectResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "project.list")
+	defer span.End()
+
+	if err := uc.validatePage(page); err != nil {
+		return nil, err
+	}
+
+	projects, err := uc.projectRepo.List(ctx, page)
 	if err != nil {
 		return nil, err
 	}
 
+	projects, nextCursor := dto.TrimPage(
+		projects,
+		page,
+		func(project *entities.Project) *dto.Cursor {
+			return &dto.Cursor{
+				Time: project.CreatedAt,
+				ID:   strconv.Itoa(project.ID),
+			}
+		},
+	)
+
 	projectResponses := make([]*dto.ProjectResponse, len(projects))
 	for i, project := range projects {
 		serviceResp := make(
@@ -28,13 +54,14 @@ func (uc *useCase) Execute(ctx context.Context) ([]*dto.ProjectResponse, errors.
 		)
 		for i, service := range project.Services {
 			serviceResp[i] = &dto.ProjectServiceResponse{
-				ID:             service.ID,
-				Name:           service.Name,
-				Version:        service.Version,
-				NextReset:      service.NextReset,
-				MaxRequests:    service.MaxRequests,
-				ResetFrequency: service.ResetFrequency,
-				AssignedAt:     service.AssignedAt,
+				ID:               service.ID,
+				Name:             service.Name,
+				Version:          service.Version,
+				NextReset:        service.NextReset,
+				MaxRequests:      service.MaxRequests,
+				ResetFrequency:   service.ResetFrequency,
+				AvailableRequest: service.AvailableRequest,
+				AssignedAt:       service.AssignedAt,
 			}
 		}
 
@@ -48,11 +75,38 @@ func (uc *useCase) Execute(ctx context.Context) ([]*dto.ProjectResponse, errors.
 		}
 	}
 
-	return projectResponses, nil
+	resp := &dto.Page[*dto.ProjectResponse]{
+		Items:      projectResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.projectRepo.Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
 }
 
-func NewUseCase(projectRepo ProjectRepository) UseCase {
+func NewUseCase(
+	validator validator.Validator, projectRepo ProjectRepository,
+) UseCase {
 	return &useCase{
+		validator:   validator,
 		projectRepo: projectRepo,
 	}
 }
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
 	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
 	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
 	gomock "go.uber.org/mock/gomock"
@@ -81,17 +82,32 @@ func (m *MockEnvironmentRepository) EXPECT() *MockEnvironmentRepositoryMockRecor
 	return m.recorder
 }
 
+// CountByProject mocks base method.
+func (m *MockEnvironmentRepository) CountByProject(ctx context.Context, projectID int) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByProject", ctx, projectID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// CountByProject indicates an expected call of CountByProject.
+func (mr *MockEnvironmentRepositoryMockRecorder) CountByProject(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByProject", reflect.TypeOf((*MockEnvironmentRepository)(nil).CountByProject), ctx, projectID)
+}
+
 // ListByProject mocks base method.
-func (m *MockEnvironmentRepository) ListByProject(ctx context.Context, projectID int) ([]*entities.Environment, errors.Error) {
+func (m *MockEnvironmentRepository) ListByProject(ctx context.Context, projectID int, page *dto.Pagination) ([]*entities.Environment, errors.Error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByProject", ctx, projectID)
+	ret := m.ctrl.Call(m, "ListByProject", ctx, projectID, page)
 	ret0, _ := ret[0].([]*entities.Environment)
 	ret1, _ := ret[1].(errors.Error)
 	return ret0, ret1
 }
 
 // ListByProject indicates an expected call of ListByProject.
-func (mr *MockEnvironmentRepositoryMockRecorder) ListByProject(ctx, projectID any) *gomock.Call {
+func (mr *MockEnvironmentRepositoryMockRecorder) ListByProject(ctx, projectID, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByProject", reflect.TypeOf((*MockEnvironmentRepository)(nil).ListByProject), ctx, projectID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByProject", reflect.TypeOf((*MockEnvironmentRepository)(nil).ListByProject), ctx, projectID, page)
 }
@@ -3,6 +3,7 @@ package listenvironments
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
@@ -12,5 +13,6 @@ type ProjectRepository interface {
 }
 
 type EnvironmentRepository interface {
-	ListByProject(ctx context.Context, projectID int) ([]*entities.Environment, errors.Error)
+	ListByProject(ctx context.Context, projectID int, page *dto.Pagination) ([]*entities.Environment, errors.Error)
+	CountByProject(ctx context.Context, projectID int) (int, errors.Error)
 }
@@ -2,14 +2,17 @@ package listenvironments
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
 
 type UseCase interface {
-	Execute(ctx context.Context, id int) ([]*dto.EnvironmentResponse, errors.Error)
+	Execute(ctx context.Context, id int, page *dto.Pagination) (*dto.Page[*dto.EnvironmentResponse], errors.Error)
 }
 
 type useCase struct {
@@ -20,9 +23,12 @@ type useCase struct {
 }
 
 func (uc *useCase) Execute(
-	ctx context.Context, id int,
-) ([]*dto.EnvironmentResponse, errors.Error) {
-	if err := uc.validateID(id); err != nil {
+	ctx context.Context, id int, page *dto.Pagination,
+) (*dto.Page[*dto.EnvironmentResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "project.list_environments")
+	defer span.End()
+
+	if err := uc.validateInput(id, page); err != nil {
 		return nil, err
 	}
 
@@ -40,11 +46,22 @@ func (uc *useCase) Execute(
 		)
 	}
 
-	environments, err := uc.environmentRepo.ListByProject(ctx, id)
+	environments, err := uc.environmentRepo.ListByProject(ctx, id, page)
 	if err != nil {
 		return nil, err
 	}
 
+	environments, nextCursor := dto.TrimPage(
+		environments,
+		page,
+		func(environment *entities.Environment) *dto.Cursor {
+			return &dto.Cursor{
+				Time: environment.CreatedAt,
+				ID:   strconv.Itoa(environment.ID),
+			}
+		},
+	)
+
 	environmentResponses := make([]*dto.EnvironmentResponse, len(environments))
 	for i, environment := range environments {
 		serviceResp := make(
@@ -64,6 +81,7 @@ func (uc *useCase) Execute(
 		environmentResponses[i] = &dto.EnvironmentResponse{
 			ID:        environment.ID,
 			Name:      environment.Name,
+			Type:      environment.Type,
 			Status:    environment.Status,
 			ProjectID: environment.ProjectID,
 			CreatedAt: environment.CreatedAt,
@@ -71,7 +89,34 @@ func (uc *useCase) Execute(
 		}
 	}
 
-	return environmentResponses, nil
+	resp := &dto.Page[*dto.EnvironmentResponse]{
+		Items:      environmentResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.environmentRepo.CountByProject(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validateInput(id int, page *dto.Pagination) errors.Error {
+	var err errors.Error
+
+	if errID := uc.validateID(id); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errPage := uc.validatePage(page); errPage != nil {
+		err = errors.Aggregate(err, errPage)
+	}
+
+	return err
 }
 
 func (uc *useCase) validateID(id int) errors.Error {
@@ -86,6 +131,17 @@ func (uc *useCase) validateID(id int) errors.Error {
 	)
 }
 
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
 func NewUseCase(
 	validator validator.Validator,
 	projectRepo ProjectRepository,
@@ -17,14 +17,17 @@ import (
 // ... Assign Service Use Case ...
 
 type ProjectAssignServiceRepository = assignservice.ProjectRepository
+type AuditAssignServiceRepository = assignservice.AuditRepository
 
 // ... Create Use Case ...
 
 type ProjectCreateRepository = create.ProjectRepository
+type AuditCreateRepository = create.AuditRepository
 
 // ... Delete Use Case ...
 
 type ProjectDeleteRepository = delete.ProjectRepository
+type AuditDeleteRepository = delete.AuditRepository
 
 // ... Get Use Case ...
 
@@ -43,20 +46,24 @@ type EnvironmentListByClientRepository = listenvironments.EnvironmentRepository
 
 type ProjectRemoveServiceRepository = removeservice.ProjectRepository
 type EnvironmentRemoveServiceRepository = removeservice.EnvironmentRepository
+type AuditRemoveServiceRepository = removeservice.AuditRepository
 
 // ... Reset Request Use Case ...
 
 type ProjectResetRequestRepository = resetrequests.ProjectRepository
+type AuditResetRequestRepository = resetrequests.AuditRepository
 
 // ... Reset Due Requests Use Case ...
 
 type ProjectResetDueRequestsRepository = resetduerequests.ProjectRepository
+type WebhookResetDueRequestsRepository = resetduerequests.WebhookRepository
 
 // ... Update Use Case ...
 
 type ProjectUpdateRepository = update.ProjectRepository
+type AuditUpdateRepository = update.AuditRepository
 
 // ... Update Service Use Case ...
 
 type ProjectUpdateServiceRepository = updateservice.ProjectRepository
-type EnvironmentServiceInfiniteQuotaRepository = updateservice.EnvironmentRepository
+type AuditUpdateServiceRepository = updateservice.AuditRepository
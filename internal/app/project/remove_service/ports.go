@@ -3,6 +3,7 @@ package removeservice
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
@@ -14,3 +15,7 @@ type ProjectRepository interface {
 type EnvironmentRepository interface {
 	RemoveServiceFromProjectEnvironments(ctx context.Context, projectID, serviceID int) (int64, errors.Error)
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -3,6 +3,9 @@ package removeservice
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -16,9 +19,13 @@ type useCase struct {
 
 	projectRepo     ProjectRepository
 	environmentRepo EnvironmentRepository
+	auditRepo       AuditRepository
 }
 
 func (uc *useCase) Execute(ctx context.Context, id, serviceID int) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "project.remove_service")
+	defer span.End()
+
 	if err := uc.validateInput(id, serviceID); err != nil {
 		return err
 	}
@@ -58,6 +65,16 @@ func (uc *useCase) Execute(ctx context.Context, id, serviceID int) errors.Error
 		)
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionRemoveService,
+		enums.AuditEntityProject,
+		id,
+		map[string]any{"id": serviceID},
+		nil,
+	)
+
 	return nil
 }
 
@@ -103,10 +120,12 @@ func NewUseCase(
 	validator validator.Validator,
 	projectRepo ProjectRepository,
 	environmentRepo EnvironmentRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:       validator,
 		projectRepo:     projectRepo,
 		environmentRepo: environmentRepo,
+		auditRepo:       auditRepo,
 	}
 }
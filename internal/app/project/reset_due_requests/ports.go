@@ -13,3 +13,7 @@ type ProjectRepository interface {
 	ResetProjectServiceUsage(ctx context.Context, id, serviceID int, nextReset time.Time) ([]*dto.EnvironmentServiceReset, errors.Error)
 	ListProjectServiceDueForReset(ctx context.Context, today time.Time) ([]*entities.Project, errors.Error)
 }
+
+type WebhookRepository interface {
+	CreateDeliveries(ctx context.Context, events []*entities.WebhookEvent) (int, errors.Error)
+}
@@ -2,9 +2,13 @@ package resetduerequests
 
 import (
 	"context"
+	"log"
 	"time"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/utils"
 )
@@ -15,9 +19,13 @@ type UseCase interface {
 
 type useCase struct {
 	projectRepo ProjectRepository
+	webhookRepo WebhookRepository
 }
 
 func (uc *useCase) Execute(ctx context.Context) ([]*dto.ProjectReset, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "project.reset_due_requests")
+	defer span.End()
+
 	today := utils.TruncateToDay(time.Now())
 	projects, err := uc.projectRepo.ListProjectServiceDueForReset(
 		ctx, today,
@@ -27,6 +35,7 @@ func (uc *useCase) Execute(ctx context.Context) ([]*dto.ProjectReset, errors.Err
 	}
 
 	projectsResponse := make([]*dto.ProjectReset, 0)
+	events := make([]*entities.WebhookEvent, 0)
 
 	var errs errors.Error
 	for _, project := range projects {
@@ -43,6 +52,17 @@ func (uc *useCase) Execute(ctx context.Context) ([]*dto.ProjectReset, errors.Err
 			}
 
 			envServices = append(envServices, resp...)
+			events = append(events, &entities.WebhookEvent{
+				Type:      enums.WebhookEventTypeQuotaReset,
+				ProjectID: project.ID,
+				ServiceID: service.ID,
+				Quota: &entities.WebhookEventQuota{
+					Level:            enums.QuotaLevelProject,
+					MaxRequests:      service.MaxRequests,
+					AvailableRequest: service.MaxRequests,
+				},
+				OccurredAt: time.Now(),
+			})
 		}
 
 		projectsResponse = append(projectsResponse, &dto.ProjectReset{
@@ -53,11 +73,31 @@ func (uc *useCase) Execute(ctx context.Context) ([]*dto.ProjectReset, errors.Err
 		})
 	}
 
+	uc.notify(ctx, events)
+
 	return projectsResponse, nil
 }
 
-func NewUseCase(projectRepo ProjectRepository) UseCase {
+// notify queues the quota.reset events of the services that were reset.
+// The quotas are already reset, so failing to queue them is only logged.
+func (uc *useCase) notify(ctx context.Context, events []*entities.WebhookEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	if _, err := uc.webhookRepo.CreateDeliveries(ctx, events); err != nil {
+		log.Printf(
+			"[WARN] Failed to queue webhooks for %d quota resets: %v",
+			len(events), err,
+		)
+	}
+}
+
+func NewUseCase(
+	projectRepo ProjectRepository, webhookRepo WebhookRepository,
+) UseCase {
 	return &useCase{
 		projectRepo: projectRepo,
+		webhookRepo: webhookRepo,
 	}
 }
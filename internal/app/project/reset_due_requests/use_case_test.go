@@ -22,6 +22,7 @@ type UseCaseSuite struct {
 	ctrl *gomock.Controller
 
 	projectRepo *mock.MockProjectRepository
+	webhookRepo *mock.MockWebhookRepository
 
 	useCase UseCase
 
@@ -33,8 +34,9 @@ func (s *UseCaseSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 
 	s.projectRepo = mock.NewMockProjectRepository(s.ctrl)
+	s.webhookRepo = mock.NewMockWebhookRepository(s.ctrl)
 
-	s.useCase = NewUseCase(s.projectRepo)
+	s.useCase = NewUseCase(s.projectRepo, s.webhookRepo)
 
 	s.ctx = context.Background()
 }
@@ -100,6 +102,21 @@ func (s *UseCaseSuite) TestExecute_Success_SingleProjectSingleService() {
 		}).
 		Times(1)
 
+	s.webhookRepo.EXPECT().
+		CreateDeliveries(s.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, events []*entities.WebhookEvent) (int, errors.Error) {
+			s.Require().Len(events, 1)
+			s.Equal(enums.WebhookEventTypeQuotaReset, events[0].Type)
+			s.Equal(project.ID, events[0].ProjectID)
+			s.Equal(project.Services[0].ID, events[0].ServiceID)
+			s.Require().NotNil(events[0].Quota)
+			s.Equal(enums.QuotaLevelProject, events[0].Quota.Level)
+			s.Equal(1000, events[0].Quota.MaxRequests)
+			s.Equal(1000, events[0].Quota.AvailableRequest)
+			return 1, nil
+		}).
+		Times(1)
+
 	// Execute
 	result, err := s.useCase.Execute(s.ctx)
 
@@ -191,6 +208,11 @@ func (s *UseCaseSuite) TestExecute_Success_MultipleProjectsMultipleServices() {
 		Return(envServiceResets3, nil).
 		Times(1)
 
+	s.webhookRepo.EXPECT().
+		CreateDeliveries(s.ctx, gomock.Len(3)).
+		Return(3, nil).
+		Times(1)
+
 	// Execute
 	result, err := s.useCase.Execute(s.ctx)
 
@@ -378,6 +400,12 @@ func (s *UseCaseSuite) TestExecute_Error_PartialFailure_MultipleProjects() {
 		Return(envServiceResets, nil).
 		Times(1)
 
+	// Only the services that were reset are notified
+	s.webhookRepo.EXPECT().
+		CreateDeliveries(s.ctx, gomock.Len(2)).
+		Return(2, nil).
+		Times(1)
+
 	// Execute
 	result, err := s.useCase.Execute(s.ctx)
 
@@ -3,7 +3,10 @@ package resetrequests
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -16,11 +19,15 @@ type useCase struct {
 	validator validator.Validator
 
 	projectRepo ProjectRepository
+	auditRepo   AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, id, serviceID int, recalculateNextReset bool,
 ) (*dto.ProjectResetRequestResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "project.reset_requests")
+	defer span.End()
+
 	if err := uc.validateInput(id, serviceID); err != nil {
 		return nil, err
 	}
@@ -52,6 +59,8 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	before := *service
+
 	var envServices []*dto.EnvironmentServiceReset
 	if recalculateNextReset {
 		service.CalculateNextReset()
@@ -70,17 +79,30 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	service.AvailableRequest = service.MaxRequests
+
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionResetRequests,
+		enums.AuditEntityProject,
+		id,
+		&before,
+		service,
+	)
+
 	return &dto.ProjectResetRequestResponse{
 		ResetCount:          len(envServices),
 		EnvironmentServices: envServices,
 		ProjectService: &dto.ProjectServiceResponse{
-			ID:             service.ID,
-			Name:           service.Name,
-			Version:        service.Version,
-			NextReset:      service.NextReset,
-			MaxRequests:    service.MaxRequests,
-			ResetFrequency: service.ResetFrequency,
-			AssignedAt:     service.AssignedAt,
+			ID:               service.ID,
+			Name:             service.Name,
+			Version:          service.Version,
+			NextReset:        service.NextReset,
+			MaxRequests:      service.MaxRequests,
+			ResetFrequency:   service.ResetFrequency,
+			AvailableRequest: service.AvailableRequest,
+			AssignedAt:       service.AssignedAt,
 		},
 	}, nil
 }
@@ -124,10 +146,13 @@ func (uc *useCase) validateServiceID(serviceID int) errors.Error {
 }
 
 func NewUseCase(
-	validator validator.Validator, projectRepo ProjectRepository,
+	validator validator.Validator,
+	projectRepo ProjectRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:   validator,
 		projectRepo: projectRepo,
+		auditRepo:   auditRepo,
 	}
 }
@@ -3,11 +3,17 @@ package update
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
 type ProjectRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Project, errors.Error)
 	Update(ctx context.Context, id int, update *dto.ProjectUpdate) (*entities.Project, errors.Error)
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
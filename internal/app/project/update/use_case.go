@@ -3,7 +3,10 @@ package update
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -16,32 +19,52 @@ type useCase struct {
 	validator validator.Validator
 
 	projectRepo ProjectRepository
+	auditRepo   AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, id int, req *dto.ProjectUpdate,
 ) (*dto.ProjectResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "project.update")
+	defer span.End()
+
 	if err := uc.validateInput(id, req); err != nil {
 		return nil, err
 	}
 
+	before, err := uc.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
 	project, err := uc.projectRepo.Update(ctx, id, req)
 	if err != nil {
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionUpdate,
+		enums.AuditEntityProject,
+		project.ID,
+		before,
+		project,
+	)
+
 	serviceResp := make(
 		[]*dto.ProjectServiceResponse, len(project.Services),
 	)
 	for i, service := range project.Services {
 		serviceResp[i] = &dto.ProjectServiceResponse{
-			ID:             service.ID,
-			Name:           service.Name,
-			Version:        service.Version,
-			NextReset:      service.NextReset,
-			MaxRequests:    service.MaxRequests,
-			ResetFrequency: service.ResetFrequency,
-			AssignedAt:     service.AssignedAt,
+			ID:               service.ID,
+			Name:             service.Name,
+			Version:          service.Version,
+			NextReset:        service.NextReset,
+			MaxRequests:      service.MaxRequests,
+			ResetFrequency:   service.ResetFrequency,
+			AvailableRequest: service.AvailableRequest,
+			AssignedAt:       service.AssignedAt,
 		}
 	}
 
@@ -86,10 +109,13 @@ func (uc *useCase) validateReq(req *dto.ProjectUpdate) errors.Error {
 }
 
 func NewUseCase(
-	validator validator.Validator, projectRepo ProjectRepository,
+	validator validator.Validator,
+	projectRepo ProjectRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:   validator,
 		projectRepo: projectRepo,
+		auditRepo:   auditRepo,
 	}
 }
@@ -73,6 +73,21 @@ func (mr *MockProjectRepositoryMockRecorder) GetProjectServiceQuotaUsage(ctx, id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectServiceQuotaUsage", reflect.TypeOf((*MockProjectRepository)(nil).GetProjectServiceQuotaUsage), ctx, id, serviceID)
 }
 
+// GetServiceByID mocks base method.
+func (m *MockProjectRepository) GetServiceByID(ctx context.Context, id, serviceID int) (*entities.ProjectService, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceByID", ctx, id, serviceID)
+	ret0, _ := ret[0].(*entities.ProjectService)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// GetServiceByID indicates an expected call of GetServiceByID.
+func (mr *MockProjectRepositoryMockRecorder) GetServiceByID(ctx, id, serviceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceByID", reflect.TypeOf((*MockProjectRepository)(nil).GetServiceByID), ctx, id, serviceID)
+}
+
 // UpdateService mocks base method.
 func (m *MockProjectRepository) UpdateService(ctx context.Context, id, serviceID int, update *dto.ProjectServiceUpdate) (*entities.ProjectService, errors.Error) {
 	m.ctrl.T.Helper()
@@ -88,41 +103,40 @@ func (mr *MockProjectRepositoryMockRecorder) UpdateService(ctx, id, serviceID, u
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*MockProjectRepository)(nil).UpdateService), ctx, id, serviceID, update)
 }
 
-// MockEnvironmentRepository is a mock of EnvironmentRepository interface.
-type MockEnvironmentRepository struct {
+// MockAuditRepository is a mock of AuditRepository interface.
+type MockAuditRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockEnvironmentRepositoryMockRecorder
+	recorder *MockAuditRepositoryMockRecorder
 	isgomock struct{}
 }
 
-// MockEnvironmentRepositoryMockRecorder is the mock recorder for MockEnvironmentRepository.
-type MockEnvironmentRepositoryMockRecorder struct {
-	mock *MockEnvironmentRepository
+// MockAuditRepositoryMockRecorder is the mock recorder for MockAuditRepository.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
 }
 
-// NewMockEnvironmentRepository creates a new mock instance.
-func NewMockEnvironmentRepository(ctrl *gomock.Controller) *MockEnvironmentRepository {
-	mock := &MockEnvironmentRepository{ctrl: ctrl}
-	mock.recorder = &MockEnvironmentRepositoryMockRecorder{mock}
+// NewMockAuditRepository creates a new mock instance.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockEnvironmentRepository) EXPECT() *MockEnvironmentRepositoryMockRecorder {
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
 	return m.recorder
 }
 
-// ExistsServiceWithInfiniteMaxRequest mocks base method.
-func (m *MockEnvironmentRepository) ExistsServiceWithInfiniteMaxRequest(ctx context.Context, projectID, serviceID int) (bool, errors.Error) {
+// Create mocks base method.
+func (m *MockAuditRepository) Create(ctx context.Context, entry *entities.AuditEntry) errors.Error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExistsServiceWithInfiniteMaxRequest", ctx, projectID, serviceID)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(errors.Error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Create", ctx, entry)
+	ret0, _ := ret[0].(errors.Error)
+	return ret0
 }
 
-// ExistsServiceWithInfiniteMaxRequest indicates an expected call of ExistsServiceWithInfiniteMaxRequest.
-func (mr *MockEnvironmentRepositoryMockRecorder) ExistsServiceWithInfiniteMaxRequest(ctx, projectID, serviceID any) *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockAuditRepositoryMockRecorder) Create(ctx, entry any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsServiceWithInfiniteMaxRequest", reflect.TypeOf((*MockEnvironmentRepository)(nil).ExistsServiceWithInfiniteMaxRequest), ctx, projectID, serviceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditRepository)(nil).Create), ctx, entry)
 }
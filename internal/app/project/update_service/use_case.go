@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
@@ -19,13 +21,16 @@ type UseCase interface {
 type useCase struct {
 	validator validator.Validator
 
-	projectRepo     ProjectRepository
-	environmentRepo EnvironmentRepository
+	projectRepo ProjectRepository
+	auditRepo   AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, id, serviceID int, req *dto.ProjectServiceUpdate,
 ) (*dto.ProjectServiceResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "project.update_service")
+	defer span.End()
+
 	if req.NextReset.IsZero() && req.ResetFrequency != enums.ProjectServiceResetFrequencyNull {
 		service := entities.ProjectService{
 			MaxRequests:    req.MaxRequests,
@@ -71,28 +76,20 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
-	if req.MaxRequests != -1 {
-		if req.MaxRequests < quota.CurrentAllocated {
-			return nil, errors.NewAttributeValidationFailed(
-				"ProjectServiceUpdate",
-				"max_requests",
-				"max_requests is below the total allocated to environments",
-				nil,
-			)
-		}
-		hasInfinite, err := uc.environmentRepo.ExistsServiceWithInfiniteMaxRequest(
-			ctx, id, serviceID,
+	// Environments with unlimited max_requests are bounded by the project
+	// pool on consume, so only finite allocations are checked here.
+	if req.MaxRequests != -1 && req.MaxRequests < quota.CurrentAllocated {
+		return nil, errors.NewAttributeValidationFailed(
+			"ProjectServiceUpdate",
+			"max_requests",
+			"max_requests is below the total allocated to environments",
+			nil,
 		)
-		if err != nil {
-			return nil, err
-		}
+	}
 
-		if hasInfinite {
-			return nil, errors.NewValidationFailed(
-				"cannot set a finite max_requests while some environments have infinite quota",
-				nil,
-			)
-		}
+	before, err := uc.projectRepo.GetServiceByID(ctx, id, serviceID)
+	if err != nil {
+		return nil, err
 	}
 
 	service, err := uc.projectRepo.UpdateService(ctx, id, serviceID, req)
@@ -100,14 +97,25 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionUpdateService,
+		enums.AuditEntityProject,
+		id,
+		before,
+		service,
+	)
+
 	return &dto.ProjectServiceResponse{
-		ID:             service.ID,
-		Name:           service.Name,
-		Version:        service.Version,
-		NextReset:      service.NextReset,
-		MaxRequests:    service.MaxRequests,
-		ResetFrequency: service.ResetFrequency,
-		AssignedAt:     service.AssignedAt,
+		ID:               service.ID,
+		Name:             service.Name,
+		Version:          service.Version,
+		NextReset:        service.NextReset,
+		MaxRequests:      service.MaxRequests,
+		ResetFrequency:   service.ResetFrequency,
+		AvailableRequest: service.AvailableRequest,
+		AssignedAt:       service.AssignedAt,
 	}, nil
 }
 
@@ -189,11 +197,11 @@ func (uc *useCase) validateReq(req *dto.ProjectServiceUpdate) errors.Error {
 func NewUseCase(
 	validator validator.Validator,
 	projectRepo ProjectRepository,
-	environmentRepo EnvironmentRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
-		validator:       validator,
-		projectRepo:     projectRepo,
-		environmentRepo: environmentRepo,
+		validator:   validator,
+		projectRepo: projectRepo,
+		auditRepo:   auditRepo,
 	}
 }
@@ -20,9 +20,11 @@ import (
 type AssignServiceUseCase = assignservice.UseCase
 
 func NewAssignServiceUseCase(
-	validator validator.Validator, projectRepo ProjectAssignServiceRepository,
+	validator validator.Validator,
+	projectRepo ProjectAssignServiceRepository,
+	auditRepo AuditAssignServiceRepository,
 ) AssignServiceUseCase {
-	return assignservice.NewUseCase(validator, projectRepo)
+	return assignservice.NewUseCase(validator, projectRepo, auditRepo)
 }
 
 // ... Create Use Case ...
@@ -30,9 +32,11 @@ func NewAssignServiceUseCase(
 type CreateUseCase = create.UseCase
 
 func NewCreateUseCase(
-	validator validator.Validator, projectRepo ProjectCreateRepository,
+	validator validator.Validator,
+	projectRepo ProjectCreateRepository,
+	auditRepo AuditCreateRepository,
 ) CreateUseCase {
-	return create.NewUseCase(validator, projectRepo)
+	return create.NewUseCase(validator, projectRepo, auditRepo)
 }
 
 // ... Delete Use Case ...
@@ -40,9 +44,11 @@ func NewCreateUseCase(
 type DeleteUseCase = delete.UseCase
 
 func NewDeleteUseCase(
-	validator validator.Validator, projectRepo ProjectDeleteRepository,
+	validator validator.Validator,
+	projectRepo ProjectDeleteRepository,
+	auditRepo AuditDeleteRepository,
 ) DeleteUseCase {
-	return delete.NewUseCase(validator, projectRepo)
+	return delete.NewUseCase(validator, projectRepo, auditRepo)
 }
 
 // ... Get Use Case ...
@@ -59,8 +65,10 @@ func NewGetUseCase(
 
 type ListUseCase = list.UseCase
 
-func NewListUseCase(projectRepo ProjectListRepository) ListUseCase {
-	return list.NewUseCase(projectRepo)
+func NewListUseCase(
+	validator validator.Validator, projectRepo ProjectListRepository,
+) ListUseCase {
+	return list.NewUseCase(validator, projectRepo)
 }
 
 // ... List Environments Use Case ...
@@ -83,8 +91,14 @@ func NewRemoveServiceUseCase(
 	validator validator.Validator,
 	projectRepo ProjectRemoveServiceRepository,
 	environmentRepo EnvironmentRemoveServiceRepository,
+	auditRepo AuditRemoveServiceRepository,
 ) RemoveServiceUseCase {
-	return removeservice.NewUseCase(validator, projectRepo, environmentRepo)
+	return removeservice.NewUseCase(
+		validator,
+		projectRepo,
+		environmentRepo,
+		auditRepo,
+	)
 }
 
 // ... Reset Request Use Case ...
@@ -94,8 +108,9 @@ type ResetRequestUseCase = resetrequests.UseCase
 func NewResetRequestUseCase(
 	validator validator.Validator,
 	projectRepo ProjectResetRequestRepository,
+	auditRepo AuditResetRequestRepository,
 ) ResetRequestUseCase {
-	return resetrequests.NewUseCase(validator, projectRepo)
+	return resetrequests.NewUseCase(validator, projectRepo, auditRepo)
 }
 
 // ... Reset Due Requests Use Case ...
@@ -104,8 +119,9 @@ type ResetDueRequestsUseCase = resetduerequests.UseCase
 
 func NewResetDueRequestsUseCase(
 	projectRepo ProjectResetDueRequestsRepository,
+	webhookRepo WebhookResetDueRequestsRepository,
 ) ResetDueRequestsUseCase {
-	return resetduerequests.NewUseCase(projectRepo)
+	return resetduerequests.NewUseCase(projectRepo, webhookRepo)
 }
 
 // ... Update Use Case ...
@@ -113,9 +129,11 @@ func NewResetDueRequestsUseCase(
 type UpdateUseCase = update.UseCase
 
 func NewUpdateUseCase(
-	validator validator.Validator, projectRepo ProjectUpdateRepository,
+	validator validator.Validator,
+	projectRepo ProjectUpdateRepository,
+	auditRepo AuditUpdateRepository,
 ) UpdateUseCase {
-	return update.NewUseCase(validator, projectRepo)
+	return update.NewUseCase(validator, projectRepo, auditRepo)
 }
 
 // ... Update Service Use Case ...
@@ -125,7 +143,7 @@ type UpdateServiceUseCase = updateservice.UseCase
 func NewUpdateServiceUseCase(
 	validator validator.Validator,
 	projectRepo ProjectUpdateServiceRepository,
-	environmentRepo EnvironmentServiceInfiniteQuotaRepository,
+	auditRepo AuditUpdateServiceRepository,
 ) UpdateServiceUseCase {
-	return updateservice.NewUseCase(validator, projectRepo, environmentRepo)
+	return updateservice.NewUseCase(validator, projectRepo, auditRepo)
 }
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/request/create_partitions/ports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/request/create_partitions/ports.go -destination=internal/app/request/create_partitions/mock/ports.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRequestRepository is a mock of RequestRepository interface.
+type MockRequestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRequestRepositoryMockRecorder is the mock recorder for MockRequestRepository.
+type MockRequestRepositoryMockRecorder struct {
+	mock *MockRequestRepository
+}
+
+// NewMockRequestRepository creates a new mock instance.
+func NewMockRequestRepository(ctrl *gomock.Controller) *MockRequestRepository {
+	mock := &MockRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockRequestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestRepository) EXPECT() *MockRequestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePartition mocks base method.
+func (m *MockRequestRepository) CreatePartition(ctx context.Context, from, to time.Time) (bool, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePartition", ctx, from, to)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// CreatePartition indicates an expected call of CreatePartition.
+func (mr *MockRequestRepositoryMockRecorder) CreatePartition(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePartition", reflect.TypeOf((*MockRequestRepository)(nil).CreatePartition), ctx, from, to)
+}
@@ -0,0 +1,12 @@
+package createpartitions
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type RequestRepository interface {
+	CreatePartition(ctx context.Context, from, to time.Time) (bool, errors.Error)
+}
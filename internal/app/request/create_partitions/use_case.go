@@ -0,0 +1,53 @@
+package createpartitions
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// monthsAhead is how many months after the current one have their partition
+// created in advance, so requests never fall into the default partition.
+const monthsAhead = 3
+
+type UseCase interface {
+	Execute(ctx context.Context) (int, errors.Error)
+}
+
+type useCase struct {
+	requestRepo RequestRepository
+}
+
+// Execute creates the monthly partitions of the request log missing for the
+// current month and the following ones, and returns how many it created.
+func (uc *useCase) Execute(ctx context.Context) (int, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "request.create_partitions")
+	defer span.End()
+
+	now := time.Now().UTC()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	var created int
+	for i := 0; i <= monthsAhead; i++ {
+		from := month.AddDate(0, i, 0)
+
+		ok, err := uc.requestRepo.CreatePartition(ctx, from, from.AddDate(0, 1, 0))
+		if err != nil {
+			return created, err
+		}
+
+		if ok {
+			created++
+		}
+	}
+
+	return created, nil
+}
+
+func NewUseCase(requestRepo RequestRepository) UseCase {
+	return &useCase{
+		requestRepo: requestRepo,
+	}
+}
@@ -0,0 +1,74 @@
+package createpartitions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/request/create_partitions/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	requestRepo *mock.MockRequestRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	time.Local = time.UTC
+	s.ctrl = gomock.NewController(s.T())
+
+	s.requestRepo = mock.NewMockRequestRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.requestRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) TestCreatesMissingMonthlyPartitions() {
+	now := time.Now().UTC()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i <= monthsAhead; i++ {
+		from := month.AddDate(0, i, 0)
+		s.requestRepo.EXPECT().
+			CreatePartition(s.ctx, from, from.AddDate(0, 1, 0)).
+			Return(i > 0, nil).
+			Times(1)
+	}
+
+	created, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(monthsAhead, created)
+}
+
+func (s *UseCaseSuite) TestStopsOnError() {
+	s.requestRepo.EXPECT().
+		CreatePartition(s.ctx, gomock.Any(), gomock.Any()).
+		Return(false, errors.NewInternal("failed", nil)).
+		Times(1)
+
+	created, err := s.useCase.Execute(s.ctx)
+
+	s.Require().Error(err)
+	s.Zero(created)
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
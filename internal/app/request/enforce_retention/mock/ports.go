@@ -0,0 +1,250 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/request/enforce_retention/ports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/request/enforce_retention/ports.go -destination=internal/app/request/enforce_retention/mock/ports.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRequestRepository is a mock of RequestRepository interface.
+type MockRequestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRequestRepositoryMockRecorder is the mock recorder for MockRequestRepository.
+type MockRequestRepositoryMockRecorder struct {
+	mock *MockRequestRepository
+}
+
+// NewMockRequestRepository creates a new mock instance.
+func NewMockRequestRepository(ctrl *gomock.Controller) *MockRequestRepository {
+	mock := &MockRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockRequestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestRepository) EXPECT() *MockRequestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByIDs mocks base method.
+func (m *MockRequestRepository) DeleteByIDs(ctx context.Context, ids []string) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByIDs", ctx, ids)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// DeleteByIDs indicates an expected call of DeleteByIDs.
+func (mr *MockRequestRepositoryMockRecorder) DeleteByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByIDs", reflect.TypeOf((*MockRequestRepository)(nil).DeleteByIDs), ctx, ids)
+}
+
+// DropPartition mocks base method.
+func (m *MockRequestRepository) DropPartition(ctx context.Context, partition string) errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DropPartition", ctx, partition)
+	ret0, _ := ret[0].(errors.Error)
+	return ret0
+}
+
+// DropPartition indicates an expected call of DropPartition.
+func (mr *MockRequestRepositoryMockRecorder) DropPartition(ctx, partition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropPartition", reflect.TypeOf((*MockRequestRepository)(nil).DropPartition), ctx, partition)
+}
+
+// ListByPartition mocks base method.
+func (m *MockRequestRepository) ListByPartition(ctx context.Context, partition string, page *dto.Pagination) ([]*entities.Request, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPartition", ctx, partition, page)
+	ret0, _ := ret[0].([]*entities.Request)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// ListByPartition indicates an expected call of ListByPartition.
+func (mr *MockRequestRepositoryMockRecorder) ListByPartition(ctx, partition, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPartition", reflect.TypeOf((*MockRequestRepository)(nil).ListByPartition), ctx, partition, page)
+}
+
+// ListExpired mocks base method.
+func (m *MockRequestRepository) ListExpired(ctx context.Context, retention *dto.RequestRetention, limit int) ([]*entities.Request, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpired", ctx, retention, limit)
+	ret0, _ := ret[0].([]*entities.Request)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// ListExpired indicates an expected call of ListExpired.
+func (mr *MockRequestRepositoryMockRecorder) ListExpired(ctx, retention, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpired", reflect.TypeOf((*MockRequestRepository)(nil).ListExpired), ctx, retention, limit)
+}
+
+// ListOrphaned mocks base method.
+func (m *MockRequestRepository) ListOrphaned(ctx context.Context, before time.Time, limit int) ([]*entities.Request, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrphaned", ctx, before, limit)
+	ret0, _ := ret[0].([]*entities.Request)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// ListOrphaned indicates an expected call of ListOrphaned.
+func (mr *MockRequestRepositoryMockRecorder) ListOrphaned(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrphaned", reflect.TypeOf((*MockRequestRepository)(nil).ListOrphaned), ctx, before, limit)
+}
+
+// ListPartitions mocks base method.
+func (m *MockRequestRepository) ListPartitions(ctx context.Context) ([]*entities.RequestPartition, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPartitions", ctx)
+	ret0, _ := ret[0].([]*entities.RequestPartition)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// ListPartitions indicates an expected call of ListPartitions.
+func (mr *MockRequestRepositoryMockRecorder) ListPartitions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPartitions", reflect.TypeOf((*MockRequestRepository)(nil).ListPartitions), ctx)
+}
+
+// MockServiceRepository is a mock of ServiceRepository interface.
+type MockServiceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceRepositoryMockRecorder is the mock recorder for MockServiceRepository.
+type MockServiceRepositoryMockRecorder struct {
+	mock *MockServiceRepository
+}
+
+// NewMockServiceRepository creates a new mock instance.
+func NewMockServiceRepository(ctrl *gomock.Controller) *MockServiceRepository {
+	mock := &MockServiceRepository{ctrl: ctrl}
+	mock.recorder = &MockServiceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceRepository) EXPECT() *MockServiceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListWithRetention mocks base method.
+func (m *MockServiceRepository) ListWithRetention(ctx context.Context) ([]*entities.Service, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithRetention", ctx)
+	ret0, _ := ret[0].([]*entities.Service)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// ListWithRetention indicates an expected call of ListWithRetention.
+func (mr *MockServiceRepositoryMockRecorder) ListWithRetention(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithRetention", reflect.TypeOf((*MockServiceRepository)(nil).ListWithRetention), ctx)
+}
+
+// MockUsageRepository is a mock of UsageRepository interface.
+type MockUsageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsageRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUsageRepositoryMockRecorder is the mock recorder for MockUsageRepository.
+type MockUsageRepositoryMockRecorder struct {
+	mock *MockUsageRepository
+}
+
+// NewMockUsageRepository creates a new mock instance.
+func NewMockUsageRepository(ctrl *gomock.Controller) *MockUsageRepository {
+	mock := &MockUsageRepository{ctrl: ctrl}
+	mock.recorder = &MockUsageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsageRepository) EXPECT() *MockUsageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetRollupWatermark mocks base method.
+func (m *MockUsageRepository) GetRollupWatermark(ctx context.Context) (time.Time, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRollupWatermark", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// GetRollupWatermark indicates an expected call of GetRollupWatermark.
+func (mr *MockUsageRepositoryMockRecorder) GetRollupWatermark(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRollupWatermark", reflect.TypeOf((*MockUsageRepository)(nil).GetRollupWatermark), ctx)
+}
+
+// MockRequestArchiver is a mock of RequestArchiver interface.
+type MockRequestArchiver struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestArchiverMockRecorder
+	isgomock struct{}
+}
+
+// MockRequestArchiverMockRecorder is the mock recorder for MockRequestArchiver.
+type MockRequestArchiverMockRecorder struct {
+	mock *MockRequestArchiver
+}
+
+// NewMockRequestArchiver creates a new mock instance.
+func NewMockRequestArchiver(ctrl *gomock.Controller) *MockRequestArchiver {
+	mock := &MockRequestArchiver{ctrl: ctrl}
+	mock.recorder = &MockRequestArchiverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestArchiver) EXPECT() *MockRequestArchiverMockRecorder {
+	return m.recorder
+}
+
+// Archive mocks base method.
+func (m *MockRequestArchiver) Archive(ctx context.Context, requests []*entities.Request) errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", ctx, requests)
+	ret0, _ := ret[0].(errors.Error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockRequestArchiverMockRecorder) Archive(ctx, requests any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockRequestArchiver)(nil).Archive), ctx, requests)
+}
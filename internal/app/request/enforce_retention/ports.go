@@ -0,0 +1,31 @@
+package enforceretention
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type RequestRepository interface {
+	ListExpired(ctx context.Context, retention *dto.RequestRetention, limit int) ([]*entities.Request, errors.Error)
+	ListOrphaned(ctx context.Context, before time.Time, limit int) ([]*entities.Request, errors.Error)
+	ListByPartition(ctx context.Context, partition string, page *dto.Pagination) ([]*entities.Request, errors.Error)
+	ListPartitions(ctx context.Context) ([]*entities.RequestPartition, errors.Error)
+	DeleteByIDs(ctx context.Context, ids []string) (int, errors.Error)
+	DropPartition(ctx context.Context, partition string) errors.Error
+}
+
+type ServiceRepository interface {
+	ListWithRetention(ctx context.Context) ([]*entities.Service, errors.Error)
+}
+
+type UsageRepository interface {
+	GetRollupWatermark(ctx context.Context) (time.Time, errors.Error)
+}
+
+type RequestArchiver interface {
+	Archive(ctx context.Context, requests []*entities.Request) errors.Error
+}
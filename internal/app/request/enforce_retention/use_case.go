@@ -0,0 +1,280 @@
+package enforceretention
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+// batchSize is how many requests are archived and deleted at a time.
+const batchSize = 1000
+
+type UseCase interface {
+	Execute(ctx context.Context) (*dto.RequestRetentionResult, errors.Error)
+}
+
+type useCase struct {
+	requestRepo RequestRepository
+	serviceRepo ServiceRepository
+	usageRepo   UsageRepository
+
+	archiver RequestArchiver
+
+	retentionDays int
+}
+
+// Execute deletes the requests older than their retention, archiving them
+// first when an archiver is set. Partitions whose requests have all expired
+// are dropped whole, the remaining expired requests are deleted in batches.
+// Requests created after the rollup watermark are kept until they are
+// rolled up, so their usage is never lost.
+func (uc *useCase) Execute(
+	ctx context.Context,
+) (*dto.RequestRetentionResult, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "request.enforce_retention")
+	defer span.End()
+
+	services, err := uc.serviceRepo.ListWithRetention(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	watermark, err := uc.usageRepo.GetRollupWatermark(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := new(dto.RequestRetentionResult)
+
+	if cutoff, ok := uc.partitionCutoff(now, services); ok {
+		cutoff = rolledUp(cutoff, watermark)
+		dropped, err := uc.dropPartitions(ctx, cutoff)
+		if err != nil {
+			return nil, err
+		}
+		result.DroppedPartitions = dropped
+	}
+
+	for _, retention := range uc.retentions(now, services) {
+		retention.Before = rolledUp(retention.Before, watermark)
+		if retention.Before.IsZero() {
+			continue
+		}
+
+		deleted, err := uc.deleteBatches(
+			ctx,
+			func(ctx context.Context) ([]*entities.Request, errors.Error) {
+				return uc.requestRepo.ListExpired(ctx, retention, batchSize)
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		result.DeletedRequests += deleted
+	}
+
+	// A dropped partition may hold the initial request of chains whose
+	// later requests are in the next partition, which go along with it.
+	if result.DroppedPartitions > 0 {
+		deleted, err := uc.deleteBatches(
+			ctx,
+			func(ctx context.Context) ([]*entities.Request, errors.Error) {
+				return uc.requestRepo.ListOrphaned(ctx, watermark, batchSize)
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		result.DeletedRequests += deleted
+	}
+
+	return result, nil
+}
+
+// rolledUp returns the earliest of before and the watermark, before which
+// every request has been rolled up.
+func rolledUp(before, watermark time.Time) time.Time {
+	if watermark.Before(before) {
+		return watermark
+	}
+	return before
+}
+
+// partitionCutoff returns the time before which the requests have expired
+// under every retention, and false when some requests are kept forever.
+func (uc *useCase) partitionCutoff(
+	now time.Time, services []*entities.Service,
+) (time.Time, bool) {
+	days := uc.retentionDays
+	if days == 0 {
+		return time.Time{}, false
+	}
+
+	for _, service := range services {
+		if *service.RequestRetentionDays == 0 {
+			return time.Time{}, false
+		}
+		days = max(days, *service.RequestRetentionDays)
+	}
+
+	return now.AddDate(0, 0, -days), true
+}
+
+// retentions returns the requests to delete under each service retention,
+// then under the global retention for the requests of every other service.
+func (uc *useCase) retentions(
+	now time.Time, services []*entities.Service,
+) []*dto.RequestRetention {
+	var retentions []*dto.RequestRetention
+
+	excluded := make([]int, 0, len(services))
+	for _, service := range services {
+		excluded = append(excluded, service.ID)
+
+		if days := *service.RequestRetentionDays; days > 0 {
+			retentions = append(
+				retentions,
+				&dto.RequestRetention{
+					Before:    now.AddDate(0, 0, -days),
+					ServiceID: service.ID,
+				},
+			)
+		}
+	}
+
+	if uc.retentionDays > 0 {
+		retentions = append(
+			retentions,
+			&dto.RequestRetention{
+				Before:             now.AddDate(0, 0, -uc.retentionDays),
+				ExcludedServiceIDs: excluded,
+			},
+		)
+	}
+
+	return retentions
+}
+
+func (uc *useCase) dropPartitions(
+	ctx context.Context, cutoff time.Time,
+) (int, errors.Error) {
+	partitions, err := uc.requestRepo.ListPartitions(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var dropped int
+	for _, partition := range partitions {
+		if partition.To.IsZero() || partition.To.After(cutoff) {
+			continue
+		}
+
+		if uc.archiver != nil {
+			if err := uc.archivePartition(ctx, partition.Name); err != nil {
+				return dropped, err
+			}
+		}
+
+		if err := uc.requestRepo.DropPartition(ctx, partition.Name); err != nil {
+			return dropped, err
+		}
+		dropped++
+	}
+
+	return dropped, nil
+}
+
+func (uc *useCase) archivePartition(
+	ctx context.Context, partition string,
+) errors.Error {
+	page := &dto.Pagination{Limit: batchSize}
+	for {
+		requests, err := uc.requestRepo.ListByPartition(ctx, partition, page)
+		if err != nil {
+			return err
+		}
+
+		requests, next := dto.TrimPage(
+			requests,
+			page,
+			func(r *entities.Request) *dto.Cursor {
+				return &dto.Cursor{Time: r.CreatedAt, ID: r.ID}
+			},
+		)
+
+		if len(requests) > 0 {
+			if err := uc.archiver.Archive(ctx, requests); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		page.Cursor = next
+	}
+}
+
+// deleteBatches deletes the requests list returns, batch after batch, until
+// it returns a batch that is not full.
+func (uc *useCase) deleteBatches(
+	ctx context.Context,
+	list func(ctx context.Context) ([]*entities.Request, errors.Error),
+) (int, errors.Error) {
+	var deleted int
+	for {
+		requests, err := list(ctx)
+		if err != nil {
+			return deleted, err
+		}
+
+		if len(requests) == 0 {
+			return deleted, nil
+		}
+
+		if uc.archiver != nil {
+			if err := uc.archiver.Archive(ctx, requests); err != nil {
+				return deleted, err
+			}
+		}
+
+		ids := make([]string, len(requests))
+		for i, request := range requests {
+			ids[i] = request.ID
+		}
+
+		n, err := uc.requestRepo.DeleteByIDs(ctx, ids)
+		if err != nil {
+			return deleted, err
+		}
+		deleted += n
+
+		if len(requests) < batchSize {
+			return deleted, nil
+		}
+	}
+}
+
+// NewUseCase returns the use case enforcing retentionDays, the global
+// retention, of which 0 keeps requests forever. The archiver may be nil, in
+// which case expired requests are deleted without being archived.
+func NewUseCase(
+	requestRepo RequestRepository,
+	serviceRepo ServiceRepository,
+	usageRepo UsageRepository,
+	archiver RequestArchiver,
+	retentionDays int,
+) UseCase {
+	return &useCase{
+		requestRepo:   requestRepo,
+		serviceRepo:   serviceRepo,
+		usageRepo:     usageRepo,
+		archiver:      archiver,
+		retentionDays: retentionDays,
+	}
+}
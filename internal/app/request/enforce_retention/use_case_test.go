@@ -0,0 +1,419 @@
+package enforceretention
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/request/enforce_retention/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	requestRepo *mock.MockRequestRepository
+	serviceRepo *mock.MockServiceRepository
+	usageRepo   *mock.MockUsageRepository
+	archiver    *mock.MockRequestArchiver
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	time.Local = time.UTC
+	s.ctrl = gomock.NewController(s.T())
+
+	s.requestRepo = mock.NewMockRequestRepository(s.ctrl)
+	s.serviceRepo = mock.NewMockServiceRepository(s.ctrl)
+	s.usageRepo = mock.NewMockUsageRepository(s.ctrl)
+	s.archiver = mock.NewMockRequestArchiver(s.ctrl)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) service(id, days int) *entities.Service {
+	return &entities.Service{ID: id, RequestRetentionDays: &days}
+}
+
+func (s *UseCaseSuite) requests(n int) []*entities.Request {
+	requests := make([]*entities.Request, n)
+	for i := range requests {
+		requests[i] = &entities.Request{
+			ID:        fmt.Sprintf("request-%d", i),
+			CreatedAt: time.Now().AddDate(0, 0, -100),
+		}
+	}
+	return requests
+}
+
+// expectWatermark makes every request created before the watermark rolled
+// up.
+func (s *UseCaseSuite) expectWatermark(watermark time.Time) {
+	s.usageRepo.EXPECT().
+		GetRollupWatermark(s.ctx).
+		Return(watermark, nil).
+		Times(1)
+}
+
+func (s *UseCaseSuite) before(days int) gomock.Matcher {
+	return gomock.Cond(func(r *dto.RequestRetention) bool {
+		want := time.Now().AddDate(0, 0, -days)
+		return r.Before.After(want.Add(-time.Minute)) && !r.Before.After(want)
+	})
+}
+
+func (s *UseCaseSuite) TestKeepsEverythingWithoutRetention() {
+	useCase := NewUseCase(s.requestRepo, s.serviceRepo, s.usageRepo, s.archiver, 0)
+
+	s.serviceRepo.EXPECT().
+		ListWithRetention(s.ctx).
+		Return(nil, nil).
+		Times(1)
+
+	s.expectWatermark(time.Now())
+
+	result, err := useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(&dto.RequestRetentionResult{}, result)
+}
+
+func (s *UseCaseSuite) TestDropsExpiredPartitionsAndDeletesTheRest() {
+	useCase := NewUseCase(s.requestRepo, s.serviceRepo, s.usageRepo, s.archiver, 30)
+
+	s.serviceRepo.EXPECT().
+		ListWithRetention(s.ctx).
+		Return(nil, nil).
+		Times(1)
+
+	s.expectWatermark(time.Now())
+
+	s.requestRepo.EXPECT().
+		ListPartitions(s.ctx).
+		Return(
+			[]*entities.RequestPartition{
+				{Name: "request_legacy", To: time.Now().AddDate(0, 0, -60)},
+				{
+					Name: "request_current",
+					From: time.Now().AddDate(0, 0, -20),
+					To:   time.Now().AddDate(0, 0, 10),
+				},
+				{Name: "request_default"},
+			},
+			nil,
+		).
+		Times(1)
+
+	partitionRequests := s.requests(2)
+	s.requestRepo.EXPECT().
+		ListByPartition(s.ctx, "request_legacy", gomock.Any()).
+		Return(partitionRequests, nil).
+		Times(1)
+
+	s.archiver.EXPECT().
+		Archive(s.ctx, partitionRequests).
+		Return(nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		DropPartition(s.ctx, "request_legacy").
+		Return(nil).
+		Times(1)
+
+	expired := s.requests(3)
+	s.requestRepo.EXPECT().
+		ListExpired(s.ctx, s.before(30), batchSize).
+		Return(expired, nil).
+		Times(1)
+
+	s.archiver.EXPECT().
+		Archive(s.ctx, expired).
+		Return(nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		DeleteByIDs(s.ctx, []string{"request-0", "request-1", "request-2"}).
+		Return(3, nil).
+		Times(1)
+
+	orphaned := s.requests(1)
+	s.requestRepo.EXPECT().
+		ListOrphaned(s.ctx, gomock.Any(), batchSize).
+		Return(orphaned, nil).
+		Times(1)
+
+	s.archiver.EXPECT().
+		Archive(s.ctx, orphaned).
+		Return(nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		DeleteByIDs(s.ctx, []string{"request-0"}).
+		Return(1, nil).
+		Times(1)
+
+	result, err := useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(
+		&dto.RequestRetentionResult{DeletedRequests: 4, DroppedPartitions: 1},
+		result,
+	)
+}
+
+func (s *UseCaseSuite) TestDeletesInBatches() {
+	useCase := NewUseCase(s.requestRepo, s.serviceRepo, s.usageRepo, nil, 0)
+
+	s.serviceRepo.EXPECT().
+		ListWithRetention(s.ctx).
+		Return([]*entities.Service{s.service(5, 7)}, nil).
+		Times(1)
+
+	s.expectWatermark(time.Now())
+
+	serviceRetention := gomock.Cond(func(r *dto.RequestRetention) bool {
+		return r.ServiceID == 5
+	})
+
+	gomock.InOrder(
+		s.requestRepo.EXPECT().
+			ListExpired(s.ctx, serviceRetention, batchSize).
+			Return(s.requests(batchSize), nil),
+		s.requestRepo.EXPECT().
+			DeleteByIDs(s.ctx, gomock.Len(batchSize)).
+			Return(batchSize, nil),
+		s.requestRepo.EXPECT().
+			ListExpired(s.ctx, serviceRetention, batchSize).
+			Return(s.requests(10), nil),
+		s.requestRepo.EXPECT().
+			DeleteByIDs(s.ctx, gomock.Len(10)).
+			Return(10, nil),
+	)
+
+	result, err := useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(batchSize+10, result.DeletedRequests)
+	s.Zero(result.DroppedPartitions)
+}
+
+func (s *UseCaseSuite) TestServiceKeptForeverIsExcluded() {
+	useCase := NewUseCase(s.requestRepo, s.serviceRepo, s.usageRepo, nil, 30)
+
+	s.serviceRepo.EXPECT().
+		ListWithRetention(s.ctx).
+		Return(
+			[]*entities.Service{s.service(7, 0), s.service(8, 90)},
+			nil,
+		).
+		Times(1)
+
+	s.expectWatermark(time.Now())
+
+	s.requestRepo.EXPECT().
+		ListPartitions(gomock.Any()).
+		Times(0)
+
+	s.requestRepo.EXPECT().
+		ListExpired(
+			s.ctx,
+			gomock.Cond(func(r *dto.RequestRetention) bool {
+				return r.ServiceID == 8
+			}),
+			batchSize,
+		).
+		Return(nil, nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		ListExpired(
+			s.ctx,
+			gomock.Cond(func(r *dto.RequestRetention) bool {
+				return r.ServiceID == 0 &&
+					fmt.Sprint(r.ExcludedServiceIDs) == "[7 8]"
+			}),
+			batchSize,
+		).
+		Return(nil, nil).
+		Times(1)
+
+	result, err := useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(&dto.RequestRetentionResult{}, result)
+}
+
+func (s *UseCaseSuite) TestPartitionsKeptUntilLongestRetention() {
+	useCase := NewUseCase(s.requestRepo, s.serviceRepo, s.usageRepo, nil, 30)
+
+	s.serviceRepo.EXPECT().
+		ListWithRetention(s.ctx).
+		Return([]*entities.Service{s.service(8, 90)}, nil).
+		Times(1)
+
+	s.expectWatermark(time.Now())
+
+	s.requestRepo.EXPECT().
+		ListPartitions(s.ctx).
+		Return(
+			[]*entities.RequestPartition{
+				{Name: "request_old", To: time.Now().AddDate(0, 0, -60)},
+			},
+			nil,
+		).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		DropPartition(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.requestRepo.EXPECT().
+		ListExpired(s.ctx, gomock.Any(), batchSize).
+		Return(nil, nil).
+		Times(2)
+
+	result, err := useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Zero(result.DroppedPartitions)
+}
+
+func (s *UseCaseSuite) TestArchiveErrorKeepsRequests() {
+	useCase := NewUseCase(s.requestRepo, s.serviceRepo, s.usageRepo, s.archiver, 30)
+
+	s.serviceRepo.EXPECT().
+		ListWithRetention(s.ctx).
+		Return(nil, nil).
+		Times(1)
+
+	s.expectWatermark(time.Now())
+
+	s.requestRepo.EXPECT().
+		ListPartitions(s.ctx).
+		Return(nil, nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		ListExpired(s.ctx, s.before(30), batchSize).
+		Return(s.requests(1), nil).
+		Times(1)
+
+	s.archiver.EXPECT().
+		Archive(s.ctx, gomock.Any()).
+		Return(errors.NewInternal("disk full", nil)).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		DeleteByIDs(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	result, err := useCase.Execute(s.ctx)
+
+	s.Require().Error(err)
+	s.Nil(result)
+	s.Equal(errors.CodeInternal, err.Code())
+}
+
+func (s *UseCaseSuite) TestKeepsRequestsNotRolledUp() {
+	useCase := NewUseCase(s.requestRepo, s.serviceRepo, s.usageRepo, nil, 30)
+
+	s.serviceRepo.EXPECT().
+		ListWithRetention(s.ctx).
+		Return(nil, nil).
+		Times(1)
+
+	watermark := time.Now().AddDate(0, 0, -40)
+	s.expectWatermark(watermark)
+
+	s.requestRepo.EXPECT().
+		ListPartitions(s.ctx).
+		Return(
+			[]*entities.RequestPartition{
+				{Name: "request_old", To: time.Now().AddDate(0, 0, -60)},
+				{
+					Name: "request_recent",
+					From: time.Now().AddDate(0, 0, -45),
+					To:   time.Now().AddDate(0, 0, -35),
+				},
+			},
+			nil,
+		).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		DropPartition(s.ctx, "request_old").
+		Return(nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		ListExpired(
+			s.ctx,
+			gomock.Cond(func(r *dto.RequestRetention) bool {
+				return r.Before.Equal(watermark)
+			}),
+			batchSize,
+		).
+		Return(nil, nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		ListOrphaned(s.ctx, watermark, batchSize).
+		Return(nil, nil).
+		Times(1)
+
+	result, err := useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(&dto.RequestRetentionResult{DroppedPartitions: 1}, result)
+}
+
+func (s *UseCaseSuite) TestKeepsEverythingBeforeFirstRollup() {
+	useCase := NewUseCase(s.requestRepo, s.serviceRepo, s.usageRepo, nil, 30)
+
+	s.serviceRepo.EXPECT().
+		ListWithRetention(s.ctx).
+		Return([]*entities.Service{s.service(5, 7)}, nil).
+		Times(1)
+
+	s.expectWatermark(time.Time{})
+
+	s.requestRepo.EXPECT().
+		ListPartitions(s.ctx).
+		Return(
+			[]*entities.RequestPartition{
+				{Name: "request_old", To: time.Now().AddDate(0, 0, -60)},
+			},
+			nil,
+		).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		DropPartition(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.requestRepo.EXPECT().
+		ListExpired(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	result, err := useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(&dto.RequestRetentionResult{}, result)
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
@@ -0,0 +1,13 @@
+package export
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type RequestRepository interface {
+	StreamSearch(ctx context.Context, filter *dto.RequestSearch, yield func(*entities.Request) errors.Error) errors.Error
+}
@@ -0,0 +1,111 @@
+package export
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, req *dto.RequestSearch, yield func(*dto.RequestResponse) errors.Error) errors.Error
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	requestRepo RequestRepository
+}
+
+// Execute hands every request matching the search to yield, in the order
+// the search lists them, as they are read from the request log. It stops at
+// the first error yield returns.
+func (uc *useCase) Execute(
+	ctx context.Context,
+	req *dto.RequestSearch,
+	yield func(*dto.RequestResponse) errors.Error,
+) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "request.export")
+	defer span.End()
+
+	if err := uc.validateReq(req); err != nil {
+		return err
+	}
+
+	return uc.requestRepo.StreamSearch(
+		ctx,
+		req,
+		func(request *entities.Request) errors.Error {
+			return yield(
+				&dto.RequestResponse{
+					ID:                 request.ID,
+					StartPoint:         request.StartPoint,
+					Detail:             request.Detail,
+					StatusCode:         request.StatusCode,
+					ExecutionStatus:    request.ExecutionStatus,
+					UnauthorizedReason: request.UnauthorizedReason,
+					Units:              request.Units,
+					RequestTime:        request.RequestTime,
+					Path:               request.Path,
+					Method:             request.Method,
+					IPAddress:          request.IPAddress,
+					CreatedAt:          request.CreatedAt,
+					APIKey: &dto.RequestAPIKeyResponse{
+						ID:  request.APIKey.ID,
+						Key: request.APIKey.KeySummary(),
+					},
+					Project: &dto.RequestProjectResponse{
+						ID:   request.Project.ID,
+						Name: request.Project.Name,
+					},
+					Environment: &dto.RequestEnvironmentResponse{
+						ID:   request.Environment.ID,
+						Name: request.Environment.Name,
+					},
+					Service: &dto.RequestServiceResponse{
+						ID:      request.Service.ID,
+						Name:    request.Service.Name,
+						Version: request.Service.Version,
+					},
+				},
+			)
+		},
+	)
+}
+
+func (uc *useCase) validateReq(req *dto.RequestSearch) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"client_id.gt":              "client_id must be greater than 0",
+			"project_id.gt":             "project_id must be greater than 0",
+			"environment_id.gt":         "environment_id must be greater than 0",
+			"api_key_id.gt":             "api_key_id must be greater than 0",
+			"service_id.gt":             "service_id must be greater than 0",
+			"method.enums":              "method must be one of the following: GET, HEAD, POST, PUT, PATCH, DELETE, CONNECT, OPTIONS, TRACE",
+			"ip_address.ip|cidr":        "ip_address must be a valid IP address or CIDR",
+			"status_code_from.gte":      "status_code_from must be greater than or equal to 100",
+			"status_code_from.lte":      "status_code_from must be less than or equal to 599",
+			"status_code_to.gte":        "status_code_to must be greater than or equal to 100",
+			"status_code_to.lte":        "status_code_to must be less than or equal to 599",
+			"status_code_to.gtefield":   "status_code_to must be greater than or equal to status_code_from",
+			"unauthorized_reason.enums": "unauthorized_reason must be one of the following: API_KEY_INVALID, QUOTA_EXCEEDED, API_KEY_EXPIRED, API_KEY_DISABLED, SERVICE_MISMATCH, SERVICE_DISABLED, SERVICE_DEPRECATED, SERVICE_NOT_ASSIGNED, ENVIRONMENT_DISABLED, RATE_LIMITED",
+			"execution_status.enums":    "execution_status must be one of the following: success, forwarded, client_error, server_error, unauthorized, abandoned",
+			"request_time_to.gtefield":  "request_time_to must be greater than or equal to request_time_from",
+			"sort_by.enums":             "sort_by must be one of the following: created_at, request_time",
+			"sort_order.enums":          "sort_order must be one of the following: asc, desc",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator, requestRepo RequestRepository,
+) UseCase {
+	return &useCase{
+		validator:   validator,
+		requestRepo: requestRepo,
+	}
+}
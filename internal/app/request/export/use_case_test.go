@@ -0,0 +1,160 @@
+package export
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/request/export/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator   *mockvalidator.MockValidator
+	requestRepo *mock.MockRequestRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	time.Local = time.UTC
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.requestRepo = mock.NewMockRequestRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.validator, s.requestRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) expectValidInput() {
+	s.validator.EXPECT().
+		ValidateStruct(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+}
+
+func (s *UseCaseSuite) request(id string) *entities.Request {
+	return &entities.Request{
+		ID:          id,
+		APIKey:      &entities.RequestAPIKey{ID: 1, Key: "pdr_live_abcdefghijklmnop"},
+		Project:     &entities.RequestProject{ID: 2, Name: "Project"},
+		Environment: &entities.RequestEnvironment{ID: 3, Name: "Production"},
+		Service:     &entities.RequestService{ID: 4, Name: "Service", Version: "1.0.0"},
+		Path:        "/items",
+	}
+}
+
+func (s *UseCaseSuite) TestYieldsEveryRequest() {
+	req := &dto.RequestSearch{ProjectID: 2}
+
+	s.expectValidInput()
+
+	s.requestRepo.EXPECT().
+		StreamSearch(s.ctx, req, gomock.Any()).
+		DoAndReturn(
+			func(
+				_ context.Context,
+				_ *dto.RequestSearch,
+				yield func(*entities.Request) errors.Error,
+			) errors.Error {
+				for _, id := range []string{"a", "b"} {
+					if err := yield(s.request(id)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		).
+		Times(1)
+
+	var exported []*dto.RequestResponse
+	err := s.useCase.Execute(
+		s.ctx,
+		req,
+		func(r *dto.RequestResponse) errors.Error {
+			exported = append(exported, r)
+			return nil
+		},
+	)
+
+	s.Require().NoError(err)
+	s.Require().Len(exported, 2)
+	s.Equal("a", exported[0].ID)
+	s.Equal("b", exported[1].ID)
+	s.Equal("Project", exported[0].Project.Name)
+	s.NotEqual("pdr_live_abcdefghijklmnop", exported[0].APIKey.Key)
+}
+
+func (s *UseCaseSuite) TestYieldErrorStopsExport() {
+	s.expectValidInput()
+
+	s.requestRepo.EXPECT().
+		StreamSearch(s.ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(
+				_ context.Context,
+				_ *dto.RequestSearch,
+				yield func(*entities.Request) errors.Error,
+			) errors.Error {
+				return yield(s.request("a"))
+			},
+		).
+		Times(1)
+
+	err := s.useCase.Execute(
+		s.ctx,
+		&dto.RequestSearch{},
+		func(*dto.RequestResponse) errors.Error {
+			return errors.NewInternal("client went away", nil)
+		},
+	)
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeInternal, err.Code())
+}
+
+func (s *UseCaseSuite) TestInvalidSearch() {
+	s.validator.EXPECT().
+		ValidateStruct(gomock.Any(), gomock.Any()).
+		Return(
+			errors.NewAttributeValidationFailed(
+				"RequestSearch", "ip_address", "ip_address must be a valid IP address or CIDR", nil,
+			),
+		).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		StreamSearch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := s.useCase.Execute(
+		s.ctx,
+		&dto.RequestSearch{IPAddress: "not-an-ip"},
+		func(*dto.RequestResponse) errors.Error { return nil },
+	)
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
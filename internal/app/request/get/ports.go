@@ -0,0 +1,13 @@
+package get
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type RequestRepository interface {
+	GetByID(ctx context.Context, id string) (*entities.Request, errors.Error)
+	ListChain(ctx context.Context, id string) ([]*entities.Request, errors.Error)
+}
@@ -0,0 +1,127 @@
+package get
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id string) (*dto.RequestCompleteResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	requestRepo RequestRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, id string,
+) (*dto.RequestCompleteResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "request.get")
+	defer span.End()
+
+	if err := uc.validateID(id); err != nil {
+		return nil, err
+	}
+
+	request, err := uc.requestRepo.GetByID(ctx, id)
+	if err != nil {
+		if err.Code() == errors.CodeNotFound {
+			return nil, errors.NewEntityNotFound(
+				"Request",
+				"request not found",
+				map[string]any{"id": id},
+				err,
+			)
+		}
+		return nil, err
+	}
+
+	chain, err := uc.requestRepo.ListChain(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	chainResp := make([]*dto.RequestResponse, len(chain))
+	for i, link := range chain {
+		chainResp[i] = requestResponse(link)
+	}
+
+	resp := &dto.RequestCompleteResponse{
+		RequestResponse: *requestResponse(request),
+		Chain:           chainResp,
+	}
+
+	if request.Metadata != nil {
+		resp.Metadata = &dto.RequestDetailsReponse{
+			Body:            request.Metadata.Body,
+			BodyContentType: request.Metadata.BodyContentType,
+			Cookies:         request.Metadata.Cookies,
+			Headers:         request.Metadata.Headers,
+			QueryParams:     request.Metadata.QueryParams,
+		}
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validateID(id string) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,uuid4",
+		map[string]string{
+			"uuid4":    "id must be a valid UUID",
+			"required": "id is required",
+		},
+	)
+}
+
+func requestResponse(request *entities.Request) *dto.RequestResponse {
+	return &dto.RequestResponse{
+		ID:                 request.ID,
+		StartPoint:         request.StartPoint,
+		Detail:             request.Detail,
+		StatusCode:         request.StatusCode,
+		ExecutionStatus:    request.ExecutionStatus,
+		UnauthorizedReason: request.UnauthorizedReason,
+		Units:              request.Units,
+		RequestTime:        request.RequestTime,
+		Path:               request.Path,
+		Method:             request.Method,
+		IPAddress:          request.IPAddress,
+		CreatedAt:          request.CreatedAt,
+		APIKey: &dto.RequestAPIKeyResponse{
+			ID:  request.APIKey.ID,
+			Key: request.APIKey.KeySummary(),
+		},
+		Project: &dto.RequestProjectResponse{
+			ID:   request.Project.ID,
+			Name: request.Project.Name,
+		},
+		Environment: &dto.RequestEnvironmentResponse{
+			ID:   request.Environment.ID,
+			Name: request.Environment.Name,
+		},
+		Service: &dto.RequestServiceResponse{
+			ID:      request.Service.ID,
+			Name:    request.Service.Name,
+			Version: request.Service.Version,
+		},
+	}
+}
+
+func NewUseCase(
+	validator validator.Validator, requestRepo RequestRepository,
+) UseCase {
+	return &useCase{
+		validator:   validator,
+		requestRepo: requestRepo,
+	}
+}
@@ -1,9 +1,37 @@
 package request
 
 import (
+	createpartitions "github.com/MAD-py/pandora-core/internal/app/request/create_partitions"
+	enforceretention "github.com/MAD-py/pandora-core/internal/app/request/enforce_retention"
+	"github.com/MAD-py/pandora-core/internal/app/request/export"
+	"github.com/MAD-py/pandora-core/internal/app/request/get"
+	"github.com/MAD-py/pandora-core/internal/app/request/search"
 	updateexecutionstatus "github.com/MAD-py/pandora-core/internal/app/request/update_execution_status"
 )
 
+// ... Create Partitions Use Case ...
+
+type RequestPartitionRepository = createpartitions.RequestRepository
+
+// ... Enforce Retention Use Case ...
+
+type RequestRetentionRepository = enforceretention.RequestRepository
+type ServiceRetentionRepository = enforceretention.ServiceRepository
+type UsageRetentionRepository = enforceretention.UsageRepository
+type RequestArchiver = enforceretention.RequestArchiver
+
+// ... Export Use Case ...
+
+type RequestExportRepository = export.RequestRepository
+
+// ... Get Use Case ...
+
+type RequestGetRepository = get.RequestRepository
+
+// ... Search Use Case ...
+
+type RequestSearchRepository = search.RequestRepository
+
 // ... Update Execution Status Use Case ...
 
 type RequestUpdateExecutionStatusRepository = updateexecutionstatus.RequestRepository
@@ -0,0 +1,166 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/request/search/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator   *mockvalidator.MockValidator
+	requestRepo *mock.MockRequestRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	time.Local = time.UTC
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.requestRepo = mock.NewMockRequestRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.validator, s.requestRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) expectValidInput() {
+	s.validator.EXPECT().
+		ValidateStruct(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(2)
+}
+
+func (s *UseCaseSuite) newRequests(n int) []*entities.Request {
+	now := time.Now()
+
+	requests := make([]*entities.Request, n)
+	for i := range requests {
+		requests[i] = &entities.Request{
+			ID:          fmt.Sprintf("0b7c6e4a-9d3f-4a25-8a4c-6e4a9d3f4a2%d", i),
+			APIKey:      &entities.RequestAPIKey{ID: 1, Key: "pandora_key_1234"},
+			Project:     &entities.RequestProject{ID: 1, Name: "Project"},
+			Environment: &entities.RequestEnvironment{ID: 1, Name: "Environment"},
+			Service:     &entities.RequestService{ID: 1, Name: "Service", Version: "1.0.0"},
+			RequestTime: now.Add(-time.Duration(i) * time.Minute),
+			CreatedAt:   now.Add(-time.Duration(i) * time.Second),
+		}
+	}
+
+	return requests
+}
+
+func (s *UseCaseSuite) TestLastPage() {
+	req := &dto.RequestSearch{ClientID: 1, IPAddress: "10.0.0.0/8"}
+	page := &dto.Pagination{Limit: 3}
+	requests := s.newRequests(2)
+
+	s.expectValidInput()
+
+	s.requestRepo.EXPECT().
+		Search(s.ctx, req, page).
+		Return(requests, nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		Count(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, req, page)
+
+	s.Require().NoError(err)
+	s.Len(resp.Items, 2)
+	s.Empty(resp.NextCursor)
+	s.Nil(resp.Total)
+	s.Equal(requests[0].APIKey.KeySummary(), resp.Items[0].APIKey.Key)
+}
+
+func (s *UseCaseSuite) TestNextPageSortedByRequestTime() {
+	req := &dto.RequestSearch{
+		SortBy:    enums.RequestSortFieldRequestTime,
+		SortOrder: enums.SortOrderAsc,
+	}
+	page := &dto.Pagination{Limit: 2, IncludeTotal: true}
+	requests := s.newRequests(3)
+
+	s.expectValidInput()
+
+	s.requestRepo.EXPECT().
+		Search(s.ctx, req, page).
+		Return(requests, nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		Count(s.ctx, req).
+		Return(9, nil).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, req, page)
+
+	s.Require().NoError(err)
+	s.Len(resp.Items, 2)
+	s.Require().NotNil(resp.Total)
+	s.Equal(9, *resp.Total)
+
+	cursor, decodeErr := (&dto.Pagination{Cursor: resp.NextCursor}).DecodeCursor()
+	s.Require().NoError(decodeErr)
+	s.Equal(requests[1].ID, cursor.ID)
+	s.True(requests[1].RequestTime.Equal(cursor.Time))
+}
+
+func (s *UseCaseSuite) TestInvalidInput() {
+	s.validator.EXPECT().
+		ValidateStruct(gomock.Any(), gomock.Any()).
+		Return(
+			errors.NewAttributeValidationFailed(
+				"RequestSearch",
+				"ip_address",
+				"ip_address must be a valid IP address or CIDR",
+				nil,
+			),
+		).
+		Times(1)
+
+	s.validator.EXPECT().
+		ValidateStruct(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		Search(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(
+		s.ctx, &dto.RequestSearch{IPAddress: "10.0.0"}, &dto.Pagination{},
+	)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
@@ -3,6 +3,7 @@ package updateexecutionstatus
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
@@ -22,6 +23,9 @@ type useCase struct {
 func (uc *useCase) Execute(
 	ctx context.Context, id string, req *dto.RequestExecutionStatusUpdate,
 ) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "request.update_execution_status")
+	defer span.End()
+
 	if err := uc.validateInput(id, req); err != nil {
 		return err
 	}
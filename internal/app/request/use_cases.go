@@ -1,10 +1,71 @@
 package request
 
 import (
+	createpartitions "github.com/MAD-py/pandora-core/internal/app/request/create_partitions"
+	enforceretention "github.com/MAD-py/pandora-core/internal/app/request/enforce_retention"
+	"github.com/MAD-py/pandora-core/internal/app/request/export"
+	"github.com/MAD-py/pandora-core/internal/app/request/get"
+	"github.com/MAD-py/pandora-core/internal/app/request/search"
 	updateexecutionstatus "github.com/MAD-py/pandora-core/internal/app/request/update_execution_status"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
 
+// ... Create Partitions Use Case ...
+
+type CreatePartitionsUseCase = createpartitions.UseCase
+
+func NewCreatePartitionsUseCase(
+	requestRepo RequestPartitionRepository,
+) CreatePartitionsUseCase {
+	return createpartitions.NewUseCase(requestRepo)
+}
+
+// ... Enforce Retention Use Case ...
+
+type EnforceRetentionUseCase = enforceretention.UseCase
+
+func NewEnforceRetentionUseCase(
+	requestRepo RequestRetentionRepository,
+	serviceRepo ServiceRetentionRepository,
+	usageRepo UsageRetentionRepository,
+	archiver RequestArchiver,
+	retentionDays int,
+) EnforceRetentionUseCase {
+	return enforceretention.NewUseCase(
+		requestRepo, serviceRepo, usageRepo, archiver, retentionDays,
+	)
+}
+
+// ... Export Use Case ...
+
+type ExportUseCase = export.UseCase
+
+func NewExportUseCase(
+	validator validator.Validator, requestRepo RequestExportRepository,
+) ExportUseCase {
+	return export.NewUseCase(validator, requestRepo)
+}
+
+// ... Get Use Case ...
+
+type GetUseCase = get.UseCase
+
+func NewGetUseCase(
+	validator validator.Validator, requestRepo RequestGetRepository,
+) GetUseCase {
+	return get.NewUseCase(validator, requestRepo)
+}
+
+// ... Search Use Case ...
+
+type SearchUseCase = search.UseCase
+
+func NewSearchUseCase(
+	validator validator.Validator, requestRepo RequestSearchRepository,
+) SearchUseCase {
+	return search.NewUseCase(validator, requestRepo)
+}
+
 // ... Update Execution Status Use Case ...
 
 type UpdateExecutionStatusUseCase = updateexecutionstatus.UseCase
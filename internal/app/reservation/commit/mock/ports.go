@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
 	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,16 +42,31 @@ func (m *MockReservationRepository) EXPECT() *MockReservationRepositoryMockRecor
 	return m.recorder
 }
 
-// Delete mocks base method.
-func (m *MockReservationRepository) Delete(ctx context.Context, id string) errors.Error {
+// Commit mocks base method.
+func (m *MockReservationRepository) Commit(ctx context.Context, id string, units int) errors.Error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Commit", ctx, id, units)
 	ret0, _ := ret[0].(errors.Error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockReservationRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+// Commit indicates an expected call of Commit.
+func (mr *MockReservationRepositoryMockRecorder) Commit(ctx, id, units any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReservationRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockReservationRepository)(nil).Commit), ctx, id, units)
+}
+
+// GetByID mocks base method.
+func (m *MockReservationRepository) GetByID(ctx context.Context, id string) (*entities.Reservation, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Reservation)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReservationRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReservationRepository)(nil).GetByID), ctx, id)
 }
@@ -3,9 +3,11 @@ package commit
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
 type ReservationRepository interface {
-	Delete(ctx context.Context, id string) errors.Error
+	Commit(ctx context.Context, id string, units int) errors.Error
+	GetByID(ctx context.Context, id string) (*entities.Reservation, errors.Error)
 }
@@ -2,13 +2,16 @@ package commit
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
 
 type UseCase interface {
-	Execute(ctx context.Context, id string) errors.Error
+	Execute(ctx context.Context, id string, req *dto.ReservationCommit) errors.Error
 }
 
 type useCase struct {
@@ -17,11 +20,43 @@ type useCase struct {
 	reservationRepo ReservationRepository
 }
 
-func (uc *useCase) Execute(ctx context.Context, id string) errors.Error {
+func (uc *useCase) Execute(
+	ctx context.Context, id string, req *dto.ReservationCommit,
+) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "reservation.commit")
+	defer span.End()
+
 	if err := uc.validateID(id); err != nil {
 		return err
 	}
-	return uc.reservationRepo.Delete(ctx, id)
+
+	if err := uc.validateReq(req); err != nil {
+		return err
+	}
+
+	reservation, err := uc.reservationRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Without units the reservation is settled for everything it held.
+	units := reservation.Units
+	if req.Units != nil {
+		if *req.Units > reservation.Units {
+			return errors.NewAttributeValidationFailed(
+				"ReservationCommit",
+				"units",
+				fmt.Sprintf("units must not exceed the %d units reserved", reservation.Units),
+				nil,
+			)
+		}
+		units = *req.Units
+	}
+
+	// Deleting the reservation, refunding what it held beyond units and
+	// charging units to its request happen together, so a failure never
+	// leaves a reservation gone without its refund.
+	return uc.reservationRepo.Commit(ctx, id, units)
 }
 
 func (uc *useCase) validateID(id string) errors.Error {
@@ -36,6 +71,15 @@ func (uc *useCase) validateID(id string) errors.Error {
 	)
 }
 
+func (uc *useCase) validateReq(req *dto.ReservationCommit) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"units.gte": "units must be greater than or equal to 0",
+		},
+	)
+}
+
 func NewUseCase(
 	validator validator.Validator,
 	reservationRepo ReservationRepository,
@@ -1 +1,171 @@
 package commit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/reservation/commit/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator       *mockvalidator.MockValidator
+	reservationRepo *mock.MockReservationRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	time.Local = time.UTC
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.reservationRepo = mock.NewMockReservationRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.validator, s.reservationRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) expectReservation(units int) *entities.Reservation {
+	reservation := &entities.Reservation{
+		ID:             "0b7c6e4a-9d3f-4a25-8a4c-6e4a9d3f4a25",
+		EnvironmentID:  1,
+		ServiceID:      10,
+		StartRequestID: "f0a3c1c4-1a8e-4a7b-9a1e-3c1c4a8e4a7b",
+		ExpiresAt:      time.Now().Add(time.Minute),
+		Units:          units,
+	}
+
+	s.validator.EXPECT().
+		ValidateVariable(reservation.ID, "id", "required,uuid4", gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.validator.EXPECT().
+		ValidateStruct(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.reservationRepo.EXPECT().
+		GetByID(s.ctx, reservation.ID).
+		Return(reservation, nil).
+		Times(1)
+
+	return reservation
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	reservation := s.expectReservation(5)
+
+	s.reservationRepo.EXPECT().
+		Commit(s.ctx, reservation.ID, 5).
+		Return(nil).
+		Times(1)
+
+	err := s.useCase.Execute(s.ctx, reservation.ID, &dto.ReservationCommit{})
+
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) TestRefundsUnusedUnits() {
+	reservation := s.expectReservation(5)
+
+	s.reservationRepo.EXPECT().
+		Commit(s.ctx, reservation.ID, 2).
+		Return(nil).
+		Times(1)
+
+	units := 2
+	err := s.useCase.Execute(s.ctx, reservation.ID, &dto.ReservationCommit{Units: &units})
+
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) TestZeroUnitsReleaseEverything() {
+	reservation := s.expectReservation(5)
+
+	s.reservationRepo.EXPECT().
+		Commit(s.ctx, reservation.ID, 0).
+		Return(nil).
+		Times(1)
+
+	units := 0
+	err := s.useCase.Execute(s.ctx, reservation.ID, &dto.ReservationCommit{Units: &units})
+
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) TestUnitsExceedReserved() {
+	reservation := s.expectReservation(5)
+
+	s.reservationRepo.EXPECT().
+		Commit(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	units := 6
+	err := s.useCase.Execute(s.ctx, reservation.ID, &dto.ReservationCommit{Units: &units})
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func (s *UseCaseSuite) TestCommitFails() {
+	reservation := s.expectReservation(5)
+
+	s.reservationRepo.EXPECT().
+		Commit(s.ctx, reservation.ID, 2).
+		Return(errors.NewInternal("failed", nil)).
+		Times(1)
+
+	units := 2
+	err := s.useCase.Execute(s.ctx, reservation.ID, &dto.ReservationCommit{Units: &units})
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeInternal, err.Code())
+}
+
+func (s *UseCaseSuite) TestNotFound() {
+	id := "0b7c6e4a-9d3f-4a25-8a4c-6e4a9d3f4a25"
+
+	s.validator.EXPECT().
+		ValidateVariable(id, "id", "required,uuid4", gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.validator.EXPECT().
+		ValidateStruct(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.reservationRepo.EXPECT().
+		GetByID(s.ctx, id).
+		Return(nil, errors.NewNotFound("reservation not found", nil)).
+		Times(1)
+
+	err := s.useCase.Execute(s.ctx, id, &dto.ReservationCommit{})
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeNotFound, err.Code())
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
@@ -2,9 +2,23 @@ package reservation
 
 import (
 	"github.com/MAD-py/pandora-core/internal/app/reservation/commit"
+	releaseexpired "github.com/MAD-py/pandora-core/internal/app/reservation/release_expired"
+	"github.com/MAD-py/pandora-core/internal/app/reservation/reserve"
 	"github.com/MAD-py/pandora-core/internal/app/reservation/rollback"
 )
 
+// ... Reserve Use Case ...
+
+type APIKeyReserveRepository = reserve.APIKeyRepository
+type ProjectReserveRepository = reserve.ProjectRepository
+type ServiceReserveRepository = reserve.ServiceRepository
+type RequestReserveRepository = reserve.RequestRepository
+type EnvironmentReserveRepository = reserve.EnvironmentRepository
+type ReservationReserveRepository = reserve.ReservationRepository
+type QuotaAlertReserveRepository = reserve.QuotaAlertRepository
+type WebhookReserveRepository = reserve.WebhookRepository
+type RateLimiterReserve = reserve.RateLimiter
+
 // ... Commit Use Case ...
 
 type ReservationCommitRepository = commit.ReservationRepository
@@ -12,4 +26,7 @@ type ReservationCommitRepository = commit.ReservationRepository
 // ... Rollback Use Case ...
 
 type ReservationRollbackRepository = rollback.ReservationRepository
-type EnvironmentAvailableRequestIncrementerRepository = rollback.EnvironmentRepository
+
+// ... Release Expired Use Case ...
+
+type ReservationReleaseExpiredRepository = releaseexpired.ReservationRepository
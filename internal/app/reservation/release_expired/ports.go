@@ -0,0 +1,15 @@
+package releaseexpired
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ReservationRepository interface {
+	ListExpired(ctx context.Context, now time.Time) ([]*entities.Reservation, errors.Error)
+	Release(ctx context.Context, id string, update *dto.RequestExecutionStatusUpdate) errors.Error
+}
@@ -0,0 +1,67 @@
+package releaseexpired
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+const abandonedDetail = "Reservation expired before being committed or rolled back"
+
+type UseCase interface {
+	Execute(ctx context.Context) ([]*dto.ReservationRelease, errors.Error)
+}
+
+type useCase struct {
+	reservationRepo ReservationRepository
+}
+
+func (uc *useCase) Execute(ctx context.Context) ([]*dto.ReservationRelease, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "reservation.release_expired")
+	defer span.End()
+
+	reservations, err := uc.reservationRepo.ListExpired(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	released := make([]*dto.ReservationRelease, 0, len(reservations))
+
+	var errs errors.Error
+	for _, reservation := range reservations {
+		// A reservation committed or rolled back after being listed is
+		// already settled, so its quota must not be given back twice.
+		err := uc.reservationRepo.Release(
+			ctx,
+			reservation.ID,
+			&dto.RequestExecutionStatusUpdate{
+				Detail:          abandonedDetail,
+				ExecutionStatus: enums.RequestExecutionStatusAbandoned,
+			},
+		)
+		if err != nil {
+			if err.Code() != errors.CodeNotFound {
+				errs = errors.Aggregate(errs, err)
+			}
+			continue
+		}
+
+		released = append(released, &dto.ReservationRelease{
+			ID:             reservation.ID,
+			EnvironmentID:  reservation.EnvironmentID,
+			ServiceID:      reservation.ServiceID,
+			StartRequestID: reservation.StartRequestID,
+			ExpiresAt:      reservation.ExpiresAt,
+		})
+	}
+
+	return released, errs
+}
+
+func NewUseCase(reservationRepo ReservationRepository) UseCase {
+	return &useCase{reservationRepo: reservationRepo}
+}
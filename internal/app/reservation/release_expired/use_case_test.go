@@ -0,0 +1,175 @@
+package releaseexpired
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/reservation/release_expired/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	reservationRepo *mock.MockReservationRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	time.Local = time.UTC
+	s.ctrl = gomock.NewController(s.T())
+
+	s.reservationRepo = mock.NewMockReservationRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.reservationRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) expiredReservations() []*entities.Reservation {
+	expiresAt := time.Now().Add(-time.Minute)
+	return []*entities.Reservation{
+		{
+			ID:             "9b1f1a4e-5c7d-4e2a-8f3b-1a4e5c7d4e2a",
+			EnvironmentID:  1,
+			ServiceID:      10,
+			APIKey:         "api-key-1",
+			StartRequestID: "3c2d1e0f-4a5b-4c6d-8e7f-1e0f4a5b4c6d",
+			ExpiresAt:      expiresAt,
+			Units:          1,
+		},
+		{
+			ID:             "7e6d5c4b-3a29-4180-9f7e-5c4b3a294180",
+			EnvironmentID:  2,
+			ServiceID:      20,
+			APIKey:         "api-key-2",
+			StartRequestID: "1a2b3c4d-5e6f-4a7b-8c9d-3c4d5e6f4a7b",
+			ExpiresAt:      expiresAt,
+			Units:          3,
+		},
+	}
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	reservations := s.expiredReservations()
+
+	s.reservationRepo.EXPECT().
+		ListExpired(s.ctx, gomock.Any()).
+		Return(reservations, nil).
+		Times(1)
+
+	for _, reservation := range reservations {
+		s.reservationRepo.EXPECT().
+			Release(s.ctx, reservation.ID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, update *dto.RequestExecutionStatusUpdate) errors.Error {
+				s.Require().Equal(enums.RequestExecutionStatusAbandoned, update.ExecutionStatus)
+				s.Require().NotEmpty(update.Detail)
+				s.Require().Zero(update.StatusCode)
+				return nil
+			}).
+			Times(1)
+	}
+
+	result, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(result, len(reservations))
+
+	for i, reservation := range reservations {
+		s.Equal(reservation.ID, result[i].ID)
+		s.Equal(reservation.EnvironmentID, result[i].EnvironmentID)
+		s.Equal(reservation.ServiceID, result[i].ServiceID)
+		s.Equal(reservation.StartRequestID, result[i].StartRequestID)
+		s.Equal(reservation.ExpiresAt, result[i].ExpiresAt)
+	}
+}
+
+func (s *UseCaseSuite) TestNoExpiredReservations() {
+	s.reservationRepo.EXPECT().
+		ListExpired(s.ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	result, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Empty(result)
+}
+
+func (s *UseCaseSuite) TestListExpiredError() {
+	s.reservationRepo.EXPECT().
+		ListExpired(s.ctx, gomock.Any()).
+		Return(nil, errors.NewInternal("database error", nil)).
+		Times(1)
+
+	result, err := s.useCase.Execute(s.ctx)
+
+	s.Require().Error(err)
+	s.Nil(result)
+	s.Equal(errors.CodeInternal, err.Code())
+}
+
+func (s *UseCaseSuite) TestAlreadySettledReservationIsSkipped() {
+	reservations := s.expiredReservations()[:1]
+
+	s.reservationRepo.EXPECT().
+		ListExpired(s.ctx, gomock.Any()).
+		Return(reservations, nil).
+		Times(1)
+
+	s.reservationRepo.EXPECT().
+		Release(s.ctx, reservations[0].ID, gomock.Any()).
+		Return(errors.NewNotFound("reservation not found", nil)).
+		Times(1)
+
+	result, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Empty(result)
+}
+
+func (s *UseCaseSuite) TestPartialFailureContinues() {
+	reservations := s.expiredReservations()
+
+	s.reservationRepo.EXPECT().
+		ListExpired(s.ctx, gomock.Any()).
+		Return(reservations, nil).
+		Times(1)
+
+	s.reservationRepo.EXPECT().
+		Release(s.ctx, reservations[0].ID, gomock.Any()).
+		Return(errors.NewInternal("database error", nil)).
+		Times(1)
+
+	s.reservationRepo.EXPECT().
+		Release(s.ctx, reservations[1].ID, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	result, err := s.useCase.Execute(s.ctx)
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeInternal, err.Code())
+	s.Require().Len(result, 1)
+	s.Equal(reservations[1].ID, result[0].ID)
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
@@ -0,0 +1,49 @@
+package reserve
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/api_key/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type APIKeyRepository interface {
+	shared.ValidateAPIKeyRepository
+	UpdateLastUsed(ctx context.Context, key string) errors.Error
+}
+
+type EnvironmentRepository interface {
+	shared.ValidateEnvironmentRepository
+	IncreaseAvailableRequest(ctx context.Context, id, serviceID, units int) errors.Error
+	DecrementAvailableRequest(ctx context.Context, id, serviceID, units int) (*dto.DecrementAvailableRequest, errors.Error)
+}
+
+type ProjectRepository interface {
+	shared.ValidateProjectRepository
+}
+
+type ServiceRepository interface {
+	shared.ValidateServiceRepository
+}
+
+type RequestRepository interface {
+	CreateAsInitialPoint(ctx context.Context, request *entities.Request) errors.Error
+}
+
+type ReservationRepository interface {
+	Create(ctx context.Context, reservation *entities.Reservation) errors.Error
+}
+
+type QuotaAlertRepository interface {
+	shared.NotifyQuotaAlertRepository
+}
+
+type WebhookRepository interface {
+	shared.NotifyWebhookRepository
+}
+
+type RateLimiter interface {
+	shared.ValidateRateLimiter
+}
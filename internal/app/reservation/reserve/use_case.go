@@ -0,0 +1,243 @@
+package reserve
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/app/api_key/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, req *dto.APIKeyValidate) (*dto.ReservationResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	ttl time.Duration
+
+	apiKeyRepo      APIKeyRepository
+	projectRepo     ProjectRepository
+	serviceRepo     ServiceRepository
+	requestRepo     RequestRepository
+	environmentRepo EnvironmentRepository
+	reservationRepo ReservationRepository
+	quotaAlertRepo  QuotaAlertRepository
+	webhookRepo     WebhookRepository
+
+	rateLimiter RateLimiter
+
+	validateDeps *shared.ValidateDependencies
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, req *dto.APIKeyValidate,
+) (*dto.ReservationResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "reservation.reserve")
+	defer span.End()
+
+	if err := uc.validateReq(req); err != nil {
+		return nil, err
+	}
+
+	validateResponse := dto.APIKeyValidateResponse{}
+
+	var requestMetadata entities.RequestMetadata
+	if req.Request.Metadata != nil {
+		requestMetadata = entities.RequestMetadata{
+			QueryParams:     req.Request.Metadata.QueryParams,
+			Cookies:         req.Request.Metadata.Cookies,
+			Headers:         req.Request.Metadata.Headers,
+			Body:            req.Request.Metadata.Body,
+			BodyContentType: req.Request.Metadata.BodyContentType,
+		}
+	}
+
+	request := entities.Request{
+		Path:        req.Request.Path,
+		Method:      req.Request.Method,
+		IPAddress:   req.Request.IPAddress,
+		RequestTime: req.Request.RequestTime,
+		Metadata:    &requestMetadata,
+		APIKey: &entities.RequestAPIKey{
+			Key: req.APIKey,
+		},
+		Service: &entities.RequestService{
+			Name:    req.ServiceName,
+			Version: req.ServiceVersion,
+		},
+		Environment: &entities.RequestEnvironment{},
+		Project:     &entities.RequestProject{},
+	}
+
+	err := shared.ValidateAPIKey(
+		ctx, uc.validateDeps, req, &request, &validateResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The units held by the reservation are charged to its start request
+	// until the reservation is committed or released.
+	var availableRequest *dto.DecrementAvailableRequest
+	if validateResponse.Valid {
+		units := shared.RequestedUnits(req)
+		availableRequest, err = uc.environmentRepo.DecrementAvailableRequest(
+			ctx, request.Environment.ID, request.Service.ID, units,
+		)
+		if err != nil {
+			if err.Code() != errors.CodeNotFound {
+				return nil, err
+			}
+
+			shared.SetQuotaExceeded(&validateResponse, enums.QuotaLevelEnvironment)
+		} else if availableRequest.ExceededLevel != enums.QuotaLevelNull {
+			shared.SetQuotaExceeded(&validateResponse, availableRequest.ExceededLevel)
+		} else {
+			request.Units = units
+		}
+	}
+
+	if validateResponse.Valid {
+		request.ExecutionStatus = enums.RequestExecutionStatusForwarded
+	} else {
+		request.ExecutionStatus = enums.RequestExecutionStatusUnauthorized
+
+		request.StatusCode = http.StatusUnauthorized
+		if validateResponse.FailureCode == enums.APIKeyValidationFailureCodeRateLimited {
+			request.StatusCode = http.StatusTooManyRequests
+		}
+		request.UnauthorizedReason = validateResponse.FailureCode
+	}
+
+	if err := uc.requestRepo.CreateAsInitialPoint(ctx, &request); err != nil {
+		uc.releaseQuota(ctx, &request)
+		return nil, err
+	}
+
+	validateResponse.RequestID = request.ID
+
+	response := dto.ReservationResponse{
+		APIKeyValidateResponse: validateResponse,
+	}
+
+	if !validateResponse.Valid {
+		return &response, nil
+	}
+
+	reservation := entities.Reservation{
+		EnvironmentID:  request.Environment.ID,
+		ServiceID:      request.Service.ID,
+		APIKey:         request.APIKey.KeySummary(),
+		StartRequestID: request.ID,
+		RequestTime:    req.Request.RequestTime,
+		ExpiresAt:      time.Now().Add(uc.ttl),
+		Units:          request.Units,
+	}
+
+	if err := uc.reservationRepo.Create(ctx, &reservation); err != nil {
+		uc.releaseQuota(ctx, &request)
+		return nil, err
+	}
+
+	response.ReservationID = reservation.ID
+	response.ExpiresAt = reservation.ExpiresAt
+	response.AvailableRequest = availableRequest.AvailableRequest
+
+	shared.NotifyQuota(
+		ctx, uc.quotaAlertRepo, uc.webhookRepo, &request, availableRequest,
+	)
+
+	if err := uc.apiKeyRepo.UpdateLastUsed(ctx, req.APIKey); err != nil {
+		log.Printf(
+			"[WARN] Failed to update last_used for API Key %v: %v",
+			request.APIKey.ID, err,
+		)
+	}
+	return &response, nil
+}
+
+// releaseQuota gives back the units consumed for a reservation that could
+// not be persisted, so a failed Reserve never leaks quota.
+func (uc *useCase) releaseQuota(ctx context.Context, request *entities.Request) {
+	if request.Units == 0 {
+		return
+	}
+
+	err := uc.environmentRepo.IncreaseAvailableRequest(
+		ctx, request.Environment.ID, request.Service.ID, request.Units,
+	)
+	if err != nil {
+		log.Printf(
+			"[WARN] Failed to release quota for environment %v and service %v: %v",
+			request.Environment.ID, request.Service.ID, err,
+		)
+	}
+}
+
+func (uc *useCase) validateReq(req *dto.APIKeyValidate) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"api_key.required":         "api_key is required",
+			"request.required":         "request is required",
+			"service_name.required":    "service_name is required",
+			"service_version.required": "service_version is required",
+
+			"request.path.required":         "request.path is required",
+			"request.ip_address.ip":         "request.ip_address must be a valid IP address",
+			"request.method.required":       "request.method is required",
+			"request.request_time.utc":      "request.request_time must be in UTC format",
+			"request.ip_address.required":   "request.ip_address is required",
+			"request.request_time.required": "request.request_time is required",
+
+			"request.metadata.body_content_type.enums": "request.metadata.body_content_type must be one of the following: application/xml, application/json, text/plain, text/html, multipart/form-data, application/x-www-form-urlencoded, application/octet-stream",
+
+			"units.gt": "units must be greater than 0",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	ttl time.Duration,
+	apiKeyRepo APIKeyRepository,
+	projectRepo ProjectRepository,
+	serviceRepo ServiceRepository,
+	requestRepo RequestRepository,
+	environmentRepo EnvironmentRepository,
+	reservationRepo ReservationRepository,
+	quotaAlertRepo QuotaAlertRepository,
+	webhookRepo WebhookRepository,
+	rateLimiter RateLimiter,
+) UseCase {
+	return &useCase{
+		validator:       validator,
+		ttl:             ttl,
+		apiKeyRepo:      apiKeyRepo,
+		projectRepo:     projectRepo,
+		serviceRepo:     serviceRepo,
+		requestRepo:     requestRepo,
+		environmentRepo: environmentRepo,
+		reservationRepo: reservationRepo,
+		quotaAlertRepo:  quotaAlertRepo,
+		webhookRepo:     webhookRepo,
+		rateLimiter:     rateLimiter,
+
+		validateDeps: shared.NewValidationDependencies(
+			apiKeyRepo,
+			serviceRepo,
+			projectRepo,
+			environmentRepo,
+			rateLimiter,
+		),
+	}
+}
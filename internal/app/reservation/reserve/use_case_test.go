@@ -0,0 +1,465 @@
+package reserve
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/reservation/reserve/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator       *mockvalidator.MockValidator
+	apiKeyRepo      *mock.MockAPIKeyRepository
+	projectRepo     *mock.MockProjectRepository
+	serviceRepo     *mock.MockServiceRepository
+	requestRepo     *mock.MockRequestRepository
+	environmentRepo *mock.MockEnvironmentRepository
+	reservationRepo *mock.MockReservationRepository
+	quotaAlertRepo  *mock.MockQuotaAlertRepository
+	webhookRepo     *mock.MockWebhookRepository
+	rateLimiter     *mock.MockRateLimiter
+
+	ttl time.Duration
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	time.Local = time.UTC
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.apiKeyRepo = mock.NewMockAPIKeyRepository(s.ctrl)
+	s.projectRepo = mock.NewMockProjectRepository(s.ctrl)
+	s.serviceRepo = mock.NewMockServiceRepository(s.ctrl)
+	s.requestRepo = mock.NewMockRequestRepository(s.ctrl)
+	s.environmentRepo = mock.NewMockEnvironmentRepository(s.ctrl)
+	s.reservationRepo = mock.NewMockReservationRepository(s.ctrl)
+	s.quotaAlertRepo = mock.NewMockQuotaAlertRepository(s.ctrl)
+	s.webhookRepo = mock.NewMockWebhookRepository(s.ctrl)
+	s.rateLimiter = mock.NewMockRateLimiter(s.ctrl)
+
+	s.ttl = 5 * time.Minute
+
+	s.useCase = NewUseCase(
+		s.validator,
+		s.ttl,
+		s.apiKeyRepo,
+		s.projectRepo,
+		s.serviceRepo,
+		s.requestRepo,
+		s.environmentRepo,
+		s.reservationRepo,
+		s.quotaAlertRepo,
+		s.webhookRepo,
+		s.rateLimiter,
+	)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) newRequest(apiKey string) *dto.APIKeyValidate {
+	return &dto.APIKeyValidate{
+		APIKey:         apiKey,
+		ServiceName:    "TestService",
+		ServiceVersion: "1.0.0",
+		Request: &dto.RequestIncoming{
+			Path:        "/test",
+			Method:      "POST",
+			IPAddress:   "127.0.0.1",
+			RequestTime: time.Now(),
+		},
+	}
+}
+
+func (s *UseCaseSuite) expectValidation(
+	req *dto.APIKeyValidate,
+	apiKeyStatus enums.APIKeyStatus,
+	rateLimit *entities.APIKeyRateLimit,
+) (*entities.Service, *entities.Environment) {
+	service := &entities.Service{
+		ID:      1,
+		Name:    req.ServiceName,
+		Version: req.ServiceVersion,
+		Status:  enums.ServiceStatusEnabled,
+	}
+	apiKey := &entities.APIKey{
+		ID:            10,
+		Key:           req.APIKey,
+		Status:        apiKeyStatus,
+		EnvironmentID: 100,
+		RateLimit:     rateLimit,
+	}
+	environment := &entities.Environment{
+		ID:        100,
+		Name:      "production",
+		Status:    enums.EnvironmentStatusEnabled,
+		ProjectID: 1000,
+		Services: []*entities.EnvironmentService{
+			{
+				ID:               service.ID,
+				Name:             service.Name,
+				Version:          service.Version,
+				MaxRequests:      10,
+				AvailableRequest: 5,
+			},
+		},
+	}
+
+	s.validator.EXPECT().
+		ValidateStruct(req, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.serviceRepo.EXPECT().
+		GetByNameAndVersion(s.ctx, req.ServiceName, req.ServiceVersion).
+		Return(service, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		GetByKey(s.ctx, req.APIKey).
+		Return(apiKey, nil).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		GetByID(s.ctx, apiKey.EnvironmentID).
+		Return(environment, nil).
+		Times(1)
+
+	s.projectRepo.EXPECT().
+		GetProjectClientInfoByID(s.ctx, environment.ProjectID).
+		Return(
+			&dto.ProjectClientInfoResponse{
+				ProjectID:   1000,
+				ProjectName: "TestProject",
+				ClientID:    2000,
+				ClientName:  "TestClient",
+			},
+			nil,
+		).
+		Times(1)
+
+	return service, environment
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	req := s.newRequest("pdr_test_valid0000000000000000000000000000000000Test_a9715933")
+	service, environment := s.expectValidation(req, enums.APIKeyStatusEnabled, nil)
+
+	wantRequestID := "f0a3c1c4-1a8e-4a7b-9a1e-3c1c4a8e4a7b"
+	wantReservationID := "0b7c6e4a-9d3f-4a25-8a4c-6e4a9d3f4a25"
+
+	s.environmentRepo.EXPECT().
+		DecrementAvailableRequest(s.ctx, environment.ID, service.ID, 1).
+		Return(
+			&dto.DecrementAvailableRequest{MaxRequests: 10, AvailableRequest: 4},
+			nil,
+		).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		CreateAsInitialPoint(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		DoAndReturn(func(_ context.Context, r *entities.Request) errors.Error {
+			s.Require().Equal(enums.RequestExecutionStatusForwarded, r.ExecutionStatus)
+			s.Require().Zero(r.UnauthorizedReason)
+			r.ID = wantRequestID
+			r.StartPoint = wantRequestID
+			return nil
+		}).
+		Times(1)
+
+	s.reservationRepo.EXPECT().
+		Create(s.ctx, gomock.AssignableToTypeOf(&entities.Reservation{})).
+		DoAndReturn(func(_ context.Context, r *entities.Reservation) errors.Error {
+			s.Require().Equal(environment.ID, r.EnvironmentID)
+			s.Require().Equal(service.ID, r.ServiceID)
+			s.Require().Equal("pdr_test_vali...", r.APIKey)
+			s.Require().Equal(wantRequestID, r.StartRequestID)
+			s.Require().Equal(1, r.Units)
+			s.Require().WithinDuration(time.Now().Add(s.ttl), r.ExpiresAt, time.Second)
+			r.ID = wantReservationID
+			return nil
+		}).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		UpdateLastUsed(s.ctx, req.APIKey).
+		Return(nil).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.True(resp.Valid)
+	s.Empty(resp.FailureCode)
+	s.Equal(wantRequestID, resp.RequestID)
+	s.Equal(wantReservationID, resp.ReservationID)
+	s.Equal(4, resp.AvailableRequest)
+	s.False(resp.ExpiresAt.IsZero())
+}
+
+func (s *UseCaseSuite) TestWeightedUnits() {
+	req := s.newRequest("pdr_test_valid0000000000000000000000000000000000Test_a9715933")
+	req.Units = 3
+	service, environment := s.expectValidation(req, enums.APIKeyStatusEnabled, nil)
+
+	s.environmentRepo.EXPECT().
+		DecrementAvailableRequest(s.ctx, environment.ID, service.ID, 3).
+		Return(
+			&dto.DecrementAvailableRequest{MaxRequests: 10, AvailableRequest: 2},
+			nil,
+		).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		CreateAsInitialPoint(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		DoAndReturn(func(_ context.Context, r *entities.Request) errors.Error {
+			s.Require().Equal(3, r.Units)
+			r.ID = "f0a3c1c4-1a8e-4a7b-9a1e-3c1c4a8e4a7b"
+			return nil
+		}).
+		Times(1)
+
+	s.reservationRepo.EXPECT().
+		Create(s.ctx, gomock.AssignableToTypeOf(&entities.Reservation{})).
+		DoAndReturn(func(_ context.Context, r *entities.Reservation) errors.Error {
+			s.Require().Equal(3, r.Units)
+			r.ID = "0b7c6e4a-9d3f-4a25-8a4c-6e4a9d3f4a25"
+			return nil
+		}).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		UpdateLastUsed(s.ctx, req.APIKey).
+		Return(nil).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.True(resp.Valid)
+	s.Equal(2, resp.AvailableRequest)
+}
+
+func (s *UseCaseSuite) TestUnauthorizedDoesNotReserve() {
+	req := s.newRequest("pdr_test_disabled0000000000000000000000000000000Test_d74efcea")
+	s.expectValidation(req, enums.APIKeyStatusDisabled, nil)
+
+	s.environmentRepo.EXPECT().
+		DecrementAvailableRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.requestRepo.EXPECT().
+		CreateAsInitialPoint(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		DoAndReturn(func(_ context.Context, r *entities.Request) errors.Error {
+			s.Require().Equal(enums.RequestExecutionStatusUnauthorized, r.ExecutionStatus)
+			s.Require().Equal(enums.APIKeyValidationFailureCodeAPIKeyDisabled, r.UnauthorizedReason)
+			r.ID = "request-id"
+			return nil
+		}).
+		Times(1)
+
+	s.reservationRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.False(resp.Valid)
+	s.Equal(enums.APIKeyValidationFailureCodeAPIKeyDisabled, resp.FailureCode)
+	s.Empty(resp.ReservationID)
+	s.True(resp.ExpiresAt.IsZero())
+}
+
+func (s *UseCaseSuite) TestRateLimited() {
+	req := s.newRequest("pdr_test_valid0000000000000000000000000000000000Test_a9715933")
+	rateLimit := entities.NewAPIKeyRateLimit(1, enums.APIKeyRateLimitPeriodSecond, 0)
+	s.expectValidation(req, enums.APIKeyStatusEnabled, rateLimit)
+
+	s.rateLimiter.EXPECT().
+		Allow(s.ctx, "api_key:10", rateLimit).
+		Return(time.Second, nil).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		DecrementAvailableRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.requestRepo.EXPECT().
+		CreateAsInitialPoint(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		DoAndReturn(func(_ context.Context, r *entities.Request) errors.Error {
+			s.Require().Equal(enums.RequestExecutionStatusUnauthorized, r.ExecutionStatus)
+			s.Require().Equal(enums.APIKeyValidationFailureCodeRateLimited, r.UnauthorizedReason)
+			s.Require().Equal(http.StatusTooManyRequests, r.StatusCode)
+			r.ID = "request-id"
+			return nil
+		}).
+		Times(1)
+
+	s.reservationRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.False(resp.Valid)
+	s.Equal(enums.APIKeyValidationFailureCodeRateLimited, resp.FailureCode)
+	s.Equal(time.Second, resp.RetryAfter)
+	s.Empty(resp.ReservationID)
+}
+
+func (s *UseCaseSuite) TestQuotaExceeded() {
+	req := s.newRequest("pdr_test_valid0000000000000000000000000000000000Test_a9715933")
+	service, environment := s.expectValidation(req, enums.APIKeyStatusEnabled, nil)
+
+	s.environmentRepo.EXPECT().
+		DecrementAvailableRequest(s.ctx, environment.ID, service.ID, 1).
+		Return(
+			&dto.DecrementAvailableRequest{ExceededLevel: enums.QuotaLevelEnvironment},
+			nil,
+		).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		CreateAsInitialPoint(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		DoAndReturn(func(_ context.Context, r *entities.Request) errors.Error {
+			s.Require().Equal(enums.RequestExecutionStatusUnauthorized, r.ExecutionStatus)
+			s.Require().Equal(enums.APIKeyValidationFailureCodeQuotaExceeded, r.UnauthorizedReason)
+			r.ID = "request-id"
+			return nil
+		}).
+		Times(1)
+
+	s.reservationRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.False(resp.Valid)
+	s.Equal(enums.APIKeyValidationFailureCodeQuotaExceeded, resp.FailureCode)
+	s.Equal(enums.QuotaLevelEnvironment, resp.QuotaLevel)
+}
+
+func (s *UseCaseSuite) TestProjectQuotaExceeded() {
+	req := s.newRequest("pdr_test_valid0000000000000000000000000000000000Test_a9715933")
+	service, environment := s.expectValidation(req, enums.APIKeyStatusEnabled, nil)
+
+	s.environmentRepo.EXPECT().
+		DecrementAvailableRequest(s.ctx, environment.ID, service.ID, 1).
+		Return(
+			&dto.DecrementAvailableRequest{ExceededLevel: enums.QuotaLevelProject},
+			nil,
+		).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		CreateAsInitialPoint(s.ctx, gomock.AssignableToTypeOf(&entities.Request{})).
+		DoAndReturn(func(_ context.Context, r *entities.Request) errors.Error {
+			s.Require().Equal(enums.RequestExecutionStatusUnauthorized, r.ExecutionStatus)
+			s.Require().Equal(enums.APIKeyValidationFailureCodeQuotaExceeded, r.UnauthorizedReason)
+			r.ID = "request-id"
+			return nil
+		}).
+		Times(1)
+
+	s.reservationRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.False(resp.Valid)
+	s.Equal(enums.APIKeyValidationFailureCodeQuotaExceeded, resp.FailureCode)
+	s.Equal(enums.QuotaLevelProject, resp.QuotaLevel)
+}
+
+func (s *UseCaseSuite) TestReservationCreateFailsReleasesQuota() {
+	req := s.newRequest("pdr_test_valid0000000000000000000000000000000000Test_a9715933")
+	req.Units = 4
+	service, environment := s.expectValidation(req, enums.APIKeyStatusEnabled, nil)
+
+	s.environmentRepo.EXPECT().
+		DecrementAvailableRequest(s.ctx, environment.ID, service.ID, 4).
+		Return(
+			&dto.DecrementAvailableRequest{MaxRequests: 10, AvailableRequest: 4},
+			nil,
+		).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		CreateAsInitialPoint(s.ctx, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.reservationRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		Return(errors.NewInternal("database error", nil)).
+		Times(1)
+
+	s.environmentRepo.EXPECT().
+		IncreaseAvailableRequest(s.ctx, environment.ID, service.ID, 4).
+		Return(nil).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+	s.Equal(errors.CodeInternal, err.Code())
+}
+
+func (s *UseCaseSuite) TestValidationError() {
+	req := s.newRequest("")
+
+	s.validator.EXPECT().
+		ValidateStruct(req, gomock.Any()).
+		Return(errors.NewValidationFailed("api_key is required", nil)).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, req)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
@@ -13,7 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
-	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
+	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
 	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,69 +42,16 @@ func (m *MockReservationRepository) EXPECT() *MockReservationRepositoryMockRecor
 	return m.recorder
 }
 
-// Delete mocks base method.
-func (m *MockReservationRepository) Delete(ctx context.Context, id string) errors.Error {
+// Release mocks base method.
+func (m *MockReservationRepository) Release(ctx context.Context, id string, update *dto.RequestExecutionStatusUpdate) errors.Error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Release", ctx, id, update)
 	ret0, _ := ret[0].(errors.Error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockReservationRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+// Release indicates an expected call of Release.
+func (mr *MockReservationRepositoryMockRecorder) Release(ctx, id, update any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReservationRepository)(nil).Delete), ctx, id)
-}
-
-// GetByID mocks base method.
-func (m *MockReservationRepository) GetByID(ctx context.Context, id string) (*entities.Reservation, errors.Error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", ctx, id)
-	ret0, _ := ret[0].(*entities.Reservation)
-	ret1, _ := ret[1].(errors.Error)
-	return ret0, ret1
-}
-
-// GetByID indicates an expected call of GetByID.
-func (mr *MockReservationRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReservationRepository)(nil).GetByID), ctx, id)
-}
-
-// MockEnvironmentRepository is a mock of EnvironmentRepository interface.
-type MockEnvironmentRepository struct {
-	ctrl     *gomock.Controller
-	recorder *MockEnvironmentRepositoryMockRecorder
-	isgomock struct{}
-}
-
-// MockEnvironmentRepositoryMockRecorder is the mock recorder for MockEnvironmentRepository.
-type MockEnvironmentRepositoryMockRecorder struct {
-	mock *MockEnvironmentRepository
-}
-
-// NewMockEnvironmentRepository creates a new mock instance.
-func NewMockEnvironmentRepository(ctrl *gomock.Controller) *MockEnvironmentRepository {
-	mock := &MockEnvironmentRepository{ctrl: ctrl}
-	mock.recorder = &MockEnvironmentRepositoryMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockEnvironmentRepository) EXPECT() *MockEnvironmentRepositoryMockRecorder {
-	return m.recorder
-}
-
-// IncreaseAvailableRequest mocks base method.
-func (m *MockEnvironmentRepository) IncreaseAvailableRequest(ctx context.Context, id, serviceID int) errors.Error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IncreaseAvailableRequest", ctx, id, serviceID)
-	ret0, _ := ret[0].(errors.Error)
-	return ret0
-}
-
-// IncreaseAvailableRequest indicates an expected call of IncreaseAvailableRequest.
-func (mr *MockEnvironmentRepositoryMockRecorder) IncreaseAvailableRequest(ctx, id, serviceID any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseAvailableRequest", reflect.TypeOf((*MockEnvironmentRepository)(nil).IncreaseAvailableRequest), ctx, id, serviceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockReservationRepository)(nil).Release), ctx, id, update)
 }
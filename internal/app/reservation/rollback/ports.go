@@ -3,15 +3,10 @@ package rollback
 import (
 	"context"
 
-	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
 type ReservationRepository interface {
-	Delete(ctx context.Context, id string) errors.Error
-	GetByID(ctx context.Context, id string) (*entities.Reservation, errors.Error)
-}
-
-type EnvironmentRepository interface {
-	IncreaseAvailableRequest(ctx context.Context, id, serviceID int) errors.Error
+	Release(ctx context.Context, id string, update *dto.RequestExecutionStatusUpdate) errors.Error
 }
@@ -3,6 +3,7 @@ package rollback
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -15,29 +16,19 @@ type useCase struct {
 	validator validator.Validator
 
 	reservationRepo ReservationRepository
-	environmentRepo EnvironmentRepository
 }
 
 func (uc *useCase) Execute(ctx context.Context, id string) errors.Error {
-	if err := uc.validateID(id); err != nil {
-		return err
-	}
+	ctx, span := tracing.StartUseCase(ctx, "reservation.rollback")
+	defer span.End()
 
-	reservation, err := uc.reservationRepo.GetByID(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	if err := uc.reservationRepo.Delete(ctx, id); err != nil {
+	if err := uc.validateID(id); err != nil {
 		return err
 	}
 
-	// Must not exist reservation for increasing available request
-	if err := uc.environmentRepo.IncreaseAvailableRequest(
-		ctx, reservation.EnvironmentID, reservation.ServiceID); err != nil {
-		return err
-	}
-	return nil
+	// The refund, the units reset and the delete are settled together, so
+	// a failure leaves the reservation in place to be rolled back again.
+	return uc.reservationRepo.Release(ctx, id, nil)
 }
 
 func (uc *useCase) validateID(id string) errors.Error {
@@ -55,11 +46,9 @@ func (uc *useCase) validateID(id string) errors.Error {
 func NewUseCase(
 	validator validator.Validator,
 	reservationRepo ReservationRepository,
-	environmentRepo EnvironmentRepository,
 ) UseCase {
 	return &useCase{
 		validator:       validator,
 		reservationRepo: reservationRepo,
-		environmentRepo: environmentRepo,
 	}
 }
@@ -1 +1,129 @@
 package rollback
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/reservation/rollback/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator       *mockvalidator.MockValidator
+	reservationRepo *mock.MockReservationRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	time.Local = time.UTC
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.reservationRepo = mock.NewMockReservationRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.validator, s.reservationRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) expectValidID(id string) {
+	s.validator.EXPECT().
+		ValidateVariable(id, "id", "required,uuid4", gomock.Any()).
+		Return(nil).
+		Times(1)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	id := "0b7c6e4a-9d3f-4a25-8a4c-6e4a9d3f4a25"
+	s.expectValidID(id)
+
+	s.reservationRepo.EXPECT().
+		Release(s.ctx, id, (*dto.RequestExecutionStatusUpdate)(nil)).
+		Return(nil).
+		Times(1)
+
+	err := s.useCase.Execute(s.ctx, id)
+
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) TestInvalidID() {
+	id := "not-a-uuid"
+
+	s.validator.EXPECT().
+		ValidateVariable(id, "id", "required,uuid4", gomock.Any()).
+		Return(errors.NewValidationFailed("id must be a valid UUID", nil)).
+		Times(1)
+
+	s.reservationRepo.EXPECT().
+		Release(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := s.useCase.Execute(s.ctx, id)
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeValidationFailed, err.Code())
+}
+
+func (s *UseCaseSuite) TestReservationNotFound() {
+	id := "0b7c6e4a-9d3f-4a25-8a4c-6e4a9d3f4a25"
+	s.expectValidID(id)
+
+	s.reservationRepo.EXPECT().
+		Release(s.ctx, id, gomock.Any()).
+		Return(errors.NewNotFound("reservation not found", nil)).
+		Times(1)
+
+	err := s.useCase.Execute(s.ctx, id)
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeNotFound, err.Code())
+}
+
+func (s *UseCaseSuite) TestRefundFailureKeepsReservation() {
+	id := "0b7c6e4a-9d3f-4a25-8a4c-6e4a9d3f4a25"
+	s.expectValidID(id)
+
+	// The refund is part of the release, so when it fails the reservation
+	// is never deleted on its own and the rollback can be retried.
+	s.reservationRepo.EXPECT().
+		Release(s.ctx, id, gomock.Any()).
+		Return(errors.NewInternal("database error", nil)).
+		Times(1)
+
+	err := s.useCase.Execute(s.ctx, id)
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeInternal, err.Code())
+
+	// A retry settles the same reservation once the refund goes through.
+	s.expectValidID(id)
+
+	s.reservationRepo.EXPECT().
+		Release(s.ctx, id, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.Require().NoError(s.useCase.Execute(s.ctx, id))
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
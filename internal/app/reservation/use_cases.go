@@ -1,11 +1,47 @@
 package reservation
 
 import (
+	"time"
+
 	"github.com/MAD-py/pandora-core/internal/app/reservation/commit"
+	releaseexpired "github.com/MAD-py/pandora-core/internal/app/reservation/release_expired"
+	"github.com/MAD-py/pandora-core/internal/app/reservation/reserve"
 	"github.com/MAD-py/pandora-core/internal/app/reservation/rollback"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
 
+// ... Reserve Use Case ...
+
+type ReserveUseCase = reserve.UseCase
+
+func NewReserveUseCase(
+	validator validator.Validator,
+	ttl time.Duration,
+	apiKeyRepo APIKeyReserveRepository,
+	projectRepo ProjectReserveRepository,
+	serviceRepo ServiceReserveRepository,
+	requestRepo RequestReserveRepository,
+	environmentRepo EnvironmentReserveRepository,
+	reservationRepo ReservationReserveRepository,
+	quotaAlertRepo QuotaAlertReserveRepository,
+	webhookRepo WebhookReserveRepository,
+	rateLimiter RateLimiterReserve,
+) ReserveUseCase {
+	return reserve.NewUseCase(
+		validator,
+		ttl,
+		apiKeyRepo,
+		projectRepo,
+		serviceRepo,
+		requestRepo,
+		environmentRepo,
+		reservationRepo,
+		quotaAlertRepo,
+		webhookRepo,
+		rateLimiter,
+	)
+}
+
 // ... Commit Use Case ...
 
 type CommitUseCase = commit.UseCase
@@ -24,7 +60,16 @@ type RollbackUseCase = rollback.UseCase
 func NewRollbackUseCase(
 	validator validator.Validator,
 	reservationRepo ReservationRollbackRepository,
-	environmentRepo EnvironmentAvailableRequestIncrementerRepository,
 ) RollbackUseCase {
-	return rollback.NewUseCase(validator, reservationRepo, environmentRepo)
+	return rollback.NewUseCase(validator, reservationRepo)
+}
+
+// ... Release Expired Use Case ...
+
+type ReleaseExpiredUseCase = releaseexpired.UseCase
+
+func NewReleaseExpiredUseCase(
+	reservationRepo ReservationReleaseExpiredRepository,
+) ReleaseExpiredUseCase {
+	return releaseexpired.NewUseCase(reservationRepo)
 }
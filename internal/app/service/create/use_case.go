@@ -3,6 +3,8 @@ package create
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
@@ -18,11 +20,15 @@ type useCase struct {
 	validator validator.Validator
 
 	serviceRepo ServiceRepository
+	auditRepo   AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, req *dto.ServiceCreate,
 ) (*dto.ServiceResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "service.create")
+	defer span.End()
+
 	if err := uc.validateReq(req); err != nil {
 		return nil, err
 	}
@@ -48,6 +54,16 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionCreate,
+		enums.AuditEntityService,
+		service.ID,
+		nil,
+		&service,
+	)
+
 	return &dto.ServiceResponse{
 		ID:        service.ID,
 		Name:      service.Name,
@@ -69,10 +85,13 @@ func (uc *useCase) validateReq(req *dto.ServiceCreate) errors.Error {
 }
 
 func NewUseCase(
-	validator validator.Validator, serviceRepo ServiceRepository,
+	validator validator.Validator,
+	serviceRepo ServiceRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:   validator,
 		serviceRepo: serviceRepo,
+		auditRepo:   auditRepo,
 	}
 }
@@ -23,6 +23,7 @@ type Suite struct {
 
 	validator   *mockvalidator.MockValidator
 	serviceRepo *mock.MockServiceRepository
+	auditRepo   *mock.MockAuditRepository
 
 	useCase UseCase
 
@@ -35,9 +36,10 @@ func (s *Suite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 
 	s.serviceRepo = mock.NewMockServiceRepository(s.ctrl)
+	s.auditRepo = mock.NewMockAuditRepository(s.ctrl)
 	s.validator = mockvalidator.NewMockValidator(s.ctrl)
 
-	s.useCase = NewUseCase(s.validator, s.serviceRepo)
+	s.useCase = NewUseCase(s.validator, s.serviceRepo, s.auditRepo)
 
 	s.ctx = context.Background()
 }
@@ -67,6 +69,17 @@ func (s *Suite) TestSuccess() {
 		).
 		Times(1)
 
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *entities.AuditEntry) errors.Error {
+			s.Equal(enums.AuditActionCreate, entry.Action)
+			s.Equal(enums.AuditEntityService, entry.Entity)
+			s.Equal(42, entry.EntityID)
+			s.Equal(req.Name, entry.After["name"])
+			return nil
+		}).
+		Times(1)
+
 	resp, err := s.useCase.Execute(s.ctx, &req)
 
 	s.Require().NoError(err)
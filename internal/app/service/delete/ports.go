@@ -3,13 +3,20 @@ package delete
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
 type ServiceRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Service, errors.Error)
 	Delete(ctx context.Context, id int) errors.Error
 }
 
 type ProjectRepository interface {
 	ExistsServiceIn(ctx context.Context, serviceID int) (bool, errors.Error)
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
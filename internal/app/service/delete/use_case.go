@@ -3,6 +3,9 @@ package delete
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -16,9 +19,13 @@ type useCase struct {
 
 	serviceRepo ServiceRepository
 	projectRepo ProjectRepository
+	auditRepo   AuditRepository
 }
 
 func (uc *useCase) Execute(ctx context.Context, id int) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "service.delete")
+	defer span.End()
+
 	if err := uc.validateID(id); err != nil {
 		return err
 	}
@@ -37,10 +44,25 @@ func (uc *useCase) Execute(ctx context.Context, id int) errors.Error {
 		)
 	}
 
+	before, err := uc.serviceRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	if err := uc.serviceRepo.Delete(ctx, id); err != nil {
 		return err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionDelete,
+		enums.AuditEntityService,
+		id,
+		before,
+		nil,
+	)
+
 	return nil
 }
 
@@ -60,10 +82,12 @@ func NewUseCase(
 	validator validator.Validator,
 	serviceRepo ServiceRepository,
 	projectRepo ProjectRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		validator:   validator,
 		serviceRepo: serviceRepo,
 		projectRepo: projectRepo,
+		auditRepo:   auditRepo,
 	}
 }
@@ -43,17 +43,32 @@ func (m *MockServiceRepository) EXPECT() *MockServiceRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockServiceRepository) Count(ctx context.Context, filter *dto.ServiceFilter) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockServiceRepositoryMockRecorder) Count(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockServiceRepository)(nil).Count), ctx, filter)
+}
+
 // List mocks base method.
-func (m *MockServiceRepository) List(ctx context.Context, filter *dto.ServiceFilter) ([]*entities.Service, errors.Error) {
+func (m *MockServiceRepository) List(ctx context.Context, filter *dto.ServiceFilter, page *dto.Pagination) ([]*entities.Service, errors.Error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret := m.ctrl.Call(m, "List", ctx, filter, page)
 	ret0, _ := ret[0].([]*entities.Service)
 	ret1, _ := ret[1].(errors.Error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockServiceRepositoryMockRecorder) List(ctx, filter any) *gomock.Call {
+func (mr *MockServiceRepositoryMockRecorder) List(ctx, filter, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockServiceRepository)(nil).List), ctx, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockServiceRepository)(nil).List), ctx, filter, page)
 }
@@ -9,5 +9,6 @@ import (
 )
 
 type ServiceRepository interface {
-	List(ctx context.Context, filter *dto.ServiceFilter) ([]*entities.Service, errors.Error)
+	List(ctx context.Context, filter *dto.ServiceFilter, page *dto.Pagination) ([]*entities.Service, errors.Error)
+	Count(ctx context.Context, filter *dto.ServiceFilter) (int, errors.Error)
 }
@@ -2,14 +2,17 @@ package list
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
 
 type UseCase interface {
-	Execute(ctx context.Context, req *dto.ServiceFilter) ([]*dto.ServiceResponse, errors.Error)
+	Execute(ctx context.Context, req *dto.ServiceFilter, page *dto.Pagination) (*dto.Page[*dto.ServiceResponse], errors.Error)
 }
 
 type useCase struct {
@@ -19,29 +22,73 @@ type useCase struct {
 }
 
 func (uc *useCase) Execute(
-	ctx context.Context, req *dto.ServiceFilter,
-) ([]*dto.ServiceResponse, errors.Error) {
-	if err := uc.validateReq(req); err != nil {
+	ctx context.Context, req *dto.ServiceFilter, page *dto.Pagination,
+) (*dto.Page[*dto.ServiceResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "service.list")
+	defer span.End()
+
+	if err := uc.validateInput(req, page); err != nil {
 		return nil, err
 	}
 
-	services, err := uc.serviceRepo.List(ctx, req)
+	services, err := uc.serviceRepo.List(ctx, req, page)
 	if err != nil {
 		return nil, err
 	}
 
+	services, nextCursor := dto.TrimPage(
+		services,
+		page,
+		func(service *entities.Service) *dto.Cursor {
+			return &dto.Cursor{
+				Time: service.CreatedAt,
+				ID:   strconv.Itoa(service.ID),
+			}
+		},
+	)
+
 	serviceResponses := make([]*dto.ServiceResponse, len(services))
 	for i, service := range services {
 		serviceResponses[i] = &dto.ServiceResponse{
-			ID:        service.ID,
-			Name:      service.Name,
-			Status:    service.Status,
-			Version:   service.Version,
-			CreatedAt: service.CreatedAt,
+			ID:                   service.ID,
+			Name:                 service.Name,
+			Status:               service.Status,
+			Version:              service.Version,
+			CreatedAt:            service.CreatedAt,
+			RequestRetentionDays: service.RequestRetentionDays,
 		}
 	}
 
-	return serviceResponses, nil
+	resp := &dto.Page[*dto.ServiceResponse]{
+		Items:      serviceResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.serviceRepo.Count(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validateInput(
+	req *dto.ServiceFilter, page *dto.Pagination,
+) errors.Error {
+	var err errors.Error
+
+	if errReq := uc.validateReq(req); errReq != nil {
+		err = errors.Aggregate(err, errReq)
+	}
+
+	if errPage := uc.validatePage(page); errPage != nil {
+		err = errors.Aggregate(err, errPage)
+	}
+
+	return err
 }
 
 func (uc *useCase) validateReq(req *dto.ServiceFilter) errors.Error {
@@ -53,6 +100,17 @@ func (uc *useCase) validateReq(req *dto.ServiceFilter) errors.Error {
 	)
 }
 
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
 func NewUseCase(
 	validator validator.Validator, serviceRepo ServiceRepository,
 ) UseCase {
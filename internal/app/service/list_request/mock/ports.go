@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	dto "github.com/MAD-py/pandora-core/internal/domain/dto"
+	entities "github.com/MAD-py/pandora-core/internal/domain/entities"
 	errors "github.com/MAD-py/pandora-core/internal/domain/errors"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -81,17 +82,32 @@ func (m *MockRequestRepository) EXPECT() *MockRequestRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountByService mocks base method.
+func (m *MockRequestRepository) CountByService(ctx context.Context, serviceID int, filter *dto.RequestFilter) (int, errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByService", ctx, serviceID, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(errors.Error)
+	return ret0, ret1
+}
+
+// CountByService indicates an expected call of CountByService.
+func (mr *MockRequestRepositoryMockRecorder) CountByService(ctx, serviceID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByService", reflect.TypeOf((*MockRequestRepository)(nil).CountByService), ctx, serviceID, filter)
+}
+
 // ListByService mocks base method.
-func (m *MockRequestRepository) ListByService(ctx context.Context, serviceID int, filter *dto.RequestFilter) ([]*dto.RequestResponse, errors.Error) {
+func (m *MockRequestRepository) ListByService(ctx context.Context, serviceID int, filter *dto.RequestFilter, page *dto.Pagination) ([]*entities.Request, errors.Error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByService", ctx, serviceID, filter)
-	ret0, _ := ret[0].([]*dto.RequestResponse)
+	ret := m.ctrl.Call(m, "ListByService", ctx, serviceID, filter, page)
+	ret0, _ := ret[0].([]*entities.Request)
 	ret1, _ := ret[1].(errors.Error)
 	return ret0, ret1
 }
 
 // ListByService indicates an expected call of ListByService.
-func (mr *MockRequestRepositoryMockRecorder) ListByService(ctx, serviceID, filter any) *gomock.Call {
+func (mr *MockRequestRepositoryMockRecorder) ListByService(ctx, serviceID, filter, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByService", reflect.TypeOf((*MockRequestRepository)(nil).ListByService), ctx, serviceID, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByService", reflect.TypeOf((*MockRequestRepository)(nil).ListByService), ctx, serviceID, filter, page)
 }
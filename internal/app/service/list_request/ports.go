@@ -13,5 +13,6 @@ type ServiceRepository interface {
 }
 
 type RequestRepository interface {
-	ListByService(ctx context.Context, serviceID int, filter *dto.RequestFilter) ([]*entities.Request, errors.Error)
+	ListByService(ctx context.Context, serviceID int, filter *dto.RequestFilter, page *dto.Pagination) ([]*entities.Request, errors.Error)
+	CountByService(ctx context.Context, serviceID int, filter *dto.RequestFilter) (int, errors.Error)
 }
@@ -3,13 +3,15 @@ package listrequest
 import (
 	"context"
 
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
 
 type UseCase interface {
-	Execute(ctx context.Context, id int, req *dto.RequestFilter) ([]*dto.RequestResponse, errors.Error)
+	Execute(ctx context.Context, id int, req *dto.RequestFilter, page *dto.Pagination) (*dto.Page[*dto.RequestResponse], errors.Error)
 }
 
 type useCase struct {
@@ -20,9 +22,15 @@ type useCase struct {
 }
 
 func (uc *useCase) Execute(
-	ctx context.Context, id int, req *dto.RequestFilter,
-) ([]*dto.RequestResponse, errors.Error) {
-	if err := uc.validateInput(id, req); err != nil {
+	ctx context.Context,
+	id int,
+	req *dto.RequestFilter,
+	page *dto.Pagination,
+) (*dto.Page[*dto.RequestResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "service.list_request")
+	defer span.End()
+
+	if err := uc.validateInput(id, req, page); err != nil {
 		return nil, err
 	}
 
@@ -40,20 +48,30 @@ func (uc *useCase) Execute(
 		)
 	}
 
-	requests, err := uc.requestRepo.ListByService(ctx, id, req)
+	requests, err := uc.requestRepo.ListByService(ctx, id, req, page)
 	if err != nil {
 		return nil, err
 	}
 
+	requests, nextCursor := dto.TrimPage(
+		requests,
+		page,
+		func(request *entities.Request) *dto.Cursor {
+			return &dto.Cursor{Time: request.CreatedAt, ID: request.ID}
+		},
+	)
+
 	requestResponses := make([]*dto.RequestResponse, len(requests))
 	for i, request := range requests {
 		requestResponses[i] = &dto.RequestResponse{
 			ID:                 request.ID,
 			StartPoint:         request.StartPoint,
+			Detail:             request.Detail,
 			StatusCode:         request.StatusCode,
 			ExecutionStatus:    request.ExecutionStatus,
 			UnauthorizedReason: request.UnauthorizedReason,
-			RequestTime:        req.RequestTimeFrom,
+			Units:              request.Units,
+			RequestTime:        request.RequestTime,
 			Path:               request.Path,
 			Method:             request.Method,
 			IPAddress:          request.IPAddress,
@@ -78,10 +96,25 @@ func (uc *useCase) Execute(
 		}
 	}
 
-	return requestResponses, nil
+	resp := &dto.Page[*dto.RequestResponse]{
+		Items:      requestResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.requestRepo.CountByService(ctx, id, req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
 }
 
-func (uc *useCase) validateInput(id int, req *dto.RequestFilter) errors.Error {
+func (uc *useCase) validateInput(
+	id int, req *dto.RequestFilter, page *dto.Pagination,
+) errors.Error {
 	var err errors.Error
 
 	if errID := uc.validateID(id); errID != nil {
@@ -92,6 +125,10 @@ func (uc *useCase) validateInput(id int, req *dto.RequestFilter) errors.Error {
 		err = errors.Aggregate(err, errStatus)
 	}
 
+	if errPage := uc.validatePage(page); errPage != nil {
+		err = errors.Aggregate(err, errPage)
+	}
+
 	return err
 }
 
@@ -118,6 +155,17 @@ func (uc *useCase) validateReq(req *dto.RequestFilter) errors.Error {
 	)
 }
 
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
 func NewUseCase(
 	validator validator.Validator,
 	serviceRepo ServiceRepository,
@@ -1 +1,167 @@
 package listrequest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/service/list_request/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type UseCaseSuite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator   *mockvalidator.MockValidator
+	serviceRepo *mock.MockServiceRepository
+	requestRepo *mock.MockRequestRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	time.Local = time.UTC
+	s.ctrl = gomock.NewController(s.T())
+
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+	s.serviceRepo = mock.NewMockServiceRepository(s.ctrl)
+	s.requestRepo = mock.NewMockRequestRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.validator, s.serviceRepo, s.requestRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *UseCaseSuite) expectValidInput() {
+	s.validator.EXPECT().
+		ValidateVariable(gomock.Any(), "id", gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.validator.EXPECT().
+		ValidateStruct(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(2)
+}
+
+func (s *UseCaseSuite) newRequests(n int) []*entities.Request {
+	createdAt := time.Now()
+
+	requests := make([]*entities.Request, n)
+	for i := range requests {
+		requests[i] = &entities.Request{
+			ID:          fmt.Sprintf("0b7c6e4a-9d3f-4a25-8a4c-6e4a9d3f4a2%d", i),
+			APIKey:      &entities.RequestAPIKey{ID: 1, Key: "pandora_key_1234"},
+			Project:     &entities.RequestProject{ID: 1, Name: "Project"},
+			Environment: &entities.RequestEnvironment{ID: 1, Name: "Environment"},
+			Service:     &entities.RequestService{ID: 1, Name: "Service", Version: "1.0.0"},
+			RequestTime: createdAt.Add(-time.Duration(i) * time.Second),
+			CreatedAt:   createdAt.Add(-time.Duration(i) * time.Second),
+		}
+	}
+
+	return requests
+}
+
+func (s *UseCaseSuite) TestLastPage() {
+	page := &dto.Pagination{Limit: 3}
+	requests := s.newRequests(2)
+
+	s.expectValidInput()
+
+	s.serviceRepo.EXPECT().
+		Exists(s.ctx, 1).
+		Return(true, nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		ListByService(s.ctx, 1, gomock.Any(), page).
+		Return(requests, nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		CountByService(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, 1, &dto.RequestFilter{}, page)
+
+	s.Require().NoError(err)
+	s.Len(resp.Items, 2)
+	s.Empty(resp.NextCursor)
+	s.Nil(resp.Total)
+	s.Equal(requests[1].RequestTime, resp.Items[1].RequestTime)
+}
+
+func (s *UseCaseSuite) TestNextPage() {
+	page := &dto.Pagination{Limit: 2, IncludeTotal: true}
+	requests := s.newRequests(3)
+
+	s.expectValidInput()
+
+	s.serviceRepo.EXPECT().
+		Exists(s.ctx, 1).
+		Return(true, nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		ListByService(s.ctx, 1, gomock.Any(), page).
+		Return(requests, nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		CountByService(s.ctx, 1, gomock.Any()).
+		Return(7, nil).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, 1, &dto.RequestFilter{}, page)
+
+	s.Require().NoError(err)
+	s.Len(resp.Items, 2)
+	s.Require().NotNil(resp.Total)
+	s.Equal(7, *resp.Total)
+
+	cursor, decodeErr := (&dto.Pagination{Cursor: resp.NextCursor}).DecodeCursor()
+	s.Require().NoError(decodeErr)
+	s.Equal(requests[1].ID, cursor.ID)
+	s.True(requests[1].CreatedAt.Equal(cursor.Time))
+}
+
+func (s *UseCaseSuite) TestServiceNotFound() {
+	s.expectValidInput()
+
+	s.serviceRepo.EXPECT().
+		Exists(s.ctx, 1).
+		Return(false, nil).
+		Times(1)
+
+	s.requestRepo.EXPECT().
+		ListByService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(
+		s.ctx, 1, &dto.RequestFilter{}, &dto.Pagination{},
+	)
+
+	s.Require().Error(err)
+	s.Nil(resp)
+	s.Equal(errors.CodeNotFound, err.Code())
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseSuite))
+}
@@ -5,17 +5,20 @@ import (
 	"github.com/MAD-py/pandora-core/internal/app/service/delete"
 	"github.com/MAD-py/pandora-core/internal/app/service/list"
 	listrequest "github.com/MAD-py/pandora-core/internal/app/service/list_request"
+	updateretention "github.com/MAD-py/pandora-core/internal/app/service/update_retention"
 	updatestatus "github.com/MAD-py/pandora-core/internal/app/service/update_status"
 )
 
 // ... Create Use Case ...
 
 type ServiceCreateRepository = create.ServiceRepository
+type AuditCreateRepository = create.AuditRepository
 
 // ... Delete Use Case ...
 
 type ServiceDeleteRepository = delete.ServiceRepository
 type ProjectServiceVerifier = delete.ProjectRepository
+type AuditDeleteRepository = delete.AuditRepository
 
 // ... List Use Case ...
 
@@ -25,6 +28,12 @@ type ServiceListRepository = list.ServiceRepository
 type ServiceListRequestsRepository = listrequest.ServiceRepository
 type RequestListByServiceRepository = listrequest.RequestRepository
 
+// ... Update Retention Use Case ...
+
+type ServiceUpdateRetentionRepository = updateretention.ServiceRepository
+type AuditUpdateRetentionRepository = updateretention.AuditRepository
+
 // ... Update Status Use Case ...
 
 type ServiceUpdateStatusRepository = updatestatus.ServiceRepository
+type AuditUpdateStatusRepository = updatestatus.AuditRepository
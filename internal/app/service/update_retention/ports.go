@@ -0,0 +1,18 @@
+package updateretention
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type ServiceRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Service, errors.Error)
+	UpdateRetention(ctx context.Context, id int, days *int) (*entities.Service, errors.Error)
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -0,0 +1,131 @@
+package updateretention
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id int, req *dto.ServiceRetentionUpdate) (*dto.ServiceResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	serviceRepo ServiceRepository
+	auditRepo   AuditRepository
+}
+
+// Execute sets how many days the requests of the service are kept. Without
+// retention days the service follows the global retention again.
+func (uc *useCase) Execute(
+	ctx context.Context, id int, req *dto.ServiceRetentionUpdate,
+) (*dto.ServiceResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "service.update_retention")
+	defer span.End()
+
+	if err := uc.validateInput(id, req); err != nil {
+		return nil, err
+	}
+
+	before, err := uc.serviceRepo.GetByID(ctx, id)
+	if err != nil {
+		if err.Code() == errors.CodeNotFound {
+			return nil, errors.NewEntityNotFound(
+				"Service",
+				"service not found",
+				map[string]any{"id": id},
+				err,
+			)
+		}
+		return nil, err
+	}
+
+	service, err := uc.serviceRepo.UpdateRetention(ctx, id, req.RetentionDays)
+	if err != nil {
+		if err.Code() == errors.CodeNotFound {
+			return nil, errors.NewEntityNotFound(
+				"Service",
+				"service not found",
+				map[string]any{"id": id},
+				err,
+			)
+		}
+		return nil, err
+	}
+
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionUpdateRetention,
+		enums.AuditEntityService,
+		service.ID,
+		before,
+		service,
+	)
+
+	return &dto.ServiceResponse{
+		ID:                   service.ID,
+		Name:                 service.Name,
+		Status:               service.Status,
+		Version:              service.Version,
+		CreatedAt:            service.CreatedAt,
+		RequestRetentionDays: service.RequestRetentionDays,
+	}, nil
+}
+
+func (uc *useCase) validateInput(
+	id int, req *dto.ServiceRetentionUpdate,
+) errors.Error {
+	var err errors.Error
+
+	if errID := uc.validateID(id); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errReq := uc.validateReq(req); errReq != nil {
+		err = errors.Aggregate(err, errReq)
+	}
+
+	return err
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "id must be greater than 0",
+			"required": "id is required",
+		},
+	)
+}
+
+func (uc *useCase) validateReq(req *dto.ServiceRetentionUpdate) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"retention_days.gte": "retention_days must be greater than or equal to 0",
+			"retention_days.lte": "retention_days must be less than or equal to 36500",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	serviceRepo ServiceRepository,
+	auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		serviceRepo: serviceRepo,
+		validator:   validator,
+		auditRepo:   auditRepo,
+	}
+}
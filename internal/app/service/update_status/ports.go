@@ -3,11 +3,17 @@ package updatestatus
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
 	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
 type ServiceRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Service, errors.Error)
 	UpdateStatus(ctx context.Context, id int, status enums.ServiceStatus) (*entities.Service, errors.Error)
 }
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -3,6 +3,8 @@ package updatestatus
 import (
 	"context"
 
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
 	"github.com/MAD-py/pandora-core/internal/domain/dto"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
@@ -17,15 +19,32 @@ type useCase struct {
 	validator validator.Validator
 
 	serviceRepo ServiceRepository
+	auditRepo   AuditRepository
 }
 
 func (uc *useCase) Execute(
 	ctx context.Context, id int, status enums.ServiceStatus,
 ) (*dto.ServiceResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "service.update_status")
+	defer span.End()
+
 	if err := uc.validateInput(id, status); err != nil {
 		return nil, err
 	}
 
+	before, err := uc.serviceRepo.GetByID(ctx, id)
+	if err != nil {
+		if err.Code() == errors.CodeNotFound {
+			return nil, errors.NewEntityNotFound(
+				"Service",
+				"service not found",
+				map[string]any{"id": id},
+				err,
+			)
+		}
+		return nil, err
+	}
+
 	service, err := uc.serviceRepo.UpdateStatus(ctx, id, status)
 	if err != nil {
 		if err.Code() == errors.CodeNotFound {
@@ -39,12 +58,23 @@ func (uc *useCase) Execute(
 		return nil, err
 	}
 
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionUpdateStatus,
+		enums.AuditEntityService,
+		service.ID,
+		before,
+		service,
+	)
+
 	return &dto.ServiceResponse{
-		ID:        service.ID,
-		Name:      service.Name,
-		Status:    service.Status,
-		Version:   service.Version,
-		CreatedAt: service.CreatedAt,
+		ID:                   service.ID,
+		Name:                 service.Name,
+		Status:               service.Status,
+		Version:              service.Version,
+		CreatedAt:            service.CreatedAt,
+		RequestRetentionDays: service.RequestRetentionDays,
 	}, nil
 }
 
@@ -87,10 +117,13 @@ func (uc *useCase) validateStatus(status enums.ServiceStatus) errors.Error {
 }
 
 func NewUseCase(
-	validator validator.Validator, serviceRepo ServiceRepository,
+	validator validator.Validator,
+	serviceRepo ServiceRepository,
+	auditRepo AuditRepository,
 ) UseCase {
 	return &useCase{
 		serviceRepo: serviceRepo,
 		validator:   validator,
+		auditRepo:   auditRepo,
 	}
 }
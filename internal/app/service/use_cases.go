@@ -5,6 +5,7 @@ import (
 	"github.com/MAD-py/pandora-core/internal/app/service/delete"
 	"github.com/MAD-py/pandora-core/internal/app/service/list"
 	listrequest "github.com/MAD-py/pandora-core/internal/app/service/list_request"
+	updateretention "github.com/MAD-py/pandora-core/internal/app/service/update_retention"
 	updatestatus "github.com/MAD-py/pandora-core/internal/app/service/update_status"
 	"github.com/MAD-py/pandora-core/internal/validator"
 )
@@ -16,8 +17,9 @@ type CreateUseCase = create.UseCase
 func NewCreateUseCase(
 	validator validator.Validator,
 	serviceRepo ServiceCreateRepository,
+	auditRepo AuditCreateRepository,
 ) CreateUseCase {
-	return create.NewUseCase(validator, serviceRepo)
+	return create.NewUseCase(validator, serviceRepo, auditRepo)
 }
 
 // ... Delete Use Case ...
@@ -28,8 +30,9 @@ func NewDeleteUseCase(
 	validator validator.Validator,
 	serviceRepo ServiceDeleteRepository,
 	projectRepo ProjectServiceVerifier,
+	auditRepo AuditDeleteRepository,
 ) DeleteUseCase {
-	return delete.NewUseCase(validator, serviceRepo, projectRepo)
+	return delete.NewUseCase(validator, serviceRepo, projectRepo, auditRepo)
 }
 
 // ... List Use Case ...
@@ -54,6 +57,18 @@ func NewListRequestsUseCase(
 	return listrequest.NewUseCase(validator, serviceRepo, requestRepo)
 }
 
+// ... Update Retention Use Case ...
+
+type UpdateRetentionUseCase = updateretention.UseCase
+
+func NewUpdateRetentionUseCase(
+	validator validator.Validator,
+	serviceRepo ServiceUpdateRetentionRepository,
+	auditRepo AuditUpdateRetentionRepository,
+) UpdateRetentionUseCase {
+	return updateretention.NewUseCase(validator, serviceRepo, auditRepo)
+}
+
 // ... Update Status Use Case ...
 
 type UpdateStatusUseCase = updatestatus.UseCase
@@ -61,6 +76,7 @@ type UpdateStatusUseCase = updatestatus.UseCase
 func NewUpdateStatusUseCase(
 	validator validator.Validator,
 	serviceRepo ServiceUpdateStatusRepository,
+	auditRepo AuditUpdateStatusRepository,
 ) UpdateStatusUseCase {
-	return updatestatus.NewUseCase(validator, serviceRepo)
+	return updatestatus.NewUseCase(validator, serviceRepo, auditRepo)
 }
@@ -0,0 +1,26 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName names the tracer of the use cases.
+const tracerName = "github.com/MAD-py/pandora-core/internal/app"
+
+// StartUseCase starts the span of a use case run, named after the use case,
+// as a child of the span in ctx, which is the one of the request running
+// it. The queries of the use case are traced as children of the span, so
+// the caller must pass on the returned context and end the span.
+//
+// When the span is not recorded, as when tracing is disabled, ctx is
+// returned as is.
+func StartUseCase(ctx context.Context, name string) (context.Context, trace.Span) {
+	spanCtx, span := otel.Tracer(tracerName).Start(ctx, name)
+	if !span.IsRecording() {
+		return ctx, span
+	}
+	return spanCtx, span
+}
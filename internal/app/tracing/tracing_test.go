@@ -0,0 +1,109 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/reservation/commit"
+	"github.com/MAD-py/pandora-core/internal/app/reservation/commit/mock"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+func setTracerProvider(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(
+		sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+	)
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+	return recorder
+}
+
+func TestStartUseCaseNestsRepositorySpans(t *testing.T) {
+	recorder := setTracerProvider(t)
+	tracer := otel.Tracer("test")
+
+	ctrl := gomock.NewController(t)
+	validator := mockvalidator.NewMockValidator(ctrl)
+	reservationRepo := mock.NewMockReservationRepository(ctrl)
+
+	reservation := &entities.Reservation{
+		ID:        "0b7c6e4a-9d3f-4a25-8a4c-6e4a9d3f4a25",
+		ExpiresAt: time.Now().Add(time.Minute),
+		Units:     1,
+	}
+
+	validator.EXPECT().
+		ValidateVariable(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+	validator.EXPECT().ValidateStruct(gomock.Any(), gomock.Any()).Return(nil)
+
+	// The repositories trace their queries from the context they are given,
+	// as the pool tracer does.
+	reservationRepo.EXPECT().
+		GetByID(gomock.Any(), reservation.ID).
+		DoAndReturn(func(
+			ctx context.Context, id string,
+		) (*entities.Reservation, errors.Error) {
+			_, span := tracer.Start(ctx, "query GetByID")
+			span.End()
+			return reservation, nil
+		})
+	reservationRepo.EXPECT().
+		Commit(gomock.Any(), reservation.ID, 1).
+		DoAndReturn(func(ctx context.Context, id string, units int) errors.Error {
+			_, span := tracer.Start(ctx, "query Commit")
+			span.End()
+			return nil
+		})
+
+	ctx, request := tracer.Start(context.Background(), "request")
+	err := commit.NewUseCase(validator, reservationRepo).
+		Execute(ctx, reservation.ID, &dto.ReservationCommit{})
+	request.End()
+	require.Nil(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 4)
+
+	byName := make(map[string]sdktrace.ReadOnlySpan, len(spans))
+	for _, span := range spans {
+		byName[span.Name()] = span
+	}
+	require.Contains(t, byName, "reservation.commit")
+
+	parentOf := func(name string) string {
+		parent := byName[name].Parent().SpanID()
+		for _, span := range spans {
+			if span.SpanContext().SpanID() == parent {
+				return span.Name()
+			}
+		}
+		return ""
+	}
+	require.Equal(t, "request", parentOf("reservation.commit"))
+	require.Equal(t, "reservation.commit", parentOf("query GetByID"))
+	require.Equal(t, "reservation.commit", parentOf("query Commit"))
+	require.False(t, byName["request"].Parent().IsValid())
+}
+
+func TestStartUseCaseKeepsContextWhenNotRecording(t *testing.T) {
+	otel.SetTracerProvider(noop.NewTracerProvider())
+
+	ctx := context.Background()
+	spanCtx, span := tracing.StartUseCase(ctx, "reservation.commit")
+	defer span.End()
+
+	require.Equal(t, ctx, spanCtx)
+}
@@ -0,0 +1,102 @@
+package create
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, req *dto.WebhookCreate) (*dto.WebhookResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	webhookRepo WebhookRepository
+	auditRepo   AuditRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, req *dto.WebhookCreate,
+) (*dto.WebhookResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "webhook.create")
+	defer span.End()
+
+	if err := uc.validateReq(req); err != nil {
+		return nil, err
+	}
+
+	webhook := entities.Webhook{
+		ClientID:  req.ClientID,
+		ProjectID: req.ProjectID,
+		URL:       req.URL,
+		Events:    req.Events,
+		Status:    enums.WebhookStatusEnabled,
+	}
+
+	if err := webhook.GenerateSecret(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.webhookRepo.Create(ctx, &webhook); err != nil {
+		return nil, err
+	}
+
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionCreate,
+		enums.AuditEntityWebhook,
+		webhook.ID,
+		nil,
+		&webhook,
+	)
+
+	return &dto.WebhookResponse{
+		ID:        webhook.ID,
+		ClientID:  webhook.ClientID,
+		ProjectID: webhook.ProjectID,
+		URL:       webhook.URL,
+		Events:    webhook.Events,
+		Status:    webhook.Status,
+		CreatedAt: webhook.CreatedAt,
+		Secret:    webhook.Secret,
+	}, nil
+}
+
+func (uc *useCase) validateReq(req *dto.WebhookCreate) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"client_id.gt":               "client_id must be greater than 0",
+			"client_id.excluded_with":    "client_id and project_id cannot be set together",
+			"client_id.required_without": "client_id or project_id is required",
+			"project_id.gt":              "project_id must be greater than 0",
+			"url.http_url":               "url must be a valid HTTP or HTTPS URL",
+			"url.required":               "url is required",
+			"events.min":                 "events must contain at least one event",
+			"events.unique":              "events must not repeat an event",
+			"events.required":            "events is required",
+			"events[].enums":             "events must be one of the following: quota.threshold_reached, quota.exhausted, quota.reset, api_key.expired, api_key.disabled, quota.alert_triggered",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	webhookRepo WebhookRepository,
+	auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		validator:   validator,
+		webhookRepo: webhookRepo,
+		auditRepo:   auditRepo,
+	}
+}
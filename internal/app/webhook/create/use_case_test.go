@@ -0,0 +1,168 @@
+package create
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/webhook/create/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	mockvalidator "github.com/MAD-py/pandora-core/internal/validator/mock"
+)
+
+type Suite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	validator   *mockvalidator.MockValidator
+	webhookRepo *mock.MockWebhookRepository
+	auditRepo   *mock.MockAuditRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *Suite) SetupTest() {
+	time.Local = time.UTC
+
+	s.ctrl = gomock.NewController(s.T())
+
+	s.webhookRepo = mock.NewMockWebhookRepository(s.ctrl)
+	s.auditRepo = mock.NewMockAuditRepository(s.ctrl)
+	s.validator = mockvalidator.NewMockValidator(s.ctrl)
+
+	s.useCase = NewUseCase(s.validator, s.webhookRepo, s.auditRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *Suite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *Suite) newRequest() dto.WebhookCreate {
+	return dto.WebhookCreate{
+		ProjectID: 7,
+		URL:       "https://example.com/hooks/pandora",
+		Events: []enums.WebhookEventType{
+			enums.WebhookEventTypeQuotaExhausted,
+			enums.WebhookEventTypeAPIKeyExpired,
+		},
+	}
+}
+
+func (s *Suite) TestSuccess() {
+	req := s.newRequest()
+
+	now := time.Now()
+
+	s.validator.EXPECT().
+		ValidateStruct(&req, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	var secret string
+	s.webhookRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, webhook *entities.Webhook) errors.Error {
+				s.Equal(enums.WebhookStatusEnabled, webhook.Status)
+				s.True(strings.HasPrefix(webhook.Secret, "whsec_"))
+
+				secret = webhook.Secret
+				webhook.ID = 42
+				webhook.CreatedAt = now
+				return nil
+			},
+		).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *entities.AuditEntry) errors.Error {
+			s.Equal(enums.AuditActionCreate, entry.Action)
+			s.Equal(enums.AuditEntityWebhook, entry.Entity)
+			s.Equal(42, entry.EntityID)
+			s.Nil(entry.Before)
+			s.Equal(req.URL, entry.After["url"])
+			s.NotContains(entry.After, "secret")
+			return nil
+		}).
+		Times(1)
+
+	resp, err := s.useCase.Execute(s.ctx, &req)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	s.Equal(42, resp.ID)
+	s.Zero(resp.ClientID)
+	s.Equal(req.ProjectID, resp.ProjectID)
+	s.Equal(req.URL, resp.URL)
+	s.Equal(req.Events, resp.Events)
+	s.Equal(enums.WebhookStatusEnabled, resp.Status)
+	s.Equal(secret, resp.Secret)
+	s.Equal(now, resp.CreatedAt)
+}
+
+func (s *Suite) TestValidationError() {
+	req := dto.WebhookCreate{}
+
+	validationErr := errors.NewValidationFailed("Validation Error", nil)
+	s.validator.EXPECT().
+		ValidateStruct(&req, gomock.Any()).
+		Return(validationErr).
+		Times(1)
+
+	s.webhookRepo.EXPECT().
+		Create(s.ctx, gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, &req)
+
+	s.Require().Nil(resp)
+	s.Require().Error(err)
+
+	s.Equal(errors.CodeValidationFailed, err.Code())
+	s.Equal(validationErr, err)
+}
+
+func (s *Suite) TestRepositoryError() {
+	req := s.newRequest()
+
+	s.validator.EXPECT().
+		ValidateStruct(&req, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	repositoryErr := errors.NewInternal("Repository Error", nil)
+	s.webhookRepo.EXPECT().
+		Create(s.ctx, gomock.AssignableToTypeOf(&entities.Webhook{})).
+		Return(repositoryErr).
+		Times(1)
+
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resp, err := s.useCase.Execute(s.ctx, &req)
+
+	s.Require().Nil(resp)
+	s.Require().Error(err)
+
+	s.Equal(errors.CodeInternal, err.Code())
+	s.Equal(repositoryErr, err)
+}
+
+func TestWebhookCreateSuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
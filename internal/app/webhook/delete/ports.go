@@ -0,0 +1,18 @@
+package delete
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type WebhookRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Webhook, errors.Error)
+	Delete(ctx context.Context, id int) errors.Error
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -0,0 +1,76 @@
+package delete
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id int) errors.Error
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	webhookRepo WebhookRepository
+	auditRepo   AuditRepository
+}
+
+func (uc *useCase) Execute(ctx context.Context, id int) errors.Error {
+	ctx, span := tracing.StartUseCase(ctx, "webhook.delete")
+	defer span.End()
+
+	if err := uc.validateID(id); err != nil {
+		return err
+	}
+
+	before, err := uc.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.webhookRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionDelete,
+		enums.AuditEntityWebhook,
+		id,
+		before,
+		nil,
+	)
+
+	return nil
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "id must be greater than 0",
+			"required": "id is required",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	webhookRepo WebhookRepository,
+	auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		validator:   validator,
+		webhookRepo: webhookRepo,
+		auditRepo:   auditRepo,
+	}
+}
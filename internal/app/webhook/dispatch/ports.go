@@ -0,0 +1,18 @@
+package dispatch
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type WebhookRepository interface {
+	ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entities.WebhookDelivery, errors.Error)
+	UpdateDelivery(ctx context.Context, delivery *entities.WebhookDelivery) errors.Error
+}
+
+type WebhookSender interface {
+	Send(ctx context.Context, delivery *entities.WebhookDelivery) (int, errors.Error)
+}
@@ -0,0 +1,141 @@
+package dispatch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+const (
+	batchSize = 100
+
+	// concurrency is the number of deliveries sent at the same time, so a
+	// slow webhook does not hold back the others.
+	concurrency = 10
+
+	// lease is how long a claimed delivery is kept from other runs. A
+	// delivery whose outcome could not be recorded is sent again after it.
+	lease = 5 * time.Minute
+)
+
+type UseCase interface {
+	Execute(ctx context.Context) (*dto.WebhookDispatch, errors.Error)
+}
+
+type useCase struct {
+	webhookRepo WebhookRepository
+	sender      WebhookSender
+}
+
+// Execute sends the deliveries that are due, batch after batch, until none
+// is left. The outcome of every attempt is recorded even when others fail
+// to be.
+func (uc *useCase) Execute(
+	ctx context.Context,
+) (*dto.WebhookDispatch, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "webhook.dispatch")
+	defer span.End()
+
+	result := new(dto.WebhookDispatch)
+
+	var errs errors.Error
+	for ctx.Err() == nil {
+		deliveries, err := uc.webhookRepo.ClaimDueDeliveries(
+			ctx, time.Now(), lease, batchSize,
+		)
+		if err != nil {
+			return result, errors.Aggregate(errs, err)
+		}
+
+		if err := uc.sendAll(ctx, deliveries, result); err != nil {
+			errs = errors.Aggregate(errs, err)
+		}
+
+		if len(deliveries) < batchSize {
+			break
+		}
+	}
+
+	return result, errs
+}
+
+func (uc *useCase) sendAll(
+	ctx context.Context,
+	deliveries []*entities.WebhookDelivery,
+	result *dto.WebhookDispatch,
+) errors.Error {
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs errors.Error
+	)
+
+	slots := make(chan struct{}, concurrency)
+	for _, delivery := range deliveries {
+		slots <- struct{}{}
+		wg.Add(1)
+
+		go func(delivery *entities.WebhookDelivery) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			err := uc.send(ctx, delivery)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = errors.Aggregate(errs, err)
+				return
+			}
+
+			switch delivery.Status {
+			case enums.WebhookDeliveryStatusDelivered:
+				result.Delivered++
+			case enums.WebhookDeliveryStatusFailed:
+				result.Failed++
+			default:
+				result.Retrying++
+			}
+		}(delivery)
+	}
+
+	wg.Wait()
+	return errs
+}
+
+func (uc *useCase) send(
+	ctx context.Context, delivery *entities.WebhookDelivery,
+) errors.Error {
+	statusCode, err := uc.sender.Send(ctx, delivery)
+
+	// The delivery log keeps the cause, such as a timeout, rather than
+	// the generic error of the sender.
+	var failure string
+	if err != nil {
+		failure = err.Error()
+		if cause := err.Unwrap(); cause != nil {
+			failure = cause.Error()
+		}
+	}
+
+	delivery.RecordAttempt(time.Now(), statusCode, failure)
+	return uc.webhookRepo.UpdateDelivery(ctx, delivery)
+}
+
+func NewUseCase(
+	webhookRepo WebhookRepository, sender WebhookSender,
+) UseCase {
+	return &useCase{
+		webhookRepo: webhookRepo,
+		sender:      sender,
+	}
+}
@@ -0,0 +1,174 @@
+package dispatch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/webhook/dispatch/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type Suite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	webhookRepo *mock.MockWebhookRepository
+	sender      *mock.MockWebhookSender
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *Suite) SetupTest() {
+	time.Local = time.UTC
+
+	s.ctrl = gomock.NewController(s.T())
+
+	s.webhookRepo = mock.NewMockWebhookRepository(s.ctrl)
+	s.sender = mock.NewMockWebhookSender(s.ctrl)
+
+	s.useCase = NewUseCase(s.webhookRepo, s.sender)
+
+	s.ctx = context.Background()
+}
+
+func (s *Suite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *Suite) newDelivery(id string, attempts int) *entities.WebhookDelivery {
+	return &entities.WebhookDelivery{
+		ID:        id,
+		WebhookID: 1,
+		Event:     enums.WebhookEventTypeQuotaExhausted,
+		Payload:   []byte(`{}`),
+		Status:    enums.WebhookDeliveryStatusPending,
+		Attempts:  attempts,
+		URL:       "https://example.com/hooks/pandora",
+		Secret:    "whsec_test",
+	}
+}
+
+func (s *Suite) TestDeliveredRetryingAndFailed() {
+	delivered := s.newDelivery("delivered", 0)
+	retrying := s.newDelivery("retrying", 0)
+	failed := s.newDelivery("failed", entities.WebhookMaxAttempts-1)
+
+	s.webhookRepo.EXPECT().
+		ClaimDueDeliveries(s.ctx, gomock.Any(), lease, batchSize).
+		Return([]*entities.WebhookDelivery{delivered, retrying, failed}, nil).
+		Times(1)
+
+	s.sender.EXPECT().Send(s.ctx, delivered).Return(200, nil).Times(1)
+	s.sender.EXPECT().Send(s.ctx, retrying).Return(503, nil).Times(1)
+	s.sender.EXPECT().
+		Send(s.ctx, failed).
+		Return(0, errors.NewInternal("failed to send webhook", context.DeadlineExceeded)).
+		Times(1)
+
+	s.webhookRepo.EXPECT().
+		UpdateDelivery(s.ctx, gomock.Any()).
+		Return(nil).
+		Times(3)
+
+	result, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(1, result.Delivered)
+	s.Equal(1, result.Retrying)
+	s.Equal(1, result.Failed)
+
+	s.Equal(enums.WebhookDeliveryStatusDelivered, delivered.Status)
+	s.Equal(1, delivered.Attempts)
+	s.False(delivered.DeliveredAt.IsZero())
+
+	s.Equal(enums.WebhookDeliveryStatusPending, retrying.Status)
+	s.Equal(503, retrying.LastStatusCode)
+	s.Equal("unexpected status code 503", retrying.LastError)
+	s.True(retrying.NextAttemptAt.After(time.Now()))
+
+	s.Equal(enums.WebhookDeliveryStatusFailed, failed.Status)
+	s.Equal(entities.WebhookMaxAttempts, failed.Attempts)
+	s.Equal(context.DeadlineExceeded.Error(), failed.LastError)
+}
+
+func (s *Suite) TestClaimsUntilBatchIsNotFull() {
+	full := make([]*entities.WebhookDelivery, batchSize)
+	for i := range full {
+		full[i] = s.newDelivery("full", 0)
+	}
+
+	gomock.InOrder(
+		s.webhookRepo.EXPECT().
+			ClaimDueDeliveries(s.ctx, gomock.Any(), lease, batchSize).
+			Return(full, nil),
+		s.webhookRepo.EXPECT().
+			ClaimDueDeliveries(s.ctx, gomock.Any(), lease, batchSize).
+			Return(nil, nil),
+	)
+
+	s.sender.EXPECT().
+		Send(s.ctx, gomock.Any()).
+		Return(204, nil).
+		Times(batchSize)
+
+	s.webhookRepo.EXPECT().
+		UpdateDelivery(s.ctx, gomock.Any()).
+		Return(nil).
+		Times(batchSize)
+
+	result, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(batchSize, result.Delivered)
+}
+
+func (s *Suite) TestUpdateDeliveryError() {
+	delivery := s.newDelivery("delivery", 0)
+
+	s.webhookRepo.EXPECT().
+		ClaimDueDeliveries(s.ctx, gomock.Any(), lease, batchSize).
+		Return([]*entities.WebhookDelivery{delivery}, nil).
+		Times(1)
+
+	s.sender.EXPECT().Send(s.ctx, delivery).Return(200, nil).Times(1)
+
+	repositoryErr := errors.NewInternal("Repository Error", nil)
+	s.webhookRepo.EXPECT().
+		UpdateDelivery(s.ctx, delivery).
+		Return(repositoryErr).
+		Times(1)
+
+	result, err := s.useCase.Execute(s.ctx)
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeInternal, err.Code())
+	s.Zero(result.Delivered)
+}
+
+func (s *Suite) TestClaimError() {
+	repositoryErr := errors.NewInternal("Repository Error", nil)
+	s.webhookRepo.EXPECT().
+		ClaimDueDeliveries(s.ctx, gomock.Any(), lease, batchSize).
+		Return(nil, repositoryErr).
+		Times(1)
+
+	s.sender.EXPECT().Send(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.useCase.Execute(s.ctx)
+
+	s.Require().Error(err)
+	s.Equal(errors.CodeInternal, err.Code())
+}
+
+func TestWebhookDispatchSuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
@@ -0,0 +1,12 @@
+package get
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type WebhookRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Webhook, errors.Error)
+}
@@ -0,0 +1,75 @@
+package get
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id int) (*dto.WebhookResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	webhookRepo WebhookRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, id int,
+) (*dto.WebhookResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "webhook.get")
+	defer span.End()
+
+	if err := uc.validateID(id); err != nil {
+		return nil, err
+	}
+
+	webhook, err := uc.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		if err.Code() == errors.CodeNotFound {
+			return nil, errors.NewEntityNotFound(
+				"webhook",
+				"webhook not found",
+				map[string]any{"id": id},
+				err,
+			)
+		}
+		return nil, err
+	}
+
+	return &dto.WebhookResponse{
+		ID:        webhook.ID,
+		ClientID:  webhook.ClientID,
+		ProjectID: webhook.ProjectID,
+		URL:       webhook.URL,
+		Events:    webhook.Events,
+		Status:    webhook.Status,
+		CreatedAt: webhook.CreatedAt,
+	}, nil
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "id must be greater than 0",
+			"required": "id is required",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator, webhookRepo WebhookRepository,
+) UseCase {
+	return &useCase{
+		validator:   validator,
+		webhookRepo: webhookRepo,
+	}
+}
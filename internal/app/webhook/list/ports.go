@@ -0,0 +1,14 @@
+package list
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type WebhookRepository interface {
+	List(ctx context.Context, filter *dto.WebhookFilter, page *dto.Pagination) ([]*entities.Webhook, errors.Error)
+	Count(ctx context.Context, filter *dto.WebhookFilter) (int, errors.Error)
+}
@@ -0,0 +1,123 @@
+package list
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, req *dto.WebhookFilter, page *dto.Pagination) (*dto.Page[*dto.WebhookResponse], errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	webhookRepo WebhookRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, req *dto.WebhookFilter, page *dto.Pagination,
+) (*dto.Page[*dto.WebhookResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "webhook.list")
+	defer span.End()
+
+	if err := uc.validateInput(req, page); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := uc.webhookRepo.List(ctx, req, page)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, nextCursor := dto.TrimPage(
+		webhooks,
+		page,
+		func(webhook *entities.Webhook) *dto.Cursor {
+			return &dto.Cursor{
+				Time: webhook.CreatedAt,
+				ID:   strconv.Itoa(webhook.ID),
+			}
+		},
+	)
+
+	webhookResponses := make([]*dto.WebhookResponse, len(webhooks))
+	for i, webhook := range webhooks {
+		webhookResponses[i] = &dto.WebhookResponse{
+			ID:        webhook.ID,
+			ClientID:  webhook.ClientID,
+			ProjectID: webhook.ProjectID,
+			URL:       webhook.URL,
+			Events:    webhook.Events,
+			Status:    webhook.Status,
+			CreatedAt: webhook.CreatedAt,
+		}
+	}
+
+	resp := &dto.Page[*dto.WebhookResponse]{
+		Items:      webhookResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.webhookRepo.Count(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validateInput(
+	req *dto.WebhookFilter, page *dto.Pagination,
+) errors.Error {
+	var err errors.Error
+
+	if errReq := uc.validateReq(req); errReq != nil {
+		err = errors.Aggregate(err, errReq)
+	}
+
+	if errPage := uc.validatePage(page); errPage != nil {
+		err = errors.Aggregate(err, errPage)
+	}
+
+	return err
+}
+
+func (uc *useCase) validateReq(req *dto.WebhookFilter) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"client_id.gt":  "client_id must be greater than 0",
+			"project_id.gt": "project_id must be greater than 0",
+		},
+	)
+}
+
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator, webhookRepo WebhookRepository,
+) UseCase {
+	return &useCase{
+		validator:   validator,
+		webhookRepo: webhookRepo,
+	}
+}
@@ -0,0 +1,15 @@
+package listdeliveries
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type WebhookRepository interface {
+	Exists(ctx context.Context, id int) (bool, errors.Error)
+	ListDeliveries(ctx context.Context, id int, filter *dto.WebhookDeliveryFilter, page *dto.Pagination) ([]*entities.WebhookDelivery, errors.Error)
+	CountDeliveries(ctx context.Context, id int, filter *dto.WebhookDeliveryFilter) (int, errors.Error)
+}
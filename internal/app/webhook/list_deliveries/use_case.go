@@ -0,0 +1,155 @@
+package listdeliveries
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id int, req *dto.WebhookDeliveryFilter, page *dto.Pagination) (*dto.Page[*dto.WebhookDeliveryResponse], errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	webhookRepo WebhookRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context,
+	id int,
+	req *dto.WebhookDeliveryFilter,
+	page *dto.Pagination,
+) (*dto.Page[*dto.WebhookDeliveryResponse], errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "webhook.list_deliveries")
+	defer span.End()
+
+	if err := uc.validateInput(id, req, page); err != nil {
+		return nil, err
+	}
+
+	exists, err := uc.webhookRepo.Exists(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.NewEntityNotFound(
+			"Webhook",
+			"webhook not found",
+			map[string]any{"id": id},
+			nil,
+		)
+	}
+
+	deliveries, err := uc.webhookRepo.ListDeliveries(ctx, id, req, page)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, nextCursor := dto.TrimPage(
+		deliveries,
+		page,
+		func(delivery *entities.WebhookDelivery) *dto.Cursor {
+			return &dto.Cursor{Time: delivery.CreatedAt, ID: delivery.ID}
+		},
+	)
+
+	deliveryResponses := make([]*dto.WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		deliveryResponses[i] = &dto.WebhookDeliveryResponse{
+			ID:             delivery.ID,
+			WebhookID:      delivery.WebhookID,
+			Event:          delivery.Event,
+			Payload:        delivery.Payload,
+			Status:         delivery.Status,
+			Attempts:       delivery.Attempts,
+			NextAttemptAt:  delivery.NextAttemptAt,
+			LastStatusCode: delivery.LastStatusCode,
+			LastError:      delivery.LastError,
+			DeliveredAt:    delivery.DeliveredAt,
+			CreatedAt:      delivery.CreatedAt,
+		}
+	}
+
+	resp := &dto.Page[*dto.WebhookDeliveryResponse]{
+		Items:      deliveryResponses,
+		NextCursor: nextCursor,
+	}
+
+	if page.IncludeTotal {
+		total, err := uc.webhookRepo.CountDeliveries(ctx, id, req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
+}
+
+func (uc *useCase) validateInput(
+	id int, req *dto.WebhookDeliveryFilter, page *dto.Pagination,
+) errors.Error {
+	var err errors.Error
+
+	if errID := uc.validateID(id); errID != nil {
+		err = errors.Aggregate(err, errID)
+	}
+
+	if errReq := uc.validateReq(req); errReq != nil {
+		err = errors.Aggregate(err, errReq)
+	}
+
+	if errPage := uc.validatePage(page); errPage != nil {
+		err = errors.Aggregate(err, errPage)
+	}
+
+	return err
+}
+
+func (uc *useCase) validateID(id int) errors.Error {
+	return uc.validator.ValidateVariable(
+		id,
+		"id",
+		"required,gt=0",
+		map[string]string{
+			"gt":       "id must be greater than 0",
+			"required": "id is required",
+		},
+	)
+}
+
+func (uc *useCase) validateReq(req *dto.WebhookDeliveryFilter) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"status.enums": "status must be one of the following: pending, delivered, failed",
+		},
+	)
+}
+
+func (uc *useCase) validatePage(page *dto.Pagination) errors.Error {
+	return uc.validator.ValidateStruct(
+		page,
+		map[string]string{
+			"cursor.base64rawurl": "cursor is invalid",
+			"limit.gt":            "limit must be greater than 0",
+			"limit.lte":           "limit must be less than or equal to 500",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator, webhookRepo WebhookRepository,
+) UseCase {
+	return &useCase{
+		validator:   validator,
+		webhookRepo: webhookRepo,
+	}
+}
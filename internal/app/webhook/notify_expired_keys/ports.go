@@ -0,0 +1,19 @@
+package notifyexpiredkeys
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type APIKeyRepository interface {
+	ListExpiredBetween(ctx context.Context, from, to time.Time) ([]*entities.APIKey, errors.Error)
+}
+
+type WebhookRepository interface {
+	GetExpiryWatermark(ctx context.Context) (time.Time, errors.Error)
+	CreateDeliveries(ctx context.Context, events []*entities.WebhookEvent) (int, errors.Error)
+	UpdateExpiryWatermark(ctx context.Context, watermark time.Time) errors.Error
+}
@@ -0,0 +1,75 @@
+package notifyexpiredkeys
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context) (int, errors.Error)
+}
+
+type useCase struct {
+	apiKeyRepo  APIKeyRepository
+	webhookRepo WebhookRepository
+}
+
+// Execute queues an api_key.expired event for every key that expired since
+// the previous run and returns the number of deliveries created. The first
+// run only records the watermark, so keys that expired before webhooks were
+// available are not notified.
+func (uc *useCase) Execute(ctx context.Context) (int, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "webhook.notify_expired_keys")
+	defer span.End()
+
+	now := time.Now()
+
+	watermark, err := uc.webhookRepo.GetExpiryWatermark(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if watermark.IsZero() {
+		return 0, uc.webhookRepo.UpdateExpiryWatermark(ctx, now)
+	}
+
+	apiKeys, err := uc.apiKeyRepo.ListExpiredBetween(ctx, watermark, now)
+	if err != nil {
+		return 0, err
+	}
+
+	events := make([]*entities.WebhookEvent, len(apiKeys))
+	for i, apiKey := range apiKeys {
+		events[i] = &entities.WebhookEvent{
+			Type:          enums.WebhookEventTypeAPIKeyExpired,
+			EnvironmentID: apiKey.EnvironmentID,
+			APIKeyID:      apiKey.ID,
+			OccurredAt:    apiKey.ExpiresAt,
+		}
+	}
+
+	created, err := uc.webhookRepo.CreateDeliveries(ctx, events)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := uc.webhookRepo.UpdateExpiryWatermark(ctx, now); err != nil {
+		return created, err
+	}
+
+	return created, nil
+}
+
+func NewUseCase(
+	apiKeyRepo APIKeyRepository, webhookRepo WebhookRepository,
+) UseCase {
+	return &useCase{
+		apiKeyRepo:  apiKeyRepo,
+		webhookRepo: webhookRepo,
+	}
+}
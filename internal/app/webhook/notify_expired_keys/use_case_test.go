@@ -0,0 +1,146 @@
+package notifyexpiredkeys
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MAD-py/pandora-core/internal/app/webhook/notify_expired_keys/mock"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type Suite struct {
+	suite.Suite
+
+	ctrl *gomock.Controller
+
+	apiKeyRepo  *mock.MockAPIKeyRepository
+	webhookRepo *mock.MockWebhookRepository
+
+	useCase UseCase
+
+	ctx context.Context
+}
+
+func (s *Suite) SetupTest() {
+	time.Local = time.UTC
+
+	s.ctrl = gomock.NewController(s.T())
+
+	s.apiKeyRepo = mock.NewMockAPIKeyRepository(s.ctrl)
+	s.webhookRepo = mock.NewMockWebhookRepository(s.ctrl)
+
+	s.useCase = NewUseCase(s.apiKeyRepo, s.webhookRepo)
+
+	s.ctx = context.Background()
+}
+
+func (s *Suite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *Suite) TestSuccess() {
+	watermark := time.Now().Add(-time.Minute)
+	expiresAt := time.Now().Add(-30 * time.Second)
+
+	s.webhookRepo.EXPECT().
+		GetExpiryWatermark(s.ctx).
+		Return(watermark, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		ListExpiredBetween(s.ctx, watermark, gomock.Any()).
+		Return(
+			[]*entities.APIKey{
+				{ID: 10, EnvironmentID: 100, ExpiresAt: expiresAt},
+			},
+			nil,
+		).
+		Times(1)
+
+	s.webhookRepo.EXPECT().
+		CreateDeliveries(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, events []*entities.WebhookEvent) (int, errors.Error) {
+			s.Require().Len(events, 1)
+			s.Equal(enums.WebhookEventTypeAPIKeyExpired, events[0].Type)
+			s.Equal(100, events[0].EnvironmentID)
+			s.Equal(10, events[0].APIKeyID)
+			s.Equal(expiresAt, events[0].OccurredAt)
+			return 2, nil
+		}).
+		Times(1)
+
+	s.webhookRepo.EXPECT().
+		UpdateExpiryWatermark(s.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, t time.Time) errors.Error {
+			s.True(t.After(watermark))
+			return nil
+		}).
+		Times(1)
+
+	created, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(2, created)
+}
+
+func (s *Suite) TestFirstRunOnlyRecordsWatermark() {
+	s.webhookRepo.EXPECT().
+		GetExpiryWatermark(s.ctx).
+		Return(time.Time{}, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		ListExpiredBetween(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	s.webhookRepo.EXPECT().
+		UpdateExpiryWatermark(s.ctx, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	created, err := s.useCase.Execute(s.ctx)
+
+	s.Require().NoError(err)
+	s.Zero(created)
+}
+
+func (s *Suite) TestCreateDeliveriesErrorKeepsWatermark() {
+	watermark := time.Now().Add(-time.Minute)
+
+	s.webhookRepo.EXPECT().
+		GetExpiryWatermark(s.ctx).
+		Return(watermark, nil).
+		Times(1)
+
+	s.apiKeyRepo.EXPECT().
+		ListExpiredBetween(s.ctx, watermark, gomock.Any()).
+		Return([]*entities.APIKey{{ID: 10, EnvironmentID: 100}}, nil).
+		Times(1)
+
+	repositoryErr := errors.NewInternal("Repository Error", nil)
+	s.webhookRepo.EXPECT().
+		CreateDeliveries(s.ctx, gomock.Any()).
+		Return(0, repositoryErr).
+		Times(1)
+
+	// The keys are notified again by the next run.
+	s.webhookRepo.EXPECT().
+		UpdateExpiryWatermark(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	created, err := s.useCase.Execute(s.ctx)
+
+	s.Require().Error(err)
+	s.Equal(repositoryErr, err)
+	s.Zero(created)
+}
+
+func TestWebhookNotifyExpiredKeysSuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"github.com/MAD-py/pandora-core/internal/app/webhook/create"
+	"github.com/MAD-py/pandora-core/internal/app/webhook/delete"
+	"github.com/MAD-py/pandora-core/internal/app/webhook/dispatch"
+	"github.com/MAD-py/pandora-core/internal/app/webhook/get"
+	"github.com/MAD-py/pandora-core/internal/app/webhook/list"
+	listdeliveries "github.com/MAD-py/pandora-core/internal/app/webhook/list_deliveries"
+	notifyexpiredkeys "github.com/MAD-py/pandora-core/internal/app/webhook/notify_expired_keys"
+	"github.com/MAD-py/pandora-core/internal/app/webhook/update"
+)
+
+// ... Create Use Case ...
+
+type WebhookCreateRepository = create.WebhookRepository
+type AuditCreateRepository = create.AuditRepository
+
+// ... Delete Use Case ...
+
+type WebhookDeleteRepository = delete.WebhookRepository
+type AuditDeleteRepository = delete.AuditRepository
+
+// ... Dispatch Use Case ...
+
+type WebhookDispatchRepository = dispatch.WebhookRepository
+type WebhookSender = dispatch.WebhookSender
+
+// ... Get Use Case ...
+
+type WebhookGetRepository = get.WebhookRepository
+
+// ... List Use Case ...
+
+type WebhookListRepository = list.WebhookRepository
+
+// ... List Deliveries Use Case ...
+
+type WebhookListDeliveriesRepository = listdeliveries.WebhookRepository
+
+// ... Notify Expired Keys Use Case ...
+
+type APIKeyExpiredRepository = notifyexpiredkeys.APIKeyRepository
+type WebhookExpiryRepository = notifyexpiredkeys.WebhookRepository
+
+// ... Update Use Case ...
+
+type WebhookUpdateRepository = update.WebhookRepository
+type AuditUpdateRepository = update.AuditRepository
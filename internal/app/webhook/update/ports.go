@@ -0,0 +1,19 @@
+package update
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type WebhookRepository interface {
+	GetByID(ctx context.Context, id int) (*entities.Webhook, errors.Error)
+	Update(ctx context.Context, id int, update *dto.WebhookUpdate) (*entities.Webhook, errors.Error)
+}
+
+type AuditRepository interface {
+	auditshared.RecordAuditRepository
+}
@@ -0,0 +1,88 @@
+package update
+
+import (
+	"context"
+
+	auditshared "github.com/MAD-py/pandora-core/internal/app/audit/shared"
+	"github.com/MAD-py/pandora-core/internal/app/tracing"
+	"github.com/MAD-py/pandora-core/internal/domain/dto"
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+type UseCase interface {
+	Execute(ctx context.Context, id int, req *dto.WebhookUpdate) (*dto.WebhookResponse, errors.Error)
+}
+
+type useCase struct {
+	validator validator.Validator
+
+	webhookRepo WebhookRepository
+	auditRepo   AuditRepository
+}
+
+func (uc *useCase) Execute(
+	ctx context.Context, id int, req *dto.WebhookUpdate,
+) (*dto.WebhookResponse, errors.Error) {
+	ctx, span := tracing.StartUseCase(ctx, "webhook.update")
+	defer span.End()
+
+	if err := uc.validateReq(req); err != nil {
+		return nil, err
+	}
+
+	before, err := uc.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := uc.webhookRepo.Update(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	auditshared.Record(
+		ctx,
+		uc.auditRepo,
+		enums.AuditActionUpdate,
+		enums.AuditEntityWebhook,
+		webhook.ID,
+		before,
+		webhook,
+	)
+
+	return &dto.WebhookResponse{
+		ID:        webhook.ID,
+		ClientID:  webhook.ClientID,
+		ProjectID: webhook.ProjectID,
+		URL:       webhook.URL,
+		Events:    webhook.Events,
+		Status:    webhook.Status,
+		CreatedAt: webhook.CreatedAt,
+	}, nil
+}
+
+func (uc *useCase) validateReq(req *dto.WebhookUpdate) errors.Error {
+	return uc.validator.ValidateStruct(
+		req,
+		map[string]string{
+			"url.http_url":   "url must be a valid HTTP or HTTPS URL",
+			"events.unique":  "events must not repeat an event",
+			"events[].enums": "events must be one of the following: quota.threshold_reached, quota.exhausted, quota.reset, api_key.expired, api_key.disabled, quota.alert_triggered",
+			"status.enums":   "status must be one of the following: enabled, disabled",
+		},
+	)
+}
+
+func NewUseCase(
+	validator validator.Validator,
+	webhookRepo WebhookRepository,
+	auditRepo AuditRepository,
+) UseCase {
+	return &useCase{
+		validator:   validator,
+		webhookRepo: webhookRepo,
+		auditRepo:   auditRepo,
+	}
+}
@@ -0,0 +1,100 @@
+package webhook
+
+import (
+	"github.com/MAD-py/pandora-core/internal/app/webhook/create"
+	"github.com/MAD-py/pandora-core/internal/app/webhook/delete"
+	"github.com/MAD-py/pandora-core/internal/app/webhook/dispatch"
+	"github.com/MAD-py/pandora-core/internal/app/webhook/get"
+	"github.com/MAD-py/pandora-core/internal/app/webhook/list"
+	listdeliveries "github.com/MAD-py/pandora-core/internal/app/webhook/list_deliveries"
+	notifyexpiredkeys "github.com/MAD-py/pandora-core/internal/app/webhook/notify_expired_keys"
+	"github.com/MAD-py/pandora-core/internal/app/webhook/update"
+	"github.com/MAD-py/pandora-core/internal/validator"
+)
+
+// ... Create Use Case ...
+
+type CreateUseCase = create.UseCase
+
+func NewCreateUseCase(
+	validator validator.Validator,
+	webhookRepo WebhookCreateRepository,
+	auditRepo AuditCreateRepository,
+) CreateUseCase {
+	return create.NewUseCase(validator, webhookRepo, auditRepo)
+}
+
+// ... Delete Use Case ...
+
+type DeleteUseCase = delete.UseCase
+
+func NewDeleteUseCase(
+	validator validator.Validator,
+	webhookRepo WebhookDeleteRepository,
+	auditRepo AuditDeleteRepository,
+) DeleteUseCase {
+	return delete.NewUseCase(validator, webhookRepo, auditRepo)
+}
+
+// ... Dispatch Use Case ...
+
+type DispatchUseCase = dispatch.UseCase
+
+func NewDispatchUseCase(
+	webhookRepo WebhookDispatchRepository, sender WebhookSender,
+) DispatchUseCase {
+	return dispatch.NewUseCase(webhookRepo, sender)
+}
+
+// ... Get Use Case ...
+
+type GetUseCase = get.UseCase
+
+func NewGetUseCase(
+	validator validator.Validator, webhookRepo WebhookGetRepository,
+) GetUseCase {
+	return get.NewUseCase(validator, webhookRepo)
+}
+
+// ... List Use Case ...
+
+type ListUseCase = list.UseCase
+
+func NewListUseCase(
+	validator validator.Validator, webhookRepo WebhookListRepository,
+) ListUseCase {
+	return list.NewUseCase(validator, webhookRepo)
+}
+
+// ... List Deliveries Use Case ...
+
+type ListDeliveriesUseCase = listdeliveries.UseCase
+
+func NewListDeliveriesUseCase(
+	validator validator.Validator,
+	webhookRepo WebhookListDeliveriesRepository,
+) ListDeliveriesUseCase {
+	return listdeliveries.NewUseCase(validator, webhookRepo)
+}
+
+// ... Notify Expired Keys Use Case ...
+
+type NotifyExpiredKeysUseCase = notifyexpiredkeys.UseCase
+
+func NewNotifyExpiredKeysUseCase(
+	apiKeyRepo APIKeyExpiredRepository, webhookRepo WebhookExpiryRepository,
+) NotifyExpiredKeysUseCase {
+	return notifyexpiredkeys.NewUseCase(apiKeyRepo, webhookRepo)
+}
+
+// ... Update Use Case ...
+
+type UpdateUseCase = update.UseCase
+
+func NewUpdateUseCase(
+	validator validator.Validator,
+	webhookRepo WebhookUpdateRepository,
+	auditRepo AuditUpdateRepository,
+) UpdateUseCase {
+	return update.NewUseCase(validator, webhookRepo, auditRepo)
+}
@@ -1,11 +1,25 @@
 package config
 
+import "time"
+
 type Config struct {
 	http       *HTTPConfig
 	grpc       *GRPCConfig
 	taskEngine *TaskEngineConfig
 }
 
+func (c *Config) DBDriver() string {
+	if c.http != nil {
+		return c.http.dbDriver
+	}
+
+	if c.grpc != nil {
+		return c.grpc.dbDriver
+	}
+
+	return ""
+}
+
 func (c *Config) DBDNS() string {
 	if c.http != nil {
 		return c.http.dbDNS
@@ -18,6 +32,54 @@ func (c *Config) DBDNS() string {
 	return ""
 }
 
+func (c *Config) DBAutoMigrate() bool {
+	if c.http != nil {
+		return c.http.dbAutoMigrate
+	}
+
+	if c.grpc != nil {
+		return c.grpc.dbAutoMigrate
+	}
+
+	return false
+}
+
+func (c *Config) APIKeySecret() string {
+	if c.http != nil {
+		return c.http.apiKeySecret
+	}
+
+	if c.grpc != nil {
+		return c.grpc.apiKeySecret
+	}
+
+	return ""
+}
+
+func (c *Config) MetricsPort() string {
+	if c.http != nil {
+		return c.http.metricsPort
+	}
+
+	if c.grpc != nil {
+		return c.grpc.metricsPort
+	}
+
+	return ""
+}
+
+func (c *Config) TracingConfig() *TracingConfig {
+	if c.http != nil {
+		return c.http.tracing
+	}
+
+	if c.grpc != nil {
+		return c.grpc.tracing
+	}
+
+	return nil
+}
+
 func (c *Config) HTTPConfig() *HTTPConfig { return c.http }
 
 func (c *Config) GRPCConfig() *GRPCConfig { return c.grpc }
@@ -25,11 +87,41 @@ func (c *Config) GRPCConfig() *GRPCConfig { return c.grpc }
 func (c *Config) TaskEngineConfig() *TaskEngineConfig { return c.taskEngine }
 
 type baseConfig struct {
+	dbDriver string
+
 	dbDNS string
+
+	dbAutoMigrate bool
+
+	apiKeySecret string
+
+	metricsPort string
+
+	tracing *TracingConfig
 }
 
+func (c *baseConfig) DBDriver() string { return c.dbDriver }
+
 func (c *baseConfig) DBDNS() string { return c.dbDNS }
 
+func (c *baseConfig) DBAutoMigrate() bool { return c.dbAutoMigrate }
+
+func (c *baseConfig) APIKeySecret() string { return c.apiKeySecret }
+
+func (c *baseConfig) MetricsPort() string { return c.metricsPort }
+
+func (c *baseConfig) TracingConfig() *TracingConfig { return c.tracing }
+
+type TracingConfig struct {
+	exporter string
+
+	file string
+}
+
+func (c *TracingConfig) Exporter() string { return c.exporter }
+
+func (c *TracingConfig) File() string { return c.file }
+
 type HTTPConfig struct {
 	*baseConfig
 
@@ -41,6 +133,10 @@ type HTTPConfig struct {
 
 	jwtSecret string
 
+	apiKeyPrefix string
+
+	apiKeyMaxGracePeriod time.Duration
+
 	credentialsFile string
 }
 
@@ -52,20 +148,90 @@ func (c *HTTPConfig) ExposeVersion() bool { return c.exposeVersion }
 
 func (c *HTTPConfig) JWTSecret() string { return c.jwtSecret }
 
+func (c *HTTPConfig) APIKeyPrefix() string { return c.apiKeyPrefix }
+
+func (c *HTTPConfig) APIKeyMaxGracePeriod() time.Duration {
+	return c.apiKeyMaxGracePeriod
+}
+
 func (c *HTTPConfig) CredentialsFile() string { return c.credentialsFile }
 
 type GRPCConfig struct {
 	*baseConfig
 
 	port string
+
+	reservationTTL time.Duration
+
+	rateLimitBackend string
+
+	cacheTTL  time.Duration
+	cacheSize int
+
+	requestLogMode          string
+	requestLogQueueSize     int
+	requestLogFlushSize     int
+	requestLogFlushInterval time.Duration
+	requestLogMaxWait       time.Duration
 }
 
 func (c *GRPCConfig) Port() string { return c.port }
 
+func (c *GRPCConfig) ReservationTTL() time.Duration { return c.reservationTTL }
+
+func (c *GRPCConfig) RateLimitBackend() string { return c.rateLimitBackend }
+
+func (c *GRPCConfig) CacheTTL() time.Duration { return c.cacheTTL }
+
+func (c *GRPCConfig) CacheSize() int { return c.cacheSize }
+
+func (c *GRPCConfig) RequestLogMode() string { return c.requestLogMode }
+
+func (c *GRPCConfig) RequestLogQueueSize() int { return c.requestLogQueueSize }
+
+func (c *GRPCConfig) RequestLogFlushSize() int { return c.requestLogFlushSize }
+
+func (c *GRPCConfig) RequestLogFlushInterval() time.Duration {
+	return c.requestLogFlushInterval
+}
+
+func (c *GRPCConfig) RequestLogMaxWait() time.Duration { return c.requestLogMaxWait }
+
 type TaskEngineConfig struct {
 	*baseConfig
+
+	dir string
+
+	requestRetentionDays int
+
+	requestArchive string
+}
+
+func (c *TaskEngineConfig) Dir() string { return c.dir }
+
+func (c *TaskEngineConfig) RequestRetentionDays() int {
+	return c.requestRetentionDays
+}
+
+func (c *TaskEngineConfig) RequestArchive() string { return c.requestArchive }
+
+func (c *TaskEngineConfig) RequestArchiveDir() string {
+	return c.dir + "/archive/requests"
+}
+
+// MigrationConfig holds the connection strings of the databases the migrate
+// subcommand works on. Unlike the other configs, loading it does not
+// generate any secret.
+type MigrationConfig struct {
+	dbDNS string
+
+	taskEngineDBDNS string
 }
 
+func (c *MigrationConfig) DBDNS() string { return c.dbDNS }
+
+func (c *MigrationConfig) TaskEngineDBDNS() string { return c.taskEngineDBDNS }
+
 func LoadConfig() *Config {
 	return &Config{
 		http:       LoadHTTPConfig(),
@@ -80,10 +246,21 @@ func LoadHTTPConfig() *HTTPConfig {
 		port:      getHTTPPort(),
 		jwtSecret: getJWTSecrt(),
 		baseConfig: &baseConfig{
-			dbDNS: getDBDNS(),
+			dbDriver:      getDBDriver(),
+			dbDNS:         getDBDNS(),
+			dbAutoMigrate: getDBAutoMigrate(),
+			apiKeySecret:  getAPIKeySecret(),
+			metricsPort:   getMetricsPort(),
+			tracing: &TracingConfig{
+				exporter: getTracingExporter(),
+				file:     getTracingFile(getDir()),
+			},
 		},
 		exposeVersion:   getExposeVersion(),
+		apiKeyPrefix:    getAPIKeyPrefix(),
 		credentialsFile: getCredentialsFilePath(getDir()),
+
+		apiKeyMaxGracePeriod: getAPIKeyMaxGracePeriod(),
 	}
 }
 
@@ -91,15 +268,51 @@ func LoadGRPCConfig() *GRPCConfig {
 	return &GRPCConfig{
 		port: getGRPCPort(),
 		baseConfig: &baseConfig{
-			dbDNS: getDBDNS(),
+			dbDriver:      getDBDriver(),
+			dbDNS:         getDBDNS(),
+			dbAutoMigrate: getDBAutoMigrate(),
+			apiKeySecret:  getAPIKeySecret(),
+			metricsPort:   getMetricsPort(),
+			tracing: &TracingConfig{
+				exporter: getTracingExporter(),
+				file:     getTracingFile(getDir()),
+			},
 		},
+		reservationTTL:   getReservationTTL(),
+		rateLimitBackend: getRateLimitBackend(),
+		cacheTTL:         getCacheTTL(),
+		cacheSize:        getCacheSize(),
+
+		requestLogMode:          getRequestLogMode(),
+		requestLogQueueSize:     getRequestLogQueueSize(),
+		requestLogFlushSize:     getRequestLogFlushSize(),
+		requestLogFlushInterval: getRequestLogFlushInterval(),
+		requestLogMaxWait:       getRequestLogMaxWait(),
+	}
+}
+
+func LoadMigrationConfig() *MigrationConfig {
+	return &MigrationConfig{
+		dbDNS:           getDBDNS(),
+		taskEngineDBDNS: getTaskEngineDBDNS(),
 	}
 }
 
 func LoadTaskEngineConfig() *TaskEngineConfig {
 	return &TaskEngineConfig{
+		dir: getDir(),
 		baseConfig: &baseConfig{
-			dbDNS: getTaskEngineDBDNS(),
+			dbDriver:      getDBDriver(),
+			dbDNS:         getTaskEngineDBDNS(),
+			dbAutoMigrate: getDBAutoMigrate(),
+			apiKeySecret:  getAPIKeySecret(),
+			metricsPort:   getMetricsPort(),
+			tracing: &TracingConfig{
+				exporter: getTracingExporter(),
+				file:     getTracingFile(getDir()),
+			},
 		},
+		requestRetentionDays: getRequestRetentionDays(),
+		requestArchive:       getRequestArchive(),
 	}
 }
@@ -3,75 +3,48 @@ package config
 import (
 	"crypto/rand"
 	"encoding/base64"
-	"encoding/json"
 	"log"
 	"os"
-
-	"golang.org/x/crypto/bcrypt"
 )
 
+// getCredentialsFilePath returns the location of the legacy single-user
+// credentials file. It is only read once, to import the first owner.
 func getCredentialsFilePath(dir string) string {
-	dir = dir + "/adminPanel"
+	return dir + "/adminPanel/credentials.json"
+}
+
+func getAPIKeySecretFromFile(dir string) string {
+	dir = dir + "/apiKeys"
 	if !existPath(dir) {
-		if err := os.MkdirAll(dir, 0755); err != nil {
+		if err := os.MkdirAll(dir, 0700); err != nil {
 			panic(err)
 		}
 	}
 
-	credentialsFile := dir + "/credentials.json"
-	if !existPath(credentialsFile) {
-		createCredentials(credentialsFile)
-	}
-	return credentialsFile
-}
-
-func createCredentials(credentialsPath string) {
-	log.Println("[WARNING] No admin credentials were found, generating default credentials.")
+	secretFile := dir + "/secret"
+	if !existPath(secretFile) {
+		log.Println("[WARNING] No API key secret was provided. Generating a new one.")
 
-	key := make([]byte, 12)
-	if _, err := rand.Read(key); err != nil {
-		panic(err)
-	}
-
-	keyStr := base64.URLEncoding.EncodeToString(key)
-	password, err := calculateHash(keyStr)
-	if err != nil {
-		panic(err)
-	}
+		key := make([]byte, 64)
+		if _, err := rand.Read(key); err != nil {
+			panic(err)
+		}
 
-	credentials := struct {
-		Username           string `json:"username"`
-		Password           string `json:"password"`
-		ForcePasswordReset bool   `json:"force_password_reset"`
-	}{
-		Username:           "Admin",
-		Password:           password,
-		ForcePasswordReset: true,
-	}
+		secret := base64.URLEncoding.EncodeToString(key)
+		if err := os.WriteFile(secretFile, []byte(secret), 0600); err != nil {
+			panic(err)
+		}
 
-	data, err := json.Marshal(credentials)
-	if err != nil {
-		panic(err)
+		log.Printf("[SECURITY] API key secret stored at %s. Losing it invalidates every API key.\n", secretFile)
+		return secret
 	}
 
-	err = os.WriteFile(credentialsPath, data, 0644)
+	data, err := os.ReadFile(secretFile)
 	if err != nil {
 		panic(err)
 	}
 
-	log.Println("[INFO] Default admin credentials:")
-	log.Printf("        - Username: Admin\n")
-	log.Printf("        - Password: %s\n", keyStr)
-	log.Println("[SECURITY] It is highly recommended to change the default password immediately.")
-}
-
-func calculateHash(s string) (string, error) {
-	hashed, err := bcrypt.GenerateFromPassword([]byte(s), 12)
-	if err != nil {
-		return "", err
-	}
-
-	return string(hashed), nil
+	return string(data)
 }
 
 func existPath(path string) bool {
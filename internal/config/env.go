@@ -5,8 +5,13 @@ import (
 	"encoding/base64"
 	"log"
 	"os"
+	"regexp"
+	"strconv"
+	"time"
 )
 
+var apiKeyPrefixPattern = regexp.MustCompile(`^[a-z][a-z0-9]{1,9}$`)
+
 func getDir() string {
 	if value, exists := os.LookupEnv("PANDORA_DIR"); exists {
 		return value
@@ -15,6 +20,18 @@ func getDir() string {
 	return "/etc/pandora"
 }
 
+func getDBDriver() string {
+	if value, exists := os.LookupEnv("PANDORA_DB_DRIVER"); exists {
+		switch value {
+		case "postgres", "memory":
+			return value
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_DB_DRIVER %q. Using default of postgres.", value)
+	}
+	return "postgres"
+}
+
 func getDBDNS() string {
 	if value, exists := os.LookupEnv("PANDORA_DB_DNS"); exists {
 		return value
@@ -29,6 +46,13 @@ func getTaskEngineDBDNS() string {
 	return getDBDNS()
 }
 
+func getDBAutoMigrate() bool {
+	if value, exists := os.LookupEnv("PANDORA_DB_AUTO_MIGRATE"); exists {
+		return value == "true"
+	}
+	return true
+}
+
 func getJWTSecrt() string {
 	if value, exists := os.LookupEnv("PANDORA_JWT_SECRET"); exists {
 		return value
@@ -43,6 +67,39 @@ func getJWTSecrt() string {
 	return base64.URLEncoding.EncodeToString(key)
 }
 
+func getAPIKeySecret() string {
+	if value, exists := os.LookupEnv("PANDORA_API_KEY_SECRET"); exists {
+		return value
+	}
+
+	return getAPIKeySecretFromFile(getDir())
+}
+
+func getAPIKeyPrefix() string {
+	if value, exists := os.LookupEnv("PANDORA_API_KEY_PREFIX"); exists {
+		if apiKeyPrefixPattern.MatchString(value) {
+			return value
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_API_KEY_PREFIX %q. Using default of pdr.", value)
+	}
+	return "pdr"
+}
+
+// getAPIKeyMaxGracePeriod returns how long the replaced key of a rotation
+// can keep working.
+func getAPIKeyMaxGracePeriod() time.Duration {
+	if value, exists := os.LookupEnv("PANDORA_API_KEY_MAX_GRACE_PERIOD"); exists {
+		period, err := time.ParseDuration(value)
+		if err == nil && period > 0 {
+			return period
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_API_KEY_MAX_GRACE_PERIOD %q. Using default of 168h.", value)
+	}
+	return 7 * 24 * time.Hour
+}
+
 func getHTTPPort() string {
 	if value, exists := os.LookupEnv("PANDORA_HTTP_PORT"); exists {
 		return value
@@ -57,9 +114,173 @@ func getGRPCPort() string {
 	return "50051"
 }
 
+func getMetricsPort() string {
+	if value, exists := os.LookupEnv("PANDORA_METRICS_PORT"); exists {
+		return value
+	}
+	return "9464"
+}
+
+func getTracingExporter() string {
+	if value, exists := os.LookupEnv("PANDORA_TRACING_EXPORTER"); exists {
+		switch value {
+		case "none", "otlp", "stdout", "file":
+			return value
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_TRACING_EXPORTER %q. Using default of none.", value)
+	}
+	return "none"
+}
+
+func getTracingFile(dir string) string {
+	if value, exists := os.LookupEnv("PANDORA_TRACING_FILE"); exists {
+		return value
+	}
+	return dir + "/traces.jsonl"
+}
+
 func getExposeVersion() bool {
 	if value, exists := os.LookupEnv("PANDORA_EXPOSE_VERSION"); exists {
 		return value == "true"
 	}
 	return true
 }
+
+func getRateLimitBackend() string {
+	if value, exists := os.LookupEnv("PANDORA_RATE_LIMIT_BACKEND"); exists {
+		switch value {
+		case "memory", "postgres":
+			return value
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_RATE_LIMIT_BACKEND %q. Using default of memory.", value)
+	}
+	return "memory"
+}
+
+func getReservationTTL() time.Duration {
+	if value, exists := os.LookupEnv("PANDORA_RESERVATION_TTL"); exists {
+		ttl, err := time.ParseDuration(value)
+		if err == nil && ttl > 0 {
+			return ttl
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_RESERVATION_TTL %q. Using default of 5m.", value)
+	}
+	return 5 * time.Minute
+}
+
+// getCacheTTL returns how long validation lookups are cached, 0 disabling
+// the cache.
+func getCacheTTL() time.Duration {
+	if value, exists := os.LookupEnv("PANDORA_CACHE_TTL"); exists {
+		ttl, err := time.ParseDuration(value)
+		if err == nil && ttl >= 0 {
+			return ttl
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_CACHE_TTL %q. Using default of 30s.", value)
+	}
+	return 30 * time.Second
+}
+
+func getCacheSize() int {
+	if value, exists := os.LookupEnv("PANDORA_CACHE_SIZE"); exists {
+		size, err := strconv.Atoi(value)
+		if err == nil && size > 0 {
+			return size
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_CACHE_SIZE %q. Using default of 10000.", value)
+	}
+	return 10000
+}
+
+func getRequestLogMode() string {
+	if value, exists := os.LookupEnv("PANDORA_REQUEST_LOG_MODE"); exists {
+		switch value {
+		case "sync", "async":
+			return value
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_REQUEST_LOG_MODE %q. Using default of sync.", value)
+	}
+	return "sync"
+}
+
+func getRequestLogQueueSize() int {
+	if value, exists := os.LookupEnv("PANDORA_REQUEST_LOG_QUEUE_SIZE"); exists {
+		size, err := strconv.Atoi(value)
+		if err == nil && size > 0 {
+			return size
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_REQUEST_LOG_QUEUE_SIZE %q. Using default of 10000.", value)
+	}
+	return 10000
+}
+
+func getRequestLogFlushSize() int {
+	if value, exists := os.LookupEnv("PANDORA_REQUEST_LOG_FLUSH_SIZE"); exists {
+		size, err := strconv.Atoi(value)
+		if err == nil && size > 0 {
+			return size
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_REQUEST_LOG_FLUSH_SIZE %q. Using default of 500.", value)
+	}
+	return 500
+}
+
+func getRequestLogFlushInterval() time.Duration {
+	if value, exists := os.LookupEnv("PANDORA_REQUEST_LOG_FLUSH_INTERVAL"); exists {
+		interval, err := time.ParseDuration(value)
+		if err == nil && interval > 0 {
+			return interval
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_REQUEST_LOG_FLUSH_INTERVAL %q. Using default of 1s.", value)
+	}
+	return time.Second
+}
+
+// getRequestLogMaxWait reads how long a validation waits for room in the full
+// asynchronous request log queue. Past it the request is dropped, although
+// its quota was already consumed, as answering late would hold up the
+// gateway; the validation reports it as request_dropped.
+func getRequestLogMaxWait() time.Duration {
+	if value, exists := os.LookupEnv("PANDORA_REQUEST_LOG_MAX_WAIT"); exists {
+		wait, err := time.ParseDuration(value)
+		if err == nil && wait >= 0 {
+			return wait
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_REQUEST_LOG_MAX_WAIT %q. Using default of 100ms.", value)
+	}
+	return 100 * time.Millisecond
+}
+
+func getRequestRetentionDays() int {
+	if value, exists := os.LookupEnv("PANDORA_REQUEST_RETENTION_DAYS"); exists {
+		days, err := strconv.Atoi(value)
+		if err == nil && days >= 0 {
+			return days
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_REQUEST_RETENTION_DAYS %q. Using default of 0.", value)
+	}
+	return 0
+}
+
+func getRequestArchive() string {
+	if value, exists := os.LookupEnv("PANDORA_REQUEST_ARCHIVE"); exists {
+		switch value {
+		case "none", "ndjson":
+			return value
+		}
+
+		log.Printf("[WARNING] Invalid PANDORA_REQUEST_ARCHIVE %q. Using default of none.", value)
+	}
+	return "none"
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// ... Requests ...
+
+type AdminUserFilter struct {
+	Role   enums.AdminRole         `name:"role" validate:"omitempty,enums=owner operator viewer billing"`
+	Status enums.CredentialsStatus `name:"status" validate:"omitempty,enums=enabled disabled"`
+}
+
+type AdminUserCreate struct {
+	Username string          `name:"username" validate:"required,max=255"`
+	Password string          `name:"password" validate:"required,min=12"`
+	Role     enums.AdminRole `name:"role" validate:"required,enums=owner operator viewer billing"`
+}
+
+// ... Responses ...
+
+type AdminUserResponse struct {
+	ID                 int                     `name:"id"`
+	Username           string                  `name:"username"`
+	Role               enums.AdminRole         `name:"role"`
+	Status             enums.CredentialsStatus `name:"status"`
+	ForcePasswordReset bool                    `name:"force_password_reset"`
+	CreatedAt          time.Time               `name:"created_at"`
+}
+
+type AdminUserSeeded struct {
+	Username string
+	Password string
+	Imported bool
+}
@@ -3,6 +3,7 @@ package dto
 import (
 	"time"
 
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
 )
 
@@ -13,15 +14,29 @@ type APIKeyValidate struct {
 	Request        *RequestIncoming `name:"request" validate:"required"`
 	ServiceName    string           `name:"service_name" validate:"required"`
 	ServiceVersion string           `name:"service_version" validate:"required"`
+	Units          int              `name:"units" validate:"omitempty,gt=0"`
+}
+
+type APIKeyRateLimit struct {
+	Requests int                         `name:"requests" validate:"gte=0"`
+	Period   enums.APIKeyRateLimitPeriod `name:"period" validate:"omitempty,enums=second minute"`
+	Burst    int                         `name:"burst" validate:"gte=0"`
 }
 
 type APIKeyCreate struct {
-	ExpiresAt     time.Time `name:"expires_at" validate:"omitempty,utc"`
-	EnvironmentID int       `name:"environment_id" validate:"required,gt=0"`
+	ExpiresAt     time.Time        `name:"expires_at" validate:"omitempty,utc"`
+	RateLimit     *APIKeyRateLimit `name:"rate_limit" validate:"omitempty"`
+	EnvironmentID int              `name:"environment_id" validate:"required,gt=0"`
 }
 
 type APIKeyUpdate struct {
-	ExpiresAt time.Time `name:"expires_at" validate:"omitempty,utc"`
+	ExpiresAt time.Time        `name:"expires_at" validate:"omitempty,utc"`
+	RateLimit *APIKeyRateLimit `name:"rate_limit" validate:"omitempty"`
+}
+
+type APIKeyRotate struct {
+	ExpiresAt   time.Time `name:"expires_at" validate:"omitempty,utc"`
+	GraceEndsAt time.Time `name:"grace_ends_at" validate:"required,utc"`
 }
 
 // ... Responses ...
@@ -47,11 +62,17 @@ type APIKeyValidateEnvironmentResponse struct {
 
 type APIKeyValidateResponse struct {
 	Valid       bool                               `name:"valid"`
+	RetryAfter  time.Duration                      `name:"retry_after"`
 	RequestID   string                             `name:"request_id"`
 	FailureCode enums.APIKeyValidationFailureCode  `name:"failure_code"`
+	QuotaLevel  enums.QuotaLevel                   `name:"quota_level"`
 	Client      *APIKeyValidateClientResponse      `name:"client"`
 	Project     *APIKeyValidateProjectResponse     `name:"project"`
 	Environment *APIKeyValidateEnvironmentResponse `name:"environment"`
+
+	// RequestDropped tells the request was not logged, so RequestID cannot
+	// be updated.
+	RequestDropped bool `name:"request_dropped"`
 }
 
 type APIKeyValidateConsumeResponse struct {
@@ -66,5 +87,28 @@ type APIKeyResponse struct {
 	LastUsed      time.Time          `name:"last_used"`
 	ExpiresAt     time.Time          `name:"expires_at"`
 	EnvironmentID int                `name:"environment_id"`
+	RotatedFromID int                `name:"rotated_from_id"`
+	GraceEndsAt   time.Time          `name:"grace_ends_at"`
+	RateLimit     *APIKeyRateLimit   `name:"rate_limit"`
 	CreatedAt     time.Time          `name:"created_at"`
 }
+
+type APIKeyRotateResponse struct {
+	APIKey        *APIKeyResponse `name:"api_key"`
+	RotatedAPIKey *APIKeyResponse `name:"rotated_api_key"`
+}
+
+// ... Internal ...
+
+// APIKeyValidationLookup holds every row validating an API key for a
+// service reads. Service is nil when no service has the name and version,
+// and APIKey when the key is unknown, in which case nothing else is set.
+// Environment comes without its services: ServiceAssigned tells whether
+// Service is one of them.
+type APIKeyValidationLookup struct {
+	Service         *entities.Service
+	APIKey          *entities.APIKey
+	Environment     *entities.Environment
+	ProjectClient   *ProjectClientInfoResponse
+	ServiceAssigned bool
+}
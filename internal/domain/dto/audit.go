@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// ... Requests ...
+
+type AuditFilter struct {
+	Actor       string            `name:"actor" validate:"omitempty,max=255"`
+	Action      enums.AuditAction `name:"action" validate:"omitempty,enums=create update delete assign_service update_service remove_service reset_requests update_status update_retention enable disable rotate reveal_key change_password"`
+	Entity      enums.AuditEntity `name:"entity" validate:"omitempty,enums=client project environment service api_key webhook quota_alert credentials client_token"`
+	EntityID    int               `name:"entity_id" validate:"omitempty,gt=0"`
+	CreatedFrom time.Time         `name:"created_from" validate:"omitempty"`
+	CreatedTo   time.Time         `name:"created_to" validate:"omitempty,gtefield=CreatedFrom"`
+}
+
+// ... Responses ...
+
+type AuditEntryResponse struct {
+	ID        int64             `name:"id"`
+	Actor     string            `name:"actor"`
+	Action    enums.AuditAction `name:"action"`
+	Entity    enums.AuditEntity `name:"entity"`
+	EntityID  int               `name:"entity_id"`
+	Before    map[string]any    `name:"before"`
+	After     map[string]any    `name:"after"`
+	IP        string            `name:"ip"`
+	CreatedAt time.Time         `name:"created_at"`
+}
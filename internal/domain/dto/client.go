@@ -13,23 +13,26 @@ type ClientFilter struct {
 }
 
 type ClientCreate struct {
-	Type  enums.ClientType `name:"type" validate:"required,enums=developer organization"`
-	Name  string           `name:"name" validate:"required"`
-	Email string           `name:"email" validate:"required,email"`
+	Type        enums.ClientType `name:"type" validate:"required,enums=developer organization"`
+	Name        string           `name:"name" validate:"required"`
+	Email       string           `name:"email" validate:"required,email"`
+	APIKeyLimit int              `name:"api_key_limit" validate:"gte=0"`
 }
 
 type ClientUpdate struct {
-	Type  enums.ClientType `name:"type" validate:"omitempty,enums=developer organization"`
-	Name  string           `name:"name" validate:"omitempty"`
-	Email string           `name:"email" validate:"omitempty,email"`
+	Type        enums.ClientType `name:"type" validate:"omitempty,enums=developer organization"`
+	Name        string           `name:"name" validate:"omitempty"`
+	Email       string           `name:"email" validate:"omitempty,email"`
+	APIKeyLimit *int             `name:"api_key_limit" validate:"omitempty,gte=0"`
 }
 
 // ... Responses ...
 
 type ClientResponse struct {
-	ID        int              `name:"id"`
-	Type      enums.ClientType `name:"type"`
-	Name      string           `name:"name"`
-	Email     string           `name:"email"`
-	CreatedAt time.Time        `name:"created_at"`
+	ID          int              `name:"id"`
+	Type        enums.ClientType `name:"type"`
+	Name        string           `name:"name"`
+	Email       string           `name:"email"`
+	APIKeyLimit int              `name:"api_key_limit"`
+	CreatedAt   time.Time        `name:"created_at"`
 }
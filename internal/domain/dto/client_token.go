@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"time"
+)
+
+// ... Requests ...
+
+type ClientTokenCreate struct {
+	Name      string    `name:"name" validate:"required,max=255"`
+	ExpiresAt time.Time `name:"expires_at" validate:"omitempty,utc"`
+}
+
+// ... Responses ...
+
+type ClientTokenResponse struct {
+	ID        int       `name:"id"`
+	ClientID  int       `name:"client_id"`
+	Name      string    `name:"name"`
+	Token     string    `name:"token"`
+	ExpiresAt time.Time `name:"expires_at"`
+	LastUsed  time.Time `name:"last_used"`
+	CreatedAt time.Time `name:"created_at"`
+}
@@ -14,8 +14,9 @@ type EnvironmentService struct {
 }
 
 type EnvironmentCreate struct {
-	Name      string `name:"name" validate:"required"`
-	ProjectID int    `name:"project_id" validate:"required,gt=0"`
+	Name      string                `name:"name" validate:"required"`
+	Type      enums.EnvironmentType `name:"type" validate:"omitempty,enums=live test"`
+	ProjectID int                   `name:"project_id" validate:"required,gt=0"`
 
 	Services []*EnvironmentService `name:"services" validate:"required,dive"`
 }
@@ -42,6 +43,7 @@ type EnvironmentServiceResponse struct {
 type EnvironmentResponse struct {
 	ID        int                     `name:"id"`
 	Name      string                  `name:"name"`
+	Type      enums.EnvironmentType   `name:"type"`
 	Status    enums.EnvironmentStatus `name:"status"`
 	ProjectID int                     `name:"project_id"`
 	CreatedAt time.Time               `name:"created_at"`
@@ -59,11 +61,63 @@ type EnvironmentServiceReset struct {
 
 // ... Internal ...
 
+// DecrementAvailableRequest is the outcome of consuming units from an
+// environment service and its project pool. Nothing is consumed when
+// ExceededLevel is set. AvailableRequest is what the environment can still
+// consume, bounded by the project pool, or -1 when neither is limited.
 type DecrementAvailableRequest struct {
+	MaxRequests      int              `name:"max_requests"`
+	ExceededLevel    enums.QuotaLevel `name:"exceeded_level"`
+	AvailableRequest int              `name:"available_request"`
+
+	// Environment and Project are what is left in each pool once the units
+	// are consumed.
+	Environment QuotaBalance `name:"environment"`
+	Project     QuotaBalance `name:"project"`
+}
+
+// QuotaBalance is the requests left in a quota pool. A MaxRequests of -1
+// means the pool is unlimited.
+type QuotaBalance struct {
 	MaxRequests      int `name:"max_requests"`
 	AvailableRequest int `name:"available_request"`
 }
 
+// UsedPercent returns the percentage of MaxRequests consumed, rounded
+// down. Unlimited pools are never consumed.
+func (b *QuotaBalance) UsedPercent() int {
+	if b.MaxRequests <= 0 {
+		return 0
+	}
+
+	return (b.MaxRequests - b.AvailableRequest) * 100 / b.MaxRequests
+}
+
+// Crossed reports whether consuming units, which left the pool at its
+// current balance, took its consumption from below percent of MaxRequests
+// to at least percent of it.
+func (b *QuotaBalance) Crossed(units, percent int) bool {
+	before := QuotaBalance{
+		MaxRequests:      b.MaxRequests,
+		AvailableRequest: b.AvailableRequest + units,
+	}
+	return before.UsedPercent() < percent && b.UsedPercent() >= percent
+}
+
+// CrossedPercent returns the percentage of MaxRequests consumed when
+// consuming units moved it to a higher whole percentage, or 0 otherwise.
+func (b *QuotaBalance) CrossedPercent(units int) int {
+	before := QuotaBalance{
+		MaxRequests:      b.MaxRequests,
+		AvailableRequest: b.AvailableRequest + units,
+	}
+
+	if used := b.UsedPercent(); used > before.UsedPercent() {
+		return used
+	}
+	return 0
+}
+
 type QuotaUsage struct {
 	MaxAllowed       int `name:"max_allowed"`
 	CurrentAllocated int `name:"current_allocated"`
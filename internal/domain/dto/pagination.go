@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 500
+)
+
+// ... Requests ...
+
+type Pagination struct {
+	Cursor       string `name:"cursor" validate:"omitempty,base64rawurl"`
+	Limit        int    `name:"limit" validate:"omitempty,gt=0,lte=500"`
+	IncludeTotal bool   `name:"include_total"`
+}
+
+// PageLimit returns the number of items a page holds, DefaultPageLimit when
+// no limit was requested.
+func (p *Pagination) PageLimit() int {
+	if p == nil || p.Limit == 0 {
+		return DefaultPageLimit
+	}
+	return p.Limit
+}
+
+// DecodeCursor returns the position a page starts after, nil for the first
+// page.
+func (p *Pagination) DecodeCursor() (*Cursor, error) {
+	if p == nil || p.Cursor == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(p.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := new(Cursor)
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// Cursor is the keyset position of the last item of a page. Lists are
+// ordered by a time, the creation time unless they can be sorted otherwise,
+// and then by ID.
+type Cursor struct {
+	Time time.Time `json:"time"`
+	ID   string    `json:"id"`
+}
+
+// Encode returns the cursor as the opaque string handed to clients.
+func (c *Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ... Responses ...
+
+type Page[T any] struct {
+	Items      []T    `name:"items"`
+	NextCursor string `name:"next_cursor"`
+	Total      *int   `name:"total"`
+}
+
+// TrimPage drops the item fetched past the page limit and returns the cursor
+// of the next page, empty when items is the last page. Repositories fetch
+// one item more than the page holds so a next page can be told apart from a
+// page that is exactly full.
+func TrimPage[T any](
+	items []T, page *Pagination, cursor func(T) *Cursor,
+) ([]T, string) {
+	limit := page.PageLimit()
+	if len(items) <= limit {
+		return items, ""
+	}
+
+	items = items[:limit]
+	return items, cursor(items[limit-1]).Encode()
+}
@@ -0,0 +1,157 @@
+package dto
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+func TestPaginationValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		dto        Pagination
+		wantErr    bool
+		wantLocErr string
+	}{
+		{
+			name:    "Empty",
+			dto:     Pagination{},
+			wantErr: false,
+		},
+		{
+			name: "ValidCursorAndLimit",
+			dto: Pagination{
+				Cursor: (&Cursor{Time: time.Now(), ID: "1"}).Encode(),
+				Limit:  MaxPageLimit,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "InvalidCursor",
+			dto:        Pagination{Cursor: "not a cursor"},
+			wantErr:    true,
+			wantLocErr: "cursor",
+		},
+		{
+			name:       "NegativeLimit",
+			dto:        Pagination{Limit: -1},
+			wantErr:    true,
+			wantLocErr: "limit",
+		},
+		{
+			name:       "LimitTooHigh",
+			dto:        Pagination{Limit: MaxPageLimit + 1},
+			wantErr:    true,
+			wantLocErr: "limit",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := v.ValidateStruct(test.dto, map[string]string{})
+
+			if !test.wantErr {
+				if err != nil {
+					t.Errorf("got %v, want nil", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Error("got nil, want error")
+				return
+			}
+
+			vErr, ok := err.(*errors.AttributeError)
+			if !ok {
+				t.Errorf("got %T error, want AttributeError", err)
+				return
+			}
+
+			if vErr.Loc() != test.wantLocErr {
+				t.Errorf("got %s loc, want %s", vErr.Loc(), test.wantLocErr)
+			}
+		})
+	}
+}
+
+func TestPaginationDecodeCursor(t *testing.T) {
+	createdAt := time.Date(2025, 6, 1, 12, 30, 0, 123456000, time.UTC)
+	want := &Cursor{Time: createdAt, ID: "42"}
+
+	page := &Pagination{Cursor: want.Encode()}
+	got, err := page.DecodeCursor()
+	if err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+
+	if !got.Time.Equal(want.Time) || got.ID != want.ID {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if cursor, err := (&Pagination{}).DecodeCursor(); cursor != nil || err != nil {
+		t.Errorf("got %v, %v, want nil, nil", cursor, err)
+	}
+
+	if _, err := (&Pagination{Cursor: "bm90LWpzb24"}).DecodeCursor(); err == nil {
+		t.Error("got nil, want error")
+	}
+}
+
+func TestTrimPage(t *testing.T) {
+	cursor := func(id int) *Cursor {
+		return &Cursor{ID: strconv.Itoa(id)}
+	}
+
+	tests := []struct {
+		name           string
+		items          []int
+		page           *Pagination
+		wantItems      int
+		wantNextCursor bool
+	}{
+		{
+			name:           "LastPage",
+			items:          []int{1, 2},
+			page:           &Pagination{Limit: 2},
+			wantItems:      2,
+			wantNextCursor: false,
+		},
+		{
+			name:           "NextPage",
+			items:          []int{1, 2, 3},
+			page:           &Pagination{Limit: 2},
+			wantItems:      2,
+			wantNextCursor: true,
+		},
+		{
+			name:           "DefaultLimit",
+			items:          make([]int, DefaultPageLimit+1),
+			page:           nil,
+			wantItems:      DefaultPageLimit,
+			wantNextCursor: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			items, nextCursor := TrimPage(test.items, test.page, cursor)
+
+			if len(items) != test.wantItems {
+				t.Errorf("got %d items, want %d", len(items), test.wantItems)
+			}
+
+			if (nextCursor != "") != test.wantNextCursor {
+				t.Errorf("got next cursor %q, want one: %t", nextCursor, test.wantNextCursor)
+			}
+		})
+	}
+
+	items, nextCursor := TrimPage([]int{1, 2, 3}, &Pagination{Limit: 2}, cursor)
+	decoded, err := (&Pagination{Cursor: nextCursor}).DecodeCursor()
+	if err != nil || decoded.ID != cursor(items[1]).ID {
+		t.Errorf("got %v, %v, want cursor of the last item", decoded, err)
+	}
+}
@@ -34,13 +34,14 @@ type ProjectServiceUpdate struct {
 // ... Responses ...
 
 type ProjectServiceResponse struct {
-	ID             int                                `name:"id"`
-	Name           string                             `name:"name"`
-	Version        string                             `name:"version"`
-	NextReset      time.Time                          `name:"next_reset"`
-	MaxRequests    int                                `name:"max_requests"`
-	ResetFrequency enums.ProjectServiceResetFrequency `name:"reset_frequency"`
-	AssignedAt     time.Time                          `name:"assigned_at"`
+	ID               int                                `name:"id"`
+	Name             string                             `name:"name"`
+	Version          string                             `name:"version"`
+	NextReset        time.Time                          `name:"next_reset"`
+	MaxRequests      int                                `name:"max_requests"`
+	ResetFrequency   enums.ProjectServiceResetFrequency `name:"reset_frequency"`
+	AvailableRequest int                                `name:"available_request"`
+	AssignedAt       time.Time                          `name:"assigned_at"`
 }
 
 type ProjectResponse struct {
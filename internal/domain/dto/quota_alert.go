@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// ... Requests ...
+
+type QuotaAlertCreate struct {
+	Level     enums.QuotaLevel `name:"level" validate:"required,enums=environment project"`
+	Threshold int              `name:"threshold" validate:"required,min=1,max=100"`
+}
+
+// ... Responses ...
+
+type QuotaAlertResponse struct {
+	ID            int              `name:"id"`
+	EnvironmentID int              `name:"environment_id"`
+	ServiceID     int              `name:"service_id"`
+	Level         enums.QuotaLevel `name:"level"`
+	Threshold     int              `name:"threshold"`
+	TriggeredAt   time.Time        `name:"triggered_at"`
+	CreatedAt     time.Time        `name:"created_at"`
+}
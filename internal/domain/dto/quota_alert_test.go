@@ -0,0 +1,104 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+func TestQuotaAlertCreateValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		dto        QuotaAlertCreate
+		wantErr    bool
+		wantLocErr string
+	}{
+		{
+			name:    "ValidEnvironment",
+			dto:     QuotaAlertCreate{Level: enums.QuotaLevelEnvironment, Threshold: 75},
+			wantErr: false,
+		},
+		{
+			name:    "ValidProject",
+			dto:     QuotaAlertCreate{Level: enums.QuotaLevelProject, Threshold: 100},
+			wantErr: false,
+		},
+		{
+			name:       "MissingLevel",
+			dto:        QuotaAlertCreate{Threshold: 90},
+			wantErr:    true,
+			wantLocErr: "level",
+		},
+		{
+			name:       "InvalidLevel",
+			dto:        QuotaAlertCreate{Level: "client", Threshold: 90},
+			wantErr:    true,
+			wantLocErr: "level",
+		},
+		{
+			name:       "MissingThreshold",
+			dto:        QuotaAlertCreate{Level: enums.QuotaLevelEnvironment},
+			wantErr:    true,
+			wantLocErr: "threshold",
+		},
+		{
+			name:       "ThresholdAboveMax",
+			dto:        QuotaAlertCreate{Level: enums.QuotaLevelEnvironment, Threshold: 101},
+			wantErr:    true,
+			wantLocErr: "threshold",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := v.ValidateStruct(test.dto, map[string]string{})
+
+			if !test.wantErr {
+				if err != nil {
+					t.Errorf("got %v, want nil", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Error("got nil, want error")
+				return
+			}
+
+			vErr, ok := err.(*errors.AttributeError)
+			if !ok {
+				t.Errorf("got %T error, want AttributeError", err)
+				return
+			}
+
+			if vErr.Loc() != test.wantLocErr {
+				t.Errorf("got %s loc, want %s", vErr.Loc(), test.wantLocErr)
+			}
+		})
+	}
+}
+
+func TestQuotaBalanceCrossedPercent(t *testing.T) {
+	tests := []struct {
+		name    string
+		balance QuotaBalance
+		units   int
+		want    int
+	}{
+		{"RisesOnePercent", QuotaBalance{MaxRequests: 100, AvailableRequest: 25}, 1, 75},
+		{"RisesSeveralPercent", QuotaBalance{MaxRequests: 10, AvailableRequest: 1}, 2, 90},
+		{"SamePercent", QuotaBalance{MaxRequests: 1000, AvailableRequest: 249}, 1, 0},
+		{"Exhausted", QuotaBalance{MaxRequests: 10, AvailableRequest: 0}, 1, 100},
+		{"Unlimited", QuotaBalance{MaxRequests: -1, AvailableRequest: -1}, 1, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.balance.CrossedPercent(test.units)
+			if got != test.want {
+				t.Errorf("got %d, want %d", got, test.want)
+			}
+		})
+	}
+}
@@ -14,6 +14,28 @@ type RequestFilter struct {
 	ExecutionStatus enums.RequestExecutionStatus `name:"execution_status" validate:"omitempty,enums=success forwarded client_error server_error unauthorized quota_exceeded"`
 }
 
+// RequestSearch filters requests. With IncludeRotated, the APIKeyID filter
+// also matches the keys the API key replaced by rotation or was replaced by.
+type RequestSearch struct {
+	ClientID           int                               `name:"client_id" validate:"omitempty,gt=0"`
+	ProjectID          int                               `name:"project_id" validate:"omitempty,gt=0"`
+	EnvironmentID      int                               `name:"environment_id" validate:"omitempty,gt=0"`
+	APIKeyID           int                               `name:"api_key_id" validate:"omitempty,gt=0"`
+	IncludeRotated     bool                              `name:"include_rotated"`
+	ServiceID          int                               `name:"service_id" validate:"omitempty,gt=0"`
+	PathPrefix         string                            `name:"path_prefix" validate:"omitempty"`
+	Method             string                            `name:"method" validate:"omitempty,enums=GET HEAD POST PUT PATCH DELETE CONNECT OPTIONS TRACE"`
+	IPAddress          string                            `name:"ip_address" validate:"omitempty,ip|cidr"`
+	StatusCodeFrom     int                               `name:"status_code_from" validate:"omitempty,gte=100,lte=599"`
+	StatusCodeTo       int                               `name:"status_code_to" validate:"omitempty,gte=100,lte=599,gtefield=StatusCodeFrom"`
+	UnauthorizedReason enums.APIKeyValidationFailureCode `name:"unauthorized_reason" validate:"omitempty,enums=API_KEY_INVALID QUOTA_EXCEEDED API_KEY_EXPIRED API_KEY_DISABLED SERVICE_MISMATCH SERVICE_DISABLED SERVICE_DEPRECATED SERVICE_NOT_ASSIGNED ENVIRONMENT_DISABLED RATE_LIMITED"`
+	ExecutionStatus    enums.RequestExecutionStatus      `name:"execution_status" validate:"omitempty,enums=success forwarded client_error server_error unauthorized abandoned"`
+	RequestTimeFrom    time.Time                         `name:"request_time_from" validate:"omitempty"`
+	RequestTimeTo      time.Time                         `name:"request_time_to" validate:"omitempty,gtefield=RequestTimeFrom"`
+	SortBy             enums.RequestSortField            `name:"sort_by" validate:"omitempty,enums=created_at request_time"`
+	SortOrder          enums.SortOrder                   `name:"sort_order" validate:"omitempty,enums=asc desc"`
+}
+
 type RequestIncomingMetadata struct {
 	QueryParams     string                       `name:"query_params" validate:"omitempty"`
 	Cookies         string                       `name:"cookies" validate:"omitempty"`
@@ -36,6 +58,16 @@ type RequestExecutionStatusUpdate struct {
 	ExecutionStatus enums.RequestExecutionStatus `name:"execution_status" validate:"required,enums=success client_error server_error"`
 }
 
+// RequestRetention selects the requests created before Before. With a
+// ServiceID only the requests of that service are selected, otherwise those
+// of the services in ExcludedServiceIDs, which have a retention of their
+// own, are left out.
+type RequestRetention struct {
+	Before             time.Time
+	ServiceID          int
+	ExcludedServiceIDs []int
+}
+
 // ... Responses ...
 
 type RequestAPIKeyResponse struct {
@@ -70,6 +102,7 @@ type RequestResponse struct {
 	StatusCode         int                               `name:"status_code"`
 	ExecutionStatus    enums.RequestExecutionStatus      `name:"execution_status"`
 	UnauthorizedReason enums.APIKeyValidationFailureCode `name:"unauthorized_reason"`
+	Units              int                               `name:"units"`
 	RequestTime        time.Time                         `name:"request_time"`
 	Path               string                            `name:"path"`
 	Method             string                            `name:"method"`
@@ -87,5 +120,11 @@ type RequestDetailsReponse struct {
 
 type RequestCompleteResponse struct {
 	RequestResponse
-	RequestDetailsReponse
+	Metadata *RequestDetailsReponse `name:"metadata"`
+	Chain    []*RequestResponse     `name:"chain"`
+}
+
+type RequestRetentionResult struct {
+	DeletedRequests   int `name:"deleted_requests"`
+	DroppedPartitions int `name:"dropped_partitions"`
 }
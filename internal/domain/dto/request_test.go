@@ -0,0 +1,103 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+func TestRequestSearchValidation(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name       string
+		dto        RequestSearch
+		wantErr    bool
+		wantLocErr string
+	}{
+		{
+			name:    "Empty",
+			dto:     RequestSearch{},
+			wantErr: false,
+		},
+		{
+			name: "ValidFilters",
+			dto: RequestSearch{
+				ClientID:        1,
+				PathPrefix:      "/v1/",
+				Method:          "GET",
+				IPAddress:       "192.168.0.0/16",
+				StatusCodeFrom:  400,
+				StatusCodeTo:    499,
+				RequestTimeFrom: now.Add(-time.Hour),
+				RequestTimeTo:   now,
+				SortBy:          "request_time",
+				SortOrder:       "asc",
+			},
+			wantErr: false,
+		},
+		{
+			name:    "SingleIPAddress",
+			dto:     RequestSearch{IPAddress: "2001:db8::1"},
+			wantErr: false,
+		},
+		{
+			name:    "OnlyRequestTimeTo",
+			dto:     RequestSearch{RequestTimeTo: now},
+			wantErr: false,
+		},
+		{
+			name:       "InvalidIPAddress",
+			dto:        RequestSearch{IPAddress: "10.0.0"},
+			wantErr:    true,
+			wantLocErr: "ip_address",
+		},
+		{
+			name:       "StatusCodeRangeReversed",
+			dto:        RequestSearch{StatusCodeFrom: 500, StatusCodeTo: 400},
+			wantErr:    true,
+			wantLocErr: "status_code_to",
+		},
+		{
+			name:       "RequestTimeRangeReversed",
+			dto:        RequestSearch{RequestTimeFrom: now, RequestTimeTo: now.Add(-time.Hour)},
+			wantErr:    true,
+			wantLocErr: "request_time_to",
+		},
+		{
+			name:       "InvalidSortBy",
+			dto:        RequestSearch{SortBy: "path"},
+			wantErr:    true,
+			wantLocErr: "sort_by",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := v.ValidateStruct(test.dto, map[string]string{})
+
+			if !test.wantErr {
+				if err != nil {
+					t.Errorf("got %v, want nil", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Error("got nil, want error")
+				return
+			}
+
+			vErr, ok := err.(*errors.AttributeError)
+			if !ok {
+				t.Errorf("got %T error, want AttributeError", err)
+				return
+			}
+
+			if vErr.Loc() != test.wantLocErr {
+				t.Errorf("got %s loc, want %s", vErr.Loc(), test.wantLocErr)
+			}
+		})
+	}
+}
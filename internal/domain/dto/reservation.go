@@ -1,6 +1,29 @@
 package dto
 
-import "github.com/MAD-py/pandora-core/internal/domain/enums"
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// ... Requests ...
+
+// ReservationCommit settles a reservation for Units, or for everything it
+// holds when Units is nil. Zero units release the reservation entirely.
+type ReservationCommit struct {
+	Units *int `name:"units" validate:"omitempty,gte=0"`
+}
+
+// ... Responses ...
+
+type ReservationResponse struct {
+	APIKeyValidateResponse
+	ReservationID    string    `name:"reservation_id"`
+	AvailableRequest int       `name:"available_request"`
+	ExpiresAt        time.Time `name:"expires_at"`
+}
+
+// ... Internal ...
 
 type ReservationWithDetails struct {
 	ID                string                  `name:"id"`
@@ -14,3 +37,11 @@ type ReservationWithDetails struct {
 	EnvironmentName   string                  `name:"environment_name"`
 	EnvironmentStatus enums.EnvironmentStatus `name:"environment_status"`
 }
+
+type ReservationRelease struct {
+	ID             string    `name:"id"`
+	EnvironmentID  int       `name:"environment_id"`
+	ServiceID      int       `name:"service_id"`
+	StartRequestID string    `name:"start_request_id"`
+	ExpiresAt      time.Time `name:"expires_at"`
+}
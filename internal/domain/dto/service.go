@@ -17,6 +17,10 @@ type ServiceCreate struct {
 	Version string `name:"version" validate:"required,max=25"`
 }
 
+type ServiceRetentionUpdate struct {
+	RetentionDays *int `name:"retention_days" validate:"omitempty,gte=0,lte=36500"`
+}
+
 // ... Responses ...
 
 type ServiceResponse struct {
@@ -25,4 +29,6 @@ type ServiceResponse struct {
 	Status    enums.ServiceStatus `name:"status"`
 	Version   string              `name:"version"`
 	CreatedAt time.Time           `name:"created_at"`
+
+	RequestRetentionDays *int `name:"request_retention_days"`
 }
@@ -135,3 +135,80 @@ func TestServiceCreateValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceRetentionUpdateValidation(t *testing.T) {
+	zero, days, tooMany, negative := 0, 90, 36501, -1
+
+	tests := []struct {
+		name       string
+		dto        ServiceRetentionUpdate
+		wantErr    bool
+		wantLocErr string
+	}{
+		{
+			name:    "GlobalRetention",
+			dto:     ServiceRetentionUpdate{},
+			wantErr: false,
+		},
+		{
+			name:    "KeepForever",
+			dto:     ServiceRetentionUpdate{RetentionDays: &zero},
+			wantErr: false,
+		},
+		{
+			name:    "ValidDays",
+			dto:     ServiceRetentionUpdate{RetentionDays: &days},
+			wantErr: false,
+		},
+		{
+			name:       "NegativeDays",
+			dto:        ServiceRetentionUpdate{RetentionDays: &negative},
+			wantErr:    true,
+			wantLocErr: "retention_days",
+		},
+		{
+			name:       "TooManyDays",
+			dto:        ServiceRetentionUpdate{RetentionDays: &tooMany},
+			wantErr:    true,
+			wantLocErr: "retention_days",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := v.ValidateStruct(test.dto, map[string]string{})
+
+			if !test.wantErr {
+				if err != nil {
+					t.Errorf("got %v, want nil", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Error("got nil, want error")
+				return
+			}
+
+			if errors.CodeValidationFailed != err.Code() {
+				t.Errorf(
+					"got %s code, want %s",
+					err.Code(),
+					errors.CodeValidationFailed,
+				)
+				return
+			}
+
+			vErr, ok := err.(*errors.AttributeError)
+			if !ok {
+				t.Errorf("got %T error, want AttributeError", err)
+				return
+			}
+
+			if vErr.Loc() != test.wantLocErr {
+				t.Errorf("got %s loc, want %s", vErr.Loc(), test.wantLocErr)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// MaxUsageBuckets is the number of time buckets a usage query may span.
+const MaxUsageBuckets = 1000
+
+// ... Requests ...
+
+type UsageFilter struct {
+	Granularity   enums.UsageGranularity `name:"granularity" validate:"required,enums=hour day month"`
+	From          time.Time              `name:"from" validate:"required"`
+	To            time.Time              `name:"to" validate:"required,gtfield=From"`
+	GroupBy       []enums.UsageGroupBy   `name:"group_by" validate:"omitempty,unique,dive,enums=client project environment service api_key execution_status unauthorized_reason"`
+	ClientID      int                    `name:"client_id" validate:"omitempty,gt=0"`
+	ProjectID     int                    `name:"project_id" validate:"omitempty,gt=0"`
+	EnvironmentID int                    `name:"environment_id" validate:"omitempty,gt=0"`
+	ServiceID     int                    `name:"service_id" validate:"omitempty,gt=0"`
+	APIKeyID      int                    `name:"api_key_id" validate:"omitempty,gt=0"`
+}
+
+// ... Responses ...
+
+type UsageBucketResponse struct {
+	Bucket             time.Time                         `name:"bucket"`
+	ClientID           int                               `name:"client_id"`
+	ProjectID          int                               `name:"project_id"`
+	EnvironmentID      int                               `name:"environment_id"`
+	ServiceID          int                               `name:"service_id"`
+	APIKeyID           int                               `name:"api_key_id"`
+	ExecutionStatus    enums.RequestExecutionStatus      `name:"execution_status"`
+	UnauthorizedReason enums.APIKeyValidationFailureCode `name:"unauthorized_reason"`
+	Requests           int                               `name:"requests"`
+	Units              int                               `name:"units"`
+}
+
+type UsageResponse struct {
+	Granularity enums.UsageGranularity `name:"granularity"`
+	From        time.Time              `name:"from"`
+	To          time.Time              `name:"to"`
+	Buckets     []*UsageBucketResponse `name:"buckets"`
+}
@@ -0,0 +1,101 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+func TestUsageFilterValidation(t *testing.T) {
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	tests := []struct {
+		name       string
+		dto        UsageFilter
+		wantErr    bool
+		wantLocErr string
+	}{
+		{
+			name: "Valid",
+			dto: UsageFilter{
+				Granularity: enums.UsageGranularityDay,
+				From:        from,
+				To:          to,
+				GroupBy: []enums.UsageGroupBy{
+					enums.UsageGroupByClient, enums.UsageGroupByExecutionStatus,
+				},
+				ProjectID: 1,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "MissingGranularity",
+			dto:        UsageFilter{From: from, To: to},
+			wantErr:    true,
+			wantLocErr: "granularity",
+		},
+		{
+			name: "ToBeforeFrom",
+			dto: UsageFilter{
+				Granularity: enums.UsageGranularityHour, From: to, To: from,
+			},
+			wantErr:    true,
+			wantLocErr: "to",
+		},
+		{
+			name: "InvalidGroupBy",
+			dto: UsageFilter{
+				Granularity: enums.UsageGranularityHour,
+				From:        from,
+				To:          to,
+				GroupBy:     []enums.UsageGroupBy{"path"},
+			},
+			wantErr:    true,
+			wantLocErr: "group_by[0]",
+		},
+		{
+			name: "RepeatedGroupBy",
+			dto: UsageFilter{
+				Granularity: enums.UsageGranularityHour,
+				From:        from,
+				To:          to,
+				GroupBy: []enums.UsageGroupBy{
+					enums.UsageGroupByService, enums.UsageGroupByService,
+				},
+			},
+			wantErr:    true,
+			wantLocErr: "group_by",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := v.ValidateStruct(test.dto, map[string]string{})
+
+			if !test.wantErr {
+				if err != nil {
+					t.Errorf("got %v, want nil", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Error("got nil, want error")
+				return
+			}
+
+			vErr, ok := err.(*errors.AttributeError)
+			if !ok {
+				t.Errorf("got %T error, want AttributeError", err)
+				return
+			}
+
+			if vErr.Loc() != test.wantLocErr {
+				t.Errorf("got %s loc, want %s", vErr.Loc(), test.wantLocErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// ... Requests ...
+
+type WebhookFilter struct {
+	ClientID  int `name:"client_id" validate:"omitempty,gt=0"`
+	ProjectID int `name:"project_id" validate:"omitempty,gt=0"`
+}
+
+type WebhookCreate struct {
+	ClientID  int                      `name:"client_id" validate:"required_without=ProjectID,excluded_with=ProjectID,omitempty,gt=0"`
+	ProjectID int                      `name:"project_id" validate:"omitempty,gt=0"`
+	URL       string                   `name:"url" validate:"required,http_url"`
+	Events    []enums.WebhookEventType `name:"events" validate:"required,min=1,unique,dive,enums=quota.threshold_reached quota.exhausted quota.reset api_key.expired api_key.disabled quota.alert_triggered"`
+}
+
+type WebhookUpdate struct {
+	URL    string                   `name:"url" validate:"omitempty,http_url"`
+	Events []enums.WebhookEventType `name:"events" validate:"omitempty,unique,dive,enums=quota.threshold_reached quota.exhausted quota.reset api_key.expired api_key.disabled quota.alert_triggered"`
+	Status enums.WebhookStatus      `name:"status" validate:"omitempty,enums=enabled disabled"`
+}
+
+type WebhookDeliveryFilter struct {
+	Status enums.WebhookDeliveryStatus `name:"status" validate:"omitempty,enums=pending delivered failed"`
+}
+
+// ... Responses ...
+
+type WebhookResponse struct {
+	ID        int                      `name:"id"`
+	ClientID  int                      `name:"client_id"`
+	ProjectID int                      `name:"project_id"`
+	URL       string                   `name:"url"`
+	Events    []enums.WebhookEventType `name:"events"`
+	Status    enums.WebhookStatus      `name:"status"`
+	CreatedAt time.Time                `name:"created_at"`
+
+	// Secret is only returned when the webhook is created.
+	Secret string `name:"secret"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string                      `name:"id"`
+	WebhookID      int                         `name:"webhook_id"`
+	Event          enums.WebhookEventType      `name:"event"`
+	Payload        []byte                      `name:"payload"`
+	Status         enums.WebhookDeliveryStatus `name:"status"`
+	Attempts       int                         `name:"attempts"`
+	NextAttemptAt  time.Time                   `name:"next_attempt_at"`
+	LastStatusCode int                         `name:"last_status_code"`
+	LastError      string                      `name:"last_error"`
+	DeliveredAt    time.Time                   `name:"delivered_at"`
+	CreatedAt      time.Time                   `name:"created_at"`
+}
+
+// ... Internal ...
+
+// WebhookDispatch counts the deliveries sent by a dispatch run by the
+// status they were left in.
+type WebhookDispatch struct {
+	Delivered int `name:"delivered"`
+	Retrying  int `name:"retrying"`
+	Failed    int `name:"failed"`
+}
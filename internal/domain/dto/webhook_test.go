@@ -0,0 +1,132 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+func TestWebhookCreateValidation(t *testing.T) {
+	events := []enums.WebhookEventType{enums.WebhookEventTypeQuotaExhausted}
+
+	tests := []struct {
+		name       string
+		dto        WebhookCreate
+		wantErr    bool
+		wantLocErr string
+	}{
+		{
+			name: "ValidProject",
+			dto: WebhookCreate{
+				ProjectID: 1, URL: "https://example.com/hook", Events: events,
+			},
+			wantErr: false,
+		},
+		{
+			name: "ValidClient",
+			dto: WebhookCreate{
+				ClientID: 1, URL: "http://localhost:8080/hook", Events: events,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "MissingOwner",
+			dto:        WebhookCreate{URL: "https://example.com/hook", Events: events},
+			wantErr:    true,
+			wantLocErr: "client_id",
+		},
+		{
+			name: "ClientAndProject",
+			dto: WebhookCreate{
+				ClientID:  1,
+				ProjectID: 1,
+				URL:       "https://example.com/hook",
+				Events:    events,
+			},
+			wantErr:    true,
+			wantLocErr: "client_id",
+		},
+		{
+			name: "InvalidURL",
+			dto: WebhookCreate{
+				ProjectID: 1, URL: "ftp://example.com/hook", Events: events,
+			},
+			wantErr:    true,
+			wantLocErr: "url",
+		},
+		{
+			name: "NoEvents",
+			dto: WebhookCreate{
+				ProjectID: 1, URL: "https://example.com/hook",
+			},
+			wantErr:    true,
+			wantLocErr: "events",
+		},
+		{
+			name: "InvalidEvent",
+			dto: WebhookCreate{
+				ProjectID: 1,
+				URL:       "https://example.com/hook",
+				Events:    []enums.WebhookEventType{"quota.updated"},
+			},
+			wantErr:    true,
+			wantLocErr: "events[0]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := v.ValidateStruct(test.dto, map[string]string{})
+
+			if !test.wantErr {
+				if err != nil {
+					t.Errorf("got %v, want nil", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Error("got nil, want error")
+				return
+			}
+
+			vErr, ok := err.(*errors.AttributeError)
+			if !ok {
+				t.Errorf("got %T error, want AttributeError", err)
+				return
+			}
+
+			if vErr.Loc() != test.wantLocErr {
+				t.Errorf("got %s loc, want %s", vErr.Loc(), test.wantLocErr)
+			}
+		})
+	}
+}
+
+func TestQuotaBalanceCrossed(t *testing.T) {
+	tests := []struct {
+		name    string
+		balance QuotaBalance
+		units   int
+		percent int
+		want    bool
+	}{
+		{"BelowThreshold", QuotaBalance{MaxRequests: 10, AvailableRequest: 3}, 1, 80, false},
+		{"ReachesThreshold", QuotaBalance{MaxRequests: 10, AvailableRequest: 2}, 1, 80, true},
+		{"AlreadyPastThreshold", QuotaBalance{MaxRequests: 10, AvailableRequest: 1}, 1, 80, false},
+		{"JumpsPastThreshold", QuotaBalance{MaxRequests: 10, AvailableRequest: 0}, 5, 80, true},
+		{"Exhausted", QuotaBalance{MaxRequests: 10, AvailableRequest: 0}, 1, 100, true},
+		{"NotExhausted", QuotaBalance{MaxRequests: 10, AvailableRequest: 1}, 1, 100, false},
+		{"Unlimited", QuotaBalance{MaxRequests: -1, AvailableRequest: -1}, 1, 100, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.balance.Crossed(test.units, test.percent)
+			if got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
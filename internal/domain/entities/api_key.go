@@ -3,35 +3,161 @@ package entities
 import (
 	"crypto/rand"
 	"encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"math/big"
+	"strings"
 	"time"
 
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
+// APIKeyPrefixLength is the number of leading characters of a legacy key
+// kept in clear text to identify it once only its hash is stored.
+const APIKeyPrefixLength = 8
+
+const (
+	apiKeyRandomLength   = 43
+	apiKeyChecksumLength = 8
+	apiKeyLegacyLength   = 43
+	apiKeyPrefixRandom   = 4
+	apiKeySeparator      = "_"
+)
+
 type APIKey struct {
 	ID int
 
-	Key           string
+	Key           string `audit:"-"`
+	KeyPrefix     string
 	Status        enums.APIKeyStatus
 	LastUsed      time.Time
 	ExpiresAt     time.Time
 	EnvironmentID int
 
+	// RotatedFromID is the key this one replaced, if any. GraceEndsAt is
+	// set on a replaced key and marks when it stops being accepted.
+	RotatedFromID int
+	GraceEndsAt   time.Time
+
+	// RateLimit is nil when the key is only bound by its quota.
+	RateLimit *APIKeyRateLimit
+
 	CreatedAt time.Time
 }
 
-func (a *APIKey) GenerateKey() errors.Error {
+// APIKeyRateLimit allows Requests per Period on average, with up to Burst
+// requests back to back. A zero Burst allows a whole period at once.
+type APIKeyRateLimit struct {
+	Requests int
+	Period   enums.APIKeyRateLimitPeriod
+	Burst    int
+}
+
+// NewAPIKeyRateLimit returns nil when requests is not positive, meaning
+// no rate limit. The period defaults to one second.
+func NewAPIKeyRateLimit(
+	requests int, period enums.APIKeyRateLimitPeriod, burst int,
+) *APIKeyRateLimit {
+	if requests <= 0 {
+		return nil
+	}
+
+	if period == enums.APIKeyRateLimitPeriodNull {
+		period = enums.APIKeyRateLimitPeriodSecond
+	}
+
+	return &APIKeyRateLimit{Requests: requests, Period: period, Burst: burst}
+}
+
+// Interval is the time it takes to earn back a single request.
+func (r *APIKeyRateLimit) Interval() time.Duration {
+	return r.Period.Duration() / time.Duration(r.Requests)
+}
+
+func (r *APIKeyRateLimit) Capacity() int {
+	if r.Burst > 0 {
+		return r.Burst
+	}
+	return r.Requests
+}
+
+// Take applies the generic cell rate algorithm. tat is the theoretical
+// arrival time stored for the key (zero if none). It returns the tat to
+// store and, when the request is not allowed, how long to wait for it.
+func (r *APIKeyRateLimit) Take(
+	tat, now time.Time,
+) (time.Time, time.Duration) {
+	interval := r.Interval()
+
+	newTAT := tat
+	if newTAT.Before(now) {
+		newTAT = now
+	}
+	newTAT = newTAT.Add(interval)
+
+	allowAt := newTAT.Add(-interval * time.Duration(r.Capacity()))
+	if now.Before(allowAt) {
+		return tat, allowAt.Sub(now)
+	}
+
+	return newTAT, 0
+}
+
+// GenerateKey creates a key with the format
+// <prefix>_<environment type>_<random>_<checksum>, where the checksum is the
+// CRC32 of everything before it. The random part is base62 so the key
+// can be selected with a double click and matched by secret scanners.
+func (a *APIKey) GenerateKey(
+	prefix string, environmentType enums.EnvironmentType,
+) errors.Error {
 	bytes := make([]byte, 32)
 	_, err := rand.Read(bytes)
 	if err != nil {
 		return errors.NewInternal("api key generation failed", err)
 	}
 
-	a.Key = base64.RawURLEncoding.EncodeToString(bytes)
+	random := new(big.Int).SetBytes(bytes).Text(62)
+	random = strings.Repeat("0", apiKeyRandomLength-len(random)) + random
+
+	body := strings.Join(
+		[]string{prefix, string(environmentType), random}, apiKeySeparator,
+	)
+
+	a.Key = body + apiKeySeparator + apiKeyChecksum(body)
+	a.KeyPrefix = apiKeyPrefix(a.Key)
 	return nil
 }
 
+// HasValidFormat reports whether the key is either a legacy key or a
+// prefixed key with a matching checksum. It never needs the database, so
+// it can reject typos and random strings before any lookup.
+func (a *APIKey) HasValidFormat() bool {
+	if isLegacyAPIKey(a.Key) {
+		return true
+	}
+
+	body, checksum, ok := cutLast(a.Key, apiKeySeparator)
+	if !ok || len(checksum) != apiKeyChecksumLength {
+		return false
+	}
+
+	parts := strings.Split(body, apiKeySeparator)
+	if len(parts) != 3 || parts[0] == "" || !isAlphanumeric(parts[0]) {
+		return false
+	}
+
+	if t, ok := enums.ParseEnvironmentType(parts[1]); !ok || t == enums.EnvironmentTypeNull {
+		return false
+	}
+
+	if len(parts[2]) != apiKeyRandomLength || !isAlphanumeric(parts[2]) {
+		return false
+	}
+
+	return checksum == apiKeyChecksum(body)
+}
+
 func (a *APIKey) IsExpired() bool {
 	return !a.ExpiresAt.IsZero() && a.ExpiresAt.Before(time.Now())
 }
@@ -40,10 +166,64 @@ func (a *APIKey) IsEnabled() bool {
 	return a.Status == enums.APIKeyStatusEnabled
 }
 
+func (a *APIKey) IsRotated() bool {
+	return !a.GraceEndsAt.IsZero()
+}
+
+func (a *APIKey) IsGracePeriodOver() bool {
+	return a.IsRotated() && !a.GraceEndsAt.After(time.Now())
+}
+
 func (a *APIKey) KeySummary() string {
-	if len(a.Key) == 0 {
+	if len(a.KeyPrefix) == 0 {
 		return ""
 	}
 
-	return a.Key[:4] + "..." + a.Key[len(a.Key)-4:]
+	return a.KeyPrefix + "..."
+}
+
+func apiKeyChecksum(body string) string {
+	return fmt.Sprintf("%08x", crc32.ChecksumIEEE([]byte(body)))
+}
+
+// apiKeyPrefix returns the part of the key kept in clear text: the format
+// prefix, the environment type and the first random characters for
+// prefixed keys, or the first APIKeyPrefixLength characters otherwise.
+func apiKeyPrefix(key string) string {
+	if isLegacyAPIKey(key) {
+		return key[:APIKeyPrefixLength]
+	}
+
+	parts := strings.SplitN(key, apiKeySeparator, 3)
+	if len(parts) == 3 && len(parts[2]) > apiKeyPrefixRandom {
+		return parts[0] + apiKeySeparator + parts[1] + apiKeySeparator +
+			parts[2][:apiKeyPrefixRandom]
+	}
+
+	return key[:min(len(key), APIKeyPrefixLength)]
+}
+
+func isLegacyAPIKey(key string) bool {
+	if len(key) != apiKeyLegacyLength {
+		return false
+	}
+
+	_, err := base64.RawURLEncoding.DecodeString(key)
+	return err == nil
+}
+
+func isAlphanumeric(s string) bool {
+	for _, r := range s {
+		if !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
 }
@@ -0,0 +1,81 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+func TestAPIKeyRateLimitTake(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// 10 requests a second earn one back every 100ms.
+	burst := NewAPIKeyRateLimit(10, enums.APIKeyRateLimitPeriodSecond, 3)
+	steady := NewAPIKeyRateLimit(10, enums.APIKeyRateLimitPeriodSecond, 0)
+
+	tests := []struct {
+		name       string
+		limit      *APIKeyRateLimit
+		tat        time.Time
+		wantTAT    time.Time
+		retryAfter time.Duration
+	}{
+		{
+			name:    "unseen key",
+			limit:   burst,
+			wantTAT: now.Add(100 * time.Millisecond),
+		},
+		{
+			name:    "tat in the past starts from now",
+			limit:   burst,
+			tat:     now.Add(-time.Hour),
+			wantTAT: now.Add(100 * time.Millisecond),
+		},
+		{
+			name:    "last request of the burst",
+			limit:   burst,
+			tat:     now.Add(200 * time.Millisecond),
+			wantTAT: now.Add(300 * time.Millisecond),
+		},
+		{
+			name:       "burst exhausted",
+			limit:      burst,
+			tat:        now.Add(300 * time.Millisecond),
+			wantTAT:    now.Add(300 * time.Millisecond),
+			retryAfter: 100 * time.Millisecond,
+		},
+		{
+			name:       "partially refilled",
+			limit:      burst,
+			tat:        now.Add(250 * time.Millisecond),
+			wantTAT:    now.Add(250 * time.Millisecond),
+			retryAfter: 50 * time.Millisecond,
+		},
+		{
+			name:    "capacity defaults to the requests",
+			limit:   steady,
+			tat:     now.Add(900 * time.Millisecond),
+			wantTAT: now.Add(time.Second),
+		},
+		{
+			name:       "steady limit exhausted",
+			limit:      steady,
+			tat:        now.Add(time.Second),
+			wantTAT:    now.Add(time.Second),
+			retryAfter: 100 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tat, retryAfter := tt.limit.Take(tt.tat, now)
+			if !tat.Equal(tt.wantTAT) {
+				t.Errorf("tat = %s, want %s", tat, tt.wantTAT)
+			}
+			if retryAfter != tt.retryAfter {
+				t.Errorf("retryAfter = %s, want %s", retryAfter, tt.retryAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// AuditEntry records a change made through the admin API: who made it, from
+// where, and the fields of the entity it changed. Before and After only hold
+// the fields that differ; Before is empty for creations and After for
+// deletions.
+type AuditEntry struct {
+	ID int64
+
+	Actor  string
+	Action enums.AuditAction
+
+	Entity   enums.AuditEntity
+	EntityID int
+
+	Before map[string]any
+	After  map[string]any
+
+	IP string
+
+	CreatedAt time.Time
+}
@@ -13,5 +13,10 @@ type Client struct {
 	Name  string
 	Email string
 
+	// APIKeyLimit is the number of usable API keys each environment of the
+	// client may have for the client to create one through the portal.
+	// Zero keeps the client from creating keys.
+	APIKeyLimit int
+
 	CreatedAt time.Time
 }
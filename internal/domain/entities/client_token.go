@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+const (
+	clientTokenPrefix       = "pct"
+	clientTokenRandomLength = 43
+	clientTokenPrefixLength = 12
+)
+
+// ClientToken grants a client access to the portal API, scoped to its own
+// projects, environments, API keys and requests.
+type ClientToken struct {
+	ID int
+
+	ClientID    int
+	Name        string
+	Token       string `audit:"-"`
+	TokenPrefix string
+	ExpiresAt   time.Time
+	LastUsed    time.Time
+
+	CreatedAt time.Time
+}
+
+// GenerateToken creates a token with the format pct_<random>, where the
+// random part is base62 like the one of API keys.
+func (t *ClientToken) GenerateToken() errors.Error {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return errors.NewInternal("client token generation failed", err)
+	}
+
+	random := new(big.Int).SetBytes(bytes).Text(62)
+	random = strings.Repeat("0", clientTokenRandomLength-len(random)) + random
+
+	t.Token = clientTokenPrefix + apiKeySeparator + random
+	t.TokenPrefix = t.Token[:clientTokenPrefixLength]
+	return nil
+}
+
+// HasValidFormat reports whether the token looks like one generated by
+// GenerateToken, so random strings are rejected before any lookup.
+func (t *ClientToken) HasValidFormat() bool {
+	random, ok := strings.CutPrefix(t.Token, clientTokenPrefix+apiKeySeparator)
+	return ok && len(random) == clientTokenRandomLength && isAlphanumeric(random)
+}
+
+func (t *ClientToken) IsExpired() bool {
+	return !t.ExpiresAt.IsZero() && t.ExpiresAt.Before(time.Now())
+}
+
+func (t *ClientToken) TokenSummary() string {
+	if len(t.TokenPrefix) == 0 {
+		return ""
+	}
+
+	return t.TokenPrefix + "..."
+}
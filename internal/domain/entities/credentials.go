@@ -1,15 +1,32 @@
 package entities
 
 import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
 	"github.com/MAD-py/pandora-core/internal/domain/errors"
 )
 
 type Credentials struct {
+	ID                 int
 	Username           string
-	HashedPassword     string
+	HashedPassword     string `audit:"-"`
+	Role               enums.AdminRole
+	Status             enums.CredentialsStatus
 	ForcePasswordReset bool
+	CreatedAt          time.Time
+}
+
+func (c *Credentials) IsEnabled() bool {
+	return c.Status == enums.CredentialsStatusEnabled
+}
+
+func (c *Credentials) IsOwner() bool {
+	return c.Role == enums.AdminRoleOwner
 }
 
 func (c *Credentials) CalculatePasswordHash(password string) errors.Error {
@@ -22,6 +39,22 @@ func (c *Credentials) CalculatePasswordHash(password string) errors.Error {
 	return nil
 }
 
+// GeneratePassword sets a random password and returns it, so it can be
+// handed to the user once.
+func (c *Credentials) GeneratePassword() (string, errors.Error) {
+	bytes := make([]byte, 12)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", errors.NewInternal("password generation failed", err)
+	}
+
+	password := base64.URLEncoding.EncodeToString(bytes)
+	if err := c.CalculatePasswordHash(password); err != nil {
+		return "", err
+	}
+
+	return password, nil
+}
+
 func (c *Credentials) VerifyPassword(password string) errors.Error {
 	err := bcrypt.CompareHashAndPassword(
 		[]byte(c.HashedPassword), []byte(password),
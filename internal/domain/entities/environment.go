@@ -25,6 +25,7 @@ type Environment struct {
 	ID int
 
 	Name      string
+	Type      enums.EnvironmentType
 	Status    enums.EnvironmentStatus
 	ProjectID int
 
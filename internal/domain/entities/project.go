@@ -10,11 +10,12 @@ import (
 type ProjectService struct {
 	ID int
 
-	Name           string
-	Version        string
-	NextReset      time.Time
-	MaxRequests    int
-	ResetFrequency enums.ProjectServiceResetFrequency
+	Name             string
+	Version          string
+	NextReset        time.Time
+	MaxRequests      int
+	ResetFrequency   enums.ProjectServiceResetFrequency
+	AvailableRequest int
 
 	AssignedAt time.Time
 }
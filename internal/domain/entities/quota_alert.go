@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// QuotaAlert is a threshold, as a percentage of MaxRequests consumed, set on
+// the quota of a service in an environment. Level selects whether the
+// environment quota or the quota of the environment's project is watched.
+// An alert triggers once per reset period: TriggeredAt is cleared when the
+// quota it watches is reset.
+type QuotaAlert struct {
+	ID int
+
+	EnvironmentID int
+	ServiceID     int
+
+	Level     enums.QuotaLevel
+	Threshold int
+
+	TriggeredAt time.Time
+	CreatedAt   time.Time
+}
@@ -1,6 +1,8 @@
 package entities
 
 import (
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/MAD-py/pandora-core/internal/domain/enums"
@@ -19,12 +21,18 @@ type RequestAPIKey struct {
 	Key string
 }
 
+// KeySummary returns the identifying prefix of the key. Applying it to an
+// already summarized key returns the same value.
 func (r *RequestAPIKey) KeySummary() string {
 	if len(r.Key) == 0 {
 		return ""
 	}
 
-	return r.Key[:4] + "..." + r.Key[len(r.Key)-4:]
+	if strings.HasSuffix(r.Key, "...") {
+		return r.Key
+	}
+
+	return apiKeyPrefix(r.Key) + "..."
 }
 
 type RequestService struct {
@@ -55,6 +63,7 @@ type Request struct {
 	StatusCode         int
 	ExecutionStatus    enums.RequestExecutionStatus
 	UnauthorizedReason enums.APIKeyValidationFailureCode
+	Units              int
 	RequestTime        time.Time
 	Path               string
 	Method             string
@@ -62,4 +71,30 @@ type Request struct {
 	Metadata           *RequestMetadata
 
 	CreatedAt time.Time
+
+	// Dropped tells the request was not logged, as the asynchronous
+	// request log had no room for it.
+	Dropped bool
+}
+
+// Reject records that the request was not forwarded for the reason, with
+// the status code the gateway answers it with, so it consumes no units.
+func (r *Request) Reject(reason enums.APIKeyValidationFailureCode) {
+	r.ExecutionStatus = enums.RequestExecutionStatusUnauthorized
+	r.UnauthorizedReason = reason
+	r.Units = 0
+
+	r.StatusCode = http.StatusUnauthorized
+	if reason == enums.APIKeyValidationFailureCodeRateLimited {
+		r.StatusCode = http.StatusTooManyRequests
+	}
+}
+
+// RequestPartition is a monthly partition of the request log, holding the
+// requests created in [From, To). The partition holding the requests logged
+// before partitioning has no From, and the default partition neither.
+type RequestPartition struct {
+	Name string
+	From time.Time
+	To   time.Time
 }
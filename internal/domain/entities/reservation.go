@@ -13,4 +13,5 @@ type Reservation struct {
 	StartRequestID string
 	RequestTime    time.Time
 	ExpiresAt      time.Time
+	Units          int
 }
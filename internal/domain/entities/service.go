@@ -13,6 +13,11 @@ type Service struct {
 	Status  enums.ServiceStatus
 	Version string
 
+	// RequestRetentionDays overrides the global request log retention for
+	// the service. Nil means the global retention applies, and 0 that its
+	// requests are kept forever.
+	RequestRetentionDays *int
+
 	CreatedAt time.Time
 }
 
@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+)
+
+// UsageBucket is the number of requests, and the units they consumed, made
+// in the bucket starting at Bucket. Dimensions the usage was not grouped by
+// are left zero.
+type UsageBucket struct {
+	Bucket time.Time
+
+	ClientID           int
+	ProjectID          int
+	EnvironmentID      int
+	ServiceID          int
+	APIKeyID           int
+	ExecutionStatus    enums.RequestExecutionStatus
+	UnauthorizedReason enums.APIKeyValidationFailureCode
+
+	Requests int
+	Units    int
+}
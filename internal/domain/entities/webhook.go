@@ -0,0 +1,141 @@
+package entities
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/enums"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+const (
+	// WebhookMaxAttempts is the number of times a delivery is sent before
+	// it is given up.
+	WebhookMaxAttempts = 10
+
+	webhookSecretPrefix = "whsec_"
+	webhookRetryBase    = 30 * time.Second
+	webhookRetryMax     = 6 * time.Hour
+)
+
+// Webhook is a subscription of a URL to the events of a client's projects
+// or of a single project. Exactly one of ClientID and ProjectID is set.
+type Webhook struct {
+	ID int
+
+	ClientID  int
+	ProjectID int
+
+	URL    string
+	Secret string `audit:"-"`
+	Events []enums.WebhookEventType
+	Status enums.WebhookStatus
+
+	CreatedAt time.Time
+}
+
+// GenerateSecret creates the key the payloads sent to the webhook are
+// signed with.
+func (w *Webhook) GenerateSecret() errors.Error {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return errors.NewInternal("webhook secret generation failed", err)
+	}
+
+	w.Secret = webhookSecretPrefix + hex.EncodeToString(bytes)
+	return nil
+}
+
+// WebhookEvent is something that happened to a project. It is delivered to
+// the webhooks subscribed to its type through the project or its client.
+// When ProjectID is not known, the project of EnvironmentID is used.
+type WebhookEvent struct {
+	Type enums.WebhookEventType
+
+	ProjectID     int
+	EnvironmentID int
+	ServiceID     int
+	APIKeyID      int
+
+	// Quota is set on quota events.
+	Quota *WebhookEventQuota
+
+	OccurredAt time.Time
+}
+
+// WebhookEventQuota is the state of the quota an event is about. Threshold
+// is the percentage of MaxRequests consumed that triggered the event, and
+// AlertID the quota alert that set it, if any.
+type WebhookEventQuota struct {
+	Level            enums.QuotaLevel
+	AlertID          int
+	Threshold        int
+	MaxRequests      int
+	AvailableRequest int
+}
+
+// WebhookDelivery is an event queued for a webhook, along with the outcome
+// of its last attempt.
+type WebhookDelivery struct {
+	ID string
+
+	WebhookID int
+	Event     enums.WebhookEventType
+	Payload   []byte
+
+	Status         enums.WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	DeliveredAt    time.Time
+
+	// URL and Secret are those of the webhook, set on the deliveries
+	// claimed to be sent.
+	URL    string
+	Secret string
+
+	CreatedAt time.Time
+}
+
+// RecordAttempt records the outcome of sending the delivery at now. A
+// delivery is delivered when the webhook answers with a 2xx status code;
+// otherwise it is retried with exponential backoff until it has been
+// attempted WebhookMaxAttempts times.
+func (d *WebhookDelivery) RecordAttempt(
+	now time.Time, statusCode int, failure string,
+) {
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.LastError = failure
+
+	if failure == "" && statusCode >= 200 && statusCode < 300 {
+		d.Status = enums.WebhookDeliveryStatusDelivered
+		d.DeliveredAt = now
+		return
+	}
+
+	if failure == "" {
+		d.LastError = fmt.Sprintf("unexpected status code %d", statusCode)
+	}
+
+	if d.Attempts >= WebhookMaxAttempts {
+		d.Status = enums.WebhookDeliveryStatusFailed
+		return
+	}
+
+	d.Status = enums.WebhookDeliveryStatusPending
+	d.NextAttemptAt = now.Add(webhookRetryDelay(d.Attempts))
+}
+
+// webhookRetryDelay doubles the wait after each failed attempt, starting
+// at webhookRetryBase and capped at webhookRetryMax.
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookRetryBase
+	for i := 1; i < attempts && delay < webhookRetryMax; i++ {
+		delay *= 2
+	}
+	return min(delay, webhookRetryMax)
+}
@@ -1,5 +1,7 @@
 package enums
 
+import "time"
+
 type APIKeyStatus string
 
 const (
@@ -17,6 +19,34 @@ func ParseAPIKeyStatus(status string) (APIKeyStatus, bool) {
 	}
 }
 
+type APIKeyRateLimitPeriod string
+
+const (
+	APIKeyRateLimitPeriodNull   APIKeyRateLimitPeriod = ""
+	APIKeyRateLimitPeriodSecond APIKeyRateLimitPeriod = "second"
+	APIKeyRateLimitPeriodMinute APIKeyRateLimitPeriod = "minute"
+)
+
+func ParseAPIKeyRateLimitPeriod(period string) (APIKeyRateLimitPeriod, bool) {
+	switch p := APIKeyRateLimitPeriod(period); p {
+	case APIKeyRateLimitPeriodNull,
+		APIKeyRateLimitPeriodSecond,
+		APIKeyRateLimitPeriodMinute:
+		return p, true
+	default:
+		return APIKeyRateLimitPeriodNull, false
+	}
+}
+
+func (p APIKeyRateLimitPeriod) Duration() time.Duration {
+	switch p {
+	case APIKeyRateLimitPeriodMinute:
+		return time.Minute
+	default:
+		return time.Second
+	}
+}
+
 type APIKeyValidationFailureCode string
 
 const (
@@ -29,6 +59,7 @@ const (
 	APIKeyValidationFailureCodeServiceDeprecated   APIKeyValidationFailureCode = "SERVICE_DEPRECATED"
 	APIKeyValidationFailureCodeServiceNotAssigned  APIKeyValidationFailureCode = "SERVICE_NOT_ASSIGNED"
 	APIKeyValidationFailureCodeEnvironmentDisabled APIKeyValidationFailureCode = "ENVIRONMENT_DISABLED"
+	APIKeyValidationFailureCodeRateLimited         APIKeyValidationFailureCode = "RATE_LIMITED"
 )
 
 func ParseAPIKeyValidationFailureCode(code string) (APIKeyValidationFailureCode, bool) {
@@ -39,7 +70,8 @@ func ParseAPIKeyValidationFailureCode(code string) (APIKeyValidationFailureCode,
 		APIKeyValidationFailureCodeAPIKeyDisabled,
 		APIKeyValidationFailureCodeServiceMismatch,
 		APIKeyValidationFailureCodeServiceNotAssigned,
-		APIKeyValidationFailureCodeEnvironmentDisabled:
+		APIKeyValidationFailureCodeEnvironmentDisabled,
+		APIKeyValidationFailureCodeRateLimited:
 		return c, true
 	default:
 		return "", false
@@ -47,13 +79,14 @@ func ParseAPIKeyValidationFailureCode(code string) (APIKeyValidationFailureCode,
 }
 
 var ValidationFailurePriority = map[APIKeyValidationFailureCode]int{
-	APIKeyValidationFailureCodeAPIKeyInvalid:       9,
-	APIKeyValidationFailureCodeAPIKeyDisabled:      8,
-	APIKeyValidationFailureCodeAPIKeyExpired:       7,
-	APIKeyValidationFailureCodeServiceMismatch:     6,
-	APIKeyValidationFailureCodeServiceDisabled:     5,
-	APIKeyValidationFailureCodeServiceDeprecated:   4,
-	APIKeyValidationFailureCodeServiceNotAssigned:  3,
-	APIKeyValidationFailureCodeEnvironmentDisabled: 2,
+	APIKeyValidationFailureCodeAPIKeyInvalid:       10,
+	APIKeyValidationFailureCodeAPIKeyDisabled:      9,
+	APIKeyValidationFailureCodeAPIKeyExpired:       8,
+	APIKeyValidationFailureCodeServiceMismatch:     7,
+	APIKeyValidationFailureCodeServiceDisabled:     6,
+	APIKeyValidationFailureCodeServiceDeprecated:   5,
+	APIKeyValidationFailureCodeServiceNotAssigned:  4,
+	APIKeyValidationFailureCodeEnvironmentDisabled: 3,
+	APIKeyValidationFailureCodeRateLimited:         2,
 	APIKeyValidationFailureCodeQuotaExceeded:       1,
 }
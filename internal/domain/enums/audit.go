@@ -0,0 +1,77 @@
+package enums
+
+type AuditEntity string
+
+const (
+	AuditEntityNull        AuditEntity = ""
+	AuditEntityClient      AuditEntity = "client"
+	AuditEntityProject     AuditEntity = "project"
+	AuditEntityEnvironment AuditEntity = "environment"
+	AuditEntityService     AuditEntity = "service"
+	AuditEntityAPIKey      AuditEntity = "api_key"
+	AuditEntityWebhook     AuditEntity = "webhook"
+	AuditEntityQuotaAlert  AuditEntity = "quota_alert"
+	AuditEntityCredentials AuditEntity = "credentials"
+	AuditEntityClientToken AuditEntity = "client_token"
+)
+
+func ParseAuditEntity(entity string) (AuditEntity, bool) {
+	switch e := AuditEntity(entity); e {
+	case AuditEntityNull,
+		AuditEntityClient,
+		AuditEntityProject,
+		AuditEntityEnvironment,
+		AuditEntityService,
+		AuditEntityAPIKey,
+		AuditEntityWebhook,
+		AuditEntityQuotaAlert,
+		AuditEntityCredentials,
+		AuditEntityClientToken:
+		return e, true
+	default:
+		return AuditEntityNull, false
+	}
+}
+
+type AuditAction string
+
+const (
+	AuditActionNull            AuditAction = ""
+	AuditActionCreate          AuditAction = "create"
+	AuditActionUpdate          AuditAction = "update"
+	AuditActionDelete          AuditAction = "delete"
+	AuditActionAssignService   AuditAction = "assign_service"
+	AuditActionUpdateService   AuditAction = "update_service"
+	AuditActionRemoveService   AuditAction = "remove_service"
+	AuditActionResetRequests   AuditAction = "reset_requests"
+	AuditActionUpdateStatus    AuditAction = "update_status"
+	AuditActionUpdateRetention AuditAction = "update_retention"
+	AuditActionEnable          AuditAction = "enable"
+	AuditActionDisable         AuditAction = "disable"
+	AuditActionRotate          AuditAction = "rotate"
+	AuditActionRevealKey       AuditAction = "reveal_key"
+	AuditActionChangePassword  AuditAction = "change_password"
+)
+
+func ParseAuditAction(action string) (AuditAction, bool) {
+	switch a := AuditAction(action); a {
+	case AuditActionNull,
+		AuditActionCreate,
+		AuditActionUpdate,
+		AuditActionDelete,
+		AuditActionAssignService,
+		AuditActionUpdateService,
+		AuditActionRemoveService,
+		AuditActionResetRequests,
+		AuditActionUpdateStatus,
+		AuditActionUpdateRetention,
+		AuditActionEnable,
+		AuditActionDisable,
+		AuditActionRotate,
+		AuditActionRevealKey,
+		AuditActionChangePassword:
+		return a, true
+	default:
+		return AuditActionNull, false
+	}
+}
@@ -31,3 +31,94 @@ func ParseScope(action string) (Scope, bool) {
 		return ScopeNull, false
 	}
 }
+
+type AdminRole string
+
+const (
+	AdminRoleNull     AdminRole = ""
+	AdminRoleOwner    AdminRole = "owner"
+	AdminRoleOperator AdminRole = "operator"
+	AdminRoleViewer   AdminRole = "viewer"
+	AdminRoleBilling  AdminRole = "billing"
+)
+
+func ParseAdminRole(role string) (AdminRole, bool) {
+	switch r := AdminRole(role); r {
+	case AdminRoleNull,
+		AdminRoleOwner,
+		AdminRoleOperator,
+		AdminRoleViewer,
+		AdminRoleBilling:
+		return r, true
+	default:
+		return AdminRoleNull, false
+	}
+}
+
+// Can reports whether the role is granted the permission.
+func (r AdminRole) Can(permission Permission) bool {
+	for _, p := range RolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+type Permission string
+
+const (
+	PermissionNull             Permission = ""
+	PermissionResourcesRead    Permission = "resources:read"
+	PermissionResourcesWrite   Permission = "resources:write"
+	PermissionUsageRead        Permission = "usage:read"
+	PermissionAPIKeyReveal     Permission = "api_key:reveal"
+	PermissionAuditRead        Permission = "audit:read"
+	PermissionAdminUsersManage Permission = "admin_users:manage"
+)
+
+// RolePermissions lists the permissions granted to each role. Viewers see
+// the configuration, billing also sees its usage, operators change it and
+// owners also manage the admin users and read the audit log.
+var RolePermissions = map[AdminRole][]Permission{
+	AdminRoleOwner: {
+		PermissionResourcesRead,
+		PermissionResourcesWrite,
+		PermissionUsageRead,
+		PermissionAPIKeyReveal,
+		PermissionAuditRead,
+		PermissionAdminUsersManage,
+	},
+	AdminRoleOperator: {
+		PermissionResourcesRead,
+		PermissionResourcesWrite,
+		PermissionUsageRead,
+		PermissionAPIKeyReveal,
+	},
+	AdminRoleBilling: {
+		PermissionResourcesRead,
+		PermissionUsageRead,
+	},
+	AdminRoleViewer: {
+		PermissionResourcesRead,
+	},
+}
+
+type CredentialsStatus string
+
+const (
+	CredentialsStatusNull     CredentialsStatus = ""
+	CredentialsStatusEnabled  CredentialsStatus = "enabled"
+	CredentialsStatusDisabled CredentialsStatus = "disabled"
+)
+
+func ParseCredentialsStatus(status string) (CredentialsStatus, bool) {
+	switch s := CredentialsStatus(status); s {
+	case CredentialsStatusNull,
+		CredentialsStatusEnabled,
+		CredentialsStatusDisabled:
+		return s, true
+	default:
+		return CredentialsStatusNull, false
+	}
+}
@@ -16,3 +16,20 @@ func ParseEnvironmentStatus(status string) (EnvironmentStatus, bool) {
 		return EnvironmentStatusNull, false
 	}
 }
+
+type EnvironmentType string
+
+const (
+	EnvironmentTypeNull EnvironmentType = ""
+	EnvironmentTypeLive EnvironmentType = "live"
+	EnvironmentTypeTest EnvironmentType = "test"
+)
+
+func ParseEnvironmentType(t string) (EnvironmentType, bool) {
+	switch s := EnvironmentType(t); s {
+	case EnvironmentTypeNull, EnvironmentTypeLive, EnvironmentTypeTest:
+		return s, true
+	default:
+		return EnvironmentTypeNull, false
+	}
+}
@@ -0,0 +1,18 @@
+package enums
+
+type QuotaLevel string
+
+const (
+	QuotaLevelNull        QuotaLevel = ""
+	QuotaLevelProject     QuotaLevel = "project"
+	QuotaLevelEnvironment QuotaLevel = "environment"
+)
+
+func ParseQuotaLevel(level string) (QuotaLevel, bool) {
+	switch l := QuotaLevel(level); l {
+	case QuotaLevelNull, QuotaLevelProject, QuotaLevelEnvironment:
+		return l, true
+	default:
+		return QuotaLevelNull, false
+	}
+}
@@ -9,6 +9,7 @@ const (
 	RequestExecutionStatusClientError  RequestExecutionStatus = "client_error"
 	RequestExecutionStatusServerError  RequestExecutionStatus = "server_error"
 	RequestExecutionStatusUnauthorized RequestExecutionStatus = "unauthorized"
+	RequestExecutionStatusAbandoned    RequestExecutionStatus = "abandoned"
 )
 
 func ParseRequestExecutionStatus(status string) (RequestExecutionStatus, bool) {
@@ -18,7 +19,8 @@ func ParseRequestExecutionStatus(status string) (RequestExecutionStatus, bool) {
 		RequestExecutionStatusForwarded,
 		RequestExecutionStatusClientError,
 		RequestExecutionStatusServerError,
-		RequestExecutionStatusUnauthorized:
+		RequestExecutionStatusUnauthorized,
+		RequestExecutionStatusAbandoned:
 		return s, true
 	default:
 		return RequestExecutionStatusNull, false
@@ -53,3 +55,22 @@ func ParseRequestBodyContentType(status string) (RequestBodyContentType, bool) {
 		return RequestBodyContentTypeNull, false
 	}
 }
+
+type RequestSortField string
+
+const (
+	RequestSortFieldNull        RequestSortField = ""
+	RequestSortFieldCreatedAt   RequestSortField = "created_at"
+	RequestSortFieldRequestTime RequestSortField = "request_time"
+)
+
+func ParseRequestSortField(field string) (RequestSortField, bool) {
+	switch f := RequestSortField(field); f {
+	case RequestSortFieldNull,
+		RequestSortFieldCreatedAt,
+		RequestSortFieldRequestTime:
+		return f, true
+	default:
+		return RequestSortFieldNull, false
+	}
+}
@@ -0,0 +1,20 @@
+package enums
+
+type SortOrder string
+
+const (
+	SortOrderNull SortOrder = ""
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+func ParseSortOrder(order string) (SortOrder, bool) {
+	switch o := SortOrder(order); o {
+	case SortOrderNull,
+		SortOrderAsc,
+		SortOrderDesc:
+		return o, true
+	default:
+		return SortOrderNull, false
+	}
+}
@@ -0,0 +1,78 @@
+package enums
+
+import "time"
+
+type UsageGranularity string
+
+const (
+	UsageGranularityNull  UsageGranularity = ""
+	UsageGranularityHour  UsageGranularity = "hour"
+	UsageGranularityDay   UsageGranularity = "day"
+	UsageGranularityMonth UsageGranularity = "month"
+)
+
+func ParseUsageGranularity(granularity string) (UsageGranularity, bool) {
+	switch g := UsageGranularity(granularity); g {
+	case UsageGranularityNull,
+		UsageGranularityHour,
+		UsageGranularityDay,
+		UsageGranularityMonth:
+		return g, true
+	default:
+		return UsageGranularityNull, false
+	}
+}
+
+// Truncate returns the start of the bucket t falls in, in UTC.
+func (g UsageGranularity) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	switch g {
+	case UsageGranularityMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case UsageGranularityDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	default:
+		return t.Truncate(time.Hour)
+	}
+}
+
+// Next returns the start of the bucket following the one starting at t.
+func (g UsageGranularity) Next(t time.Time) time.Time {
+	switch g {
+	case UsageGranularityMonth:
+		return t.AddDate(0, 1, 0)
+	case UsageGranularityDay:
+		return t.AddDate(0, 0, 1)
+	default:
+		return t.Add(time.Hour)
+	}
+}
+
+type UsageGroupBy string
+
+const (
+	UsageGroupByNull               UsageGroupBy = ""
+	UsageGroupByClient             UsageGroupBy = "client"
+	UsageGroupByProject            UsageGroupBy = "project"
+	UsageGroupByEnvironment        UsageGroupBy = "environment"
+	UsageGroupByService            UsageGroupBy = "service"
+	UsageGroupByAPIKey             UsageGroupBy = "api_key"
+	UsageGroupByExecutionStatus    UsageGroupBy = "execution_status"
+	UsageGroupByUnauthorizedReason UsageGroupBy = "unauthorized_reason"
+)
+
+func ParseUsageGroupBy(groupBy string) (UsageGroupBy, bool) {
+	switch g := UsageGroupBy(groupBy); g {
+	case UsageGroupByNull,
+		UsageGroupByClient,
+		UsageGroupByProject,
+		UsageGroupByEnvironment,
+		UsageGroupByService,
+		UsageGroupByAPIKey,
+		UsageGroupByExecutionStatus,
+		UsageGroupByUnauthorizedReason:
+		return g, true
+	default:
+		return UsageGroupByNull, false
+	}
+}
@@ -0,0 +1,66 @@
+package enums
+
+type WebhookStatus string
+
+const (
+	WebhookStatusNull     WebhookStatus = ""
+	WebhookStatusEnabled  WebhookStatus = "enabled"
+	WebhookStatusDisabled WebhookStatus = "disabled"
+)
+
+func ParseWebhookStatus(status string) (WebhookStatus, bool) {
+	switch s := WebhookStatus(status); s {
+	case WebhookStatusNull, WebhookStatusEnabled, WebhookStatusDisabled:
+		return s, true
+	default:
+		return WebhookStatusNull, false
+	}
+}
+
+type WebhookEventType string
+
+const (
+	WebhookEventTypeNull                  WebhookEventType = ""
+	WebhookEventTypeQuotaThresholdReached WebhookEventType = "quota.threshold_reached"
+	WebhookEventTypeQuotaExhausted        WebhookEventType = "quota.exhausted"
+	WebhookEventTypeQuotaReset            WebhookEventType = "quota.reset"
+	WebhookEventTypeAPIKeyExpired         WebhookEventType = "api_key.expired"
+	WebhookEventTypeAPIKeyDisabled        WebhookEventType = "api_key.disabled"
+	WebhookEventTypeQuotaAlertTriggered   WebhookEventType = "quota.alert_triggered"
+)
+
+func ParseWebhookEventType(eventType string) (WebhookEventType, bool) {
+	switch t := WebhookEventType(eventType); t {
+	case WebhookEventTypeNull,
+		WebhookEventTypeQuotaThresholdReached,
+		WebhookEventTypeQuotaExhausted,
+		WebhookEventTypeQuotaReset,
+		WebhookEventTypeAPIKeyExpired,
+		WebhookEventTypeAPIKeyDisabled,
+		WebhookEventTypeQuotaAlertTriggered:
+		return t, true
+	default:
+		return WebhookEventTypeNull, false
+	}
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusNull      WebhookDeliveryStatus = ""
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+func ParseWebhookDeliveryStatus(status string) (WebhookDeliveryStatus, bool) {
+	switch s := WebhookDeliveryStatus(status); s {
+	case WebhookDeliveryStatusNull,
+		WebhookDeliveryStatusPending,
+		WebhookDeliveryStatusDelivered,
+		WebhookDeliveryStatusFailed:
+		return s, true
+	default:
+		return WebhookDeliveryStatusNull, false
+	}
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type RequestArchiver interface {
+	// Archive stores the requests outside the database before they are
+	// deleted from the request log. Archiving the same batch again
+	// overwrites the copy made by the previous attempt.
+	Archive(ctx context.Context, requests []*entities.Request) errors.Error
+}
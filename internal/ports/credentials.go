@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type LegacyCredentialsLoader interface {
+	// Load returns the admin user of the credentials file used before
+	// admin users were stored in the database, or nil when there is none.
+	Load() (*entities.Credentials, errors.Error)
+}
@@ -0,0 +1,12 @@
+package ports
+
+import "github.com/MAD-py/pandora-core/internal/domain/errors"
+
+type APIKeyProtector interface {
+	// ... Hash ...
+	Hash(key string) string
+
+	// ... Escrow ...
+	Encrypt(key string) (string, errors.Error)
+	Decrypt(encryptedKey string) (string, errors.Error)
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type RateLimiter interface {
+	// Allow takes a request from the bucket identified by key. It returns
+	// zero when the request is allowed, or how long to wait otherwise.
+	Allow(ctx context.Context, key string, limit *entities.APIKeyRateLimit) (time.Duration, errors.Error)
+}
@@ -17,17 +17,25 @@ type APIKeyRepository interface {
 	// ... Get ...
 	GetByID(ctx context.Context, id int) (*entities.APIKey, errors.Error)
 	GetByKey(ctx context.Context, key string) (*entities.APIKey, errors.Error)
+	GetKeyByID(ctx context.Context, id int) (string, errors.Error)
+	CountByEnvironment(ctx context.Context, environmentID int) (int, errors.Error)
+	CountUsableByEnvironment(ctx context.Context, environmentID int) (int, errors.Error)
 
 	// ... List ...
-	ListByEnvironment(ctx context.Context, environmentID int) ([]*entities.APIKey, errors.Error)
+	ListByEnvironment(ctx context.Context, environmentID int, page *dto.Pagination) ([]*entities.APIKey, errors.Error)
+	ListExpiredBetween(ctx context.Context, from, to time.Time) ([]*entities.APIKey, errors.Error)
 
 	// ... Create ...
 	Create(ctx context.Context, apiKey *entities.APIKey) errors.Error
+	CreateWithinLimit(ctx context.Context, apiKey *entities.APIKey, limit int) errors.Error
 
 	// ... Update ...
 	Update(ctx context.Context, id int, update *dto.APIKeyUpdate) (*entities.APIKey, errors.Error)
 	UpdateStatus(ctx context.Context, id int, status enums.APIKeyStatus) errors.Error
 	UpdateLastUsed(ctx context.Context, key string) errors.Error
+	ProtectLegacyKeys(ctx context.Context) (int, errors.Error)
+	Rotate(ctx context.Context, id int, graceEndsAt time.Time, successor *entities.APIKey, limit int) errors.Error
+	DisableRotated(ctx context.Context, now time.Time) ([]*entities.APIKey, errors.Error)
 
 	// ... Delete ...
 	Delete(ctx context.Context, id int) errors.Error
@@ -39,9 +47,10 @@ type ClientRepository interface {
 
 	// ... Get ...
 	GetByID(ctx context.Context, id int) (*entities.Client, errors.Error)
+	Count(ctx context.Context, filter *dto.ClientFilter) (int, errors.Error)
 
 	// ... List ...
-	List(ctx context.Context, filter *dto.ClientFilter) ([]*entities.Client, errors.Error)
+	List(ctx context.Context, filter *dto.ClientFilter, page *dto.Pagination) ([]*entities.Client, errors.Error)
 
 	// ... Create ...
 	Create(ctx context.Context, client *entities.Client) errors.Error
@@ -53,21 +62,39 @@ type ClientRepository interface {
 	Delete(ctx context.Context, id int) errors.Error
 }
 
+type ClientTokenRepository interface {
+	// ... Get ...
+	GetByID(ctx context.Context, id int) (*entities.ClientToken, errors.Error)
+	GetByToken(ctx context.Context, token string) (*entities.ClientToken, errors.Error)
+
+	// ... List ...
+	ListByClient(ctx context.Context, clientID int) ([]*entities.ClientToken, errors.Error)
+
+	// ... Create ...
+	Create(ctx context.Context, token *entities.ClientToken) errors.Error
+
+	// ... Update ...
+	UpdateLastUsed(ctx context.Context, id int) errors.Error
+
+	// ... Delete ...
+	Delete(ctx context.Context, id int) errors.Error
+}
+
 type EnvironmentRepository interface {
 	// ... Exists ...
 	Exists(ctx context.Context, id int) (bool, errors.Error)
 	IsEnabled(ctx context.Context, id int) (bool, errors.Error)
 	ExistsServiceIn(ctx context.Context, id, serviceID int) (bool, errors.Error)
 	MissingResourceDiagnosis(ctx context.Context, id int, serviceID int) (bool, bool, errors.Error)
-	ExistsServiceWithInfiniteMaxRequest(ctx context.Context, projectID, serviceID int) (bool, errors.Error)
 
 	// ... Get ...
 	GetByID(ctx context.Context, id int) (*entities.Environment, errors.Error)
 	GetServiceByID(ctx context.Context, id, serviceID int) (*entities.EnvironmentService, errors.Error)
 	GetProjectServiceQuotaUsage(ctx context.Context, id, serviceID int) (*dto.QuotaUsage, errors.Error)
+	CountByProject(ctx context.Context, projectID int) (int, errors.Error)
 
 	// ... List ...
-	ListByProject(ctx context.Context, projectID int) ([]*entities.Environment, errors.Error)
+	ListByProject(ctx context.Context, projectID int, page *dto.Pagination) ([]*entities.Environment, errors.Error)
 
 	// ... Create ...
 	Create(ctx context.Context, environment *entities.Environment) errors.Error
@@ -78,8 +105,8 @@ type EnvironmentRepository interface {
 	UpdateStatus(ctx context.Context, id int, status enums.EnvironmentStatus) errors.Error
 	UpdateService(ctx context.Context, id, serviceID int, update *dto.EnvironmentServiceUpdate) (*entities.EnvironmentService, errors.Error)
 	ResetAvailableRequests(ctx context.Context, id, serviceID int) (*entities.EnvironmentService, errors.Error)
-	IncreaseAvailableRequest(ctx context.Context, id, serviceID int) errors.Error
-	DecrementAvailableRequest(ctx context.Context, id, serviceID int) (*dto.DecrementAvailableRequest, errors.Error)
+	IncreaseAvailableRequest(ctx context.Context, id, serviceID, units int) errors.Error
+	DecrementAvailableRequest(ctx context.Context, id, serviceID, units int) (*dto.DecrementAvailableRequest, errors.Error)
 
 	// ... Delete ...
 	Delete(ctx context.Context, id int) errors.Error
@@ -97,10 +124,12 @@ type ProjectRepository interface {
 	GetServiceByID(ctx context.Context, id, serviceID int) (*entities.ProjectService, errors.Error)
 	GetProjectClientInfoByID(ctx context.Context, id int) (*dto.ProjectClientInfoResponse, errors.Error)
 	GetProjectServiceQuotaUsage(ctx context.Context, id, serviceID int) (*dto.QuotaUsage, errors.Error)
+	Count(ctx context.Context) (int, errors.Error)
+	CountByClient(ctx context.Context, clientID int) (int, errors.Error)
 
 	// ... List ...
-	List(ctx context.Context) ([]*entities.Project, errors.Error)
-	ListByClient(ctx context.Context, clientID int) ([]*entities.Project, errors.Error)
+	List(ctx context.Context, page *dto.Pagination) ([]*entities.Project, errors.Error)
+	ListByClient(ctx context.Context, clientID int, page *dto.Pagination) ([]*entities.Project, errors.Error)
 	ListProjectServiceDueForReset(ctx context.Context, today time.Time) ([]*entities.Project, errors.Error)
 
 	// ... Create ...
@@ -120,18 +149,35 @@ type ProjectRepository interface {
 }
 
 type RequestRepository interface {
+	// ... Get ...
+	GetByID(ctx context.Context, id string) (*entities.Request, errors.Error)
+	Count(ctx context.Context, filter *dto.RequestSearch) (int, errors.Error)
+	CountByService(ctx context.Context, serviceID int, filter *dto.RequestFilter) (int, errors.Error)
+
 	// ... List ...
-	ListByService(ctx context.Context, serviceID int, filter *dto.RequestFilter) ([]*entities.Request, errors.Error)
+	Search(ctx context.Context, filter *dto.RequestSearch, page *dto.Pagination) ([]*entities.Request, errors.Error)
+	ListChain(ctx context.Context, id string) ([]*entities.Request, errors.Error)
+	ListByService(ctx context.Context, serviceID int, filter *dto.RequestFilter, page *dto.Pagination) ([]*entities.Request, errors.Error)
+	StreamSearch(ctx context.Context, filter *dto.RequestSearch, yield func(*entities.Request) errors.Error) errors.Error
+	ListExpired(ctx context.Context, retention *dto.RequestRetention, limit int) ([]*entities.Request, errors.Error)
+	ListOrphaned(ctx context.Context, before time.Time, limit int) ([]*entities.Request, errors.Error)
+	ListByPartition(ctx context.Context, partition string, page *dto.Pagination) ([]*entities.Request, errors.Error)
+	ListPartitions(ctx context.Context) ([]*entities.RequestPartition, errors.Error)
 
 	// ... Create ...
 	Create(ctx context.Context, request *entities.Request) errors.Error
 	CreateAsInitialPoint(ctx context.Context, request *entities.Request) errors.Error
+	CreateBatch(ctx context.Context, requests []*entities.Request) errors.Error
+	CreatePartition(ctx context.Context, from, to time.Time) (bool, errors.Error)
 
 	// ... Update ...
 	UpdateExecutionStatus(ctx context.Context, id string, update *dto.RequestExecutionStatusUpdate) errors.Error
+	UpdateUnits(ctx context.Context, id string, units int) errors.Error
 
 	// ... Delete ...
 	DeleteByService(ctx context.Context, serviceID int) errors.Error
+	DeleteByIDs(ctx context.Context, ids []string) (int, errors.Error)
+	DropPartition(ctx context.Context, partition string) errors.Error
 }
 
 type ReservationRepository interface {
@@ -140,11 +186,16 @@ type ReservationRepository interface {
 	GetByIDWithDetails(ctx context.Context, id string) (*dto.ReservationWithDetails, errors.Error)
 	CountByEnvironmentAndService(ctx context.Context, environment_id, service_id int) (int, errors.Error)
 
+	// ... List ...
+	ListExpired(ctx context.Context, now time.Time) ([]*entities.Reservation, errors.Error)
+
 	// ... Create ...
 	Create(ctx context.Context, Reservation *entities.Reservation) errors.Error
 
 	// ... Delete ...
 	Delete(ctx context.Context, id string) errors.Error
+	Commit(ctx context.Context, id string, units int) errors.Error
+	Release(ctx context.Context, id string, update *dto.RequestExecutionStatusUpdate) errors.Error
 }
 
 type ServiceRepository interface {
@@ -152,25 +203,116 @@ type ServiceRepository interface {
 	Exists(ctx context.Context, id int) (bool, errors.Error)
 
 	// ... Get ...
+	GetByID(ctx context.Context, id int) (*entities.Service, errors.Error)
 	GetByNameAndVersion(ctx context.Context, name, version string) (*entities.Service, errors.Error)
+	Count(ctx context.Context, filter *dto.ServiceFilter) (int, errors.Error)
 
 	// ... List ...
-	List(ctx context.Context, filter *dto.ServiceFilter) ([]*entities.Service, errors.Error)
+	List(ctx context.Context, filter *dto.ServiceFilter, page *dto.Pagination) ([]*entities.Service, errors.Error)
+	ListWithRetention(ctx context.Context) ([]*entities.Service, errors.Error)
 
 	// ... Create ...
 	Create(ctx context.Context, service *entities.Service) errors.Error
 
 	// ... Update ...
 	UpdateStatus(ctx context.Context, id int, status enums.ServiceStatus) (*entities.Service, errors.Error)
+	UpdateRetention(ctx context.Context, id int, days *int) (*entities.Service, errors.Error)
 
 	// ... Delete ...
 	Delete(ctx context.Context, id int) errors.Error
 }
 
+type UsageRepository interface {
+	// ... Get ...
+	GetRollupWatermark(ctx context.Context) (time.Time, errors.Error)
+
+	// ... List ...
+	List(ctx context.Context, filter *dto.UsageFilter) ([]*entities.UsageBucket, errors.Error)
+	StreamList(ctx context.Context, filter *dto.UsageFilter, yield func(*entities.UsageBucket) errors.Error) errors.Error
+
+	// ... Update ...
+	RefreshRollups(ctx context.Context, from, to time.Time) (int, errors.Error)
+}
+
+type WebhookRepository interface {
+	// ... Exists ...
+	Exists(ctx context.Context, id int) (bool, errors.Error)
+
+	// ... Get ...
+	GetByID(ctx context.Context, id int) (*entities.Webhook, errors.Error)
+	GetExpiryWatermark(ctx context.Context) (time.Time, errors.Error)
+	Count(ctx context.Context, filter *dto.WebhookFilter) (int, errors.Error)
+	CountDeliveries(ctx context.Context, id int, filter *dto.WebhookDeliveryFilter) (int, errors.Error)
+
+	// ... List ...
+	List(ctx context.Context, filter *dto.WebhookFilter, page *dto.Pagination) ([]*entities.Webhook, errors.Error)
+	ListDeliveries(ctx context.Context, id int, filter *dto.WebhookDeliveryFilter, page *dto.Pagination) ([]*entities.WebhookDelivery, errors.Error)
+
+	// ... Create ...
+	Create(ctx context.Context, webhook *entities.Webhook) errors.Error
+	CreateDeliveries(ctx context.Context, events []*entities.WebhookEvent) (int, errors.Error)
+
+	// ... Update ...
+	Update(ctx context.Context, id int, update *dto.WebhookUpdate) (*entities.Webhook, errors.Error)
+	ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entities.WebhookDelivery, errors.Error)
+	UpdateDelivery(ctx context.Context, delivery *entities.WebhookDelivery) errors.Error
+	UpdateExpiryWatermark(ctx context.Context, watermark time.Time) errors.Error
+
+	// ... Delete ...
+	Delete(ctx context.Context, id int) errors.Error
+}
+
+type QuotaAlertRepository interface {
+	// ... List ...
+	ListByEnvironmentService(ctx context.Context, environmentID, serviceID int) ([]*entities.QuotaAlert, errors.Error)
+
+	// ... Create ...
+	Create(ctx context.Context, alert *entities.QuotaAlert) errors.Error
+
+	// ... Update ...
+	Trigger(ctx context.Context, environmentID, serviceID, environmentPercent, projectPercent int) ([]*entities.QuotaAlert, errors.Error)
+
+	// ... Delete ...
+	Delete(ctx context.Context, environmentID, serviceID, id int) errors.Error
+}
+
+type AuditRepository interface {
+	// ... Get ...
+	Count(ctx context.Context, filter *dto.AuditFilter) (int, errors.Error)
+
+	// ... List ...
+	List(ctx context.Context, filter *dto.AuditFilter, page *dto.Pagination) ([]*entities.AuditEntry, errors.Error)
+
+	// ... Create ...
+	Create(ctx context.Context, entry *entities.AuditEntry) errors.Error
+}
+
 type CredentialsRepository interface {
 	// ... Get ...
+	GetByID(ctx context.Context, id int) (*entities.Credentials, errors.Error)
 	GetByUsername(ctx context.Context, username string) (*entities.Credentials, errors.Error)
+	Count(ctx context.Context, filter *dto.AdminUserFilter) (int, errors.Error)
+
+	// ... List ...
+	List(ctx context.Context, filter *dto.AdminUserFilter, page *dto.Pagination) ([]*entities.Credentials, errors.Error)
+
+	// ... Create ...
+	Create(ctx context.Context, credentials *entities.Credentials) errors.Error
 
 	// ... Update ...
 	ChangePassword(ctx context.Context, credentials *entities.Credentials) errors.Error
+	UpdateStatus(ctx context.Context, id int, status enums.CredentialsStatus) errors.Error
+}
+
+// ValidationRepository serves API key validation and consumption with a
+// single statement each, where the other repositories take one per row.
+type ValidationRepository interface {
+	// ... Get ...
+	Lookup(ctx context.Context, key, serviceName, serviceVersion string) (*dto.APIKeyValidationLookup, errors.Error)
+
+	// ... Create ...
+	Consume(ctx context.Context, request *entities.Request) (*dto.DecrementAvailableRequest, errors.Error)
+
+	// ... Update ...
+	ConsumeQuota(ctx context.Context, request *entities.Request) (*dto.DecrementAvailableRequest, errors.Error)
 }
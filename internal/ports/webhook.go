@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MAD-py/pandora-core/internal/domain/entities"
+	"github.com/MAD-py/pandora-core/internal/domain/errors"
+)
+
+type WebhookSender interface {
+	// Send posts the payload of the delivery to the webhook URL, signed
+	// with the webhook secret, and returns the status code of the
+	// response. An error is returned when no response was received.
+	Send(ctx context.Context, delivery *entities.WebhookDelivery) (int, errors.Error)
+}